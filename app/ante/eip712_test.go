@@ -193,24 +193,24 @@ func (suite *EIP712TestSuite) SetupTest() {
 
 	hardGenState := hardtypes.DefaultGenesisState()
 	hardGenState.Params.MoneyMarkets = []hardtypes.MoneyMarket{
-		{
-			Denom: "usdx",
-			BorrowLimit: hardtypes.BorrowLimit{
-				HasMaxLimit:  true,
-				MaximumLimit: sdk.MustNewDecFromStr("100000000000"),
-				LoanToValue:  sdk.MustNewDecFromStr("1"),
-			},
-			SpotMarketID:     "usdx:usd",
-			ConversionFactor: sdkmath.NewInt(1_000_000),
-			InterestRateModel: hardtypes.InterestRateModel{
-				BaseRateAPY:    sdk.MustNewDecFromStr("0.05"),
-				BaseMultiplier: sdk.MustNewDecFromStr("2"),
-				Kink:           sdk.MustNewDecFromStr("0.8"),
-				JumpMultiplier: sdk.MustNewDecFromStr("10"),
-			},
-			ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
-			KeeperRewardPercentage: sdk.ZeroDec(),
-		},
+		hardtypes.NewMoneyMarket(
+			"usdx",
+			hardtypes.NewBorrowLimit(
+				true,
+				sdk.MustNewDecFromStr("100000000000"),
+				sdk.MustNewDecFromStr("1"),
+			),
+			"usdx:usd",
+			sdkmath.NewInt(1_000_000),
+			hardtypes.NewJumpRateModel(
+				sdk.MustNewDecFromStr("0.05"),
+				sdk.MustNewDecFromStr("2"),
+				sdk.MustNewDecFromStr("0.8"),
+				sdk.MustNewDecFromStr("10"),
+			),
+			sdk.MustNewDecFromStr("0.05"),
+			sdk.ZeroDec(),
+		),
 	}
 
 	pricefeedGenState := pricefeedtypes.DefaultGenesisState()
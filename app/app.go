@@ -240,7 +240,8 @@ var (
 		hardtypes.ModuleAccountName:     {authtypes.Minter},
 		savingstypes.ModuleAccountName:  nil,
 		liquidtypes.ModuleAccountName:   {authtypes.Minter, authtypes.Burner},
-		earntypes.ModuleAccountName:     nil,
+		liquidtypes.BasketAccountName:   nil,
+		earntypes.ModuleAccountName:     {authtypes.Minter, authtypes.Burner},
 		furydisttypes.FundModuleAccount: nil,
 		minttypes.ModuleName:            {authtypes.Minter},
 		communitytypes.ModuleName:       nil,
@@ -371,6 +372,7 @@ func NewApp(
 		swaptypes.StoreKey, cdptypes.StoreKey, hardtypes.StoreKey,
 		committeetypes.StoreKey, incentivetypes.StoreKey, evmutiltypes.StoreKey,
 		savingstypes.StoreKey, earntypes.StoreKey, minttypes.StoreKey,
+		liquidtypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, evmtypes.TransientKey, feemarkettypes.TransientKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -415,6 +417,7 @@ func NewApp(
 	evmSubspace := app.paramsKeeper.Subspace(evmtypes.ModuleName)
 	evmutilSubspace := app.paramsKeeper.Subspace(evmutiltypes.ModuleName)
 	earnSubspace := app.paramsKeeper.Subspace(earntypes.ModuleName)
+	liquidSubspace := app.paramsKeeper.Subspace(liquidtypes.ModuleName)
 	mintSubspace := app.paramsKeeper.Subspace(minttypes.ModuleName)
 
 	bApp.SetParamStore(
@@ -434,13 +437,16 @@ func NewApp(
 		mAccPerms,
 		sdk.GetConfig().GetBech32AccountAddrPrefix(),
 	)
-	app.bankKeeper = bankkeeper.NewBaseKeeper(
+	baseBankKeeper := bankkeeper.NewBaseKeeper(
 		appCodec,
 		keys[banktypes.StoreKey],
 		app.accountKeeper,
 		bankSubspace,
 		app.loadBlockedMaccAddrs(),
 	)
+	// Transfers of earn share tokens and basket derivatives call the earn and
+	// liquid hooks, so their holders earn incentive rewards.
+	app.bankKeeper = NewHookedBankKeeper(baseBankKeeper, &app.earnKeeper, &app.liquidKeeper)
 	app.stakingKeeper = stakingkeeper.NewKeeper(
 		appCodec,
 		keys[stakingtypes.StoreKey],
@@ -577,6 +583,8 @@ func NewApp(
 		appCodec,
 		keys[pricefeedtypes.StoreKey],
 		pricefeedSubspace,
+		&app.liquidKeeper,
+		app.stakingKeeper,
 	)
 	swapKeeper := swapkeeper.NewKeeper(
 		appCodec,
@@ -606,6 +614,8 @@ func NewApp(
 	)
 	app.liquidKeeper = liquidkeeper.NewDefaultKeeper(
 		appCodec,
+		keys[liquidtypes.StoreKey],
+		liquidSubspace,
 		app.accountKeeper,
 		app.bankKeeper,
 		&app.stakingKeeper,
@@ -628,6 +638,9 @@ func NewApp(
 		&app.liquidKeeper,
 		&hardKeeper,
 		&savingsKeeper,
+		&swapKeeper,
+		&cdpKeeper,
+		app.pricefeedKeeper,
 		&app.distrKeeper,
 	)
 
@@ -659,6 +672,11 @@ func NewApp(
 		authtypes.FeeCollectorName,
 	)
 
+	app.routerKeeper = routerkeeper.NewKeeper(
+		&app.earnKeeper,
+		app.liquidKeeper,
+		&app.stakingKeeper,
+	)
 	app.incentiveKeeper = incentivekeeper.NewKeeper(
 		appCodec,
 		keys[incentivetypes.StoreKey],
@@ -672,15 +690,12 @@ func NewApp(
 		&savingsKeeper,
 		&app.liquidKeeper,
 		&earnKeeper,
+		app.routerKeeper,
 		app.mintKeeper,
 		app.distrKeeper,
 		app.pricefeedKeeper,
 	)
-	app.routerKeeper = routerkeeper.NewKeeper(
-		&app.earnKeeper,
-		app.liquidKeeper,
-		&app.stakingKeeper,
-	)
+	app.incentiveKeeper.RegisterRewardSource(liquidtypes.BasketRewardSource, liquidkeeper.NewBasketRewardSource(&app.liquidKeeper))
 
 	// create committee keeper with router
 	committeeGovRouter := govv1beta1.NewRouter()
@@ -696,9 +711,15 @@ func NewApp(
 		appCodec,
 		keys[committeetypes.StoreKey],
 		committeeGovRouter,
+		app.MsgServiceRouter(),
 		app.paramsKeeper,
 		app.accountKeeper,
 		app.bankKeeper,
+		&app.stakingKeeper,
+		&app.liquidKeeper,
+		&savingsKeeper,
+		&earnKeeper,
+		&hardKeeper,
 	)
 
 	// register the staking hooks
@@ -708,13 +729,16 @@ func NewApp(
 			app.distrKeeper.Hooks(),
 			app.slashingKeeper.Hooks(),
 			app.incentiveKeeper.Hooks(),
+			app.liquidKeeper.Hooks(),
 		)))
 
 	app.swapKeeper = *swapKeeper.SetHooks(app.incentiveKeeper.Hooks())
 	app.cdpKeeper = *cdpKeeper.SetHooks(cdptypes.NewMultiCDPHooks(app.incentiveKeeper.Hooks()))
 	app.hardKeeper = *hardKeeper.SetHooks(hardtypes.NewMultiHARDHooks(app.incentiveKeeper.Hooks()))
 	app.savingsKeeper = savingsKeeper // savings incentive hooks disabled
+	earnKeeper.SetIncentiveKeeper(app.incentiveKeeper)
 	app.earnKeeper = *earnKeeper.SetHooks(app.incentiveKeeper.Hooks())
+	app.liquidKeeper.SetRewardSourceHooks(app.incentiveKeeper.Hooks())
 
 	// create gov keeper with router
 	// NOTE this must be done after any keepers referenced in the gov router (ie committee) are defined
@@ -755,7 +779,7 @@ func NewApp(
 	app.mm = module.NewManager(
 		genutil.NewAppModule(app.accountKeeper, app.stakingKeeper, app.BaseApp.DeliverTx, encodingConfig.TxConfig),
 		auth.NewAppModule(appCodec, app.accountKeeper, nil),
-		bank.NewAppModule(appCodec, app.bankKeeper, app.accountKeeper),
+		newBankAppModule(appCodec, app.bankKeeper, baseBankKeeper, app.accountKeeper),
 		capability.NewAppModule(appCodec, *app.capabilityKeeper),
 		staking.NewAppModule(appCodec, app.stakingKeeper, app.accountKeeper, app.bankKeeper),
 		distr.NewAppModule(appCodec, app.distrKeeper, app.accountKeeper, app.bankKeeper, app.stakingKeeper),
@@ -820,6 +844,9 @@ func NewApp(
 		bep3types.ModuleName,
 		hardtypes.ModuleName,
 		issuancetypes.ModuleName,
+		// Liquid begin blocker restakes the staking rewards allocated by distr, increasing the value of derivatives.
+		// It should be run before incentive, which values derivatives for earn rewards.
+		liquidtypes.ModuleName,
 		incentivetypes.ModuleName,
 		ibchost.ModuleName,
 		// Add all remaining modules with an empty begin blocker below since cosmos 0.45.0 requires it
@@ -837,7 +864,6 @@ func NewApp(
 		authz.ModuleName,
 		evmutiltypes.ModuleName,
 		savingstypes.ModuleName,
-		liquidtypes.ModuleName,
 		earntypes.ModuleName,
 		routertypes.ModuleName,
 	)
@@ -913,6 +939,7 @@ func NewApp(
 		committeetypes.ModuleName,
 		evmutiltypes.ModuleName,
 		earntypes.ModuleName,
+		liquidtypes.ModuleName,
 		communitytypes.ModuleName,
 		genutiltypes.ModuleName, // runs arbitrary txs included in genisis state, so run after modules have been initialized
 		crisistypes.ModuleName,  // runs the invariants at genesis, should run after other modules
@@ -921,7 +948,6 @@ func NewApp(
 		paramstypes.ModuleName,
 		upgradetypes.ModuleName,
 		validatorvestingtypes.ModuleName,
-		liquidtypes.ModuleName,
 		routertypes.ModuleName,
 	)
 
@@ -1134,6 +1160,8 @@ func (app *App) loadBlockedMaccAddrs() map[string]bool {
 		app.accountKeeper.GetModuleAddress(earntypes.ModuleName).String(): true,
 		// liquid
 		app.accountKeeper.GetModuleAddress(liquidtypes.ModuleName).String(): true,
+		// liquid basket
+		app.accountKeeper.GetModuleAddress(liquidtypes.BasketAccountName).String(): true,
 		// furydist fund
 		app.accountKeeper.GetModuleAddress(furydisttypes.FundModuleAccount).String(): true,
 		// community
@@ -0,0 +1,152 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// BalanceHooks are called by the app bank keeper around transfers, so modules
+// can react to changes in the balances of their tokens, such as rewarding
+// holders of earn share tokens or basket derivatives.
+type BalanceHooks interface {
+	// BeforeBalancesModified is called before the balances of coins of the
+	// accounts change.
+	BeforeBalancesModified(ctx sdk.Context, accs []sdk.AccAddress, coins sdk.Coins)
+	// AfterBalancesModified is called after the balances of coins of the
+	// accounts change.
+	AfterBalancesModified(ctx sdk.Context, accs []sdk.AccAddress, coins sdk.Coins)
+}
+
+// HookedBankKeeper wraps the bank keeper to call balance hooks around
+// transfers. The bank module has no hooks, so this must be the bank keeper
+// used by all modules. Minting and burning are not hooked, as modules mint to
+// and burn from their own module accounts before sending coins.
+type HookedBankKeeper struct {
+	bankkeeper.Keeper
+
+	hooks []BalanceHooks
+}
+
+var _ bankkeeper.Keeper = HookedBankKeeper{}
+
+// NewHookedBankKeeper returns a bank keeper that calls the hooks when
+// balances are transferred. Hooks of keepers constructed after the bank
+// keeper should be passed as pointers.
+func NewHookedBankKeeper(bk bankkeeper.Keeper, hooks ...BalanceHooks) HookedBankKeeper {
+	return HookedBankKeeper{
+		Keeper: bk,
+		hooks:  hooks,
+	}
+}
+
+// SendCoins transfers coins from one account to another.
+func (k HookedBankKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.withHooks(ctx, []sdk.AccAddress{fromAddr, toAddr}, amt, func() error {
+		return k.Keeper.SendCoins(ctx, fromAddr, toAddr, amt)
+	})
+}
+
+// InputOutputCoins performs multi-send functionality.
+func (k HookedBankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	var accs []sdk.AccAddress
+	amt := sdk.NewCoins()
+	for _, in := range inputs {
+		accs = append(accs, sdk.MustAccAddressFromBech32(in.Address))
+		amt = amt.Add(in.Coins...)
+	}
+	for _, out := range outputs {
+		accs = append(accs, sdk.MustAccAddressFromBech32(out.Address))
+	}
+
+	return k.withHooks(ctx, accs, amt, func() error {
+		return k.Keeper.InputOutputCoins(ctx, inputs, outputs)
+	})
+}
+
+// SendCoinsFromModuleToAccount transfers coins from a module account to an
+// account.
+func (k HookedBankKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	accs := []sdk.AccAddress{authtypes.NewModuleAddress(senderModule), recipientAddr}
+	return k.withHooks(ctx, accs, amt, func() error {
+		return k.Keeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+	})
+}
+
+// SendCoinsFromAccountToModule transfers coins from an account to a module
+// account.
+func (k HookedBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	accs := []sdk.AccAddress{senderAddr, authtypes.NewModuleAddress(recipientModule)}
+	return k.withHooks(ctx, accs, amt, func() error {
+		return k.Keeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+	})
+}
+
+// SendCoinsFromModuleToModule transfers coins from one module account to
+// another.
+func (k HookedBankKeeper) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	accs := []sdk.AccAddress{authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule)}
+	return k.withHooks(ctx, accs, amt, func() error {
+		return k.Keeper.SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt)
+	})
+}
+
+// withHooks calls the balance hooks around a transfer of coins between the
+// accounts.
+func (k HookedBankKeeper) withHooks(ctx sdk.Context, accs []sdk.AccAddress, amt sdk.Coins, transfer func() error) error {
+	for _, h := range k.hooks {
+		h.BeforeBalancesModified(ctx, accs, amt)
+	}
+	if err := transfer(); err != nil {
+		return err
+	}
+	for _, h := range k.hooks {
+		h.AfterBalancesModified(ctx, accs, amt)
+	}
+	return nil
+}
+
+// bankAppModule is the bank module with its msg and query servers using the
+// app bank keeper, which wraps the base keeper to call balance hooks on
+// transfers. The bank module needs the base keeper for its migrations.
+type bankAppModule struct {
+	bank.AppModule
+
+	keeper     bankkeeper.Keeper
+	baseKeeper bankkeeper.BaseKeeper
+}
+
+// newBankAppModule creates a new bank module.
+func newBankAppModule(
+	cdc codec.Codec,
+	keeper bankkeeper.Keeper,
+	baseKeeper bankkeeper.BaseKeeper,
+	accountKeeper banktypes.AccountKeeper,
+) bankAppModule {
+	return bankAppModule{
+		AppModule:  bank.NewAppModule(cdc, keeper, accountKeeper),
+		keeper:     keeper,
+		baseKeeper: baseKeeper,
+	}
+}
+
+// RegisterServices registers module services.
+func (am bankAppModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.keeper))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := bankkeeper.NewMigrator(am.baseKeeper)
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 1 to 2: %v", err))
+	}
+
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 2 to 3: %v", err))
+	}
+}
@@ -8,6 +8,7 @@ import (
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	committeetypes "github.com/incubus-network/fury/x/committee/types"
 	earnkeeper "github.com/incubus-network/fury/x/earn/keeper"
 	liquidkeeper "github.com/incubus-network/fury/x/liquid/keeper"
 	liquidtypes "github.com/incubus-network/fury/x/liquid/types"
@@ -18,12 +19,11 @@ var _ govv1.TallyHandler = TallyHandler{}
 
 // TallyHandler is the tally handler for fury
 type TallyHandler struct {
-	gk  govkeeper.Keeper
-	stk stakingkeeper.Keeper
-	svk savingskeeper.Keeper
-	ek  earnkeeper.Keeper
-	lk  liquidkeeper.Keeper
-	bk  bankkeeper.Keeper
+	gk        govkeeper.Keeper
+	stk       stakingkeeper.Keeper
+	lk        liquidkeeper.Keeper
+	bk        bankkeeper.Keeper
+	positions committeetypes.HolderPositions
 }
 
 // NewTallyHandler creates a new tally handler.
@@ -32,12 +32,11 @@ func NewTallyHandler(
 	ek earnkeeper.Keeper, lk liquidkeeper.Keeper, bk bankkeeper.Keeper,
 ) TallyHandler {
 	return TallyHandler{
-		gk:  gk,
-		stk: stk,
-		svk: svk,
-		ek:  ek,
-		lk:  lk,
-		bk:  bk,
+		gk:        gk,
+		stk:       stk,
+		lk:        lk,
+		bk:        bk,
+		positions: committeetypes.NewHolderPositions(stk, lk, svk, &ek),
 	}
 }
 
@@ -107,25 +106,31 @@ func (th TallyHandler) Tally(
 		// get voter bfury and update total voting power and results
 		addrBfury := th.getAddrBfury(ctx, voter).toCoins()
 		for _, coin := range addrBfury {
-			valAddr, err := liquidtypes.ParseLiquidStakingTokenDenom(coin.Denom)
-			if err != nil {
-				break
-			}
+			if th.lk.IsBasketDenom(coin.Denom) {
+				// reduce delegator shares by the basket delegations backing the voter's basket derivative
+				for valAddrStr, shares := range th.lk.BasketSharesFromDerivative(ctx, coin.Amount) {
+					if val, ok := currValidators[valAddrStr]; ok {
+						val.DelegatorDeductions = val.DelegatorDeductions.Add(shares)
+						currValidators[valAddrStr] = val
+					}
+				}
+			} else {
+				valAddr, err := liquidtypes.ParseLiquidStakingTokenDenom(coin.Denom)
+				if err != nil {
+					break
+				}
 
-			// reduce delegator shares by the amount of voter bfury for the validator
-			valAddrStr := valAddr.String()
-			if val, ok := currValidators[valAddrStr]; ok {
-				val.DelegatorDeductions = val.DelegatorDeductions.Add(sdk.NewDecFromInt(coin.Amount))
-				currValidators[valAddrStr] = val
+				// reduce delegator shares by the shares backing the voter bfury for the validator,
+				// which differ from the bfury amount once rewards have been compounded
+				valAddrStr := valAddr.String()
+				if val, ok := currValidators[valAddrStr]; ok {
+					val.DelegatorDeductions = val.DelegatorDeductions.Add(th.lk.SharesFromDerivative(ctx, valAddr, coin.Amount))
+					currValidators[valAddrStr] = val
+				}
 			}
 
 			// votingPower = amount of ufury coin
-			stakedCoins, err := th.lk.GetStakedTokensForDerivatives(ctx, sdk.NewCoins(coin))
-			if err != nil {
-				// error is returned only if the bfury denom is incorrect, which should never happen here.
-				panic(err)
-			}
-			votingPower := sdk.NewDecFromInt(stakedCoins.Amount)
+			votingPower := sdk.NewDecFromInt(th.positions.DerivativesValue(ctx, sdk.NewCoins(coin)))
 
 			for _, option := range vote.Options {
 				subPower := votingPower.Mul(sdk.MustNewDecFromStr(option.Weight))
@@ -211,46 +216,15 @@ func (bfuryMap bfuryByDenom) toCoins() sdk.Coins {
 // of the addr for each validator.
 func (th TallyHandler) getAddrBfury(ctx sdk.Context, addr sdk.AccAddress) bfuryByDenom {
 	results := make(bfuryByDenom)
-	th.addBfuryFromWallet(ctx, addr, results)
-	th.addBfuryFromSavings(ctx, addr, results)
-	th.addBfuryFromEarn(ctx, addr, results)
-	return results
-}
-
-// addBfuryFromWallet adds all addr balances of bfury in x/bank.
-func (th TallyHandler) addBfuryFromWallet(ctx sdk.Context, addr sdk.AccAddress, bfury bfuryByDenom) {
-	coins := th.bk.GetAllBalances(ctx, addr)
-	for _, coin := range coins {
-		if th.lk.IsDerivativeDenom(ctx, coin.Denom) {
-			bfury.add(coin)
-		}
-	}
-}
-
-// addBfuryFromSavings adds all addr deposits of bfury in x/savings.
-func (th TallyHandler) addBfuryFromSavings(ctx sdk.Context, addr sdk.AccAddress, bfury bfuryByDenom) {
-	deposit, found := th.svk.GetDeposit(ctx, addr)
-	if !found {
-		return
-	}
-	for _, coin := range deposit.Amount {
-		if th.lk.IsDerivativeDenom(ctx, coin.Denom) {
-			bfury.add(coin)
-		}
-	}
-}
-
-// addBfuryFromEarn adds all addr deposits of bfury in x/earn.
-func (th TallyHandler) addBfuryFromEarn(ctx sdk.Context, addr sdk.AccAddress, bfury bfuryByDenom) {
-	shares, found := th.ek.GetVaultAccountShares(ctx, addr)
-	if !found {
-		return
-	}
-	for _, share := range shares {
-		if th.lk.IsDerivativeDenom(ctx, share.Denom) {
-			if coin, err := th.ek.ConvertToAssets(ctx, share); err == nil {
-				bfury.add(coin)
-			}
+	// bfury in x/bank, x/savings and x/earn
+	for _, coins := range []sdk.Coins{
+		th.bk.GetAllBalances(ctx, addr),
+		th.positions.SavingsDeposits(ctx, addr),
+		th.positions.EarnDeposits(ctx, addr),
+	} {
+		for _, coin := range th.positions.Derivatives(ctx, coins) {
+			results.add(coin)
 		}
 	}
+	return results
 }
@@ -122,6 +122,69 @@ func (suite *tallyHandlerSuite) TestVotePower_UserOverridesValidator() {
 	suite.Equal(sdk.ZeroInt().String(), results.AbstainCount)
 }
 
+func (suite *tallyHandlerSuite) TestVotePower_CompoundedDerivativeOverridesValidator() {
+	user := suite.createAccount(suite.newBondCoin(sdkmath.NewInt(1e9)))
+
+	delegated := sdkmath.NewInt(1e9)
+	validator := suite.delegateToNewBondedValidator(user.GetAddress(), delegated)
+	selfDelegated := validator.GetTokens().Sub(delegated)
+
+	suite.mintDerivative(user.GetAddress(), validator.GetOperator(), sdkmath.NewInt(500e6))
+
+	// Restake rewards for the liquid module's delegation, so the derivatives are backed by more shares than their amount.
+	restaked := sdkmath.NewInt(100e6)
+	err := suite.app.FundModuleAccount(suite.ctx, liquidtypes.ModuleAccountName, sdk.NewCoins(suite.newBondCoin(restaked)))
+	suite.Require().NoError(err)
+	modAddr := suite.app.GetAccountKeeper().GetModuleAddress(liquidtypes.ModuleAccountName)
+	_, err = suite.staking.delegate(suite.ctx, modAddr, validator.GetOperator(), restaked)
+	suite.Require().NoError(err)
+
+	proposal := suite.createProposal()
+	suite.voteOnProposal(validator.GetOperator().Bytes(), proposal.Id, govv1beta1.OptionYes)
+
+	// User votes, taking the power of their delegation and all the shares backing their derivative away from the validator.
+	suite.voteOnProposal(user.GetAddress(), proposal.Id, govv1beta1.OptionNo)
+
+	_, _, results := suite.tallier.Tally(suite.ctx, proposal)
+	suite.Equal(selfDelegated.String(), results.YesCount)
+	suite.Equal(sdkmath.NewInt(500e6+600e6).String(), results.NoCount)
+	suite.Equal(sdk.ZeroInt().String(), results.NoWithVetoCount)
+	suite.Equal(sdk.ZeroInt().String(), results.AbstainCount)
+}
+
+func (suite *tallyHandlerSuite) TestVotePower_BasketOverridesValidators() {
+	user := suite.createAccount(suite.newBondCoin(sdkmath.NewInt(1_200_000_000)))
+
+	v1 := suite.delegateToNewBondedValidator(user.GetAddress(), sdkmath.NewInt(1e9))
+	v2 := suite.createNewBondedValidator(sdkmath.NewInt(1e9))
+	selfDelegated := v1.GetTokens().Sub(sdkmath.NewInt(1e9)).Add(v2.GetTokens())
+
+	suite.mintDerivative(user.GetAddress(), v1.GetOperator(), sdkmath.NewInt(100e6))
+
+	lk := suite.app.GetLiquidKeeper()
+	params := lk.GetParams(suite.ctx)
+	params.BasketValidators = liquidtypes.BasketValidators{
+		liquidtypes.NewBasketValidator(v1.GetOperator(), d("0.5")),
+		liquidtypes.NewBasketValidator(v2.GetOperator(), d("0.5")),
+	}
+	lk.SetParams(suite.ctx, params)
+	_, err := lk.MintBasketDerivative(suite.ctx, user.GetAddress(), suite.newBondCoin(sdkmath.NewInt(200e6)))
+	suite.Require().NoError(err)
+
+	proposal := suite.createProposal()
+	suite.voteOnProposal(v1.GetOperator().Bytes(), proposal.Id, govv1beta1.OptionYes)
+	suite.voteOnProposal(v2.GetOperator().Bytes(), proposal.Id, govv1beta1.OptionYes)
+
+	// User votes, taking the power of their delegation, derivative, and basket derivative away from the validators.
+	suite.voteOnProposal(user.GetAddress(), proposal.Id, govv1beta1.OptionNo)
+
+	_, _, results := suite.tallier.Tally(suite.ctx, proposal)
+	suite.Equal(selfDelegated.String(), results.YesCount)
+	suite.Equal(sdkmath.NewInt(900e6+100e6+200e6).String(), results.NoCount)
+	suite.Equal(sdk.ZeroInt().String(), results.NoWithVetoCount)
+	suite.Equal(sdk.ZeroInt().String(), results.AbstainCount)
+}
+
 func (suite *tallyHandlerSuite) TestTallyOutcomes() {
 	suite.Run("VotedPowerBelowQuorumFails", func() {
 		suite.SetupTest()
@@ -11,4 +11,6 @@ import (
 // BeginBlocker runs at the start of every block.
 func BeginBlocker(ctx sdk.Context, _ abci.RequestBeginBlock, k keeper.Keeper) {
 	k.ProcessProposals(ctx)
+	k.ProcessQueuedProposals(ctx)
+	k.DeleteExpiredVoteDelegations(ctx)
 }
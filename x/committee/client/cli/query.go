@@ -28,10 +28,13 @@ func GetQueryCmd() *cobra.Command {
 		// committees
 		getCmdQueryCommittee(),
 		getCmdQueryCommittees(),
+		getCmdQueryMembershipChanges(),
+		getCmdQueryVoteDelegations(),
 		// proposals
 		getCmdQueryNextProposalID(),
 		getCmdQueryProposal(),
 		getCmdQueryProposals(),
+		getCmdQueryQueuedProposals(),
 		// votes
 		getCmdQueryVotes(),
 		// other
@@ -104,6 +107,74 @@ func getCmdQueryCommittees() *cobra.Command {
 	}
 }
 
+// getCmdQueryMembershipChanges implements a query membership changes command.
+func getCmdQueryMembershipChanges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "membership-changes [committee-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the history of member and vote threshold changes of a committee",
+		Example: fmt.Sprintf("%s query %s membership-changes 1", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			committeeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("committee-id %s not a valid int", args[0])
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MembershipChanges(context.Background(), &types.QueryMembershipChangesRequest{
+				CommitteeId: committeeID,
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "membership changes")
+	return cmd
+}
+
+// getCmdQueryVoteDelegations implements a query vote delegations command.
+func getCmdQueryVoteDelegations() *cobra.Command {
+	return &cobra.Command{
+		Use:     "vote-delegations [committee-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the vote delegations of the members of a committee",
+		Example: fmt.Sprintf("%s query %s vote-delegations 1", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			committeeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("committee-id %s not a valid int", args[0])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.VoteDelegations(context.Background(), &types.QueryVoteDelegationsRequest{
+				CommitteeId: committeeID,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
 // ------------------------------------------
 //				Proposals
 // ------------------------------------------
@@ -193,6 +264,29 @@ func getCmdQueryProposals() *cobra.Command {
 	}
 }
 
+// getCmdQueryQueuedProposals implements a query queued proposals command.
+func getCmdQueryQueuedProposals() *cobra.Command {
+	return &cobra.Command{
+		Use:     "queued-proposals",
+		Short:   "Query all passed proposals waiting to be enacted",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query %s queued-proposals", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.QueuedProposals(context.Background(), &types.QueryQueuedProposalsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
 // ------------------------------------------
 //				Votes
 // ------------------------------------------
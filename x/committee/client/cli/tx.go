@@ -74,6 +74,8 @@ func GetTxCmd() *cobra.Command {
 	cmds := []*cobra.Command{
 		getCmdVote(),
 		getCmdSubmitProposal(),
+		getCmdDelegateVote(),
+		getCmdRevokeVoteDelegation(),
 	}
 
 	for _, cmd := range cmds {
@@ -196,6 +198,74 @@ func getCmdVote() *cobra.Command {
 	}
 }
 
+// getCmdDelegateVote returns the command to let another address vote in place of a committee member
+func getCmdDelegateVote() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delegate-vote [committee-id] [delegate] [duration]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Let another address vote in your place on a committee",
+		Long: fmt.Sprintf(`Let [delegate] vote in your place on the proposals of the committee with id [committee-id] for [duration].
+Your own votes take precedence over the votes of the delegate. The duration can be at most %s.`, types.MaxVoteDelegationDuration),
+		Example: fmt.Sprintf("%s tx %s delegate-vote 1 fury1ze7y9qwdddejmy7jlw4cymqqlt2wh05yhwmrv2 72h", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			committeeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("committee-id %s not a valid int", args[0])
+			}
+
+			delegate, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[2])
+			if err != nil {
+				return fmt.Errorf("duration %s not valid: %w", args[2], err)
+			}
+
+			msg := types.NewMsgDelegateVote(committeeID, clientCtx.GetFromAddress(), delegate, duration)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+// getCmdRevokeVoteDelegation returns the command to end the delegation of a committee member's vote
+func getCmdRevokeVoteDelegation() *cobra.Command {
+	return &cobra.Command{
+		Use:     "revoke-vote-delegation [committee-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "End the delegation of your vote on a committee",
+		Example: fmt.Sprintf("%s tx %s revoke-vote-delegation 1", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			committeeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("committee-id %s not a valid int", args[0])
+			}
+
+			msg := types.NewMsgRevokeVoteDelegation(committeeID, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
 // GetGovCmdSubmitProposal returns a command to submit a proposal to the gov module. It is passed to the gov module for use on its command subtree.
 func GetGovCmdSubmitProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -26,6 +26,15 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, gs *types.GenesisState)
 	for _, v := range gs.Votes {
 		keeper.SetVote(ctx, v)
 	}
+	for _, qp := range gs.QueuedProposals {
+		keeper.SetQueuedProposal(ctx, qp)
+	}
+	for _, mc := range gs.MembershipChanges {
+		keeper.SetMembershipChange(ctx, mc)
+	}
+	for _, vd := range gs.VoteDelegations {
+		keeper.SetVoteDelegation(ctx, vd)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -38,10 +47,14 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	proposals := keeper.GetProposals(ctx)
 	votes := keeper.GetVotes(ctx)
 
-	return types.NewGenesisState(
+	gs := types.NewGenesisState(
 		nextID,
 		committees,
 		proposals,
 		votes,
 	)
+	gs.QueuedProposals = keeper.GetQueuedProposals(ctx)
+	gs.MembershipChanges = keeper.GetMembershipChanges(ctx)
+	gs.VoteDelegations = keeper.GetVoteDelegations(ctx)
+	return gs
 }
@@ -3,7 +3,10 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/types/query"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/incubus-network/fury/x/committee/testutil"
@@ -48,6 +51,80 @@ func (suite *grpcQueryTestSuite) TestVote() {
 	suite.Require().Equal(vote.Voter.String(), queryRes.Votes[0].Voter)
 }
 
+func (suite *grpcQueryTestSuite) TestQueuedProposals() {
+	ctx, keeper, queryClient := suite.Ctx, suite.Keeper, suite.QueryClient
+	committee := types.MustNewMemberCommittee(1, "This committee is for testing.", suite.Addresses[:2], nil,
+		testutil.D("0.5"), time.Hour*24*7, types.TALLY_OPTION_FIRST_PAST_THE_POST)
+	committee.SetExecutionDelay(time.Hour)
+	committee.SetVetoCommitteeID(2)
+	keeper.SetCommittee(ctx, committee)
+
+	executionTime := ctx.BlockTime().Add(time.Hour)
+	proposal := types.MustNewProposal(govv1beta1.NewTextProposal("A Title", "A description of this proposal."), 1, 1, ctx.BlockTime())
+	keeper.SetQueuedProposal(ctx, types.NewQueuedProposal(proposal, executionTime))
+
+	res, err := queryClient.QueuedProposals(context.Background(), &types.QueryQueuedProposalsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.QueuedProposals, 1)
+	suite.Equal(proposal.ID, res.QueuedProposals[0].ID)
+	suite.Equal(proposal.CommitteeID, res.QueuedProposals[0].CommitteeID)
+	suite.Equal(proposal.Content.Value, res.QueuedProposals[0].PubProposal.Value)
+	suite.True(executionTime.Equal(res.QueuedProposals[0].ExecutionTime))
+	suite.Equal(uint64(2), res.QueuedProposals[0].VetoCommitteeID)
+}
+
 func TestGrpcQueryTestSuite(t *testing.T) {
 	suite.Run(t, new(grpcQueryTestSuite))
 }
+
+func (suite *grpcQueryTestSuite) TestMembershipChanges() {
+	ctx, keeper, queryClient := suite.Ctx, suite.Keeper, suite.QueryClient
+	blockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+	changes := []types.MembershipChange{
+		types.NewMembershipChange(1, 1, types.MEMBERSHIP_CHANGE_TYPE_ADD_MEMBER, suite.Addresses[2], testutil.D("0.5"), 10, blockTime),
+		types.NewMembershipChange(1, 2, types.MEMBERSHIP_CHANGE_TYPE_REMOVE_MEMBER, suite.Addresses[0], testutil.D("0.5"), 11, blockTime),
+		types.NewMembershipChange(1, 3, types.MEMBERSHIP_CHANGE_TYPE_CHANGE_VOTE_THRESHOLD, nil, testutil.D("0.75"), 12, blockTime),
+		types.NewMembershipChange(2, 1, types.MEMBERSHIP_CHANGE_TYPE_ADD_MEMBER, suite.Addresses[3], testutil.D("0.5"), 12, blockTime),
+	}
+	for _, change := range changes {
+		keeper.SetMembershipChange(ctx, change)
+	}
+
+	res, err := queryClient.MembershipChanges(context.Background(), &types.QueryMembershipChangesRequest{CommitteeId: 1})
+	suite.Require().NoError(err)
+	suite.Equal(changes[:3], res.MembershipChanges)
+	suite.Equal(uint64(4), keeper.GetNextMembershipChangeSequence(ctx, 1))
+	suite.Equal(uint64(2), keeper.GetNextMembershipChangeSequence(ctx, 2))
+
+	res, err = queryClient.MembershipChanges(context.Background(), &types.QueryMembershipChangesRequest{
+		CommitteeId: 1,
+		Pagination:  &query.PageRequest{Offset: 1, Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Equal(changes[1:2], res.MembershipChanges)
+
+	res, err = queryClient.MembershipChanges(context.Background(), &types.QueryMembershipChangesRequest{CommitteeId: 3})
+	suite.Require().NoError(err)
+	suite.Empty(res.MembershipChanges)
+}
+
+func (suite *grpcQueryTestSuite) TestVoteDelegations() {
+	ctx, keeper, queryClient := suite.Ctx, suite.Keeper, suite.QueryClient
+	expiration := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+	delegations := []types.VoteDelegation{
+		types.NewVoteDelegation(1, suite.Addresses[0], suite.Addresses[2], expiration),
+		types.NewVoteDelegation(1, suite.Addresses[1], suite.Addresses[2], expiration),
+		types.NewVoteDelegation(2, suite.Addresses[0], suite.Addresses[3], expiration),
+	}
+	for _, delegation := range delegations {
+		keeper.SetVoteDelegation(ctx, delegation)
+	}
+
+	res, err := queryClient.VoteDelegations(context.Background(), &types.QueryVoteDelegationsRequest{CommitteeId: 1})
+	suite.Require().NoError(err)
+	suite.ElementsMatch(delegations[:2], res.VoteDelegations)
+
+	res, err = queryClient.VoteDelegations(context.Background(), &types.QueryVoteDelegationsRequest{CommitteeId: 3})
+	suite.Require().NoError(err)
+	suite.Empty(res.VoteDelegations)
+}
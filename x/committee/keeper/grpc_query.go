@@ -171,6 +171,33 @@ func (s queryServer) Tally(c context.Context, req *types.QueryTallyRequest) (*ty
 	return tally, nil
 }
 
+// QueuedProposals implements the Query/QueuedProposals gRPC method
+func (s queryServer) QueuedProposals(c context.Context, req *types.QueryQueuedProposalsRequest) (*types.QueryQueuedProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var queuedProposalsResp []types.QueryQueuedProposalResponse
+	for _, queuedProposal := range s.keeper.GetQueuedProposals(ctx) {
+		var vetoCommitteeID uint64
+		if committee, found := s.keeper.GetCommittee(ctx, queuedProposal.Proposal.CommitteeID); found {
+			vetoCommitteeID = committee.GetVetoCommitteeID()
+		}
+		queuedProposalsResp = append(queuedProposalsResp, types.QueryQueuedProposalResponse{
+			PubProposal:     queuedProposal.Proposal.Content,
+			ID:              queuedProposal.Proposal.ID,
+			CommitteeID:     queuedProposal.Proposal.CommitteeID,
+			ExecutionTime:   queuedProposal.ExecutionTime,
+			VetoCommitteeID: vetoCommitteeID,
+		})
+	}
+
+	return &types.QueryQueuedProposalsResponse{
+		QueuedProposals: queuedProposalsResp,
+	}, nil
+}
+
 // RawParams implements the Query/RawParams gRPC method
 func (s queryServer) RawParams(c context.Context, req *types.QueryRawParamsRequest) (*types.QueryRawParamsResponse, error) {
 	if req == nil {
@@ -202,3 +229,46 @@ func (s queryServer) votesResponseFromVote(vote types.Vote) types.QueryVoteRespo
 		VoteType:   vote.VoteType,
 	}
 }
+
+// MembershipChanges implements the Query/MembershipChanges gRPC method.
+func (s queryServer) MembershipChanges(c context.Context, req *types.QueryMembershipChangesRequest) (*types.QueryMembershipChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var changes []types.MembershipChange
+	store := ctx.KVStore(s.keeper.storeKey)
+	changesStore := prefix.NewStore(store, append(types.MembershipChangeKeyPrefix, types.GetKeyFromID(req.CommitteeId)...))
+	pageRes, err := query.Paginate(changesStore, req.Pagination, func(key []byte, value []byte) error {
+		var change types.MembershipChange
+		if err := s.keeper.cdc.Unmarshal(value, &change); err != nil {
+			return err
+		}
+
+		changes = append(changes, change)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMembershipChangesResponse{
+		MembershipChanges: changes,
+		Pagination:        pageRes,
+	}, nil
+}
+
+// VoteDelegations implements the Query/VoteDelegations gRPC method.
+func (s queryServer) VoteDelegations(c context.Context, req *types.QueryVoteDelegationsRequest) (*types.QueryVoteDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryVoteDelegationsResponse{
+		VoteDelegations: s.keeper.GetVoteDelegationsByCommittee(ctx, req.CommitteeId),
+	}, nil
+}
@@ -21,12 +21,19 @@ type Keeper struct {
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
 
+	// Used to count the positions of token committee voters outside of x/bank
+	positions  types.HolderPositions
+	hardKeeper types.HardKeeper
+
 	// Proposal router
 	router govv1beta1.Router
+	// Msg router used to execute the messages of MsgsProposals
+	msgRouter types.MsgRouter
 }
 
-func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, router govv1beta1.Router,
-	paramKeeper types.ParamKeeper, ak types.AccountKeeper, sk types.BankKeeper,
+func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, router govv1beta1.Router, msgRouter types.MsgRouter,
+	paramKeeper types.ParamKeeper, ak types.AccountKeeper, sk types.BankKeeper, stk types.StakingKeeper,
+	lk types.LiquidKeeper, svk types.SavingsKeeper, ek types.EarnKeeper, hk types.HardKeeper,
 ) Keeper {
 	// Logic in the keeper methods assume the set of gov handlers is fixed.
 	// So the gov router must be sealed so no handlers can be added or removed after the keeper is created.
@@ -38,7 +45,10 @@ func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, router govv1beta1.
 		paramKeeper:   paramKeeper,
 		accountKeeper: ak,
 		bankKeeper:    sk,
+		positions:     types.NewHolderPositions(stk, lk, svk, ek),
+		hardKeeper:    hk,
 		router:        router,
+		msgRouter:     msgRouter,
 	}
 }
 
@@ -299,3 +309,175 @@ func (k Keeper) GetVotesByProposal(ctx sdk.Context, proposalID uint64) []types.V
 
 	return results
 }
+
+// ------------------------------------------
+//				Queued Proposals
+// ------------------------------------------
+
+// GetQueuedProposal gets a queued proposal from the store.
+func (k Keeper) GetQueuedProposal(ctx sdk.Context, proposalID uint64) (types.QueuedProposal, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedProposalKeyPrefix)
+	bz := store.Get(types.GetKeyFromID(proposalID))
+	if bz == nil {
+		return types.QueuedProposal{}, false
+	}
+	var queuedProposal types.QueuedProposal
+	k.cdc.MustUnmarshal(bz, &queuedProposal)
+	return queuedProposal, true
+}
+
+// SetQueuedProposal puts a queued proposal into the store.
+func (k Keeper) SetQueuedProposal(ctx sdk.Context, queuedProposal types.QueuedProposal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedProposalKeyPrefix)
+	bz := k.cdc.MustMarshal(&queuedProposal)
+	store.Set(types.GetKeyFromID(queuedProposal.Proposal.ID), bz)
+}
+
+// DeleteQueuedProposal removes a queued proposal from the store.
+func (k Keeper) DeleteQueuedProposal(ctx sdk.Context, proposalID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedProposalKeyPrefix)
+	store.Delete(types.GetKeyFromID(proposalID))
+}
+
+// IterateQueuedProposals provides an iterator over all stored queued proposals.
+// For each queued proposal, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateQueuedProposals(ctx sdk.Context, cb func(queuedProposal types.QueuedProposal) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.QueuedProposalKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var queuedProposal types.QueuedProposal
+		k.cdc.MustUnmarshal(iterator.Value(), &queuedProposal)
+		if cb(queuedProposal) {
+			break
+		}
+	}
+}
+
+// GetQueuedProposals returns all stored queued proposals.
+func (k Keeper) GetQueuedProposals(ctx sdk.Context) types.QueuedProposals {
+	results := types.QueuedProposals{}
+	k.IterateQueuedProposals(ctx, func(qp types.QueuedProposal) bool {
+		results = append(results, qp)
+		return false
+	})
+	return results
+}
+
+// ------------------------------------------
+//				Membership Changes
+// ------------------------------------------
+
+// SetMembershipChange puts a membership change into the store.
+func (k Keeper) SetMembershipChange(ctx sdk.Context, change types.MembershipChange) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MembershipChangeKeyPrefix)
+	bz := k.cdc.MustMarshal(&change)
+	store.Set(types.GetMembershipChangeKey(change.CommitteeID, change.Sequence), bz)
+}
+
+// GetNextMembershipChangeSequence returns the sequence number of the next membership change of a committee.
+func (k Keeper) GetNextMembershipChangeSequence(ctx sdk.Context, committeeID uint64) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.MembershipChangeKeyPrefix, types.GetKeyFromID(committeeID)...))
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return 1
+	}
+	var change types.MembershipChange
+	k.cdc.MustUnmarshal(iterator.Value(), &change)
+	return change.Sequence + 1
+}
+
+// IterateMembershipChanges provides an iterator over all stored membership changes, ordered by committee and sequence.
+// For each membership change, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateMembershipChanges(ctx sdk.Context, cb func(change types.MembershipChange) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.MembershipChangeKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.MembershipChange
+		k.cdc.MustUnmarshal(iterator.Value(), &change)
+		if cb(change) {
+			break
+		}
+	}
+}
+
+// GetMembershipChanges returns all stored membership changes.
+func (k Keeper) GetMembershipChanges(ctx sdk.Context) []types.MembershipChange {
+	results := []types.MembershipChange{}
+	k.IterateMembershipChanges(ctx, func(change types.MembershipChange) bool {
+		results = append(results, change)
+		return false
+	})
+	return results
+}
+
+// ------------------------------------------
+//				Vote Delegations
+// ------------------------------------------
+
+// GetVoteDelegation gets the vote delegation of a committee member from the store.
+func (k Keeper) GetVoteDelegation(ctx sdk.Context, committeeID uint64, delegator sdk.AccAddress) (types.VoteDelegation, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoteDelegationKeyPrefix)
+	bz := store.Get(types.GetVoteDelegationKey(committeeID, delegator))
+	if bz == nil {
+		return types.VoteDelegation{}, false
+	}
+	var delegation types.VoteDelegation
+	k.cdc.MustUnmarshal(bz, &delegation)
+	return delegation, true
+}
+
+// SetVoteDelegation puts a vote delegation into the store, replacing any prior delegation of the member.
+func (k Keeper) SetVoteDelegation(ctx sdk.Context, delegation types.VoteDelegation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoteDelegationKeyPrefix)
+	bz := k.cdc.MustMarshal(&delegation)
+	store.Set(types.GetVoteDelegationKey(delegation.CommitteeID, delegation.Delegator), bz)
+}
+
+// DeleteVoteDelegation removes the vote delegation of a committee member from the store.
+func (k Keeper) DeleteVoteDelegation(ctx sdk.Context, committeeID uint64, delegator sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VoteDelegationKeyPrefix)
+	store.Delete(types.GetVoteDelegationKey(committeeID, delegator))
+}
+
+// IterateVoteDelegations provides an iterator over all stored vote delegations.
+// For each vote delegation, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateVoteDelegations(ctx sdk.Context, cb func(delegation types.VoteDelegation) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.VoteDelegationKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.VoteDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		if cb(delegation) {
+			break
+		}
+	}
+}
+
+// GetVoteDelegations returns all stored vote delegations.
+func (k Keeper) GetVoteDelegations(ctx sdk.Context) []types.VoteDelegation {
+	results := []types.VoteDelegation{}
+	k.IterateVoteDelegations(ctx, func(delegation types.VoteDelegation) bool {
+		results = append(results, delegation)
+		return false
+	})
+	return results
+}
+
+// GetVoteDelegationsByCommittee returns all stored vote delegations of the members of a committee.
+func (k Keeper) GetVoteDelegationsByCommittee(ctx sdk.Context, committeeID uint64) []types.VoteDelegation {
+	results := []types.VoteDelegation{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.VoteDelegationKeyPrefix, types.GetKeyFromID(committeeID)...))
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.VoteDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		results = append(results, delegation)
+	}
+	return results
+}
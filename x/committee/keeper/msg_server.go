@@ -68,3 +68,55 @@ func (m msgServer) Vote(goCtx context.Context, msg *types.MsgVote) (*types.MsgVo
 
 	return &types.MsgVoteResponse{}, nil
 }
+
+// DelegateVote handles MsgDelegateVote messages
+func (m msgServer) DelegateVote(goCtx context.Context, msg *types.MsgDelegateVote) (*types.MsgDelegateVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+	delegate, err := sdk.AccAddressFromBech32(msg.Delegate)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.DelegateVote(ctx, msg.CommitteeID, delegator, delegate, msg.Duration); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Delegator),
+		),
+	)
+
+	return &types.MsgDelegateVoteResponse{}, nil
+}
+
+// RevokeVoteDelegation handles MsgRevokeVoteDelegation messages
+func (m msgServer) RevokeVoteDelegation(goCtx context.Context, msg *types.MsgRevokeVoteDelegation) (*types.MsgRevokeVoteDelegationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.RevokeVoteDelegation(ctx, msg.CommitteeID, delegator); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Delegator),
+		),
+	)
+
+	return &types.MsgRevokeVoteDelegationResponse{}, nil
+}
@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -22,7 +24,7 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, proposer sdk.AccAddress, committ
 	}
 
 	// Check committee has permissions to enact proposal.
-	if !com.HasPermissionsFor(ctx, k.cdc, k.paramKeeper, pubProposal) {
+	if !k.hasPermissionsFor(ctx, com, pubProposal) {
 		return 0, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "committee does not have permissions to enact proposal")
 	}
 
@@ -64,13 +66,18 @@ func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress
 		return errorsmod.Wrapf(types.ErrUnknownCommittee, "%d", pr.CommitteeID)
 	}
 
-	if _, ok := com.(*types.MemberCommittee); ok {
-		if !com.HasMember(voter) {
-			return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "voter must be a member of committee")
+	switch com.(type) {
+	case *types.MemberCommittee:
+		if !com.HasMember(voter) && !k.isActiveDelegate(ctx, com, voter) {
+			return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "voter must be a member of committee or a delegate of a member")
 		}
 		if voteType != types.VOTE_TYPE_YES {
 			return errorsmod.Wrap(types.ErrInvalidVoteType, "member committees only accept yes votes")
 		}
+	case *types.WeightedMemberCommittee:
+		if !com.HasMember(voter) && !k.isActiveDelegate(ctx, com, voter) {
+			return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "voter must be a member of committee or a delegate of a member")
+		}
 	}
 
 	// Store vote, overwriting any prior vote
@@ -88,6 +95,103 @@ func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress
 	return nil
 }
 
+// DelegateVote lets another address vote in place of a member of a member committee until a duration has passed.
+// A vote of the member themselves takes precedence over the vote of their delegate. Any prior delegation is replaced.
+func (k Keeper) DelegateVote(ctx sdk.Context, committeeID uint64, delegator, delegate sdk.AccAddress, duration time.Duration) error {
+	com, found := k.GetCommittee(ctx, committeeID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUnknownCommittee, "%d", committeeID)
+	}
+	if !acceptsDelegatedVotes(com) {
+		return errorsmod.Wrapf(types.ErrInvalidVoteDelegation, "votes of %s committees cannot be delegated", com.GetType())
+	}
+	if !com.HasMember(delegator) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "delegator must be a member of committee")
+	}
+	if delegator.Equals(delegate) {
+		return errorsmod.Wrap(types.ErrInvalidVoteDelegation, "cannot delegate vote to self")
+	}
+	if duration <= 0 || duration > types.MaxVoteDelegationDuration {
+		return errorsmod.Wrapf(types.ErrInvalidVoteDelegation, "duration must be positive and at most %s", types.MaxVoteDelegationDuration)
+	}
+
+	delegation := types.NewVoteDelegation(committeeID, delegator, delegate, ctx.BlockTime().Add(duration))
+	k.SetVoteDelegation(ctx, delegation)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVoteDelegate,
+			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", committeeID)),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegate, delegate.String()),
+			sdk.NewAttribute(types.AttributeKeyExpiration, delegation.Expiration.String()),
+		),
+	)
+	return nil
+}
+
+// RevokeVoteDelegation ends the vote delegation of a committee member.
+func (k Keeper) RevokeVoteDelegation(ctx sdk.Context, committeeID uint64, delegator sdk.AccAddress) error {
+	delegation, found := k.GetVoteDelegation(ctx, committeeID, delegator)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUnknownVoteDelegation, "committee %d, delegator %s", committeeID, delegator)
+	}
+	k.endVoteDelegation(ctx, delegation)
+	return nil
+}
+
+// DeleteExpiredVoteDelegations removes all vote delegations that have expired by the block time.
+func (k Keeper) DeleteExpiredVoteDelegations(ctx sdk.Context) {
+	for _, delegation := range k.GetVoteDelegations(ctx) {
+		if !delegation.IsActiveAt(ctx.BlockTime()) {
+			k.endVoteDelegation(ctx, delegation)
+		}
+	}
+}
+
+// PruneVoteDelegations removes the vote delegations of a committee that no longer apply, because the delegator is not
+// a member or the committee no longer accepts delegated votes.
+func (k Keeper) PruneVoteDelegations(ctx sdk.Context, committeeID uint64) {
+	com, found := k.GetCommittee(ctx, committeeID)
+	for _, delegation := range k.GetVoteDelegationsByCommittee(ctx, committeeID) {
+		if !found || !acceptsDelegatedVotes(com) || !com.HasMember(delegation.Delegator) {
+			k.endVoteDelegation(ctx, delegation)
+		}
+	}
+}
+
+func (k Keeper) endVoteDelegation(ctx sdk.Context, delegation types.VoteDelegation) {
+	k.DeleteVoteDelegation(ctx, delegation.CommitteeID, delegation.Delegator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVoteDelegationEnd,
+			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", delegation.CommitteeID)),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegation.Delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegate, delegation.Delegate.String()),
+		),
+	)
+}
+
+// isActiveDelegate returns whether an address holds an unexpired vote delegation from a member of a committee.
+func (k Keeper) isActiveDelegate(ctx sdk.Context, com types.Committee, addr sdk.AccAddress) bool {
+	for _, delegation := range k.GetVoteDelegationsByCommittee(ctx, com.GetID()) {
+		if delegation.Delegate.Equals(addr) && delegation.IsActiveAt(ctx.BlockTime()) && com.HasMember(delegation.Delegator) {
+			return true
+		}
+	}
+	return false
+}
+
+func acceptsDelegatedVotes(com types.Committee) bool {
+	switch com.(type) {
+	case *types.MemberCommittee, *types.WeightedMemberCommittee:
+		return true
+	default:
+		return false
+	}
+}
+
 // ValidatePubProposal checks if a pubproposal is valid.
 func (k Keeper) ValidatePubProposal(ctx sdk.Context, pubProposal types.PubProposal) (returnErr error) {
 	if pubProposal == nil {
@@ -97,13 +201,12 @@ func (k Keeper) ValidatePubProposal(ctx sdk.Context, pubProposal types.PubPropos
 		return err
 	}
 
-	if !k.router.HasRoute(pubProposal.ProposalRoute()) {
+	if !k.hasHandler(pubProposal) {
 		return errorsmod.Wrapf(types.ErrNoProposalHandlerExists, "%T", pubProposal)
 	}
 
 	// Run the proposal's changes through the associated handler using a cached version of state to ensure changes are not permanent.
 	cacheCtx, _ := ctx.CacheContext()
-	handler := k.router.GetRoute(pubProposal.ProposalRoute())
 
 	// Handle an edge case where a param change proposal causes the proposal handler to panic.
 	// A param change proposal with a registered subspace value but unregistered key value will cause a panic in the param change proposal handler.
@@ -115,9 +218,132 @@ func (k Keeper) ValidatePubProposal(ctx sdk.Context, pubProposal types.PubPropos
 		}
 	}()
 
-	if err := handler(cacheCtx, pubProposal); err != nil {
+	return k.handlePubProposal(cacheCtx, pubProposal)
+}
+
+// hasHandler returns whether a pubproposal can be enacted. Msgs, veto and membership change proposals are handled by the
+// committee keeper, other proposals need a route to a gov handler.
+func (k Keeper) hasHandler(pubProposal types.PubProposal) bool {
+	switch pubProposal.(type) {
+	case *types.MsgsProposal, *types.VetoProposal, types.MembershipChangeProposal:
+		return true
+	default:
+		return k.router.HasRoute(pubProposal.ProposalRoute())
+	}
+}
+
+// handlePubProposal makes the changes of a pubproposal.
+func (k Keeper) handlePubProposal(ctx sdk.Context, pubProposal types.PubProposal) error {
+	switch p := pubProposal.(type) {
+	case *types.MsgsProposal:
+		return k.executeMsgs(ctx, p)
+	case *types.VetoProposal:
+		return k.VetoQueuedProposal(ctx, p.ProposalID)
+	case types.MembershipChangeProposal:
+		return k.ChangeCommitteeMembership(ctx, p)
+	default:
+		handler := k.router.GetRoute(pubProposal.ProposalRoute())
+		return handler(ctx, pubProposal)
+	}
+}
+
+// hasPermissionsFor returns whether a committee is authorized to enact a proposal. A veto committee does not need a
+// permission to veto the queued proposals of the committees it is the veto committee of. Committees can only change
+// their own membership.
+func (k Keeper) hasPermissionsFor(ctx sdk.Context, com types.Committee, pubProposal types.PubProposal) bool {
+	if membershipChange, ok := pubProposal.(types.MembershipChangeProposal); ok && membershipChange.GetCommitteeID() != com.GetID() {
+		return false
+	}
+	if veto, ok := pubProposal.(*types.VetoProposal); ok {
+		if queuedProposal, found := k.GetQueuedProposal(ctx, veto.ProposalID); found {
+			vetoed, found := k.GetCommittee(ctx, queuedProposal.Proposal.CommitteeID)
+			if found && vetoed.GetVetoCommitteeID() != 0 && vetoed.GetVetoCommitteeID() == com.GetID() {
+				return true
+			}
+		}
+	}
+	return com.HasPermissionsFor(ctx, k.cdc, k.paramKeeper, pubProposal)
+}
+
+// ChangeCommitteeMembership applies a membership change proposal to its committee and records the change.
+// The changed committee must stay within the limits of one of its membership permissions, unless it has a god permission.
+// Votes of a removed member on the committee's open proposals are deleted.
+func (k Keeper) ChangeCommitteeMembership(ctx sdk.Context, proposal types.MembershipChangeProposal) error {
+	com, found := k.GetCommittee(ctx, proposal.GetCommitteeID())
+	if !found {
+		return errorsmod.Wrapf(types.ErrUnknownCommittee, "%d", proposal.GetCommitteeID())
+	}
+	if err := proposal.ApplyTo(com); err != nil {
 		return err
 	}
+	if err := com.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidCommittee, err.Error())
+	}
+	if !allowsMembershipChange(ctx, com, proposal) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "membership change exceeds the limits of committee %d", com.GetID())
+	}
+	k.SetCommittee(ctx, com)
+
+	if proposal.GetMembershipChangeType() == types.MEMBERSHIP_CHANGE_TYPE_REMOVE_MEMBER {
+		for _, p := range k.GetProposalsByCommittee(ctx, com.GetID()) {
+			k.DeleteVote(ctx, p.ID, proposal.GetMember())
+		}
+		k.PruneVoteDelegations(ctx, com.GetID())
+	}
+
+	change := types.NewMembershipChange(
+		com.GetID(),
+		k.GetNextMembershipChangeSequence(ctx, com.GetID()),
+		proposal.GetMembershipChangeType(),
+		proposal.GetMember(),
+		com.GetVoteThreshold(),
+		ctx.BlockHeight(),
+		ctx.BlockTime(),
+	)
+	k.SetMembershipChange(ctx, change)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMembershipChange,
+			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", change.CommitteeID)),
+			sdk.NewAttribute(types.AttributeKeyMembershipChange, change.Type.String()),
+			sdk.NewAttribute(types.AttributeKeyMember, change.Member.String()),
+			sdk.NewAttribute(types.AttributeKeyVoteThreshold, change.VoteThreshold.String()),
+		),
+	)
+	return nil
+}
+
+// allowsMembershipChange returns whether a committee, after a membership change, is within the limits of its permissions.
+func allowsMembershipChange(ctx sdk.Context, com types.Committee, proposal types.MembershipChangeProposal) bool {
+	for _, perm := range com.GetPermissions() {
+		switch p := perm.(type) {
+		case *types.GodPermission:
+			return true
+		case *types.CommitteeMembershipPermission:
+			if p.Allows(ctx, nil, proposal) && p.AllowsCommittee(com) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// executeMsgs runs the messages of a msgs proposal through their msg service handlers, stopping at the first error.
+func (k Keeper) executeMsgs(ctx sdk.Context, proposal *types.MsgsProposal) error {
+	msgs, err := proposal.GetMsgs()
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidPubProposal, err.Error())
+	}
+	for i, msg := range msgs {
+		handler := k.msgRouter.Handler(msg)
+		if handler == nil {
+			return errorsmod.Wrapf(types.ErrNoProposalHandlerExists, "msg %d: %s", i, sdk.MsgTypeURL(msg))
+		}
+		if _, err := handler(ctx, msg); err != nil {
+			return errorsmod.Wrapf(err, "msg %d", i)
+		}
+	}
 	return nil
 }
 
@@ -133,7 +359,7 @@ func (k Keeper) ProcessProposals(ctx sdk.Context) {
 			if committee.GetTallyOption() == types.TALLY_OPTION_FIRST_PAST_THE_POST {
 				passed := k.GetProposalResult(ctx, proposal.ID, committee)
 				if passed {
-					outcome := k.attemptEnactProposal(ctx, proposal)
+					outcome := k.attemptEnactOrQueueProposal(ctx, proposal, committee)
 					k.CloseProposal(ctx, proposal, outcome)
 				}
 			}
@@ -141,7 +367,7 @@ func (k Keeper) ProcessProposals(ctx sdk.Context) {
 			passed := k.GetProposalResult(ctx, proposal.ID, committee)
 			outcome := types.Failed
 			if passed {
-				outcome = k.attemptEnactProposal(ctx, proposal)
+				outcome = k.attemptEnactOrQueueProposal(ctx, proposal, committee)
 			}
 			k.CloseProposal(ctx, proposal, outcome)
 		}
@@ -151,7 +377,7 @@ func (k Keeper) ProcessProposals(ctx sdk.Context) {
 
 func (k Keeper) GetProposalResult(ctx sdk.Context, proposalID uint64, committee types.Committee) bool {
 	switch com := committee.(type) {
-	case *types.MemberCommittee:
+	case *types.MemberCommittee, *types.WeightedMemberCommittee:
 		return k.GetMemberCommitteeProposalResult(ctx, proposalID, com)
 	case *types.TokenCommittee:
 		return k.GetTokenCommitteeProposalResult(ctx, proposalID, com)
@@ -162,20 +388,57 @@ func (k Keeper) GetProposalResult(ctx sdk.Context, proposalID uint64, committee
 
 // GetMemberCommitteeProposalResult gets the result of a member committee proposal
 func (k Keeper) GetMemberCommitteeProposalResult(ctx sdk.Context, proposalID uint64, committee types.Committee) bool {
-	currVotes := k.TallyMemberCommitteeVotes(ctx, proposalID)
-	possibleVotes := sdk.NewDec(int64(len(committee.GetMembers())))
-	return currVotes.GTE(committee.GetVoteThreshold().Mul(possibleVotes)) // vote threshold requirements
+	yesVotes, _, _, possibleVotes := k.TallyMemberCommitteeVotes(ctx, proposalID, committee)
+	return yesVotes.GTE(committee.GetVoteThreshold().Mul(possibleVotes)) // vote threshold requirements
 }
 
-// TallyMemberCommitteeVotes returns the polling status of a member committee vote
-func (k Keeper) TallyMemberCommitteeVotes(ctx sdk.Context, proposalID uint64) (totalVotes sdk.Dec) {
-	votes := k.GetVotesByProposal(ctx, proposalID)
-	return sdk.NewDec(int64(len(votes)))
+// TallyMemberCommitteeVotes returns the polling status of a member committee vote. Members of a weighted member committee
+// count with their weight, members of other committees with a weight of one. The vote of a member's delegate is counted
+// in place of the member when the member has not voted. Returns yes, no and abstain votes, and total possible votes (equal
+// to the sum of the weights of all members).
+func (k Keeper) TallyMemberCommitteeVotes(ctx sdk.Context, proposalID uint64,
+	committee types.Committee,
+) (yesVotes, noVotes, abstainVotes, possibleVotes sdk.Dec) {
+	votes := make(map[string]types.VoteType)
+	for _, vote := range k.GetVotesByProposal(ctx, proposalID) {
+		votes[vote.Voter.String()] = vote.VoteType
+	}
+
+	yesVotes = sdk.ZeroDec()
+	noVotes = sdk.ZeroDec()
+	abstainVotes = sdk.ZeroDec()
+	possibleVotes = sdk.ZeroDec()
+	for _, member := range committee.GetMembers() {
+		weight := sdk.OneDec()
+		if weighted, ok := committee.(*types.WeightedMemberCommittee); ok {
+			weight = weighted.GetMemberWeight(member)
+		}
+		possibleVotes = possibleVotes.Add(weight)
+
+		voteType, found := votes[member.String()]
+		if !found {
+			delegation, found := k.GetVoteDelegation(ctx, committee.GetID(), member)
+			if !found || !delegation.IsActiveAt(ctx.BlockTime()) {
+				continue
+			}
+			voteType = votes[delegation.Delegate.String()]
+		}
+
+		switch voteType {
+		case types.VOTE_TYPE_YES:
+			yesVotes = yesVotes.Add(weight)
+		case types.VOTE_TYPE_NO:
+			noVotes = noVotes.Add(weight)
+		case types.VOTE_TYPE_ABSTAIN:
+			abstainVotes = abstainVotes.Add(weight)
+		}
+	}
+	return yesVotes, noVotes, abstainVotes, possibleVotes
 }
 
 // GetTokenCommitteeProposalResult gets the result of a token committee proposal
 func (k Keeper) GetTokenCommitteeProposalResult(ctx sdk.Context, proposalID uint64, committee *types.TokenCommittee) bool {
-	yesVotes, noVotes, totalVotes, possibleVotes := k.TallyTokenCommitteeVotes(ctx, proposalID, committee.TallyDenom)
+	yesVotes, noVotes, totalVotes, possibleVotes := k.TallyTokenCommitteeVotes(ctx, proposalID, committee)
 	if totalVotes.GTE(committee.Quorum.Mul(possibleVotes)) { // quorum requirement
 		nonAbstainVotes := yesVotes.Add(noVotes)
 		if yesVotes.GTE(nonAbstainVotes.Mul(committee.VoteThreshold)) { // vote threshold requirements
@@ -185,11 +448,11 @@ func (k Keeper) GetTokenCommitteeProposalResult(ctx sdk.Context, proposalID uint
 	return false
 }
 
-// TallyMemberCommitteeVotes returns the polling status of a token committee vote. Returns yes votes,
-// total current votes, total possible votes (equal to token supply), vote threshold (yes vote ratio
-// required for proposal to pass), and quorum (votes tallied at this percentage).
+// TallyTokenCommitteeVotes returns the polling status of a token committee vote. Returns yes votes,
+// no votes, total current votes, and total possible votes (equal to token supply). Each voter's
+// votes include their positions in the committee's tally sources.
 func (k Keeper) TallyTokenCommitteeVotes(ctx sdk.Context, proposalID uint64,
-	tallyDenom string,
+	committee *types.TokenCommittee,
 ) (yesVotes, noVotes, totalVotes, possibleVotes sdk.Dec) {
 	votes := k.GetVotesByProposal(ctx, proposalID)
 
@@ -199,7 +462,7 @@ func (k Keeper) TallyTokenCommitteeVotes(ctx sdk.Context, proposalID uint64,
 	for _, vote := range votes {
 		// 1 token = 1 vote
 		acc := k.accountKeeper.GetAccount(ctx, vote.Voter)
-		accNumCoins := k.bankKeeper.GetBalance(ctx, acc.GetAddress(), tallyDenom).Amount
+		accNumCoins := k.GetTokenCommitteeVotingBalance(ctx, acc.GetAddress(), committee)
 
 		// Add votes to counters
 		totalVotes = totalVotes.Add(sdk.NewDecFromInt(accNumCoins))
@@ -210,10 +473,51 @@ func (k Keeper) TallyTokenCommitteeVotes(ctx sdk.Context, proposalID uint64,
 		}
 	}
 
-	possibleVotesInt := k.bankKeeper.GetSupply(ctx, tallyDenom).Amount
+	// Positions in the tally sources are backed by tokens held by module accounts, so they are already part of the supply.
+	possibleVotesInt := k.bankKeeper.GetSupply(ctx, committee.TallyDenom).Amount
 	return yesVotes, noVotes, totalVotes, sdk.NewDecFromInt(possibleVotesInt)
 }
 
+// GetTokenCommitteeVotingBalance returns the number of votes an address has in a token committee: its bank balance of the
+// tally denom plus its positions in each of the committee's tally sources.
+func (k Keeper) GetTokenCommitteeVotingBalance(ctx sdk.Context, addr sdk.AccAddress, committee *types.TokenCommittee) sdkmath.Int {
+	balance := k.bankKeeper.GetBalance(ctx, addr, committee.TallyDenom).Amount
+
+	for _, source := range committee.TallySources {
+		switch source {
+		case types.TALLY_SOURCE_STAKED:
+			balance = balance.Add(k.positions.StakedBalance(ctx, addr))
+		case types.TALLY_SOURCE_LIQUID:
+			balance = balance.Add(k.positions.DerivativesValue(ctx, k.bankKeeper.GetAllBalances(ctx, addr)))
+		case types.TALLY_SOURCE_SAVINGS:
+			balance = balance.Add(k.positions.Value(ctx, committee.TallyDenom, k.positions.SavingsDeposits(ctx, addr)))
+		case types.TALLY_SOURCE_EARN:
+			balance = balance.Add(k.positions.Value(ctx, committee.TallyDenom, k.positions.EarnDeposits(ctx, addr)))
+		case types.TALLY_SOURCE_HARD:
+			balance = balance.Add(k.getHardDepositValue(ctx, committee.TallyDenom, addr))
+		}
+	}
+
+	// borrowed hard tokens are held by the borrower, so the balance can be reduced below zero by the hard source
+	if balance.IsNegative() {
+		return sdk.ZeroInt()
+	}
+	return balance
+}
+
+// getHardDepositValue returns the value in the tally denom of an address's x/hard deposits minus its borrows. It is
+// negative when more is borrowed than deposited, as the borrowed tokens are counted wherever the borrower holds them.
+func (k Keeper) getHardDepositValue(ctx sdk.Context, tallyDenom string, addr sdk.AccAddress) sdkmath.Int {
+	value := sdk.ZeroInt()
+	if deposit, found := k.hardKeeper.GetSyncedDeposit(ctx, addr); found {
+		value = value.Add(k.positions.Value(ctx, tallyDenom, deposit.Amount))
+	}
+	if borrow, found := k.hardKeeper.GetSyncedBorrow(ctx, addr); found {
+		value = value.Sub(k.positions.Value(ctx, tallyDenom, borrow.Amount))
+	}
+	return value
+}
+
 func (k Keeper) attemptEnactProposal(ctx sdk.Context, proposal types.Proposal) types.ProposalOutcome {
 	err := k.enactProposal(ctx, proposal)
 	if err != nil {
@@ -222,31 +526,101 @@ func (k Keeper) attemptEnactProposal(ctx sdk.Context, proposal types.Proposal) t
 	return types.Passed
 }
 
+// attemptEnactOrQueueProposal enacts a passed proposal, or queues it if its committee has an execution delay.
+// Veto proposals are never queued so they can take effect before the proposal they veto is enacted.
+func (k Keeper) attemptEnactOrQueueProposal(ctx sdk.Context, proposal types.Proposal, committee types.Committee) types.ProposalOutcome {
+	_, isVeto := proposal.GetContent().(*types.VetoProposal)
+	if committee.GetExecutionDelay() <= 0 || isVeto {
+		return k.attemptEnactProposal(ctx, proposal)
+	}
+
+	// Proposals that cannot be enacted now are not queued.
+	if err := k.validateEnactment(ctx, proposal); err != nil {
+		return types.Invalid
+	}
+	k.QueueProposal(ctx, proposal, ctx.BlockTime().Add(committee.GetExecutionDelay()))
+	return types.Queued
+}
+
 // enactProposal makes the changes proposed in a proposal.
 func (k Keeper) enactProposal(ctx sdk.Context, proposal types.Proposal) error {
+	if err := k.validateEnactment(ctx, proposal); err != nil {
+		return err
+	}
+
+	// enact the proposal
+	if err := k.handlePubProposal(ctx, proposal.GetContent()); err != nil {
+		// the handler should not error as it was checked in ValidatePubProposal
+		panic(fmt.Sprintf("unexpected handler error: %s", err))
+	}
+	return nil
+}
+
+// validateEnactment checks a proposal can be enacted by its committee.
+func (k Keeper) validateEnactment(ctx sdk.Context, proposal types.Proposal) error {
 	// Check committee still has permissions for the proposal
 	// Since the proposal was submitted params could have changed, invalidating the permission of the committee.
 	com, found := k.GetCommittee(ctx, proposal.CommitteeID)
 	if !found {
 		return errorsmod.Wrapf(types.ErrUnknownCommittee, "%d", proposal.CommitteeID)
 	}
-	if !com.HasPermissionsFor(ctx, k.cdc, k.paramKeeper, proposal.GetContent()) {
+	if !k.hasPermissionsFor(ctx, com, proposal.GetContent()) {
 		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "committee does not have permissions to enact proposal")
 	}
 
-	if err := k.ValidatePubProposal(ctx, proposal.GetContent()); err != nil {
-		return err
+	return k.ValidatePubProposal(ctx, proposal.GetContent())
+}
+
+// QueueProposal stores a passed proposal until the end of its committee's execution delay. An event announces the
+// execution time so the changes of the proposal are known before they are made.
+func (k Keeper) QueueProposal(ctx sdk.Context, proposal types.Proposal, executionTime time.Time) {
+	k.SetQueuedProposal(ctx, types.NewQueuedProposal(proposal, executionTime))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalQueue,
+			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", proposal.CommitteeID)),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ID)),
+			sdk.NewAttribute(types.AttributeKeyExecutionTime, executionTime.String()),
+		),
+	)
+}
+
+// ProcessQueuedProposals enacts the queued proposals whose execution delay has ended.
+func (k Keeper) ProcessQueuedProposals(ctx sdk.Context) {
+	for _, queuedProposal := range k.GetQueuedProposals(ctx) {
+		if !queuedProposal.IsExecutableBy(ctx.BlockTime()) {
+			continue
+		}
+		outcome := k.attemptEnactProposal(ctx, queuedProposal.Proposal)
+		k.closeQueuedProposal(ctx, queuedProposal, outcome)
 	}
+}
 
-	// enact the proposal
-	handler := k.router.GetRoute(proposal.GetContent().ProposalRoute())
-	if err := handler(ctx, proposal.GetContent()); err != nil {
-		// the handler should not error as it was checked in ValidatePubProposal
-		panic(fmt.Sprintf("unexpected handler error: %s", err))
+// VetoQueuedProposal cancels a queued proposal so it is never enacted.
+func (k Keeper) VetoQueuedProposal(ctx sdk.Context, proposalID uint64) error {
+	queuedProposal, found := k.GetQueuedProposal(ctx, proposalID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUnknownProposal, "queued proposal %d", proposalID)
 	}
+	k.closeQueuedProposal(ctx, queuedProposal, types.Vetoed)
 	return nil
 }
 
+// closeQueuedProposal deletes a queued proposal, emitting an event denoting its final status.
+func (k Keeper) closeQueuedProposal(ctx sdk.Context, queuedProposal types.QueuedProposal, outcome types.ProposalOutcome) {
+	k.DeleteQueuedProposal(ctx, queuedProposal.Proposal.ID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQueuedProposalClose,
+			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", queuedProposal.Proposal.CommitteeID)),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", queuedProposal.Proposal.ID)),
+			sdk.NewAttribute(types.AttributeKeyProposalOutcome, outcome.String()),
+		),
+	)
+}
+
 // GetProposalTallyResponse returns the tally results of a proposal.
 func (k Keeper) GetProposalTallyResponse(ctx sdk.Context, proposalID uint64) (*types.QueryTallyResponse, bool) {
 	proposal, found := k.GetProposal(ctx, proposalID)
@@ -259,24 +633,25 @@ func (k Keeper) GetProposalTallyResponse(ctx sdk.Context, proposalID uint64) (*t
 	}
 	var proposalTally types.QueryTallyResponse
 	switch com := committee.(type) {
-	case *types.MemberCommittee:
-		currVotes := k.TallyMemberCommitteeVotes(ctx, proposal.ID)
-		possibleVotes := sdk.NewDec(int64(len(com.Members)))
+	case *types.MemberCommittee, *types.WeightedMemberCommittee:
+		yesVotes, noVotes, abstainVotes, possibleVotes := k.TallyMemberCommitteeVotes(ctx, proposal.ID, com)
 		proposalTally = types.QueryTallyResponse{
 			ProposalID:    proposal.ID,
-			YesVotes:      currVotes,
-			NoVotes:       sdk.ZeroDec(),
-			CurrentVotes:  currVotes,
+			YesVotes:      yesVotes,
+			NoVotes:       noVotes,
+			AbstainVotes:  abstainVotes,
+			CurrentVotes:  yesVotes.Add(noVotes).Add(abstainVotes),
 			PossibleVotes: possibleVotes,
-			VoteThreshold: com.VoteThreshold,
+			VoteThreshold: com.GetVoteThreshold(),
 			Quorum:        sdk.ZeroDec(),
 		}
 	case *types.TokenCommittee:
-		yesVotes, noVotes, currVotes, possibleVotes := k.TallyTokenCommitteeVotes(ctx, proposal.ID, com.TallyDenom)
+		yesVotes, noVotes, currVotes, possibleVotes := k.TallyTokenCommitteeVotes(ctx, proposal.ID, com)
 		proposalTally = types.QueryTallyResponse{
 			ProposalID:    proposal.ID,
 			YesVotes:      yesVotes,
 			NoVotes:       noVotes,
+			AbstainVotes:  currVotes.Sub(yesVotes).Sub(noVotes),
 			CurrentVotes:  currVotes,
 			PossibleVotes: possibleVotes,
 			VoteThreshold: com.VoteThreshold,
@@ -4,18 +4,29 @@ import (
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/incubus-network/fury/app"
 	// bep3types "github.com/incubus-network/fury/x/bep3/types"
 	// cdptypes "github.com/incubus-network/fury/x/cdp/types"
 
 	"github.com/incubus-network/fury/x/committee/testutil"
 	"github.com/incubus-network/fury/x/committee/types"
+	earntypes "github.com/incubus-network/fury/x/earn/types"
+	hardtypes "github.com/incubus-network/fury/x/hard/types"
+	liquidtypes "github.com/incubus-network/fury/x/liquid/types"
+	pricefeedtypes "github.com/incubus-network/fury/x/pricefeed/types"
 	// "github.com/incubus-network/fury/x/pricefeed"
 )
 
@@ -426,8 +437,9 @@ func (suite *keeperTestSuite) TestTallyMemberCommitteeVotes() {
 		)
 
 		// Check that all votes are counted
-		currentVotes := keeper.TallyMemberCommitteeVotes(ctx, defaultProposalID)
+		currentVotes, _, _, possibleVotes := keeper.TallyMemberCommitteeVotes(ctx, defaultProposalID, memberCom)
 		suite.Equal(tc.expectedVoteCount, currentVotes)
+		suite.Equal(testutil.D("5"), possibleVotes)
 	}
 }
 
@@ -565,7 +577,7 @@ func (suite *keeperTestSuite) TestTallyTokenCommitteeVotes() {
 			app.NewFundedGenStateWithCoins(tApp.AppCodec(), genCoins, genAddrs),
 		)
 
-		yesVotes, noVotes, currVotes, possibleVotes := keeper.TallyTokenCommitteeVotes(ctx, defaultProposalID, tokenCom.TallyDenom)
+		yesVotes, noVotes, currVotes, possibleVotes := keeper.TallyTokenCommitteeVotes(ctx, defaultProposalID, tokenCom)
 
 		// Check that all Yes votes are counted according to their weight
 		suite.Equal(tc.expectedYesVoteCount, yesVotes)
@@ -578,6 +590,203 @@ func (suite *keeperTestSuite) TestTallyTokenCommitteeVotes() {
 	}
 }
 
+func (suite *keeperTestSuite) TestTallyTokenCommitteeVotes_TallySources() {
+	var defaultProposalID uint64 = 1
+	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+	voter := suite.Addresses[0]
+	valAddr := sdk.ValAddress(suite.Addresses[1])
+
+	// The voter's account balance of 400e6 is always counted, in addition to their 300e6 staked.
+	testcases := []struct {
+		name                 string
+		tallySources         []types.TallySource
+		expectedYesVoteCount sdk.Dec
+	}{
+		{
+			name:                 "counts staked tokens",
+			tallySources:         []types.TallySource{types.TALLY_SOURCE_STAKED},
+			expectedYesVoteCount: testutil.D("700000000"),
+		},
+		{
+			name:                 "counts derivatives held in the account",
+			tallySources:         []types.TallySource{types.TALLY_SOURCE_STAKED, types.TALLY_SOURCE_LIQUID},
+			expectedYesVoteCount: testutil.D("900000000"),
+		},
+		{
+			name:                 "counts derivatives deposited in earn",
+			tallySources:         []types.TallySource{types.TALLY_SOURCE_STAKED, types.TALLY_SOURCE_LIQUID, types.TALLY_SOURCE_EARN},
+			expectedYesVoteCount: testutil.D("1000000000"),
+		},
+		{
+			name:                 "does not count sources without positions",
+			tallySources:         []types.TallySource{types.TALLY_SOURCE_STAKED, types.TALLY_SOURCE_SAVINGS, types.TALLY_SOURCE_HARD},
+			expectedYesVoteCount: testutil.D("700000000"),
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			tokenCom := types.MustNewTokenCommittee(
+				12,
+				"This committee is for testing.",
+				suite.Addresses[:5],
+				[]types.Permission{&types.GodPermission{}},
+				testutil.D("0.667"),
+				time.Hour*24*7,
+				types.TALLY_OPTION_DEADLINE,
+				testutil.D("0.4"),
+				types.BondDenom,
+			)
+			tokenCom.TallySources = tc.tallySources
+
+			tApp := app.NewTestApp()
+			keeper := tApp.GetCommitteeKeeper()
+			tApp.InitializeFromGenesisStates(
+				committeeGenState(
+					tApp.AppCodec(),
+					[]types.Committee{tokenCom},
+					[]types.Proposal{types.MustNewProposal(
+						govv1beta1.NewTextProposal("A Title", "A description of this proposal."),
+						defaultProposalID,
+						tokenCom.GetID(),
+						firstBlockTime.Add(time.Hour*24*7),
+					)},
+					[]types.Vote{{ProposalID: defaultProposalID, Voter: voter, VoteType: types.VOTE_TYPE_YES}},
+				),
+				app.NewFundedGenStateWithSameCoins(
+					tApp.AppCodec(),
+					testutil.Cs(testutil.C(types.BondDenom, 1e9)),
+					[]sdk.AccAddress{voter, valAddr.Bytes()},
+				),
+			)
+			ctx := tApp.NewContext(false, tmproto.Header{Height: 1, Time: firstBlockTime})
+
+			sk := tApp.GetStakingKeeper()
+			stakingParams := sk.GetParams(ctx)
+			stakingParams.BondDenom = types.BondDenom
+			sk.SetParams(ctx, stakingParams)
+
+			// The voter stakes 600e6, then converts 300e6 of their delegation into derivatives and deposits 100e6 of those in earn.
+			msgServer := stakingkeeper.NewMsgServerImpl(sk)
+			createMsg, err := stakingtypes.NewMsgCreateValidator(
+				valAddr,
+				ed25519.GenPrivKey().PubKey(),
+				testutil.C(types.BondDenom, 1e9),
+				stakingtypes.Description{},
+				stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+				sdk.NewInt(1e6),
+			)
+			suite.Require().NoError(err)
+			_, err = msgServer.CreateValidator(sdk.WrapSDKContext(ctx), createMsg)
+			suite.Require().NoError(err)
+			_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), stakingtypes.NewMsgDelegate(voter, valAddr, testutil.C(types.BondDenom, 600e6)))
+			suite.Require().NoError(err)
+			staking.EndBlocker(ctx, sk)
+
+			derivative, err := tApp.GetLiquidKeeper().MintDerivative(ctx, voter, valAddr, testutil.C(types.BondDenom, 300e6))
+			suite.Require().NoError(err)
+
+			ek := tApp.GetEarnKeeper()
+			earnParams := ek.GetParams(ctx)
+			earnParams.AllowedVaults = append(earnParams.AllowedVaults, earntypes.NewAllowedVault(
+				liquidtypes.DefaultDerivativeDenom, earntypes.StrategyTypes{earntypes.STRATEGY_TYPE_SAVINGS}, false, nil,
+			))
+			ek.SetParams(ctx, earnParams)
+			svk := tApp.GetSavingsKeeper()
+			savingsParams := svk.GetParams(ctx)
+			savingsParams.SupportedDenoms = append(savingsParams.SupportedDenoms, liquidtypes.DefaultDerivativeDenom)
+			svk.SetParams(ctx, savingsParams)
+			err = ek.Deposit(ctx, voter, testutil.C(derivative.Denom, 100e6), earntypes.STRATEGY_TYPE_SAVINGS)
+			suite.Require().NoError(err)
+
+			yesVotes, noVotes, currVotes, possibleVotes := keeper.TallyTokenCommitteeVotes(ctx, defaultProposalID, tokenCom)
+
+			suite.Equal(tc.expectedYesVoteCount, yesVotes)
+			suite.Equal(sdk.ZeroDec(), noVotes)
+			suite.Equal(tc.expectedYesVoteCount, currVotes)
+			suite.Equal(sdk.NewDecFromInt(tApp.GetBankKeeper().GetSupply(ctx, types.BondDenom).Amount), possibleVotes)
+		})
+	}
+}
+
+func (suite *keeperTestSuite) TestTallyTokenCommitteeVotes_HardBorrows() {
+	var defaultProposalID uint64 = 1
+	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+	voter := suite.Addresses[0]
+
+	tokenCom := types.MustNewTokenCommittee(
+		12,
+		"This committee is for testing.",
+		suite.Addresses[:5],
+		[]types.Permission{&types.GodPermission{}},
+		testutil.D("0.667"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_DEADLINE,
+		testutil.D("0.4"),
+		"usdx",
+	)
+	tokenCom.TallySources = []types.TallySource{types.TALLY_SOURCE_HARD}
+
+	hardGS := hardtypes.NewGenesisState(
+		hardtypes.NewParams(
+			hardtypes.MoneyMarkets{
+				hardtypes.NewMoneyMarket(
+					"usdx",
+					hardtypes.NewBorrowLimit(false, sdk.NewDec(1e15), sdk.MustNewDecFromStr("0.8")),
+					"usdx:usd",
+					sdkmath.NewInt(1e6),
+					hardtypes.NewJumpRateModel(sdk.ZeroDec(), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("1")),
+					sdk.MustNewDecFromStr("0.05"),
+					sdk.ZeroDec(),
+				),
+			},
+			sdk.NewDec(10),
+			hardtypes.DefaultCheckLtvIndexCount,
+			hardtypes.DefaultEModeCategories,
+		),
+		hardtypes.DefaultAccumulationTimes, hardtypes.DefaultDeposits, hardtypes.DefaultBorrows,
+		hardtypes.DefaultTotalSupplied, hardtypes.DefaultTotalBorrowed, hardtypes.DefaultTotalReserves,
+	)
+	pricefeedGS := pricefeedtypes.NewGenesisState(
+		pricefeedtypes.NewParams([]pricefeedtypes.Market{
+			pricefeedtypes.NewMarket("usdx:usd", "usdx", "usd", []sdk.AccAddress{}, true),
+		}),
+		[]pricefeedtypes.PostedPrice{
+			pricefeedtypes.NewPostedPrice("usdx:usd", sdk.AccAddress{}, sdk.OneDec(), firstBlockTime.Add(time.Hour)),
+		},
+	)
+
+	tApp := app.NewTestApp()
+	cdc := tApp.AppCodec()
+	tApp.InitializeFromGenesisStatesWithTime(
+		firstBlockTime,
+		committeeGenState(
+			cdc,
+			[]types.Committee{tokenCom},
+			[]types.Proposal{types.MustNewProposal(
+				govv1beta1.NewTextProposal("A Title", "A description of this proposal."),
+				defaultProposalID,
+				tokenCom.GetID(),
+				firstBlockTime.Add(time.Hour*24*7),
+			)},
+			[]types.Vote{{ProposalID: defaultProposalID, Voter: voter, VoteType: types.VOTE_TYPE_YES}},
+		),
+		app.GenesisState{hardtypes.ModuleName: cdc.MustMarshalJSON(&hardGS)},
+		app.GenesisState{pricefeedtypes.ModuleName: cdc.MustMarshalJSON(&pricefeedGS)},
+		app.NewFundedGenStateWithSameCoins(cdc, testutil.Cs(testutil.C("usdx", 1000e6)), []sdk.AccAddress{voter}),
+	)
+	ctx := tApp.NewContext(false, tmproto.Header{Height: 1, Time: firstBlockTime})
+
+	// The voter deposits 500e6 and borrows 100e6 back, holding 600e6 in their account.
+	hk := tApp.GetHardKeeper()
+	suite.Require().NoError(hk.Deposit(ctx, voter, testutil.Cs(testutil.C("usdx", 500e6))))
+	suite.Require().NoError(hk.Borrow(ctx, voter, testutil.Cs(testutil.C("usdx", 100e6))))
+
+	// The borrowed tokens are only counted once, in the account.
+	yesVotes, _, _, _ := tApp.GetCommitteeKeeper().TallyTokenCommitteeVotes(ctx, defaultProposalID, tokenCom)
+	suite.Equal(testutil.D("1000000000"), yesVotes)
+}
+
 func (suite *keeperTestSuite) TestGetMemberCommitteeProposalResult() {
 	memberCom := types.MustNewMemberCommittee(
 
@@ -837,6 +1046,394 @@ func (suite *keeperTestSuite) TestCloseProposal() {
 	suite.False(found)
 }
 
+func (suite *keeperTestSuite) TestMsgsProposal() {
+	authority := types.GetAuthority()
+	recipient := suite.Addresses[4]
+	memberCom := types.MustNewMemberCommittee(
+		12,
+		"This committee is for testing.",
+		suite.Addresses[:2],
+		[]types.Permission{&types.MsgsPermission{
+			AllowedMsgs: types.AllowedMsgs{{
+				TypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}),
+				FieldRequirements: []types.MsgFieldRequirement{
+					{Field: "amount.denom", AllowedValues: []string{"ufury"}},
+				},
+			}},
+		}},
+		testutil.D("0.5"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+
+	tApp := app.NewTestApp()
+	keeper := tApp.GetCommitteeKeeper()
+	bankKeeper := tApp.GetBankKeeper()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: firstBlockTime})
+	tApp.InitializeFromGenesisStates(
+		committeeGenState(tApp.AppCodec(), []types.Committee{memberCom}, []types.Proposal{}, []types.Vote{}),
+	)
+	suite.Require().NoError(tApp.FundAccount(ctx, authority, testutil.Cs(testutil.C("ufury", 1000), testutil.C("hard", 1000))))
+
+	// messages outside of the committee's permissions cannot be proposed
+	disallowed := types.MustNewMsgsProposal("A Title", "A description of this proposal.", []sdk.Msg{
+		banktypes.NewMsgSend(authority, recipient, testutil.Cs(testutil.C("hard", 100))),
+	})
+	_, err := keeper.SubmitProposal(ctx, memberCom.Members[0], memberCom.ID, &disallowed)
+	suite.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// messages that fail to execute cannot be proposed
+	failing := types.MustNewMsgsProposal("A Title", "A description of this proposal.", []sdk.Msg{
+		banktypes.NewMsgSend(authority, recipient, testutil.Cs(testutil.C("ufury", 5000))),
+	})
+	_, err = keeper.SubmitProposal(ctx, memberCom.Members[0], memberCom.ID, &failing)
+	suite.Error(err)
+
+	proposal := types.MustNewMsgsProposal("A Title", "A description of this proposal.", []sdk.Msg{
+		banktypes.NewMsgSend(authority, recipient, testutil.Cs(testutil.C("ufury", 100))),
+		banktypes.NewMsgSend(authority, recipient, testutil.Cs(testutil.C("ufury", 200))),
+	})
+	proposalID, err := keeper.SubmitProposal(ctx, memberCom.Members[0], memberCom.ID, &proposal)
+	suite.Require().NoError(err)
+	suite.Require().NoError(keeper.AddVote(ctx, proposalID, memberCom.Members[0], types.VOTE_TYPE_YES))
+
+	keeper.ProcessProposals(ctx)
+
+	_, found := keeper.GetProposal(ctx, proposalID)
+	suite.False(found)
+	suite.Equal(testutil.C("ufury", 300), bankKeeper.GetBalance(ctx, recipient, "ufury"))
+	suite.Equal(testutil.C("ufury", 700), bankKeeper.GetBalance(ctx, authority, "ufury"))
+}
+
+func (suite *keeperTestSuite) TestQueuedProposals() {
+	authority := types.GetAuthority()
+	recipient := suite.Addresses[4]
+	memberCom := types.MustNewMemberCommittee(
+		12,
+		"This committee is for testing.",
+		suite.Addresses[:2],
+		[]types.Permission{&types.MsgsPermission{
+			AllowedMsgs: types.AllowedMsgs{{TypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{})}},
+		}},
+		testutil.D("0.5"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+	memberCom.SetExecutionDelay(time.Hour)
+	memberCom.SetVetoCommitteeID(13)
+	vetoCom := types.MustNewMemberCommittee(
+		13,
+		"This committee is for vetoing.",
+		suite.Addresses[2:4],
+		[]types.Permission{},
+		testutil.D("0.5"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+
+	tApp := app.NewTestApp()
+	keeper := tApp.GetCommitteeKeeper()
+	bankKeeper := tApp.GetBankKeeper()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: firstBlockTime})
+	tApp.InitializeFromGenesisStates(
+		committeeGenState(tApp.AppCodec(), []types.Committee{memberCom, vetoCom}, []types.Proposal{}, []types.Vote{}),
+	)
+	suite.Require().NoError(tApp.FundAccount(ctx, authority, testutil.Cs(testutil.C("ufury", 1000))))
+
+	passProposal := func(committee types.Committee, pubProposal types.PubProposal) uint64 {
+		proposalID, err := keeper.SubmitProposal(ctx, committee.GetMembers()[0], committee.GetID(), pubProposal)
+		suite.Require().NoError(err)
+		suite.Require().NoError(keeper.AddVote(ctx, proposalID, committee.GetMembers()[0], types.VOTE_TYPE_YES))
+		keeper.ProcessProposals(ctx)
+		return proposalID
+	}
+	send := types.MustNewMsgsProposal("A Title", "A description of this proposal.", []sdk.Msg{
+		banktypes.NewMsgSend(authority, recipient, testutil.Cs(testutil.C("ufury", 100))),
+	})
+
+	// a passed proposal is queued instead of being enacted
+	proposalID := passProposal(memberCom, &send)
+	_, found := keeper.GetProposal(ctx, proposalID)
+	suite.False(found)
+	queuedProposal, found := keeper.GetQueuedProposal(ctx, proposalID)
+	suite.Require().True(found)
+	suite.Equal(firstBlockTime.Add(time.Hour), queuedProposal.ExecutionTime)
+	suite.True(bankKeeper.GetBalance(ctx, recipient, "ufury").IsZero())
+
+	// it is not enacted before the execution delay has passed
+	ctx = ctx.WithBlockTime(firstBlockTime.Add(time.Hour - time.Second))
+	keeper.ProcessQueuedProposals(ctx)
+	_, found = keeper.GetQueuedProposal(ctx, proposalID)
+	suite.True(found)
+	suite.True(bankKeeper.GetBalance(ctx, recipient, "ufury").IsZero())
+
+	// it is enacted once the execution delay has passed
+	ctx = ctx.WithBlockTime(firstBlockTime.Add(time.Hour))
+	keeper.ProcessQueuedProposals(ctx)
+	_, found = keeper.GetQueuedProposal(ctx, proposalID)
+	suite.False(found)
+	suite.Equal(testutil.C("ufury", 100), bankKeeper.GetBalance(ctx, recipient, "ufury"))
+
+	// a queued proposal can be vetoed by the veto committee
+	proposalID = passProposal(memberCom, &send)
+	_, found = keeper.GetQueuedProposal(ctx, proposalID)
+	suite.Require().True(found)
+
+	veto := types.NewVetoProposal("A Title", "A description of this proposal.", proposalID)
+	_, err := keeper.SubmitProposal(ctx, memberCom.Members[0], memberCom.ID, &veto)
+	suite.ErrorIs(err, sdkerrors.ErrUnauthorized, "only the veto committee can veto without a permission")
+
+	passProposal(vetoCom, &veto)
+	_, found = keeper.GetQueuedProposal(ctx, proposalID)
+	suite.False(found)
+
+	ctx = ctx.WithBlockTime(firstBlockTime.Add(3 * time.Hour))
+	keeper.ProcessQueuedProposals(ctx)
+	suite.Equal(testutil.C("ufury", 100), bankKeeper.GetBalance(ctx, recipient, "ufury"))
+
+	// proposals that are no longer queued cannot be vetoed
+	suite.ErrorIs(keeper.VetoQueuedProposal(ctx, proposalID), types.ErrUnknownProposal)
+}
+
+func (suite *keeperTestSuite) TestChangeCommitteeMembership() {
+	memberCom := types.MustNewMemberCommittee(
+		12,
+		"This committee is for testing.",
+		suite.Addresses[:3],
+		[]types.Permission{&types.CommitteeMembershipPermission{
+			MinMembers:       2,
+			MaxMembers:       3,
+			MinVoteThreshold: testutil.D("0.5"),
+			MaxVoteThreshold: testutil.D("0.8"),
+		}},
+		testutil.D("0.5"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+	otherCom := types.MustNewMemberCommittee(
+		13,
+		"This committee is for testing.",
+		suite.Addresses[3:4],
+		[]types.Permission{&types.GodPermission{}},
+		testutil.D("0.5"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+
+	tApp := app.NewTestApp()
+	keeper := tApp.GetCommitteeKeeper()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: firstBlockTime})
+	tApp.InitializeFromGenesisStates(
+		committeeGenState(tApp.AppCodec(), []types.Committee{memberCom, otherCom}, []types.Proposal{}, []types.Vote{}),
+	)
+
+	// a member votes on an open proposal
+	threshold := types.NewChangeCommitteeVoteThresholdProposal("A Title", "A description of this proposal.", memberCom.ID, testutil.D("0.6"))
+	openProposalID, err := keeper.SubmitProposal(ctx, memberCom.Members[0], memberCom.ID, &threshold)
+	suite.Require().NoError(err)
+	suite.Require().NoError(keeper.AddVote(ctx, openProposalID, memberCom.Members[2], types.VOTE_TYPE_YES))
+
+	// the committee removes the member, deleting their votes
+	remove := types.NewRemoveCommitteeMemberProposal("A Title", "A description of this proposal.", memberCom.ID, memberCom.Members[2])
+	proposalID, err := keeper.SubmitProposal(ctx, memberCom.Members[0], memberCom.ID, &remove)
+	suite.Require().NoError(err)
+	suite.Require().NoError(keeper.AddVote(ctx, proposalID, memberCom.Members[0], types.VOTE_TYPE_YES))
+	suite.Require().NoError(keeper.AddVote(ctx, proposalID, memberCom.Members[1], types.VOTE_TYPE_YES))
+	keeper.ProcessProposals(ctx)
+
+	com, found := keeper.GetCommittee(ctx, memberCom.ID)
+	suite.Require().True(found)
+	suite.Equal(suite.Addresses[:2], com.GetMembers())
+	_, found = keeper.GetVote(ctx, openProposalID, memberCom.Members[2])
+	suite.False(found)
+
+	// the committee cannot go below its minimum members
+	remove = types.NewRemoveCommitteeMemberProposal("A Title", "A description of this proposal.", memberCom.ID, memberCom.Members[1])
+	suite.ErrorIs(keeper.ChangeCommitteeMembership(ctx, &remove), sdkerrors.ErrUnauthorized)
+
+	// the committee can add members up to its maximum members
+	add := types.NewAddCommitteeMemberProposal("A Title", "A description of this proposal.", memberCom.ID, suite.Addresses[4])
+	suite.Require().NoError(keeper.ChangeCommitteeMembership(ctx, &add))
+	add = types.NewAddCommitteeMemberProposal("A Title", "A description of this proposal.", memberCom.ID, suite.Addresses[5])
+	suite.ErrorIs(keeper.ChangeCommitteeMembership(ctx, &add), sdkerrors.ErrUnauthorized)
+
+	// the vote threshold can only change within limits
+	suite.Require().NoError(keeper.ChangeCommitteeMembership(ctx, &threshold))
+	threshold = types.NewChangeCommitteeVoteThresholdProposal("A Title", "A description of this proposal.", memberCom.ID, testutil.D("0.9"))
+	_, err = keeper.SubmitProposal(ctx, memberCom.Members[0], memberCom.ID, &threshold)
+	suite.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// committees can only change their own membership
+	add = types.NewAddCommitteeMemberProposal("A Title", "A description of this proposal.", memberCom.ID, suite.Addresses[5])
+	_, err = keeper.SubmitProposal(ctx, otherCom.Members[0], otherCom.ID, &add)
+	suite.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the changes are recorded in order
+	suite.Equal([]types.MembershipChange{
+		types.NewMembershipChange(memberCom.ID, 1, types.MEMBERSHIP_CHANGE_TYPE_REMOVE_MEMBER, suite.Addresses[2], testutil.D("0.5"), 1, firstBlockTime),
+		types.NewMembershipChange(memberCom.ID, 2, types.MEMBERSHIP_CHANGE_TYPE_ADD_MEMBER, suite.Addresses[4], testutil.D("0.5"), 1, firstBlockTime),
+		types.NewMembershipChange(memberCom.ID, 3, types.MEMBERSHIP_CHANGE_TYPE_CHANGE_VOTE_THRESHOLD, nil, testutil.D("0.6"), 1, firstBlockTime),
+	}, keeper.GetMembershipChanges(ctx))
+}
+
+func (suite *keeperTestSuite) TestChangeWeightedCommitteeMembership() {
+	weightedCom := types.MustNewWeightedMemberCommittee(
+		12,
+		"This committee is for testing.",
+		[]types.MemberWeight{
+			types.NewMemberWeight(suite.Addresses[0], testutil.D("5")),
+			types.NewMemberWeight(suite.Addresses[1], testutil.D("3")),
+			types.NewMemberWeight(suite.Addresses[2], testutil.D("2")),
+		},
+		[]types.Permission{&types.CommitteeMembershipPermission{
+			MinMembers:       2,
+			MaxMembers:       3,
+			MinVoteThreshold: testutil.D("0.5"),
+			MaxVoteThreshold: testutil.D("0.8"),
+			MinMemberWeight:  testutil.D("1"),
+			MaxMemberWeight:  testutil.D("5"),
+		}},
+		testutil.D("0.5"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+
+	tApp := app.NewTestApp()
+	keeper := tApp.GetCommitteeKeeper()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)})
+	tApp.InitializeFromGenesisStates(
+		committeeGenState(tApp.AppCodec(), []types.Committee{weightedCom}, []types.Proposal{}, []types.Vote{}),
+	)
+
+	// the heaviest member rotates their key, keeping their weight
+	remove := types.NewRemoveCommitteeMemberProposal("A Title", "A description of this proposal.", weightedCom.ID, suite.Addresses[0])
+	suite.Require().NoError(keeper.ChangeCommitteeMembership(ctx, &remove))
+
+	// weights outside the limits of the permission are not allowed
+	add := types.NewWeightedAddCommitteeMemberProposal("A Title", "A description of this proposal.", weightedCom.ID, suite.Addresses[4], testutil.D("6"))
+	suite.ErrorIs(keeper.ChangeCommitteeMembership(ctx, &add), sdkerrors.ErrUnauthorized)
+	_, err := keeper.SubmitProposal(ctx, suite.Addresses[1], weightedCom.ID, &add)
+	suite.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	add = types.NewWeightedAddCommitteeMemberProposal("A Title", "A description of this proposal.", weightedCom.ID, suite.Addresses[4], testutil.D("5"))
+	suite.Require().NoError(keeper.ChangeCommitteeMembership(ctx, &add))
+
+	com, found := keeper.GetCommittee(ctx, weightedCom.ID)
+	suite.Require().True(found)
+	weighted, ok := com.(*types.WeightedMemberCommittee)
+	suite.Require().True(ok)
+	suite.Equal([]types.MemberWeight{
+		types.NewMemberWeight(suite.Addresses[1], testutil.D("3")),
+		types.NewMemberWeight(suite.Addresses[2], testutil.D("2")),
+		types.NewMemberWeight(suite.Addresses[4], testutil.D("5")),
+	}, weighted.MemberWeights)
+	suite.Equal(testutil.D("10"), weighted.GetTotalWeight())
+}
+
+func (suite *keeperTestSuite) TestWeightedMemberCommitteeVoteDelegation() {
+	weightedCom := types.MustNewWeightedMemberCommittee(
+		12,
+		"This committee is for testing.",
+		[]types.MemberWeight{
+			types.NewMemberWeight(suite.Addresses[0], testutil.D("5")),
+			types.NewMemberWeight(suite.Addresses[1], testutil.D("3")),
+			types.NewMemberWeight(suite.Addresses[2], testutil.D("2")),
+		},
+		[]types.Permission{&types.GodPermission{}},
+		testutil.D("0.6"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_DEADLINE,
+	)
+	tokenCom := types.MustNewTokenCommittee(
+		13,
+		"This committee is for testing.",
+		suite.Addresses[:1],
+		[]types.Permission{&types.GodPermission{}},
+		testutil.D("0.6"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_DEADLINE,
+		testutil.D("0.4"),
+		"hard",
+	)
+	delegate := suite.Addresses[4]
+	var proposalID uint64 = 1
+	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+
+	tApp := app.NewTestApp()
+	keeper := tApp.GetCommitteeKeeper()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: firstBlockTime})
+	tApp.InitializeFromGenesisStates(
+		committeeGenState(
+			tApp.AppCodec(),
+			[]types.Committee{weightedCom, tokenCom},
+			[]types.Proposal{types.MustNewProposal(
+				govv1beta1.NewTextProposal("A Title", "A description of this proposal."),
+				proposalID,
+				weightedCom.GetID(),
+				firstBlockTime.Add(time.Hour*24*7),
+			)},
+			[]types.Vote{},
+		),
+	)
+
+	// only committee members can delegate their votes, and only on member committees
+	suite.ErrorIs(keeper.DelegateVote(ctx, weightedCom.ID, suite.Addresses[3], delegate, time.Hour), sdkerrors.ErrUnauthorized)
+	suite.ErrorIs(keeper.DelegateVote(ctx, tokenCom.ID, suite.Addresses[0], delegate, time.Hour), types.ErrInvalidVoteDelegation)
+	suite.ErrorIs(keeper.DelegateVote(ctx, weightedCom.ID, suite.Addresses[1], delegate, types.MaxVoteDelegationDuration+time.Hour), types.ErrInvalidVoteDelegation)
+	suite.ErrorIs(keeper.RevokeVoteDelegation(ctx, weightedCom.ID, suite.Addresses[1]), types.ErrUnknownVoteDelegation)
+
+	// a delegate votes in place of a member, and non members without a delegation cannot vote
+	suite.Require().NoError(keeper.DelegateVote(ctx, weightedCom.ID, suite.Addresses[1], delegate, 24*time.Hour))
+	suite.Require().NoError(keeper.AddVote(ctx, proposalID, suite.Addresses[0], types.VOTE_TYPE_YES))
+	suite.Require().NoError(keeper.AddVote(ctx, proposalID, delegate, types.VOTE_TYPE_NO))
+	suite.Require().NoError(keeper.AddVote(ctx, proposalID, suite.Addresses[2], types.VOTE_TYPE_ABSTAIN))
+	suite.ErrorIs(keeper.AddVote(ctx, proposalID, suite.Addresses[3], types.VOTE_TYPE_YES), sdkerrors.ErrUnauthorized)
+
+	tally, found := keeper.GetProposalTallyResponse(ctx, proposalID)
+	suite.Require().True(found)
+	suite.Equal(types.QueryTallyResponse{
+		ProposalID:    proposalID,
+		YesVotes:      testutil.D("5"),
+		NoVotes:       testutil.D("3"),
+		AbstainVotes:  testutil.D("2"),
+		CurrentVotes:  testutil.D("10"),
+		PossibleVotes: testutil.D("10"),
+		VoteThreshold: testutil.D("0.6"),
+		Quorum:        testutil.D("0"),
+	}, *tally)
+	suite.False(keeper.GetMemberCommitteeProposalResult(ctx, proposalID, weightedCom))
+
+	// a member's own vote takes precedence over the vote of their delegate
+	suite.Require().NoError(keeper.AddVote(ctx, proposalID, suite.Addresses[1], types.VOTE_TYPE_YES))
+	yesVotes, noVotes, abstainVotes, possibleVotes := keeper.TallyMemberCommitteeVotes(ctx, proposalID, weightedCom)
+	suite.Equal(testutil.D("8"), yesVotes)
+	suite.Equal(testutil.D("0"), noVotes)
+	suite.Equal(testutil.D("2"), abstainVotes)
+	suite.Equal(testutil.D("10"), possibleVotes)
+	suite.True(keeper.GetMemberCommitteeProposalResult(ctx, proposalID, weightedCom))
+
+	// delegations end when they expire
+	suite.Require().NoError(keeper.DelegateVote(ctx, weightedCom.ID, suite.Addresses[2], delegate, time.Hour))
+	ctx = ctx.WithBlockTime(firstBlockTime.Add(time.Hour))
+	suite.Len(keeper.GetVoteDelegationsByCommittee(ctx, weightedCom.ID), 2)
+	keeper.DeleteExpiredVoteDelegations(ctx)
+	suite.Equal([]types.VoteDelegation{
+		types.NewVoteDelegation(weightedCom.ID, suite.Addresses[1], delegate, firstBlockTime.Add(24*time.Hour)),
+	}, keeper.GetVoteDelegationsByCommittee(ctx, weightedCom.ID))
+
+	// delegations end when they are revoked, or when the delegator is no longer a member
+	suite.Require().NoError(keeper.DelegateVote(ctx, weightedCom.ID, suite.Addresses[0], delegate, time.Hour))
+	suite.Require().NoError(keeper.RevokeVoteDelegation(ctx, weightedCom.ID, suite.Addresses[0]))
+	weightedCom.SetMembers(suite.Addresses[:1])
+	keeper.SetCommittee(ctx, weightedCom)
+	keeper.PruneVoteDelegations(ctx, weightedCom.ID)
+	suite.Empty(keeper.GetVoteDelegations(ctx))
+	suite.ErrorIs(keeper.AddVote(ctx, proposalID, delegate, types.VOTE_TYPE_YES), sdkerrors.ErrUnauthorized)
+}
+
 func committeeGenState(cdc codec.Codec, committees []types.Committee, proposals []types.Proposal, votes []types.Vote) app.GenesisState {
 	gs := types.NewGenesisState(
 		uint64(len(proposals)+1),
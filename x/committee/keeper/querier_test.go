@@ -200,6 +200,7 @@ func (suite *QuerierTestSuite) TestQueryTally() {
 		ProposalID:    1,
 		YesVotes:      sdk.NewDec(int64(len(suite.votes[propID]))),
 		NoVotes:       sdk.ZeroDec(),
+		AbstainVotes:  sdk.ZeroDec(),
 		CurrentVotes:  sdk.NewDec(int64(len(suite.votes[propID]))),
 		PossibleVotes: testutil.D("3.0"),
 		VoteThreshold: testutil.D("0.667"),
@@ -236,6 +236,64 @@ func (suite *ProposalHandlerTestSuite) TestProposalHandler_DeleteCommittee() {
 	}
 }
 
+func (suite *ProposalHandlerTestSuite) TestProposalHandler_Veto() {
+	queuedProposal := types.NewQueuedProposal(
+		types.MustNewProposal(
+			govv1beta1.NewTextProposal("A Title", "A description of this proposal."), 2, 1, testTime,
+		),
+		testTime.Add(time.Hour),
+	)
+	suite.testGenesis.NextProposalID = 3
+	suite.testGenesis.QueuedProposals = types.QueuedProposals{queuedProposal}
+
+	testCases := []struct {
+		name       string
+		proposal   types.VetoProposal
+		expectPass bool
+	}{
+		{
+			name:       "normal",
+			proposal:   types.NewVetoProposal("A Title", "A proposal description.", queuedProposal.Proposal.ID),
+			expectPass: true,
+		},
+		{
+			name:       "proposal not queued",
+			proposal:   types.NewVetoProposal("A Title", "A proposal description.", 1),
+			expectPass: false,
+		},
+		{
+			name:       "invalid title",
+			proposal:   types.NewVetoProposal("", "A proposal description.", queuedProposal.Proposal.ID),
+			expectPass: false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Setup
+			suite.app = app.NewTestApp()
+			suite.keeper = suite.app.GetCommitteeKeeper()
+			suite.app = suite.app.InitializeFromGenesisStates(
+				NewCommitteeGenState(suite.app.AppCodec(), suite.testGenesis),
+			)
+			suite.ctx = suite.app.NewContext(true, tmproto.Header{Height: 1, Time: testTime})
+			handler := committee.NewProposalHandler(suite.keeper)
+
+			// Run
+			err := handler(suite.ctx, &tc.proposal)
+
+			// Check
+			if tc.expectPass {
+				suite.NoError(err)
+				_, found := suite.keeper.GetQueuedProposal(suite.ctx, tc.proposal.ProposalID)
+				suite.False(found)
+			} else {
+				suite.Error(err)
+				testutil.AssertProtoMessageJSON(suite.T(), suite.app.AppCodec(), suite.testGenesis, committee.ExportGenesis(suite.ctx, suite.keeper))
+			}
+		})
+	}
+}
+
 func TestProposalHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(ProposalHandlerTestSuite))
 }
@@ -60,12 +60,18 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterInterface((*PubProposal)(nil), nil)
 	cdc.RegisterConcrete(CommitteeChangeProposal{}, "fury/CommitteeChangeProposal", nil)
 	cdc.RegisterConcrete(CommitteeDeleteProposal{}, "fury/CommitteeDeleteProposal", nil)
+	cdc.RegisterConcrete(MsgsProposal{}, "fury/MsgsProposal", nil)
+	cdc.RegisterConcrete(VetoProposal{}, "fury/VetoProposal", nil)
+	cdc.RegisterConcrete(AddCommitteeMemberProposal{}, "fury/AddCommitteeMemberProposal", nil)
+	cdc.RegisterConcrete(RemoveCommitteeMemberProposal{}, "fury/RemoveCommitteeMemberProposal", nil)
+	cdc.RegisterConcrete(ChangeCommitteeVoteThresholdProposal{}, "fury/ChangeCommitteeVoteThresholdProposal", nil)
 
 	// Committees
 	cdc.RegisterInterface((*Committee)(nil), nil)
 	cdc.RegisterConcrete(BaseCommittee{}, "fury/BaseCommittee", nil)
 	cdc.RegisterConcrete(MemberCommittee{}, "fury/MemberCommittee", nil)
 	cdc.RegisterConcrete(TokenCommittee{}, "fury/TokenCommittee", nil)
+	cdc.RegisterConcrete(WeightedMemberCommittee{}, "fury/WeightedMemberCommittee", nil)
 
 	// Permissions
 	cdc.RegisterInterface((*Permission)(nil), nil)
@@ -76,10 +82,14 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(CommunityCDPRepayDebtPermission{}, "fury/CommunityCDPRepayDebtPermission", nil)
 	cdc.RegisterConcrete(CommunityCDPWithdrawCollateralPermission{}, "fury/CommunityCDPWithdrawCollateralPermission", nil)
 	cdc.RegisterConcrete(CommunityPoolLendWithdrawPermission{}, "fury/CommunityPoolLendWithdrawPermission", nil)
+	cdc.RegisterConcrete(MsgsPermission{}, "fury/MsgsPermission", nil)
+	cdc.RegisterConcrete(CommitteeMembershipPermission{}, "fury/CommitteeMembershipPermission", nil)
 
 	// Msgs
 	legacy.RegisterAminoMsg(cdc, &MsgSubmitProposal{}, "fury/MsgSubmitProposal")
 	legacy.RegisterAminoMsg(cdc, &MsgVote{}, "fury/MsgVote")
+	legacy.RegisterAminoMsg(cdc, &MsgDelegateVote{}, "fury/MsgDelegateVote")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeVoteDelegation{}, "fury/MsgRevokeVoteDelegation")
 }
 
 // RegisterProposalTypeCodec allows external modules to register their own pubproposal types on the
@@ -92,6 +102,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSubmitProposal{},
 		&MsgVote{},
+		&MsgDelegateVote{},
+		&MsgRevokeVoteDelegation{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -102,6 +114,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&BaseCommittee{},
 		&TokenCommittee{},
 		&MemberCommittee{},
+		&WeightedMemberCommittee{},
 	)
 
 	registry.RegisterInterface(
@@ -114,6 +127,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&CommunityCDPRepayDebtPermission{},
 		&CommunityCDPWithdrawCollateralPermission{},
 		&CommunityPoolLendWithdrawPermission{},
+		&MsgsPermission{},
+		&CommitteeMembershipPermission{},
 	)
 
 	// Need to register PubProposal here since we use this as alias for the x/gov Content interface for all the proposal implementations used in this module.
@@ -131,11 +146,17 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&communitytypes.CommunityCDPRepayDebtProposal{},
 		&communitytypes.CommunityCDPWithdrawCollateralProposal{},
 		&communitytypes.CommunityPoolLendWithdrawProposal{},
+		&MsgsProposal{},
+		&VetoProposal{},
+		&AddCommitteeMemberProposal{},
+		&RemoveCommitteeMemberProposal{},
+		&ChangeCommitteeVoteThresholdProposal{},
 	)
 
 	registry.RegisterImplementations(
 		(*govv1beta1.Content)(nil),
 		&CommitteeChangeProposal{},
 		&CommitteeDeleteProposal{},
+		&VetoProposal{},
 	)
 }
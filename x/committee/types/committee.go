@@ -14,11 +14,16 @@ import (
 
 const MaxCommitteeDescriptionLength int = 512
 
+// MaxVoteDelegationDuration is the longest time a committee member can delegate their vote for.
+const MaxVoteDelegationDuration = 30 * 24 * time.Hour
+
 const (
 	BaseCommitteeType   = "fury/BaseCommittee"
 	MemberCommitteeType = "fury/MemberCommittee" // Committee is composed of member addresses that vote to enact proposals within their permissions
 	TokenCommitteeType  = "fury/TokenCommittee"  // Committee is composed of token holders with voting power determined by total token balance
-	BondDenom           = "ufury"
+	// Committee is composed of member addresses with set voting weights that vote to enact proposals within their permissions
+	WeightedMemberCommitteeType = "fury/WeightedMemberCommittee"
+	BondDenom                   = "ufury"
 )
 
 // Marshal needed for protobuf compatibility.
@@ -56,6 +61,13 @@ type Committee interface {
 	SetVoteThreshold(sdk.Dec)
 
 	GetTallyOption() TallyOption
+
+	GetExecutionDelay() time.Duration
+	SetExecutionDelay(time.Duration)
+
+	GetVetoCommitteeID() uint64
+	SetVetoCommitteeID(uint64)
+
 	Validate() error
 
 	String() string
@@ -143,10 +155,13 @@ func (c BaseCommittee) String() string {
   	Permissions:               			%s
   	VoteThreshold:            		  %s
 	ProposalDuration:        						%s
-	TallyOption:   						%s`,
+	TallyOption:   						%s
+	ExecutionDelay:        						%s
+	VetoCommitteeID:        						%d`,
 		c.ID, c.Description, c.GetMembers(), c.Permissions,
 		c.VoteThreshold.String(), c.ProposalDuration.String(),
-		c.TallyOption.String(),
+		c.TallyOption.String(), c.ExecutionDelay.String(),
+		c.VetoCommitteeID,
 	)
 }
 
@@ -169,6 +184,22 @@ func (c *BaseCommittee) SetProposalDuration(proposalDuration time.Duration) {
 // GetTallyOption is a getter for committee TallyOption
 func (c BaseCommittee) GetTallyOption() TallyOption { return c.TallyOption }
 
+// GetExecutionDelay is a getter for committee ExecutionDelay
+func (c BaseCommittee) GetExecutionDelay() time.Duration { return c.ExecutionDelay }
+
+// SetExecutionDelay is a setter for committee ExecutionDelay
+func (c *BaseCommittee) SetExecutionDelay(executionDelay time.Duration) {
+	c.ExecutionDelay = executionDelay
+}
+
+// GetVetoCommitteeID is a getter for committee VetoCommitteeID
+func (c BaseCommittee) GetVetoCommitteeID() uint64 { return c.VetoCommitteeID }
+
+// SetVetoCommitteeID is a setter for committee VetoCommitteeID
+func (c *BaseCommittee) SetVetoCommitteeID(vetoCommitteeID uint64) {
+	c.VetoCommitteeID = vetoCommitteeID
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (c BaseCommittee) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	for _, any := range c.Permissions {
@@ -213,6 +244,16 @@ func (c BaseCommittee) Validate() error {
 		if p == nil {
 			return fmt.Errorf("committee cannot have a nil permission")
 		}
+		switch perm := p.(type) {
+		case *MsgsPermission:
+			if err := perm.Validate(); err != nil {
+				return err
+			}
+		case *CommitteeMembershipPermission:
+			if err := perm.Validate(); err != nil {
+				return err
+			}
+		}
 	}
 
 	if c.ProposalDuration < 0 {
@@ -228,6 +269,14 @@ func (c BaseCommittee) Validate() error {
 		return fmt.Errorf("invalid tally option: %d", c.TallyOption)
 	}
 
+	if c.ExecutionDelay < 0 {
+		return fmt.Errorf("invalid execution delay: %s", c.ExecutionDelay)
+	}
+
+	if c.VetoCommitteeID != 0 && c.VetoCommitteeID == c.ID {
+		return fmt.Errorf("committee cannot be its own veto committee")
+	}
+
 	return nil
 }
 
@@ -309,12 +358,39 @@ func (c TokenCommittee) GetQuorum() sdk.Dec { return c.Quorum }
 // GetTallyDenom returns the tally denom of the committee
 func (c TokenCommittee) GetTallyDenom() string { return c.TallyDenom }
 
+// HasTallySource returns true if the committee counts the token holders' positions in the source
+func (c TokenCommittee) HasTallySource(source TallySource) bool {
+	for _, s := range c.TallySources {
+		if s == source {
+			return true
+		}
+	}
+	return false
+}
+
 // Validate validates the committee's fields
 func (c TokenCommittee) Validate() error {
-	if c.TallyDenom == BondDenom {
+	// Staked tokens make up most of the bond denom supply, so it can only be tallied when they are counted.
+	if c.TallyDenom == BondDenom && !c.HasTallySource(TALLY_SOURCE_STAKED) {
 		return fmt.Errorf("invalid tally denom: %s", c.TallyDenom)
 	}
 
+	seenSources := make(map[TallySource]bool)
+	for _, source := range c.TallySources {
+		if source <= TALLY_SOURCE_UNSPECIFIED || source > TALLY_SOURCE_HARD {
+			return fmt.Errorf("invalid tally source: %d", source)
+		}
+		if seenSources[source] {
+			return fmt.Errorf("duplicate tally source: %s", source)
+		}
+		seenSources[source] = true
+
+		// Staked tokens and liquid staking derivatives are only valued in the bond denom.
+		if (source == TALLY_SOURCE_STAKED || source == TALLY_SOURCE_LIQUID) && c.TallyDenom != BondDenom {
+			return fmt.Errorf("tally source %s requires tally denom %s", source, BondDenom)
+		}
+	}
+
 	err := sdk.ValidateDenom(c.TallyDenom)
 	if err != nil {
 		return err
@@ -327,6 +403,126 @@ func (c TokenCommittee) Validate() error {
 	return c.BaseCommittee.Validate()
 }
 
+// NewWeightedMemberCommittee instantiates a new instance of WeightedMemberCommittee. The members of the committee are the
+// members of the weights.
+func NewWeightedMemberCommittee(id uint64, description string, memberWeights []MemberWeight, permissions []Permission,
+	threshold sdk.Dec, duration time.Duration, tallyOption TallyOption,
+) (*WeightedMemberCommittee, error) {
+	permissionsAny, err := PackPermissions(permissions)
+	if err != nil {
+		return nil, err
+	}
+	members := make([]sdk.AccAddress, len(memberWeights))
+	for i, mw := range memberWeights {
+		members[i] = mw.Member
+	}
+	return &WeightedMemberCommittee{
+		BaseCommittee: &BaseCommittee{
+			ID:               id,
+			Description:      description,
+			Members:          members,
+			Permissions:      permissionsAny,
+			VoteThreshold:    threshold,
+			ProposalDuration: duration,
+			TallyOption:      tallyOption,
+		},
+		MemberWeights: memberWeights,
+	}, nil
+}
+
+// MustNewWeightedMemberCommittee instantiates a new instance of WeightedMemberCommittee and panics on error
+func MustNewWeightedMemberCommittee(id uint64, description string, memberWeights []MemberWeight, permissions []Permission,
+	threshold sdk.Dec, duration time.Duration, tallyOption TallyOption,
+) *WeightedMemberCommittee {
+	committee, err := NewWeightedMemberCommittee(id, description, memberWeights, permissions, threshold, duration, tallyOption)
+	if err != nil {
+		panic(err)
+	}
+	return committee
+}
+
+// NewMemberWeight returns a new MemberWeight
+func NewMemberWeight(member sdk.AccAddress, weight sdk.Dec) MemberWeight {
+	return MemberWeight{
+		Member: member,
+		Weight: weight,
+	}
+}
+
+// GetType is a getter for committee type
+func (c WeightedMemberCommittee) GetType() string { return WeightedMemberCommitteeType }
+
+// SetMembers sets the members of the committee, keeping the weights of existing members.
+// New members are given a weight of one.
+func (c *WeightedMemberCommittee) SetMembers(members []sdk.AccAddress) {
+	weights := make([]MemberWeight, len(members))
+	for i, m := range members {
+		weights[i] = NewMemberWeight(m, sdk.OneDec())
+		for _, mw := range c.MemberWeights {
+			if mw.Member.Equals(m) {
+				weights[i] = mw
+				break
+			}
+		}
+	}
+	c.MemberWeights = weights
+	c.BaseCommittee.SetMembers(members)
+}
+
+// SetMemberWeights sets the members of the committee and their weights.
+func (c *WeightedMemberCommittee) SetMemberWeights(memberWeights []MemberWeight) {
+	members := make([]sdk.AccAddress, len(memberWeights))
+	for i, mw := range memberWeights {
+		members[i] = mw.Member
+	}
+	c.MemberWeights = memberWeights
+	c.BaseCommittee.SetMembers(members)
+}
+
+// GetMemberWeight returns the voting weight of a member, or zero if the address is not a member.
+func (c WeightedMemberCommittee) GetMemberWeight(addr sdk.AccAddress) sdk.Dec {
+	for _, mw := range c.MemberWeights {
+		if mw.Member.Equals(addr) {
+			return mw.Weight
+		}
+	}
+	return sdk.ZeroDec()
+}
+
+// GetTotalWeight returns the sum of the voting weights of all members.
+func (c WeightedMemberCommittee) GetTotalWeight() sdk.Dec {
+	total := sdk.ZeroDec()
+	for _, mw := range c.MemberWeights {
+		total = total.Add(mw.Weight)
+	}
+	return total
+}
+
+// String implements fmt.Stringer
+func (c WeightedMemberCommittee) String() string {
+	return fmt.Sprintf(`%s
+	MemberWeights:        						%s`,
+		c.BaseCommittee.String(), c.MemberWeights,
+	)
+}
+
+// Validate validates the committee's fields
+func (c WeightedMemberCommittee) Validate() error {
+	if len(c.MemberWeights) != len(c.Members) {
+		return fmt.Errorf("committee must have one weight for each member")
+	}
+	for i, mw := range c.MemberWeights {
+		if !mw.Member.Equals(c.Members[i]) {
+			return fmt.Errorf("committee member weights must match members, %s", mw.Member)
+		}
+		if mw.Weight.IsNil() || !mw.Weight.IsPositive() {
+			return fmt.Errorf("invalid member weight: %s", mw.Weight)
+		}
+	}
+
+	return c.BaseCommittee.Validate()
+}
+
 // ------------------------------------------
 //				Proposals
 // ------------------------------------------
@@ -447,6 +643,100 @@ func (p Proposal) HasExpiredBy(time time.Time) bool {
 	return !time.Before(p.Deadline)
 }
 
+var _ codectypes.UnpackInterfacesMessage = QueuedProposals{}
+
+type QueuedProposals []QueuedProposal
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (qps QueuedProposals) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, qp := range qps {
+		if err := qp.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewQueuedProposal instantiates a new instance of QueuedProposal
+func NewQueuedProposal(proposal Proposal, executionTime time.Time) QueuedProposal {
+	return QueuedProposal{
+		Proposal:      proposal,
+		ExecutionTime: executionTime,
+	}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (qp QueuedProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return qp.Proposal.UnpackInterfaces(unpacker)
+}
+
+// IsExecutableBy returns true if the execution delay of the proposal has ended by a certain time.
+func (qp QueuedProposal) IsExecutableBy(time time.Time) bool {
+	return !time.Before(qp.ExecutionTime)
+}
+
+// NewMembershipChange instantiates a new instance of MembershipChange
+func NewMembershipChange(
+	committeeID uint64, sequence uint64, changeType MembershipChangeType, member sdk.AccAddress, voteThreshold sdk.Dec,
+	height int64, time time.Time,
+) MembershipChange {
+	return MembershipChange{
+		CommitteeID:   committeeID,
+		Sequence:      sequence,
+		Type:          changeType,
+		Member:        member,
+		VoteThreshold: voteThreshold,
+		Height:        height,
+		Time:          time,
+	}
+}
+
+// Validate validates MembershipChange fields
+func (mc MembershipChange) Validate() error {
+	switch mc.Type {
+	case MEMBERSHIP_CHANGE_TYPE_ADD_MEMBER, MEMBERSHIP_CHANGE_TYPE_REMOVE_MEMBER:
+		if mc.Member.Empty() {
+			return fmt.Errorf("membership change member cannot be empty")
+		}
+	case MEMBERSHIP_CHANGE_TYPE_CHANGE_VOTE_THRESHOLD:
+	default:
+		return fmt.Errorf("invalid membership change type: %d", mc.Type)
+	}
+	if mc.VoteThreshold.IsNil() || !mc.VoteThreshold.IsPositive() || mc.VoteThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid membership change threshold: %s", mc.VoteThreshold)
+	}
+	return nil
+}
+
+// NewVoteDelegation instantiates a new instance of VoteDelegation
+func NewVoteDelegation(committeeID uint64, delegator sdk.AccAddress, delegate sdk.AccAddress, expiration time.Time) VoteDelegation {
+	return VoteDelegation{
+		CommitteeID: committeeID,
+		Delegator:   delegator,
+		Delegate:    delegate,
+		Expiration:  expiration,
+	}
+}
+
+// Validate validates VoteDelegation fields
+func (vd VoteDelegation) Validate() error {
+	if vd.Delegator.Empty() {
+		return fmt.Errorf("vote delegation delegator cannot be empty")
+	}
+	if vd.Delegate.Empty() {
+		return fmt.Errorf("vote delegation delegate cannot be empty")
+	}
+	if vd.Delegator.Equals(vd.Delegate) {
+		return fmt.Errorf("vote delegation delegator cannot be the delegate, %s", vd.Delegator)
+	}
+	return nil
+}
+
+// IsActiveAt returns whether the delegation has not expired by a given time.
+func (vd VoteDelegation) IsActiveAt(time time.Time) bool {
+	return time.Before(vd.Expiration)
+}
+
 // NewVote instantiates a new instance of Vote
 func NewVote(proposalID uint64, voter sdk.AccAddress, voteType VoteType) Vote {
 	return Vote{
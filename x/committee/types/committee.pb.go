@@ -59,7 +59,51 @@ func (x TallyOption) String() string {
 }
 
 func (TallyOption) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_a2549fd9d70ca349, []int{0}
+	return fileDescriptor_c873432765d1f05e, []int{0}
+}
+
+// TallySource enumerates the positions of token holders that can be counted in a token committee tally.
+type TallySource int32
+
+const (
+	// TALLY_SOURCE_UNSPECIFIED defines a null tally source.
+	TALLY_SOURCE_UNSPECIFIED TallySource = 0
+	// Tokens delegated to validators
+	TALLY_SOURCE_STAKED TallySource = 1
+	// x/liquid staking derivatives held in the account, valued at their staked tokens
+	TALLY_SOURCE_LIQUID TallySource = 2
+	// Deposits in x/savings
+	TALLY_SOURCE_SAVINGS TallySource = 3
+	// Deposits in x/earn vaults
+	TALLY_SOURCE_EARN TallySource = 4
+	// Deposits in x/hard minus borrows
+	TALLY_SOURCE_HARD TallySource = 5
+)
+
+var TallySource_name = map[int32]string{
+	0: "TALLY_SOURCE_UNSPECIFIED",
+	1: "TALLY_SOURCE_STAKED",
+	2: "TALLY_SOURCE_LIQUID",
+	3: "TALLY_SOURCE_SAVINGS",
+	4: "TALLY_SOURCE_EARN",
+	5: "TALLY_SOURCE_HARD",
+}
+
+var TallySource_value = map[string]int32{
+	"TALLY_SOURCE_UNSPECIFIED": 0,
+	"TALLY_SOURCE_STAKED":      1,
+	"TALLY_SOURCE_LIQUID":      2,
+	"TALLY_SOURCE_SAVINGS":     3,
+	"TALLY_SOURCE_EARN":        4,
+	"TALLY_SOURCE_HARD":        5,
+}
+
+func (x TallySource) String() string {
+	return proto.EnumName(TallySource_name, int32(x))
+}
+
+func (TallySource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c873432765d1f05e, []int{1}
 }
 
 // BaseCommittee is a common type shared by all Committees
@@ -73,12 +117,17 @@ type BaseCommittee struct {
 	// The length of time a proposal remains active for. Proposals will close earlier if they get enough votes.
 	ProposalDuration time.Duration `protobuf:"bytes,6,opt,name=proposal_duration,json=proposalDuration,proto3,stdduration" json:"proposal_duration"`
 	TallyOption      TallyOption   `protobuf:"varint,7,opt,name=tally_option,json=tallyOption,proto3,enum=fury.committee.v1beta1.TallyOption" json:"tally_option,omitempty"`
+	// The length of time a passed proposal is queued for before it is enacted, during which it can be vetoed.
+	// Proposals are enacted as soon as they pass if it is zero.
+	ExecutionDelay time.Duration `protobuf:"bytes,8,opt,name=execution_delay,json=executionDelay,proto3,stdduration" json:"execution_delay"`
+	// The committee that can veto queued proposals of this committee. Zero if there is no veto committee.
+	VetoCommitteeID uint64 `protobuf:"varint,9,opt,name=veto_committee_id,json=vetoCommitteeId,proto3" json:"veto_committee_id,omitempty"`
 }
 
 func (m *BaseCommittee) Reset()      { *m = BaseCommittee{} }
 func (*BaseCommittee) ProtoMessage() {}
 func (*BaseCommittee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a2549fd9d70ca349, []int{0}
+	return fileDescriptor_c873432765d1f05e, []int{0}
 }
 func (m *BaseCommittee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -115,7 +164,7 @@ type MemberCommittee struct {
 func (m *MemberCommittee) Reset()      { *m = MemberCommittee{} }
 func (*MemberCommittee) ProtoMessage() {}
 func (*MemberCommittee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a2549fd9d70ca349, []int{1}
+	return fileDescriptor_c873432765d1f05e, []int{1}
 }
 func (m *MemberCommittee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -149,12 +198,14 @@ type TokenCommittee struct {
 	*BaseCommittee `protobuf:"bytes,1,opt,name=base_committee,json=baseCommittee,proto3,embedded=base_committee" json:"base_committee,omitempty"`
 	Quorum         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=quorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quorum"`
 	TallyDenom     string                                 `protobuf:"bytes,3,opt,name=tally_denom,json=tallyDenom,proto3" json:"tally_denom,omitempty"`
+	// tally_sources are the positions counted in addition to the bank balance of the tally denom
+	TallySources []TallySource `protobuf:"varint,4,rep,packed,name=tally_sources,json=tallySources,proto3,enum=fury.committee.v1beta1.TallySource" json:"tally_sources,omitempty"`
 }
 
 func (m *TokenCommittee) Reset()      { *m = TokenCommittee{} }
 func (*TokenCommittee) ProtoMessage() {}
 func (*TokenCommittee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a2549fd9d70ca349, []int{2}
+	return fileDescriptor_c873432765d1f05e, []int{2}
 }
 func (m *TokenCommittee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -183,60 +234,156 @@ func (m *TokenCommittee) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TokenCommittee proto.InternalMessageInfo
 
+// WeightedMemberCommittee supports voting on proposals by members with set voting weights
+type WeightedMemberCommittee struct {
+	*BaseCommittee `protobuf:"bytes,1,opt,name=base_committee,json=baseCommittee,proto3,embedded=base_committee" json:"base_committee,omitempty"`
+	// The voting weight of each member. Every member has exactly one weight.
+	MemberWeights []MemberWeight `protobuf:"bytes,2,rep,name=member_weights,json=memberWeights,proto3" json:"member_weights"`
+}
+
+func (m *WeightedMemberCommittee) Reset()      { *m = WeightedMemberCommittee{} }
+func (*WeightedMemberCommittee) ProtoMessage() {}
+func (*WeightedMemberCommittee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c873432765d1f05e, []int{3}
+}
+func (m *WeightedMemberCommittee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedMemberCommittee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedMemberCommittee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedMemberCommittee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedMemberCommittee.Merge(m, src)
+}
+func (m *WeightedMemberCommittee) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedMemberCommittee) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedMemberCommittee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedMemberCommittee proto.InternalMessageInfo
+
+// MemberWeight is the voting weight of a member of a weighted member committee.
+type MemberWeight struct {
+	Member github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=member,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"member,omitempty"`
+	Weight github_com_cosmos_cosmos_sdk_types.Dec        `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *MemberWeight) Reset()         { *m = MemberWeight{} }
+func (m *MemberWeight) String() string { return proto.CompactTextString(m) }
+func (*MemberWeight) ProtoMessage()    {}
+func (*MemberWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c873432765d1f05e, []int{4}
+}
+func (m *MemberWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemberWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MemberWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MemberWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemberWeight.Merge(m, src)
+}
+func (m *MemberWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemberWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemberWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemberWeight proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("fury.committee.v1beta1.TallyOption", TallyOption_name, TallyOption_value)
+	proto.RegisterEnum("fury.committee.v1beta1.TallySource", TallySource_name, TallySource_value)
 	proto.RegisterType((*BaseCommittee)(nil), "fury.committee.v1beta1.BaseCommittee")
 	proto.RegisterType((*MemberCommittee)(nil), "fury.committee.v1beta1.MemberCommittee")
 	proto.RegisterType((*TokenCommittee)(nil), "fury.committee.v1beta1.TokenCommittee")
+	proto.RegisterType((*WeightedMemberCommittee)(nil), "fury.committee.v1beta1.WeightedMemberCommittee")
+	proto.RegisterType((*MemberWeight)(nil), "fury.committee.v1beta1.MemberWeight")
 }
 
 func init() {
-	proto.RegisterFile("fury/committee/v1beta1/committee.proto", fileDescriptor_a2549fd9d70ca349)
+	proto.RegisterFile("fury/committee/v1beta1/committee.proto", fileDescriptor_c873432765d1f05e)
 }
 
-var fileDescriptor_a2549fd9d70ca349 = []byte{
-	// 649 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xb6, 0x93, 0x90, 0xd2, 0x75, 0x1b, 0xd2, 0xa5, 0x54, 0x4e, 0x85, 0x6c, 0xab, 0x40, 0x15,
-	0x81, 0x62, 0xab, 0xe1, 0xc6, 0x2d, 0xae, 0x13, 0xd5, 0x52, 0x69, 0x22, 0xc7, 0x3d, 0xc0, 0xc5,
-	0xb2, 0xe3, 0x25, 0xb5, 0x1a, 0x67, 0x83, 0x77, 0x53, 0x35, 0x6f, 0xc0, 0x91, 0x63, 0x8f, 0x48,
-	0xbc, 0x42, 0x1f, 0xa2, 0xea, 0xa9, 0xe2, 0x84, 0x38, 0x84, 0x92, 0x3e, 0x05, 0x9c, 0x90, 0xff,
-	0x1a, 0x17, 0x8a, 0x04, 0x07, 0x4e, 0xde, 0xfd, 0xe6, 0x9b, 0x99, 0xfd, 0x66, 0x3e, 0x19, 0x6c,
-	0x1e, 0xda, 0x47, 0xb6, 0xd2, 0xc3, 0xbe, 0xef, 0x51, 0x8a, 0x90, 0x72, 0xb4, 0xe5, 0x20, 0x6a,
-	0x6f, 0xcd, 0x11, 0x79, 0x14, 0x60, 0x8a, 0xe1, 0x5a, 0xc8, 0x93, 0xe7, 0x68, 0xc2, 0x5b, 0xaf,
-	0xf4, 0x30, 0xf1, 0x31, 0xb1, 0x22, 0x96, 0x12, 0x5f, 0xe2, 0x94, 0xf5, 0xd5, 0x3e, 0xee, 0xe3,
-	0x18, 0x0f, 0x4f, 0x09, 0x5a, 0xe9, 0x63, 0xdc, 0x1f, 0x20, 0x25, 0xba, 0x39, 0xe3, 0x37, 0x8a,
-	0x3d, 0x9c, 0x24, 0x21, 0xe1, 0xd7, 0x90, 0x3b, 0x0e, 0x6c, 0xea, 0xe1, 0x61, 0x1c, 0xdf, 0xf8,
-	0x9e, 0x07, 0xcb, 0xaa, 0x4d, 0xd0, 0x76, 0xfa, 0x0a, 0xb8, 0x06, 0x72, 0x9e, 0xcb, 0xb3, 0x12,
-	0x5b, 0x2d, 0xa8, 0xc5, 0xd9, 0x54, 0xcc, 0xe9, 0x9a, 0x91, 0xf3, 0x5c, 0x28, 0x01, 0xce, 0x45,
-	0xa4, 0x17, 0x78, 0xa3, 0x30, 0x9d, 0xcf, 0x49, 0x6c, 0x75, 0xd1, 0xc8, 0x42, 0xd0, 0x01, 0x0b,
-	0x3e, 0xf2, 0x1d, 0x14, 0x10, 0x3e, 0x2f, 0xe5, 0xab, 0x4b, 0xea, 0xce, 0x8f, 0xa9, 0x58, 0xeb,
-	0x7b, 0xf4, 0x60, 0xec, 0x84, 0x32, 0x13, 0x29, 0xc9, 0xa7, 0x46, 0xdc, 0x43, 0x85, 0x4e, 0x46,
-	0x88, 0xc8, 0x8d, 0x5e, 0xaf, 0xe1, 0xba, 0x01, 0x22, 0xe4, 0xd3, 0x69, 0xed, 0x7e, 0x22, 0x38,
-	0x41, 0xd4, 0x09, 0x45, 0xc4, 0x48, 0x0b, 0xc3, 0x16, 0xe0, 0x46, 0x28, 0xf0, 0x3d, 0x42, 0x3c,
-	0x3c, 0x24, 0x7c, 0x41, 0xca, 0x57, 0xb9, 0xfa, 0xaa, 0x1c, 0xab, 0x94, 0x53, 0x95, 0x72, 0x63,
-	0x38, 0x51, 0x4b, 0xe7, 0xa7, 0x35, 0xd0, 0xb9, 0x26, 0x1b, 0xd9, 0x44, 0xb8, 0x0f, 0x4a, 0x47,
-	0x98, 0x22, 0x8b, 0x1e, 0x04, 0x88, 0x1c, 0xe0, 0x81, 0xcb, 0xdf, 0x09, 0x05, 0xa9, 0xf2, 0xd9,
-	0x54, 0x64, 0xbe, 0x4c, 0xc5, 0xcd, 0xbf, 0x78, 0xb6, 0x86, 0x7a, 0xc6, 0x72, 0x58, 0xc5, 0x4c,
-	0x8b, 0xc0, 0x0e, 0x58, 0x19, 0x05, 0x78, 0x84, 0x89, 0x3d, 0xb0, 0xd2, 0x49, 0xf3, 0x45, 0x89,
-	0xad, 0x72, 0xf5, 0xca, 0x6f, 0x8f, 0xd4, 0x12, 0x82, 0x7a, 0x37, 0x6c, 0x7a, 0xf2, 0x55, 0x64,
-	0x8d, 0x72, 0x9a, 0x9d, 0xc6, 0x60, 0x0b, 0x2c, 0x51, 0x7b, 0x30, 0x98, 0x58, 0x38, 0x9e, 0xfb,
-	0x82, 0xc4, 0x56, 0x4b, 0xf5, 0x47, 0xf2, 0xed, 0xde, 0x91, 0xcd, 0x90, 0xdb, 0x8e, 0xa8, 0x06,
-	0x47, 0xe7, 0x97, 0x17, 0x2b, 0x27, 0x1f, 0x44, 0xe6, 0xfc, 0xb4, 0xb6, 0x78, 0xbd, 0xe9, 0x8d,
-	0x63, 0x70, 0xef, 0x65, 0x34, 0xd6, 0xf9, 0xf2, 0x0d, 0x50, 0x72, 0x6c, 0x82, 0xac, 0xeb, 0xc2,
-	0x91, 0x11, 0xb8, 0xfa, 0x93, 0x3f, 0xf5, 0xbb, 0xe1, 0x1d, 0xb5, 0x70, 0x31, 0x15, 0x59, 0x63,
-	0xd9, 0xc9, 0x82, 0xb7, 0x75, 0xbe, 0x64, 0x41, 0xc9, 0xc4, 0x87, 0x68, 0xf8, 0x5f, 0x3b, 0xc3,
-	0x16, 0x28, 0xbe, 0x1d, 0xe3, 0x60, 0xec, 0xc7, 0x6e, 0xfd, 0xe7, 0xe5, 0x26, 0xd9, 0x50, 0x04,
-	0xf1, 0x28, 0x2d, 0x17, 0x0d, 0xb1, 0xcf, 0xe7, 0x23, 0xeb, 0x83, 0x08, 0xd2, 0x42, 0xe4, 0x16,
-	0x89, 0x4f, 0x03, 0xc0, 0x65, 0x76, 0x01, 0x1f, 0x02, 0xde, 0x6c, 0xec, 0xee, 0xbe, 0xb2, 0xda,
-	0x1d, 0x53, 0x6f, 0xef, 0x59, 0xfb, 0x7b, 0xdd, 0x4e, 0x73, 0x5b, 0x6f, 0xe9, 0x4d, 0xad, 0xcc,
-	0xc0, 0xc7, 0x40, 0xba, 0x11, 0x6d, 0xe9, 0x46, 0xd7, 0xb4, 0x3a, 0x8d, 0xae, 0x69, 0x99, 0x3b,
-	0x4d, 0xab, 0xd3, 0xee, 0x9a, 0x65, 0x16, 0x56, 0xc0, 0x83, 0x1b, 0x2c, 0xad, 0xd9, 0xd0, 0x76,
-	0xf5, 0xbd, 0x66, 0x39, 0xb7, 0x5e, 0x78, 0xf7, 0x51, 0x60, 0x54, 0xfd, 0xec, 0x9b, 0xc0, 0x9c,
-	0xcd, 0x04, 0xf6, 0x62, 0x26, 0xb0, 0x97, 0x33, 0x81, 0x7d, 0x7f, 0x25, 0x30, 0x17, 0x57, 0x02,
-	0xf3, 0xf9, 0x4a, 0x60, 0x5e, 0x3f, 0xcb, 0xa8, 0x0e, 0x67, 0x5a, 0x1b, 0xd8, 0x0e, 0x89, 0x4e,
-	0xca, 0x71, 0xe6, 0x6f, 0x15, 0xc9, 0x77, 0x8a, 0x91, 0x4b, 0x9f, 0xff, 0x0c, 0x00, 0x00, 0xff,
-	0xff, 0x0d, 0x0b, 0x28, 0x7d, 0xcc, 0x04, 0x00, 0x00,
+var fileDescriptor_c873432765d1f05e = []byte{
+	// 884 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x6f, 0xdb, 0xc6,
+	0x13, 0x15, 0x29, 0x59, 0x89, 0x57, 0x96, 0x4c, 0xaf, 0x9d, 0x84, 0x36, 0x7e, 0x20, 0x85, 0xfc,
+	0xd2, 0x40, 0x08, 0x20, 0x0a, 0x76, 0x6f, 0xbd, 0x14, 0xa4, 0x29, 0xd5, 0x44, 0x55, 0x49, 0xa1,
+	0xe8, 0x14, 0xed, 0x85, 0x15, 0xc9, 0x8d, 0x4c, 0x58, 0xd4, 0xaa, 0xdc, 0xa5, 0x63, 0x7d, 0x83,
+	0x1c, 0x7b, 0xcc, 0xb1, 0x40, 0x6f, 0xed, 0xd5, 0x87, 0x7e, 0x04, 0x23, 0x27, 0xa3, 0xbd, 0x14,
+	0x3d, 0xa8, 0xad, 0xfc, 0x2d, 0x7a, 0x2a, 0xc8, 0xa5, 0xfe, 0xc5, 0x0e, 0x1a, 0x04, 0x6d, 0x4f,
+	0xd2, 0xbe, 0x99, 0xb7, 0x33, 0x6f, 0xf8, 0x86, 0x04, 0x8f, 0x9f, 0x47, 0xe1, 0xb8, 0xe6, 0xe2,
+	0x20, 0xf0, 0x29, 0x45, 0xa8, 0x76, 0xb6, 0xef, 0x20, 0xda, 0xdb, 0x5f, 0x20, 0xca, 0x28, 0xc4,
+	0x14, 0xc3, 0xfb, 0x71, 0x9e, 0xb2, 0x40, 0xd3, 0xbc, 0xbd, 0x5d, 0x17, 0x93, 0x00, 0x13, 0x3b,
+	0xc9, 0xaa, 0xb1, 0x03, 0xa3, 0xec, 0xed, 0xf4, 0x71, 0x1f, 0x33, 0x3c, 0xfe, 0x97, 0xa2, 0xbb,
+	0x7d, 0x8c, 0xfb, 0x03, 0x54, 0x4b, 0x4e, 0x4e, 0xf4, 0xbc, 0xd6, 0x1b, 0x8e, 0xd3, 0x90, 0xf4,
+	0x66, 0xc8, 0x8b, 0xc2, 0x1e, 0xf5, 0xf1, 0x90, 0xc5, 0x1f, 0xbe, 0x5c, 0x03, 0x45, 0xad, 0x47,
+	0xd0, 0xe1, 0xac, 0x0b, 0x78, 0x1f, 0xf0, 0xbe, 0x27, 0x72, 0x65, 0xae, 0x92, 0xd3, 0xf2, 0xd3,
+	0x89, 0xcc, 0x1b, 0xba, 0xc9, 0xfb, 0x1e, 0x2c, 0x83, 0x82, 0x87, 0x88, 0x1b, 0xfa, 0xa3, 0x98,
+	0x2e, 0xf2, 0x65, 0xae, 0xb2, 0x6e, 0x2e, 0x43, 0xd0, 0x01, 0x77, 0x02, 0x14, 0x38, 0x28, 0x24,
+	0x62, 0xb6, 0x9c, 0xad, 0x6c, 0x68, 0x47, 0x7f, 0x4e, 0xe4, 0x6a, 0xdf, 0xa7, 0x27, 0x91, 0x13,
+	0xcb, 0x4c, 0xa5, 0xa4, 0x3f, 0x55, 0xe2, 0x9d, 0xd6, 0xe8, 0x78, 0x84, 0x88, 0xa2, 0xba, 0xae,
+	0xea, 0x79, 0x21, 0x22, 0xe4, 0xa7, 0x8b, 0xea, 0x76, 0x2a, 0x38, 0x45, 0xb4, 0x31, 0x45, 0xc4,
+	0x9c, 0x5d, 0x0c, 0x1b, 0xa0, 0x30, 0x42, 0x61, 0xe0, 0x13, 0xe2, 0xe3, 0x21, 0x11, 0x73, 0xe5,
+	0x6c, 0xa5, 0x70, 0xb0, 0xa3, 0x30, 0x95, 0xca, 0x4c, 0xa5, 0xa2, 0x0e, 0xc7, 0x5a, 0xe9, 0xf5,
+	0x45, 0x15, 0x74, 0xe6, 0xc9, 0xe6, 0x32, 0x11, 0x1e, 0x83, 0xd2, 0x19, 0xa6, 0xc8, 0xa6, 0x27,
+	0x21, 0x22, 0x27, 0x78, 0xe0, 0x89, 0x6b, 0xb1, 0x20, 0x4d, 0xb9, 0x9c, 0xc8, 0x99, 0x5f, 0x27,
+	0xf2, 0xe3, 0x77, 0x68, 0x5b, 0x47, 0xae, 0x59, 0x8c, 0x6f, 0xb1, 0x66, 0x97, 0xc0, 0x0e, 0xd8,
+	0x1a, 0x85, 0x78, 0x84, 0x49, 0x6f, 0x60, 0xcf, 0x26, 0x2d, 0xe6, 0xcb, 0x5c, 0xa5, 0x70, 0xb0,
+	0x7b, 0xa3, 0x49, 0x3d, 0x4d, 0xd0, 0xee, 0xc6, 0x45, 0x5f, 0xfd, 0x26, 0x73, 0xa6, 0x30, 0x63,
+	0xcf, 0x62, 0xb0, 0x01, 0x36, 0x68, 0x6f, 0x30, 0x18, 0xdb, 0x98, 0xcd, 0xfd, 0x4e, 0x99, 0xab,
+	0x94, 0x0e, 0xfe, 0xaf, 0xdc, 0xee, 0x1d, 0xc5, 0x8a, 0x73, 0xdb, 0x49, 0xaa, 0x59, 0xa0, 0x8b,
+	0x03, 0x6c, 0x82, 0x4d, 0x74, 0x8e, 0xdc, 0x28, 0x3e, 0xd8, 0x1e, 0x1a, 0xf4, 0xc6, 0xe2, 0xdd,
+	0x77, 0xef, 0xab, 0x34, 0xe7, 0xea, 0x31, 0x15, 0x7e, 0x0c, 0xb6, 0xce, 0x10, 0xc5, 0xf6, 0xbc,
+	0x01, 0xdb, 0xf7, 0xc4, 0xf5, 0xc4, 0x33, 0xdb, 0xd3, 0x89, 0xbc, 0xf9, 0x0c, 0x51, 0x3c, 0xb7,
+	0x94, 0xa1, 0x9b, 0x9b, 0x67, 0x2b, 0x80, 0xf7, 0xd1, 0xd6, 0xab, 0x6f, 0xe5, 0xcc, 0xeb, 0x8b,
+	0xea, 0xfa, 0x1c, 0x7c, 0x78, 0x0e, 0x36, 0x3f, 0x4b, 0x9e, 0xf2, 0xc2, 0x8b, 0x26, 0x28, 0x39,
+	0x3d, 0x82, 0x16, 0x65, 0x12, 0x5f, 0x16, 0x0e, 0x3e, 0x78, 0x9b, 0xfc, 0x15, 0x2b, 0x6b, 0xb9,
+	0xab, 0x89, 0xcc, 0x99, 0x45, 0x67, 0x19, 0xbc, 0xad, 0xf2, 0xf7, 0x3c, 0x28, 0x59, 0xf8, 0x14,
+	0x0d, 0xff, 0xd5, 0xca, 0xb0, 0x01, 0xf2, 0x5f, 0x47, 0x38, 0x8c, 0x02, 0x91, 0x7f, 0x2f, 0xaf,
+	0xa5, 0x6c, 0x28, 0x03, 0xf6, 0x64, 0x6d, 0x0f, 0x0d, 0x71, 0x20, 0x66, 0x93, 0x4d, 0x04, 0x09,
+	0xa4, 0xc7, 0x08, 0x3c, 0x02, 0x45, 0x96, 0x40, 0x70, 0x14, 0xba, 0x88, 0xad, 0xc9, 0xdf, 0x99,
+	0xa6, 0x9b, 0xe4, 0x9a, 0x1b, 0x74, 0x71, 0x20, 0xb7, 0x0d, 0xeb, 0x67, 0x0e, 0x3c, 0xf8, 0x1c,
+	0xf9, 0xfd, 0x13, 0x8a, 0xbc, 0xff, 0xe0, 0x79, 0xc1, 0xa7, 0xa0, 0xc4, 0x96, 0xdf, 0x7e, 0x91,
+	0x54, 0x25, 0x22, 0x9f, 0x2c, 0xfd, 0xa3, 0xb7, 0xdd, 0xc9, 0x9a, 0x62, 0x2d, 0x6a, 0xb9, 0x78,
+	0xc6, 0x66, 0x31, 0x58, 0xc2, 0x6e, 0x55, 0xf5, 0x23, 0x07, 0x36, 0x96, 0x89, 0xf0, 0x2b, 0x90,
+	0x67, 0xa4, 0x44, 0xc2, 0x3f, 0xf9, 0x2e, 0x4b, 0xef, 0x8d, 0xed, 0xc0, 0x14, 0xbd, 0xaf, 0x1d,
+	0x18, 0xfb, 0x49, 0x08, 0x0a, 0x4b, 0x5b, 0x0f, 0xff, 0x07, 0x44, 0x4b, 0x6d, 0x36, 0xbf, 0xb0,
+	0xdb, 0x1d, 0xcb, 0x68, 0xb7, 0xec, 0xe3, 0x56, 0xb7, 0x53, 0x3f, 0x34, 0x1a, 0x46, 0x5d, 0x17,
+	0x32, 0xf0, 0x11, 0x28, 0xaf, 0x44, 0x1b, 0x86, 0xd9, 0xb5, 0xec, 0x8e, 0xda, 0xb5, 0x6c, 0xeb,
+	0xa8, 0x6e, 0x77, 0xda, 0x5d, 0x4b, 0xe0, 0xe0, 0x2e, 0xb8, 0xb7, 0x92, 0xa5, 0xd7, 0x55, 0xbd,
+	0x69, 0xb4, 0xea, 0x02, 0xbf, 0x97, 0x7b, 0xf9, 0x9d, 0x94, 0x79, 0xf2, 0x03, 0x97, 0x16, 0x65,
+	0x46, 0x59, 0x14, 0xed, 0xb6, 0x8f, 0xcd, 0xc3, 0xfa, 0x1b, 0x45, 0x1f, 0x80, 0xed, 0x95, 0x68,
+	0xd7, 0x52, 0x3f, 0xad, 0xeb, 0x02, 0x77, 0x23, 0xd0, 0x34, 0x9e, 0x1e, 0x1b, 0xba, 0xc0, 0x43,
+	0x11, 0xec, 0xac, 0x32, 0xd4, 0x67, 0x46, 0xeb, 0x93, 0xae, 0x90, 0x85, 0xf7, 0xc0, 0xd6, 0x4a,
+	0xa4, 0xae, 0x9a, 0x2d, 0x21, 0x77, 0x03, 0x3e, 0x52, 0x4d, 0x5d, 0x58, 0x63, 0xdd, 0x6a, 0xed,
+	0xcb, 0x3f, 0xa4, 0xcc, 0xe5, 0x54, 0xe2, 0xae, 0xa6, 0x12, 0xf7, 0xfb, 0x54, 0xe2, 0xbe, 0xb9,
+	0x96, 0x32, 0x57, 0xd7, 0x52, 0xe6, 0x97, 0x6b, 0x29, 0xf3, 0xe5, 0xfe, 0xd2, 0xbc, 0xfd, 0xa1,
+	0x1b, 0x39, 0x11, 0xa9, 0x0e, 0x11, 0x7d, 0x81, 0xc3, 0xd3, 0x5a, 0xf2, 0x25, 0x3f, 0x5f, 0xfa,
+	0x96, 0x27, 0xe3, 0x77, 0xf2, 0xc9, 0xbb, 0xf2, 0xc3, 0xbf, 0x06, 0x00, 0xe3, 0xa7, 0xfd, 0xd9,
+	0xea, 0x07, 0x00, 0x00,
 }
 
 func (m *BaseCommittee) Marshal() (dAtA []byte, err error) {
@@ -259,18 +406,31 @@ func (m *BaseCommittee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.TallyOption != 0 {
-		i = encodeVarintCommittee(dAtA, i, uint64(m.TallyOption))
+	if m.VetoCommitteeID != 0 {
+		i = encodeVarintCommittee(dAtA, i, uint64(m.VetoCommitteeID))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x48
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ProposalDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ProposalDuration):])
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ExecutionDelay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExecutionDelay):])
 	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintCommittee(dAtA, i, uint64(n1))
 	i--
+	dAtA[i] = 0x42
+	if m.TallyOption != 0 {
+		i = encodeVarintCommittee(dAtA, i, uint64(m.TallyOption))
+		i--
+		dAtA[i] = 0x38
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ProposalDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ProposalDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintCommittee(dAtA, i, uint64(n2))
+	i--
 	dAtA[i] = 0x32
 	{
 		size := m.VoteThreshold.Size()
@@ -375,6 +535,24 @@ func (m *TokenCommittee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TallySources) > 0 {
+		dAtA5 := make([]byte, len(m.TallySources)*10)
+		var j4 int
+		for _, num := range m.TallySources {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintCommittee(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.TallyDenom) > 0 {
 		i -= len(m.TallyDenom)
 		copy(dAtA[i:], m.TallyDenom)
@@ -407,6 +585,95 @@ func (m *TokenCommittee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WeightedMemberCommittee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedMemberCommittee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedMemberCommittee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MemberWeights) > 0 {
+		for iNdEx := len(m.MemberWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MemberWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommittee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BaseCommittee != nil {
+		{
+			size, err := m.BaseCommittee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommittee(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MemberWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemberWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemberWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCommittee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintCommittee(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCommittee(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCommittee(v)
 	base := offset
@@ -450,6 +717,11 @@ func (m *BaseCommittee) Size() (n int) {
 	if m.TallyOption != 0 {
 		n += 1 + sovCommittee(uint64(m.TallyOption))
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExecutionDelay)
+	n += 1 + l + sovCommittee(uint64(l))
+	if m.VetoCommitteeID != 0 {
+		n += 1 + sovCommittee(uint64(m.VetoCommitteeID))
+	}
 	return n
 }
 
@@ -482,6 +754,47 @@ func (m *TokenCommittee) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCommittee(uint64(l))
 	}
+	if len(m.TallySources) > 0 {
+		l = 0
+		for _, e := range m.TallySources {
+			l += sovCommittee(uint64(e))
+		}
+		n += 1 + sovCommittee(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *WeightedMemberCommittee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseCommittee != nil {
+		l = m.BaseCommittee.Size()
+		n += 1 + l + sovCommittee(uint64(l))
+	}
+	if len(m.MemberWeights) > 0 {
+		for _, e := range m.MemberWeights {
+			l = e.Size()
+			n += 1 + l + sovCommittee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MemberWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovCommittee(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovCommittee(uint64(l))
 	return n
 }
 
@@ -723,6 +1036,58 @@ func (m *BaseCommittee) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ExecutionDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoCommitteeID", wireType)
+			}
+			m.VetoCommitteeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VetoCommitteeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommittee(dAtA[iNdEx:])
@@ -961,6 +1326,313 @@ func (m *TokenCommittee) Unmarshal(dAtA []byte) error {
 			}
 			m.TallyDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v TallySource
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCommittee
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= TallySource(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TallySources = append(m.TallySources, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCommittee
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCommittee
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCommittee
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.TallySources) == 0 {
+					m.TallySources = make([]TallySource, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v TallySource
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommittee
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= TallySource(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TallySources = append(m.TallySources, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallySources", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommittee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightedMemberCommittee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommittee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedMemberCommittee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedMemberCommittee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseCommittee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseCommittee == nil {
+				m.BaseCommittee = &BaseCommittee{}
+			}
+			if err := m.BaseCommittee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberWeights = append(m.MemberWeights, MemberWeight{})
+			if err := m.MemberWeights[len(m.MemberWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommittee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemberWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommittee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemberWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemberWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = append(m.Member[:0], dAtA[iNdEx:postIndex]...)
+			if m.Member == nil {
+				m.Member = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommittee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommittee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommittee(dAtA[iNdEx:])
@@ -184,6 +184,67 @@ func TestBaseCommittee(t *testing.T) {
 			},
 			expectPass: false,
 		},
+		{
+			name: "execution delay and veto committee",
+			createCommittee: func() (*types.MemberCommittee, error) {
+				committee, err := types.NewMemberCommittee(
+					1,
+					"This base committee is for testing.",
+					addresses[:3],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+				)
+				if err != nil {
+					return nil, err
+				}
+				committee.SetExecutionDelay(time.Hour * 24)
+				committee.SetVetoCommitteeID(2)
+				return committee, nil
+			},
+			expectPass: true,
+		},
+		{
+			name: "negative execution delay",
+			createCommittee: func() (*types.MemberCommittee, error) {
+				committee, err := types.NewMemberCommittee(
+					1,
+					"This base committee is for testing.",
+					addresses[:3],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+				)
+				if err != nil {
+					return nil, err
+				}
+				committee.SetExecutionDelay(-time.Hour)
+				return committee, nil
+			},
+			expectPass: false,
+		},
+		{
+			name: "own veto committee",
+			createCommittee: func() (*types.MemberCommittee, error) {
+				committee, err := types.NewMemberCommittee(
+					1,
+					"This base committee is for testing.",
+					addresses[:3],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+				)
+				if err != nil {
+					return nil, err
+				}
+				committee.SetVetoCommitteeID(1)
+				return committee, nil
+			},
+			expectPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -248,6 +309,124 @@ func TestMemberCommittee(t *testing.T) {
 	}
 }
 
+// TestWeightedMemberCommittee tests unique WeightedMemberCommittee functionality
+func TestWeightedMemberCommittee(t *testing.T) {
+	addresses := []sdk.AccAddress{
+		sdk.AccAddress(crypto.AddressHash([]byte("WeightedMember1"))),
+		sdk.AccAddress(crypto.AddressHash([]byte("WeightedMember2"))),
+		sdk.AccAddress(crypto.AddressHash([]byte("WeightedMember3"))),
+	}
+
+	testCases := []struct {
+		name          string
+		memberWeights []types.MemberWeight
+		setMembers    func(committee *types.WeightedMemberCommittee)
+		expectPass    bool
+	}{
+		{
+			name: "normal",
+			memberWeights: []types.MemberWeight{
+				types.NewMemberWeight(addresses[0], testutil.D("3")),
+				types.NewMemberWeight(addresses[1], testutil.D("1.5")),
+			},
+			expectPass: true,
+		},
+		{
+			name: "zero weight",
+			memberWeights: []types.MemberWeight{
+				types.NewMemberWeight(addresses[0], testutil.D("3")),
+				types.NewMemberWeight(addresses[1], testutil.D("0")),
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative weight",
+			memberWeights: []types.MemberWeight{
+				types.NewMemberWeight(addresses[0], testutil.D("-1")),
+			},
+			expectPass: false,
+		},
+		{
+			name: "nil weight",
+			memberWeights: []types.MemberWeight{
+				{Member: addresses[0]},
+			},
+			expectPass: false,
+		},
+		{
+			name: "duplicate member",
+			memberWeights: []types.MemberWeight{
+				types.NewMemberWeight(addresses[0], testutil.D("3")),
+				types.NewMemberWeight(addresses[0], testutil.D("1")),
+			},
+			expectPass: false,
+		},
+		{
+			name: "weights do not match members",
+			memberWeights: []types.MemberWeight{
+				types.NewMemberWeight(addresses[0], testutil.D("3")),
+			},
+			setMembers: func(committee *types.WeightedMemberCommittee) {
+				committee.BaseCommittee.SetMembers(addresses[1:2])
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			committee, err := types.NewWeightedMemberCommittee(
+				1,
+				"This weighted member committee is for testing.",
+				tc.memberWeights,
+				[]types.Permission{&types.GodPermission{}},
+				testutil.D("0.667"),
+				time.Hour*24*7,
+				types.TALLY_OPTION_FIRST_PAST_THE_POST,
+			)
+			require.NoError(t, err)
+			require.Equal(t, types.WeightedMemberCommitteeType, committee.GetType())
+			if tc.setMembers != nil {
+				tc.setMembers(committee)
+			}
+
+			err = committee.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	t.Run("weights", func(t *testing.T) {
+		committee := types.MustNewWeightedMemberCommittee(
+			1,
+			"This weighted member committee is for testing.",
+			[]types.MemberWeight{
+				types.NewMemberWeight(addresses[0], testutil.D("3")),
+				types.NewMemberWeight(addresses[1], testutil.D("1.5")),
+			},
+			[]types.Permission{&types.GodPermission{}},
+			testutil.D("0.667"),
+			time.Hour*24*7,
+			types.TALLY_OPTION_FIRST_PAST_THE_POST,
+		)
+		require.Equal(t, testutil.D("4.5"), committee.GetTotalWeight())
+		require.Equal(t, testutil.D("1.5"), committee.GetMemberWeight(addresses[1]))
+		require.Equal(t, testutil.D("0"), committee.GetMemberWeight(addresses[2]))
+
+		// setting members keeps the weights of existing members and gives new members a weight of one
+		committee.SetMembers([]sdk.AccAddress{addresses[1], addresses[2]})
+		require.Equal(t, []types.MemberWeight{
+			types.NewMemberWeight(addresses[1], testutil.D("1.5")),
+			types.NewMemberWeight(addresses[2], testutil.D("1")),
+		}, committee.MemberWeights)
+		require.Equal(t, []sdk.AccAddress{addresses[1], addresses[2]}, committee.GetMembers())
+		require.NoError(t, committee.Validate())
+	})
+}
+
 // TestTokenCommittee tests unique TokenCommittee functionality
 func TestTokenCommittee(t *testing.T) {
 	addresses := []sdk.AccAddress{
@@ -346,6 +525,120 @@ func TestTokenCommittee(t *testing.T) {
 			},
 			expectPass: false,
 		},
+		{
+			name: "bond denom with staked source",
+			createCommittee: func() (*types.TokenCommittee, error) {
+				committee, err := types.NewTokenCommittee(
+					1,
+					"This token committee is for testing.",
+					addresses[:3],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+					testutil.D("0.4"),
+					types.BondDenom,
+				)
+				committee.TallySources = []types.TallySource{types.TALLY_SOURCE_STAKED, types.TALLY_SOURCE_LIQUID, types.TALLY_SOURCE_EARN}
+				return committee, err
+			},
+			expectPass: true,
+		},
+		{
+			name: "bond denom without staked source",
+			createCommittee: func() (*types.TokenCommittee, error) {
+				committee, err := types.NewTokenCommittee(
+					1,
+					"This token committee is for testing.",
+					addresses[:3],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+					testutil.D("0.4"),
+					types.BondDenom,
+				)
+				committee.TallySources = []types.TallySource{types.TALLY_SOURCE_LIQUID, types.TALLY_SOURCE_EARN}
+				return committee, err
+			},
+			expectPass: false,
+		},
+		{
+			name: "deposit sources",
+			createCommittee: func() (*types.TokenCommittee, error) {
+				committee, err := types.NewTokenCommittee(
+					1,
+					"This token committee is for testing.",
+					addresses[:3],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+					testutil.D("0.4"),
+					"hard",
+				)
+				committee.TallySources = []types.TallySource{types.TALLY_SOURCE_SAVINGS, types.TALLY_SOURCE_EARN, types.TALLY_SOURCE_HARD}
+				return committee, err
+			},
+			expectPass: true,
+		},
+		{
+			name: "staked source without bond denom",
+			createCommittee: func() (*types.TokenCommittee, error) {
+				committee, err := types.NewTokenCommittee(
+					1,
+					"This token committee is for testing.",
+					addresses[:3],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+					testutil.D("0.4"),
+					"hard",
+				)
+				committee.TallySources = []types.TallySource{types.TALLY_SOURCE_STAKED}
+				return committee, err
+			},
+			expectPass: false,
+		},
+		{
+			name: "unspecified tally source",
+			createCommittee: func() (*types.TokenCommittee, error) {
+				committee, err := types.NewTokenCommittee(
+					1,
+					"This token committee is for testing.",
+					addresses[:3],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+					testutil.D("0.4"),
+					"hard",
+				)
+				committee.TallySources = []types.TallySource{types.TALLY_SOURCE_UNSPECIFIED}
+				return committee, err
+			},
+			expectPass: false,
+		},
+		{
+			name: "duplicate tally source",
+			createCommittee: func() (*types.TokenCommittee, error) {
+				committee, err := types.NewTokenCommittee(
+					1,
+					"This token committee is for testing.",
+					addresses[:3],
+					[]types.Permission{&types.GodPermission{}},
+					testutil.D("0.667"),
+					time.Hour*24*7,
+					types.TALLY_OPTION_FIRST_PAST_THE_POST,
+					testutil.D("0.4"),
+					"hard",
+				)
+				committee.TallySources = []types.TallySource{types.TALLY_SOURCE_HARD, types.TALLY_SOURCE_HARD}
+				return committee, err
+			},
+			expectPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -14,4 +14,6 @@ var (
 	ErrUnknownSubspace         = errorsmod.Register(ModuleName, 10, "subspace not found")
 	ErrInvalidVoteType         = errorsmod.Register(ModuleName, 11, "invalid vote type")
 	ErrNotFoundProposalTally   = errorsmod.Register(ModuleName, 12, "proposal tally not found")
+	ErrInvalidVoteDelegation   = errorsmod.Register(ModuleName, 13, "invalid vote delegation")
+	ErrUnknownVoteDelegation   = errorsmod.Register(ModuleName, 14, "vote delegation not found")
 )
@@ -2,9 +2,14 @@ package types
 
 // Module event types
 const (
-	EventTypeProposalSubmit = "proposal_submit"
-	EventTypeProposalClose  = "proposal_close"
-	EventTypeProposalVote   = "proposal_vote"
+	EventTypeProposalSubmit      = "proposal_submit"
+	EventTypeProposalClose       = "proposal_close"
+	EventTypeProposalVote        = "proposal_vote"
+	EventTypeProposalQueue       = "proposal_queue"
+	EventTypeQueuedProposalClose = "queued_proposal_close"
+	EventTypeMembershipChange    = "committee_membership_change"
+	EventTypeVoteDelegate        = "vote_delegate"
+	EventTypeVoteDelegationEnd   = "vote_delegation_end"
 
 	AttributeValueCategory          = "committee"
 	AttributeKeyCommitteeID         = "committee_id"
@@ -15,4 +20,11 @@ const (
 	AttributeKeyVote                = "vote"
 	AttributeKeyProposalOutcome     = "proposal_outcome"
 	AttributeKeyProposalTally       = "proposal_tally"
+	AttributeKeyExecutionTime       = "execution_time"
+	AttributeKeyMembershipChange    = "membership_change"
+	AttributeKeyMember              = "member"
+	AttributeKeyVoteThreshold       = "vote_threshold"
+	AttributeKeyDelegator           = "delegator"
+	AttributeKeyDelegate            = "delegate"
+	AttributeKeyExpiration          = "expiration"
 )
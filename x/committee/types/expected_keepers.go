@@ -1,9 +1,15 @@
 package types
 
 import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	earntypes "github.com/incubus-network/fury/x/earn/types"
+	hardtypes "github.com/incubus-network/fury/x/hard/types"
+	savingstypes "github.com/incubus-network/fury/x/savings/types"
 )
 
 type ParamKeeper interface {
@@ -19,4 +25,39 @@ type AccountKeeper interface {
 type BankKeeper interface {
 	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// StakingKeeper defines the expected staking keeper used to count staked token committee votes
+type StakingKeeper interface {
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+	IterateDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress, cb func(delegation stakingtypes.Delegation) (stop bool))
+}
+
+// LiquidKeeper defines the expected liquid keeper used to value staking derivatives in token committee votes
+type LiquidKeeper interface {
+	IsDerivativeDenom(ctx sdk.Context, denom string) bool
+	GetStakedTokensForDerivatives(ctx sdk.Context, coins sdk.Coins) (sdk.Coin, error)
+}
+
+// SavingsKeeper defines the expected savings keeper used to count deposits in token committee votes
+type SavingsKeeper interface {
+	GetDeposit(ctx sdk.Context, depositor sdk.AccAddress) (savingstypes.Deposit, bool)
+}
+
+// EarnKeeper defines the expected earn keeper used to count vault deposits in token committee votes
+type EarnKeeper interface {
+	GetVaultAccountShares(ctx sdk.Context, acc sdk.AccAddress) (shares earntypes.VaultShares, found bool)
+	ConvertToAssets(ctx sdk.Context, share earntypes.VaultShare) (sdk.Coin, error)
+}
+
+// HardKeeper defines the expected hard keeper used to count deposits net of borrows in token committee votes
+type HardKeeper interface {
+	GetSyncedDeposit(ctx sdk.Context, depositor sdk.AccAddress) (hardtypes.Deposit, bool)
+	GetSyncedBorrow(ctx sdk.Context, borrower sdk.AccAddress) (hardtypes.Borrow, bool)
+}
+
+// MsgRouter defines the expected msg service router used to execute the messages of proposals
+type MsgRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
 }
@@ -56,7 +56,7 @@ func (data GenesisState) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
 			return err
 		}
 	}
-	return nil
+	return data.QueuedProposals.UnpackInterfaces(unpacker)
 }
 
 // Validate performs basic validation of genesis data.
@@ -80,6 +80,13 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	// check veto committees exist
+	for _, com := range committees {
+		if com.GetVetoCommitteeID() != 0 && !committeeMap[com.GetVetoCommitteeID()] {
+			return fmt.Errorf("committee %d refers to non existent veto committee; committee id: %d", com.GetID(), com.GetVetoCommitteeID())
+		}
+	}
+
 	// validate proposals
 	proposalMap := make(map[uint64]bool, len(gs.Proposals))
 	for _, p := range gs.Proposals {
@@ -105,6 +112,64 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	// validate queued proposals
+	queuedProposalMap := make(map[uint64]bool, len(gs.QueuedProposals))
+	for _, qp := range gs.QueuedProposals {
+		// check there are no duplicate IDs, including the IDs of proposals being voted on
+		if proposalMap[qp.Proposal.ID] || queuedProposalMap[qp.Proposal.ID] {
+			return fmt.Errorf("duplicate proposal ID found in genesis state; id: %d", qp.Proposal.ID)
+		}
+		queuedProposalMap[qp.Proposal.ID] = true
+
+		if qp.Proposal.ID >= gs.NextProposalID {
+			return fmt.Errorf("NextProposalID is not greater than all proposal IDs; id: %d", qp.Proposal.ID)
+		}
+
+		if err := qp.Proposal.ValidateBasic(); err != nil {
+			return fmt.Errorf("queued proposal %d invalid: %w", qp.Proposal.ID, err)
+		}
+	}
+
+	// validate membership changes
+	type membershipChangeKey struct{ committeeID, sequence uint64 }
+	membershipChangeMap := make(map[membershipChangeKey]bool, len(gs.MembershipChanges))
+	for _, mc := range gs.MembershipChanges {
+		// check there are no duplicate sequences for a committee
+		key := membershipChangeKey{mc.CommitteeID, mc.Sequence}
+		if membershipChangeMap[key] {
+			return fmt.Errorf("duplicate membership change found in genesis state; committee id: %d, sequence: %d", mc.CommitteeID, mc.Sequence)
+		}
+		membershipChangeMap[key] = true
+
+		if err := mc.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// validate vote delegations
+	type voteDelegationKey struct {
+		committeeID uint64
+		delegator   string
+	}
+	voteDelegationMap := make(map[voteDelegationKey]bool, len(gs.VoteDelegations))
+	for _, vd := range gs.VoteDelegations {
+		// check there is at most one delegation for each member of a committee
+		key := voteDelegationKey{vd.CommitteeID, vd.Delegator.String()}
+		if voteDelegationMap[key] {
+			return fmt.Errorf("duplicate vote delegation found in genesis state; committee id: %d, delegator: %s", vd.CommitteeID, vd.Delegator)
+		}
+		voteDelegationMap[key] = true
+
+		// check committee exists
+		if !committeeMap[vd.CommitteeID] {
+			return fmt.Errorf("vote delegation refers to non existent committee; committee id: %d", vd.CommitteeID)
+		}
+
+		if err := vd.Validate(); err != nil {
+			return err
+		}
+	}
+
 	// validate votes
 	for _, v := range gs.Votes {
 		// validate committee
@@ -30,6 +30,42 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MembershipChangeType enumerates the valid types of a membership change.
+type MembershipChangeType int32
+
+const (
+	// MEMBERSHIP_CHANGE_TYPE_UNSPECIFIED defines an invalid membership change.
+	MEMBERSHIP_CHANGE_TYPE_UNSPECIFIED MembershipChangeType = 0
+	// MEMBERSHIP_CHANGE_TYPE_ADD_MEMBER defines a member being added.
+	MEMBERSHIP_CHANGE_TYPE_ADD_MEMBER MembershipChangeType = 1
+	// MEMBERSHIP_CHANGE_TYPE_REMOVE_MEMBER defines a member being removed.
+	MEMBERSHIP_CHANGE_TYPE_REMOVE_MEMBER MembershipChangeType = 2
+	// MEMBERSHIP_CHANGE_TYPE_CHANGE_VOTE_THRESHOLD defines the vote threshold being changed.
+	MEMBERSHIP_CHANGE_TYPE_CHANGE_VOTE_THRESHOLD MembershipChangeType = 3
+)
+
+var MembershipChangeType_name = map[int32]string{
+	0: "MEMBERSHIP_CHANGE_TYPE_UNSPECIFIED",
+	1: "MEMBERSHIP_CHANGE_TYPE_ADD_MEMBER",
+	2: "MEMBERSHIP_CHANGE_TYPE_REMOVE_MEMBER",
+	3: "MEMBERSHIP_CHANGE_TYPE_CHANGE_VOTE_THRESHOLD",
+}
+
+var MembershipChangeType_value = map[string]int32{
+	"MEMBERSHIP_CHANGE_TYPE_UNSPECIFIED":           0,
+	"MEMBERSHIP_CHANGE_TYPE_ADD_MEMBER":            1,
+	"MEMBERSHIP_CHANGE_TYPE_REMOVE_MEMBER":         2,
+	"MEMBERSHIP_CHANGE_TYPE_CHANGE_VOTE_THRESHOLD": 3,
+}
+
+func (x MembershipChangeType) String() string {
+	return proto.EnumName(MembershipChangeType_name, int32(x))
+}
+
+func (MembershipChangeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_34c8b9a6a80ac26b, []int{0}
+}
+
 // VoteType enumerates the valid types of a vote.
 type VoteType int32
 
@@ -63,22 +99,25 @@ func (x VoteType) String() string {
 }
 
 func (VoteType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_919b27ac60d8c5fd, []int{0}
+	return fileDescriptor_34c8b9a6a80ac26b, []int{1}
 }
 
 // GenesisState defines the committee module's genesis state.
 type GenesisState struct {
-	NextProposalID uint64       `protobuf:"varint,1,opt,name=next_proposal_id,json=nextProposalId,proto3" json:"next_proposal_id,omitempty"`
-	Committees     []*types.Any `protobuf:"bytes,2,rep,name=committees,proto3" json:"committees,omitempty"`
-	Proposals      Proposals    `protobuf:"bytes,3,rep,name=proposals,proto3,castrepeated=Proposals" json:"proposals"`
-	Votes          []Vote       `protobuf:"bytes,4,rep,name=votes,proto3" json:"votes"`
+	NextProposalID    uint64             `protobuf:"varint,1,opt,name=next_proposal_id,json=nextProposalId,proto3" json:"next_proposal_id,omitempty"`
+	Committees        []*types.Any       `protobuf:"bytes,2,rep,name=committees,proto3" json:"committees,omitempty"`
+	Proposals         Proposals          `protobuf:"bytes,3,rep,name=proposals,proto3,castrepeated=Proposals" json:"proposals"`
+	Votes             []Vote             `protobuf:"bytes,4,rep,name=votes,proto3" json:"votes"`
+	QueuedProposals   QueuedProposals    `protobuf:"bytes,5,rep,name=queued_proposals,json=queuedProposals,proto3,castrepeated=QueuedProposals" json:"queued_proposals"`
+	MembershipChanges []MembershipChange `protobuf:"bytes,6,rep,name=membership_changes,json=membershipChanges,proto3" json:"membership_changes"`
+	VoteDelegations   []VoteDelegation   `protobuf:"bytes,7,rep,name=vote_delegations,json=voteDelegations,proto3" json:"vote_delegations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_919b27ac60d8c5fd, []int{0}
+	return fileDescriptor_34c8b9a6a80ac26b, []int{0}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -118,7 +157,7 @@ type Proposal struct {
 func (m *Proposal) Reset()      { *m = Proposal{} }
 func (*Proposal) ProtoMessage() {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_919b27ac60d8c5fd, []int{1}
+	return fileDescriptor_34c8b9a6a80ac26b, []int{1}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -147,6 +186,45 @@ func (m *Proposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Proposal proto.InternalMessageInfo
 
+// QueuedProposal is an internal record of a passed proposal waiting for the execution delay of its committee to end.
+type QueuedProposal struct {
+	Proposal      Proposal  `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal"`
+	ExecutionTime time.Time `protobuf:"bytes,2,opt,name=execution_time,json=executionTime,proto3,stdtime" json:"execution_time"`
+}
+
+func (m *QueuedProposal) Reset()         { *m = QueuedProposal{} }
+func (m *QueuedProposal) String() string { return proto.CompactTextString(m) }
+func (*QueuedProposal) ProtoMessage()    {}
+func (*QueuedProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34c8b9a6a80ac26b, []int{2}
+}
+func (m *QueuedProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedProposal.Merge(m, src)
+}
+func (m *QueuedProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedProposal proto.InternalMessageInfo
+
 // Vote is an internal record of a single governance vote.
 type Vote struct {
 	ProposalID uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
@@ -158,7 +236,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_919b27ac60d8c5fd, []int{2}
+	return fileDescriptor_34c8b9a6a80ac26b, []int{3}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -187,60 +265,177 @@ func (m *Vote) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Vote proto.InternalMessageInfo
 
+// VoteDelegation is a record of a committee member letting another address vote in their place until an expiration time.
+type VoteDelegation struct {
+	CommitteeID uint64                                        `protobuf:"varint,1,opt,name=committee_id,json=committeeId,proto3" json:"committee_id,omitempty"`
+	Delegator   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=delegator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator,omitempty"`
+	Delegate    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=delegate,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegate,omitempty"`
+	Expiration  time.Time                                     `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration"`
+}
+
+func (m *VoteDelegation) Reset()         { *m = VoteDelegation{} }
+func (m *VoteDelegation) String() string { return proto.CompactTextString(m) }
+func (*VoteDelegation) ProtoMessage()    {}
+func (*VoteDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34c8b9a6a80ac26b, []int{4}
+}
+func (m *VoteDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteDelegation.Merge(m, src)
+}
+func (m *VoteDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteDelegation proto.InternalMessageInfo
+
+// MembershipChange is a record of a change a committee made to its own members or vote threshold.
+type MembershipChange struct {
+	CommitteeID uint64 `protobuf:"varint,1,opt,name=committee_id,json=committeeId,proto3" json:"committee_id,omitempty"`
+	// The sequence number of the change among the changes of the committee.
+	Sequence uint64               `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Type     MembershipChangeType `protobuf:"varint,3,opt,name=type,proto3,enum=fury.committee.v1beta1.MembershipChangeType" json:"type,omitempty"`
+	// The member added or removed. Empty for vote threshold changes.
+	Member github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=member,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"member,omitempty"`
+	// The vote threshold of the committee after the change.
+	VoteThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=vote_threshold,json=voteThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"vote_threshold"`
+	Height        int64                                  `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Time          time.Time                              `protobuf:"bytes,7,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *MembershipChange) Reset()         { *m = MembershipChange{} }
+func (m *MembershipChange) String() string { return proto.CompactTextString(m) }
+func (*MembershipChange) ProtoMessage()    {}
+func (*MembershipChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34c8b9a6a80ac26b, []int{5}
+}
+func (m *MembershipChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MembershipChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MembershipChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MembershipChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MembershipChange.Merge(m, src)
+}
+func (m *MembershipChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MembershipChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MembershipChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MembershipChange proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("fury.committee.v1beta1.MembershipChangeType", MembershipChangeType_name, MembershipChangeType_value)
 	proto.RegisterEnum("fury.committee.v1beta1.VoteType", VoteType_name, VoteType_value)
 	proto.RegisterType((*GenesisState)(nil), "fury.committee.v1beta1.GenesisState")
 	proto.RegisterType((*Proposal)(nil), "fury.committee.v1beta1.Proposal")
+	proto.RegisterType((*QueuedProposal)(nil), "fury.committee.v1beta1.QueuedProposal")
 	proto.RegisterType((*Vote)(nil), "fury.committee.v1beta1.Vote")
+	proto.RegisterType((*VoteDelegation)(nil), "fury.committee.v1beta1.VoteDelegation")
+	proto.RegisterType((*MembershipChange)(nil), "fury.committee.v1beta1.MembershipChange")
 }
 
 func init() {
-	proto.RegisterFile("fury/committee/v1beta1/genesis.proto", fileDescriptor_919b27ac60d8c5fd)
-}
-
-var fileDescriptor_919b27ac60d8c5fd = []byte{
-	// 647 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xb7, 0x1d, 0x53, 0x92, 0x4b, 0x1a, 0xd2, 0xa3, 0xad, 0xd2, 0x08, 0xd9, 0x55, 0xc5, 0x50,
-	0x81, 0x62, 0xab, 0x65, 0x41, 0x15, 0x48, 0xc4, 0x49, 0x00, 0x2f, 0x69, 0x71, 0x42, 0xa5, 0x32,
-	0x10, 0x39, 0xf1, 0x61, 0xac, 0x26, 0xbe, 0x28, 0x77, 0x8d, 0x9a, 0x6f, 0xd0, 0xb1, 0x23, 0x23,
-	0x12, 0x4c, 0xcc, 0xfd, 0x10, 0x55, 0xa7, 0x8a, 0x89, 0x01, 0xb9, 0xc8, 0xfd, 0x06, 0x8c, 0x4c,
-	0xe8, 0xce, 0x7f, 0x12, 0x51, 0x3a, 0xf9, 0xdd, 0x7b, 0xbf, 0xf7, 0xe7, 0xf7, 0x7b, 0x4f, 0x06,
-	0x0f, 0x0f, 0xed, 0x89, 0xad, 0xf7, 0xf1, 0x70, 0xe8, 0x51, 0x8a, 0x90, 0x3e, 0xd9, 0xea, 0x21,
-	0x6a, 0x6f, 0xe9, 0x2e, 0xf2, 0x11, 0xf1, 0x88, 0x36, 0x1a, 0x63, 0x8a, 0xe1, 0x2a, 0x43, 0x69,
-	0x29, 0x4a, 0x8b, 0x51, 0x95, 0xb5, 0x3e, 0x26, 0x43, 0x4c, 0xba, 0x1c, 0xa5, 0x47, 0x8f, 0x28,
-	0xa5, 0xb2, 0xec, 0x62, 0x17, 0x47, 0x7e, 0x66, 0xc5, 0xde, 0x35, 0x17, 0x63, 0x77, 0x80, 0x74,
-	0xfe, 0xea, 0x1d, 0x7d, 0xd0, 0x6d, 0x7f, 0x1a, 0x87, 0xd4, 0x7f, 0x43, 0xd4, 0x1b, 0x22, 0x42,
-	0xed, 0xe1, 0x28, 0x02, 0x6c, 0x7c, 0x95, 0x40, 0xe1, 0x55, 0x34, 0x56, 0x9b, 0xda, 0x14, 0xc1,
-	0x67, 0xa0, 0xe4, 0xa3, 0x63, 0xca, 0xba, 0x8f, 0x30, 0xb1, 0x07, 0x5d, 0xcf, 0x29, 0x8b, 0xeb,
-	0xe2, 0xa6, 0x6c, 0xc0, 0x30, 0x50, 0x8b, 0x2d, 0x74, 0x4c, 0xf7, 0xe2, 0x90, 0xd9, 0xb0, 0x8a,
-	0xfe, 0xfc, 0xdb, 0x81, 0x75, 0x00, 0x52, 0x42, 0xa4, 0x2c, 0xad, 0x67, 0x36, 0xf3, 0xdb, 0xcb,
-	0x5a, 0x34, 0x84, 0x96, 0x0c, 0xa1, 0xd5, 0xfc, 0xa9, 0xb1, 0x78, 0x71, 0x56, 0xcd, 0xd5, 0x13,
-	0xac, 0x35, 0x97, 0x06, 0xdf, 0x80, 0x5c, 0xd2, 0x9d, 0x94, 0x33, 0xbc, 0xc6, 0xba, 0xf6, 0x7f,
-	0xb1, 0xb4, 0xa4, 0xb7, 0xb1, 0x74, 0x1e, 0xa8, 0xc2, 0xb7, 0x2b, 0x35, 0x97, 0x78, 0x88, 0x35,
-	0xab, 0x02, 0x9f, 0x82, 0x3b, 0x13, 0x4c, 0x11, 0x29, 0xcb, 0xbc, 0xdc, 0x83, 0xdb, 0xca, 0xed,
-	0x63, 0x8a, 0x0c, 0x99, 0x95, 0xb2, 0xa2, 0x84, 0x1d, 0xf9, 0xe4, 0xb3, 0x2a, 0x6c, 0xfc, 0x16,
-	0x41, 0x36, 0x29, 0x0c, 0x5b, 0xe0, 0x6e, 0x1f, 0xfb, 0x14, 0xf9, 0x94, 0x2b, 0x73, 0x1b, 0x43,
-	0xe5, 0xe2, 0xac, 0x5a, 0x89, 0xd7, 0xe7, 0xe2, 0x49, 0xda, 0xa3, 0x1e, 0xe5, 0x5a, 0x49, 0x11,
-	0xb8, 0x0a, 0x24, 0xcf, 0x29, 0x4b, 0x5c, 0xe4, 0x85, 0x30, 0x50, 0x25, 0xb3, 0x61, 0x49, 0x9e,
-	0x03, 0xb7, 0x41, 0x21, 0x9d, 0x90, 0xad, 0x21, 0xc3, 0x11, 0xf7, 0xc2, 0x40, 0xcd, 0xa7, 0xc2,
-	0x99, 0x0d, 0x2b, 0x9f, 0x82, 0x4c, 0x07, 0xbe, 0x00, 0x59, 0x07, 0xd9, 0xce, 0xc0, 0xf3, 0x51,
-	0x59, 0xe6, 0xc3, 0x55, 0x6e, 0x0c, 0xd7, 0x49, 0x6e, 0xc0, 0xc8, 0x32, 0xa6, 0xa7, 0x57, 0xaa,
-	0x68, 0xa5, 0x59, 0x3b, 0x59, 0x46, 0xf8, 0x13, 0x23, 0xfd, 0x53, 0x04, 0x32, 0x13, 0x04, 0xea,
-	0x20, 0x7f, 0xf3, 0x1c, 0x8a, 0x61, 0xa0, 0x82, 0xb9, 0x53, 0x00, 0xa3, 0xd9, 0x19, 0xbc, 0x8f,
-	0xe4, 0x1e, 0x73, 0x52, 0x05, 0xe3, 0xf5, 0x9f, 0x40, 0xad, 0xba, 0x1e, 0xfd, 0x78, 0xd4, 0x63,
-	0x9a, 0xc7, 0x37, 0x1d, 0x7f, 0xaa, 0xc4, 0x39, 0xd4, 0xe9, 0x74, 0x84, 0x88, 0x56, 0xeb, 0xf7,
-	0x6b, 0x8e, 0x33, 0x46, 0x84, 0x7c, 0x3f, 0xab, 0xde, 0x8f, 0xa5, 0x8b, 0x3d, 0xc6, 0x94, 0x22,
-	0x12, 0x2d, 0x65, 0x0c, 0x9f, 0x83, 0x1c, 0x33, 0xba, 0x2c, 0x8d, 0xcb, 0x52, 0xbc, 0xfd, 0x42,
-	0x18, 0x83, 0xce, 0x74, 0x84, 0xac, 0xec, 0x24, 0xb6, 0xa2, 0x9d, 0x3e, 0x72, 0x41, 0x36, 0x89,
-	0xc1, 0x35, 0xb0, 0xb2, 0xbf, 0xdb, 0x69, 0x76, 0x3b, 0x07, 0x7b, 0xcd, 0xee, 0xdb, 0x56, 0x7b,
-	0xaf, 0x59, 0x37, 0x5f, 0x9a, 0xcd, 0x46, 0x49, 0x80, 0x4b, 0x60, 0x71, 0x16, 0x3a, 0x68, 0xb6,
-	0x4b, 0x22, 0x2c, 0x81, 0xc2, 0xcc, 0xd5, 0xda, 0x2d, 0x49, 0x70, 0x05, 0x2c, 0xcd, 0x3c, 0x35,
-	0xa3, 0xdd, 0xa9, 0x99, 0xad, 0x52, 0xa6, 0x22, 0x9f, 0x7c, 0x51, 0x04, 0xa3, 0x79, 0x1e, 0x2a,
-	0xe2, 0x65, 0xa8, 0x88, 0xbf, 0x42, 0x45, 0x3c, 0xbd, 0x56, 0x84, 0xcb, 0x6b, 0x45, 0xf8, 0x71,
-	0xad, 0x08, 0xef, 0x1e, 0xcf, 0x89, 0xc2, 0xc6, 0xaf, 0x0e, 0xec, 0x1e, 0xe1, 0x96, 0x7e, 0x3c,
-	0xf7, 0xff, 0xe0, 0xea, 0xf4, 0x16, 0xf8, 0x02, 0x9f, 0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x6a,
-	0x30, 0x5b, 0x09, 0x5e, 0x04, 0x00, 0x00,
+	proto.RegisterFile("fury/committee/v1beta1/genesis.proto", fileDescriptor_34c8b9a6a80ac26b)
+}
+
+var fileDescriptor_34c8b9a6a80ac26b = []byte{
+	// 1045 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xda, 0x1b, 0xc7, 0x7e, 0x49, 0x1c, 0x67, 0x48, 0xc3, 0xc6, 0x42, 0xde, 0x10, 0x95,
+	0xc8, 0xaa, 0x6a, 0x9b, 0x94, 0x4b, 0x55, 0x81, 0x54, 0xaf, 0xbd, 0x34, 0x56, 0x89, 0x93, 0xae,
+	0xdd, 0xa0, 0x22, 0xc1, 0x62, 0xef, 0x4e, 0xd6, 0x4b, 0xe3, 0x5d, 0xc7, 0x33, 0x0e, 0xf1, 0x37,
+	0xe8, 0xb1, 0x47, 0x8e, 0x48, 0x1c, 0x90, 0x38, 0x87, 0xcf, 0x40, 0xd4, 0x53, 0xc5, 0x09, 0x21,
+	0xe4, 0x22, 0xe7, 0x1b, 0x70, 0xe4, 0x84, 0x76, 0x76, 0x76, 0xed, 0x24, 0x35, 0x24, 0x28, 0x27,
+	0xef, 0xbc, 0xf9, 0xbd, 0x3f, 0xbf, 0xf7, 0x7e, 0x6f, 0x64, 0xb8, 0xbd, 0xdf, 0xef, 0x0d, 0x8a,
+	0x86, 0xdb, 0xe9, 0xd8, 0x94, 0x62, 0x5c, 0x3c, 0xda, 0x6c, 0x61, 0xda, 0xdc, 0x2c, 0x5a, 0xd8,
+	0xc1, 0xc4, 0x26, 0x85, 0x6e, 0xcf, 0xa5, 0x2e, 0x5a, 0xf1, 0x50, 0x85, 0x10, 0x55, 0xe0, 0xa8,
+	0xcc, 0xaa, 0xe1, 0x92, 0x8e, 0x4b, 0x74, 0x86, 0x2a, 0xfa, 0x07, 0xdf, 0x25, 0xb3, 0x6c, 0xb9,
+	0x96, 0xeb, 0xdb, 0xbd, 0x2f, 0x6e, 0x5d, 0xb5, 0x5c, 0xd7, 0x3a, 0xc0, 0x45, 0x76, 0x6a, 0xf5,
+	0xf7, 0x8b, 0x4d, 0x67, 0xc0, 0xaf, 0xe4, 0x8b, 0x57, 0xd4, 0xee, 0x60, 0x42, 0x9b, 0x9d, 0xae,
+	0x0f, 0x58, 0x3f, 0x15, 0x61, 0xfe, 0x91, 0x5f, 0x56, 0x9d, 0x36, 0x29, 0x46, 0x1f, 0x43, 0xda,
+	0xc1, 0xc7, 0xd4, 0xcb, 0xde, 0x75, 0x49, 0xf3, 0x40, 0xb7, 0x4d, 0x49, 0x58, 0x13, 0x72, 0xa2,
+	0x82, 0x46, 0x43, 0x39, 0x55, 0xc3, 0xc7, 0x74, 0x97, 0x5f, 0x55, 0x2b, 0x5a, 0xca, 0x99, 0x3c,
+	0x9b, 0xa8, 0x0c, 0x10, 0x12, 0x22, 0x52, 0x74, 0x2d, 0x96, 0x9b, 0xbb, 0xb7, 0x5c, 0xf0, 0x8b,
+	0x28, 0x04, 0x45, 0x14, 0x4a, 0xce, 0x40, 0x59, 0x78, 0x75, 0x92, 0x4f, 0x96, 0x03, 0xac, 0x36,
+	0xe1, 0x86, 0x9e, 0x40, 0x32, 0xc8, 0x4e, 0xa4, 0x18, 0x8b, 0xb1, 0x56, 0x78, 0x7b, 0xb3, 0x0a,
+	0x41, 0x6e, 0x65, 0xe9, 0x74, 0x28, 0x47, 0x7e, 0x7a, 0x23, 0x27, 0x03, 0x0b, 0xd1, 0xc6, 0x51,
+	0xd0, 0x7d, 0x98, 0x39, 0x72, 0x29, 0x26, 0x92, 0xc8, 0xc2, 0xbd, 0x37, 0x2d, 0xdc, 0x9e, 0x4b,
+	0xb1, 0x22, 0x7a, 0xa1, 0x34, 0xdf, 0x01, 0x7d, 0x03, 0xe9, 0xc3, 0x3e, 0xee, 0x63, 0x53, 0x1f,
+	0xd7, 0x34, 0xc3, 0x82, 0x6c, 0x4c, 0x0b, 0xf2, 0x84, 0xe1, 0xc3, 0xca, 0xde, 0xe5, 0x95, 0x2d,
+	0x9e, 0xb7, 0x13, 0x6d, 0xf1, 0xf0, 0xbc, 0x01, 0x7d, 0x09, 0xa8, 0x83, 0x3b, 0x2d, 0xdc, 0x23,
+	0x6d, 0xbb, 0xab, 0x1b, 0xed, 0xa6, 0x63, 0x61, 0x22, 0xc5, 0x59, 0xb6, 0xdc, 0xb4, 0x6c, 0xdb,
+	0xa1, 0x47, 0x99, 0x39, 0xf0, 0xf2, 0x97, 0x3a, 0x17, 0xec, 0x04, 0x7d, 0x0e, 0x69, 0x8f, 0x93,
+	0x6e, 0xe2, 0x03, 0x6c, 0x35, 0xa9, 0xed, 0x3a, 0x44, 0x9a, 0xfd, 0x77, 0x2a, 0x5e, 0x3f, 0x2a,
+	0x21, 0x9c, 0x87, 0x5e, 0x3c, 0x3a, 0x67, 0x25, 0x0f, 0xc4, 0x17, 0xdf, 0xcb, 0x91, 0xf5, 0xbf,
+	0x04, 0x48, 0x04, 0x5c, 0x50, 0x0d, 0x66, 0x0d, 0xd7, 0xa1, 0xd8, 0xa1, 0x4c, 0x3d, 0xd3, 0x54,
+	0x90, 0x7d, 0x75, 0x92, 0xcf, 0x70, 0x89, 0x5b, 0xee, 0x51, 0x98, 0xb7, 0xec, 0xfb, 0x6a, 0x41,
+	0x10, 0xb4, 0x02, 0x51, 0xdb, 0x94, 0xa2, 0x4c, 0x88, 0xf1, 0xd1, 0x50, 0x8e, 0x56, 0x2b, 0x5a,
+	0xd4, 0x36, 0xd1, 0x3d, 0x98, 0x0f, 0xab, 0xf6, 0xa4, 0x1a, 0x63, 0x88, 0xc5, 0xd1, 0x50, 0x9e,
+	0x0b, 0xc5, 0x55, 0xad, 0x68, 0x73, 0x21, 0xa8, 0x6a, 0xa2, 0x87, 0x90, 0x30, 0x71, 0xd3, 0x3c,
+	0xb0, 0x1d, 0x2c, 0x89, 0xac, 0xb8, 0xcc, 0xa5, 0xe2, 0x1a, 0xc1, 0x9e, 0x28, 0x09, 0x8f, 0xf3,
+	0xcb, 0x37, 0xb2, 0xa0, 0x85, 0x5e, 0x0f, 0x12, 0x1e, 0xe1, 0xef, 0x3c, 0xd2, 0x3f, 0x0a, 0x90,
+	0x3a, 0x3f, 0x57, 0xa4, 0x40, 0x22, 0x90, 0x0a, 0xe7, 0xfe, 0xdf, 0xea, 0xf5, 0x1b, 0x1b, 0xfa,
+	0xa1, 0xc7, 0x90, 0xc2, 0xc7, 0xd8, 0xe8, 0x7b, 0xfd, 0xd5, 0xbd, 0x9d, 0x95, 0xa2, 0xd7, 0x28,
+	0x74, 0x21, 0xf4, 0xf5, 0x6e, 0xf9, 0x78, 0xfe, 0x10, 0x40, 0xf4, 0xc6, 0x89, 0x8a, 0x30, 0x77,
+	0x79, 0xb9, 0x53, 0xa3, 0xa1, 0x0c, 0x13, 0x8b, 0x0d, 0xdd, 0xf1, 0x52, 0x7f, 0xe5, 0x2f, 0x4f,
+	0x8f, 0xd5, 0x30, 0xaf, 0x6c, 0xfd, 0x3d, 0x94, 0xf3, 0x96, 0x4d, 0xdb, 0xfd, 0x96, 0x47, 0x89,
+	0xbf, 0x50, 0xfc, 0x27, 0x4f, 0xcc, 0xe7, 0x45, 0x3a, 0xe8, 0x62, 0x52, 0x28, 0x19, 0x46, 0xc9,
+	0x34, 0x7b, 0x98, 0x90, 0x5f, 0x4f, 0xf2, 0xef, 0xf0, 0x21, 0x73, 0x8b, 0x32, 0xa0, 0x98, 0xf8,
+	0x2b, 0xd6, 0x43, 0x9f, 0x40, 0xd2, 0xfb, 0xd0, 0x3d, 0x37, 0x36, 0xc0, 0xd4, 0xf4, 0x8e, 0x79,
+	0x0c, 0x1a, 0x83, 0x2e, 0xd6, 0x12, 0x47, 0xfc, 0x8b, 0xd3, 0x1b, 0x46, 0x21, 0x75, 0x5e, 0xad,
+	0x97, 0xb4, 0x21, 0x5c, 0x41, 0x1b, 0xfb, 0x90, 0xe4, 0xeb, 0xe1, 0xde, 0x3c, 0xdf, 0x71, 0x68,
+	0x64, 0x42, 0x82, 0x1f, 0x7c, 0xca, 0x37, 0x99, 0x26, 0x8c, 0x8c, 0x2a, 0x00, 0xf8, 0xb8, 0x6b,
+	0xf7, 0x58, 0x3f, 0xae, 0xa5, 0xf5, 0x09, 0x3f, 0xde, 0xe0, 0x9f, 0x63, 0x90, 0xbe, 0xf8, 0xd6,
+	0xfc, 0xaf, 0x16, 0x67, 0x20, 0x41, 0xf0, 0x61, 0x1f, 0x3b, 0x86, 0xaf, 0x6a, 0x51, 0x0b, 0xcf,
+	0xe8, 0x21, 0x88, 0x13, 0x2a, 0xb8, 0x7b, 0xd5, 0x37, 0x8f, 0x29, 0x82, 0x79, 0xa2, 0xaf, 0x21,
+	0xee, 0xbf, 0x7c, 0x92, 0x78, 0xc3, 0x6d, 0xe5, 0x71, 0xd1, 0x53, 0x48, 0xf9, 0x72, 0x6d, 0xf7,
+	0x30, 0x69, 0xbb, 0x07, 0xa6, 0x34, 0xb3, 0x26, 0xe4, 0x92, 0x4a, 0xc1, 0x6b, 0xde, 0xef, 0x43,
+	0x79, 0xe3, 0x0a, 0xd9, 0x2a, 0xd8, 0xd0, 0x16, 0x98, 0x82, 0x83, 0x20, 0x68, 0x05, 0xe2, 0x6d,
+	0x6c, 0x5b, 0x6d, 0x2a, 0xc5, 0xd7, 0x84, 0x5c, 0x4c, 0xe3, 0x27, 0x74, 0x1f, 0x44, 0xf6, 0x00,
+	0xcc, 0x5e, 0x63, 0x7a, 0xcc, 0xc3, 0x9f, 0xdb, 0x9d, 0x5f, 0x04, 0x58, 0x7e, 0x5b, 0xbf, 0xd0,
+	0x06, 0xac, 0x6f, 0xab, 0xdb, 0x8a, 0xaa, 0xd5, 0xb7, 0xaa, 0xbb, 0x7a, 0x79, 0xab, 0x54, 0x7b,
+	0xa4, 0xea, 0x8d, 0x67, 0xbb, 0xaa, 0xfe, 0xb4, 0x56, 0xdf, 0x55, 0xcb, 0xd5, 0x4f, 0xab, 0x6a,
+	0x25, 0x1d, 0x41, 0x1f, 0xc0, 0xfb, 0x53, 0x70, 0xa5, 0x4a, 0x45, 0xf7, 0xaf, 0xd2, 0x02, 0xca,
+	0xc1, 0xed, 0x29, 0x30, 0x4d, 0xdd, 0xde, 0xd9, 0x53, 0x03, 0x64, 0x14, 0x7d, 0x08, 0x77, 0xa7,
+	0x20, 0xf9, 0xf7, 0xde, 0x4e, 0x43, 0xd5, 0x1b, 0x5b, 0x9a, 0x5a, 0xdf, 0xda, 0xf9, 0xac, 0x92,
+	0x8e, 0x65, 0xc4, 0x17, 0x3f, 0x64, 0x23, 0x77, 0x2c, 0x48, 0x04, 0xeb, 0x8f, 0x56, 0xe1, 0x96,
+	0x8f, 0xba, 0x5c, 0xef, 0x12, 0x2c, 0x8c, 0xaf, 0x9e, 0xa9, 0xf5, 0xb4, 0x80, 0xd2, 0x30, 0x3f,
+	0x36, 0xd5, 0x76, 0xd2, 0x51, 0x74, 0x0b, 0x96, 0xc6, 0x96, 0x92, 0x52, 0x6f, 0x94, 0xaa, 0xb5,
+	0x20, 0x91, 0xf2, 0xf8, 0x74, 0x94, 0x15, 0x5e, 0x8f, 0xb2, 0xc2, 0x9f, 0xa3, 0xac, 0xf0, 0xf2,
+	0x2c, 0x1b, 0x79, 0x7d, 0x96, 0x8d, 0xfc, 0x76, 0x96, 0x8d, 0x7c, 0xb1, 0x39, 0x31, 0x5b, 0xdb,
+	0x31, 0xfa, 0xad, 0x3e, 0xc9, 0x3b, 0x98, 0x7e, 0xeb, 0xf6, 0x9e, 0x17, 0xd9, 0x9f, 0xbe, 0xe3,
+	0x89, 0xbf, 0x7d, 0x6c, 0xd4, 0xad, 0x38, 0x9b, 0xd4, 0x47, 0xff, 0x0c, 0x00, 0xae, 0x0d, 0xaf,
+	0x88, 0x15, 0x0a, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -263,6 +458,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VoteDelegations) > 0 {
+		for iNdEx := len(m.VoteDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.MembershipChanges) > 0 {
+		for iNdEx := len(m.MembershipChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MembershipChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.QueuedProposals) > 0 {
+		for iNdEx := len(m.QueuedProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Votes) > 0 {
 		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -366,6 +603,47 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueuedProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExecutionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExecutionTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Vote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -406,6 +684,124 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VoteDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CommitteeID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CommitteeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MembershipChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MembershipChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MembershipChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintGenesis(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x3a
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.VoteThreshold.Size()
+		i -= size
+		if _, err := m.VoteThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Type != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CommitteeID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CommitteeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -444,6 +840,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.QueuedProposals) > 0 {
+		for _, e := range m.QueuedProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MembershipChanges) > 0 {
+		for _, e := range m.MembershipChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VoteDelegations) > 0 {
+		for _, e := range m.VoteDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -468,6 +882,19 @@ func (m *Proposal) Size() (n int) {
 	return n
 }
 
+func (m *QueuedProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proposal.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExecutionTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func (m *Vote) Size() (n int) {
 	if m == nil {
 		return 0
@@ -487,19 +914,70 @@ func (m *Vote) Size() (n int) {
 	return n
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *VoteDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommitteeID != 0 {
+		n += 1 + sovGenesis(uint64(m.CommitteeID))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
+
+func (m *MembershipChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommitteeID != 0 {
+		n += 1 + sovGenesis(uint64(m.CommitteeID))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	if m.Type != 0 {
+		n += 1 + sovGenesis(uint64(m.Type))
+	}
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.VoteThreshold.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowGenesis
 			}
@@ -643,6 +1121,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedProposals = append(m.QueuedProposals, QueuedProposal{})
+			if err := m.QueuedProposals[len(m.QueuedProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MembershipChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MembershipChanges = append(m.MembershipChanges, MembershipChange{})
+			if err := m.MembershipChanges[len(m.MembershipChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteDelegations = append(m.VoteDelegations, VoteDelegation{})
+			if err := m.VoteDelegations[len(m.VoteDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -821,6 +1401,122 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueuedProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Vote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -943,6 +1639,403 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VoteDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeID", wireType)
+			}
+			m.CommitteeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = append(m.Delegator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegator == nil {
+				m.Delegator = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = append(m.Delegate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegate == nil {
+				m.Delegate = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MembershipChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MembershipChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MembershipChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeID", wireType)
+			}
+			m.CommitteeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= MembershipChangeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = append(m.Member[:0], dAtA[iNdEx:postIndex]...)
+			if m.Member == nil {
+				m.Member = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
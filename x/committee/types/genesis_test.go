@@ -67,6 +67,36 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 	)
 
+	withQueuedProposals := func(nextProposalID uint64, queuedProposalIDs ...uint64) *types.GenesisState {
+		gs := types.NewGenesisState(nextProposalID, testGenesis.GetCommittees(), testGenesis.Proposals, testGenesis.Votes)
+		for _, id := range queuedProposalIDs {
+			proposal := types.MustNewProposal(govv1beta1.NewTextProposal("A Title", "A description of this proposal."), id, 1, testTime)
+			gs.QueuedProposals = append(gs.QueuedProposals, types.NewQueuedProposal(proposal, testTime.Add(time.Hour)))
+		}
+		return gs
+	}
+	withMembershipChanges := func(changes ...types.MembershipChange) *types.GenesisState {
+		gs := types.NewGenesisState(testGenesis.NextProposalID, testGenesis.GetCommittees(), testGenesis.Proposals, testGenesis.Votes)
+		gs.MembershipChanges = changes
+		return gs
+	}
+	withVoteDelegations := func(delegations ...types.VoteDelegation) *types.GenesisState {
+		gs := types.NewGenesisState(testGenesis.NextProposalID, testGenesis.GetCommittees(), testGenesis.Proposals, testGenesis.Votes)
+		gs.VoteDelegations = delegations
+		return gs
+	}
+	vetoedCommittee := types.MustNewMemberCommittee(
+		4,
+		"This members committee is vetoed.",
+		addresses[:3],
+		nil,
+		testutil.D("0.667"),
+		time.Hour*24*7,
+		types.TALLY_OPTION_FIRST_PAST_THE_POST,
+	)
+	vetoedCommittee.SetExecutionDelay(time.Hour * 24)
+	vetoedCommittee.SetVetoCommitteeID(3)
+
 	testCases := []struct {
 		name       string
 		genState   *types.GenesisState
@@ -160,6 +190,108 @@ func TestGenesisState_Validate(t *testing.T) {
 			),
 			expectPass: false,
 		},
+		{
+			name:       "queued proposals",
+			genState:   withQueuedProposals(4, 2, 3),
+			expectPass: true,
+		},
+		{
+			name:       "queued proposal with ID of proposal",
+			genState:   withQueuedProposals(4, 1),
+			expectPass: false,
+		},
+		{
+			name:       "duplicate queued proposal IDs",
+			genState:   withQueuedProposals(4, 2, 2),
+			expectPass: false,
+		},
+		{
+			name:       "queued proposal with invalid NextProposalID",
+			genState:   withQueuedProposals(2, 2),
+			expectPass: false,
+		},
+		{
+			name: "membership changes",
+			genState: withMembershipChanges(
+				types.NewMembershipChange(1, 1, types.MEMBERSHIP_CHANGE_TYPE_ADD_MEMBER, addresses[2], testutil.D("0.667"), 1, testTime),
+				types.NewMembershipChange(1, 2, types.MEMBERSHIP_CHANGE_TYPE_CHANGE_VOTE_THRESHOLD, nil, testutil.D("0.5"), 2, testTime),
+				types.NewMembershipChange(2, 1, types.MEMBERSHIP_CHANGE_TYPE_REMOVE_MEMBER, addresses[2], testutil.D("0.5"), 2, testTime),
+			),
+			expectPass: true,
+		},
+		{
+			name: "duplicate membership change sequence",
+			genState: withMembershipChanges(
+				types.NewMembershipChange(1, 1, types.MEMBERSHIP_CHANGE_TYPE_ADD_MEMBER, addresses[2], testutil.D("0.667"), 1, testTime),
+				types.NewMembershipChange(1, 1, types.MEMBERSHIP_CHANGE_TYPE_CHANGE_VOTE_THRESHOLD, nil, testutil.D("0.5"), 2, testTime),
+			),
+			expectPass: false,
+		},
+		{
+			name: "membership change without member",
+			genState: withMembershipChanges(
+				types.NewMembershipChange(1, 1, types.MEMBERSHIP_CHANGE_TYPE_ADD_MEMBER, nil, testutil.D("0.667"), 1, testTime),
+			),
+			expectPass: false,
+		},
+		{
+			name: "membership change with invalid type",
+			genState: withMembershipChanges(
+				types.NewMembershipChange(1, 1, types.MEMBERSHIP_CHANGE_TYPE_UNSPECIFIED, addresses[2], testutil.D("0.667"), 1, testTime),
+			),
+			expectPass: false,
+		},
+		{
+			name: "vote delegations",
+			genState: withVoteDelegations(
+				types.NewVoteDelegation(1, addresses[0], addresses[2], testTime),
+				types.NewVoteDelegation(1, addresses[1], addresses[2], testTime),
+				types.NewVoteDelegation(2, addresses[0], addresses[2], testTime),
+			),
+			expectPass: true,
+		},
+		{
+			name: "duplicate vote delegation",
+			genState: withVoteDelegations(
+				types.NewVoteDelegation(1, addresses[0], addresses[2], testTime),
+				types.NewVoteDelegation(1, addresses[0], addresses[1], testTime),
+			),
+			expectPass: false,
+		},
+		{
+			name: "vote delegation to self",
+			genState: withVoteDelegations(
+				types.NewVoteDelegation(1, addresses[0], addresses[0], testTime),
+			),
+			expectPass: false,
+		},
+		{
+			name: "vote delegation of non existent committee",
+			genState: withVoteDelegations(
+				types.NewVoteDelegation(10, addresses[0], addresses[2], testTime),
+			),
+			expectPass: false,
+		},
+		{
+			name: "veto committee",
+			genState: types.NewGenesisState(
+				testGenesis.NextProposalID,
+				append(testGenesis.GetCommittees(), vetoedCommittee),
+				testGenesis.Proposals,
+				testGenesis.Votes,
+			),
+			expectPass: true,
+		},
+		{
+			name: "veto committee does not exist",
+			genState: types.NewGenesisState(
+				testGenesis.NextProposalID,
+				[]types.Committee{vetoedCommittee},
+				nil,
+				nil,
+			),
+			expectPass: false,
+		},
 		{
 			name: "invalid vote",
 			genState: types.NewGenesisState(
@@ -30,6 +30,10 @@ var (
 	VoteKeyPrefix      = []byte{0x02} // prefix for keys that store votes
 
 	NextProposalIDKey = []byte{0x03} // key for the next proposal id
+
+	QueuedProposalKeyPrefix   = []byte{0x04} // prefix for keys that store passed proposals waiting to be enacted
+	MembershipChangeKeyPrefix = []byte{0x05} // prefix for keys that store the membership changes of committees
+	VoteDelegationKeyPrefix   = []byte{0x06} // prefix for keys that store the vote delegations of committee members
 )
 
 // GetKeyFromID returns the bytes to use as a key for a uint64 id
@@ -41,6 +45,16 @@ func GetVoteKey(proposalID uint64, voter sdk.AccAddress) []byte {
 	return append(GetKeyFromID(proposalID), voter.Bytes()...)
 }
 
+// GetMembershipChangeKey returns the key of a membership change, ordering the changes of a committee by sequence.
+func GetMembershipChangeKey(committeeID uint64, sequence uint64) []byte {
+	return append(GetKeyFromID(committeeID), uint64ToBytes(sequence)...)
+}
+
+// GetVoteDelegationKey returns the key of the vote delegation of a committee member.
+func GetVoteDelegationKey(committeeID uint64, delegator sdk.AccAddress) []byte {
+	return append(GetKeyFromID(committeeID), delegator.Bytes()...)
+}
+
 // Uint64ToBytes converts a uint64 into fixed length bytes for use in store keys.
 func uint64ToBytes(id uint64) []byte {
 	bz := make([]byte, 8)
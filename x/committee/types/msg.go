@@ -2,6 +2,7 @@ package types
 
 import (
 	fmt "fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/codec/types"
@@ -12,10 +13,14 @@ import (
 const (
 	TypeMsgSubmitProposal = "commmittee_submit_proposal" // 'committee' prefix appended to avoid potential conflicts with gov msg types
 	TypeMsgVote           = "committee_vote"
+
+	TypeMsgDelegateVote         = "committee_delegate_vote"
+	TypeMsgRevokeVoteDelegation = "committee_revoke_vote_delegation"
 )
 
 var (
 	_, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgVote{}
+	_, _ sdk.Msg                       = &MsgDelegateVote{}, &MsgRevokeVoteDelegation{}
 	_    types.UnpackInterfacesMessage = &MsgSubmitProposal{}
 )
 
@@ -151,3 +156,96 @@ func (msg MsgVote) GetVoter() sdk.AccAddress {
 	}
 	return address
 }
+
+// NewMsgDelegateVote creates a message to let another address vote in place of a committee member
+func NewMsgDelegateVote(committeeID uint64, delegator sdk.AccAddress, delegate sdk.AccAddress, duration time.Duration) *MsgDelegateVote {
+	return &MsgDelegateVote{
+		CommitteeID: committeeID,
+		Delegator:   delegator.String(),
+		Delegate:    delegate.String(),
+		Duration:    duration,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgDelegateVote) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within events.
+func (msg MsgDelegateVote) Type() string { return TypeMsgDelegateVote }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgDelegateVote) ValidateBasic() error {
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return err
+	}
+	delegate, err := sdk.AccAddressFromBech32(msg.Delegate)
+	if err != nil {
+		return err
+	}
+	if delegator.Equals(delegate) {
+		return errorsmod.Wrap(ErrInvalidVoteDelegation, "cannot delegate vote to self")
+	}
+	if msg.Duration <= 0 || msg.Duration > MaxVoteDelegationDuration {
+		return errorsmod.Wrapf(ErrInvalidVoteDelegation, "duration must be positive and at most %s", MaxVoteDelegationDuration)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgDelegateVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgDelegateVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.GetDelegator()}
+}
+
+func (msg MsgDelegateVote) GetDelegator() sdk.AccAddress {
+	address, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return sdk.AccAddress{}
+	}
+	return address
+}
+
+// NewMsgRevokeVoteDelegation creates a message to end the delegation of a committee member's vote
+func NewMsgRevokeVoteDelegation(committeeID uint64, delegator sdk.AccAddress) *MsgRevokeVoteDelegation {
+	return &MsgRevokeVoteDelegation{
+		CommitteeID: committeeID,
+		Delegator:   delegator.String(),
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRevokeVoteDelegation) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within events.
+func (msg MsgRevokeVoteDelegation) Type() string { return TypeMsgRevokeVoteDelegation }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRevokeVoteDelegation) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Delegator)
+	return err
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRevokeVoteDelegation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRevokeVoteDelegation) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.GetDelegator()}
+}
+
+func (msg MsgRevokeVoteDelegation) GetDelegator() sdk.AccAddress {
+	address, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return sdk.AccAddress{}
+	}
+	return address
+}
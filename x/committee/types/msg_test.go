@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto"
@@ -107,3 +108,61 @@ func TestMsgVote_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgDelegateVote_ValidateBasic(t *testing.T) {
+	delegator := sdk.AccAddress(crypto.AddressHash([]byte("VoteDelegator")))
+	delegate := sdk.AccAddress(crypto.AddressHash([]byte("VoteDelegate")))
+	tests := []struct {
+		name       string
+		msg        *MsgDelegateVote
+		expectPass bool
+	}{
+		{
+			name:       "normal",
+			msg:        NewMsgDelegateVote(1, delegator, delegate, 24*time.Hour),
+			expectPass: true,
+		},
+		{
+			name:       "max duration",
+			msg:        NewMsgDelegateVote(1, delegator, delegate, MaxVoteDelegationDuration),
+			expectPass: true,
+		},
+		{
+			name:       "duration above max",
+			msg:        NewMsgDelegateVote(1, delegator, delegate, MaxVoteDelegationDuration+time.Second),
+			expectPass: false,
+		},
+		{
+			name:       "zero duration",
+			msg:        NewMsgDelegateVote(1, delegator, delegate, 0),
+			expectPass: false,
+		},
+		{
+			name:       "delegate to self",
+			msg:        NewMsgDelegateVote(1, delegator, delegator, 24*time.Hour),
+			expectPass: false,
+		},
+		{
+			name:       "empty delegate",
+			msg:        &MsgDelegateVote{CommitteeID: 1, Delegator: delegator.String(), Duration: 24 * time.Hour},
+			expectPass: false,
+		},
+		{
+			name:       "empty delegator",
+			msg:        &MsgDelegateVote{CommitteeID: 1, Delegate: delegate.String(), Duration: 24 * time.Hour},
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -2,10 +2,12 @@ package types
 
 import (
 	"encoding/json"
+	"errors"
 	fmt "fmt"
 	"reflect"
 	"strings"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -57,6 +59,8 @@ var (
 	_ Permission = CommunityCDPRepayDebtPermission{}
 	_ Permission = CommunityPoolLendWithdrawPermission{}
 	_ Permission = CommunityCDPWithdrawCollateralPermission{}
+	_ Permission = MsgsPermission{}
+	_ Permission = CommitteeMembershipPermission{}
 )
 
 // Allows implement permission interface for GodPermission.
@@ -92,6 +96,112 @@ func (CommunityPoolLendWithdrawPermission) Allows(_ sdk.Context, _ ParamKeeper,
 	return ok
 }
 
+// Allows implement permission interface for MsgsPermission.
+func (perm MsgsPermission) Allows(_ sdk.Context, _ ParamKeeper, p PubProposal) bool {
+	proposal, ok := p.(*MsgsProposal)
+	if !ok {
+		return false
+	}
+	msgs, err := proposal.GetMsgs()
+	if err != nil || len(msgs) == 0 {
+		return false
+	}
+
+	// Every message must be allowed by at least one of the allowed messages of its type.
+	for _, msg := range msgs {
+		allowed := false
+		for _, am := range perm.AllowedMsgs.filterByTypeURL(sdk.MsgTypeURL(msg)) {
+			if am.allowsMsg(msg) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate checks the allowed messages of the permission are well formed.
+func (perm MsgsPermission) Validate() error {
+	return perm.AllowedMsgs.Validate()
+}
+
+// Allows implement permission interface for CommitteeMembershipPermission.
+// The limits on the committee's members are checked by AllowsCommittee when the change is made.
+func (perm CommitteeMembershipPermission) Allows(_ sdk.Context, _ ParamKeeper, p PubProposal) bool {
+	switch proposal := p.(type) {
+	case *AddCommitteeMemberProposal:
+		return perm.allowsMemberWeight(proposal.GetWeight())
+	case *RemoveCommitteeMemberProposal:
+		return true
+	case *ChangeCommitteeVoteThresholdProposal:
+		return perm.allowsVoteThreshold(proposal.VoteThreshold)
+	default:
+		return false
+	}
+}
+
+// AllowsCommittee returns true if a committee's members and vote threshold are within the limits of the permission.
+func (perm CommitteeMembershipPermission) AllowsCommittee(committee Committee) bool {
+	members := uint64(len(committee.GetMembers()))
+	if members < perm.MinMembers || members > perm.MaxMembers {
+		return false
+	}
+	return perm.allowsVoteThreshold(committee.GetVoteThreshold())
+}
+
+func (perm CommitteeMembershipPermission) allowsVoteThreshold(threshold sdk.Dec) bool {
+	if threshold.IsNil() || perm.MinVoteThreshold.IsNil() || perm.MaxVoteThreshold.IsNil() {
+		return false
+	}
+	return threshold.GTE(perm.MinVoteThreshold) && threshold.LTE(perm.MaxVoteThreshold)
+}
+
+// allowsMemberWeight returns true if a member can be added with a weight. Without weight limits, members can only be
+// added with a weight of one.
+func (perm CommitteeMembershipPermission) allowsMemberWeight(weight sdk.Dec) bool {
+	if !perm.hasMemberWeightLimits() {
+		return weight.Equal(sdk.OneDec())
+	}
+	return weight.GTE(perm.MinMemberWeight) && weight.LTE(perm.MaxMemberWeight)
+}
+
+// hasMemberWeightLimits returns whether the permission sets member weight limits. Unset limits are decoded as zero.
+func (perm CommitteeMembershipPermission) hasMemberWeightLimits() bool {
+	return !perm.MaxMemberWeight.IsNil() && !perm.MaxMemberWeight.IsZero()
+}
+
+// Validate checks the limits of the permission are consistent.
+func (perm CommitteeMembershipPermission) Validate() error {
+	if perm.MinMembers == 0 {
+		return errors.New("min members must be positive")
+	}
+	if perm.MaxMembers < perm.MinMembers {
+		return fmt.Errorf("max members %d must not be less than min members %d", perm.MaxMembers, perm.MinMembers)
+	}
+	if perm.MinVoteThreshold.IsNil() || !perm.MinVoteThreshold.IsPositive() {
+		return fmt.Errorf("invalid min vote threshold: %s", perm.MinVoteThreshold)
+	}
+	if perm.MaxVoteThreshold.IsNil() || perm.MaxVoteThreshold.GT(sdk.OneDec()) || perm.MaxVoteThreshold.LT(perm.MinVoteThreshold) {
+		return fmt.Errorf("invalid max vote threshold: %s", perm.MaxVoteThreshold)
+	}
+	if !perm.hasMemberWeightLimits() {
+		if !perm.MinMemberWeight.IsNil() && !perm.MinMemberWeight.IsZero() {
+			return errors.New("min member weight cannot be set without a max member weight")
+		}
+		return nil
+	}
+	if perm.MinMemberWeight.IsNil() || !perm.MinMemberWeight.IsPositive() {
+		return fmt.Errorf("invalid min member weight: %s", perm.MinMemberWeight)
+	}
+	if perm.MaxMemberWeight.LT(perm.MinMemberWeight) {
+		return fmt.Errorf("invalid max member weight: %s", perm.MaxMemberWeight)
+	}
+	return nil
+}
+
 // Allows implement permission interface for ParamsChangePermission.
 func (perm ParamsChangePermission) Allows(ctx sdk.Context, pk ParamKeeper, p PubProposal) bool {
 	proposal, ok := p.(*paramsproposal.ParameterChangeProposal)
@@ -312,3 +422,118 @@ func (allowed AllowedParamsChange) allowsParamChange(ctx sdk.Context, paramsChan
 
 	return allowed.allowsSingleParamsChange(currentValue, changeValue)
 }
+
+type AllowedMsgs []AllowedMsg
+
+// Validate checks each allowed message has a type URL and well formed field requirements.
+func (msgs AllowedMsgs) Validate() error {
+	for _, am := range msgs {
+		if !strings.HasPrefix(am.TypeUrl, "/") || len(am.TypeUrl) == 1 {
+			return fmt.Errorf("invalid allowed msg type url: '%s'", am.TypeUrl)
+		}
+		for _, req := range am.FieldRequirements {
+			if strings.TrimSpace(req.Field) == "" {
+				return fmt.Errorf("allowed msg %s has a field requirement with an empty field", am.TypeUrl)
+			}
+			if len(req.AllowedValues) == 0 {
+				return fmt.Errorf("allowed msg %s field requirement %s has no allowed values", am.TypeUrl, req.Field)
+			}
+		}
+	}
+	return nil
+}
+
+// filterByTypeURL returns all allowed messages with a type URL.
+func (msgs AllowedMsgs) filterByTypeURL(typeURL string) AllowedMsgs {
+	filtered := AllowedMsgs{}
+	for _, am := range msgs {
+		if am.TypeUrl == typeURL {
+			filtered = append(filtered, am)
+		}
+	}
+	return filtered
+}
+
+// allowsMsg returns true if a message meets all the field requirements of the allowed message.
+func (am AllowedMsg) allowsMsg(msg sdk.Msg) bool {
+	if len(am.FieldRequirements) == 0 {
+		return true
+	}
+
+	bz, err := codec.ProtoMarshalJSON(msg, nil)
+	if err != nil {
+		return false
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return false
+	}
+
+	for _, req := range am.FieldRequirements {
+		values, err := collectFieldValues(fields, strings.Split(req.Field, "."))
+		if err != nil || len(values) == 0 {
+			return false
+		}
+		for _, v := range values {
+			if !req.allowsValue(v) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// allowsValue returns true if a json decoded field value is one of the allowed values.
+func (req MsgFieldRequirement) allowsValue(value interface{}) bool {
+	var str string
+	switch v := value.(type) {
+	case string:
+		str = v
+	case map[string]interface{}:
+		// objects can only be constrained through their fields
+		return false
+	default:
+		bz, err := json.Marshal(v)
+		if err != nil {
+			return false
+		}
+		str = string(bz)
+	}
+
+	for _, allowed := range req.AllowedValues {
+		if str == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// collectFieldValues returns the values found at a path in a json decoded message. Lists along the path are expanded
+// so the values of every item are returned.
+func collectFieldValues(value interface{}, path []string) ([]interface{}, error) {
+	if list, ok := value.([]interface{}); ok {
+		var values []interface{}
+		for _, item := range list {
+			itemValues, err := collectFieldValues(item, path)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, itemValues...)
+		}
+		return values, nil
+	}
+
+	if len(path) == 0 {
+		return []interface{}{value}, nil
+	}
+
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("field path does not match the message")
+	}
+	field, found := object[path[0]]
+	if !found {
+		return nil, fmt.Errorf("field %s not found", path[0])
+	}
+	return collectFieldValues(field, path[1:])
+}
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -32,7 +33,7 @@ func (m *GodPermission) Reset()         { *m = GodPermission{} }
 func (m *GodPermission) String() string { return proto.CompactTextString(m) }
 func (*GodPermission) ProtoMessage()    {}
 func (*GodPermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bdfaf7be16465ae4, []int{0}
+	return fileDescriptor_d9a7590d3738e282, []int{0}
 }
 func (m *GodPermission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -69,7 +70,7 @@ func (m *SoftwareUpgradePermission) Reset()         { *m = SoftwareUpgradePermis
 func (m *SoftwareUpgradePermission) String() string { return proto.CompactTextString(m) }
 func (*SoftwareUpgradePermission) ProtoMessage()    {}
 func (*SoftwareUpgradePermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bdfaf7be16465ae4, []int{1}
+	return fileDescriptor_d9a7590d3738e282, []int{1}
 }
 func (m *SoftwareUpgradePermission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -106,7 +107,7 @@ func (m *TextPermission) Reset()         { *m = TextPermission{} }
 func (m *TextPermission) String() string { return proto.CompactTextString(m) }
 func (*TextPermission) ProtoMessage()    {}
 func (*TextPermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bdfaf7be16465ae4, []int{2}
+	return fileDescriptor_d9a7590d3738e282, []int{2}
 }
 func (m *TextPermission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -143,7 +144,7 @@ func (m *CommunityCDPRepayDebtPermission) Reset()         { *m = CommunityCDPRep
 func (m *CommunityCDPRepayDebtPermission) String() string { return proto.CompactTextString(m) }
 func (*CommunityCDPRepayDebtPermission) ProtoMessage()    {}
 func (*CommunityCDPRepayDebtPermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bdfaf7be16465ae4, []int{3}
+	return fileDescriptor_d9a7590d3738e282, []int{3}
 }
 func (m *CommunityCDPRepayDebtPermission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -182,7 +183,7 @@ func (m *CommunityCDPWithdrawCollateralPermission) Reset() {
 func (m *CommunityCDPWithdrawCollateralPermission) String() string { return proto.CompactTextString(m) }
 func (*CommunityCDPWithdrawCollateralPermission) ProtoMessage()    {}
 func (*CommunityCDPWithdrawCollateralPermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bdfaf7be16465ae4, []int{4}
+	return fileDescriptor_d9a7590d3738e282, []int{4}
 }
 func (m *CommunityCDPWithdrawCollateralPermission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -219,7 +220,7 @@ func (m *CommunityPoolLendWithdrawPermission) Reset()         { *m = CommunityPo
 func (m *CommunityPoolLendWithdrawPermission) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolLendWithdrawPermission) ProtoMessage()    {}
 func (*CommunityPoolLendWithdrawPermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bdfaf7be16465ae4, []int{5}
+	return fileDescriptor_d9a7590d3738e282, []int{5}
 }
 func (m *CommunityPoolLendWithdrawPermission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -257,7 +258,7 @@ func (m *ParamsChangePermission) Reset()         { *m = ParamsChangePermission{}
 func (m *ParamsChangePermission) String() string { return proto.CompactTextString(m) }
 func (*ParamsChangePermission) ProtoMessage()    {}
 func (*ParamsChangePermission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bdfaf7be16465ae4, []int{6}
+	return fileDescriptor_d9a7590d3738e282, []int{6}
 }
 func (m *ParamsChangePermission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -309,7 +310,7 @@ func (m *AllowedParamsChange) Reset()         { *m = AllowedParamsChange{} }
 func (m *AllowedParamsChange) String() string { return proto.CompactTextString(m) }
 func (*AllowedParamsChange) ProtoMessage()    {}
 func (*AllowedParamsChange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bdfaf7be16465ae4, []int{7}
+	return fileDescriptor_d9a7590d3738e282, []int{7}
 }
 func (m *AllowedParamsChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -380,7 +381,7 @@ func (m *SubparamRequirement) Reset()         { *m = SubparamRequirement{} }
 func (m *SubparamRequirement) String() string { return proto.CompactTextString(m) }
 func (*SubparamRequirement) ProtoMessage()    {}
 func (*SubparamRequirement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bdfaf7be16465ae4, []int{8}
+	return fileDescriptor_d9a7590d3738e282, []int{8}
 }
 func (m *SubparamRequirement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -430,6 +431,227 @@ func (m *SubparamRequirement) GetAllowedSubparamAttrChanges() []string {
 	return nil
 }
 
+// MsgsPermission allows MsgsProposals executing messages of the allowed types.
+type MsgsPermission struct {
+	AllowedMsgs AllowedMsgs `protobuf:"bytes,1,rep,name=allowed_msgs,json=allowedMsgs,proto3,castrepeated=AllowedMsgs" json:"allowed_msgs"`
+}
+
+func (m *MsgsPermission) Reset()         { *m = MsgsPermission{} }
+func (m *MsgsPermission) String() string { return proto.CompactTextString(m) }
+func (*MsgsPermission) ProtoMessage()    {}
+func (*MsgsPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a7590d3738e282, []int{9}
+}
+func (m *MsgsPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgsPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgsPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgsPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgsPermission.Merge(m, src)
+}
+func (m *MsgsPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgsPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgsPermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgsPermission proto.InternalMessageInfo
+
+func (m *MsgsPermission) GetAllowedMsgs() AllowedMsgs {
+	if m != nil {
+		return m.AllowedMsgs
+	}
+	return nil
+}
+
+// AllowedMsg contains a message type that can be executed and optional requirements on its fields.
+type AllowedMsg struct {
+	// The type URL of the message, eg /cosmos.bank.v1beta1.MsgSend
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// Requirements on the values of the message fields. All of them must be met for the message to be allowed.
+	FieldRequirements []MsgFieldRequirement `protobuf:"bytes,2,rep,name=field_requirements,json=fieldRequirements,proto3" json:"field_requirements"`
+}
+
+func (m *AllowedMsg) Reset()         { *m = AllowedMsg{} }
+func (m *AllowedMsg) String() string { return proto.CompactTextString(m) }
+func (*AllowedMsg) ProtoMessage()    {}
+func (*AllowedMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a7590d3738e282, []int{10}
+}
+func (m *AllowedMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowedMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedMsg.Merge(m, src)
+}
+func (m *AllowedMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedMsg proto.InternalMessageInfo
+
+func (m *AllowedMsg) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *AllowedMsg) GetFieldRequirements() []MsgFieldRequirement {
+	if m != nil {
+		return m.FieldRequirements
+	}
+	return nil
+}
+
+// MsgFieldRequirement restricts a message field to a list of values.
+type MsgFieldRequirement struct {
+	// The dot separated path of the field in the json encoded message, eg amount.denom. Fields in lists are required
+	// for every item of the list.
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// The allowed values of the field, as they are json encoded with any quotes of strings removed.
+	AllowedValues []string `protobuf:"bytes,2,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
+}
+
+func (m *MsgFieldRequirement) Reset()         { *m = MsgFieldRequirement{} }
+func (m *MsgFieldRequirement) String() string { return proto.CompactTextString(m) }
+func (*MsgFieldRequirement) ProtoMessage()    {}
+func (*MsgFieldRequirement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a7590d3738e282, []int{11}
+}
+func (m *MsgFieldRequirement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFieldRequirement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFieldRequirement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFieldRequirement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFieldRequirement.Merge(m, src)
+}
+func (m *MsgFieldRequirement) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFieldRequirement) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFieldRequirement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFieldRequirement proto.InternalMessageInfo
+
+func (m *MsgFieldRequirement) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *MsgFieldRequirement) GetAllowedValues() []string {
+	if m != nil {
+		return m.AllowedValues
+	}
+	return nil
+}
+
+// CommitteeMembershipPermission allows a committee to add and remove its own members and change its own vote threshold,
+// as long as the committee stays within the limits of the permission.
+type CommitteeMembershipPermission struct {
+	// The fewest members the committee can be left with.
+	MinMembers uint64 `protobuf:"varint,1,opt,name=min_members,json=minMembers,proto3" json:"min_members,omitempty"`
+	// The most members the committee can have.
+	MaxMembers uint64 `protobuf:"varint,2,opt,name=max_members,json=maxMembers,proto3" json:"max_members,omitempty"`
+	// The lowest vote threshold the committee can set.
+	MinVoteThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=min_vote_threshold,json=minVoteThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_vote_threshold"`
+	// The highest vote threshold the committee can set.
+	MaxVoteThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_vote_threshold,json=maxVoteThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_vote_threshold"`
+	// The lowest weight a weighted member committee can add a member with.
+	MinMemberWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=min_member_weight,json=minMemberWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_member_weight"`
+	// The highest weight a weighted member committee can add a member with. If it is not set, members can only be
+	// added with a weight of one.
+	MaxMemberWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_member_weight,json=maxMemberWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_member_weight"`
+}
+
+func (m *CommitteeMembershipPermission) Reset()         { *m = CommitteeMembershipPermission{} }
+func (m *CommitteeMembershipPermission) String() string { return proto.CompactTextString(m) }
+func (*CommitteeMembershipPermission) ProtoMessage()    {}
+func (*CommitteeMembershipPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a7590d3738e282, []int{12}
+}
+func (m *CommitteeMembershipPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitteeMembershipPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitteeMembershipPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitteeMembershipPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitteeMembershipPermission.Merge(m, src)
+}
+func (m *CommitteeMembershipPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitteeMembershipPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitteeMembershipPermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitteeMembershipPermission proto.InternalMessageInfo
+
+func (m *CommitteeMembershipPermission) GetMinMembers() uint64 {
+	if m != nil {
+		return m.MinMembers
+	}
+	return 0
+}
+
+func (m *CommitteeMembershipPermission) GetMaxMembers() uint64 {
+	if m != nil {
+		return m.MaxMembers
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GodPermission)(nil), "fury.committee.v1beta1.GodPermission")
 	proto.RegisterType((*SoftwareUpgradePermission)(nil), "fury.committee.v1beta1.SoftwareUpgradePermission")
@@ -440,46 +662,67 @@ func init() {
 	proto.RegisterType((*ParamsChangePermission)(nil), "fury.committee.v1beta1.ParamsChangePermission")
 	proto.RegisterType((*AllowedParamsChange)(nil), "fury.committee.v1beta1.AllowedParamsChange")
 	proto.RegisterType((*SubparamRequirement)(nil), "fury.committee.v1beta1.SubparamRequirement")
+	proto.RegisterType((*MsgsPermission)(nil), "fury.committee.v1beta1.MsgsPermission")
+	proto.RegisterType((*AllowedMsg)(nil), "fury.committee.v1beta1.AllowedMsg")
+	proto.RegisterType((*MsgFieldRequirement)(nil), "fury.committee.v1beta1.MsgFieldRequirement")
+	proto.RegisterType((*CommitteeMembershipPermission)(nil), "fury.committee.v1beta1.CommitteeMembershipPermission")
 }
 
 func init() {
-	proto.RegisterFile("fury/committee/v1beta1/permissions.proto", fileDescriptor_bdfaf7be16465ae4)
-}
-
-var fileDescriptor_bdfaf7be16465ae4 = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0x87, 0x1b, 0xb3, 0x88, 0x3b, 0xe2, 0xb2, 0x64, 0x4b, 0xc9, 0x86, 0x35, 0x2d, 0xf5, 0x12,
-	0x28, 0x9b, 0x50, 0xc5, 0xcb, 0xde, 0xda, 0xae, 0x78, 0xf1, 0x50, 0xb2, 0x8a, 0xe0, 0x25, 0x4c,
-	0x9a, 0x31, 0x0d, 0x3b, 0xc9, 0xc4, 0x79, 0x27, 0xed, 0x16, 0x04, 0xbf, 0x82, 0x5f, 0x43, 0xcf,
-	0x7e, 0x88, 0xc5, 0xd3, 0x1e, 0x3d, 0xa9, 0xb4, 0x1f, 0xc3, 0x8b, 0xe4, 0x6f, 0x03, 0x1b, 0x72,
-	0x9b, 0x79, 0xf3, 0xfc, 0xde, 0xc9, 0x33, 0x2f, 0x83, 0x8c, 0x6b, 0xbc, 0xc2, 0xd6, 0x82, 0x85,
-	0x61, 0x20, 0x04, 0x21, 0xd6, 0x6a, 0xec, 0x12, 0x81, 0xc7, 0x56, 0x4c, 0x78, 0x18, 0x00, 0x04,
-	0x2c, 0x02, 0x33, 0xe6, 0x4c, 0x30, 0xa5, 0x97, 0x92, 0x66, 0x45, 0x9a, 0x05, 0xa9, 0x9d, 0x2e,
-	0x18, 0x84, 0x0c, 0x9c, 0x8c, 0xb2, 0xf2, 0x4d, 0x1e, 0xd1, 0xba, 0x3e, 0xf3, 0x59, 0x5e, 0x4f,
-	0x57, 0x79, 0x75, 0xd8, 0x47, 0x4f, 0x5e, 0x33, 0x6f, 0x5e, 0x1d, 0x70, 0x71, 0xf4, 0xf3, 0xc7,
-	0x39, 0xda, 0xef, 0x87, 0x23, 0x74, 0x7a, 0xc5, 0x3e, 0x8a, 0x35, 0xe6, 0xe4, 0x5d, 0xec, 0x73,
-	0xec, 0x91, 0x16, 0x78, 0x80, 0x8e, 0xde, 0x92, 0x1b, 0xd1, 0x42, 0x8c, 0x51, 0x7f, 0xc6, 0xc2,
-	0x30, 0x89, 0x02, 0xb1, 0x99, 0x5d, 0xce, 0x6d, 0x12, 0xe3, 0xcd, 0x25, 0x71, 0xdb, 0x22, 0x17,
-	0xc8, 0xa8, 0x47, 0xde, 0x07, 0x62, 0xe9, 0x71, 0xbc, 0x9e, 0x31, 0x4a, 0xb1, 0x20, 0x1c, 0xd3,
-	0x96, 0xec, 0x4b, 0xf4, 0xac, 0xca, 0xce, 0x19, 0xa3, 0x6f, 0x48, 0xe4, 0x95, 0x0d, 0x5a, 0x62,
-	0xdf, 0x24, 0xd4, 0x9b, 0x63, 0x8e, 0x43, 0x98, 0x2d, 0x71, 0xe4, 0xd7, 0x94, 0x95, 0x2f, 0xa8,
-	0x87, 0x29, 0x65, 0x6b, 0xe2, 0x39, 0x71, 0x46, 0x38, 0x8b, 0x0c, 0x01, 0x55, 0x1a, 0xc8, 0xc6,
-	0xe3, 0xe7, 0x23, 0xb3, 0x79, 0x34, 0xe6, 0x24, 0x4f, 0xd5, 0xdb, 0x4e, 0xcf, 0x6e, 0x7f, 0xf7,
-	0x3b, 0xdf, 0xff, 0xf4, 0xbb, 0x0d, 0x1f, 0xc1, 0xee, 0xe2, 0x86, 0xea, 0xbd, 0x7f, 0xfd, 0x27,
-	0xa1, 0x93, 0x86, 0xb8, 0xa2, 0xa1, 0x47, 0x90, 0xb8, 0x10, 0xe3, 0x05, 0x51, 0xa5, 0x81, 0x64,
-	0x1c, 0xda, 0xd5, 0x5e, 0x39, 0x46, 0xf2, 0x35, 0xd9, 0xa8, 0x0f, 0xb2, 0x72, 0xba, 0x54, 0x26,
-	0xe8, 0x29, 0x04, 0x91, 0x4f, 0x89, 0x03, 0x89, 0x9b, 0x89, 0x39, 0xa5, 0x26, 0x16, 0x82, 0x83,
-	0x2a, 0x0f, 0x64, 0xe3, 0xd0, 0xd6, 0x72, 0xe8, 0xaa, 0x60, 0x8a, 0x73, 0x27, 0x29, 0xa1, 0x00,
-	0x3a, 0x0b, 0x13, 0x2a, 0x82, 0xaa, 0x03, 0x38, 0x9c, 0x7c, 0x4a, 0x02, 0x4e, 0x42, 0x12, 0x09,
-	0x50, 0x0f, 0xda, 0xef, 0xa7, 0xec, 0x69, 0xef, 0x33, 0xd3, 0x83, 0xf4, 0x7e, 0x6c, 0x2d, 0x6b,
-	0x5b, 0x7e, 0x87, 0x1a, 0x00, 0xc3, 0xcf, 0xe8, 0xa4, 0x21, 0x58, 0x0a, 0x4a, 0x7b, 0xc1, 0x63,
-	0x24, 0xaf, 0x30, 0x2d, 0x95, 0x57, 0x98, 0xa6, 0xca, 0xa5, 0xe2, 0xde, 0x59, 0x08, 0x5e, 0x0d,
-	0xb4, 0x50, 0x2e, 0xa0, 0xca, 0x59, 0x08, 0x5e, 0xcc, 0x62, 0xfa, 0xea, 0x76, 0xab, 0x4b, 0x77,
-	0x5b, 0x5d, 0xfa, 0xbb, 0xd5, 0xa5, 0xaf, 0x3b, 0xbd, 0x73, 0xb7, 0xd3, 0x3b, 0xbf, 0x76, 0x7a,
-	0xe7, 0xc3, 0xc8, 0x0f, 0xc4, 0x32, 0x71, 0x53, 0x4f, 0x2b, 0x15, 0x3e, 0xa7, 0xd8, 0x85, 0x6c,
-	0x65, 0xdd, 0xd4, 0x5e, 0xb8, 0xd8, 0xc4, 0x04, 0xdc, 0x87, 0xd9, 0x5b, 0x7c, 0xf1, 0x3f, 0x00,
-	0x00, 0xff, 0xff, 0x64, 0xe8, 0xa8, 0x0a, 0x00, 0x04, 0x00, 0x00,
+	proto.RegisterFile("fury/committee/v1beta1/permissions.proto", fileDescriptor_d9a7590d3738e282)
+}
+
+var fileDescriptor_d9a7590d3738e282 = []byte{
+	// 783 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xcf, 0x6b, 0xe3, 0x46,
+	0x14, 0xc7, 0xad, 0xd8, 0x49, 0x93, 0x71, 0xe3, 0x26, 0xb2, 0x09, 0x8e, 0x49, 0x6c, 0xe3, 0xd2,
+	0x62, 0x08, 0xb1, 0x71, 0x4b, 0x2f, 0xb9, 0xc5, 0x0e, 0xed, 0xa1, 0x35, 0x18, 0xe5, 0x17, 0x84,
+	0x82, 0x3a, 0xb2, 0xc6, 0xf2, 0x10, 0x49, 0xa3, 0xce, 0x8c, 0x6c, 0x19, 0x02, 0x3d, 0xf7, 0xb6,
+	0xff, 0xc6, 0xee, 0x79, 0xff, 0x88, 0xb0, 0xa7, 0x1c, 0x97, 0x3d, 0x64, 0x97, 0x84, 0xfd, 0x2b,
+	0xf6, 0xb2, 0x68, 0xf4, 0x33, 0x8e, 0xf1, 0x42, 0x4e, 0xd6, 0x7b, 0xf3, 0x79, 0xdf, 0x99, 0xef,
+	0x7b, 0x83, 0x07, 0x34, 0x47, 0x2e, 0x9d, 0xb5, 0x87, 0xc4, 0xb2, 0x30, 0xe7, 0x08, 0xb5, 0x27,
+	0x1d, 0x0d, 0x71, 0xd8, 0x69, 0x3b, 0x88, 0x5a, 0x98, 0x31, 0x4c, 0x6c, 0xd6, 0x72, 0x28, 0xe1,
+	0x44, 0xde, 0xf1, 0xc9, 0x56, 0x4c, 0xb6, 0x42, 0xb2, 0xb2, 0x3b, 0x24, 0xcc, 0x22, 0x4c, 0x15,
+	0x54, 0x3b, 0x08, 0x82, 0x92, 0x4a, 0xc9, 0x20, 0x06, 0x09, 0xf2, 0xfe, 0x57, 0x90, 0x6d, 0xd4,
+	0xc0, 0xe6, 0x1f, 0x44, 0x1f, 0xc4, 0x1b, 0x1c, 0x15, 0xde, 0xbd, 0x3d, 0x04, 0x49, 0xdc, 0x38,
+	0x00, 0xbb, 0xa7, 0x64, 0xc4, 0xa7, 0x90, 0xa2, 0x73, 0xc7, 0xa0, 0x50, 0x47, 0x4b, 0xe0, 0x3a,
+	0x28, 0x9c, 0x21, 0x8f, 0x2f, 0x21, 0x3a, 0xa0, 0xd6, 0x23, 0x96, 0xe5, 0xda, 0x98, 0xcf, 0x7a,
+	0x27, 0x03, 0x05, 0x39, 0x70, 0x76, 0x82, 0xb4, 0x65, 0x25, 0x47, 0xa0, 0x99, 0x2e, 0xb9, 0xc4,
+	0x7c, 0xac, 0x53, 0x38, 0xed, 0x11, 0xd3, 0x84, 0x1c, 0x51, 0x68, 0x2e, 0xa9, 0xfd, 0x0d, 0xfc,
+	0x18, 0xd7, 0x0e, 0x08, 0x31, 0xff, 0x42, 0xb6, 0x1e, 0x09, 0x2c, 0x29, 0x7b, 0x2d, 0x81, 0x9d,
+	0x01, 0xa4, 0xd0, 0x62, 0xbd, 0x31, 0xb4, 0x8d, 0x94, 0x65, 0xf9, 0x3f, 0xb0, 0x03, 0x4d, 0x93,
+	0x4c, 0x91, 0xae, 0x3a, 0x82, 0x50, 0x87, 0x02, 0x61, 0x65, 0xa9, 0x9e, 0x6d, 0xe6, 0x7f, 0x39,
+	0x68, 0x2d, 0x1e, 0x4d, 0xeb, 0x38, 0xa8, 0x4a, 0xcb, 0x76, 0xf7, 0x6e, 0xef, 0x6b, 0x99, 0x37,
+	0x1f, 0x6b, 0xa5, 0x05, 0x8b, 0x4c, 0x29, 0xc1, 0x05, 0xd9, 0x67, 0x67, 0xfd, 0x22, 0x81, 0xe2,
+	0x82, 0x72, 0xb9, 0x02, 0xd6, 0x99, 0xab, 0x31, 0x07, 0x0e, 0x51, 0x59, 0xaa, 0x4b, 0xcd, 0x0d,
+	0x25, 0x8e, 0xe5, 0x2d, 0x90, 0xbd, 0x46, 0xb3, 0xf2, 0x8a, 0x48, 0xfb, 0x9f, 0xf2, 0x31, 0xd8,
+	0x67, 0xd8, 0x36, 0x4c, 0xa4, 0x32, 0x57, 0x13, 0xc6, 0xd4, 0xc8, 0x26, 0xe4, 0x9c, 0xb2, 0x72,
+	0xb6, 0x9e, 0x6d, 0x6e, 0x28, 0x95, 0x00, 0x3a, 0x0d, 0x99, 0x70, 0xdf, 0x63, 0x9f, 0x90, 0x19,
+	0xd8, 0xb3, 0x5c, 0x93, 0xe3, 0x58, 0x81, 0xa9, 0x14, 0xfd, 0xeb, 0x62, 0x8a, 0x2c, 0x64, 0x73,
+	0x56, 0xce, 0x2d, 0xef, 0x4f, 0xa4, 0xa9, 0x24, 0x35, 0xdd, 0x9c, 0xdf, 0x1f, 0xa5, 0x22, 0x64,
+	0xa3, 0x75, 0x96, 0x02, 0x58, 0xe3, 0x06, 0x14, 0x17, 0x14, 0x46, 0x06, 0xa5, 0xc4, 0xe0, 0x16,
+	0xc8, 0x4e, 0xa0, 0x19, 0x59, 0x9e, 0x40, 0xd3, 0xb7, 0x1c, 0x59, 0x4c, 0x3c, 0x73, 0x4e, 0xe3,
+	0x81, 0x86, 0x96, 0x43, 0x28, 0xf6, 0xcc, 0x39, 0x0d, 0x67, 0xd1, 0xb8, 0x01, 0x85, 0x3e, 0x33,
+	0x58, 0xea, 0x7a, 0x5c, 0x81, 0xef, 0x23, 0x51, 0x8b, 0x19, 0xd1, 0xa5, 0x68, 0x7c, 0xe3, 0x52,
+	0xf4, 0x99, 0xd1, 0x2d, 0x86, 0x77, 0x21, 0x9f, 0xe4, 0x98, 0x92, 0x87, 0x49, 0xf0, 0x6c, 0xf2,
+	0xff, 0x4b, 0x00, 0x24, 0xb0, 0xbc, 0x0b, 0xd6, 0xf9, 0xcc, 0x41, 0xaa, 0x4b, 0xcd, 0xd0, 0xf8,
+	0x77, 0x7e, 0x7c, 0x4e, 0x4d, 0xf9, 0x1f, 0x20, 0x8f, 0x30, 0x32, 0xf5, 0xa7, 0x03, 0x59, 0x59,
+	0x3e, 0x90, 0x3e, 0x33, 0x7e, 0xf7, 0x8b, 0x9e, 0x0f, 0x64, 0x7b, 0x34, 0x97, 0x67, 0x0d, 0x05,
+	0x14, 0x17, 0xf0, 0x72, 0x09, 0xac, 0x0a, 0x36, 0x3c, 0x50, 0x10, 0xc8, 0x3f, 0x81, 0x42, 0xd4,
+	0xa4, 0x09, 0x34, 0x5d, 0x14, 0x1c, 0x65, 0x43, 0xd9, 0x0c, 0xb3, 0x17, 0x22, 0xd9, 0xf8, 0x9c,
+	0x05, 0xfb, 0xbd, 0xe8, 0x58, 0x7d, 0x64, 0x69, 0x88, 0xb2, 0x31, 0x76, 0x52, 0xdd, 0xae, 0x81,
+	0xbc, 0x85, 0x6d, 0xd5, 0x0a, 0xd6, 0xc4, 0x26, 0x39, 0x05, 0x58, 0xd8, 0x0e, 0x69, 0x01, 0x40,
+	0x2f, 0x06, 0x56, 0x42, 0x00, 0x7a, 0x11, 0xf0, 0x37, 0x90, 0x7d, 0x85, 0x09, 0xe1, 0x48, 0xe5,
+	0x63, 0x8a, 0xd8, 0x98, 0x98, 0x7a, 0x39, 0xeb, 0x9f, 0xb6, 0xdb, 0xf2, 0xcd, 0x7e, 0xb8, 0xaf,
+	0xfd, 0x6c, 0x60, 0x3e, 0x76, 0x35, 0xbf, 0x45, 0xe1, 0x5f, 0x6a, 0xf8, 0x73, 0xc8, 0xf4, 0xeb,
+	0xb6, 0xdf, 0x63, 0xd6, 0x3a, 0x41, 0x43, 0x65, 0xcb, 0xc2, 0xf6, 0x05, 0xe1, 0xe8, 0x2c, 0xd2,
+	0x11, 0xea, 0xd0, 0x9b, 0x57, 0xcf, 0xbd, 0x50, 0x1d, 0x7a, 0x4f, 0xd5, 0xaf, 0xc0, 0x76, 0xe2,
+	0x5e, 0x9d, 0x22, 0x6c, 0x8c, 0x79, 0x79, 0xf5, 0x45, 0xe2, 0x3f, 0xc4, 0x3d, 0xbb, 0x14, 0x32,
+	0x42, 0x1b, 0x7a, 0x73, 0xda, 0x6b, 0x2f, 0xd4, 0x86, 0x5e, 0x5a, 0x7b, 0xfe, 0x1e, 0x77, 0xff,
+	0xbc, 0x7d, 0xa8, 0x4a, 0x77, 0x0f, 0x55, 0xe9, 0xd3, 0x43, 0x55, 0x7a, 0xf5, 0x58, 0xcd, 0xdc,
+	0x3d, 0x56, 0x33, 0xef, 0x1f, 0xab, 0x99, 0xab, 0x4e, 0x6a, 0x0b, 0x6c, 0x0f, 0x5d, 0xcd, 0x65,
+	0x87, 0x36, 0xe2, 0x53, 0x42, 0xaf, 0xdb, 0xe2, 0xad, 0xf4, 0x52, 0xaf, 0xa5, 0xd8, 0x51, 0x5b,
+	0x13, 0xef, 0xda, 0xaf, 0x5f, 0x07, 0x00, 0x9d, 0xce, 0x9b, 0xc0, 0x4c, 0x07, 0x00, 0x00,
 }
 
 func (m *GodPermission) Marshal() (dAtA []byte, err error) {
@@ -763,6 +1006,199 @@ func (m *SubparamRequirement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgsPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgsPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgsPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedMsgs) > 0 {
+		for iNdEx := len(m.AllowedMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedMsgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPermissions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowedMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FieldRequirements) > 0 {
+		for iNdEx := len(m.FieldRequirements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FieldRequirements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPermissions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFieldRequirement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFieldRequirement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFieldRequirement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedValues) > 0 {
+		for iNdEx := len(m.AllowedValues) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedValues[iNdEx])
+			copy(dAtA[i:], m.AllowedValues[iNdEx])
+			i = encodeVarintPermissions(dAtA, i, uint64(len(m.AllowedValues[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitteeMembershipPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitteeMembershipPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitteeMembershipPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxMemberWeight.Size()
+		i -= size
+		if _, err := m.MaxMemberWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPermissions(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinMemberWeight.Size()
+		i -= size
+		if _, err := m.MinMemberWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPermissions(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxVoteThreshold.Size()
+		i -= size
+		if _, err := m.MaxVoteThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPermissions(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinVoteThreshold.Size()
+		i -= size
+		if _, err := m.MinVoteThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPermissions(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.MaxMembers != 0 {
+		i = encodeVarintPermissions(dAtA, i, uint64(m.MaxMembers))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinMembers != 0 {
+		i = encodeVarintPermissions(dAtA, i, uint64(m.MinMembers))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPermissions(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPermissions(v)
 	base := offset
@@ -895,20 +1331,96 @@ func (m *SubparamRequirement) Size() (n int) {
 	return n
 }
 
-func sovPermissions(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPermissions(x uint64) (n int) {
-	return sovPermissions(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgsPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedMsgs) > 0 {
+		for _, e := range m.AllowedMsgs {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	return n
 }
-func (m *GodPermission) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+
+func (m *AllowedMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if len(m.FieldRequirements) > 0 {
+		for _, e := range m.FieldRequirements {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFieldRequirement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if len(m.AllowedValues) > 0 {
+		for _, s := range m.AllowedValues {
+			l = len(s)
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommitteeMembershipPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinMembers != 0 {
+		n += 1 + sovPermissions(uint64(m.MinMembers))
+	}
+	if m.MaxMembers != 0 {
+		n += 1 + sovPermissions(uint64(m.MaxMembers))
+	}
+	l = m.MinVoteThreshold.Size()
+	n += 1 + l + sovPermissions(uint64(l))
+	l = m.MaxVoteThreshold.Size()
+	n += 1 + l + sovPermissions(uint64(l))
+	l = m.MinMemberWeight.Size()
+	n += 1 + l + sovPermissions(uint64(l))
+	l = m.MaxMemberWeight.Size()
+	n += 1 + l + sovPermissions(uint64(l))
+	return n
+}
+
+func sovPermissions(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPermissions(x uint64) (n int) {
+	return sovPermissions(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GodPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowPermissions
 			}
 			if iNdEx >= l {
@@ -1611,6 +2123,544 @@ func (m *SubparamRequirement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgsPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgsPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgsPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMsgs = append(m.AllowedMsgs, AllowedMsg{})
+			if err := m.AllowedMsgs[len(m.AllowedMsgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldRequirements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FieldRequirements = append(m.FieldRequirements, MsgFieldRequirement{})
+			if err := m.FieldRequirements[len(m.FieldRequirements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFieldRequirement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFieldRequirement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFieldRequirement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedValues = append(m.AllowedValues, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitteeMembershipPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitteeMembershipPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitteeMembershipPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinMembers", wireType)
+			}
+			m.MinMembers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinMembers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMembers", wireType)
+			}
+			m.MaxMembers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMembers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinVoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxVoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinMemberWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinMemberWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemberWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMemberWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPermissions(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,11 +2,15 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 
@@ -293,6 +297,162 @@ func TestParamsChangePermission_SimpleParamsChange_Allows(t *testing.T) {
 	}
 }
 
+func TestMsgsPermission_Allows(t *testing.T) {
+	sender := sdk.AccAddress(crypto.AddressHash([]byte("MsgsPermissionSender")))
+	recipient := sdk.AccAddress(crypto.AddressHash([]byte("MsgsPermissionRecipient")))
+	send := func(coins ...sdk.Coin) sdk.Msg {
+		return banktypes.NewMsgSend(sender, recipient, sdk.NewCoins(coins...))
+	}
+	permission := types.MsgsPermission{
+		AllowedMsgs: types.AllowedMsgs{
+			{
+				TypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}),
+				FieldRequirements: []types.MsgFieldRequirement{
+					{Field: "amount.denom", AllowedValues: []string{"ufury", "hard"}},
+				},
+			},
+			{
+				TypeUrl: sdk.MsgTypeURL(&banktypes.MsgMultiSend{}),
+			},
+		},
+	}
+
+	testcases := []struct {
+		name     string
+		proposal types.PubProposal
+		allowed  bool
+	}{
+		{
+			name:     "allowed for msg meeting field requirements",
+			proposal: newTestMsgsProposal(t, send(sdk.NewInt64Coin("ufury", 100))),
+			allowed:  true,
+		},
+		{
+			name:     "allowed for every item of a list meeting field requirements",
+			proposal: newTestMsgsProposal(t, send(sdk.NewInt64Coin("ufury", 100), sdk.NewInt64Coin("hard", 100))),
+			allowed:  true,
+		},
+		{
+			name:     "allowed for msg without field requirements",
+			proposal: newTestMsgsProposal(t, banktypes.NewMsgMultiSend(nil, nil)),
+			allowed:  true,
+		},
+		{
+			name: "allowed for multiple allowed msgs",
+			proposal: newTestMsgsProposal(t,
+				send(sdk.NewInt64Coin("ufury", 100)),
+				banktypes.NewMsgMultiSend(nil, nil),
+			),
+			allowed: true,
+		},
+		{
+			name:     "fails for an item of a list not meeting field requirements",
+			proposal: newTestMsgsProposal(t, send(sdk.NewInt64Coin("ufury", 100), sdk.NewInt64Coin("usdf", 100))),
+			allowed:  false,
+		},
+		{
+			name: "fails if any msg is not allowed",
+			proposal: newTestMsgsProposal(t,
+				send(sdk.NewInt64Coin("ufury", 100)),
+				banktypes.NewMsgSend(sender, recipient, sdk.NewCoins()),
+			),
+			allowed: false,
+		},
+		{
+			name:     "fails for msg type not allowed",
+			proposal: newTestMsgsProposal(t, distrtypes.NewMsgFundCommunityPool(sdk.NewCoins(sdk.NewInt64Coin("ufury", 100)), sender)),
+			allowed:  false,
+		},
+		{
+			name:     "fails for proposal without msgs",
+			proposal: &types.MsgsProposal{Title: "A Title", Description: "A description of this proposal."},
+			allowed:  false,
+		},
+		{
+			name:     "fails for wrong proposal",
+			proposal: govv1beta1.NewTextProposal("A Title", "A description of this proposal."),
+			allowed:  false,
+		},
+		{
+			name:     "fails for nil proposal",
+			proposal: nil,
+			allowed:  false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.allowed, permission.Allows(sdk.Context{}, nil, tc.proposal))
+		})
+	}
+}
+
+func TestMsgsPermission_Validate(t *testing.T) {
+	testcases := []struct {
+		name       string
+		permission types.MsgsPermission
+		expectPass bool
+	}{
+		{
+			name: "normal",
+			permission: types.MsgsPermission{AllowedMsgs: types.AllowedMsgs{
+				{
+					TypeUrl:           "/cosmos.bank.v1beta1.MsgSend",
+					FieldRequirements: []types.MsgFieldRequirement{{Field: "amount.denom", AllowedValues: []string{"ufury"}}},
+				},
+			}},
+			expectPass: true,
+		},
+		{
+			name:       "empty",
+			permission: types.MsgsPermission{},
+			expectPass: true,
+		},
+		{
+			name:       "invalid type url",
+			permission: types.MsgsPermission{AllowedMsgs: types.AllowedMsgs{{TypeUrl: "cosmos.bank.v1beta1.MsgSend"}}},
+			expectPass: false,
+		},
+		{
+			name: "empty field",
+			permission: types.MsgsPermission{AllowedMsgs: types.AllowedMsgs{
+				{
+					TypeUrl:           "/cosmos.bank.v1beta1.MsgSend",
+					FieldRequirements: []types.MsgFieldRequirement{{Field: " ", AllowedValues: []string{"ufury"}}},
+				},
+			}},
+			expectPass: false,
+		},
+		{
+			name: "no allowed values",
+			permission: types.MsgsPermission{AllowedMsgs: types.AllowedMsgs{
+				{
+					TypeUrl:           "/cosmos.bank.v1beta1.MsgSend",
+					FieldRequirements: []types.MsgFieldRequirement{{Field: "amount.denom"}},
+				},
+			}},
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.permission.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func newTestMsgsProposal(t *testing.T, msgs ...sdk.Msg) types.PubProposal {
+	proposal, err := types.NewMsgsProposal("A Title", "A description for this proposal.", msgs)
+	require.NoError(t, err)
+	return &proposal
+}
+
 func newTestParamsChangeProposalWithChanges(changes []paramsproposal.ParamChange) types.PubProposal {
 	return paramsproposal.NewParameterChangeProposal(
 		"A Title",
@@ -300,3 +460,268 @@ func newTestParamsChangeProposalWithChanges(changes []paramsproposal.ParamChange
 		changes,
 	)
 }
+
+func TestCommitteeMembershipPermission_Allows(t *testing.T) {
+	member := sdk.AccAddress(crypto.AddressHash([]byte("MembershipPermissionMember")))
+	permission := types.CommitteeMembershipPermission{
+		MinMembers:       1,
+		MaxMembers:       3,
+		MinVoteThreshold: sdk.MustNewDecFromStr("0.5"),
+		MaxVoteThreshold: sdk.MustNewDecFromStr("0.8"),
+	}
+
+	testcases := []struct {
+		name          string
+		proposal      types.PubProposal
+		expectAllowed bool
+	}{
+		{
+			name:          "add member",
+			proposal:      &types.AddCommitteeMemberProposal{Title: "A Title", Description: "A description", CommitteeID: 1, Member: member},
+			expectAllowed: true,
+		},
+		{
+			name:          "add member with weight without weight limits",
+			proposal:      &types.AddCommitteeMemberProposal{Title: "A Title", Description: "A description", CommitteeID: 1, Member: member, Weight: sdk.NewDec(2)},
+			expectAllowed: false,
+		},
+		{
+			name:          "remove member",
+			proposal:      &types.RemoveCommitteeMemberProposal{Title: "A Title", Description: "A description", CommitteeID: 1, Member: member},
+			expectAllowed: true,
+		},
+		{
+			name:          "vote threshold within limits",
+			proposal:      &types.ChangeCommitteeVoteThresholdProposal{Title: "A Title", Description: "A description", CommitteeID: 1, VoteThreshold: sdk.MustNewDecFromStr("0.8")},
+			expectAllowed: true,
+		},
+		{
+			name:          "vote threshold below limit",
+			proposal:      &types.ChangeCommitteeVoteThresholdProposal{Title: "A Title", Description: "A description", CommitteeID: 1, VoteThreshold: sdk.MustNewDecFromStr("0.4")},
+			expectAllowed: false,
+		},
+		{
+			name:          "vote threshold above limit",
+			proposal:      &types.ChangeCommitteeVoteThresholdProposal{Title: "A Title", Description: "A description", CommitteeID: 1, VoteThreshold: sdk.MustNewDecFromStr("0.9")},
+			expectAllowed: false,
+		},
+		{
+			name:          "other proposal",
+			proposal:      govv1beta1.NewTextProposal("A Title", "A description"),
+			expectAllowed: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectAllowed, permission.Allows(sdk.Context{}, nil, tc.proposal))
+		})
+	}
+}
+
+func TestCommitteeMembershipPermission_AllowsMemberWeight(t *testing.T) {
+	member := sdk.AccAddress(crypto.AddressHash([]byte("MembershipPermissionMember")))
+	permission := types.CommitteeMembershipPermission{
+		MinMembers:       1,
+		MaxMembers:       3,
+		MinVoteThreshold: sdk.MustNewDecFromStr("0.5"),
+		MaxVoteThreshold: sdk.MustNewDecFromStr("0.8"),
+		MinMemberWeight:  sdk.NewDec(2),
+		MaxMemberWeight:  sdk.NewDec(5),
+	}
+
+	testcases := []struct {
+		name          string
+		weight        sdk.Dec
+		expectAllowed bool
+	}{
+		{
+			name:          "min weight",
+			weight:        sdk.NewDec(2),
+			expectAllowed: true,
+		},
+		{
+			name:          "max weight",
+			weight:        sdk.NewDec(5),
+			expectAllowed: true,
+		},
+		{
+			name:          "weight below limit",
+			weight:        sdk.MustNewDecFromStr("1.9"),
+			expectAllowed: false,
+		},
+		{
+			name:          "weight above limit",
+			weight:        sdk.MustNewDecFromStr("5.1"),
+			expectAllowed: false,
+		},
+		{
+			name:          "default weight below limit",
+			weight:        sdk.Dec{},
+			expectAllowed: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			proposal := types.NewWeightedAddCommitteeMemberProposal("A Title", "A description", 1, member, tc.weight)
+			require.Equal(t, tc.expectAllowed, permission.Allows(sdk.Context{}, nil, &proposal))
+		})
+	}
+}
+
+func TestCommitteeMembershipPermission_AllowsCommittee(t *testing.T) {
+	permission := types.CommitteeMembershipPermission{
+		MinMembers:       2,
+		MaxMembers:       3,
+		MinVoteThreshold: sdk.MustNewDecFromStr("0.5"),
+		MaxVoteThreshold: sdk.MustNewDecFromStr("0.8"),
+	}
+	members := []sdk.AccAddress{
+		sdk.AccAddress(crypto.AddressHash([]byte("MembershipPermissionMember1"))),
+		sdk.AccAddress(crypto.AddressHash([]byte("MembershipPermissionMember2"))),
+		sdk.AccAddress(crypto.AddressHash([]byte("MembershipPermissionMember3"))),
+		sdk.AccAddress(crypto.AddressHash([]byte("MembershipPermissionMember4"))),
+	}
+
+	testcases := []struct {
+		name          string
+		members       []sdk.AccAddress
+		threshold     sdk.Dec
+		expectAllowed bool
+	}{
+		{
+			name:          "within limits",
+			members:       members[:2],
+			threshold:     sdk.MustNewDecFromStr("0.5"),
+			expectAllowed: true,
+		},
+		{
+			name:          "too few members",
+			members:       members[:1],
+			threshold:     sdk.MustNewDecFromStr("0.5"),
+			expectAllowed: false,
+		},
+		{
+			name:          "too many members",
+			members:       members,
+			threshold:     sdk.MustNewDecFromStr("0.5"),
+			expectAllowed: false,
+		},
+		{
+			name:          "threshold out of limits",
+			members:       members[:3],
+			threshold:     sdk.OneDec(),
+			expectAllowed: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			committee := types.MustNewMemberCommittee(
+				1, "A description of this committee", tc.members, []types.Permission{&permission},
+				tc.threshold, time.Hour, types.TALLY_OPTION_FIRST_PAST_THE_POST,
+			)
+			require.Equal(t, tc.expectAllowed, permission.AllowsCommittee(committee))
+		})
+	}
+}
+
+func TestCommitteeMembershipPermission_Validate(t *testing.T) {
+	testcases := []struct {
+		name       string
+		permission types.CommitteeMembershipPermission
+		expectPass bool
+	}{
+		{
+			name: "normal",
+			permission: types.CommitteeMembershipPermission{
+				MinMembers: 1, MaxMembers: 5, MinVoteThreshold: sdk.MustNewDecFromStr("0.5"), MaxVoteThreshold: sdk.OneDec(),
+			},
+			expectPass: true,
+		},
+		{
+			name: "zero min members",
+			permission: types.CommitteeMembershipPermission{
+				MinMembers: 0, MaxMembers: 5, MinVoteThreshold: sdk.MustNewDecFromStr("0.5"), MaxVoteThreshold: sdk.OneDec(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "max members below min members",
+			permission: types.CommitteeMembershipPermission{
+				MinMembers: 3, MaxMembers: 2, MinVoteThreshold: sdk.MustNewDecFromStr("0.5"), MaxVoteThreshold: sdk.OneDec(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero min vote threshold",
+			permission: types.CommitteeMembershipPermission{
+				MinMembers: 1, MaxMembers: 5, MinVoteThreshold: sdk.ZeroDec(), MaxVoteThreshold: sdk.OneDec(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "max vote threshold above one",
+			permission: types.CommitteeMembershipPermission{
+				MinMembers: 1, MaxMembers: 5, MinVoteThreshold: sdk.MustNewDecFromStr("0.5"), MaxVoteThreshold: sdk.MustNewDecFromStr("1.1"),
+			},
+			expectPass: false,
+		},
+		{
+			name: "max vote threshold below min vote threshold",
+			permission: types.CommitteeMembershipPermission{
+				MinMembers: 1, MaxMembers: 5, MinVoteThreshold: sdk.MustNewDecFromStr("0.5"), MaxVoteThreshold: sdk.MustNewDecFromStr("0.4"),
+			},
+			expectPass: false,
+		},
+		{
+			name:       "nil vote thresholds",
+			permission: types.CommitteeMembershipPermission{MinMembers: 1, MaxMembers: 5},
+			expectPass: false,
+		},
+		{
+			name: "member weight limits",
+			permission: types.CommitteeMembershipPermission{
+				MinMembers: 1, MaxMembers: 5, MinVoteThreshold: sdk.MustNewDecFromStr("0.5"), MaxVoteThreshold: sdk.OneDec(),
+				MinMemberWeight: sdk.OneDec(), MaxMemberWeight: sdk.NewDec(10),
+			},
+			expectPass: true,
+		},
+		{
+			name: "min member weight without max member weight",
+			permission: types.CommitteeMembershipPermission{
+				MinMembers: 1, MaxMembers: 5, MinVoteThreshold: sdk.MustNewDecFromStr("0.5"), MaxVoteThreshold: sdk.OneDec(),
+				MinMemberWeight: sdk.OneDec(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "max member weight without min member weight",
+			permission: types.CommitteeMembershipPermission{
+				MinMembers: 1, MaxMembers: 5, MinVoteThreshold: sdk.MustNewDecFromStr("0.5"), MaxVoteThreshold: sdk.OneDec(),
+				MaxMemberWeight: sdk.NewDec(10),
+			},
+			expectPass: false,
+		},
+		{
+			name: "max member weight below min member weight",
+			permission: types.CommitteeMembershipPermission{
+				MinMembers: 1, MaxMembers: 5, MinVoteThreshold: sdk.MustNewDecFromStr("0.5"), MaxVoteThreshold: sdk.OneDec(),
+				MinMemberWeight: sdk.NewDec(10), MaxMemberWeight: sdk.NewDec(5),
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.permission.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
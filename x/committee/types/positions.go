@@ -0,0 +1,99 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// HolderPositions reads the tokens an address holds in other modules and values them in a tally denom.
+// It is shared by token committee tallies and the gov tally handler so both count positions the same way.
+type HolderPositions struct {
+	stakingKeeper StakingKeeper
+	liquidKeeper  LiquidKeeper
+	savingsKeeper SavingsKeeper
+	earnKeeper    EarnKeeper
+}
+
+// NewHolderPositions returns a new HolderPositions
+func NewHolderPositions(stk StakingKeeper, lk LiquidKeeper, svk SavingsKeeper, ek EarnKeeper) HolderPositions {
+	return HolderPositions{
+		stakingKeeper: stk,
+		liquidKeeper:  lk,
+		savingsKeeper: svk,
+		earnKeeper:    ek,
+	}
+}
+
+// StakedBalance returns the tokens an address has delegated to validators.
+func (hp HolderPositions) StakedBalance(ctx sdk.Context, addr sdk.AccAddress) sdkmath.Int {
+	staked := sdk.ZeroInt()
+	hp.stakingKeeper.IterateDelegatorDelegations(ctx, addr, func(delegation stakingtypes.Delegation) (stop bool) {
+		validator, found := hp.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
+		if found {
+			staked = staked.Add(validator.TokensFromSharesTruncated(delegation.GetShares()).TruncateInt())
+		}
+		return false
+	})
+	return staked
+}
+
+// SavingsDeposits returns the coins an address has deposited in x/savings.
+func (hp HolderPositions) SavingsDeposits(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	deposit, found := hp.savingsKeeper.GetDeposit(ctx, addr)
+	if !found {
+		return sdk.NewCoins()
+	}
+	return deposit.Amount
+}
+
+// EarnDeposits returns the assets of an address's x/earn vault shares.
+func (hp HolderPositions) EarnDeposits(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	deposits := sdk.NewCoins()
+	shares, found := hp.earnKeeper.GetVaultAccountShares(ctx, addr)
+	if !found {
+		return deposits
+	}
+	for _, share := range shares {
+		if coin, err := hp.earnKeeper.ConvertToAssets(ctx, share); err == nil {
+			deposits = deposits.Add(coin)
+		}
+	}
+	return deposits
+}
+
+// Derivatives returns the liquid staking derivatives in coins.
+func (hp HolderPositions) Derivatives(ctx sdk.Context, coins sdk.Coins) sdk.Coins {
+	derivatives := sdk.NewCoins()
+	for _, coin := range coins {
+		if hp.liquidKeeper.IsDerivativeDenom(ctx, coin.Denom) {
+			derivatives = derivatives.Add(coin)
+		}
+	}
+	return derivatives
+}
+
+// DerivativesValue returns the staked tokens backing the liquid staking derivatives in coins.
+func (hp HolderPositions) DerivativesValue(ctx sdk.Context, coins sdk.Coins) sdkmath.Int {
+	derivatives := hp.Derivatives(ctx, coins)
+	if derivatives.IsZero() {
+		return sdk.ZeroInt()
+	}
+
+	stakedTokens, err := hp.liquidKeeper.GetStakedTokensForDerivatives(ctx, derivatives)
+	if err != nil {
+		// error is returned only if a derivative denom is incorrect, which should never happen here.
+		panic(err)
+	}
+	return stakedTokens.Amount
+}
+
+// Value returns the value of coins in a tally denom: their amount of the tally denom, plus the staked tokens backing
+// any liquid staking derivatives when the tally denom is the bond denom.
+func (hp HolderPositions) Value(ctx sdk.Context, tallyDenom string, coins sdk.Coins) sdkmath.Int {
+	value := coins.AmountOf(tallyDenom)
+	if tallyDenom == BondDenom {
+		value = value.Add(hp.DerivativesValue(ctx, coins))
+	}
+	return value
+}
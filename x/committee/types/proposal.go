@@ -3,12 +3,21 @@ package types
 import (
 	errorsmod "cosmossdk.io/errors"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
 const (
 	ProposalTypeCommitteeChange = "CommitteeChange"
 	ProposalTypeCommitteeDelete = "CommitteeDelete"
+	ProposalTypeMsgs            = "Msgs"
+	ProposalTypeVeto            = "Veto"
+
+	ProposalTypeAddCommitteeMember           = "AddCommitteeMember"
+	ProposalTypeRemoveCommitteeMember        = "RemoveCommitteeMember"
+	ProposalTypeChangeCommitteeVoteThreshold = "ChangeCommitteeVoteThreshold"
 )
 
 // ProposalOutcome indicates the status of a proposal when it's closed and deleted from the store
@@ -21,12 +30,18 @@ const (
 	Failed
 	// Invalid indicates that proposal passed but an error occurred when attempting to enact it
 	Invalid
+	// Queued indicates that the proposal passed and is waiting for the execution delay of its committee to end
+	Queued
+	// Vetoed indicates that the proposal passed but was vetoed while it was queued
+	Vetoed
 )
 
 var toString = map[ProposalOutcome]string{
 	Passed:  "Passed",
 	Failed:  "Failed",
 	Invalid: "Invalid",
+	Queued:  "Queued",
+	Vetoed:  "Vetoed",
 }
 
 func (p ProposalOutcome) String() string {
@@ -34,16 +49,29 @@ func (p ProposalOutcome) String() string {
 }
 
 // ensure proposal types fulfill the PubProposal interface and the gov Content interface.
-var _, _ govv1beta1.Content = &CommitteeChangeProposal{}, &CommitteeDeleteProposal{}
-var _, _ PubProposal = &CommitteeChangeProposal{}, &CommitteeDeleteProposal{}
+var _, _, _, _ govv1beta1.Content = &CommitteeChangeProposal{}, &CommitteeDeleteProposal{}, &MsgsProposal{}, &VetoProposal{}
+var _, _, _, _ PubProposal = &CommitteeChangeProposal{}, &CommitteeDeleteProposal{}, &MsgsProposal{}, &VetoProposal{}
+
+// ensure membership change proposal types fulfill the MembershipChangeProposal interface
+var _, _, _ MembershipChangeProposal = &AddCommitteeMemberProposal{}, &RemoveCommitteeMemberProposal{}, &ChangeCommitteeVoteThresholdProposal{}
 
-// ensure CommitteeChangeProposal fulfill the codectypes.UnpackInterfacesMessage interface
-var _ codectypes.UnpackInterfacesMessage = &CommitteeChangeProposal{}
+// ensure CommitteeChangeProposal and MsgsProposal fulfill the codectypes.UnpackInterfacesMessage interface
+var _, _ codectypes.UnpackInterfacesMessage = &CommitteeChangeProposal{}, &MsgsProposal{}
 
 func init() {
 	// Gov proposals need to be registered on gov's ModuleCdc so MsgSubmitProposal can be encoded.
 	govv1beta1.RegisterProposalType(ProposalTypeCommitteeChange)
 	govv1beta1.RegisterProposalType(ProposalTypeCommitteeDelete)
+	govv1beta1.RegisterProposalType(ProposalTypeMsgs)
+	govv1beta1.RegisterProposalType(ProposalTypeVeto)
+	govv1beta1.RegisterProposalType(ProposalTypeAddCommitteeMember)
+	govv1beta1.RegisterProposalType(ProposalTypeRemoveCommitteeMember)
+	govv1beta1.RegisterProposalType(ProposalTypeChangeCommitteeVoteThreshold)
+}
+
+// GetAuthority returns the address messages of a MsgsProposal are executed as.
+func GetAuthority() sdk.AccAddress {
+	return authtypes.NewModuleAddress(ModuleName)
 }
 
 func NewCommitteeChangeProposal(title string, description string, newCommittee Committee) (CommitteeChangeProposal, error) {
@@ -132,3 +160,320 @@ func (cdp CommitteeDeleteProposal) ProposalType() string { return ProposalTypeCo
 func (cdp CommitteeDeleteProposal) ValidateBasic() error {
 	return govv1beta1.ValidateAbstract(&cdp)
 }
+
+// NewMsgsProposal returns a new MsgsProposal executing msgs
+func NewMsgsProposal(title string, description string, msgs []sdk.Msg) (MsgsProposal, error) {
+	msgsAny, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return MsgsProposal{}, err
+	}
+	return MsgsProposal{
+		Title:       title,
+		Description: description,
+		Messages:    msgsAny,
+	}, nil
+}
+
+// MustNewMsgsProposal returns a new MsgsProposal, panicking on error
+func MustNewMsgsProposal(title string, description string, msgs []sdk.Msg) MsgsProposal {
+	proposal, err := NewMsgsProposal(title, description, msgs)
+	if err != nil {
+		panic(err)
+	}
+	return proposal
+}
+
+// GetTitle returns the title of the proposal.
+func (mp MsgsProposal) GetTitle() string { return mp.Title }
+
+// GetDescription returns the description of the proposal.
+func (mp MsgsProposal) GetDescription() string { return mp.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (mp MsgsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (mp MsgsProposal) ProposalType() string { return ProposalTypeMsgs }
+
+// GetMsgs unpacks the messages of the proposal.
+func (mp MsgsProposal) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(mp.Messages, "committee msgs proposal")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (mp MsgsProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, mp.Messages)
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (mp MsgsProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(&mp); err != nil {
+		return err
+	}
+	if len(mp.Messages) == 0 {
+		return errorsmod.Wrap(ErrInvalidPubProposal, "proposal must contain messages")
+	}
+
+	msgs, err := mp.GetMsgs()
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidPubProposal, err.Error())
+	}
+	authority := GetAuthority()
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidPubProposal, "msg %d: %s", i, err)
+		}
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(authority) {
+			return errorsmod.Wrapf(ErrInvalidPubProposal, "msg %d must only be signed by the committee authority %s", i, authority)
+		}
+	}
+	return nil
+}
+
+// NewVetoProposal returns a new VetoProposal cancelling a queued proposal
+func NewVetoProposal(title string, description string, proposalID uint64) VetoProposal {
+	return VetoProposal{
+		Title:       title,
+		Description: description,
+		ProposalID:  proposalID,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (vp VetoProposal) GetTitle() string { return vp.Title }
+
+// GetDescription returns the description of the proposal.
+func (vp VetoProposal) GetDescription() string { return vp.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (vp VetoProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (vp VetoProposal) ProposalType() string { return ProposalTypeVeto }
+
+// ValidateBasic runs basic stateless validity checks
+func (vp VetoProposal) ValidateBasic() error {
+	return govv1beta1.ValidateAbstract(&vp)
+}
+
+// MembershipChangeProposal is a proposal a committee passes to change its own members or vote threshold.
+type MembershipChangeProposal interface {
+	PubProposal
+
+	// GetCommitteeID returns the ID of the committee changed by the proposal.
+	GetCommitteeID() uint64
+	// GetMembershipChangeType returns the type of change the proposal makes.
+	GetMembershipChangeType() MembershipChangeType
+	// GetMember returns the member added or removed by the proposal, if any.
+	GetMember() sdk.AccAddress
+	// ApplyTo makes the change of the proposal to a committee.
+	ApplyTo(committee Committee) error
+}
+
+// NewAddCommitteeMemberProposal returns a new AddCommitteeMemberProposal
+func NewAddCommitteeMemberProposal(title string, description string, committeeID uint64, member sdk.AccAddress) AddCommitteeMemberProposal {
+	return AddCommitteeMemberProposal{
+		Title:       title,
+		Description: description,
+		CommitteeID: committeeID,
+		Member:      member,
+	}
+}
+
+// NewWeightedAddCommitteeMemberProposal returns a new AddCommitteeMemberProposal that adds a member to a weighted member
+// committee with a voting weight.
+func NewWeightedAddCommitteeMemberProposal(
+	title string, description string, committeeID uint64, member sdk.AccAddress, weight sdk.Dec,
+) AddCommitteeMemberProposal {
+	proposal := NewAddCommitteeMemberProposal(title, description, committeeID, member)
+	proposal.Weight = weight
+	return proposal
+}
+
+// GetTitle returns the title of the proposal.
+func (p AddCommitteeMemberProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal.
+func (p AddCommitteeMemberProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (p AddCommitteeMemberProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (p AddCommitteeMemberProposal) ProposalType() string { return ProposalTypeAddCommitteeMember }
+
+// GetCommitteeID returns the ID of the committee changed by the proposal.
+func (p AddCommitteeMemberProposal) GetCommitteeID() uint64 { return p.CommitteeID }
+
+// GetMembershipChangeType returns the type of change the proposal makes.
+func (p AddCommitteeMemberProposal) GetMembershipChangeType() MembershipChangeType {
+	return MEMBERSHIP_CHANGE_TYPE_ADD_MEMBER
+}
+
+// GetMember returns the member added by the proposal.
+func (p AddCommitteeMemberProposal) GetMember() sdk.AccAddress { return p.Member }
+
+// GetWeight returns the voting weight the member is added with to a weighted member committee, one if it is not set.
+func (p AddCommitteeMemberProposal) GetWeight() sdk.Dec {
+	if !p.hasWeight() {
+		return sdk.OneDec()
+	}
+	return p.Weight
+}
+
+// hasWeight returns whether the proposal sets the weight of the member. An unset weight is decoded as zero.
+func (p AddCommitteeMemberProposal) hasWeight() bool {
+	return !p.Weight.IsNil() && !p.Weight.IsZero()
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p AddCommitteeMemberProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(&p); err != nil {
+		return err
+	}
+	if p.Member.Empty() {
+		return errorsmod.Wrap(ErrInvalidPubProposal, "member address cannot be empty")
+	}
+	if p.hasWeight() && !p.Weight.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidPubProposal, "invalid member weight: %s", p.Weight)
+	}
+	return nil
+}
+
+// ApplyTo adds the member to a committee. Members of weighted member committees are added with the weight of the
+// proposal.
+func (p AddCommitteeMemberProposal) ApplyTo(committee Committee) error {
+	if committee.HasMember(p.Member) {
+		return errorsmod.Wrapf(ErrInvalidPubProposal, "%s is already a member of committee %d", p.Member, committee.GetID())
+	}
+
+	if weighted, ok := committee.(*WeightedMemberCommittee); ok {
+		weights := make([]MemberWeight, 0, len(weighted.MemberWeights)+1)
+		weights = append(weights, weighted.MemberWeights...)
+		weighted.SetMemberWeights(append(weights, NewMemberWeight(p.Member, p.GetWeight())))
+		return nil
+	}
+	if p.hasWeight() {
+		return errorsmod.Wrapf(ErrInvalidPubProposal, "member weights cannot be set for committee %d", committee.GetID())
+	}
+
+	members := make([]sdk.AccAddress, 0, len(committee.GetMembers())+1)
+	members = append(members, committee.GetMembers()...)
+	committee.SetMembers(append(members, p.Member))
+	return nil
+}
+
+// NewRemoveCommitteeMemberProposal returns a new RemoveCommitteeMemberProposal
+func NewRemoveCommitteeMemberProposal(title string, description string, committeeID uint64, member sdk.AccAddress) RemoveCommitteeMemberProposal {
+	return RemoveCommitteeMemberProposal{
+		Title:       title,
+		Description: description,
+		CommitteeID: committeeID,
+		Member:      member,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (p RemoveCommitteeMemberProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal.
+func (p RemoveCommitteeMemberProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (p RemoveCommitteeMemberProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (p RemoveCommitteeMemberProposal) ProposalType() string {
+	return ProposalTypeRemoveCommitteeMember
+}
+
+// GetCommitteeID returns the ID of the committee changed by the proposal.
+func (p RemoveCommitteeMemberProposal) GetCommitteeID() uint64 { return p.CommitteeID }
+
+// GetMembershipChangeType returns the type of change the proposal makes.
+func (p RemoveCommitteeMemberProposal) GetMembershipChangeType() MembershipChangeType {
+	return MEMBERSHIP_CHANGE_TYPE_REMOVE_MEMBER
+}
+
+// GetMember returns the member removed by the proposal.
+func (p RemoveCommitteeMemberProposal) GetMember() sdk.AccAddress { return p.Member }
+
+// ValidateBasic runs basic stateless validity checks
+func (p RemoveCommitteeMemberProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(&p); err != nil {
+		return err
+	}
+	if p.Member.Empty() {
+		return errorsmod.Wrap(ErrInvalidPubProposal, "member address cannot be empty")
+	}
+	return nil
+}
+
+// ApplyTo removes the member from a committee.
+func (p RemoveCommitteeMemberProposal) ApplyTo(committee Committee) error {
+	if !committee.HasMember(p.Member) {
+		return errorsmod.Wrapf(ErrInvalidPubProposal, "%s is not a member of committee %d", p.Member, committee.GetID())
+	}
+	members := make([]sdk.AccAddress, 0, len(committee.GetMembers()))
+	for _, m := range committee.GetMembers() {
+		if !m.Equals(p.Member) {
+			members = append(members, m)
+		}
+	}
+	committee.SetMembers(members)
+	return nil
+}
+
+// NewChangeCommitteeVoteThresholdProposal returns a new ChangeCommitteeVoteThresholdProposal
+func NewChangeCommitteeVoteThresholdProposal(title string, description string, committeeID uint64, voteThreshold sdk.Dec) ChangeCommitteeVoteThresholdProposal {
+	return ChangeCommitteeVoteThresholdProposal{
+		Title:         title,
+		Description:   description,
+		CommitteeID:   committeeID,
+		VoteThreshold: voteThreshold,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (p ChangeCommitteeVoteThresholdProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal.
+func (p ChangeCommitteeVoteThresholdProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (p ChangeCommitteeVoteThresholdProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (p ChangeCommitteeVoteThresholdProposal) ProposalType() string {
+	return ProposalTypeChangeCommitteeVoteThreshold
+}
+
+// GetCommitteeID returns the ID of the committee changed by the proposal.
+func (p ChangeCommitteeVoteThresholdProposal) GetCommitteeID() uint64 { return p.CommitteeID }
+
+// GetMembershipChangeType returns the type of change the proposal makes.
+func (p ChangeCommitteeVoteThresholdProposal) GetMembershipChangeType() MembershipChangeType {
+	return MEMBERSHIP_CHANGE_TYPE_CHANGE_VOTE_THRESHOLD
+}
+
+// GetMember returns nil as the proposal does not change the members.
+func (p ChangeCommitteeVoteThresholdProposal) GetMember() sdk.AccAddress { return nil }
+
+// ValidateBasic runs basic stateless validity checks
+func (p ChangeCommitteeVoteThresholdProposal) ValidateBasic() error {
+	if err := govv1beta1.ValidateAbstract(&p); err != nil {
+		return err
+	}
+	// threshold must be in the range (0, 1]
+	if p.VoteThreshold.IsNil() || !p.VoteThreshold.IsPositive() || p.VoteThreshold.GT(sdk.OneDec()) {
+		return errorsmod.Wrapf(ErrInvalidPubProposal, "invalid threshold: %s", p.VoteThreshold)
+	}
+	return nil
+}
+
+// ApplyTo sets the vote threshold of a committee.
+func (p ChangeCommitteeVoteThresholdProposal) ApplyTo(committee Committee) error {
+	committee.SetVoteThreshold(p.VoteThreshold)
+	return nil
+}
@@ -0,0 +1,259 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/incubus-network/fury/x/committee/types"
+)
+
+func TestMsgsProposal_ValidateBasic(t *testing.T) {
+	authority := types.GetAuthority()
+	otherAddress := sdk.AccAddress(crypto.AddressHash([]byte("MsgsProposalRecipient")))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("ufury", 100))
+
+	testcases := []struct {
+		name       string
+		title      string
+		msgs       []sdk.Msg
+		expectPass bool
+	}{
+		{
+			name:       "normal",
+			title:      "A Title",
+			msgs:       []sdk.Msg{banktypes.NewMsgSend(authority, otherAddress, coins)},
+			expectPass: true,
+		},
+		{
+			name:       "no msgs",
+			title:      "A Title",
+			msgs:       []sdk.Msg{},
+			expectPass: false,
+		},
+		{
+			name:       "missing title",
+			title:      "",
+			msgs:       []sdk.Msg{banktypes.NewMsgSend(authority, otherAddress, coins)},
+			expectPass: false,
+		},
+		{
+			name:       "invalid msg",
+			title:      "A Title",
+			msgs:       []sdk.Msg{banktypes.NewMsgSend(authority, otherAddress, sdk.Coins{})},
+			expectPass: false,
+		},
+		{
+			name:       "msg not signed by the authority",
+			title:      "A Title",
+			msgs:       []sdk.Msg{banktypes.NewMsgSend(otherAddress, authority, coins)},
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			proposal, err := types.NewMsgsProposal(tc.title, "A description of this proposal.", tc.msgs)
+			require.NoError(t, err)
+
+			err = proposal.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMembershipChangeProposals_ApplyTo(t *testing.T) {
+	memberA := sdk.AccAddress(crypto.AddressHash([]byte("MembershipMemberA")))
+	memberB := sdk.AccAddress(crypto.AddressHash([]byte("MembershipMemberB")))
+	newCommittee := func() types.Committee {
+		return types.MustNewMemberCommittee(
+			1, "A description of this committee", []sdk.AccAddress{memberA},
+			[]types.Permission{&types.GodPermission{}}, sdk.MustNewDecFromStr("0.5"), time.Hour,
+			types.TALLY_OPTION_FIRST_PAST_THE_POST,
+		)
+	}
+
+	testcases := []struct {
+		name            string
+		proposal        types.MembershipChangeProposal
+		expectPass      bool
+		expectMembers   []sdk.AccAddress
+		expectThreshold sdk.Dec
+	}{
+		{
+			name:            "add member",
+			proposal:        &types.AddCommitteeMemberProposal{Title: "A Title", Description: "A description", CommitteeID: 1, Member: memberB},
+			expectPass:      true,
+			expectMembers:   []sdk.AccAddress{memberA, memberB},
+			expectThreshold: sdk.MustNewDecFromStr("0.5"),
+		},
+		{
+			name:       "add existing member",
+			proposal:   &types.AddCommitteeMemberProposal{Title: "A Title", Description: "A description", CommitteeID: 1, Member: memberA},
+			expectPass: false,
+		},
+		{
+			name:            "remove member",
+			proposal:        &types.RemoveCommitteeMemberProposal{Title: "A Title", Description: "A description", CommitteeID: 1, Member: memberA},
+			expectPass:      true,
+			expectMembers:   []sdk.AccAddress{},
+			expectThreshold: sdk.MustNewDecFromStr("0.5"),
+		},
+		{
+			name:       "remove non member",
+			proposal:   &types.RemoveCommitteeMemberProposal{Title: "A Title", Description: "A description", CommitteeID: 1, Member: memberB},
+			expectPass: false,
+		},
+		{
+			name:            "change vote threshold",
+			proposal:        &types.ChangeCommitteeVoteThresholdProposal{Title: "A Title", Description: "A description", CommitteeID: 1, VoteThreshold: sdk.MustNewDecFromStr("0.8")},
+			expectPass:      true,
+			expectMembers:   []sdk.AccAddress{memberA},
+			expectThreshold: sdk.MustNewDecFromStr("0.8"),
+		},
+		{
+			name:       "add member with weight",
+			proposal:   &types.AddCommitteeMemberProposal{Title: "A Title", Description: "A description", CommitteeID: 1, Member: memberB, Weight: sdk.NewDec(2)},
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			committee := newCommittee()
+			err := tc.proposal.ApplyTo(committee)
+			if tc.expectPass {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectMembers, committee.GetMembers())
+				require.Equal(t, tc.expectThreshold, committee.GetVoteThreshold())
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidPubProposal)
+			}
+		})
+	}
+}
+
+func TestAddCommitteeMemberProposal_ApplyToWeightedCommittee(t *testing.T) {
+	memberA := sdk.AccAddress(crypto.AddressHash([]byte("MembershipMemberA")))
+	memberB := sdk.AccAddress(crypto.AddressHash([]byte("MembershipMemberB")))
+	newCommittee := func() *types.WeightedMemberCommittee {
+		return types.MustNewWeightedMemberCommittee(
+			1, "A description of this committee", []types.MemberWeight{types.NewMemberWeight(memberA, sdk.NewDec(5))},
+			[]types.Permission{&types.GodPermission{}}, sdk.MustNewDecFromStr("0.5"), time.Hour,
+			types.TALLY_OPTION_FIRST_PAST_THE_POST,
+		)
+	}
+
+	testcases := []struct {
+		name          string
+		proposal      types.AddCommitteeMemberProposal
+		expectWeights []types.MemberWeight
+	}{
+		{
+			name:     "weight is set",
+			proposal: types.NewWeightedAddCommitteeMemberProposal("A Title", "A description", 1, memberB, sdk.NewDec(5)),
+			expectWeights: []types.MemberWeight{
+				types.NewMemberWeight(memberA, sdk.NewDec(5)),
+				types.NewMemberWeight(memberB, sdk.NewDec(5)),
+			},
+		},
+		{
+			name:     "weight defaults to one",
+			proposal: types.NewAddCommitteeMemberProposal("A Title", "A description", 1, memberB),
+			expectWeights: []types.MemberWeight{
+				types.NewMemberWeight(memberA, sdk.NewDec(5)),
+				types.NewMemberWeight(memberB, sdk.OneDec()),
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			committee := newCommittee()
+			require.NoError(t, tc.proposal.ApplyTo(committee))
+			require.Equal(t, tc.expectWeights, committee.MemberWeights)
+			require.Equal(t, []sdk.AccAddress{memberA, memberB}, committee.GetMembers())
+			require.NoError(t, committee.Validate())
+		})
+	}
+}
+
+func TestMembershipChangeProposals_ValidateBasic(t *testing.T) {
+	member := sdk.AccAddress(crypto.AddressHash([]byte("MembershipMemberC")))
+
+	testcases := []struct {
+		name       string
+		proposal   types.PubProposal
+		expectPass bool
+	}{
+		{
+			name:       "add member",
+			proposal:   &types.AddCommitteeMemberProposal{Title: "A Title", Description: "A description", CommitteeID: 1, Member: member},
+			expectPass: true,
+		},
+		{
+			name:       "add empty member",
+			proposal:   &types.AddCommitteeMemberProposal{Title: "A Title", Description: "A description", CommitteeID: 1},
+			expectPass: false,
+		},
+		{
+			name:       "add member with weight",
+			proposal:   &types.AddCommitteeMemberProposal{Title: "A Title", Description: "A description", CommitteeID: 1, Member: member, Weight: sdk.NewDec(3)},
+			expectPass: true,
+		},
+		{
+			name:       "add member with negative weight",
+			proposal:   &types.AddCommitteeMemberProposal{Title: "A Title", Description: "A description", CommitteeID: 1, Member: member, Weight: sdk.NewDec(-1)},
+			expectPass: false,
+		},
+		{
+			name:       "remove member missing title",
+			proposal:   &types.RemoveCommitteeMemberProposal{Description: "A description", CommitteeID: 1, Member: member},
+			expectPass: false,
+		},
+		{
+			name:       "remove empty member",
+			proposal:   &types.RemoveCommitteeMemberProposal{Title: "A Title", Description: "A description", CommitteeID: 1},
+			expectPass: false,
+		},
+		{
+			name:       "change vote threshold",
+			proposal:   &types.ChangeCommitteeVoteThresholdProposal{Title: "A Title", Description: "A description", CommitteeID: 1, VoteThreshold: sdk.OneDec()},
+			expectPass: true,
+		},
+		{
+			name:       "zero vote threshold",
+			proposal:   &types.ChangeCommitteeVoteThresholdProposal{Title: "A Title", Description: "A description", CommitteeID: 1, VoteThreshold: sdk.ZeroDec()},
+			expectPass: false,
+		},
+		{
+			name:       "vote threshold above one",
+			proposal:   &types.ChangeCommitteeVoteThresholdProposal{Title: "A Title", Description: "A description", CommitteeID: 1, VoteThreshold: sdk.MustNewDecFromStr("1.1")},
+			expectPass: false,
+		},
+		{
+			name:       "nil vote threshold",
+			proposal:   &types.ChangeCommitteeVoteThresholdProposal{Title: "A Title", Description: "A description", CommitteeID: 1},
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -38,7 +38,7 @@ func (b lendGenesisBuilder) WithMarket(denom, spotMarketId string, price sdk.Dec
 			hardtypes.NewBorrowLimit(false, sdk.NewDec(1e15), sdk.MustNewDecFromStr("0.6")),
 			spotMarketId,
 			sdkmath.NewInt(1e6),
-			hardtypes.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+			hardtypes.NewJumpRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
 			sdk.MustNewDecFromStr("0.05"),
 			sdk.ZeroDec(),
 		),
@@ -0,0 +1,17 @@
+package earn
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fury/x/earn/keeper"
+)
+
+// BeginBlocker compounds the rewards of auto-compounding vaults, charges
+// performance fees, records vault share prices, and moves the assets of
+// multi-strategy vaults towards their target weights
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.CompoundVaults(ctx)
+	k.ChargePerformanceFees(ctx)
+	k.SnapshotVaults(ctx)
+	k.RebalanceVaults(ctx)
+}
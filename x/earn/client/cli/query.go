@@ -34,6 +34,8 @@ func GetQueryCmd() *cobra.Command {
 		queryParamsCmd(),
 		queryVaultsCmd(),
 		queryVaultCmd(),
+		queryVaultFeesCmd(),
+		queryVaultPerformanceCmd(),
 		queryDepositsCmd(),
 		queryTotalSupplyCmd(),
 	}
@@ -124,6 +126,67 @@ func queryVaultCmd() *cobra.Command {
 	}
 }
 
+func queryVaultFeesCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "vault-fees",
+		Short:   "get the fees of an earn vault",
+		Long:    "Get the fee params, high-water mark and accrued fees of an earn module vault by denom.",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s q %[2]s vault-fees usdx`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.NewQueryVaultFeesRequest(args[0])
+			res, err := queryClient.VaultFees(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryVaultPerformanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "vault-performance",
+		Short:   "get the performance of an earn vault",
+		Long:    "Get the share price history and realized APY of an earn module vault by denom.",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s q %[2]s vault-performance usdx`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.NewQueryVaultPerformanceRequest(args[0], pageReq)
+			res, err := queryClient.VaultPerformance(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "snapshots")
+
+	return cmd
+}
+
 func queryDepositsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "deposits",
@@ -29,6 +29,8 @@ func GetTxCmd() *cobra.Command {
 	cmds := []*cobra.Command{
 		getCmdDeposit(),
 		getCmdWithdraw(),
+		getCmdTokenizeShares(),
+		getCmdRedeemTokens(),
 	}
 
 	for _, cmd := range cmds {
@@ -112,6 +114,68 @@ func getCmdWithdraw() *cobra.Command {
 	}
 }
 
+func getCmdTokenizeShares() *cobra.Command {
+	return &cobra.Command{
+		Use:   "tokenize-shares [amount]",
+		Short: "convert earn vault shares into transferable share tokens",
+		Example: fmt.Sprintf(
+			`%s tx %s tokenize-shares 10000000usdx --from <key>`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgTokenizeShares(signer.String(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+func getCmdRedeemTokens() *cobra.Command {
+	return &cobra.Command{
+		Use:   "redeem-tokens [amount]",
+		Short: "convert share tokens back into earn vault shares",
+		Example: fmt.Sprintf(
+			`%s tx %s redeem-tokens 10000000%susdx --from <key>`,
+			version.AppName, types.ModuleName, types.ShareTokenPrefix,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgRedeemTokens(signer.String(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
 // GetCmdSubmitCommunityPoolDepositProposal implements the command to submit a community-pool deposit proposal
 func GetCmdSubmitCommunityPoolDepositProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -50,6 +50,26 @@ func InitGenesis(
 		k.SetVaultRecord(ctx, vaultRecord)
 	}
 
+	for _, deposit := range gs.CdpSavingsDeposits {
+		k.SetCdpSavingsDeposit(ctx, deposit)
+	}
+
+	for _, record := range gs.VaultRewardsRecords {
+		k.SetVaultRewardsRecord(ctx, record)
+	}
+
+	for _, record := range gs.VaultFeeRecords {
+		k.SetVaultFeeRecord(ctx, record)
+	}
+
+	for _, snapshot := range gs.VaultSharePriceSnapshots {
+		k.SetSharePriceSnapshot(ctx, snapshot)
+	}
+
+	for _, residual := range gs.SwapLPResiduals {
+		k.SetSwapLPResidual(ctx, residual)
+	}
+
 	k.SetParams(ctx, gs.Params)
 }
 
@@ -58,6 +78,20 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	params := k.GetParams(ctx)
 	vaultRecords := k.GetAllVaultRecords(ctx)
 	vaultShareRecords := k.GetAllVaultShareRecords(ctx)
+	cdpSavingsDeposits := k.GetAllCdpSavingsDeposits(ctx)
+	vaultRewardsRecords := k.GetAllVaultRewardsRecords(ctx)
+	vaultFeeRecords := k.GetAllVaultFeeRecords(ctx)
+	vaultSharePriceSnapshots := k.GetAllSharePriceSnapshots(ctx)
+	swapLPResiduals := k.GetAllSwapLPResiduals(ctx)
 
-	return types.NewGenesisState(params, vaultRecords, vaultShareRecords)
+	return types.NewGenesisState(
+		params,
+		vaultRecords,
+		vaultShareRecords,
+		cdpSavingsDeposits,
+		vaultRewardsRecords,
+		vaultFeeRecords,
+		vaultSharePriceSnapshots,
+		swapLPResiduals,
+	)
 }
@@ -2,6 +2,7 @@ package earn_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/incubus-network/fury/app"
 	"github.com/incubus-network/fury/x/earn"
@@ -35,6 +36,11 @@ func (suite *genesisTestSuite) Test_InitGenesis_ValidationPanic() {
 			},
 		},
 		types.VaultShareRecords{},
+		types.CdpSavingsDeposits{},
+		types.VaultRewardsRecords{},
+		types.VaultFeeRecords{},
+		types.VaultSharePriceSnapshots{},
+		types.SwapLPResiduals{},
 	)
 
 	suite.Panics(func() {
@@ -90,6 +96,22 @@ func (suite *genesisTestSuite) Test_InitAndExportGenesis() {
 				),
 			},
 		},
+		types.CdpSavingsDeposits{
+			types.NewCdpSavingsDeposit("ufury", sdk.NewInt64Coin("usdx", 1000000)),
+		},
+		types.VaultRewardsRecords{
+			types.NewVaultRewardsRecord("usdx", sdk.NewCoins(sdk.NewInt64Coin("hard", 1000))),
+		},
+		types.VaultFeeRecords{
+			types.NewVaultFeeRecord("usdx", sdk.MustNewDecFromStr("1.05")),
+		},
+		types.VaultSharePriceSnapshots{
+			types.NewVaultSharePriceSnapshot("usdx", 100, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), sdk.OneDec()),
+			types.NewVaultSharePriceSnapshot("usdx", 200, time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC), sdk.MustNewDecFromStr("1.05")),
+		},
+		types.SwapLPResiduals{
+			types.NewSwapLPResidual("usdx", sdk.NewCoins(sdk.NewInt64Coin("ufury", 3), sdk.NewInt64Coin("usdx", 2))),
+		},
 	)
 
 	earn.InitGenesis(suite.Ctx, suite.Keeper, suite.AccountKeeper, state)
@@ -158,6 +180,22 @@ func (suite *genesisTestSuite) Test_Marshall() {
 				),
 			},
 		},
+		types.CdpSavingsDeposits{
+			types.NewCdpSavingsDeposit("ufury", sdk.NewInt64Coin("usdx", 1000000)),
+		},
+		types.VaultRewardsRecords{
+			types.NewVaultRewardsRecord("usdx", sdk.NewCoins(sdk.NewInt64Coin("hard", 1000))),
+		},
+		types.VaultFeeRecords{
+			types.NewVaultFeeRecord("usdx", sdk.MustNewDecFromStr("1.05")),
+		},
+		types.VaultSharePriceSnapshots{
+			types.NewVaultSharePriceSnapshot("usdx", 100, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), sdk.OneDec()),
+			types.NewVaultSharePriceSnapshot("usdx", 200, time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC), sdk.MustNewDecFromStr("1.05")),
+		},
+		types.SwapLPResiduals{
+			types.NewSwapLPResidual("usdx", sdk.NewCoins(sdk.NewInt64Coin("ufury", 3), sdk.NewInt64Coin("usdx", 2))),
+		},
 	)
 
 	encodingCfg := app.MakeEncodingConfig()
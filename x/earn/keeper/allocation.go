@@ -0,0 +1,286 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fury/x/earn/types"
+)
+
+// rebalanceThreshold is the deviation of a strategy's assets from its target,
+// as a fraction of the vault's total value, above which a vault is rebalanced.
+var rebalanceThreshold = sdk.MustNewDecFromStr("0.01")
+
+// getVaultStrategies returns the strategies of a vault along with their target
+// weights.
+func (k *Keeper) getVaultStrategies(allowedVault types.AllowedVault) ([]Strategy, []sdk.Dec, error) {
+	weights := allowedVault.GetStrategyWeights()
+	if len(weights) != len(allowedVault.Strategies) {
+		return nil, nil, types.ErrInvalidVaultStrategy
+	}
+
+	strategies := make([]Strategy, len(allowedVault.Strategies))
+	for i, strategyType := range allowedVault.Strategies {
+		strategy, err := k.GetStrategy(strategyType)
+		if err != nil {
+			return nil, nil, err
+		}
+		strategies[i] = strategy
+	}
+
+	return strategies, weights, nil
+}
+
+// getStrategyValues returns the estimated assets of the vault denom held by
+// each strategy.
+func getStrategyValues(ctx sdk.Context, strategies []Strategy, denom string) ([]sdkmath.Int, error) {
+	values := make([]sdkmath.Int, len(strategies))
+	for i, strategy := range strategies {
+		value, err := strategy.GetEstimatedTotalAssets(ctx, denom)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value.Amount
+	}
+
+	return values, nil
+}
+
+// splitByWeights splits an amount into parts proportional to the weights. Any
+// remainder from truncation is added to the first part.
+func splitByWeights(amount sdkmath.Int, weights []sdk.Dec) []sdkmath.Int {
+	total := sdk.ZeroDec()
+	for _, weight := range weights {
+		total = total.Add(weight)
+	}
+
+	parts := make([]sdkmath.Int, len(weights))
+	remaining := amount
+	for i, weight := range weights {
+		parts[i] = sdk.NewDecFromInt(amount).Mul(weight).QuoTruncate(total).TruncateInt()
+		remaining = remaining.Sub(parts[i])
+	}
+	parts[0] = parts[0].Add(remaining)
+
+	return parts
+}
+
+// depositToStrategies spreads a deposit across the strategies of a vault
+// according to their target weights.
+func (k *Keeper) depositToStrategies(ctx sdk.Context, allowedVault types.AllowedVault, amount sdk.Coin) error {
+	strategies, weights, err := k.getVaultStrategies(allowedVault)
+	if err != nil {
+		return err
+	}
+
+	for i, part := range splitByWeights(amount.Amount, weights) {
+		if !part.IsPositive() {
+			continue
+		}
+		if err := strategies[i].Deposit(ctx, sdk.NewCoin(amount.Denom, part)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// withdrawFromStrategies spreads a withdrawal across the strategies of a vault
+// in proportion to the assets each currently holds, so the allocation of the
+// vault is unchanged.
+func (k *Keeper) withdrawFromStrategies(ctx sdk.Context, allowedVault types.AllowedVault, amount sdk.Coin) error {
+	strategies, _, err := k.getVaultStrategies(allowedVault)
+	if err != nil {
+		return err
+	}
+
+	values, err := getStrategyValues(ctx, strategies, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	valueWeights := make([]sdk.Dec, len(values))
+	totalValue := sdk.ZeroInt()
+	for i, value := range values {
+		valueWeights[i] = sdk.NewDecFromInt(value)
+		totalValue = totalValue.Add(value)
+	}
+	if totalValue.LT(amount.Amount) {
+		return fmt.Errorf("vault %s holds %s, less than withdraw amount %s", amount.Denom, totalValue, amount.Amount)
+	}
+
+	parts := splitByWeights(amount.Amount, valueWeights)
+
+	// Truncation remainders may push a part above the value of its strategy,
+	// move any excess to the strategies with value to spare.
+	excess := sdk.ZeroInt()
+	for i := range parts {
+		if parts[i].GT(values[i]) {
+			excess = excess.Add(parts[i].Sub(values[i]))
+			parts[i] = values[i]
+		}
+	}
+	for i := range parts {
+		if !excess.IsPositive() {
+			break
+		}
+		extra := sdkmath.MinInt(values[i].Sub(parts[i]), excess)
+		parts[i] = parts[i].Add(extra)
+		excess = excess.Sub(extra)
+	}
+
+	for i, part := range parts {
+		if !part.IsPositive() {
+			continue
+		}
+		if err := strategies[i].Withdraw(ctx, sdk.NewCoin(amount.Denom, part)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateVaultAllocations sets the allocations of a vault record to the assets
+// currently held by each of its strategies.
+func (k *Keeper) updateVaultAllocations(ctx sdk.Context, denom string) error {
+	vaultRecord, found := k.GetVaultRecord(ctx, denom)
+	if !found {
+		return nil
+	}
+
+	allowedVault, found := k.GetAllowedVault(ctx, denom)
+	if !found {
+		return types.ErrInvalidVaultDenom
+	}
+
+	strategies, _, err := k.getVaultStrategies(allowedVault)
+	if err != nil {
+		return err
+	}
+
+	values, err := getStrategyValues(ctx, strategies, denom)
+	if err != nil {
+		return err
+	}
+
+	vaultRecord.Allocations = make(types.StrategyAllocations, len(strategies))
+	for i, strategy := range strategies {
+		vaultRecord.Allocations[i] = types.NewStrategyAllocation(strategy.GetStrategyType(), values[i])
+	}
+
+	k.SetVaultRecord(ctx, vaultRecord)
+	return nil
+}
+
+// RebalanceVault moves assets between the strategies of a vault towards their
+// target weights. Vaults are only rebalanced if a strategy deviates from its
+// target by more than the rebalance threshold.
+func (k *Keeper) RebalanceVault(ctx sdk.Context, denom string) error {
+	allowedVault, found := k.GetAllowedVault(ctx, denom)
+	if !found {
+		return types.ErrInvalidVaultDenom
+	}
+
+	strategies, weights, err := k.getVaultStrategies(allowedVault)
+	if err != nil {
+		return err
+	}
+	if len(strategies) < 2 {
+		return nil
+	}
+
+	values, err := getStrategyValues(ctx, strategies, denom)
+	if err != nil {
+		return err
+	}
+
+	totalValue := sdk.ZeroInt()
+	for _, value := range values {
+		totalValue = totalValue.Add(value)
+	}
+	if totalValue.IsZero() {
+		return nil
+	}
+
+	targets := splitByWeights(totalValue, weights)
+	maxDeviation := sdk.ZeroInt()
+	for i := range values {
+		maxDeviation = sdkmath.MaxInt(maxDeviation, values[i].Sub(targets[i]).Abs())
+	}
+	if sdk.NewDecFromInt(maxDeviation).QuoInt(totalValue).LTE(rebalanceThreshold) {
+		return nil
+	}
+
+	// Withdraw from strategies above their target first so the module account
+	// holds the funds to deposit into strategies below their target.
+	withdrawn := sdk.ZeroInt()
+	for i := range strategies {
+		if !values[i].GT(targets[i]) {
+			continue
+		}
+		excess := values[i].Sub(targets[i])
+		if err := strategies[i].Withdraw(ctx, sdk.NewCoin(denom, excess)); err != nil {
+			return err
+		}
+		withdrawn = withdrawn.Add(excess)
+	}
+
+	lastUnderweight := -1
+	for i := range strategies {
+		if values[i].LT(targets[i]) {
+			lastUnderweight = i
+		}
+	}
+	for i := range strategies {
+		if !values[i].LT(targets[i]) {
+			continue
+		}
+		deficit := sdkmath.MinInt(targets[i].Sub(values[i]), withdrawn)
+		if i == lastUnderweight {
+			deficit = withdrawn
+		}
+		if !deficit.IsPositive() {
+			continue
+		}
+		if err := strategies[i].Deposit(ctx, sdk.NewCoin(denom, deficit)); err != nil {
+			return err
+		}
+		withdrawn = withdrawn.Sub(deficit)
+	}
+
+	if err := k.updateVaultAllocations(ctx, denom); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVaultRebalance,
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, denom),
+		),
+	)
+
+	return nil
+}
+
+// RebalanceVaults rebalances every vault with deposits. A vault that fails to
+// rebalance, for example if a strategy lacks the liquidity to withdraw, is
+// left unchanged and retried in the next block.
+func (k *Keeper) RebalanceVaults(ctx sdk.Context) {
+	var denoms []string
+	k.IterateVaultRecords(ctx, func(record types.VaultRecord) bool {
+		denoms = append(denoms, record.TotalShares.Denom)
+		return false
+	})
+
+	for _, denom := range denoms {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.RebalanceVault(cacheCtx, denom); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to rebalance %s vault: %s", denom, err))
+			continue
+		}
+		writeCache()
+	}
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/incubus-network/fury/x/earn/testutil"
+	"github.com/incubus-network/fury/x/earn/types"
+)
+
+type allocationTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *allocationTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+
+	vault := types.NewAllowedVault(
+		"usdx",
+		types.StrategyTypes{types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS},
+		false,
+		nil,
+	)
+	vault.StrategyWeights = []sdk.Dec{sdk.MustNewDecFromStr("0.6"), sdk.MustNewDecFromStr("0.4")}
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}))
+}
+
+func TestAllocationTestSuite(t *testing.T) {
+	suite.Run(t, new(allocationTestSuite))
+}
+
+func (suite *allocationTestSuite) allocationsEqual(hardAmount, savingsAmount int64) {
+	suite.HardDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin("usdx", hardAmount)))
+	suite.SavingsDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin("usdx", savingsAmount)))
+
+	record, found := suite.Keeper.GetVaultRecord(suite.Ctx, "usdx")
+	suite.Require().True(found)
+	suite.Equal(types.StrategyAllocations{
+		types.NewStrategyAllocation(types.STRATEGY_TYPE_HARD, sdk.NewInt(hardAmount)),
+		types.NewStrategyAllocation(types.STRATEGY_TYPE_SAVINGS, sdk.NewInt(savingsAmount)),
+	}, record.Allocations)
+}
+
+func (suite *allocationTestSuite) TestDepositAndWithdraw() {
+	acc := suite.CreateAccount(sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000)), 0)
+
+	// Either of the vault's strategies can be specified, funds are spread across both
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin("usdx", 1000), types.STRATEGY_TYPE_SAVINGS)
+	suite.Require().NoError(err)
+
+	suite.allocationsEqual(600, 400)
+	suite.VaultTotalValuesEqual(sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000)))
+
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin("usdx", 500), types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.allocationsEqual(300, 200)
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("usdx", 500)))
+
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin("usdx", 500), types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.HardDepositAmountEqual(sdk.NewCoins())
+	suite.SavingsDepositAmountEqual(sdk.NewCoins())
+	_, found := suite.Keeper.GetVaultRecord(suite.Ctx, "usdx")
+	suite.False(found)
+}
+
+func (suite *allocationTestSuite) TestRebalanceVault() {
+	acc := suite.CreateAccount(sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000)), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin("usdx", 1000), types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	// Move funds between the strategies so the vault drifts from its targets
+	savings, err := suite.Keeper.GetStrategy(types.STRATEGY_TYPE_SAVINGS)
+	suite.Require().NoError(err)
+	hard, err := suite.Keeper.GetStrategy(types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(savings.Withdraw(suite.Ctx, sdk.NewInt64Coin("usdx", 5)))
+	suite.Require().NoError(hard.Deposit(suite.Ctx, sdk.NewInt64Coin("usdx", 5)))
+
+	// Deviations within the threshold are left alone
+	err = suite.Keeper.RebalanceVault(suite.Ctx, "usdx")
+	suite.Require().NoError(err)
+	suite.HardDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin("usdx", 605)))
+
+	suite.Require().NoError(savings.Withdraw(suite.Ctx, sdk.NewInt64Coin("usdx", 195)))
+	suite.Require().NoError(hard.Deposit(suite.Ctx, sdk.NewInt64Coin("usdx", 195)))
+
+	err = suite.Keeper.RebalanceVault(suite.Ctx, "usdx")
+	suite.Require().NoError(err)
+
+	suite.allocationsEqual(600, 400)
+	suite.VaultTotalValuesEqual(sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000)))
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeVaultRebalance,
+		sdk.NewAttribute(types.AttributeKeyVaultDenom, "usdx"),
+	))
+}
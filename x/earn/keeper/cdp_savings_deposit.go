@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/incubus-network/fury/x/earn/types"
+)
+
+// ----------------------------------------------------------------------------
+// CdpSavingsDeposit -- debt asset deposited in savings by the cdp strategy
+
+// GetCdpSavingsDeposit returns the cdp savings deposit for a given vault denom.
+func (k *Keeper) GetCdpSavingsDeposit(
+	ctx sdk.Context,
+	vaultDenom string,
+) (types.CdpSavingsDeposit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpSavingsDepositKeyPrefix)
+
+	bz := store.Get(types.VaultKey(vaultDenom))
+	if bz == nil {
+		return types.CdpSavingsDeposit{}, false
+	}
+
+	var deposit types.CdpSavingsDeposit
+	k.cdc.MustUnmarshal(bz, &deposit)
+
+	return deposit, true
+}
+
+// UpdateCdpSavingsDeposit updates the cdp savings deposit in state for a given
+// vault. This deletes it if the amount is zero and updates the state if the
+// amount is non-zero.
+func (k *Keeper) UpdateCdpSavingsDeposit(
+	ctx sdk.Context,
+	deposit types.CdpSavingsDeposit,
+) {
+	if deposit.Amount.IsZero() {
+		k.DeleteCdpSavingsDeposit(ctx, deposit.VaultDenom)
+	} else {
+		k.SetCdpSavingsDeposit(ctx, deposit)
+	}
+}
+
+// DeleteCdpSavingsDeposit deletes the cdp savings deposit for a given vault
+// denom.
+func (k *Keeper) DeleteCdpSavingsDeposit(ctx sdk.Context, vaultDenom string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpSavingsDepositKeyPrefix)
+	store.Delete(types.VaultKey(vaultDenom))
+}
+
+// SetCdpSavingsDeposit sets the cdp savings deposit for a given vault denom.
+func (k *Keeper) SetCdpSavingsDeposit(ctx sdk.Context, deposit types.CdpSavingsDeposit) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpSavingsDepositKeyPrefix)
+	bz := k.cdc.MustMarshal(&deposit)
+	store.Set(types.VaultKey(deposit.VaultDenom), bz)
+}
+
+// IterateCdpSavingsDeposits iterates over all cdp savings deposits in the
+// store and performs a callback function.
+func (k Keeper) IterateCdpSavingsDeposits(
+	ctx sdk.Context,
+	cb func(deposit types.CdpSavingsDeposit) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpSavingsDepositKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.CdpSavingsDeposit
+		k.cdc.MustUnmarshal(iterator.Value(), &deposit)
+		if cb(deposit) {
+			break
+		}
+	}
+}
+
+// GetAllCdpSavingsDeposits returns all cdp savings deposits from the store.
+func (k Keeper) GetAllCdpSavingsDeposits(ctx sdk.Context) types.CdpSavingsDeposits {
+	var deposits types.CdpSavingsDeposits
+
+	k.IterateCdpSavingsDeposits(ctx, func(deposit types.CdpSavingsDeposit) bool {
+		deposits = append(deposits, deposit)
+		return false
+	})
+
+	return deposits
+}
+
+// GetTotalCdpSavingsDeposits returns the sum of the debt asset deposited in
+// savings by the cdp strategy for all vaults.
+func (k Keeper) GetTotalCdpSavingsDeposits(ctx sdk.Context) sdk.Coins {
+	total := sdk.NewCoins()
+
+	k.IterateCdpSavingsDeposits(ctx, func(deposit types.CdpSavingsDeposit) bool {
+		total = total.Add(deposit.Amount)
+		return false
+	})
+
+	return total
+}
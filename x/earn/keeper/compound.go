@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fury/x/earn/types"
+	swaptypes "github.com/incubus-network/fury/x/swap/types"
+)
+
+// harvestRewards claims the incentive rewards accrued by the hard and savings
+// deposits of auto-compounding vaults and adds them to the vaults' rewards
+// records. It runs before the module account's hard or savings deposits
+// change, as the incentive hooks would otherwise merge the rewards of all
+// vaults into the module account's claims.
+func (k *Keeper) harvestRewards(ctx sdk.Context) {
+	if k.incentiveKeeper == nil {
+		return
+	}
+
+	for _, allowedVault := range k.GetAllowedVaults(ctx) {
+		if allowedVault.AutoCompoundParams == nil {
+			continue
+		}
+
+		// Rewards left in the incentive claims on failure are merged by the
+		// hooks, and not compounded.
+		cacheCtx, writeCache := ctx.CacheContext()
+		rewards, err := k.claimVaultRewards(cacheCtx, allowedVault)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to claim %s vault rewards: %s", allowedVault.Denom, err))
+			continue
+		}
+		writeCache()
+
+		if rewards.IsZero() {
+			continue
+		}
+
+		record, found := k.GetVaultRewardsRecord(ctx, allowedVault.Denom)
+		if !found {
+			record = types.NewVaultRewardsRecord(allowedVault.Denom, sdk.NewCoins())
+		}
+		record.Rewards = record.Rewards.Add(rewards...)
+
+		k.UpdateVaultRewardsRecord(ctx, record)
+	}
+}
+
+// claimVaultRewards claims the incentive rewards accrued by the deposits of a
+// vault's strategies to the module account.
+func (k *Keeper) claimVaultRewards(ctx sdk.Context, allowedVault types.AllowedVault) (sdk.Coins, error) {
+	macc := k.accountKeeper.GetModuleAddress(types.ModuleAccountName)
+	rewards := sdk.NewCoins()
+
+	if allowedVault.IsStrategyAllowed(types.STRATEGY_TYPE_HARD) {
+		claimed, err := k.incentiveKeeper.ClaimHardSupplyRewardForDenom(ctx, macc, types.ModuleAccountName, allowedVault.Denom)
+		if err != nil {
+			return nil, err
+		}
+		rewards = rewards.Add(claimed...)
+	}
+
+	if allowedVault.IsStrategyAllowed(types.STRATEGY_TYPE_SAVINGS) {
+		claimed, err := k.incentiveKeeper.ClaimSavingsRewardForDenom(ctx, macc, types.ModuleAccountName, allowedVault.Denom)
+		if err != nil {
+			return nil, err
+		}
+		rewards = rewards.Add(claimed...)
+	}
+
+	return rewards, nil
+}
+
+// CompoundVault claims the incentive rewards of a vault, swaps them for the
+// vault denom and deposits them into the vault's strategies, increasing the
+// value of the vault's shares. Rewards that cannot be swapped within the
+// slippage limit, or that have no pool with the vault denom, are kept for a
+// later compound.
+func (k *Keeper) CompoundVault(ctx sdk.Context, denom string) error {
+	allowedVault, found := k.GetAllowedVault(ctx, denom)
+	if !found {
+		return types.ErrInvalidVaultDenom
+	}
+
+	if allowedVault.AutoCompoundParams == nil {
+		return fmt.Errorf("vault %s does not auto-compound", denom)
+	}
+
+	// Rewards are only compounded for vaults with depositors to receive them.
+	if _, found := k.GetVaultRecord(ctx, denom); !found {
+		return nil
+	}
+
+	k.harvestRewards(ctx)
+
+	record, found := k.GetVaultRewardsRecord(ctx, denom)
+	if !found {
+		return nil
+	}
+
+	compounded := sdk.NewCoin(denom, sdk.ZeroInt())
+	swapped := sdk.NewCoins()
+	remaining := sdk.NewCoins()
+	for _, reward := range record.Rewards {
+		if reward.Denom == denom {
+			compounded = compounded.Add(reward)
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		output, err := k.swapReward(cacheCtx, reward, denom, allowedVault.AutoCompoundParams.SlippageLimit)
+		if err != nil {
+			ctx.Logger().Info(fmt.Sprintf("not compounding %s in %s vault: %s", reward, denom, err))
+			remaining = remaining.Add(reward)
+			continue
+		}
+		writeCache()
+
+		compounded = compounded.Add(output)
+		swapped = swapped.Add(reward)
+	}
+
+	k.UpdateVaultRewardsRecord(ctx, types.NewVaultRewardsRecord(denom, remaining))
+
+	if compounded.IsZero() {
+		return nil
+	}
+
+	if err := k.depositToStrategies(ctx, allowedVault, compounded); err != nil {
+		return err
+	}
+
+	if err := k.updateVaultAllocations(ctx, denom); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVaultCompound,
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyRewards, swapped.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, compounded.Amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// swapReward swaps a reward held by the module account for the vault denom
+// through the pool of the two, and returns the amount received.
+func (k *Keeper) swapReward(ctx sdk.Context, reward sdk.Coin, denom string, slippageLimit sdk.Dec) (sdk.Coin, error) {
+	poolID := swaptypes.PoolID(reward.Denom, denom)
+	pool, found := k.swapKeeper.GetPool(ctx, poolID)
+	if !found {
+		return sdk.Coin{}, fmt.Errorf("swap pool %s not found", poolID)
+	}
+
+	expected := spotSwapOutput(pool.Reserves(), reward, denom)
+	if expected.IsZero() {
+		return sdk.Coin{}, fmt.Errorf("%s too small to swap", reward)
+	}
+
+	macc := k.accountKeeper.GetModuleAddress(types.ModuleAccountName)
+	before := k.bankKeeper.GetAllBalances(ctx, macc).AmountOf(denom)
+
+	if err := k.swapKeeper.SwapExactForTokens(ctx, macc, reward, expected, slippageLimit); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	after := k.bankKeeper.GetAllBalances(ctx, macc).AmountOf(denom)
+	return sdk.NewCoin(denom, after.Sub(before)), nil
+}
+
+// CompoundVaults compounds every auto-compounding vault at the end of its
+// interval. A vault that fails to compound is left unchanged and retried at
+// its next interval.
+func (k *Keeper) CompoundVaults(ctx sdk.Context) {
+	for _, allowedVault := range k.GetAllowedVaults(ctx) {
+		params := allowedVault.AutoCompoundParams
+		if params == nil || ctx.BlockHeight()%params.Interval != 0 {
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.CompoundVault(cacheCtx, allowedVault.Denom); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to compound %s vault: %s", allowedVault.Denom, err))
+			continue
+		}
+		writeCache()
+	}
+}
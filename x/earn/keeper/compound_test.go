@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/incubus-network/fury/x/earn"
+	"github.com/incubus-network/fury/x/earn/testutil"
+	"github.com/incubus-network/fury/x/earn/types"
+	incentivetypes "github.com/incubus-network/fury/x/incentive/types"
+	swaptypes "github.com/incubus-network/fury/x/swap/types"
+)
+
+const (
+	compoundVaultDenom  = "usdx"
+	compoundRewardDenom = "busd"
+	compoundInterval    = 10
+)
+
+type compoundTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *compoundTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+
+	// A pool to swap rewards for the vault denom
+	swapKeeper := suite.App.GetSwapKeeper()
+	swapKeeper.SetParams(suite.Ctx, swaptypes.NewParams(
+		swaptypes.NewAllowedPools(swaptypes.NewAllowedPool(compoundRewardDenom, compoundVaultDenom)),
+		sdk.MustNewDecFromStr("0.003"),
+	))
+	provider := suite.CreateAccount(sdk.NewCoins(
+		sdk.NewInt64Coin(compoundVaultDenom, 1_000_000_000),
+		sdk.NewInt64Coin(compoundRewardDenom, 1_000_000_000),
+	), 1)
+	err := swapKeeper.Deposit(
+		suite.Ctx,
+		provider.GetAddress(),
+		sdk.NewInt64Coin(compoundVaultDenom, 1_000_000_000),
+		sdk.NewInt64Coin(compoundRewardDenom, 1_000_000_000),
+		sdk.MustNewDecFromStr("0.01"),
+	)
+	suite.Require().NoError(err)
+
+	incentiveKeeper := suite.App.GetIncentiveKeeper()
+	incentiveParams := incentiveKeeper.GetParams(suite.Ctx)
+	incentiveParams.ClaimEnd = suite.Ctx.BlockTime().Add(365 * 24 * time.Hour)
+	incentiveKeeper.SetParams(suite.Ctx, incentiveParams)
+	suite.setRewardFactor(sdk.ZeroDec())
+
+	vault := types.NewAllowedVault(compoundVaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	vault.AutoCompoundParams = types.NewAutoCompoundParams(compoundInterval, sdk.MustNewDecFromStr("0.05"))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}))
+}
+
+func TestCompoundTestSuite(t *testing.T) {
+	suite.Run(t, new(compoundTestSuite))
+}
+
+// setRewardFactor sets the global hard supply reward factor of the vault denom.
+func (suite *compoundTestSuite) setRewardFactor(factor sdk.Dec) {
+	suite.App.GetIncentiveKeeper().SetHardSupplyRewardIndexes(
+		suite.Ctx,
+		compoundVaultDenom,
+		incentivetypes.RewardIndexes{incentivetypes.NewRewardIndex(compoundRewardDenom, factor)},
+	)
+}
+
+// accrueRewards sets the reward factor so the earn module account accrues the
+// amount of rewards per unit deposited, and funds the incentive module account
+// to pay them.
+func (suite *compoundTestSuite) accrueRewards(factor sdk.Dec, amount int64) {
+	suite.setRewardFactor(factor)
+	err := suite.App.FundModuleAccount(
+		suite.Ctx,
+		incentivetypes.IncentiveMacc,
+		sdk.NewCoins(sdk.NewInt64Coin(compoundRewardDenom, amount)),
+	)
+	suite.Require().NoError(err)
+}
+
+func (suite *compoundTestSuite) TestCompoundVault() {
+	depositAmount := sdk.NewInt64Coin(compoundVaultDenom, 100_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.accrueRewards(sdk.MustNewDecFromStr("0.01"), 1_000_000)
+
+	err = suite.Keeper.CompoundVault(suite.Ctx, compoundVaultDenom)
+	suite.Require().NoError(err)
+
+	// 1 busd of rewards swapped to usdx, less swap fees, is deposited to hard
+	accValue, err := suite.Keeper.GetVaultAccountValue(suite.Ctx, compoundVaultDenom, acc.GetAddress())
+	suite.Require().NoError(err)
+	suite.True(accValue.Amount.GT(sdk.NewInt(100_990_000)), "unexpected value %s", accValue)
+	suite.True(accValue.Amount.LT(sdk.NewInt(101_000_000)), "unexpected value %s", accValue)
+
+	_, found := suite.Keeper.GetVaultRewardsRecord(suite.Ctx, compoundVaultDenom)
+	suite.False(found, "compounded rewards should be removed from the rewards record")
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeVaultCompound,
+		sdk.NewAttribute(types.AttributeKeyVaultDenom, compoundVaultDenom),
+		sdk.NewAttribute(types.AttributeKeyRewards, sdk.NewInt64Coin(compoundRewardDenom, 1_000_000).String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, accValue.Amount.Sub(depositAmount.Amount).String()),
+	))
+}
+
+func (suite *compoundTestSuite) TestCompoundVault_SlippageExceeded() {
+	depositAmount := sdk.NewInt64Coin(compoundVaultDenom, 100_000_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	// 100 busd of rewards moves the pool price by ~10%
+	suite.accrueRewards(sdk.MustNewDecFromStr("0.001"), 100_000_000)
+
+	err = suite.Keeper.CompoundVault(suite.Ctx, compoundVaultDenom)
+	suite.Require().NoError(err)
+
+	// Rewards are kept for a later compound
+	record, found := suite.Keeper.GetVaultRewardsRecord(suite.Ctx, compoundVaultDenom)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(compoundRewardDenom, 100_000_000)), record.Rewards)
+	suite.VaultTotalValuesEqual(sdk.NewCoins(depositAmount))
+}
+
+func (suite *compoundTestSuite) TestHarvestBeforeDeposit() {
+	depositAmount := sdk.NewInt64Coin(compoundVaultDenom, 100_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount.Add(depositAmount)), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.accrueRewards(sdk.MustNewDecFromStr("0.01"), 1_000_000)
+
+	// Changing the hard deposit claims the rewards accrued so far to the vault
+	err = suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	record, found := suite.Keeper.GetVaultRewardsRecord(suite.Ctx, compoundVaultDenom)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(compoundRewardDenom, 1_000_000)), record.Rewards)
+
+	macc := suite.AccountKeeper.GetModuleAddress(types.ModuleAccountName)
+	claim, found := suite.App.GetIncentiveKeeper().GetHardLiquidityProviderClaim(suite.Ctx, macc)
+	suite.Require().True(found)
+	suite.True(claim.Reward.IsZero(), "rewards should not be left in the incentive claim")
+}
+
+func (suite *compoundTestSuite) TestBeginBlocker_Interval() {
+	depositAmount := sdk.NewInt64Coin(compoundVaultDenom, 100_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.accrueRewards(sdk.MustNewDecFromStr("0.01"), 1_000_000)
+
+	// Vaults are not compounded before the end of the interval
+	suite.Ctx = suite.Ctx.WithBlockHeight(compoundInterval + 1)
+	earn.BeginBlocker(suite.Ctx, suite.Keeper)
+	suite.VaultTotalValuesEqual(sdk.NewCoins(depositAmount))
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(2 * compoundInterval)
+	earn.BeginBlocker(suite.Ctx, suite.Keeper)
+
+	totalValue, err := suite.Keeper.GetVaultTotalValue(suite.Ctx, compoundVaultDenom)
+	suite.Require().NoError(err)
+	suite.True(totalValue.Amount.GT(depositAmount.Amount), "expected %s > %s", totalValue, depositAmount)
+}
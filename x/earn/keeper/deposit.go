@@ -37,21 +37,22 @@ func (k *Keeper) Deposit(
 		return types.ErrAccountDepositNotAllowed
 	}
 
+	// Charge performance fees on growth so far, so the depositor's shares are
+	// issued at the share price after fees
+	if err := k.ChargePerformanceFee(ctx, amount.Denom); err != nil {
+		return err
+	}
+
 	// Check if VaultRecord exists, create if not exist
 	vaultRecord, found := k.GetVaultRecord(ctx, amount.Denom)
 	if !found {
 		// Create a new VaultRecord with 0 supply
 		vaultRecord = types.NewVaultRecord(amount.Denom, sdk.ZeroDec())
-	}
 
-	// Get the strategy for the vault
-	// NOTE: Currently always uses the first one, AllowedVaults are currently
-	// only valid with 1 and only 1 strategy so this is safe.
-	// If/When multiple strategies are supported and users can specify specific
-	// strategies, shares should be issued per-strategy instead of per-vault.
-	strategy, err := k.GetStrategy(allowedVault.Strategies[0])
-	if err != nil {
-		return err
+		// Shares of a new vault are issued 1:1
+		if allowedVault.FeeParams != nil {
+			k.resetHighWaterMark(ctx, amount.Denom)
+		}
 	}
 
 	// Transfer amount to module account
@@ -77,10 +78,12 @@ func (k *Keeper) Deposit(
 		return fmt.Errorf("failed to convert assets to shares: %w", err)
 	}
 
-	isNew := vaultShareRecord.Shares.AmountOf(amount.Denom).IsZero()
+	// Shares of share tokens held by the depositor earn rewards as well
+	currentShares := k.GetVaultAccountRewardShares(ctx, depositor, amount.Denom)
+	isNew := currentShares.IsZero()
 	if !isNew {
 		// If deposits for this vault already exists, call hook with user's existing shares
-		k.BeforeVaultDepositModified(ctx, amount.Denom, depositor, vaultShareRecord.Shares.AmountOf(amount.Denom))
+		k.BeforeVaultDepositModified(ctx, amount.Denom, depositor, currentShares)
 	}
 
 	// Increment VaultRecord total shares and account shares
@@ -96,8 +99,14 @@ func (k *Keeper) Deposit(
 		k.AfterVaultDepositCreated(ctx, amount.Denom, depositor, shares.Amount)
 	}
 
-	// Deposit to the strategy
-	if err := strategy.Deposit(ctx, amount); err != nil {
+	// Deposit to the strategies, spread according to their target weights.
+	// Shares are issued per-vault, so the strategy chosen by the depositor
+	// only needs to be one of the vault's strategies.
+	if err := k.depositToStrategies(ctx, allowedVault, amount); err != nil {
+		return err
+	}
+
+	if err := k.updateVaultAllocations(ctx, amount.Denom); err != nil {
 		return err
 	}
 
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fury/x/earn/types"
+)
+
+// GetVaultSharePrice returns the value of one share of a vault. Vaults without
+// shares issue shares 1:1, so their share price is one.
+func (k *Keeper) GetVaultSharePrice(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	totalShares, found := k.GetVaultTotalShares(ctx, denom)
+	if !found || totalShares.Amount.IsZero() {
+		return sdk.OneDec(), nil
+	}
+
+	totalValue, err := k.GetVaultTotalValue(ctx, denom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return sdk.NewDecFromInt(totalValue.Amount).Quo(totalShares.Amount), nil
+}
+
+// ChargePerformanceFee issues the fee recipient of a vault shares worth the
+// performance fee on the growth of the vault's share price above its
+// high-water mark, then raises the high-water mark to the share price after
+// the fee. Vaults are only charged on growth after they started charging fees.
+func (k *Keeper) ChargePerformanceFee(ctx sdk.Context, denom string) error {
+	allowedVault, found := k.GetAllowedVault(ctx, denom)
+	if !found {
+		return types.ErrInvalidVaultDenom
+	}
+
+	feeParams := allowedVault.FeeParams
+	if feeParams == nil {
+		return nil
+	}
+
+	vaultRecord, found := k.GetVaultRecord(ctx, denom)
+	if !found {
+		return nil
+	}
+
+	totalValue, err := k.GetVaultTotalValue(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if totalValue.IsZero() {
+		return nil
+	}
+
+	value := sdk.NewDecFromInt(totalValue.Amount)
+	totalShares := vaultRecord.TotalShares.Amount
+	sharePrice := value.Quo(totalShares)
+
+	feeRecord, found := k.GetVaultFeeRecord(ctx, denom)
+	if !found {
+		k.SetVaultFeeRecord(ctx, types.NewVaultFeeRecord(denom, sharePrice))
+		return nil
+	}
+
+	if !feeParams.PerformanceFee.IsPositive() || sharePrice.LTE(feeRecord.HighWaterMark) {
+		return nil
+	}
+
+	// feeValue  = (sharePrice - highWaterMark) * totalShares * performanceFee
+	//
+	// Fee shares dilute the vault so they are worth the fee value:
+	// feeValue  = feeShares * totalValue / (totalShares + feeShares)
+	// feeShares = feeValue * totalShares / (totalValue - feeValue)
+	feeValue := sharePrice.Sub(feeRecord.HighWaterMark).Mul(totalShares).Mul(feeParams.PerformanceFee)
+	feeShares := types.NewVaultShare(denom, feeValue.Mul(totalShares).QuoTruncate(value.Sub(feeValue)))
+
+	if feeShares.IsPositive() {
+		vaultRecord.TotalShares = vaultRecord.TotalShares.Add(feeShares)
+		k.SetVaultRecord(ctx, vaultRecord)
+		k.addFeeShares(ctx, feeParams.FeeRecipient, feeShares, types.AttributeValuePerformanceFee)
+
+		feeRecord.AccruedPerformanceFees = feeRecord.AccruedPerformanceFees.Add(feeShares.Amount)
+	}
+
+	feeRecord.HighWaterMark = value.Quo(vaultRecord.TotalShares.Amount)
+	k.SetVaultFeeRecord(ctx, feeRecord)
+
+	return nil
+}
+
+// ChargePerformanceFees charges the performance fees of every vault with
+// deposits. A vault that fails to be charged is left unchanged and retried in
+// the next block.
+func (k *Keeper) ChargePerformanceFees(ctx sdk.Context) {
+	var denoms []string
+	k.IterateVaultRecords(ctx, func(record types.VaultRecord) bool {
+		denoms = append(denoms, record.TotalShares.Denom)
+		return false
+	})
+
+	for _, denom := range denoms {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.ChargePerformanceFee(cacheCtx, denom); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to charge %s vault performance fee: %s", denom, err))
+			continue
+		}
+		writeCache()
+	}
+}
+
+// resetHighWaterMark sets the high-water mark of a vault that charges fees to
+// the share price of a vault without shares.
+func (k *Keeper) resetHighWaterMark(ctx sdk.Context, denom string) {
+	feeRecord, found := k.GetVaultFeeRecord(ctx, denom)
+	if !found {
+		feeRecord = types.NewVaultFeeRecord(denom, sdk.OneDec())
+	}
+	feeRecord.HighWaterMark = sdk.OneDec()
+
+	k.SetVaultFeeRecord(ctx, feeRecord)
+}
+
+// recordWithdrawalFee adds withdrawal fee shares to the accrued fees of a
+// vault.
+func (k *Keeper) recordWithdrawalFee(ctx sdk.Context, feeShares types.VaultShare) {
+	feeRecord, found := k.GetVaultFeeRecord(ctx, feeShares.Denom)
+	if !found {
+		feeRecord = types.NewVaultFeeRecord(feeShares.Denom, sdk.OneDec())
+	}
+	feeRecord.AccruedWithdrawalFees = feeRecord.AccruedWithdrawalFees.Add(feeShares.Amount)
+
+	k.SetVaultFeeRecord(ctx, feeRecord)
+}
+
+// addFeeShares adds fee shares to the share record of a vault's fee recipient.
+// It does not change the total shares of the vault.
+func (k *Keeper) addFeeShares(ctx sdk.Context, recipient sdk.AccAddress, shares types.VaultShare, feeType string) {
+	k.addAccountShares(ctx, recipient, shares)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVaultFee,
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, shares.Denom),
+			sdk.NewAttribute(types.AttributeKeyFeeType, feeType),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.Amount.String()),
+		),
+	)
+}
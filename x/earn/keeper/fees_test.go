@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/incubus-network/fury/x/earn"
+	"github.com/incubus-network/fury/x/earn/testutil"
+	"github.com/incubus-network/fury/x/earn/types"
+)
+
+const feeVaultDenom = "usdx"
+
+type feesTestSuite struct {
+	testutil.Suite
+
+	recipient sdk.AccAddress
+}
+
+func (suite *feesTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+	suite.Keeper.SetParams(suite.Ctx, types.DefaultParams())
+
+	suite.recipient = suite.CreateAccount(sdk.NewCoins(), 1).GetAddress()
+}
+
+func TestFeesTestSuite(t *testing.T) {
+	suite.Run(t, new(feesTestSuite))
+}
+
+// createFeeVault adds a hard vault that charges the fees to the params.
+func (suite *feesTestSuite) createFeeVault(performanceFee, withdrawalFee sdk.Dec) {
+	vault := types.NewAllowedVault(feeVaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	vault.FeeParams = types.NewVaultFeeParams(performanceFee, withdrawalFee, suite.recipient)
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}))
+}
+
+// setHardInterestFactor grows the value of hard deposits of the vault denom.
+func (suite *feesTestSuite) setHardInterestFactor(factor sdk.Dec) {
+	suite.HardKeeper.SetSupplyInterestFactor(suite.Ctx, feeVaultDenom, factor)
+}
+
+func (suite *feesTestSuite) TestChargePerformanceFee() {
+	suite.createFeeVault(sdk.MustNewDecFromStr("0.2"), sdk.ZeroDec())
+
+	depositAmount := sdk.NewInt64Coin(feeVaultDenom, 100_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	record, found := suite.Keeper.GetVaultFeeRecord(suite.Ctx, feeVaultDenom)
+	suite.Require().True(found)
+	suite.Equal(sdk.OneDec(), record.HighWaterMark)
+
+	// Vault grows 10% to 110 usdx, 20% of the 10 usdx growth is paid as fees
+	suite.setHardInterestFactor(sdk.MustNewDecFromStr("1.1"))
+
+	err = suite.Keeper.ChargePerformanceFee(suite.Ctx, feeVaultDenom)
+	suite.Require().NoError(err)
+
+	recipientValue, err := suite.Keeper.GetVaultAccountValue(suite.Ctx, feeVaultDenom, suite.recipient)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewInt(1_999_999), recipientValue.Amount)
+
+	accValue, err := suite.Keeper.GetVaultAccountValue(suite.Ctx, feeVaultDenom, acc.GetAddress())
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewInt(108_000_000), accValue.Amount)
+
+	record, found = suite.Keeper.GetVaultFeeRecord(suite.Ctx, feeVaultDenom)
+	suite.Require().True(found)
+	suite.True(record.AccruedPerformanceFees.IsPositive())
+
+	sharePrice, err := suite.Keeper.GetVaultSharePrice(suite.Ctx, feeVaultDenom)
+	suite.Require().NoError(err)
+	suite.Equal(sharePrice, record.HighWaterMark)
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeVaultFee,
+		sdk.NewAttribute(types.AttributeKeyVaultDenom, feeVaultDenom),
+		sdk.NewAttribute(types.AttributeKeyFeeType, types.AttributeValuePerformanceFee),
+		sdk.NewAttribute(types.AttributeKeyRecipient, suite.recipient.String()),
+		sdk.NewAttribute(types.AttributeKeyShares, record.AccruedPerformanceFees.String()),
+	))
+
+	// No more fees are charged without growth above the high-water mark
+	err = suite.Keeper.ChargePerformanceFee(suite.Ctx, feeVaultDenom)
+	suite.Require().NoError(err)
+
+	newRecord, found := suite.Keeper.GetVaultFeeRecord(suite.Ctx, feeVaultDenom)
+	suite.Require().True(found)
+	suite.Equal(record, newRecord)
+}
+
+func (suite *feesTestSuite) TestChargePerformanceFee_BelowHighWaterMark() {
+	suite.createFeeVault(sdk.MustNewDecFromStr("0.2"), sdk.ZeroDec())
+
+	depositAmount := sdk.NewInt64Coin(feeVaultDenom, 100_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	suite.Keeper.SetVaultFeeRecord(suite.Ctx, types.NewVaultFeeRecord(feeVaultDenom, sdk.MustNewDecFromStr("1.2")))
+	suite.setHardInterestFactor(sdk.MustNewDecFromStr("1.1"))
+
+	earn.BeginBlocker(suite.Ctx, suite.Keeper)
+
+	_, found := suite.Keeper.GetVaultShareRecord(suite.Ctx, suite.recipient)
+	suite.False(found, "no fee shares should be issued below the high-water mark")
+
+	record, found := suite.Keeper.GetVaultFeeRecord(suite.Ctx, feeVaultDenom)
+	suite.Require().True(found)
+	suite.Equal(sdk.MustNewDecFromStr("1.2"), record.HighWaterMark)
+	suite.True(record.AccruedPerformanceFees.IsZero())
+}
+
+func (suite *feesTestSuite) TestWithdrawalFee() {
+	suite.createFeeVault(sdk.ZeroDec(), sdk.MustNewDecFromStr("0.01"))
+
+	depositAmount := sdk.NewInt64Coin(feeVaultDenom, 100_000_000)
+	withdrawAmount := sdk.NewInt64Coin(feeVaultDenom, 50_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	withdrawn, err := suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), withdrawAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	// 1% of the withdrawn shares are paid to the recipient
+	suite.Equal(sdk.NewInt64Coin(feeVaultDenom, 49_500_000), withdrawn)
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(withdrawn))
+
+	suite.VaultTotalValuesEqual(sdk.NewCoins(sdk.NewInt64Coin(feeVaultDenom, 50_500_000)))
+	suite.VaultTotalSharesEqual(types.NewVaultShares(
+		types.NewVaultShare(feeVaultDenom, sdk.NewDec(50_500_000)),
+	))
+
+	accShares, found := suite.Keeper.GetVaultAccountShares(suite.Ctx, acc.GetAddress())
+	suite.Require().True(found)
+	suite.Equal(sdk.NewDec(50_000_000), accShares.AmountOf(feeVaultDenom))
+
+	recipientShares, found := suite.Keeper.GetVaultAccountShares(suite.Ctx, suite.recipient)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewDec(500_000), recipientShares.AmountOf(feeVaultDenom))
+
+	record, found := suite.Keeper.GetVaultFeeRecord(suite.Ctx, feeVaultDenom)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewDec(500_000), record.AccruedWithdrawalFees)
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeVaultFee,
+		sdk.NewAttribute(types.AttributeKeyVaultDenom, feeVaultDenom),
+		sdk.NewAttribute(types.AttributeKeyFeeType, types.AttributeValueWithdrawalFee),
+		sdk.NewAttribute(types.AttributeKeyRecipient, suite.recipient.String()),
+		sdk.NewAttribute(types.AttributeKeyShares, sdk.NewDec(500_000).String()),
+	))
+}
@@ -8,7 +8,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/incubus-network/fury/x/earn/types"
 )
@@ -84,6 +86,12 @@ func (s queryServer) Vaults(
 			return true
 		}
 
+		allocations, err := s.keeper.GetVaultAllocations(sdkCtx, record.TotalShares.Denom)
+		if err != nil {
+			vaultRecordsErr = err
+			return true
+		}
+
 		vaults = append(vaults, types.VaultResponse{
 			Denom:             record.TotalShares.Denom,
 			Strategies:        allowedVault.Strategies,
@@ -91,6 +99,8 @@ func (s queryServer) Vaults(
 			AllowedDepositors: addressSliceToStringSlice(allowedVault.AllowedDepositors),
 			TotalShares:       record.TotalShares.Amount.String(),
 			TotalValue:        totalValue.Amount,
+			StrategyWeights:   allowedVault.GetStrategyWeights(),
+			Allocations:       allocations,
 		})
 
 		// Mark this allowed vault as visited
@@ -116,14 +126,21 @@ func (s queryServer) Vaults(
 			return nil, fmt.Errorf("vault record not found for vault record denom %s", denom)
 		}
 
+		allocations, err := s.keeper.GetVaultAllocations(sdkCtx, denom)
+		if err != nil {
+			return nil, err
+		}
+
 		vaults = append(vaults, types.VaultResponse{
 			Denom:             denom,
 			Strategies:        allowedVault.Strategies,
 			IsPrivateVault:    allowedVault.IsPrivateVault,
 			AllowedDepositors: addressSliceToStringSlice(allowedVault.AllowedDepositors),
 			// No shares, no value
-			TotalShares: sdk.ZeroDec().String(),
-			TotalValue:  sdk.ZeroInt(),
+			TotalShares:     sdk.ZeroDec().String(),
+			TotalValue:      sdk.ZeroInt(),
+			StrategyWeights: allowedVault.GetStrategyWeights(),
+			Allocations:     allocations,
 		})
 	}
 
@@ -172,6 +189,11 @@ func (s queryServer) Vault(
 		return nil, err
 	}
 
+	allocations, err := s.keeper.GetVaultAllocations(sdkCtx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
 	vault := types.VaultResponse{
 		// VaultRecord denom instead of AllowedVault.Denom for full bfury denom
 		Denom:             vaultRecord.TotalShares.Denom,
@@ -180,6 +202,8 @@ func (s queryServer) Vault(
 		AllowedDepositors: addressSliceToStringSlice(allowedVault.AllowedDepositors),
 		TotalShares:       vaultRecord.TotalShares.Amount.String(),
 		TotalValue:        totalValue.Amount,
+		StrategyWeights:   allowedVault.GetStrategyWeights(),
+		Allocations:       allocations,
 	}
 
 	return &types.QueryVaultResponse{
@@ -229,8 +253,9 @@ func (s queryServer) getAggregateBfuryVault(
 			IsPrivateVault:    allowedVault.IsPrivateVault,
 			AllowedDepositors: addressSliceToStringSlice(allowedVault.AllowedDepositors),
 			// Empty for shares, as adding up all shares is not useful information
-			TotalShares: "0",
-			TotalValue:  vaultValue.Amount,
+			TotalShares:     "0",
+			TotalValue:      vaultValue.Amount,
+			StrategyWeights: allowedVault.GetStrategyWeights(),
 		},
 	}, nil
 }
@@ -336,6 +361,116 @@ func (s queryServer) TotalSupply(
 	}, vaultRecordErr
 }
 
+// VaultFees implements the gRPC service handler for querying the fees of a
+// x/earn vault.
+func (s queryServer) VaultFees(
+	ctx context.Context,
+	req *types.QueryVaultFeesRequest,
+) (*types.QueryVaultFeesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Denom == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty denom")
+	}
+
+	allowedVault, found := s.keeper.GetAllowedVault(sdkCtx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "vault not found with specified denom")
+	}
+
+	if allowedVault.FeeParams == nil {
+		return nil, status.Errorf(codes.NotFound, "vault does not charge fees")
+	}
+
+	sharePrice, err := s.keeper.GetVaultSharePrice(sdkCtx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	feeRecord, found := s.keeper.GetVaultFeeRecord(sdkCtx, req.Denom)
+	if !found {
+		// Fees have not been charged yet, the high-water mark is set at the
+		// current share price on the first charge
+		feeRecord = types.NewVaultFeeRecord(req.Denom, sharePrice)
+	}
+
+	return &types.QueryVaultFeesResponse{
+		FeeParams:              *allowedVault.FeeParams,
+		HighWaterMark:          feeRecord.HighWaterMark,
+		SharePrice:             sharePrice,
+		AccruedPerformanceFees: feeRecord.AccruedPerformanceFees,
+		AccruedWithdrawalFees:  feeRecord.AccruedWithdrawalFees,
+	}, nil
+}
+
+// VaultPerformance implements the gRPC service handler for querying the share
+// price history and realized APY of a x/earn vault.
+func (s queryServer) VaultPerformance(
+	ctx context.Context,
+	req *types.QueryVaultPerformanceRequest,
+) (*types.QueryVaultPerformanceResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Denom == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty denom")
+	}
+
+	if _, found := s.keeper.GetAllowedVault(sdkCtx, req.Denom); !found {
+		return nil, status.Errorf(codes.NotFound, "vault not found with specified denom")
+	}
+
+	sharePrice, err := s.keeper.GetVaultSharePrice(sdkCtx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := types.VaultSharePriceSnapshots{}
+	store := prefix.NewStore(
+		prefix.NewStore(sdkCtx.KVStore(s.keeper.key), types.SharePriceSnapshotPrefix),
+		types.VaultSnapshotsKey(req.Denom),
+	)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var snapshot types.VaultSharePriceSnapshot
+		if err := s.keeper.cdc.Unmarshal(value, &snapshot); err != nil {
+			return err
+		}
+
+		snapshots = append(snapshots, snapshot)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	apys := []types.RealizedAPY{}
+	for _, window := range types.RealizedAPYWindows {
+		apy, found, err := s.keeper.GetRealizedAPY(sdkCtx, req.Denom, window)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			continue
+		}
+
+		apys = append(apys, types.RealizedAPY{Window: window, APY: apy})
+	}
+
+	return &types.QueryVaultPerformanceResponse{
+		SharePrice:   sharePrice,
+		Snapshots:    snapshots,
+		RealizedAPYs: apys,
+		Pagination:   pageRes,
+	}, nil
+}
+
 // getOneAccountOneVaultDeposit returns deposits for a specific vault and a specific
 // account
 func (s queryServer) getOneAccountOneVaultDeposit(
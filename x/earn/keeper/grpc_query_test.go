@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -81,6 +82,8 @@ func (suite *grpcQueryTestSuite) TestVaults_ZeroSupply() {
 				AllowedDepositors: nil,
 				TotalShares:       sdk.NewDec(0).String(),
 				TotalValue:        sdkmath.NewInt(0),
+				StrategyWeights:   []sdk.Dec{sdk.OneDec()},
+				Allocations:       types.StrategyAllocations{types.NewStrategyAllocation(types.STRATEGY_TYPE_HARD, sdkmath.NewInt(0))},
 			},
 			res.Vault,
 		)
@@ -97,6 +100,8 @@ func (suite *grpcQueryTestSuite) TestVaults_ZeroSupply() {
 				AllowedDepositors: nil,
 				TotalShares:       sdk.ZeroDec().String(),
 				TotalValue:        sdk.ZeroInt(),
+				StrategyWeights:   []sdk.Dec{sdk.OneDec()},
+				Allocations:       types.StrategyAllocations{types.NewStrategyAllocation(types.STRATEGY_TYPE_HARD, sdk.ZeroInt())},
 			},
 			{
 				Denom:             "busd",
@@ -105,6 +110,8 @@ func (suite *grpcQueryTestSuite) TestVaults_ZeroSupply() {
 				AllowedDepositors: nil,
 				TotalShares:       sdk.ZeroDec().String(),
 				TotalValue:        sdk.ZeroInt(),
+				StrategyWeights:   []sdk.Dec{sdk.OneDec()},
+				Allocations:       types.StrategyAllocations{types.NewStrategyAllocation(types.STRATEGY_TYPE_HARD, sdk.ZeroInt())},
 			},
 		},
 			res.Vaults,
@@ -145,6 +152,8 @@ func (suite *grpcQueryTestSuite) TestVaults_WithSupply() {
 				AllowedDepositors: nil,
 				TotalShares:       sdk.NewDecFromInt(depositAmount.Amount).String(),
 				TotalValue:        depositAmount.Amount,
+				StrategyWeights:   []sdk.Dec{sdk.OneDec()},
+				Allocations:       types.StrategyAllocations{types.NewStrategyAllocation(types.STRATEGY_TYPE_HARD, depositAmount.Amount)},
 			},
 			{
 				Denom:             vault2Denom,
@@ -153,6 +162,8 @@ func (suite *grpcQueryTestSuite) TestVaults_WithSupply() {
 				AllowedDepositors: nil,
 				TotalShares:       sdk.NewDecFromInt(deposit2Amount.Amount).String(),
 				TotalValue:        deposit2Amount.Amount,
+				StrategyWeights:   []sdk.Dec{sdk.OneDec()},
+				Allocations:       types.StrategyAllocations{types.NewStrategyAllocation(types.STRATEGY_TYPE_SAVINGS, deposit2Amount.Amount)},
 			},
 		},
 		res.Vaults,
@@ -191,6 +202,8 @@ func (suite *grpcQueryTestSuite) TestVaults_MixedSupply() {
 				AllowedDepositors: nil,
 				TotalShares:       sdk.ZeroDec().String(),
 				TotalValue:        sdk.ZeroInt(),
+				StrategyWeights:   []sdk.Dec{sdk.OneDec()},
+				Allocations:       types.StrategyAllocations{types.NewStrategyAllocation(types.STRATEGY_TYPE_HARD, sdk.ZeroInt())},
 			},
 			{
 				Denom:             vault2Denom,
@@ -199,6 +212,8 @@ func (suite *grpcQueryTestSuite) TestVaults_MixedSupply() {
 				AllowedDepositors: nil,
 				TotalShares:       sdk.ZeroDec().String(),
 				TotalValue:        sdk.ZeroInt(),
+				StrategyWeights:   []sdk.Dec{sdk.OneDec()},
+				Allocations:       types.StrategyAllocations{types.NewStrategyAllocation(types.STRATEGY_TYPE_HARD, sdk.ZeroInt())},
 			},
 			{
 				Denom:             vault3Denom,
@@ -207,6 +222,8 @@ func (suite *grpcQueryTestSuite) TestVaults_MixedSupply() {
 				AllowedDepositors: nil,
 				TotalShares:       sdk.NewDecFromInt(depositAmount.Amount).String(),
 				TotalValue:        depositAmount.Amount,
+				StrategyWeights:   []sdk.Dec{sdk.OneDec()},
+				Allocations:       types.StrategyAllocations{types.NewStrategyAllocation(types.STRATEGY_TYPE_SAVINGS, depositAmount.Amount)},
 			},
 		},
 		res.Vaults,
@@ -219,6 +236,98 @@ func (suite *grpcQueryTestSuite) TestVault_NotFound() {
 	suite.Require().ErrorIs(err, status.Errorf(codes.NotFound, "vault not found with specified denom"))
 }
 
+func (suite *grpcQueryTestSuite) TestVaultFees() {
+	vaultDenom := "usdx"
+	recipient := suite.CreateAccount(sdk.NewCoins(), 1).GetAddress()
+	feeParams := types.NewVaultFeeParams(sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.01"), recipient)
+
+	vault := types.NewAllowedVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	vault.FeeParams = feeParams
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}))
+
+	// No deposits yet
+	res, err := suite.queryClient.VaultFees(context.Background(), types.NewQueryVaultFeesRequest(vaultDenom))
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.QueryVaultFeesResponse{
+		FeeParams:              *feeParams,
+		HighWaterMark:          sdk.OneDec(),
+		SharePrice:             sdk.OneDec(),
+		AccruedPerformanceFees: sdk.ZeroDec(),
+		AccruedWithdrawalFees:  sdk.ZeroDec(),
+	}, *res)
+
+	suite.Keeper.SetVaultFeeRecord(suite.Ctx, types.VaultFeeRecord{
+		VaultDenom:             vaultDenom,
+		HighWaterMark:          sdk.MustNewDecFromStr("1.05"),
+		AccruedPerformanceFees: sdk.NewDec(100),
+		AccruedWithdrawalFees:  sdk.NewDec(10),
+	})
+
+	res, err = suite.queryClient.VaultFees(context.Background(), types.NewQueryVaultFeesRequest(vaultDenom))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.MustNewDecFromStr("1.05"), res.HighWaterMark)
+	suite.Require().Equal(sdk.NewDec(100), res.AccruedPerformanceFees)
+	suite.Require().Equal(sdk.NewDec(10), res.AccruedWithdrawalFees)
+}
+
+func (suite *grpcQueryTestSuite) TestVaultFees_NotFound() {
+	_, err := suite.queryClient.VaultFees(context.Background(), types.NewQueryVaultFeesRequest("usdx"))
+	suite.Require().ErrorIs(err, status.Errorf(codes.NotFound, "vault not found with specified denom"))
+
+	suite.CreateVault("usdx", types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+
+	_, err = suite.queryClient.VaultFees(context.Background(), types.NewQueryVaultFeesRequest("usdx"))
+	suite.Require().ErrorIs(err, status.Errorf(codes.NotFound, "vault does not charge fees"))
+}
+
+func (suite *grpcQueryTestSuite) TestVaultPerformance() {
+	vaultDenom := "usdx"
+	depositAmount := sdk.NewInt64Coin(vaultDenom, 100_000_000)
+	start := suite.Ctx.BlockTime()
+
+	vault := types.NewAllowedVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	vault.SnapshotParams = types.NewVaultSnapshotParams(24*time.Hour, 60*24*time.Hour)
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}))
+
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	snapshots := types.VaultSharePriceSnapshots{
+		types.NewVaultSharePriceSnapshot(vaultDenom, 1, start, sdk.OneDec()),
+		types.NewVaultSharePriceSnapshot(vaultDenom, 2, start.Add(24*time.Hour), sdk.OneDec()),
+	}
+	for _, snapshot := range snapshots {
+		suite.Keeper.SetSharePriceSnapshot(suite.Ctx, snapshot)
+	}
+
+	// Only the 7 day window has enough history
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(8 * 24 * time.Hour))
+	suite.App.GetHardKeeper().SetSupplyInterestFactor(suite.Ctx, vaultDenom, sdk.MustNewDecFromStr("1.01"))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.Ctx, suite.App.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, keeper.NewQueryServerImpl(suite.Keeper))
+	queryClient := types.NewQueryClient(queryHelper)
+
+	res, err := queryClient.VaultPerformance(context.Background(), types.NewQueryVaultPerformanceRequest(vaultDenom, nil))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.MustNewDecFromStr("1.01"), res.SharePrice)
+	suite.Require().Equal(snapshots, res.Snapshots)
+	suite.Require().Equal(
+		[]types.RealizedAPY{{
+			Window: 7 * 24 * time.Hour,
+			// 1% over the 7 days since the second snapshot
+			APY: sdk.MustNewDecFromStr("0.521428571428571428"),
+		}},
+		res.RealizedAPYs,
+	)
+}
+
+func (suite *grpcQueryTestSuite) TestVaultPerformance_NotFound() {
+	_, err := suite.queryClient.VaultPerformance(context.Background(), types.NewQueryVaultPerformanceRequest("usdx", nil))
+	suite.Require().ErrorIs(err, status.Errorf(codes.NotFound, "vault not found with specified denom"))
+}
+
 func (suite *grpcQueryTestSuite) TestDeposits() {
 	// Validator setup for bfury
 	_, addrs := app.GeneratePrivKeyAddressPairs(5)
@@ -630,6 +739,8 @@ func (suite *grpcQueryTestSuite) TestVault_bFury_Single() {
 			AllowedDepositors: []string(nil),
 			TotalShares:       "100.000000000000000000",
 			TotalValue:        sdkmath.NewInt(100),
+			StrategyWeights:   []sdk.Dec{sdk.OneDec()},
+			Allocations:       types.StrategyAllocations{types.NewStrategyAllocation(types.STRATEGY_TYPE_SAVINGS, sdkmath.NewInt(100))},
 		},
 		res.Vault,
 	)
@@ -683,8 +794,9 @@ func (suite *grpcQueryTestSuite) TestVault_bFury_Aggregate() {
 			IsPrivateVault:    false,
 			AllowedDepositors: []string(nil),
 			// No shares for aggregate
-			TotalShares: "0",
-			TotalValue:  expectedValue,
+			TotalShares:     "0",
+			TotalValue:      expectedValue,
+			StrategyWeights: []sdk.Dec{sdk.OneDec()},
 		},
 		res.Vault,
 	)
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	"github.com/incubus-network/fury/app"
 	"github.com/incubus-network/fury/x/earn/testutil"
 	"github.com/incubus-network/fury/x/earn/types"
 	"github.com/incubus-network/fury/x/earn/types/mocks"
@@ -537,3 +538,46 @@ func (suite *hookTestSuite) TestHooks_HookOrdering() {
 	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
 	suite.Require().NoError(err)
 }
+
+func (suite *hookTestSuite) TestHooks_ShareTokenTransfer() {
+	suite.Keeper.ClearHooks()
+	earnHooks := mocks.NewEarnHooks(suite.T())
+	suite.Keeper.SetHooks(earnHooks)
+
+	vaultDenom := "usdx"
+	depositAmount := sdk.NewInt64Coin(vaultDenom, 100)
+	suite.CreateVault(vaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+	acc2 := suite.CreateAccount(sdk.NewCoins(sdk.NewInt64Coin("ufury", 10)), 1)
+
+	earnHooks.On("AfterVaultDepositCreated", suite.Ctx, vaultDenom, acc.GetAddress(), sdk.NewDec(100)).Once()
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	// Tokenizing moves shares to the token balance, the shares earning
+	// rewards do not change
+	earnHooks.On("BeforeVaultDepositModified", suite.Ctx, vaultDenom, acc.GetAddress(), sdk.NewDec(100)).Once()
+	tokens, err := suite.Keeper.TokenizeShares(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(vaultDenom, 40))
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewDec(100), suite.Keeper.GetVaultAccountRewardShares(suite.Ctx, acc.GetAddress(), vaultDenom))
+
+	// Transfers sync the sender before, and initialize both accounts after
+	bankKeeper := app.NewHookedBankKeeper(suite.BankKeeper, &suite.Keeper)
+
+	earnHooks.On("BeforeVaultDepositModified", suite.Ctx, vaultDenom, acc.GetAddress(), sdk.NewDec(100)).Once()
+	earnHooks.On("AfterVaultDepositCreated", suite.Ctx, vaultDenom, acc.GetAddress(), sdk.NewDec(60)).Once()
+	earnHooks.On("AfterVaultDepositCreated", suite.Ctx, vaultDenom, acc2.GetAddress(), sdk.NewDec(40)).Once()
+	err = bankKeeper.SendCoins(suite.Ctx, acc.GetAddress(), acc2.GetAddress(), sdk.NewCoins(tokens))
+	suite.Require().NoError(err)
+
+	suite.Equal(sdk.NewDec(40), suite.Keeper.GetVaultAccountRewardShares(suite.Ctx, acc2.GetAddress(), vaultDenom))
+
+	// Transfers of other denoms do not call hooks
+	err = bankKeeper.SendCoins(suite.Ctx, acc2.GetAddress(), acc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("ufury", 10)))
+	suite.Require().NoError(err)
+
+	// The module account earns nothing for the tokenized shares it holds
+	macc := suite.AccountKeeper.GetModuleAddress(types.ModuleAccountName)
+	suite.True(suite.Keeper.GetVaultAccountRewardShares(suite.Ctx, macc, vaultDenom).IsZero())
+}
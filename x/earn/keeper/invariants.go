@@ -11,6 +11,7 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "vault-records", VaultRecordsInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "share-records", ShareRecordsInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "vault-shares", VaultSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "share-tokens", ShareTokensInvariant(k))
 }
 
 // AllInvariants runs all invariants of the swap module
@@ -24,7 +25,11 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			return res, stop
 		}
 
-		res, stop := VaultSharesInvariant(k)(ctx)
+		if res, stop := VaultSharesInvariant(k)(ctx); stop {
+			return res, stop
+		}
+
+		res, stop := ShareTokensInvariant(k)(ctx)
 		return res, stop
 	}
 }
@@ -113,3 +118,25 @@ func VaultSharesInvariant(k Keeper) sdk.Invariant {
 		return message, broken
 	}
 }
+
+// ShareTokensInvariant iterates all vaults and ensures the supply of each vault's
+// share token matches the tokenized shares held by the module account
+func ShareTokensInvariant(k Keeper) sdk.Invariant {
+	broken := false
+	message := sdk.FormatInvariant(types.ModuleName, "share tokens broken", "share token supply does not match tokenized shares")
+
+	return func(ctx sdk.Context) (string, bool) {
+		k.IterateVaultRecords(ctx, func(record types.VaultRecord) bool {
+			denom := record.TotalShares.Denom
+			supply := k.bankKeeper.GetSupply(ctx, types.ShareTokenDenom(denom))
+
+			if !sdk.NewDecFromInt(supply.Amount).Equal(k.GetVaultTokenizedShares(ctx, denom)) {
+				broken = true
+				return true
+			}
+			return false
+		})
+
+		return message, broken
+	}
+}
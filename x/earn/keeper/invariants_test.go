@@ -180,3 +180,21 @@ func (suite *invariantTestSuite) TestVaultSharesInvariant() {
 	suite.Equal("earn: vault shares broken invariant\nvault shares do not match depositor shares\n", message)
 	suite.Equal(true, broken)
 }
+
+func (suite *invariantTestSuite) TestShareTokensInvariant() {
+	message, broken := suite.runInvariant("share-tokens", keeper.ShareTokensInvariant)
+	suite.Equal("earn: share tokens broken invariant\nshare token supply does not match tokenized shares\n", message)
+	suite.Equal(false, broken)
+
+	suite.SetupValidState()
+	message, broken = suite.runInvariant("share-tokens", keeper.ShareTokensInvariant)
+	suite.Equal("earn: share tokens broken invariant\nshare token supply does not match tokenized shares\n", message)
+	suite.Equal(false, broken)
+
+	// broken when share tokens exist without tokenized shares
+	err := suite.App.FundAccount(suite.Ctx, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(types.ShareTokenDenom("usdx"), 10)))
+	suite.Require().NoError(err)
+	message, broken = suite.runInvariant("share-tokens", keeper.ShareTokensInvariant)
+	suite.Equal("earn: share tokens broken invariant\nshare token supply does not match tokenized shares\n", message)
+	suite.Equal(true, broken)
+}
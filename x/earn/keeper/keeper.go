@@ -21,9 +21,18 @@ type Keeper struct {
 	// Keepers used for strategies
 	hardKeeper    types.HardKeeper
 	savingsKeeper types.SavingsKeeper
+	swapKeeper    types.SwapKeeper
+	cdpKeeper     types.CdpKeeper
+
+	// Keeper for pricing CDP collateral
+	pricefeedKeeper types.PricefeedKeeper
 
 	// Keeper for community pool transfers
 	distKeeper types.DistributionKeeper
+
+	// Keeper for claiming rewards to auto-compound, set after construction as
+	// the incentive keeper depends on this keeper
+	incentiveKeeper types.IncentiveKeeper
 }
 
 // NewKeeper creates a new keeper
@@ -36,6 +45,9 @@ func NewKeeper(
 	liquidKeeper types.LiquidKeeper,
 	hardKeeper types.HardKeeper,
 	savingsKeeper types.SavingsKeeper,
+	swapKeeper types.SwapKeeper,
+	cdpKeeper types.CdpKeeper,
+	pricefeedKeeper types.PricefeedKeeper,
 	distKeeper types.DistributionKeeper,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
@@ -43,15 +55,18 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		key:           key,
-		cdc:           cdc,
-		paramSubspace: paramstore,
-		accountKeeper: accountKeeper,
-		bankKeeper:    bankKeeper,
-		liquidKeeper:  liquidKeeper,
-		hardKeeper:    hardKeeper,
-		savingsKeeper: savingsKeeper,
-		distKeeper:    distKeeper,
+		key:             key,
+		cdc:             cdc,
+		paramSubspace:   paramstore,
+		accountKeeper:   accountKeeper,
+		bankKeeper:      bankKeeper,
+		liquidKeeper:    liquidKeeper,
+		hardKeeper:      hardKeeper,
+		savingsKeeper:   savingsKeeper,
+		swapKeeper:      swapKeeper,
+		cdpKeeper:       cdpKeeper,
+		pricefeedKeeper: pricefeedKeeper,
+		distKeeper:      distKeeper,
 	}
 }
 
@@ -64,6 +79,12 @@ func (k *Keeper) SetHooks(sh types.EarnHooks) *Keeper {
 	return k
 }
 
+// SetIncentiveKeeper sets the keeper used to claim the incentive rewards of
+// auto-compounding vaults.
+func (k *Keeper) SetIncentiveKeeper(incentiveKeeper types.IncentiveKeeper) {
+	k.incentiveKeeper = incentiveKeeper
+}
+
 // ClearHooks clears the hooks on the keeper
 func (k *Keeper) ClearHooks() {
 	k.hooks = nil
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fury/x/earn/types"
+)
+
+const secondsPerYear = 31536000
+
+// SnapshotVault records the share price of a vault if its snapshot interval
+// has passed since the previous snapshot, and prunes snapshots older than its
+// retention.
+func (k *Keeper) SnapshotVault(ctx sdk.Context, denom string) error {
+	allowedVault, found := k.GetAllowedVault(ctx, denom)
+	if !found {
+		return types.ErrInvalidVaultDenom
+	}
+
+	params := allowedVault.SnapshotParams
+	if params == nil {
+		return nil
+	}
+
+	latest, found := k.GetLatestSharePriceSnapshot(ctx, denom)
+	if !found || !ctx.BlockTime().Before(latest.Time.Add(params.Interval)) {
+		sharePrice, err := k.GetVaultSharePrice(ctx, denom)
+		if err != nil {
+			return err
+		}
+
+		k.SetSharePriceSnapshot(ctx, types.NewVaultSharePriceSnapshot(
+			denom,
+			ctx.BlockHeight(),
+			ctx.BlockTime(),
+			sharePrice,
+		))
+	}
+
+	k.DeleteSharePriceSnapshotsBefore(ctx, denom, ctx.BlockTime().Add(-params.Retention))
+
+	return nil
+}
+
+// SnapshotVaults records the share prices of every vault with deposits that
+// records snapshots. A vault that fails to be recorded is left unchanged and
+// retried in the next block.
+func (k *Keeper) SnapshotVaults(ctx sdk.Context) {
+	var denoms []string
+	k.IterateVaultRecords(ctx, func(record types.VaultRecord) bool {
+		denoms = append(denoms, record.TotalShares.Denom)
+		return false
+	})
+
+	for _, denom := range denoms {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.SnapshotVault(cacheCtx, denom); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to snapshot %s vault share price: %s", denom, err))
+			continue
+		}
+		writeCache()
+	}
+}
+
+// GetRealizedAPY returns the growth of a vault's share price from the latest
+// snapshot at least window old to the current share price, annualized without
+// compounding. It returns false if there is no snapshot old enough.
+func (k *Keeper) GetRealizedAPY(ctx sdk.Context, denom string, window time.Duration) (sdk.Dec, bool, error) {
+	start, found := k.GetSharePriceSnapshotAtOrBefore(ctx, denom, ctx.BlockTime().Add(-window))
+	if !found {
+		return sdk.Dec{}, false, nil
+	}
+
+	elapsed := int64(ctx.BlockTime().Sub(start.Time).Seconds())
+	if elapsed <= 0 {
+		return sdk.Dec{}, false, nil
+	}
+
+	sharePrice, err := k.GetVaultSharePrice(ctx, denom)
+	if err != nil {
+		return sdk.Dec{}, false, err
+	}
+
+	growth := sharePrice.Quo(start.SharePrice).Sub(sdk.OneDec())
+	return growth.MulInt64(secondsPerYear).QuoInt64(elapsed), true, nil
+}
@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/incubus-network/fury/x/earn"
+	"github.com/incubus-network/fury/x/earn/testutil"
+	"github.com/incubus-network/fury/x/earn/types"
+)
+
+const (
+	performanceVaultDenom = "usdx"
+	snapshotInterval      = time.Hour
+	snapshotRetention     = 30*24*time.Hour + snapshotInterval
+)
+
+type performanceTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *performanceTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+
+	vault := types.NewAllowedVault(performanceVaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+	vault.SnapshotParams = types.NewVaultSnapshotParams(snapshotInterval, snapshotRetention)
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}))
+}
+
+func TestPerformanceTestSuite(t *testing.T) {
+	suite.Run(t, new(performanceTestSuite))
+}
+
+// deposit deposits the amount of the vault denom from a new account.
+func (suite *performanceTestSuite) deposit(amount int64) {
+	depositAmount := sdk.NewInt64Coin(performanceVaultDenom, amount)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+}
+
+// snapshotTimes returns the times of all snapshots of the vault, oldest first.
+func (suite *performanceTestSuite) snapshotTimes() []time.Time {
+	var times []time.Time
+	for _, snapshot := range suite.Keeper.GetAllSharePriceSnapshots(suite.Ctx) {
+		times = append(times, snapshot.Time)
+	}
+
+	return times
+}
+
+func (suite *performanceTestSuite) TestSnapshotVaults_Interval() {
+	suite.deposit(100_000_000)
+	start := suite.Ctx.BlockTime()
+
+	earn.BeginBlocker(suite.Ctx, suite.Keeper)
+	suite.Equal([]time.Time{start}, suite.snapshotTimes())
+
+	// No snapshot before the interval has passed
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(snapshotInterval / 2))
+	earn.BeginBlocker(suite.Ctx, suite.Keeper)
+	suite.Equal([]time.Time{start}, suite.snapshotTimes())
+
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(snapshotInterval))
+	earn.BeginBlocker(suite.Ctx, suite.Keeper)
+	suite.Equal([]time.Time{start, start.Add(snapshotInterval)}, suite.snapshotTimes())
+
+	latest, found := suite.Keeper.GetLatestSharePriceSnapshot(suite.Ctx, performanceVaultDenom)
+	suite.Require().True(found)
+	suite.Equal(
+		types.NewVaultSharePriceSnapshot(performanceVaultDenom, suite.Ctx.BlockHeight(), start.Add(snapshotInterval), sdk.OneDec()),
+		latest,
+	)
+}
+
+func (suite *performanceTestSuite) TestSnapshotVaults_Pruning() {
+	suite.deposit(100_000_000)
+	start := suite.Ctx.BlockTime()
+
+	earn.BeginBlocker(suite.Ctx, suite.Keeper)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(snapshotInterval))
+	earn.BeginBlocker(suite.Ctx, suite.Keeper)
+
+	// Snapshots older than the retention are removed
+	now := start.Add(snapshotRetention + snapshotInterval/2)
+	suite.Ctx = suite.Ctx.WithBlockTime(now)
+	earn.BeginBlocker(suite.Ctx, suite.Keeper)
+	suite.Equal([]time.Time{start.Add(snapshotInterval), now}, suite.snapshotTimes())
+}
+
+func (suite *performanceTestSuite) TestSnapshotVaults_NoSnapshotParams() {
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{
+		types.NewAllowedVault(performanceVaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil),
+	}))
+	suite.deposit(100_000_000)
+
+	earn.BeginBlocker(suite.Ctx, suite.Keeper)
+	suite.Empty(suite.Keeper.GetAllSharePriceSnapshots(suite.Ctx))
+}
+
+func (suite *performanceTestSuite) TestGetRealizedAPY() {
+	suite.deposit(100_000_000)
+	start := suite.Ctx.BlockTime()
+	window := 7 * 24 * time.Hour
+
+	suite.Keeper.SetSharePriceSnapshot(
+		suite.Ctx,
+		types.NewVaultSharePriceSnapshot(performanceVaultDenom, 1, start, sdk.OneDec()),
+	)
+
+	// No snapshot is old enough
+	_, found, err := suite.Keeper.GetRealizedAPY(suite.Ctx, performanceVaultDenom, window)
+	suite.Require().NoError(err)
+	suite.False(found)
+
+	// Share price grows 1% over 7 days
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(window))
+	suite.HardKeeper.SetSupplyInterestFactor(suite.Ctx, performanceVaultDenom, sdk.MustNewDecFromStr("1.01"))
+
+	apy, found, err := suite.Keeper.GetRealizedAPY(suite.Ctx, performanceVaultDenom, window)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Equal(sdk.MustNewDecFromStr("0.521428571428571428"), apy)
+}
+
+func (suite *performanceTestSuite) TestGetRealizedAPY_MinimumRetention() {
+	suite.deposit(100_000_000)
+	start := suite.Ctx.BlockTime()
+	window := types.MaxRealizedAPYWindow()
+
+	suite.Require().Equal(window+snapshotInterval, snapshotRetention)
+	suite.Require().NoError(types.NewVaultSnapshotParams(snapshotInterval, snapshotRetention).Validate())
+
+	// Snapshots are recorded and pruned every interval
+	for elapsed := time.Duration(0); elapsed <= window+2*snapshotInterval; elapsed += snapshotInterval {
+		suite.Ctx = suite.Ctx.WithBlockTime(start.Add(elapsed))
+		earn.BeginBlocker(suite.Ctx, suite.Keeper)
+	}
+
+	// Between snapshots, the newest snapshot at least a window old has not
+	// been pruned yet
+	suite.Ctx = suite.Ctx.WithBlockTime(start.Add(window + 2*snapshotInterval + snapshotInterval/2))
+	earn.BeginBlocker(suite.Ctx, suite.Keeper)
+	suite.HardKeeper.SetSupplyInterestFactor(suite.Ctx, performanceVaultDenom, sdk.MustNewDecFromStr("1.01"))
+
+	apy, found, err := suite.Keeper.GetRealizedAPY(suite.Ctx, performanceVaultDenom, window)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+
+	// 1% since the snapshot taken 2 intervals after the start
+	elapsed := window + snapshotInterval/2
+	suite.Equal(sdk.MustNewDecFromStr("0.01").MulInt64(365*24*60*60).QuoInt64(int64(elapsed.Seconds())), apy)
+}
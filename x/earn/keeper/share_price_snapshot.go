@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/incubus-network/fury/x/earn/types"
+)
+
+// ----------------------------------------------------------------------------
+// VaultSharePriceSnapshot -- share price history of a vault
+
+// SetSharePriceSnapshot sets the share price snapshot of a vault at the
+// snapshot's time.
+func (k *Keeper) SetSharePriceSnapshot(ctx sdk.Context, snapshot types.VaultSharePriceSnapshot) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SharePriceSnapshotPrefix)
+	bz := k.cdc.MustMarshal(&snapshot)
+	store.Set(types.SharePriceSnapshotKey(snapshot.VaultDenom, snapshot.Time), bz)
+}
+
+// GetLatestSharePriceSnapshot returns the most recent share price snapshot of
+// a vault.
+func (k *Keeper) GetLatestSharePriceSnapshot(
+	ctx sdk.Context,
+	vaultDenom string,
+) (types.VaultSharePriceSnapshot, bool) {
+	return k.GetSharePriceSnapshotAtOrBefore(ctx, vaultDenom, ctx.BlockTime())
+}
+
+// GetSharePriceSnapshotAtOrBefore returns the most recent share price snapshot
+// of a vault that was taken at or before the given time.
+func (k *Keeper) GetSharePriceSnapshotAtOrBefore(
+	ctx sdk.Context,
+	vaultDenom string,
+	t time.Time,
+) (types.VaultSharePriceSnapshot, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SharePriceSnapshotPrefix)
+	iterator := store.ReverseIterator(
+		types.VaultSnapshotsKey(vaultDenom),
+		sdk.PrefixEndBytes(types.SharePriceSnapshotKey(vaultDenom, t)),
+	)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.VaultSharePriceSnapshot{}, false
+	}
+
+	var snapshot types.VaultSharePriceSnapshot
+	k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+
+	return snapshot, true
+}
+
+// DeleteSharePriceSnapshotsBefore deletes the share price snapshots of a vault
+// taken before the given time.
+func (k *Keeper) DeleteSharePriceSnapshotsBefore(ctx sdk.Context, vaultDenom string, t time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SharePriceSnapshotPrefix)
+	iterator := store.Iterator(
+		types.VaultSnapshotsKey(vaultDenom),
+		types.SharePriceSnapshotKey(vaultDenom, t),
+	)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// IterateSharePriceSnapshots iterates over all share price snapshots in the
+// store and performs a callback function.
+func (k Keeper) IterateSharePriceSnapshots(
+	ctx sdk.Context,
+	cb func(snapshot types.VaultSharePriceSnapshot) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SharePriceSnapshotPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.VaultSharePriceSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		if cb(snapshot) {
+			break
+		}
+	}
+}
+
+// GetAllSharePriceSnapshots returns all share price snapshots from the store.
+func (k Keeper) GetAllSharePriceSnapshots(ctx sdk.Context) types.VaultSharePriceSnapshots {
+	var snapshots types.VaultSharePriceSnapshots
+
+	k.IterateSharePriceSnapshots(ctx, func(snapshot types.VaultSharePriceSnapshot) bool {
+		snapshots = append(snapshots, snapshot)
+		return false
+	})
+
+	return snapshots
+}
@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fury/x/earn/types"
+)
+
+// TokenizeShares converts whole vault shares of an account into share tokens
+// that can be transferred with x/bank, or as ERC20s if the share token denom is
+// added to the x/evmutil allowed cosmos denoms. One token is worth one share.
+//
+// The tokenized shares are held by the module account until the tokens are
+// redeemed, so they keep their value in the vault. The x/incentive earn rewards
+// of the tokenized shares are earned by the holders of the tokens.
+func (k *Keeper) TokenizeShares(ctx sdk.Context, owner sdk.AccAddress, amount sdk.Coin) (sdk.Coin, error) {
+	if !amount.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInsufficientAmount, "%s", amount)
+	}
+
+	allowedVault, found := k.GetAllowedVault(ctx, amount.Denom)
+	if !found {
+		return sdk.Coin{}, types.ErrInvalidVaultDenom
+	}
+
+	// Share tokens can be sent to any account, so shares of private vaults
+	// cannot be tokenized.
+	if allowedVault.IsPrivateVault {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidVaultDenom, "cannot tokenize shares of private vault %s", amount.Denom)
+	}
+
+	shareRecord, found := k.GetVaultShareRecord(ctx, owner)
+	if !found {
+		return sdk.Coin{}, types.ErrVaultShareRecordNotFound
+	}
+
+	shares := types.NewVaultShare(amount.Denom, sdk.NewDecFromInt(amount.Amount))
+	accCurrentShares := shareRecord.Shares.AmountOf(amount.Denom)
+	if accCurrentShares.LT(shares.Amount) {
+		return sdk.Coin{}, errorsmod.Wrapf(
+			types.ErrInsufficientValue,
+			"account has less %s vault shares than tokenize amount, %s < %s",
+			amount.Denom,
+			accCurrentShares,
+			shares.Amount,
+		)
+	}
+
+	// Call hook before record is modified with the user's current shares.
+	// Tokenizing moves shares from the record to the token balance, so the
+	// shares earning rewards do not change.
+	k.BeforeVaultDepositModified(ctx, amount.Denom, owner, k.GetVaultAccountRewardShares(ctx, owner, amount.Denom))
+
+	shareRecord.Shares = shareRecord.Shares.Sub(shares)
+	k.UpdateVaultShareRecord(ctx, shareRecord)
+
+	// The module account holds the shares without hooks, as the token holders
+	// earn the rewards for them
+	k.addCustodyShares(ctx, shares)
+
+	tokens := sdk.NewCoin(types.ShareTokenDenom(amount.Denom), amount.Amount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleAccountName, sdk.NewCoins(tokens)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, owner, sdk.NewCoins(tokens)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTokenizeShares,
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, amount.Denom),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.Amount.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, tokens.String()),
+		),
+	)
+
+	return tokens, nil
+}
+
+// RedeemTokens burns share tokens and adds the vault shares they represent to
+// the account redeeming them.
+func (k *Keeper) RedeemTokens(ctx sdk.Context, owner sdk.AccAddress, tokens sdk.Coin) (types.VaultShare, error) {
+	if !tokens.IsPositive() {
+		return types.VaultShare{}, errorsmod.Wrapf(types.ErrInsufficientAmount, "%s", tokens)
+	}
+
+	vaultDenom, err := types.ParseShareTokenDenom(tokens.Denom)
+	if err != nil {
+		return types.VaultShare{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleAccountName, sdk.NewCoins(tokens)); err != nil {
+		return types.VaultShare{}, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleAccountName, sdk.NewCoins(tokens)); err != nil {
+		return types.VaultShare{}, err
+	}
+
+	shares := types.NewVaultShare(vaultDenom, sdk.NewDecFromInt(tokens.Amount))
+	if err := k.removeCustodyShares(ctx, shares); err != nil {
+		return types.VaultShare{}, err
+	}
+
+	k.addAccountShares(ctx, owner, shares)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRedeemTokens,
+			sdk.NewAttribute(types.AttributeKeyVaultDenom, vaultDenom),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.Amount.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, tokens.String()),
+		),
+	)
+
+	return shares, nil
+}
+
+// GetVaultTokenizedShares returns the number of shares of a vault that are
+// held by the module account for share tokens.
+func (k *Keeper) GetVaultTokenizedShares(ctx sdk.Context, denom string) sdk.Dec {
+	shareRecord, found := k.GetVaultShareRecord(ctx, k.accountKeeper.GetModuleAddress(types.ModuleAccountName))
+	if !found {
+		return sdk.ZeroDec()
+	}
+
+	return shareRecord.Shares.AmountOf(denom)
+}
+
+// GetVaultAccountRewardShares returns the shares of a vault that an account
+// earns x/incentive earn rewards on: its vault shares plus the shares of the
+// share tokens it holds. The module account earns nothing for the tokenized
+// shares it holds, as they are earned by the token holders.
+func (k *Keeper) GetVaultAccountRewardShares(ctx sdk.Context, acc sdk.AccAddress, denom string) sdk.Dec {
+	if acc.Equals(k.accountKeeper.GetModuleAddress(types.ModuleAccountName)) {
+		return sdk.ZeroDec()
+	}
+
+	shares := sdk.ZeroDec()
+	if shareRecord, found := k.GetVaultShareRecord(ctx, acc); found {
+		shares = shareRecord.Shares.AmountOf(denom)
+	}
+
+	tokens := k.bankKeeper.GetBalance(ctx, acc, types.ShareTokenDenom(denom))
+	return shares.Add(sdk.NewDecFromInt(tokens.Amount))
+}
+
+// BeforeBalancesModified calls the deposit hooks of the accounts before their
+// balances of the share tokens in coins change, so the rewards they earned on
+// their current shares are synced. It is called by the app bank keeper.
+func (k *Keeper) BeforeBalancesModified(ctx sdk.Context, accs []sdk.AccAddress, coins sdk.Coins) {
+	k.iterateShareTokenHolders(ctx, accs, coins, func(vaultDenom string, acc sdk.AccAddress, shares sdk.Dec) {
+		k.BeforeVaultDepositModified(ctx, vaultDenom, acc, shares)
+	})
+}
+
+// AfterBalancesModified calls the deposit hooks of the accounts after their
+// balances of the share tokens in coins change, so accounts receiving their
+// first shares of a vault start earning rewards. It is called by the app bank
+// keeper.
+func (k *Keeper) AfterBalancesModified(ctx sdk.Context, accs []sdk.AccAddress, coins sdk.Coins) {
+	k.iterateShareTokenHolders(ctx, accs, coins, func(vaultDenom string, acc sdk.AccAddress, shares sdk.Dec) {
+		k.AfterVaultDepositCreated(ctx, vaultDenom, acc, shares)
+	})
+}
+
+// iterateShareTokenHolders calls cb for each vault with share tokens in coins
+// and each account with reward shares in that vault.
+func (k *Keeper) iterateShareTokenHolders(
+	ctx sdk.Context,
+	accs []sdk.AccAddress,
+	coins sdk.Coins,
+	cb func(vaultDenom string, acc sdk.AccAddress, shares sdk.Dec),
+) {
+	for _, coin := range coins {
+		vaultDenom, err := types.ParseShareTokenDenom(coin.Denom)
+		if err != nil {
+			continue
+		}
+
+		for _, acc := range accs {
+			shares := k.GetVaultAccountRewardShares(ctx, acc, vaultDenom)
+			if shares.IsPositive() {
+				cb(vaultDenom, acc, shares)
+			}
+		}
+	}
+}
+
+// addCustodyShares adds tokenized shares to the module account's share record.
+func (k *Keeper) addCustodyShares(ctx sdk.Context, shares types.VaultShare) {
+	macc := k.accountKeeper.GetModuleAddress(types.ModuleAccountName)
+
+	shareRecord, found := k.GetVaultShareRecord(ctx, macc)
+	if !found {
+		shareRecord = types.NewVaultShareRecord(macc, types.NewVaultShares())
+	}
+	shareRecord.Shares = shareRecord.Shares.Add(shares)
+
+	k.SetVaultShareRecord(ctx, shareRecord)
+}
+
+// removeCustodyShares removes redeemed shares from the module account's share
+// record.
+func (k *Keeper) removeCustodyShares(ctx sdk.Context, shares types.VaultShare) error {
+	macc := k.accountKeeper.GetModuleAddress(types.ModuleAccountName)
+
+	shareRecord, found := k.GetVaultShareRecord(ctx, macc)
+	if !found || shareRecord.Shares.AmountOf(shares.Denom).LT(shares.Amount) {
+		return errorsmod.Wrapf(types.ErrInsufficientValue, "not enough tokenized %s vault shares", shares.Denom)
+	}
+	shareRecord.Shares = shareRecord.Shares.Sub(shares)
+
+	k.UpdateVaultShareRecord(ctx, shareRecord)
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/incubus-network/fury/x/earn/keeper"
+	"github.com/incubus-network/fury/x/earn/testutil"
+	"github.com/incubus-network/fury/x/earn/types"
+)
+
+const shareTokenVaultDenom = "usdx"
+
+type shareTokenTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *shareTokenTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+	suite.Keeper.SetParams(suite.Ctx, types.DefaultParams())
+}
+
+func TestShareTokenTestSuite(t *testing.T) {
+	suite.Run(t, new(shareTokenTestSuite))
+}
+
+// accountShares returns the vault shares of the vault denom owned by an account.
+func (suite *shareTokenTestSuite) accountShares(addr sdk.AccAddress) sdk.Dec {
+	shares, found := suite.Keeper.GetVaultAccountShares(suite.Ctx, addr)
+	if !found {
+		return sdk.ZeroDec()
+	}
+
+	return shares.AmountOf(shareTokenVaultDenom)
+}
+
+func (suite *shareTokenTestSuite) TestTokenizeShares() {
+	suite.CreateVault(shareTokenVaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+
+	depositAmount := sdk.NewInt64Coin(shareTokenVaultDenom, 100_000_000)
+	tokenizeAmount := sdk.NewInt64Coin(shareTokenVaultDenom, 40_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	tokens, err := suite.Keeper.TokenizeShares(suite.Ctx, acc.GetAddress(), tokenizeAmount)
+	suite.Require().NoError(err)
+
+	expectedTokens := sdk.NewInt64Coin(types.ShareTokenDenom(shareTokenVaultDenom), 40_000_000)
+	suite.Equal(expectedTokens, tokens)
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(expectedTokens))
+
+	suite.Equal(sdk.NewDec(60_000_000), suite.accountShares(acc.GetAddress()))
+	suite.Equal(sdk.NewDec(40_000_000), suite.Keeper.GetVaultTokenizedShares(suite.Ctx, shareTokenVaultDenom))
+
+	// Tokenizing does not change the vault
+	suite.VaultTotalValuesEqual(sdk.NewCoins(depositAmount))
+	suite.VaultTotalSharesEqual(types.NewVaultShares(
+		types.NewVaultShare(shareTokenVaultDenom, sdk.NewDec(100_000_000)),
+	))
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeTokenizeShares,
+		sdk.NewAttribute(types.AttributeKeyVaultDenom, shareTokenVaultDenom),
+		sdk.NewAttribute(types.AttributeKeyOwner, acc.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeyShares, sdk.NewDec(40_000_000).String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, expectedTokens.String()),
+	))
+
+	_, broken := keeper.AllInvariants(suite.Keeper)(suite.Ctx)
+	suite.False(broken)
+}
+
+func (suite *shareTokenTestSuite) TestTokenizeShares_InsufficientShares() {
+	suite.CreateVault(shareTokenVaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+
+	depositAmount := sdk.NewInt64Coin(shareTokenVaultDenom, 100_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	_, err = suite.Keeper.TokenizeShares(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(shareTokenVaultDenom, 100_000_001))
+	suite.Require().ErrorIs(err, types.ErrInsufficientValue)
+}
+
+func (suite *shareTokenTestSuite) TestTokenizeShares_PrivateVault() {
+	depositAmount := sdk.NewInt64Coin(shareTokenVaultDenom, 100_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	suite.CreateVault(shareTokenVaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, true, []sdk.AccAddress{acc.GetAddress()})
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	_, err = suite.Keeper.TokenizeShares(suite.Ctx, acc.GetAddress(), depositAmount)
+	suite.Require().ErrorIs(err, types.ErrInvalidVaultDenom)
+}
+
+func (suite *shareTokenTestSuite) TestRedeemTokens() {
+	suite.CreateVault(shareTokenVaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+
+	depositAmount := sdk.NewInt64Coin(shareTokenVaultDenom, 100_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+	receiver := suite.CreateAccount(sdk.NewCoins(), 1)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+
+	tokens, err := suite.Keeper.TokenizeShares(suite.Ctx, acc.GetAddress(), depositAmount)
+	suite.Require().NoError(err)
+
+	// Share tokens are transferable
+	err = suite.BankKeeper.SendCoins(suite.Ctx, acc.GetAddress(), receiver.GetAddress(), sdk.NewCoins(tokens))
+	suite.Require().NoError(err)
+
+	shares, err := suite.Keeper.RedeemTokens(suite.Ctx, receiver.GetAddress(), tokens)
+	suite.Require().NoError(err)
+	suite.Equal(types.NewVaultShare(shareTokenVaultDenom, sdk.NewDec(100_000_000)), shares)
+
+	suite.AccountBalanceEqual(receiver.GetAddress(), sdk.NewCoins())
+	suite.Equal(sdk.NewDec(100_000_000), suite.accountShares(receiver.GetAddress()))
+	suite.True(suite.Keeper.GetVaultTokenizedShares(suite.Ctx, shareTokenVaultDenom).IsZero())
+
+	_, found := suite.Keeper.GetVaultShareRecord(suite.Ctx, acc.GetAddress())
+	suite.False(found, "share record of the tokenizing account should be removed")
+
+	supply := suite.BankKeeper.GetSupply(suite.Ctx, types.ShareTokenDenom(shareTokenVaultDenom))
+	suite.True(supply.IsZero())
+
+	suite.EventsContains(suite.GetEvents(), sdk.NewEvent(
+		types.EventTypeRedeemTokens,
+		sdk.NewAttribute(types.AttributeKeyVaultDenom, shareTokenVaultDenom),
+		sdk.NewAttribute(types.AttributeKeyOwner, receiver.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeyShares, sdk.NewDec(100_000_000).String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, tokens.String()),
+	))
+
+	// The redeemed shares can be withdrawn
+	withdrawn, err := suite.Keeper.Withdraw(suite.Ctx, receiver.GetAddress(), depositAmount, types.STRATEGY_TYPE_HARD)
+	suite.Require().NoError(err)
+	suite.Equal(depositAmount, withdrawn)
+
+	_, broken := keeper.AllInvariants(suite.Keeper)(suite.Ctx)
+	suite.False(broken)
+}
+
+func (suite *shareTokenTestSuite) TestRedeemTokens_InvalidDenom() {
+	suite.CreateVault(shareTokenVaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_HARD}, false, nil)
+
+	amount := sdk.NewInt64Coin(shareTokenVaultDenom, 100)
+	acc := suite.CreateAccount(sdk.NewCoins(amount), 0)
+
+	_, err := suite.Keeper.RedeemTokens(suite.Ctx, acc.GetAddress(), amount)
+	suite.Require().ErrorIs(err, types.ErrInvalidShareTokenDenom)
+}
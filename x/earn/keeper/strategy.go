@@ -34,6 +34,10 @@ func (k *Keeper) GetStrategy(strategyType types.StrategyType) (Strategy, error)
 		return (*HardStrategy)(k), nil
 	case types.STRATEGY_TYPE_SAVINGS:
 		return (*SavingsStrategy)(k), nil
+	case types.STRATEGY_TYPE_SWAP_LP:
+		return (*SwapLPStrategy)(k), nil
+	case types.STRATEGY_TYPE_CDP_SAVINGS:
+		return (*CdpSavingsStrategy)(k), nil
 	default:
 		return nil, fmt.Errorf("unknown strategy type: %s", strategyType)
 	}
@@ -0,0 +1,443 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cdptypes "github.com/incubus-network/fury/x/cdp/types"
+	"github.com/incubus-network/fury/x/earn/types"
+	swaptypes "github.com/incubus-network/fury/x/swap/types"
+)
+
+// CdpSavingsStrategy defines the strategy that deposits assets as collateral
+// in an x/cdp position and deposits the minted debt asset in x/savings. Debt
+// is drawn up to a conservative target collateralization ratio.
+type CdpSavingsStrategy Keeper
+
+var _ Strategy = (*CdpSavingsStrategy)(nil)
+
+// cdpSavingsPosition is the state of the cdp and savings deposit of a vault.
+type cdpSavingsPosition struct {
+	params     types.CdpSavingsStrategyParams
+	collateral cdptypes.CollateralParam
+	debt       cdptypes.DebtParam
+
+	// collateralAmount is the collateral locked in the cdp
+	collateralAmount sdkmath.Int
+	// debtAmount is the principal and fees owed by the cdp, including interest
+	// not yet synchronized
+	debtAmount sdkmath.Int
+	// savingsAmount is the debt asset deposited in savings
+	savingsAmount sdkmath.Int
+	// hasCdp is true if the module account owns a cdp of the collateral type
+	hasCdp bool
+}
+
+// GetStrategyType returns the strategy type
+func (s *CdpSavingsStrategy) GetStrategyType() types.StrategyType {
+	return types.STRATEGY_TYPE_CDP_SAVINGS
+}
+
+// GetEstimatedTotalAssets returns the cdp collateral plus the savings deposit
+// less the cdp debt, converted to the vault denom. A debt larger than the
+// savings deposit, from stability fees or a liquidated cdp, reduces the value
+// by the collateral needed to buy the difference.
+func (s *CdpSavingsStrategy) GetEstimatedTotalAssets(ctx sdk.Context, denom string) (sdk.Coin, error) {
+	position, err := s.getPosition(ctx, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	value, err := s.positionValue(ctx, position)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(denom, value), nil
+}
+
+// Deposit adds the amount to the cdp as collateral, opening the cdp if needed,
+// draws debt up to the target collateralization ratio, and deposits the debt
+// in savings.
+func (s *CdpSavingsStrategy) Deposit(ctx sdk.Context, amount sdk.Coin) error {
+	position, err := s.getPosition(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	ctype := position.params.CollateralType
+
+	targetDebt, err := s.targetDebt(ctx, position, position.collateralAmount.Add(amount.Amount))
+	if err != nil {
+		return err
+	}
+
+	draw := targetDebt.Sub(position.debtAmount)
+
+	if !position.hasCdp {
+		if draw.LT(position.debt.DebtFloor) {
+			return errorsmod.Wrapf(
+				types.ErrInsufficientAmount,
+				"deposit %s too small to open a cdp with debt above the debt floor %s",
+				amount, position.debt.DebtFloor,
+			)
+		}
+
+		principal := sdk.NewCoin(position.debt.Denom, draw)
+		if err := s.cdpKeeper.AddCdp(ctx, macc.GetAddress(), amount, principal, ctype); err != nil {
+			return err
+		}
+	} else {
+		if err := s.cdpKeeper.DepositCollateral(ctx, macc.GetAddress(), macc.GetAddress(), amount, ctype); err != nil {
+			return err
+		}
+
+		if !draw.IsPositive() {
+			return nil
+		}
+
+		principal := sdk.NewCoin(position.debt.Denom, draw)
+		if err := s.cdpKeeper.AddPrincipal(ctx, macc.GetAddress(), ctype, principal); err != nil {
+			return err
+		}
+	}
+
+	return s.depositSavings(ctx, amount.Denom, sdk.NewCoin(position.debt.Denom, draw))
+}
+
+// Withdraw withdraws the amount of collateral from the cdp, first repaying
+// debt from savings to keep the cdp at the target collateralization ratio.
+// Repaying debt from savings does not change the value of the position, so
+// the remaining value is reduced by exactly the amount. If the remaining cdp
+// would not be valid, the cdp is closed and any remainder deposited again.
+func (s *CdpSavingsStrategy) Withdraw(ctx sdk.Context, amount sdk.Coin) error {
+	position, err := s.getPosition(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	value, err := s.positionValue(ctx, position)
+	if err != nil {
+		return err
+	}
+
+	if amount.Amount.GT(value) {
+		return errorsmod.Wrapf(types.ErrInsufficientValue, "cdp savings position worth %s%s, less than %s", value, amount.Denom, amount)
+	}
+
+	if amount.Amount.LT(value) && amount.Amount.LT(position.collateralAmount) {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := s.withdrawPartial(cacheCtx, position, amount); err == nil {
+			writeCache()
+			return nil
+		}
+	}
+
+	proceeds, err := s.closePosition(ctx, position)
+	if err != nil {
+		return err
+	}
+
+	remainder := proceeds.Sub(amount.Amount)
+	if !remainder.IsPositive() {
+		return nil
+	}
+
+	return s.Deposit(ctx, sdk.NewCoin(amount.Denom, remainder))
+}
+
+// withdrawPartial repays debt from savings down to the target debt of the
+// remaining collateral, and withdraws the amount of collateral.
+func (s *CdpSavingsStrategy) withdrawPartial(ctx sdk.Context, position cdpSavingsPosition, amount sdk.Coin) error {
+	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+
+	targetDebt, err := s.targetDebt(ctx, position, position.collateralAmount.Sub(amount.Amount))
+	if err != nil {
+		return err
+	}
+
+	// The remaining principal must stay above the debt floor.
+	repay := sdkmath.MinInt(position.debtAmount.Sub(targetDebt), position.debtAmount.Sub(position.debt.DebtFloor))
+	repay = sdkmath.MinInt(repay, position.savingsAmount)
+
+	if repay.IsPositive() {
+		payment := sdk.NewCoin(position.debt.Denom, repay)
+		if err := s.withdrawSavings(ctx, amount.Denom, payment); err != nil {
+			return err
+		}
+
+		if err := s.cdpKeeper.RepayPrincipal(ctx, macc.GetAddress(), position.params.CollateralType, payment); err != nil {
+			return err
+		}
+	}
+
+	return s.cdpKeeper.WithdrawCollateral(ctx, macc.GetAddress(), macc.GetAddress(), amount, position.params.CollateralType)
+}
+
+// closePosition withdraws the savings deposit, buys any debt not covered by
+// it with collateral, and repays the cdp in full, returning the collateral to
+// the module account. It returns the amount of the vault denom received.
+func (s *CdpSavingsStrategy) closePosition(ctx sdk.Context, position cdpSavingsPosition) (sdkmath.Int, error) {
+	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	denom := position.collateral.Denom
+	ctype := position.params.CollateralType
+
+	balanceBefore := s.bankKeeper.GetAllBalances(ctx, macc.GetAddress()).AmountOf(denom)
+
+	if position.savingsAmount.IsPositive() {
+		if err := s.withdrawSavings(ctx, denom, sdk.NewCoin(position.debt.Denom, position.savingsAmount)); err != nil {
+			return sdkmath.Int{}, err
+		}
+	}
+
+	if position.hasCdp {
+		if shortfall := position.debtAmount.Sub(position.savingsAmount); shortfall.IsPositive() {
+			pool, found := s.loadDebtPool(ctx, position)
+			if !found {
+				return sdkmath.Int{}, errorsmod.Wrapf(
+					types.ErrInsufficientValue,
+					"no swap pool to buy %s%s debt not covered by savings", shortfall, position.debt.Denom,
+				)
+			}
+
+			exactOutput := sdk.NewCoin(position.debt.Denom, shortfall)
+			spotInput := spotSwapOutput(pool.Reserves(), exactOutput, denom)
+			swapInput, _ := pool.SwapWithExactOutput(exactOutput, s.swapKeeper.GetSwapFee(ctx))
+
+			if err := s.cdpKeeper.WithdrawCollateral(ctx, macc.GetAddress(), macc.GetAddress(), swapInput, ctype); err != nil {
+				return sdkmath.Int{}, err
+			}
+
+			if err := s.swapKeeper.SwapForExactTokens(ctx, macc.GetAddress(), spotInput, exactOutput, position.params.SlippageLimit); err != nil {
+				return sdkmath.Int{}, err
+			}
+		}
+
+		payment := sdk.NewCoin(position.debt.Denom, position.debtAmount)
+		if err := s.cdpKeeper.RepayPrincipal(ctx, macc.GetAddress(), ctype, payment); err != nil {
+			return sdkmath.Int{}, err
+		}
+	}
+
+	// Sell any savings left over after repaying the debt.
+	if surplus := position.savingsAmount.Sub(position.debtAmount); surplus.IsPositive() {
+		if pool, found := s.loadDebtPool(ctx, position); found {
+			swapInput := sdk.NewCoin(position.debt.Denom, surplus)
+			spotOutput := spotSwapOutput(pool.Reserves(), swapInput, denom)
+			if spotOutput.IsPositive() {
+				if err := s.swapKeeper.SwapExactForTokens(ctx, macc.GetAddress(), swapInput, spotOutput, position.params.SlippageLimit); err != nil {
+					return sdkmath.Int{}, err
+				}
+			}
+		}
+	}
+
+	balanceAfter := s.bankKeeper.GetAllBalances(ctx, macc.GetAddress()).AmountOf(denom)
+	return balanceAfter.Sub(balanceBefore), nil
+}
+
+// getPosition returns the cdp and savings deposit of the vault with the given
+// denom.
+func (s *CdpSavingsStrategy) getPosition(ctx sdk.Context, denom string) (cdpSavingsPosition, error) {
+	allowedVault, found := (*Keeper)(s).GetAllowedVault(ctx, denom)
+	if !found {
+		return cdpSavingsPosition{}, types.ErrInvalidVaultDenom
+	}
+
+	if allowedVault.CdpSavingsParams == nil {
+		return cdpSavingsPosition{}, errorsmod.Wrapf(types.ErrInvalidVaultStrategy, "vault %s has no cdp savings params", denom)
+	}
+	params := *allowedVault.CdpSavingsParams
+
+	collateral, found := s.cdpKeeper.GetCollateral(ctx, params.CollateralType)
+	if !found {
+		return cdpSavingsPosition{}, errorsmod.Wrapf(types.ErrInvalidVaultStrategy, "cdp collateral type %s not found", params.CollateralType)
+	}
+
+	if collateral.Denom != denom {
+		return cdpSavingsPosition{}, errorsmod.Wrapf(
+			types.ErrInvalidVaultStrategy,
+			"cdp collateral type %s uses %s, not vault denom %s",
+			params.CollateralType, collateral.Denom, denom,
+		)
+	}
+
+	position := cdpSavingsPosition{
+		params:           params,
+		collateral:       collateral,
+		debt:             s.cdpKeeper.GetParams(ctx).DebtParam,
+		collateralAmount: sdk.ZeroInt(),
+		debtAmount:       sdk.ZeroInt(),
+		savingsAmount:    sdk.ZeroInt(),
+	}
+
+	if deposit, found := (*Keeper)(s).GetCdpSavingsDeposit(ctx, denom); found {
+		position.savingsAmount = deposit.Amount.Amount
+	}
+
+	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	cdp, found := s.cdpKeeper.GetCdpByOwnerAndCollateralType(ctx, macc.GetAddress(), params.CollateralType)
+	if found {
+		position.hasCdp = true
+		position.collateralAmount = cdp.Collateral.Amount
+		position.debtAmount = cdp.GetTotalPrincipal().Amount.Add(s.cdpKeeper.CalculateNewInterest(ctx, cdp).Amount)
+	}
+
+	return position, nil
+}
+
+// positionValue returns the value of the position in the vault denom.
+func (s *CdpSavingsStrategy) positionValue(ctx sdk.Context, position cdpSavingsPosition) (sdkmath.Int, error) {
+	if position.savingsAmount.GTE(position.debtAmount) {
+		surplus, err := s.debtToCollateral(ctx, position, position.savingsAmount.Sub(position.debtAmount))
+		if err != nil {
+			return sdkmath.Int{}, err
+		}
+
+		return position.collateralAmount.Add(surplus), nil
+	}
+
+	cost, err := s.collateralCostOfDebt(ctx, position, position.debtAmount.Sub(position.savingsAmount))
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	if cost.GTE(position.collateralAmount) {
+		return sdk.ZeroInt(), nil
+	}
+
+	return position.collateralAmount.Sub(cost), nil
+}
+
+// targetDebt returns the debt for the collateral amount at the target
+// collateralization ratio.
+func (s *CdpSavingsStrategy) targetDebt(ctx sdk.Context, position cdpSavingsPosition, collateralAmount sdkmath.Int) (sdkmath.Int, error) {
+	price, err := s.pricefeedKeeper.GetCurrentPrice(ctx, position.collateral.SpotMarketID)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	return sdk.NewDecFromInt(collateralAmount).
+		Mul(price.Price).
+		Mul(conversionScale(position.debt.ConversionFactor, position.collateral.ConversionFactor)).
+		Quo(position.params.CollateralizationRatio).
+		TruncateInt(), nil
+}
+
+// debtToCollateral converts an amount of the debt asset to the collateral it
+// would be sold for. The swap pool is used if it exists, otherwise the spot
+// price.
+func (s *CdpSavingsStrategy) debtToCollateral(ctx sdk.Context, position cdpSavingsPosition, amount sdkmath.Int) (sdkmath.Int, error) {
+	if !amount.IsPositive() {
+		return sdk.ZeroInt(), nil
+	}
+
+	if pool, found := s.loadDebtPool(ctx, position); found {
+		swapOutput, _ := pool.SwapWithExactInput(sdk.NewCoin(position.debt.Denom, amount), s.swapKeeper.GetSwapFee(ctx))
+		return swapOutput.Amount, nil
+	}
+
+	price, err := s.pricefeedKeeper.GetCurrentPrice(ctx, position.collateral.SpotMarketID)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	return sdk.NewDecFromInt(amount).
+		Quo(conversionScale(position.debt.ConversionFactor, position.collateral.ConversionFactor)).
+		Quo(price.Price).
+		TruncateInt(), nil
+}
+
+// collateralCostOfDebt returns the collateral needed to buy an amount of the
+// debt asset. The swap pool is used if it has the liquidity, otherwise the
+// spot price.
+func (s *CdpSavingsStrategy) collateralCostOfDebt(ctx sdk.Context, position cdpSavingsPosition, amount sdkmath.Int) (sdkmath.Int, error) {
+	if !amount.IsPositive() {
+		return sdk.ZeroInt(), nil
+	}
+
+	if pool, found := s.loadDebtPool(ctx, position); found && amount.LT(pool.Reserves().AmountOf(position.debt.Denom)) {
+		swapInput, _ := pool.SwapWithExactOutput(sdk.NewCoin(position.debt.Denom, amount), s.swapKeeper.GetSwapFee(ctx))
+		return swapInput.Amount, nil
+	}
+
+	price, err := s.pricefeedKeeper.GetCurrentPrice(ctx, position.collateral.SpotMarketID)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	return sdk.NewDecFromInt(amount).
+		Quo(conversionScale(position.debt.ConversionFactor, position.collateral.ConversionFactor)).
+		Quo(price.Price).
+		Ceil().
+		TruncateInt(), nil
+}
+
+// loadDebtPool returns the swap pool between the collateral and debt denoms.
+func (s *CdpSavingsStrategy) loadDebtPool(ctx sdk.Context, position cdpSavingsPosition) (*swaptypes.DenominatedPool, bool) {
+	record, found := s.swapKeeper.GetPool(ctx, swaptypes.PoolID(position.collateral.Denom, position.debt.Denom))
+	if !found {
+		return nil, false
+	}
+
+	pool, err := swaptypes.NewDenominatedPoolWithExistingShares(record.Reserves(), record.TotalShares)
+	if err != nil {
+		return nil, false
+	}
+
+	return pool, true
+}
+
+// depositSavings deposits the debt asset in savings and records it for the
+// vault.
+func (s *CdpSavingsStrategy) depositSavings(ctx sdk.Context, vaultDenom string, amount sdk.Coin) error {
+	(*Keeper)(s).harvestRewards(ctx)
+
+	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	if err := s.savingsKeeper.Deposit(ctx, macc.GetAddress(), sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	deposit, found := (*Keeper)(s).GetCdpSavingsDeposit(ctx, vaultDenom)
+	if !found {
+		deposit = types.NewCdpSavingsDeposit(vaultDenom, sdk.NewCoin(amount.Denom, sdk.ZeroInt()))
+	}
+	deposit.Amount = deposit.Amount.Add(amount)
+
+	(*Keeper)(s).UpdateCdpSavingsDeposit(ctx, deposit)
+	return nil
+}
+
+// withdrawSavings withdraws the debt asset from savings and records it for the
+// vault.
+func (s *CdpSavingsStrategy) withdrawSavings(ctx sdk.Context, vaultDenom string, amount sdk.Coin) error {
+	deposit, found := (*Keeper)(s).GetCdpSavingsDeposit(ctx, vaultDenom)
+	if !found || deposit.Amount.IsLT(amount) {
+		return errorsmod.Wrapf(types.ErrInsufficientValue, "vault %s savings deposit less than %s", vaultDenom, amount)
+	}
+
+	(*Keeper)(s).harvestRewards(ctx)
+
+	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	if err := s.savingsKeeper.Withdraw(ctx, macc.GetAddress(), sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	deposit.Amount = deposit.Amount.Sub(amount)
+
+	(*Keeper)(s).UpdateCdpSavingsDeposit(ctx, deposit)
+	return nil
+}
+
+// conversionScale returns the factor to multiply a collateral amount by, after
+// applying the price, to get a debt amount.
+func conversionScale(debtConversionFactor, collateralConversionFactor sdkmath.Int) sdk.Dec {
+	exponent := debtConversionFactor.Sub(collateralConversionFactor).Int64()
+	if exponent >= 0 {
+		return sdk.NewDecFromInt(sdkmath.NewIntWithDecimal(1, int(exponent)))
+	}
+
+	return sdk.OneDec().QuoInt(sdkmath.NewIntWithDecimal(1, int(-exponent)))
+}
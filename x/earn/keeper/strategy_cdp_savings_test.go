@@ -0,0 +1,233 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	cdptypes "github.com/incubus-network/fury/x/cdp/types"
+	"github.com/incubus-network/fury/x/earn/testutil"
+	"github.com/incubus-network/fury/x/earn/types"
+	swaptypes "github.com/incubus-network/fury/x/swap/types"
+)
+
+const (
+	cdpVaultDenom      = "bnb"
+	cdpCollateralType  = "bnb-a"
+	cdpDebtDenom       = "usdx"
+	cdpVaultDepositAmt = 1_000_000_000 // 10 bnb, worth $100
+)
+
+type strategyCdpSavingsTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *strategyCdpSavingsTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+
+	cdpParams := cdptypes.DefaultParams()
+	cdpParams.GlobalDebtLimit = sdk.NewInt64Coin(cdpDebtDenom, 1_000_000_000_000)
+	cdpParams.CollateralParams = cdptypes.CollateralParams{
+		cdptypes.NewCollateralParam(
+			cdpVaultDenom,
+			cdpCollateralType,
+			sdk.MustNewDecFromStr("1.5"),
+			sdk.NewInt64Coin(cdpDebtDenom, 1_000_000_000_000),
+			sdk.MustNewDecFromStr("1.000000001547125958"), // 5% apr
+			sdkmath.NewInt(100_000_000_000),
+			sdk.MustNewDecFromStr("0.05"),
+			"bnb:usd",
+			"bnb:usd",
+			sdk.MustNewDecFromStr("0.01"),
+			sdkmath.NewInt(10),
+			sdkmath.NewInt(8),
+		),
+	}
+	cdpKeeper := suite.App.GetCDPKeeper()
+	cdpKeeper.SetParams(suite.Ctx, cdpParams)
+	cdpKeeper.SetMarketStatus(suite.Ctx, "bnb:usd", true)
+
+	vault := types.NewAllowedVault(cdpVaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_CDP_SAVINGS}, false, nil)
+	vault.CdpSavingsParams = types.NewCdpSavingsStrategyParams(
+		cdpCollateralType,
+		sdk.MustNewDecFromStr("3"),
+		sdk.MustNewDecFromStr("0.05"),
+	)
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}))
+}
+
+func TestStrategyCdpSavingsTestSuite(t *testing.T) {
+	suite.Run(t, new(strategyCdpSavingsTestSuite))
+}
+
+// cdpEqual checks the collateral and principal of the earn module account cdp.
+func (suite *strategyCdpSavingsTestSuite) cdpEqual(collateral, principal int64) {
+	macc := suite.AccountKeeper.GetModuleAccount(suite.Ctx, types.ModuleName)
+	cdp, found := suite.App.GetCDPKeeper().GetCdpByOwnerAndCollateralType(suite.Ctx, macc.GetAddress(), cdpCollateralType)
+	suite.Require().True(found)
+
+	suite.Equal(sdk.NewInt64Coin(cdpVaultDenom, collateral), cdp.Collateral)
+	suite.Equal(sdk.NewInt64Coin(cdpDebtDenom, principal), cdp.Principal)
+}
+
+func (suite *strategyCdpSavingsTestSuite) TestGetStrategyType() {
+	strategy, err := suite.Keeper.GetStrategy(types.STRATEGY_TYPE_CDP_SAVINGS)
+	suite.Require().NoError(err)
+
+	suite.Equal(types.STRATEGY_TYPE_CDP_SAVINGS, strategy.GetStrategyType())
+}
+
+func (suite *strategyCdpSavingsTestSuite) TestDeposit() {
+	depositAmount := sdk.NewInt64Coin(cdpVaultDenom, cdpVaultDepositAmt)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount.Add(depositAmount)), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_CDP_SAVINGS)
+	suite.Require().NoError(err)
+
+	// $100 of collateral at a 3x ratio draws $33.33 of debt into savings
+	suite.cdpEqual(cdpVaultDepositAmt, 33_333_333)
+	suite.SavingsDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin(cdpDebtDenom, 33_333_333)))
+
+	deposit, found := suite.Keeper.GetCdpSavingsDeposit(suite.Ctx, cdpVaultDenom)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewInt64Coin(cdpDebtDenom, 33_333_333), deposit.Amount)
+
+	suite.VaultTotalValuesEqual(sdk.NewCoins(depositAmount))
+
+	// A second deposit adds collateral and draws more debt
+	err = suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_CDP_SAVINGS)
+	suite.Require().NoError(err)
+
+	suite.cdpEqual(2*cdpVaultDepositAmt, 66_666_666)
+	suite.SavingsDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin(cdpDebtDenom, 66_666_666)))
+	suite.VaultTotalValuesEqual(sdk.NewCoins(depositAmount.Add(depositAmount)))
+}
+
+func (suite *strategyCdpSavingsTestSuite) TestDeposit_BelowDebtFloor() {
+	depositAmount := sdk.NewInt64Coin(cdpVaultDenom, 10_000_000) // 0.1 bnb, worth $1
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_CDP_SAVINGS)
+	suite.Require().ErrorIs(err, types.ErrInsufficientAmount)
+}
+
+func (suite *strategyCdpSavingsTestSuite) TestWithdraw() {
+	depositAmount := sdk.NewInt64Coin(cdpVaultDenom, cdpVaultDepositAmt)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_CDP_SAVINGS)
+	suite.Require().NoError(err)
+
+	// Partial withdraw repays debt from savings to keep the target ratio
+	withdrawAmount := sdk.NewInt64Coin(cdpVaultDenom, 400_000_000)
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), withdrawAmount, types.STRATEGY_TYPE_CDP_SAVINGS)
+	suite.Require().NoError(err)
+
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(withdrawAmount))
+	suite.cdpEqual(600_000_000, 20_000_000)
+	suite.SavingsDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin(cdpDebtDenom, 20_000_000)))
+	suite.VaultTotalValuesEqual(sdk.NewCoins(sdk.NewInt64Coin(cdpVaultDenom, 600_000_000)))
+
+	// Full withdraw closes the cdp
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(cdpVaultDenom, 600_000_000), types.STRATEGY_TYPE_CDP_SAVINGS)
+	suite.Require().NoError(err)
+
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(depositAmount))
+
+	macc := suite.AccountKeeper.GetModuleAccount(suite.Ctx, types.ModuleName)
+	_, found := suite.App.GetCDPKeeper().GetCdpByOwnerAndCollateralType(suite.Ctx, macc.GetAddress(), cdpCollateralType)
+	suite.False(found)
+	_, found = suite.Keeper.GetCdpSavingsDeposit(suite.Ctx, cdpVaultDenom)
+	suite.False(found)
+	_, found = suite.Keeper.GetVaultRecord(suite.Ctx, cdpVaultDenom)
+	suite.False(found)
+}
+
+func (suite *strategyCdpSavingsTestSuite) TestStabilityFeesReduceShareValue() {
+	depositAmount := sdk.NewInt64Coin(cdpVaultDenom, cdpVaultDepositAmt)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_CDP_SAVINGS)
+	suite.Require().NoError(err)
+
+	// A pool to buy the debt not covered by savings when closing the cdp
+	swapKeeper := suite.App.GetSwapKeeper()
+	swapKeeper.SetParams(suite.Ctx, swaptypes.NewParams(
+		swaptypes.NewAllowedPools(swaptypes.NewAllowedPool(cdpVaultDenom, cdpDebtDenom)),
+		sdk.MustNewDecFromStr("0.003"),
+	))
+	provider := suite.CreateAccount(sdk.NewCoins(
+		sdk.NewInt64Coin(cdpVaultDenom, 100_000_000_000),
+		sdk.NewInt64Coin(cdpDebtDenom, 10_000_000_000),
+	), 1)
+	err = swapKeeper.Deposit(
+		suite.Ctx,
+		provider.GetAddress(),
+		sdk.NewInt64Coin(cdpVaultDenom, 100_000_000_000),
+		sdk.NewInt64Coin(cdpDebtDenom, 10_000_000_000),
+		sdk.MustNewDecFromStr("0.01"),
+	)
+	suite.Require().NoError(err)
+
+	// Accrue a year of stability fees
+	cdpKeeper := suite.App.GetCDPKeeper()
+	suite.Require().NoError(cdpKeeper.AccumulateInterest(suite.Ctx, cdpCollateralType))
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(365 * 24 * time.Hour))
+	suite.Require().NoError(cdpKeeper.AccumulateInterest(suite.Ctx, cdpCollateralType))
+
+	accValue, err := suite.Keeper.GetVaultAccountValue(suite.Ctx, cdpVaultDenom, acc.GetAddress())
+	suite.Require().NoError(err)
+
+	// ~$1.67 of fees on $33.33 of debt costs ~0.167 bnb of collateral
+	suite.True(accValue.Amount.LT(sdk.NewInt(cdpVaultDepositAmt-16_000_000)), "unexpected value %s", accValue)
+	suite.True(accValue.Amount.GT(sdk.NewInt(cdpVaultDepositAmt-17_000_000)), "unexpected value %s", accValue)
+
+	// Closing the cdp sells collateral for the fees
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), accValue, types.STRATEGY_TYPE_CDP_SAVINGS)
+	suite.Require().NoError(err)
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(accValue))
+
+	macc := suite.AccountKeeper.GetModuleAccount(suite.Ctx, types.ModuleName)
+	_, found := cdpKeeper.GetCdpByOwnerAndCollateralType(suite.Ctx, macc.GetAddress(), cdpCollateralType)
+	suite.False(found)
+}
+
+func (suite *strategyCdpSavingsTestSuite) TestSavingsVaultExcludesCdpDeposit() {
+	cdpVault := suite.Keeper.GetAllowedVaults(suite.Ctx)[0]
+	savingsVault := types.NewAllowedVault(cdpDebtDenom, types.StrategyTypes{types.STRATEGY_TYPE_SAVINGS}, false, nil)
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{cdpVault, savingsVault}))
+
+	depositAmount := sdk.NewInt64Coin(cdpVaultDenom, cdpVaultDepositAmt)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_CDP_SAVINGS)
+	suite.Require().NoError(err)
+
+	// The usdx deposited by the cdp strategy is not part of the savings vault
+	savingsAmount := sdk.NewInt64Coin(cdpDebtDenom, 10_000_000)
+	saver := suite.CreateAccount(sdk.NewCoins(savingsAmount), 1)
+
+	err = suite.Keeper.Deposit(suite.Ctx, saver.GetAddress(), savingsAmount, types.STRATEGY_TYPE_SAVINGS)
+	suite.Require().NoError(err)
+
+	suite.SavingsDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin(cdpDebtDenom, 33_333_333+10_000_000)))
+
+	totalValue, err := suite.Keeper.GetVaultTotalValue(suite.Ctx, cdpDebtDenom)
+	suite.Require().NoError(err)
+	suite.Equal(savingsAmount, totalValue)
+
+	strategy, err := suite.Keeper.GetStrategy(types.STRATEGY_TYPE_SAVINGS)
+	suite.Require().NoError(err)
+	err = strategy.Withdraw(suite.Ctx, savingsAmount.Add(sdk.NewInt64Coin(cdpDebtDenom, 1)))
+	suite.Require().ErrorIs(err, types.ErrInsufficientValue)
+
+	_, err = suite.Keeper.Withdraw(suite.Ctx, saver.GetAddress(), savingsAmount, types.STRATEGY_TYPE_SAVINGS)
+	suite.Require().NoError(err)
+
+	suite.AccountBalanceEqual(saver.GetAddress(), sdk.NewCoins(savingsAmount))
+	suite.SavingsDepositAmountEqual(sdk.NewCoins(sdk.NewInt64Coin(cdpDebtDenom, 33_333_333)))
+	suite.VaultTotalValuesEqual(sdk.NewCoins(depositAmount))
+}
@@ -38,12 +38,16 @@ func (s *HardStrategy) GetEstimatedTotalAssets(ctx sdk.Context, denom string) (s
 
 // Deposit deposits the specified amount of coins into hard.
 func (s *HardStrategy) Deposit(ctx sdk.Context, amount sdk.Coin) error {
+	(*Keeper)(s).harvestRewards(ctx)
+
 	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
 	return s.hardKeeper.Deposit(ctx, macc.GetAddress(), sdk.NewCoins(amount))
 }
 
 // Withdraw withdraws the specified amount of coins from hard.
 func (s *HardStrategy) Withdraw(ctx sdk.Context, amount sdk.Coin) error {
+	(*Keeper)(s).harvestRewards(ctx)
+
 	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
 	return s.hardKeeper.Withdraw(ctx, macc.GetAddress(), sdk.NewCoins(amount))
 }
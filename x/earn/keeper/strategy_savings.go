@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/incubus-network/fury/x/earn/types"
 )
@@ -16,7 +17,8 @@ func (s *SavingsStrategy) GetStrategyType() types.StrategyType {
 }
 
 // GetEstimatedTotalAssets returns the current value of all assets deposited
-// in savings.
+// in savings. The module account savings deposit also holds the debt asset
+// deposited by the cdp strategy, which is excluded.
 func (s *SavingsStrategy) GetEstimatedTotalAssets(ctx sdk.Context, denom string) (sdk.Coin, error) {
 	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
 	deposit, found := s.savingsKeeper.GetDeposit(ctx, macc.GetAddress())
@@ -25,25 +27,37 @@ func (s *SavingsStrategy) GetEstimatedTotalAssets(ctx sdk.Context, denom string)
 		return sdk.NewCoin(denom, sdk.ZeroInt()), nil
 	}
 
-	// Only return the deposit for the vault denom.
-	for _, coin := range deposit.Amount {
-		if coin.Denom == denom {
-			return coin, nil
-		}
+	// Only return the deposit for the vault denom, less the cdp strategy's.
+	amount := deposit.Amount.AmountOf(denom).Sub((*Keeper)(s).GetTotalCdpSavingsDeposits(ctx).AmountOf(denom))
+	if !amount.IsPositive() {
+		return sdk.NewCoin(denom, sdk.ZeroInt()), nil
 	}
 
-	// Return 0 if no deposit exists for the vault denom
-	return sdk.NewCoin(denom, sdk.ZeroInt()), nil
+	return sdk.NewCoin(denom, amount), nil
 }
 
 // Deposit deposits the specified amount of coins into savings.
 func (s *SavingsStrategy) Deposit(ctx sdk.Context, amount sdk.Coin) error {
+	(*Keeper)(s).harvestRewards(ctx)
+
 	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
 	return s.savingsKeeper.Deposit(ctx, macc.GetAddress(), sdk.NewCoins(amount))
 }
 
-// Withdraw withdraws the specified amount of coins from savings.
+// Withdraw withdraws the specified amount of coins from savings. It cannot
+// withdraw the debt asset deposited by the cdp strategy.
 func (s *SavingsStrategy) Withdraw(ctx sdk.Context, amount sdk.Coin) error {
+	available, err := s.GetEstimatedTotalAssets(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	if available.IsLT(amount) {
+		return errorsmod.Wrapf(types.ErrInsufficientValue, "savings strategy holds %s, less than %s", available, amount)
+	}
+
+	(*Keeper)(s).harvestRewards(ctx)
+
 	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
 	return s.savingsKeeper.Withdraw(ctx, macc.GetAddress(), sdk.NewCoins(amount))
 }
@@ -0,0 +1,346 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fury/x/earn/types"
+	swaptypes "github.com/incubus-network/fury/x/swap/types"
+)
+
+// SwapLPStrategy defines the strategy that provides liquidity to a pool in
+// x/swap. Deposits swap part of the vault denom for the paired denom and add
+// both to the pool, withdrawals remove liquidity and swap the paired denom back.
+type SwapLPStrategy Keeper
+
+var _ Strategy = (*SwapLPStrategy)(nil)
+
+// GetStrategyType returns the strategy type
+func (s *SwapLPStrategy) GetStrategyType() types.StrategyType {
+	return types.STRATEGY_TYPE_SWAP_LP
+}
+
+// GetEstimatedTotalAssets returns the amount of the vault denom the module
+// account would receive if it removed all of its liquidity from the pool and
+// swapped the paired denom back to the vault denom, including the residual
+// held for the vault. Impermanent loss and swap fees are reflected in this
+// value.
+func (s *SwapLPStrategy) GetEstimatedTotalAssets(ctx sdk.Context, denom string) (sdk.Coin, error) {
+	params, err := s.getSwapLPParams(ctx, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	poolID := swaptypes.PoolID(denom, params.PairedDenom)
+	residual := s.getResidual(ctx, denom)
+
+	shares, found := s.swapKeeper.GetDepositorSharesAmount(ctx, macc.GetAddress(), poolID)
+	if !found {
+		shares = sdk.ZeroInt()
+	}
+
+	if shares.IsZero() && residual.IsZero() {
+		// Return 0 if no liquidity is provided by the module account
+		return sdk.NewCoin(denom, sdk.ZeroInt()), nil
+	}
+
+	pool, err := s.loadPool(ctx, poolID)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(denom, s.simulateUnwind(ctx, pool, shares, residual, denom, params.PairedDenom)), nil
+}
+
+// Deposit swaps part of the amount, together with the residual vault denom,
+// for the paired denom so the remainder and the swap output match the pool
+// ratio, and adds both to the pool. Amounts left over from rounding are added
+// to the residual.
+func (s *SwapLPStrategy) Deposit(ctx sdk.Context, amount sdk.Coin) error {
+	params, err := s.getSwapLPParams(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	poolID := swaptypes.PoolID(amount.Denom, params.PairedDenom)
+	pool, err := s.loadPool(ctx, poolID)
+	if err != nil {
+		return err
+	}
+
+	residual := s.getResidual(ctx, amount.Denom)
+	depositAmount := amount.AddAmount(residual.AmountOf(amount.Denom))
+
+	fee := s.swapKeeper.GetSwapFee(ctx)
+	reserves := pool.Reserves()
+
+	swapAmount := calculateZapSwapAmount(depositAmount.Amount, reserves.AmountOf(amount.Denom), fee)
+	if !swapAmount.IsPositive() || swapAmount.GTE(depositAmount.Amount) {
+		return errorsmod.Wrapf(types.ErrInsufficientAmount, "deposit %s too small to provide liquidity", amount)
+	}
+
+	swapInput := sdk.NewCoin(amount.Denom, swapAmount)
+	swapOutput, _ := pool.SwapWithExactInput(swapInput, fee)
+	if swapOutput.IsZero() {
+		return errorsmod.Wrapf(types.ErrInsufficientAmount, "deposit %s too small to provide liquidity", amount)
+	}
+
+	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	balanceBefore := s.bankKeeper.GetAllBalances(ctx, macc.GetAddress())
+
+	spotOutput := spotSwapOutput(reserves, swapInput, params.PairedDenom)
+	if err := s.swapKeeper.SwapExactForTokens(ctx, macc.GetAddress(), swapInput, spotOutput, params.SlippageLimit); err != nil {
+		return err
+	}
+
+	if err := s.swapKeeper.Deposit(
+		ctx,
+		macc.GetAddress(),
+		depositAmount.Sub(swapInput),
+		swapOutput,
+		params.SlippageLimit,
+	); err != nil {
+		return err
+	}
+
+	return s.updateResidual(ctx, amount.Denom, params.PairedDenom, residual, balanceBefore, amount.Amount)
+}
+
+// Withdraw removes the fewest pool shares that return at least the amount,
+// together with the residual, once the paired denom is swapped back to the
+// vault denom. Any amount above the requested amount, and any paired denom
+// that could not be swapped back, is kept as the residual of the vault.
+func (s *SwapLPStrategy) Withdraw(ctx sdk.Context, amount sdk.Coin) error {
+	params, err := s.getSwapLPParams(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	poolID := swaptypes.PoolID(amount.Denom, params.PairedDenom)
+	residual := s.getResidual(ctx, amount.Denom)
+
+	sharesOwned, found := s.swapKeeper.GetDepositorSharesAmount(ctx, macc.GetAddress(), poolID)
+	if !found {
+		sharesOwned = sdk.ZeroInt()
+	}
+
+	pool, err := s.loadPool(ctx, poolID)
+	if err != nil {
+		return err
+	}
+
+	if s.simulateUnwind(ctx, pool, sharesOwned, residual, amount.Denom, params.PairedDenom).LT(amount.Amount) {
+		return errorsmod.Wrapf(types.ErrInsufficientValue, "liquidity in pool %s is worth less than %s", poolID, amount)
+	}
+
+	// Binary search for the smallest number of shares that cover the amount.
+	low, high := sdk.ZeroInt(), sharesOwned
+	for low.LT(high) {
+		mid := low.Add(high).QuoRaw(2)
+		if s.simulateUnwind(ctx, pool, mid, residual, amount.Denom, params.PairedDenom).GTE(amount.Amount) {
+			high = mid
+		} else {
+			low = mid.AddRaw(1)
+		}
+	}
+
+	balanceBefore := s.bankKeeper.GetAllBalances(ctx, macc.GetAddress())
+
+	if high.IsPositive() {
+		if err := s.swapKeeper.Withdraw(
+			ctx,
+			macc.GetAddress(),
+			high,
+			sdk.NewCoin(amount.Denom, sdk.ZeroInt()),
+			sdk.NewCoin(params.PairedDenom, sdk.ZeroInt()),
+		); err != nil {
+			return err
+		}
+	}
+
+	// Swap all of the paired denom held for the vault back. If the swap is not
+	// possible the paired denom stays in the residual at its swap value, so the
+	// shortfall is a loss of the vault rather than of the module account.
+	pairedAmount := residual.AmountOf(params.PairedDenom).
+		Add(s.bankKeeper.GetAllBalances(ctx, macc.GetAddress()).AmountOf(params.PairedDenom)).
+		Sub(balanceBefore.AmountOf(params.PairedDenom))
+	if pairedAmount.IsPositive() {
+		remaining, err := s.loadPool(ctx, poolID)
+		if err != nil {
+			return err
+		}
+
+		swapInput := sdk.NewCoin(params.PairedDenom, pairedAmount)
+		spotOutput := spotSwapOutput(remaining.Reserves(), swapInput, amount.Denom)
+		if spotOutput.IsPositive() {
+			cacheCtx, writeCache := ctx.CacheContext()
+			err := s.swapKeeper.SwapExactForTokens(cacheCtx, macc.GetAddress(), swapInput, spotOutput, params.SlippageLimit)
+			if err == nil {
+				writeCache()
+			}
+		}
+	}
+
+	return s.updateResidual(ctx, amount.Denom, params.PairedDenom, residual, balanceBefore, amount.Amount.Neg())
+}
+
+// getResidual returns the vault denom and paired denom held in the module
+// account for the vault.
+func (s *SwapLPStrategy) getResidual(ctx sdk.Context, denom string) sdk.Coins {
+	residual, found := (*Keeper)(s).GetSwapLPResidual(ctx, denom)
+	if !found {
+		return sdk.NewCoins()
+	}
+
+	return residual.Amount
+}
+
+// updateResidual sets the residual of the vault from the change in the module
+// account balances since balanceBefore. The vault denom change excludes the
+// amount deposited to or withdrawn from the strategy, which is negative for
+// withdrawals. A residual that would be negative means the strategy could
+// not return the amount.
+func (s *SwapLPStrategy) updateResidual(
+	ctx sdk.Context,
+	denom string,
+	pairedDenom string,
+	residual sdk.Coins,
+	balanceBefore sdk.Coins,
+	vaultDenomChange sdkmath.Int,
+) error {
+	macc := s.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	balanceAfter := s.bankKeeper.GetAllBalances(ctx, macc.GetAddress())
+
+	amount := residual.AmountOf(denom).
+		Add(balanceAfter.AmountOf(denom)).
+		Sub(balanceBefore.AmountOf(denom)).
+		Add(vaultDenomChange)
+	if amount.IsNegative() {
+		return errorsmod.Wrapf(
+			types.ErrInsufficientValue,
+			"swap lp strategy returned %s%s less than requested", amount.Neg(), denom,
+		)
+	}
+
+	pairedAmount := residual.AmountOf(pairedDenom).
+		Add(balanceAfter.AmountOf(pairedDenom)).
+		Sub(balanceBefore.AmountOf(pairedDenom))
+
+	(*Keeper)(s).UpdateSwapLPResidual(ctx, types.NewSwapLPResidual(denom, sdk.NewCoins(
+		sdk.NewCoin(denom, amount),
+		sdk.NewCoin(pairedDenom, pairedAmount),
+	)))
+	return nil
+}
+
+// getSwapLPParams returns the swap LP params of the vault with the given denom.
+func (s *SwapLPStrategy) getSwapLPParams(ctx sdk.Context, denom string) (types.SwapLPStrategyParams, error) {
+	allowedVault, found := (*Keeper)(s).GetAllowedVault(ctx, denom)
+	if !found {
+		return types.SwapLPStrategyParams{}, types.ErrInvalidVaultDenom
+	}
+
+	if allowedVault.SwapLPParams == nil {
+		return types.SwapLPStrategyParams{}, errorsmod.Wrapf(types.ErrInvalidVaultStrategy, "vault %s has no swap lp params", denom)
+	}
+
+	return *allowedVault.SwapLPParams, nil
+}
+
+// loadPool returns the pool with the given ID.
+func (s *SwapLPStrategy) loadPool(ctx sdk.Context, poolID string) (*swaptypes.DenominatedPool, error) {
+	record, found := s.swapKeeper.GetPool(ctx, poolID)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidVaultStrategy, "swap pool %s not found", poolID)
+	}
+
+	pool, err := swaptypes.NewDenominatedPoolWithExistingShares(record.Reserves(), record.TotalShares)
+	if err != nil {
+		return nil, fmt.Errorf("invalid swap pool %s: %w", poolID, err)
+	}
+
+	return pool, nil
+}
+
+// simulateUnwind returns the amount of the vault denom received for removing
+// the shares from the pool and swapping the paired denom back, including the
+// residual held for the vault. If the shares are the whole pool, the paired
+// denom is valued at the pool price instead.
+func (s *SwapLPStrategy) simulateUnwind(
+	ctx sdk.Context,
+	pool *swaptypes.DenominatedPool,
+	shares sdkmath.Int,
+	residual sdk.Coins,
+	denom string,
+	pairedDenom string,
+) sdkmath.Int {
+	reserves := pool.Reserves()
+	amount := residual.AmountOf(denom)
+	pairedAmount := residual.AmountOf(pairedDenom)
+	remaining := pool
+
+	value := sdk.NewCoins()
+	if shares.IsPositive() {
+		value = pool.ShareValue(shares)
+	}
+
+	// The swap module rejects withdrawals that are zero on either side.
+	if value.AmountOf(denom).IsPositive() && value.AmountOf(pairedDenom).IsPositive() {
+		amount = amount.Add(value.AmountOf(denom))
+		pairedAmount = pairedAmount.Add(value.AmountOf(pairedDenom))
+
+		if shares.GTE(pool.TotalShares()) {
+			return amount.Add(spotSwapOutput(reserves, sdk.NewCoin(pairedDenom, pairedAmount), denom).Amount)
+		}
+
+		var err error
+		remaining, err = swaptypes.NewDenominatedPoolWithExistingShares(reserves.Sub(value...), pool.TotalShares().Sub(shares))
+		if err != nil {
+			return amount
+		}
+	}
+
+	if !pairedAmount.IsPositive() {
+		return amount
+	}
+
+	swapOutput, _ := remaining.SwapWithExactInput(sdk.NewCoin(pairedDenom, pairedAmount), s.swapKeeper.GetSwapFee(ctx))
+	return amount.Add(swapOutput.Amount)
+}
+
+// spotSwapOutput returns the output of a swap at the spot price of the
+// reserves, without fees or price impact.
+func spotSwapOutput(reserves sdk.Coins, input sdk.Coin, outputDenom string) sdk.Coin {
+	output := sdk.NewDecFromInt(input.Amount).
+		MulInt(reserves.AmountOf(outputDenom)).
+		QuoInt(reserves.AmountOf(input.Denom)).
+		TruncateInt()
+
+	return sdk.NewCoin(outputDenom, output)
+}
+
+// calculateZapSwapAmount returns the amount of a single asset deposit to swap
+// so that the rest of the deposit and the swap output are in the ratio of the
+// pool after the swap. For reserve r, deposit a and fee f, it solves
+//
+//	(1-f)s^2 + (2-f)rs - ar = 0
+func calculateZapSwapAmount(amount, reserve sdkmath.Int, fee sdk.Dec) sdkmath.Int {
+	r := sdk.NewDecFromInt(reserve)
+	a := sdk.NewDecFromInt(amount)
+	oneMinusFee := sdk.OneDec().Sub(fee)
+	twoMinusFee := sdk.NewDec(2).Sub(fee)
+
+	b := twoMinusFee.Mul(r)
+	discriminant := b.Mul(b).Add(sdk.NewDec(4).Mul(oneMinusFee).Mul(a).Mul(r))
+	root, err := discriminant.ApproxSqrt()
+	if err != nil {
+		return sdk.ZeroInt()
+	}
+
+	return root.Sub(b).Quo(sdk.NewDec(2).Mul(oneMinusFee)).TruncateInt()
+}
@@ -0,0 +1,223 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/incubus-network/fury/x/earn/testutil"
+	"github.com/incubus-network/fury/x/earn/types"
+	swaptypes "github.com/incubus-network/fury/x/swap/types"
+)
+
+const (
+	swapLPVaultDenom  = "usdx"
+	swapLPPairedDenom = "busd"
+)
+
+type strategySwapLPTestSuite struct {
+	testutil.Suite
+}
+
+func (suite *strategySwapLPTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+
+	swapKeeper := suite.App.GetSwapKeeper()
+	swapKeeper.SetParams(suite.Ctx, swaptypes.NewParams(
+		swaptypes.NewAllowedPools(swaptypes.NewAllowedPool(swapLPPairedDenom, swapLPVaultDenom)),
+		sdk.MustNewDecFromStr("0.003"),
+	))
+
+	// Seed the pool with liquidity from another provider
+	provider := suite.CreateAccount(sdk.NewCoins(
+		sdk.NewInt64Coin(swapLPVaultDenom, 1_000_000_000),
+		sdk.NewInt64Coin(swapLPPairedDenom, 1_000_000_000),
+	), 1)
+	err := swapKeeper.Deposit(
+		suite.Ctx,
+		provider.GetAddress(),
+		sdk.NewInt64Coin(swapLPVaultDenom, 1_000_000_000),
+		sdk.NewInt64Coin(swapLPPairedDenom, 1_000_000_000),
+		sdk.MustNewDecFromStr("0.01"),
+	)
+	suite.Require().NoError(err)
+
+	vault := types.NewAllowedVault(swapLPVaultDenom, types.StrategyTypes{types.STRATEGY_TYPE_SWAP_LP}, false, nil)
+	vault.SwapLPParams = types.NewSwapLPStrategyParams(swapLPPairedDenom, sdk.MustNewDecFromStr("0.05"))
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(types.AllowedVaults{vault}))
+}
+
+func TestStrategySwapLPTestSuite(t *testing.T) {
+	suite.Run(t, new(strategySwapLPTestSuite))
+}
+
+func (suite *strategySwapLPTestSuite) lpSharesOwned() sdkmath.Int {
+	macc := suite.AccountKeeper.GetModuleAccount(suite.Ctx, types.ModuleName)
+	shares, found := suite.App.GetSwapKeeper().GetDepositorSharesAmount(
+		suite.Ctx,
+		macc.GetAddress(),
+		swaptypes.PoolID(swapLPVaultDenom, swapLPPairedDenom),
+	)
+	if !found {
+		return sdk.ZeroInt()
+	}
+
+	return shares
+}
+
+func (suite *strategySwapLPTestSuite) TestGetStrategyType() {
+	strategy, err := suite.Keeper.GetStrategy(types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+
+	suite.Equal(types.STRATEGY_TYPE_SWAP_LP, strategy.GetStrategyType())
+}
+
+func (suite *strategySwapLPTestSuite) TestDeposit() {
+	depositAmount := sdk.NewInt64Coin(swapLPVaultDenom, 10_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+
+	suite.True(suite.lpSharesOwned().IsPositive())
+
+	// Swap fees and price impact are reflected in the vault value
+	totalValue, err := suite.Keeper.GetVaultTotalValue(suite.Ctx, swapLPVaultDenom)
+	suite.Require().NoError(err)
+	suite.True(totalValue.Amount.LT(depositAmount.Amount), "expected %s < %s", totalValue, depositAmount)
+	suite.True(totalValue.Amount.GT(sdk.NewInt(9_950_000)), "expected %s > 9950000", totalValue)
+
+	// Leftovers from matching the pool ratio are dust
+	macc := suite.AccountKeeper.GetModuleAccount(suite.Ctx, types.ModuleName)
+	balance := suite.BankKeeper.GetAllBalances(suite.Ctx, macc.GetAddress())
+	suite.True(balance.AmountOf(swapLPVaultDenom).LT(sdk.NewInt(10)), "unexpected leftover %s", balance)
+	suite.True(balance.AmountOf(swapLPPairedDenom).LT(sdk.NewInt(10)), "unexpected leftover %s", balance)
+}
+
+func (suite *strategySwapLPTestSuite) TestDeposit_SlippageExceeded() {
+	depositAmount := sdk.NewInt64Coin(swapLPVaultDenom, 500_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().ErrorIs(err, swaptypes.ErrSlippageExceeded)
+}
+
+func (suite *strategySwapLPTestSuite) TestWithdraw() {
+	depositAmount := sdk.NewInt64Coin(swapLPVaultDenom, 10_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+
+	totalValue, err := suite.Keeper.GetVaultTotalValue(suite.Ctx, swapLPVaultDenom)
+	suite.Require().NoError(err)
+
+	// Partial withdraw returns exactly the amount requested
+	half := sdk.NewCoin(swapLPVaultDenom, totalValue.Amount.QuoRaw(2))
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), half, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(half))
+
+	remainingValue, err := suite.Keeper.GetVaultTotalValue(suite.Ctx, swapLPVaultDenom)
+	suite.Require().NoError(err)
+	// Swapping the withdrawn paired denom moves the pool price slightly against
+	// the remaining liquidity.
+	suite.InDelta(totalValue.Amount.Sub(half.Amount).Int64(), remainingValue.Amount.Int64(), 100)
+
+	// Withdraw the rest of the account value
+	accValue, err := suite.Keeper.GetVaultAccountValue(suite.Ctx, swapLPVaultDenom, acc.GetAddress())
+	suite.Require().NoError(err)
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), accValue, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(half.Add(accValue)))
+
+	_, found := suite.Keeper.GetVaultRecord(suite.Ctx, swapLPVaultDenom)
+	suite.False(found)
+}
+
+func (suite *strategySwapLPTestSuite) TestPriceMoveReducesShareValue() {
+	depositAmount := sdk.NewInt64Coin(swapLPVaultDenom, 10_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+
+	valueBefore, err := suite.Keeper.GetVaultAccountValue(suite.Ctx, swapLPVaultDenom, acc.GetAddress())
+	suite.Require().NoError(err)
+
+	// Selling the paired denom into the pool lowers its price, and the value of
+	// the vault in the vault denom.
+	trader := suite.CreateAccount(sdk.NewCoins(sdk.NewInt64Coin(swapLPPairedDenom, 200_000_000)), 2)
+	swapKeeper := suite.App.GetSwapKeeper()
+	err = swapKeeper.SwapExactForTokens(
+		suite.Ctx,
+		trader.GetAddress(),
+		sdk.NewInt64Coin(swapLPPairedDenom, 200_000_000),
+		sdk.NewInt64Coin(swapLPVaultDenom, 160_000_000),
+		sdk.MustNewDecFromStr("0.1"),
+	)
+	suite.Require().NoError(err)
+
+	valueAfter, err := suite.Keeper.GetVaultAccountValue(suite.Ctx, swapLPVaultDenom, acc.GetAddress())
+	suite.Require().NoError(err)
+	suite.True(valueAfter.Amount.LT(valueBefore.Amount), "expected %s < %s", valueAfter, valueBefore)
+
+	// The account can withdraw its reduced value
+	_, err = suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), valueAfter, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+	suite.AccountBalanceEqual(acc.GetAddress(), sdk.NewCoins(valueAfter))
+}
+
+func (suite *strategySwapLPTestSuite) TestResidualIsCounted() {
+	depositAmount := sdk.NewInt64Coin(swapLPVaultDenom, 10_000_000)
+	acc := suite.CreateAccount(sdk.NewCoins(depositAmount.Add(depositAmount)), 0)
+
+	err := suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+
+	strategy, err := suite.Keeper.GetStrategy(types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+	lpValue, err := strategy.GetEstimatedTotalAssets(suite.Ctx, swapLPVaultDenom)
+	suite.Require().NoError(err)
+
+	// Leftovers held in the module account for the vault
+	macc := suite.AccountKeeper.GetModuleAccount(suite.Ctx, types.ModuleName)
+	leftovers := sdk.NewCoins(sdk.NewInt64Coin(swapLPVaultDenom, 1_000), sdk.NewInt64Coin(swapLPPairedDenom, 1_000))
+	err = suite.App.FundModuleAccount(suite.Ctx, types.ModuleName, leftovers.Sub(suite.BankKeeper.GetAllBalances(suite.Ctx, macc.GetAddress())...))
+	suite.Require().NoError(err)
+	suite.Keeper.SetSwapLPResidual(suite.Ctx, types.NewSwapLPResidual(swapLPVaultDenom, leftovers))
+
+	// The residual is part of the vault value, the paired denom at its swap
+	// value
+	totalValue, err := strategy.GetEstimatedTotalAssets(suite.Ctx, swapLPVaultDenom)
+	suite.Require().NoError(err)
+	suite.InDelta(lpValue.Amount.AddRaw(1_996).Int64(), totalValue.Amount.Int64(), 2)
+
+	// A withdraw covered by the residual uses it before the pool liquidity, and
+	// swaps the paired denom back
+	sharesBefore := suite.lpSharesOwned()
+	withdrawn, err := suite.Keeper.Withdraw(suite.Ctx, acc.GetAddress(), sdk.NewInt64Coin(swapLPVaultDenom, 1_500), types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+	suite.Equal(sharesBefore, suite.lpSharesOwned())
+
+	residual, found := suite.Keeper.GetSwapLPResidual(suite.Ctx, swapLPVaultDenom)
+	suite.Require().True(found)
+	suite.Equal(suite.BankKeeper.GetAllBalances(suite.Ctx, macc.GetAddress()), residual.Amount)
+	suite.True(residual.Amount.AmountOf(swapLPPairedDenom).IsZero(), "unexpected residual %s", residual.Amount)
+	suite.True(residual.Amount.AmountOf(swapLPVaultDenom).IsPositive(), "unexpected residual %s", residual.Amount)
+
+	remainingValue, err := strategy.GetEstimatedTotalAssets(suite.Ctx, swapLPVaultDenom)
+	suite.Require().NoError(err)
+	suite.InDelta(totalValue.Amount.Sub(withdrawn.Amount).Int64(), remainingValue.Amount.Int64(), 20)
+
+	// The next deposit adds the residual vault denom to the pool
+	err = suite.Keeper.Deposit(suite.Ctx, acc.GetAddress(), depositAmount, types.STRATEGY_TYPE_SWAP_LP)
+	suite.Require().NoError(err)
+
+	balance := suite.BankKeeper.GetAllBalances(suite.Ctx, macc.GetAddress())
+	residual, _ = suite.Keeper.GetSwapLPResidual(suite.Ctx, swapLPVaultDenom)
+	suite.Equal(balance, residual.Amount)
+	suite.True(balance.AmountOf(swapLPVaultDenom).LT(sdk.NewInt(10)), "unexpected leftover %s", balance)
+}
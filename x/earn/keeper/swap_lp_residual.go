@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/incubus-network/fury/x/earn/types"
+)
+
+// ----------------------------------------------------------------------------
+// SwapLPResidual -- amounts held in the module account by the swap LP strategy
+
+// GetSwapLPResidual returns the swap lp residual for a given vault denom.
+func (k *Keeper) GetSwapLPResidual(
+	ctx sdk.Context,
+	vaultDenom string,
+) (types.SwapLPResidual, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SwapLPResidualKeyPrefix)
+
+	bz := store.Get(types.VaultKey(vaultDenom))
+	if bz == nil {
+		return types.SwapLPResidual{}, false
+	}
+
+	var residual types.SwapLPResidual
+	k.cdc.MustUnmarshal(bz, &residual)
+
+	return residual, true
+}
+
+// UpdateSwapLPResidual updates the swap lp residual in state for a given
+// vault. This deletes it if the amount is empty and updates the state if
+// there is an amount.
+func (k *Keeper) UpdateSwapLPResidual(
+	ctx sdk.Context,
+	residual types.SwapLPResidual,
+) {
+	if residual.Amount.IsZero() {
+		k.DeleteSwapLPResidual(ctx, residual.VaultDenom)
+	} else {
+		k.SetSwapLPResidual(ctx, residual)
+	}
+}
+
+// DeleteSwapLPResidual deletes the swap lp residual for a given vault
+// denom.
+func (k *Keeper) DeleteSwapLPResidual(ctx sdk.Context, vaultDenom string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SwapLPResidualKeyPrefix)
+	store.Delete(types.VaultKey(vaultDenom))
+}
+
+// SetSwapLPResidual sets the swap lp residual for a given vault denom.
+func (k *Keeper) SetSwapLPResidual(ctx sdk.Context, residual types.SwapLPResidual) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SwapLPResidualKeyPrefix)
+	bz := k.cdc.MustMarshal(&residual)
+	store.Set(types.VaultKey(residual.VaultDenom), bz)
+}
+
+// IterateSwapLPResiduals iterates over all swap lp residuals in the store
+// and performs a callback function.
+func (k Keeper) IterateSwapLPResiduals(
+	ctx sdk.Context,
+	cb func(residual types.SwapLPResidual) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SwapLPResidualKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var residual types.SwapLPResidual
+		k.cdc.MustUnmarshal(iterator.Value(), &residual)
+		if cb(residual) {
+			break
+		}
+	}
+}
+
+// GetAllSwapLPResiduals returns all swap lp residuals from the store.
+func (k Keeper) GetAllSwapLPResiduals(ctx sdk.Context) types.SwapLPResiduals {
+	var residuals types.SwapLPResiduals
+
+	k.IterateSwapLPResiduals(ctx, func(residual types.SwapLPResidual) bool {
+		residuals = append(residuals, residual)
+		return false
+	})
+
+	return residuals
+}
@@ -35,13 +35,52 @@ func (k *Keeper) GetVaultTotalValue(
 		return sdk.Coin{}, types.ErrVaultRecordNotFound
 	}
 
-	strategy, err := k.GetStrategy(allowedVault.Strategies[0])
+	strategies, _, err := k.getVaultStrategies(allowedVault)
 	if err != nil {
 		return sdk.Coin{}, types.ErrInvalidVaultStrategy
 	}
 
 	// Denom can be different from allowedVault.Denom for bfury
-	return strategy.GetEstimatedTotalAssets(ctx, denom)
+	values, err := getStrategyValues(ctx, strategies, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	total := sdk.NewCoin(denom, sdk.ZeroInt())
+	for _, value := range values {
+		total = total.AddAmount(value)
+	}
+
+	return total, nil
+}
+
+// GetVaultAllocations returns the current assets of a vault held by each of
+// its strategies.
+func (k *Keeper) GetVaultAllocations(
+	ctx sdk.Context,
+	denom string,
+) (types.StrategyAllocations, error) {
+	allowedVault, found := k.GetAllowedVault(ctx, denom)
+	if !found {
+		return nil, types.ErrVaultRecordNotFound
+	}
+
+	strategies, _, err := k.getVaultStrategies(allowedVault)
+	if err != nil {
+		return nil, types.ErrInvalidVaultStrategy
+	}
+
+	values, err := getStrategyValues(ctx, strategies, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	allocations := make(types.StrategyAllocations, len(strategies))
+	for i, strategy := range strategies {
+		allocations[i] = types.NewStrategyAllocation(strategy.GetStrategyType(), values[i])
+	}
+
+	return allocations, nil
 }
 
 // GetVaultAccountShares returns the shares for a single address for all vaults.
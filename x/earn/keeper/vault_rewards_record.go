@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/incubus-network/fury/x/earn/types"
+)
+
+// ----------------------------------------------------------------------------
+// VaultRewardsRecord -- incentive rewards of a vault waiting to be compounded
+
+// GetVaultRewardsRecord returns the rewards record for a given vault denom.
+func (k *Keeper) GetVaultRewardsRecord(
+	ctx sdk.Context,
+	vaultDenom string,
+) (types.VaultRewardsRecord, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.VaultRewardsKeyPrefix)
+
+	bz := store.Get(types.VaultKey(vaultDenom))
+	if bz == nil {
+		return types.VaultRewardsRecord{}, false
+	}
+
+	var record types.VaultRewardsRecord
+	k.cdc.MustUnmarshal(bz, &record)
+
+	return record, true
+}
+
+// UpdateVaultRewardsRecord updates the rewards record in state for a given
+// vault. This deletes it if the rewards are empty and updates the state if
+// there are rewards.
+func (k *Keeper) UpdateVaultRewardsRecord(
+	ctx sdk.Context,
+	record types.VaultRewardsRecord,
+) {
+	if record.Rewards.IsZero() {
+		k.DeleteVaultRewardsRecord(ctx, record.VaultDenom)
+	} else {
+		k.SetVaultRewardsRecord(ctx, record)
+	}
+}
+
+// DeleteVaultRewardsRecord deletes the rewards record for a given vault
+// denom.
+func (k *Keeper) DeleteVaultRewardsRecord(ctx sdk.Context, vaultDenom string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.VaultRewardsKeyPrefix)
+	store.Delete(types.VaultKey(vaultDenom))
+}
+
+// SetVaultRewardsRecord sets the rewards record for a given vault denom.
+func (k *Keeper) SetVaultRewardsRecord(ctx sdk.Context, record types.VaultRewardsRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.VaultRewardsKeyPrefix)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.VaultKey(record.VaultDenom), bz)
+}
+
+// IterateVaultRewardsRecords iterates over all vault rewards records in the
+// store and performs a callback function.
+func (k Keeper) IterateVaultRewardsRecords(
+	ctx sdk.Context,
+	cb func(record types.VaultRewardsRecord) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.VaultRewardsKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.VaultRewardsRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetAllVaultRewardsRecords returns all vault rewards records from the store.
+func (k Keeper) GetAllVaultRewardsRecords(ctx sdk.Context) types.VaultRewardsRecords {
+	var records types.VaultRewardsRecords
+
+	k.IterateVaultRewardsRecords(ctx, func(record types.VaultRewardsRecord) bool {
+		records = append(records, record)
+		return false
+	})
+
+	return records
+}
@@ -80,3 +80,25 @@ func (k *Keeper) ShareIsDust(ctx sdk.Context, share types.VaultShare) (bool, err
 	// Truncated int, becomes zero if < 1
 	return coin.IsZero(), nil
 }
+
+// addAccountShares adds shares to the share record of an account, calling the
+// deposit hooks. It does not change the total shares of the vault.
+func (k *Keeper) addAccountShares(ctx sdk.Context, acc sdk.AccAddress, shares types.VaultShare) {
+	shareRecord, found := k.GetVaultShareRecord(ctx, acc)
+	if !found {
+		shareRecord = types.NewVaultShareRecord(acc, types.NewVaultShares())
+	}
+
+	currentShares := k.GetVaultAccountRewardShares(ctx, acc, shares.Denom)
+	isNew := currentShares.IsZero()
+	if !isNew {
+		k.BeforeVaultDepositModified(ctx, shares.Denom, acc, currentShares)
+	}
+
+	shareRecord.Shares = shareRecord.Shares.Add(shares)
+	k.SetVaultShareRecord(ctx, shareRecord)
+
+	if isNew {
+		k.AfterVaultDepositCreated(ctx, shares.Denom, acc, shares.Amount)
+	}
+}
@@ -32,6 +32,12 @@ func (k *Keeper) Withdraw(
 		return sdk.Coin{}, types.ErrInvalidVaultStrategy
 	}
 
+	// Charge performance fees on growth so far, so the withdrawn shares are
+	// valued at the share price after fees
+	if err := k.ChargePerformanceFee(ctx, wantAmount.Denom); err != nil {
+		return sdk.Coin{}, err
+	}
+
 	// Check if VaultRecord exists
 	vaultRecord, found := k.GetVaultRecord(ctx, wantAmount.Denom)
 	if !found {
@@ -83,17 +89,25 @@ func (k *Keeper) Withdraw(
 		)
 	}
 
-	// Get the strategy for the vault
-	strategy, err := k.GetStrategy(allowedVault.Strategies[0])
-	if err != nil {
-		return sdk.Coin{}, err
+	// Withdrawal fees are paid by giving part of the withdrawn shares to the
+	// fee recipient instead of burning them, the account receives the value of
+	// the rest.
+	feeShares := types.NewVaultShare(wantAmount.Denom, sdk.ZeroDec())
+	if allowedVault.FeeParams != nil && allowedVault.FeeParams.WithdrawalFee.IsPositive() {
+		feeShares.Amount = withdrawShares.Amount.Mul(allowedVault.FeeParams.WithdrawalFee)
+
+		withdrawAmount, err = k.ConvertToAssets(ctx, withdrawShares.Sub(feeShares))
+		if err != nil {
+			return sdk.Coin{}, fmt.Errorf("failed to convert shares to assets: %w", err)
+		}
 	}
 
-	// Not necessary to check if amount denom is allowed for the strategy, as
+	// Not necessary to check if amount denom is allowed for the strategies, as
 	// there would be no vault record if it weren't allowed.
 
-	// Withdraw the withdrawAmount from the strategy
-	if err := strategy.Withdraw(ctx, withdrawAmount); err != nil {
+	// Withdraw the withdrawAmount from the strategies in proportion to their
+	// current assets
+	if err := k.withdrawFromStrategies(ctx, allowedVault, withdrawAmount); err != nil {
 		return sdk.Coin{}, fmt.Errorf("failed to withdraw from strategy: %w", err)
 	}
 
@@ -127,17 +141,26 @@ func (k *Keeper) Withdraw(
 	}
 
 	// Call hook before record is modified with the user's current shares
-	k.BeforeVaultDepositModified(ctx, wantAmount.Denom, from, accCurrentShares)
+	k.BeforeVaultDepositModified(ctx, wantAmount.Denom, from, k.GetVaultAccountRewardShares(ctx, from, wantAmount.Denom))
 
 	// Decrement VaultRecord and VaultShareRecord supplies - must delete same
 	// amounts
 	vaultShareRecord.Shares = vaultShareRecord.Shares.Sub(withdrawShares)
-	vaultRecord.TotalShares = vaultRecord.TotalShares.Sub(withdrawShares)
+	vaultRecord.TotalShares = vaultRecord.TotalShares.Sub(withdrawShares).Add(feeShares)
 
 	// Update VaultRecord and VaultShareRecord, deletes if zero supply
 	k.UpdateVaultRecord(ctx, vaultRecord)
 	k.UpdateVaultShareRecord(ctx, vaultShareRecord)
 
+	if feeShares.IsPositive() {
+		k.addFeeShares(ctx, allowedVault.FeeParams.FeeRecipient, feeShares, types.AttributeValueWithdrawalFee)
+		k.recordWithdrawalFee(ctx, feeShares)
+	}
+
+	if err := k.updateVaultAllocations(ctx, wantAmount.Denom); err != nil {
+		return sdk.Coin{}, err
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeVaultWithdraw,
@@ -96,7 +96,7 @@ func (suite *Suite) SetupTest() {
 				),
 				"usdx:usd",
 				sdkmath.NewInt(1000000),
-				hardtypes.NewInterestRateModel(
+				hardtypes.NewJumpRateModel(
 					sdk.MustNewDecFromStr("0.05"),
 					sdk.MustNewDecFromStr("2"),
 					sdk.MustNewDecFromStr("0.8"),
@@ -114,7 +114,7 @@ func (suite *Suite) SetupTest() {
 				),
 				"busd:usd",
 				sdkmath.NewInt(1000000),
-				hardtypes.NewInterestRateModel(
+				hardtypes.NewJumpRateModel(
 					sdk.MustNewDecFromStr("0.05"),
 					sdk.MustNewDecFromStr("2"),
 					sdk.MustNewDecFromStr("0.8"),
@@ -132,7 +132,7 @@ func (suite *Suite) SetupTest() {
 				),
 				"fury:usd",
 				sdkmath.NewInt(1000000),
-				hardtypes.NewInterestRateModel(
+				hardtypes.NewJumpRateModel(
 					sdk.MustNewDecFromStr("0.05"),
 					sdk.MustNewDecFromStr("2"),
 					sdk.MustNewDecFromStr("0.8"),
@@ -143,6 +143,8 @@ func (suite *Suite) SetupTest() {
 			),
 		},
 		sdk.NewDec(10),
+		hardtypes.DefaultCheckLtvIndexCount,
+		hardtypes.DefaultEModeCategories,
 	),
 		hardtypes.DefaultAccumulationTimes,
 		hardtypes.DefaultDeposits,
@@ -162,8 +164,10 @@ func (suite *Suite) SetupTest() {
 				TestBfuryDenoms[1],
 				TestBfuryDenoms[2],
 			},
+			nil,
 		),
 		nil,
+		nil,
 	)
 
 	stakingParams := stakingtypes.DefaultParams()
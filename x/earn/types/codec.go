@@ -15,6 +15,8 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDeposit{}, "earn/MsgDeposit", nil)
 	cdc.RegisterConcrete(&MsgWithdraw{}, "earn/MsgWithdraw", nil)
+	cdc.RegisterConcrete(&MsgTokenizeShares{}, "earn/MsgTokenizeShares", nil)
+	cdc.RegisterConcrete(&MsgRedeemTokens{}, "earn/MsgRedeemTokens", nil)
 	cdc.RegisterConcrete(&CommunityPoolDepositProposal{}, "fury/CommunityPoolDepositProposal", nil)
 	cdc.RegisterConcrete(&CommunityPoolWithdrawProposal{}, "fury/CommunityPoolWithdrawProposal", nil)
 }
@@ -25,6 +27,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgDeposit{},
 		&MsgWithdraw{},
+		&MsgTokenizeShares{},
+		&MsgRedeemTokens{},
 	)
 	registry.RegisterImplementations((*govv1beta1.Content)(nil),
 		&CommunityPoolDepositProposal{},
@@ -11,4 +11,5 @@ var (
 	ErrVaultRecordNotFound      = errorsmod.Register(ModuleName, 6, "vault record not found")
 	ErrVaultShareRecordNotFound = errorsmod.Register(ModuleName, 7, "vault share record not found")
 	ErrAccountDepositNotAllowed = errorsmod.Register(ModuleName, 8, "account is not allowed to deposit to this vault")
+	ErrInvalidShareTokenDenom   = errorsmod.Register(ModuleName, 9, "invalid share token denom")
 )
@@ -2,11 +2,22 @@ package types
 
 // Event types for earn module
 const (
-	AttributeValueCategory = ModuleName
-	EventTypeVaultDeposit  = "vault_deposit"
-	EventTypeVaultWithdraw = "vault_withdraw"
-	AttributeKeyVaultDenom = "vault_denom"
-	AttributeKeyDepositor  = "depositor"
-	AttributeKeyShares     = "shares"
-	AttributeKeyOwner      = "owner"
+	AttributeValueCategory  = ModuleName
+	EventTypeVaultDeposit   = "vault_deposit"
+	EventTypeVaultWithdraw  = "vault_withdraw"
+	EventTypeVaultRebalance = "vault_rebalance"
+	EventTypeVaultCompound  = "vault_compound"
+	EventTypeVaultFee       = "vault_fee"
+	EventTypeTokenizeShares = "tokenize_shares"
+	EventTypeRedeemTokens   = "redeem_tokens"
+	AttributeKeyVaultDenom  = "vault_denom"
+	AttributeKeyDepositor   = "depositor"
+	AttributeKeyShares      = "shares"
+	AttributeKeyOwner       = "owner"
+	AttributeKeyRewards     = "rewards"
+	AttributeKeyFeeType     = "fee_type"
+	AttributeKeyRecipient   = "recipient"
+
+	AttributeValuePerformanceFee = "performance"
+	AttributeValueWithdrawalFee  = "withdrawal"
 )
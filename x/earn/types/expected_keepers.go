@@ -1,12 +1,16 @@
 package types
 
 import (
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
+	cdptypes "github.com/incubus-network/fury/x/cdp/types"
 	hardtypes "github.com/incubus-network/fury/x/hard/types"
+	pricefeedtypes "github.com/incubus-network/fury/x/pricefeed/types"
 	savingstypes "github.com/incubus-network/fury/x/savings/types"
+	swaptypes "github.com/incubus-network/fury/x/swap/types"
 )
 
 // AccountKeeper defines the expected account keeper
@@ -20,10 +24,15 @@ type AccountKeeper interface {
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+
+	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 }
 
 // DistributionKeeper defines the expected interface needed for community-pool deposits to earn vaults
@@ -56,6 +65,44 @@ type SavingsKeeper interface {
 	GetDeposit(ctx sdk.Context, depositor sdk.AccAddress) (savingstypes.Deposit, bool)
 }
 
+// SwapKeeper defines the expected interface needed for the swap LP strategy.
+type SwapKeeper interface {
+	Deposit(ctx sdk.Context, depositor sdk.AccAddress, coinA sdk.Coin, coinB sdk.Coin, slippageLimit sdk.Dec) error
+	Withdraw(ctx sdk.Context, owner sdk.AccAddress, shares sdkmath.Int, minCoinA, minCoinB sdk.Coin) error
+	SwapExactForTokens(ctx sdk.Context, requester sdk.AccAddress, exactCoinA, coinB sdk.Coin, slippageLimit sdk.Dec) error
+	SwapForExactTokens(ctx sdk.Context, requester sdk.AccAddress, coinA, exactCoinB sdk.Coin, slippageLimit sdk.Dec) error
+
+	GetPool(ctx sdk.Context, poolID string) (swaptypes.PoolRecord, bool)
+	GetDepositorSharesAmount(ctx sdk.Context, depositor sdk.AccAddress, poolID string) (sdkmath.Int, bool)
+	GetSwapFee(ctx sdk.Context) sdk.Dec
+}
+
+// CdpKeeper defines the expected interface needed for the CDP savings strategy.
+type CdpKeeper interface {
+	AddCdp(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coin, principal sdk.Coin, collateralType string) error
+	DepositCollateral(ctx sdk.Context, owner, depositor sdk.AccAddress, collateral sdk.Coin, collateralType string) error
+	WithdrawCollateral(ctx sdk.Context, owner, depositor sdk.AccAddress, collateral sdk.Coin, collateralType string) error
+	AddPrincipal(ctx sdk.Context, owner sdk.AccAddress, collateralType string, principal sdk.Coin) error
+	RepayPrincipal(ctx sdk.Context, owner sdk.AccAddress, collateralType string, payment sdk.Coin) error
+
+	GetParams(ctx sdk.Context) cdptypes.Params
+	GetCollateral(ctx sdk.Context, collateralType string) (cdptypes.CollateralParam, bool)
+	GetCdpByOwnerAndCollateralType(ctx sdk.Context, owner sdk.AccAddress, collateralType string) (cdptypes.CDP, bool)
+	CalculateNewInterest(ctx sdk.Context, cdp cdptypes.CDP) sdk.Coin
+}
+
+// PricefeedKeeper defines the expected interface needed to value CDP collateral.
+type PricefeedKeeper interface {
+	GetCurrentPrice(ctx sdk.Context, marketID string) (pricefeedtypes.CurrentPrice, error)
+}
+
+// IncentiveKeeper defines the expected interface needed to claim the incentive
+// rewards of vault deposits for auto-compounding.
+type IncentiveKeeper interface {
+	ClaimHardSupplyRewardForDenom(ctx sdk.Context, owner sdk.AccAddress, receiverModule string, denom string) (sdk.Coins, error)
+	ClaimSavingsRewardForDenom(ctx sdk.Context, owner sdk.AccAddress, receiverModule string, denom string) (sdk.Coins, error)
+}
+
 // EarnHooks are event hooks called when a user's deposit to a earn vault changes.
 type EarnHooks interface {
 	AfterVaultDepositCreated(ctx sdk.Context, vaultDenom string, depositor sdk.AccAddress, sharesOwned sdk.Dec)
@@ -5,11 +5,21 @@ func NewGenesisState(
 	params Params,
 	vaultRecords VaultRecords,
 	vaultShareRecords VaultShareRecords,
+	cdpSavingsDeposits CdpSavingsDeposits,
+	vaultRewardsRecords VaultRewardsRecords,
+	vaultFeeRecords VaultFeeRecords,
+	vaultSharePriceSnapshots VaultSharePriceSnapshots,
+	swapLPResiduals SwapLPResiduals,
 ) GenesisState {
 	return GenesisState{
-		Params:            params,
-		VaultRecords:      vaultRecords,
-		VaultShareRecords: vaultShareRecords,
+		Params:                   params,
+		VaultRecords:             vaultRecords,
+		VaultShareRecords:        vaultShareRecords,
+		CdpSavingsDeposits:       cdpSavingsDeposits,
+		VaultRewardsRecords:      vaultRewardsRecords,
+		VaultFeeRecords:          vaultFeeRecords,
+		VaultSharePriceSnapshots: vaultSharePriceSnapshots,
+		SwapLPResiduals:          swapLPResiduals,
 	}
 }
 
@@ -27,6 +37,26 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := gs.CdpSavingsDeposits.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.VaultRewardsRecords.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.VaultFeeRecords.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.VaultSharePriceSnapshots.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.SwapLPResiduals.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -36,5 +66,10 @@ func DefaultGenesisState() GenesisState {
 		DefaultParams(),
 		VaultRecords{},
 		VaultShareRecords{},
+		CdpSavingsDeposits{},
+		VaultRewardsRecords{},
+		VaultFeeRecords{},
+		VaultSharePriceSnapshots{},
+		SwapLPResiduals{},
 	)
 }
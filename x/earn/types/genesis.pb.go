@@ -31,13 +31,28 @@ type GenesisState struct {
 	VaultRecords VaultRecords `protobuf:"bytes,2,rep,name=vault_records,json=vaultRecords,proto3,castrepeated=VaultRecords" json:"vault_records"`
 	// share_records defines the owned shares of each vault
 	VaultShareRecords VaultShareRecords `protobuf:"bytes,3,rep,name=vault_share_records,json=vaultShareRecords,proto3,castrepeated=VaultShareRecords" json:"vault_share_records"`
+	// cdp_savings_deposits defines the savings deposits of vaults using the cdp
+	// savings strategy
+	CdpSavingsDeposits CdpSavingsDeposits `protobuf:"bytes,4,rep,name=cdp_savings_deposits,json=cdpSavingsDeposits,proto3,castrepeated=CdpSavingsDeposits" json:"cdp_savings_deposits"`
+	// vault_rewards_records defines the rewards of auto-compounding vaults that
+	// are waiting to be compounded
+	VaultRewardsRecords VaultRewardsRecords `protobuf:"bytes,5,rep,name=vault_rewards_records,json=vaultRewardsRecords,proto3,castrepeated=VaultRewardsRecords" json:"vault_rewards_records"`
+	// vault_fee_records defines the high-water marks and accrued fees of vaults
+	// that charge fees
+	VaultFeeRecords VaultFeeRecords `protobuf:"bytes,6,rep,name=vault_fee_records,json=vaultFeeRecords,proto3,castrepeated=VaultFeeRecords" json:"vault_fee_records"`
+	// vault_share_price_snapshots defines the share price history of vaults
+	// that record snapshots
+	VaultSharePriceSnapshots VaultSharePriceSnapshots `protobuf:"bytes,7,rep,name=vault_share_price_snapshots,json=vaultSharePriceSnapshots,proto3,castrepeated=VaultSharePriceSnapshots" json:"vault_share_price_snapshots"`
+	// swap_lp_residuals defines the amounts held in the module account by vaults
+	// using the swap LP strategy
+	SwapLPResiduals SwapLPResiduals `protobuf:"bytes,8,rep,name=swap_lp_residuals,json=swapLpResiduals,proto3,castrepeated=SwapLPResiduals" json:"swap_lp_residuals"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_514fe130cb964f8c, []int{0}
+	return fileDescriptor_89ed6600a93a244a, []int{0}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -87,33 +102,81 @@ func (m *GenesisState) GetVaultShareRecords() VaultShareRecords {
 	return nil
 }
 
+func (m *GenesisState) GetCdpSavingsDeposits() CdpSavingsDeposits {
+	if m != nil {
+		return m.CdpSavingsDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVaultRewardsRecords() VaultRewardsRecords {
+	if m != nil {
+		return m.VaultRewardsRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVaultFeeRecords() VaultFeeRecords {
+	if m != nil {
+		return m.VaultFeeRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVaultSharePriceSnapshots() VaultSharePriceSnapshots {
+	if m != nil {
+		return m.VaultSharePriceSnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSwapLPResiduals() SwapLPResiduals {
+	if m != nil {
+		return m.SwapLPResiduals
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "fury.earn.v1beta1.GenesisState")
 }
 
-func init() { proto.RegisterFile("fury/earn/v1beta1/genesis.proto", fileDescriptor_514fe130cb964f8c) }
+func init() { proto.RegisterFile("fury/earn/v1beta1/genesis.proto", fileDescriptor_89ed6600a93a244a) }
 
-var fileDescriptor_514fe130cb964f8c = []byte{
-	// 294 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x4e, 0x2c, 0x4b,
-	0xd4, 0x4f, 0x4d, 0x2c, 0xca, 0xd3, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f,
-	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0x29, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xea, 0x83, 0x58,
-	0x10, 0x85, 0x52, 0x72, 0x98, 0x26, 0x15, 0x24, 0x16, 0x25, 0xe6, 0x42, 0x0d, 0x92, 0x92, 0xc5,
-	0x94, 0x2f, 0x4b, 0x2c, 0xcd, 0x29, 0x81, 0x48, 0x2b, 0x4d, 0x62, 0xe2, 0xe2, 0x71, 0x87, 0xd8,
-	0x1c, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xce, 0xc5, 0x06, 0xd1, 0x2f, 0xc1, 0xa8, 0xc0, 0xa8,
-	0xc1, 0x6d, 0x24, 0xa9, 0x87, 0xe1, 0x12, 0xbd, 0x00, 0xb0, 0x02, 0x27, 0x96, 0x13, 0xf7, 0xe4,
-	0x19, 0x82, 0xa0, 0xca, 0x85, 0x22, 0xb9, 0x78, 0xc1, 0x06, 0xc7, 0x17, 0xa5, 0x26, 0xe7, 0x17,
-	0xa5, 0x14, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0xc9, 0x61, 0xd1, 0x1f, 0x06, 0x52, 0x17,
-	0x04, 0x56, 0xe6, 0x24, 0x02, 0x32, 0x64, 0xd5, 0x7d, 0x79, 0x1e, 0x24, 0xc1, 0xe2, 0x20, 0x9e,
-	0x32, 0x24, 0x9e, 0x50, 0x1e, 0x97, 0x30, 0xc4, 0xe8, 0xe2, 0x8c, 0xc4, 0xa2, 0x54, 0xb8, 0x05,
-	0xcc, 0x60, 0x0b, 0x94, 0x71, 0x59, 0x10, 0x0c, 0x52, 0x0c, 0xb5, 0x45, 0x12, 0x6a, 0x8b, 0x20,
-	0xba, 0x4c, 0x71, 0x90, 0x60, 0x19, 0xba, 0x90, 0x93, 0xc3, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e,
-	0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37,
-	0x1e, 0xcb, 0x31, 0x44, 0xa9, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea,
-	0x83, 0xac, 0xd5, 0xcd, 0x49, 0x4c, 0x2a, 0x06, 0xb3, 0xf4, 0x2b, 0x20, 0x81, 0x5c, 0x52, 0x59,
-	0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x5d, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8d, 0x54,
-	0x3b, 0x56, 0xe8, 0x01, 0x00, 0x00,
+var fileDescriptor_89ed6600a93a244a = []byte{
+	// 503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x18, 0xc7, 0x9b, 0xdf, 0xf6, 0x2b, 0xc8, 0x2b, 0xaa, 0xea, 0x16, 0x91, 0x75, 0x22, 0x2d, 0xff,
+	0xa4, 0x69, 0x82, 0x44, 0x1b, 0x07, 0xee, 0x01, 0x8d, 0xcb, 0x0e, 0x95, 0x23, 0x21, 0xc1, 0x25,
+	0x72, 0x13, 0x37, 0x8d, 0xe8, 0x12, 0xcb, 0x8f, 0x93, 0xd2, 0xd7, 0xc0, 0x85, 0xd7, 0xb1, 0x57,
+	0xb2, 0xe3, 0x8e, 0x9c, 0x36, 0xd4, 0xbe, 0x00, 0xde, 0x02, 0x8a, 0xe3, 0x95, 0x76, 0x59, 0xb8,
+	0x39, 0xdf, 0xe7, 0xf3, 0xf8, 0x13, 0x3f, 0x96, 0xd1, 0x60, 0x92, 0x89, 0x85, 0xc3, 0xa8, 0x48,
+	0x9c, 0xfc, 0x78, 0xcc, 0x24, 0x3d, 0x76, 0x22, 0x96, 0x30, 0x88, 0xc1, 0xe6, 0x22, 0x95, 0x29,
+	0xee, 0x14, 0x80, 0x5d, 0x00, 0xb6, 0x06, 0xfa, 0x56, 0xb5, 0x87, 0x53, 0x41, 0xcf, 0x75, 0x4b,
+	0xff, 0x69, 0xb5, 0x9e, 0xd3, 0x6c, 0x26, 0x75, 0xb9, 0x17, 0xa5, 0x51, 0xaa, 0x96, 0x4e, 0xb1,
+	0x2a, 0xd3, 0xe7, 0xbf, 0x9b, 0xa8, 0xf5, 0xb1, 0x34, 0x7b, 0x92, 0x4a, 0x86, 0xdf, 0xa1, 0x66,
+	0xb9, 0xab, 0x69, 0x0c, 0x8d, 0xc3, 0xbd, 0x93, 0x7d, 0xbb, 0xf2, 0x27, 0xf6, 0x48, 0x01, 0xee,
+	0xee, 0xe5, 0xf5, 0xa0, 0x41, 0x34, 0x8e, 0x3f, 0xa3, 0x47, 0x4a, 0xe7, 0x0b, 0x16, 0xa4, 0x22,
+	0x04, 0xf3, 0xbf, 0xe1, 0xce, 0xe1, 0xde, 0x89, 0x75, 0x4f, 0xff, 0xa7, 0x82, 0x23, 0x0a, 0x73,
+	0x7b, 0xc5, 0x26, 0x17, 0x37, 0x83, 0xd6, 0x46, 0x08, 0xa4, 0x95, 0x6f, 0x7c, 0xe1, 0x04, 0x75,
+	0xcb, 0xad, 0x61, 0x4a, 0x05, 0x5b, 0x0b, 0x76, 0x94, 0xe0, 0x45, 0x9d, 0xc0, 0x2b, 0x60, 0x6d,
+	0xd9, 0xd7, 0x96, 0xce, 0xdd, 0x0a, 0x90, 0x4e, 0x7e, 0x37, 0xc2, 0x02, 0xf5, 0x82, 0x90, 0xfb,
+	0x40, 0xf3, 0x38, 0x89, 0xc0, 0x0f, 0x19, 0x4f, 0x21, 0x96, 0x60, 0xee, 0x2a, 0xe1, 0xcb, 0x7b,
+	0x84, 0xef, 0x43, 0xee, 0x95, 0xf4, 0x87, 0x12, 0x76, 0xfb, 0xda, 0x88, 0x2b, 0x25, 0x20, 0x38,
+	0xa8, 0x64, 0x38, 0x47, 0x8f, 0x6f, 0xc7, 0x37, 0xa7, 0x22, 0x84, 0xf5, 0x29, 0xff, 0x57, 0xd2,
+	0x57, 0xf5, 0x63, 0x54, 0xb8, 0x3e, 0xe7, 0x81, 0xb6, 0x76, 0xab, 0x35, 0x20, 0xdd, 0xbc, 0x1a,
+	0xe2, 0x09, 0x2a, 0x07, 0xe0, 0x4f, 0xd8, 0xdf, 0xc9, 0x36, 0x95, 0xf3, 0x59, 0x9d, 0xf3, 0x94,
+	0xdd, 0xce, 0xf5, 0x89, 0xf6, 0xb5, 0xb7, 0x73, 0x20, 0xed, 0x7c, 0x3b, 0xc0, 0xdf, 0x0d, 0x74,
+	0xb0, 0x79, 0x89, 0x5c, 0xc4, 0x01, 0xf3, 0x21, 0xa1, 0x1c, 0xa6, 0xa9, 0x04, 0xf3, 0x81, 0x52,
+	0x1e, 0xfd, 0xf3, 0x32, 0x47, 0x45, 0x8f, 0xa7, 0x5b, 0xdc, 0xa1, 0x76, 0x9b, 0x35, 0x00, 0x10,
+	0x33, 0xaf, 0xa9, 0xe0, 0x05, 0xea, 0xc0, 0x9c, 0x72, 0x7f, 0xc6, 0x7d, 0xc1, 0x20, 0x0e, 0x33,
+	0x3a, 0x03, 0xf3, 0x61, 0xed, 0xa9, 0xbd, 0x39, 0xe5, 0x67, 0x23, 0xa2, 0x49, 0xf7, 0xa8, 0x30,
+	0x2f, 0xaf, 0x07, 0xed, 0xed, 0x1c, 0x2e, 0x6e, 0x2a, 0x11, 0x69, 0x17, 0x9e, 0x33, 0xbe, 0x0e,
+	0xdc, 0xd3, 0xcb, 0xa5, 0x65, 0x5c, 0x2d, 0x2d, 0xe3, 0xd7, 0xd2, 0x32, 0x7e, 0xac, 0xac, 0xc6,
+	0xd5, 0xca, 0x6a, 0xfc, 0x5c, 0x59, 0x8d, 0x2f, 0xaf, 0xa3, 0x58, 0x4e, 0xb3, 0xb1, 0x1d, 0xa4,
+	0xe7, 0x4e, 0x9c, 0x04, 0xd9, 0x38, 0x83, 0x37, 0x09, 0x93, 0xf3, 0x54, 0x7c, 0x75, 0xd4, 0xdb,
+	0xfe, 0x56, 0xbe, 0x6e, 0xb9, 0xe0, 0x0c, 0xc6, 0x4d, 0xf5, 0x80, 0xdf, 0xfe, 0x19, 0x00, 0x96,
+	0x27, 0xb1, 0xf3, 0x4b, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -136,6 +199,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SwapLPResiduals) > 0 {
+		for iNdEx := len(m.SwapLPResiduals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapLPResiduals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.VaultSharePriceSnapshots) > 0 {
+		for iNdEx := len(m.VaultSharePriceSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VaultSharePriceSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.VaultFeeRecords) > 0 {
+		for iNdEx := len(m.VaultFeeRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VaultFeeRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.VaultRewardsRecords) > 0 {
+		for iNdEx := len(m.VaultRewardsRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VaultRewardsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CdpSavingsDeposits) > 0 {
+		for iNdEx := len(m.CdpSavingsDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CdpSavingsDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.VaultShareRecords) > 0 {
 		for iNdEx := len(m.VaultShareRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -208,6 +341,36 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.CdpSavingsDeposits) > 0 {
+		for _, e := range m.CdpSavingsDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VaultRewardsRecords) > 0 {
+		for _, e := range m.VaultRewardsRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VaultFeeRecords) > 0 {
+		for _, e := range m.VaultFeeRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VaultSharePriceSnapshots) > 0 {
+		for _, e := range m.VaultSharePriceSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SwapLPResiduals) > 0 {
+		for _, e := range m.SwapLPResiduals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -347,6 +510,176 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpSavingsDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CdpSavingsDeposits = append(m.CdpSavingsDeposits, CdpSavingsDeposit{})
+			if err := m.CdpSavingsDeposits[len(m.CdpSavingsDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultRewardsRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultRewardsRecords = append(m.VaultRewardsRecords, VaultRewardsRecord{})
+			if err := m.VaultRewardsRecords[len(m.VaultRewardsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultFeeRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultFeeRecords = append(m.VaultFeeRecords, VaultFeeRecord{})
+			if err := m.VaultFeeRecords[len(m.VaultFeeRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultSharePriceSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultSharePriceSnapshots = append(m.VaultSharePriceSnapshots, VaultSharePriceSnapshot{})
+			if err := m.VaultSharePriceSnapshots[len(m.VaultSharePriceSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapLPResiduals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapLPResiduals = append(m.SwapLPResiduals, SwapLPResidual{})
+			if err := m.SwapLPResiduals[len(m.SwapLPResiduals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
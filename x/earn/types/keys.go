@@ -1,6 +1,11 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	// ModuleName name that will be used throughout the module
@@ -20,12 +25,20 @@ const (
 
 	// DefaultParamspace default name for parameter store
 	DefaultParamspace = ModuleName
+
+	// ShareTokenPrefix is the prefix of the denoms of tokenized vault shares
+	ShareTokenPrefix = "erc/vault/"
 )
 
 // key prefixes for store
 var (
-	VaultRecordKeyPrefix      = []byte{0x01} // denom -> vault
-	VaultShareRecordKeyPrefix = []byte{0x02} // depositor address -> vault shares
+	VaultRecordKeyPrefix       = []byte{0x01} // denom -> vault
+	VaultShareRecordKeyPrefix  = []byte{0x02} // depositor address -> vault shares
+	CdpSavingsDepositKeyPrefix = []byte{0x03} // vault denom -> cdp savings deposit
+	VaultRewardsKeyPrefix      = []byte{0x04} // vault denom -> rewards to compound
+	VaultFeeRecordKeyPrefix    = []byte{0x05} // vault denom -> vault fee record
+	SharePriceSnapshotPrefix   = []byte{0x06} // vault denom + time -> share price snapshot
+	SwapLPResidualKeyPrefix    = []byte{0x07} // vault denom -> swap lp residual
 )
 
 // VaultKey returns a key generated from a vault denom
@@ -37,3 +50,16 @@ func VaultKey(denom string) []byte {
 func DepositorVaultSharesKey(depositor sdk.AccAddress) []byte {
 	return depositor.Bytes()
 }
+
+// VaultSnapshotsKey returns the key prefix of the share price snapshots of a
+// vault. The denom is length prefixed so denoms that are prefixes of others do
+// not share snapshots.
+func VaultSnapshotsKey(denom string) []byte {
+	return address.MustLengthPrefix([]byte(denom))
+}
+
+// SharePriceSnapshotKey returns the key of the share price snapshot of a vault
+// at a time. Keys of the same vault sort by time.
+func SharePriceSnapshotKey(denom string, t time.Time) []byte {
+	return append(VaultSnapshotsKey(denom), sdk.FormatTimeBytes(t)...)
+}
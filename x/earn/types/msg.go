@@ -10,14 +10,20 @@ import (
 var (
 	_ sdk.Msg            = &MsgDeposit{}
 	_ sdk.Msg            = &MsgWithdraw{}
+	_ sdk.Msg            = &MsgTokenizeShares{}
+	_ sdk.Msg            = &MsgRedeemTokens{}
 	_ legacytx.LegacyMsg = &MsgDeposit{}
 	_ legacytx.LegacyMsg = &MsgWithdraw{}
+	_ legacytx.LegacyMsg = &MsgTokenizeShares{}
+	_ legacytx.LegacyMsg = &MsgRedeemTokens{}
 )
 
 // legacy message types
 const (
-	TypeMsgDeposit  = "earn_msg_deposit"
-	TypeMsgWithdraw = "earn_msg_withdraw"
+	TypeMsgDeposit        = "earn_msg_deposit"
+	TypeMsgWithdraw       = "earn_msg_withdraw"
+	TypeMsgTokenizeShares = "earn_msg_tokenize_shares"
+	TypeMsgRedeemTokens   = "earn_msg_redeem_tokens"
 )
 
 // NewMsgDeposit returns a new MsgDeposit.
@@ -123,3 +129,113 @@ func (msg MsgWithdraw) Route() string {
 func (msg MsgWithdraw) Type() string {
 	return TypeMsgWithdraw
 }
+
+// NewMsgTokenizeShares returns a new MsgTokenizeShares.
+func NewMsgTokenizeShares(depositor string, amount sdk.Coin) *MsgTokenizeShares {
+	return &MsgTokenizeShares{
+		Depositor: depositor,
+		Amount:    amount,
+	}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgTokenizeShares) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := msg.Amount.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	if !msg.Amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+
+	if _, err := ParseShareTokenDenom(msg.Amount.Denom); err == nil {
+		return errorsmod.Wrapf(ErrInvalidVaultDenom, "cannot tokenize shares of %s", msg.Amount.Denom)
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgTokenizeShares) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgTokenizeShares) GetSigners() []sdk.AccAddress {
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{depositor}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgTokenizeShares) Route() string {
+	return RouterKey
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgTokenizeShares) Type() string {
+	return TypeMsgTokenizeShares
+}
+
+// NewMsgRedeemTokens returns a new MsgRedeemTokens.
+func NewMsgRedeemTokens(depositor string, amount sdk.Coin) *MsgRedeemTokens {
+	return &MsgRedeemTokens{
+		Depositor: depositor,
+		Amount:    amount,
+	}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRedeemTokens) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := msg.Amount.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	if !msg.Amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "amount must be positive")
+	}
+
+	if _, err := ParseShareTokenDenom(msg.Amount.Denom); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRedeemTokens) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRedeemTokens) GetSigners() []sdk.AccAddress {
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{depositor}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRedeemTokens) Route() string {
+	return RouterKey
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRedeemTokens) Type() string {
+	return TypeMsgRedeemTokens
+}
@@ -35,7 +35,7 @@ type CommunityPoolDepositProposal struct {
 func (m *CommunityPoolDepositProposal) Reset()      { *m = CommunityPoolDepositProposal{} }
 func (*CommunityPoolDepositProposal) ProtoMessage() {}
 func (*CommunityPoolDepositProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c374f1a8c57e13e2, []int{0}
+	return fileDescriptor_56a8fa80068e74a9, []int{0}
 }
 func (m *CommunityPoolDepositProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -76,7 +76,7 @@ func (m *CommunityPoolDepositProposalJSON) Reset()         { *m = CommunityPoolD
 func (m *CommunityPoolDepositProposalJSON) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolDepositProposalJSON) ProtoMessage()    {}
 func (*CommunityPoolDepositProposalJSON) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c374f1a8c57e13e2, []int{1}
+	return fileDescriptor_56a8fa80068e74a9, []int{1}
 }
 func (m *CommunityPoolDepositProposalJSON) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -115,7 +115,7 @@ type CommunityPoolWithdrawProposal struct {
 func (m *CommunityPoolWithdrawProposal) Reset()      { *m = CommunityPoolWithdrawProposal{} }
 func (*CommunityPoolWithdrawProposal) ProtoMessage() {}
 func (*CommunityPoolWithdrawProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c374f1a8c57e13e2, []int{2}
+	return fileDescriptor_56a8fa80068e74a9, []int{2}
 }
 func (m *CommunityPoolWithdrawProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -156,7 +156,7 @@ func (m *CommunityPoolWithdrawProposalJSON) Reset()         { *m = CommunityPool
 func (m *CommunityPoolWithdrawProposalJSON) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolWithdrawProposalJSON) ProtoMessage()    {}
 func (*CommunityPoolWithdrawProposalJSON) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c374f1a8c57e13e2, []int{3}
+	return fileDescriptor_56a8fa80068e74a9, []int{3}
 }
 func (m *CommunityPoolWithdrawProposalJSON) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -192,34 +192,34 @@ func init() {
 	proto.RegisterType((*CommunityPoolWithdrawProposalJSON)(nil), "fury.earn.v1beta1.CommunityPoolWithdrawProposalJSON")
 }
 
-func init() { proto.RegisterFile("fury/earn/v1beta1/proposal.proto", fileDescriptor_c374f1a8c57e13e2) }
+func init() { proto.RegisterFile("fury/earn/v1beta1/proposal.proto", fileDescriptor_56a8fa80068e74a9) }
 
-var fileDescriptor_c374f1a8c57e13e2 = []byte{
-	// 371 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc8, 0x4e, 0x2c, 0x4b,
-	0xd4, 0x4f, 0x4d, 0x2c, 0xca, 0xd3, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x28,
-	0xca, 0x2f, 0xc8, 0x2f, 0x4e, 0xcc, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0xa9,
-	0xd0, 0x03, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x92, 0x4b, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0xd6, 0x4f,
-	0x4a, 0x2c, 0x4e, 0x85, 0x6b, 0x4b, 0xce, 0xcf, 0xcc, 0x83, 0x68, 0x91, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0x88, 0xa8, 0xd2, 0x4c, 0x46, 0x2e, 0x19, 0xe7, 0xfc, 0xdc,
-	0xdc, 0xd2, 0xbc, 0xcc, 0x92, 0xca, 0x80, 0xfc, 0xfc, 0x1c, 0x97, 0xd4, 0x82, 0xfc, 0xe2, 0xcc,
-	0x92, 0x00, 0xa8, 0x7d, 0x42, 0x22, 0x5c, 0xac, 0x25, 0x99, 0x25, 0x39, 0xa9, 0x12, 0x8c, 0x0a,
-	0x8c, 0x1a, 0x9c, 0x41, 0x10, 0x8e, 0x90, 0x02, 0x17, 0x77, 0x4a, 0x6a, 0x71, 0x72, 0x51, 0x66,
-	0x41, 0x49, 0x66, 0x7e, 0x9e, 0x04, 0x13, 0x58, 0x0e, 0x59, 0x48, 0xc8, 0x9c, 0x8b, 0x2d, 0x31,
-	0x37, 0xbf, 0x34, 0xaf, 0x44, 0x82, 0x59, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x52, 0x0f, 0xe2, 0x3e,
-	0x3d, 0x90, 0xfb, 0x60, 0x8e, 0xd6, 0x73, 0xce, 0xcf, 0xcc, 0x73, 0x62, 0x39, 0x71, 0x4f, 0x9e,
-	0x21, 0x08, 0xaa, 0xdc, 0x8a, 0xa3, 0x63, 0x81, 0x3c, 0xc3, 0x8c, 0x05, 0xf2, 0x0c, 0x4a, 0x2d,
-	0x4c, 0x5c, 0x0a, 0xf8, 0xdc, 0xe6, 0x15, 0xec, 0xef, 0x47, 0x77, 0xf7, 0x09, 0xa5, 0x72, 0xb1,
-	0xa7, 0x40, 0xdc, 0x21, 0xc1, 0xa2, 0xc0, 0x8c, 0x5f, 0xa7, 0x01, 0x48, 0xe7, 0xaa, 0xfb, 0xf2,
-	0x1a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xd0, 0x68, 0x82, 0x50,
-	0xba, 0xc5, 0x29, 0xd9, 0xfa, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x60, 0x0d, 0xc5, 0x41, 0x30, 0xb3,
-	0xe1, 0xc1, 0xc0, 0xa8, 0x34, 0x8b, 0x91, 0x4b, 0x16, 0x25, 0x18, 0xc2, 0x33, 0x4b, 0x32, 0x52,
-	0x8a, 0x12, 0xcb, 0x07, 0x43, 0x1c, 0xb5, 0x32, 0x71, 0x29, 0xe2, 0x75, 0xdc, 0xc8, 0x88, 0x24,
-	0x27, 0x87, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2,
-	0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x43, 0x32, 0x16,
-	0x94, 0x6b, 0x75, 0x73, 0x12, 0x93, 0x8a, 0xc1, 0x2c, 0xfd, 0x0a, 0x48, 0x1e, 0x07, 0x1b, 0x9d,
-	0xc4, 0x06, 0xce, 0x90, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x21, 0xfc, 0xed, 0xa9, 0xfd,
-	0x03, 0x00, 0x00,
+var fileDescriptor_56a8fa80068e74a9 = []byte{
+	// 375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x93, 0xbf, 0x4f, 0x2a, 0x41,
+	0x10, 0xc7, 0x6f, 0x81, 0xc7, 0x7b, 0x6f, 0xa9, 0xde, 0x85, 0xe2, 0x1e, 0xd1, 0xbb, 0x93, 0x8a,
+	0x42, 0x6e, 0x45, 0x0b, 0x13, 0x4b, 0x30, 0x16, 0x16, 0x4a, 0xb0, 0x30, 0xb1, 0xbb, 0x1f, 0x2b,
+	0x6c, 0xe0, 0x76, 0x2e, 0xb7, 0x7b, 0x22, 0xbd, 0x26, 0x96, 0x96, 0x6a, 0x45, 0xed, 0x5f, 0x42,
+	0x49, 0x69, 0xa5, 0x06, 0xfe, 0x11, 0x73, 0x3f, 0x20, 0xd8, 0x50, 0x1a, 0x13, 0xab, 0x9b, 0x9b,
+	0xfd, 0x7e, 0x67, 0x3e, 0x99, 0xc9, 0x60, 0xf3, 0x32, 0x0a, 0x47, 0x84, 0xda, 0x21, 0x27, 0x57,
+	0x0d, 0x87, 0x4a, 0xbb, 0x41, 0x82, 0x10, 0x02, 0x10, 0xf6, 0xc0, 0x0a, 0x42, 0x90, 0xa0, 0xfe,
+	0x8b, 0x15, 0x56, 0xac, 0xb0, 0x32, 0x45, 0x45, 0x77, 0x41, 0xf8, 0x20, 0x88, 0x63, 0x0b, 0xba,
+	0xb4, 0xb9, 0xc0, 0x78, 0x6a, 0xa9, 0x94, 0xbb, 0xd0, 0x85, 0x24, 0x24, 0x71, 0x94, 0x66, 0xab,
+	0x8f, 0x08, 0x6f, 0xb4, 0xc0, 0xf7, 0x23, 0xce, 0xe4, 0xa8, 0x0d, 0x30, 0x38, 0xa4, 0x01, 0x08,
+	0x26, 0xdb, 0x59, 0x3f, 0xb5, 0x8c, 0x7f, 0x49, 0x26, 0x07, 0x54, 0x43, 0x26, 0xaa, 0xfd, 0xed,
+	0xa4, 0x3f, 0xaa, 0x89, 0x4b, 0x1e, 0x15, 0x6e, 0xc8, 0x02, 0xc9, 0x80, 0x6b, 0xb9, 0xe4, 0x6d,
+	0x35, 0xa5, 0xee, 0xe3, 0xa2, 0xed, 0x43, 0xc4, 0xa5, 0x96, 0x37, 0x51, 0xad, 0xb4, 0xfb, 0xdf,
+	0x4a, 0xf9, 0xac, 0x98, 0x6f, 0x01, 0x6d, 0xb5, 0x80, 0xf1, 0x66, 0x61, 0xf2, 0x6a, 0x28, 0x9d,
+	0x4c, 0x7e, 0xf0, 0xe7, 0x6e, 0x6c, 0x28, 0x0f, 0x63, 0x43, 0xa9, 0xde, 0xe4, 0xb0, 0xb9, 0x8e,
+	0xed, 0xf8, 0xec, 0xf4, 0xe4, 0xcb, 0xf9, 0x54, 0x8a, 0x7f, 0x7b, 0x29, 0x87, 0x56, 0x30, 0xf3,
+	0xeb, 0x9d, 0x3b, 0xb1, 0xf3, 0xf9, 0xcd, 0xa8, 0x75, 0x99, 0xec, 0x45, 0x8e, 0xe5, 0x82, 0x4f,
+	0xb2, 0x35, 0xa5, 0x9f, 0xba, 0xf0, 0xfa, 0x44, 0x8e, 0x02, 0x2a, 0x12, 0x83, 0xe8, 0x2c, 0x6a,
+	0x2f, 0xc7, 0x80, 0xaa, 0x4f, 0x08, 0x6f, 0x7e, 0x1a, 0xc3, 0x39, 0x93, 0x3d, 0x2f, 0xb4, 0x87,
+	0xdf, 0x61, 0x47, 0xb7, 0x39, 0xbc, 0xb5, 0x16, 0xee, 0x67, 0x2c, 0xa9, 0x79, 0x34, 0x99, 0xe9,
+	0x68, 0x3a, 0xd3, 0xd1, 0xfb, 0x4c, 0x47, 0xf7, 0x73, 0x5d, 0x99, 0xce, 0x75, 0xe5, 0x65, 0xae,
+	0x2b, 0x17, 0xdb, 0x2b, 0x65, 0x19, 0x77, 0x23, 0x27, 0x12, 0x75, 0x4e, 0xe5, 0x10, 0xc2, 0x3e,
+	0x49, 0xee, 0xfc, 0x3a, 0xbd, 0xf4, 0xa4, 0x81, 0x53, 0x4c, 0xce, 0x72, 0xef, 0x63, 0x00, 0x9a,
+	0x63, 0x44, 0xd0, 0x03, 0x04, 0x00, 0x00,
 }
 
 func (m *CommunityPoolDepositProposal) Marshal() (dAtA []byte, err error) {
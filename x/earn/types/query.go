@@ -19,6 +19,21 @@ func NewQueryVaultRequest(denom string) *QueryVaultRequest {
 	}
 }
 
+// NewQueryVaultFeesRequest returns a new QueryVaultFeesRequest
+func NewQueryVaultFeesRequest(denom string) *QueryVaultFeesRequest {
+	return &QueryVaultFeesRequest{
+		Denom: denom,
+	}
+}
+
+// NewQueryVaultPerformanceRequest returns a new QueryVaultPerformanceRequest
+func NewQueryVaultPerformanceRequest(denom string, pagination *query.PageRequest) *QueryVaultPerformanceRequest {
+	return &QueryVaultPerformanceRequest{
+		Denom:      denom,
+		Pagination: pagination,
+	}
+}
+
 // NewQueryDepositsRequest returns a new QueryDepositsRequest
 func NewQueryDepositsRequest(
 	depositor string,
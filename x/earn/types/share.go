@@ -381,3 +381,18 @@ func (shares VaultShares) Sort() VaultShares {
 	sort.Sort(shares)
 	return shares
 }
+
+// ShareTokenDenom returns the denom of the tokenized shares of a vault.
+func ShareTokenDenom(vaultDenom string) string {
+	return ShareTokenPrefix + vaultDenom
+}
+
+// ParseShareTokenDenom returns the vault denom of a share token denom.
+func ParseShareTokenDenom(denom string) (string, error) {
+	vaultDenom := strings.TrimPrefix(denom, ShareTokenPrefix)
+	if vaultDenom == denom || vaultDenom == "" {
+		return "", errorsmod.Wrapf(ErrInvalidShareTokenDenom, "%s is not a vault share token", denom)
+	}
+
+	return vaultDenom, nil
+}
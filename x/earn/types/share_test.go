@@ -444,3 +444,18 @@ func (s *vaultShareTestSuite) TestVaultShares_AddVaultShareWithIsValid() {
 		}
 	}
 }
+
+func (s *vaultShareTestSuite) TestShareTokenDenom() {
+	denom := types.ShareTokenDenom(testDenom2)
+	s.Require().Equal("erc/vault/usdx", denom)
+
+	vaultDenom, err := types.ParseShareTokenDenom(denom)
+	s.Require().NoError(err)
+	s.Require().Equal(testDenom2, vaultDenom)
+
+	_, err = types.ParseShareTokenDenom(testDenom2)
+	s.Require().ErrorIs(err, types.ErrInvalidShareTokenDenom)
+
+	_, err = types.ParseShareTokenDenom(types.ShareTokenPrefix)
+	s.Require().ErrorIs(err, types.ErrInvalidShareTokenDenom)
+}
@@ -7,7 +7,12 @@ import (
 
 // IsValid returns true if the StrategyType status is valid and false otherwise.
 func (s StrategyType) IsValid() bool {
-	return s == STRATEGY_TYPE_HARD || s == STRATEGY_TYPE_SAVINGS
+	switch s {
+	case STRATEGY_TYPE_HARD, STRATEGY_TYPE_SAVINGS, STRATEGY_TYPE_SWAP_LP, STRATEGY_TYPE_CDP_SAVINGS:
+		return true
+	default:
+		return false
+	}
 }
 
 // Validate returns an error if the StrategyType is invalid.
@@ -26,6 +31,10 @@ func NewStrategyTypeFromString(str string) StrategyType {
 		return STRATEGY_TYPE_HARD
 	case "savings":
 		return STRATEGY_TYPE_SAVINGS
+	case "swap_lp":
+		return STRATEGY_TYPE_SWAP_LP
+	case "cdp_savings":
+		return STRATEGY_TYPE_CDP_SAVINGS
 	default:
 		return STRATEGY_TYPE_UNSPECIFIED
 	}
@@ -40,10 +49,6 @@ func (strategies StrategyTypes) Validate() error {
 		return fmt.Errorf("empty StrategyTypes")
 	}
 
-	if len(strategies) != 1 {
-		return fmt.Errorf("must have exactly one strategy type, multiple strategies are not supported")
-	}
-
 	uniqueStrategies := make(map[StrategyType]bool)
 
 	for _, strategy := range strategies {
@@ -2,9 +2,14 @@ package types
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	swaptypes "github.com/incubus-network/fury/x/swap/types"
 )
 
 // NewVaultRecord returns a new VaultRecord with 0 supply.
@@ -16,7 +21,65 @@ func NewVaultRecord(vaultDenom string, amount sdk.Dec) VaultRecord {
 
 // Validate returns an error if a VaultRecord is invalid.
 func (vr *VaultRecord) Validate() error {
-	return vr.TotalShares.Validate()
+	if err := vr.TotalShares.Validate(); err != nil {
+		return err
+	}
+
+	return vr.Allocations.Validate()
+}
+
+// NewStrategyAllocation returns a new StrategyAllocation with the given values.
+func NewStrategyAllocation(strategy StrategyType, amount sdkmath.Int) StrategyAllocation {
+	return StrategyAllocation{
+		Strategy: strategy,
+		Amount:   amount,
+	}
+}
+
+// Validate returns an error if a StrategyAllocation is invalid.
+func (sa StrategyAllocation) Validate() error {
+	if err := sa.Strategy.Validate(); err != nil {
+		return err
+	}
+
+	if sa.Amount.IsNil() || sa.Amount.IsNegative() {
+		return fmt.Errorf("invalid allocation amount for strategy %s: %s", sa.Strategy, sa.Amount)
+	}
+
+	return nil
+}
+
+// StrategyAllocations is a slice of StrategyAllocation.
+type StrategyAllocations []StrategyAllocation
+
+// Validate returns an error if a slice of StrategyAllocations is invalid.
+func (sas StrategyAllocations) Validate() error {
+	strategies := make(map[StrategyType]bool)
+
+	for _, sa := range sas {
+		if err := sa.Validate(); err != nil {
+			return err
+		}
+
+		if strategies[sa.Strategy] {
+			return fmt.Errorf("duplicate allocation for strategy %s", sa.Strategy)
+		}
+
+		strategies[sa.Strategy] = true
+	}
+
+	return nil
+}
+
+// AmountOf returns the amount allocated to the given strategy.
+func (sas StrategyAllocations) AmountOf(strategy StrategyType) sdkmath.Int {
+	for _, sa := range sas {
+		if sa.Strategy == strategy {
+			return sa.Amount
+		}
+	}
+
+	return sdk.ZeroInt()
 }
 
 // VaultRecords is a slice of VaultRecord.
@@ -116,7 +179,109 @@ func (a *AllowedVault) Validate() error {
 		return fmt.Errorf("non-private vaults cannot have any AllowedDepositors")
 	}
 
-	return a.Strategies.Validate()
+	if err := a.Strategies.Validate(); err != nil {
+		return err
+	}
+
+	if err := a.validateStrategyWeights(); err != nil {
+		return err
+	}
+
+	if a.AutoCompoundParams != nil {
+		if err := a.AutoCompoundParams.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if a.FeeParams != nil {
+		if err := a.FeeParams.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if a.SnapshotParams != nil {
+		if err := a.SnapshotParams.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return a.validateStrategyParams()
+}
+
+// validateStrategyParams returns an error if a strategy that requires params
+// is missing them, or if params are set for a strategy the vault does not use.
+func (a *AllowedVault) validateStrategyParams() error {
+	if a.IsStrategyAllowed(STRATEGY_TYPE_SWAP_LP) {
+		if a.SwapLPParams == nil {
+			return fmt.Errorf("vaults with strategy %s require SwapLPParams", STRATEGY_TYPE_SWAP_LP)
+		}
+
+		if err := a.SwapLPParams.Validate(a.Denom); err != nil {
+			return err
+		}
+	} else if a.SwapLPParams != nil {
+		return fmt.Errorf("SwapLPParams set for vault without strategy %s", STRATEGY_TYPE_SWAP_LP)
+	}
+
+	if a.IsStrategyAllowed(STRATEGY_TYPE_CDP_SAVINGS) {
+		if a.CdpSavingsParams == nil {
+			return fmt.Errorf("vaults with strategy %s require CdpSavingsParams", STRATEGY_TYPE_CDP_SAVINGS)
+		}
+
+		if err := a.CdpSavingsParams.Validate(); err != nil {
+			return err
+		}
+	} else if a.CdpSavingsParams != nil {
+		return fmt.Errorf("CdpSavingsParams set for vault without strategy %s", STRATEGY_TYPE_CDP_SAVINGS)
+	}
+
+	return nil
+}
+
+// validateStrategyWeights returns an error if the strategy weights do not
+// match the strategies or do not sum to one.
+func (a *AllowedVault) validateStrategyWeights() error {
+	if len(a.StrategyWeights) == 0 {
+		if len(a.Strategies) > 1 {
+			return fmt.Errorf("vaults with multiple strategies require StrategyWeights")
+		}
+
+		return nil
+	}
+
+	if len(a.StrategyWeights) != len(a.Strategies) {
+		return fmt.Errorf(
+			"number of StrategyWeights %d does not match number of Strategies %d",
+			len(a.StrategyWeights),
+			len(a.Strategies),
+		)
+	}
+
+	total := sdk.ZeroDec()
+	for i, weight := range a.StrategyWeights {
+		if weight.IsNil() || !weight.IsPositive() {
+			return fmt.Errorf("weight of strategy %s must be positive: %s", a.Strategies[i], weight)
+		}
+
+		total = total.Add(weight)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("StrategyWeights must sum to 1, got %s", total)
+	}
+
+	return nil
+}
+
+// GetStrategyWeights returns the target weight of each strategy, in the same
+// order as Strategies. A vault with a single strategy and no weights holds all
+// of its assets in that strategy.
+func (a *AllowedVault) GetStrategyWeights() []sdk.Dec {
+	if len(a.StrategyWeights) == 0 && len(a.Strategies) == 1 {
+		return []sdk.Dec{sdk.OneDec()}
+	}
+
+	return a.StrategyWeights
 }
 
 // IsStrategyAllowed returns true if the given strategy type is allowed for the
@@ -154,6 +319,7 @@ type AllowedVaults []AllowedVault
 // Validate returns an error if the AllowedVaults is invalid.
 func (a AllowedVaults) Validate() error {
 	denoms := make(map[string]bool)
+	pools := make(map[string]bool)
 
 	for _, v := range a {
 		if err := v.Validate(); err != nil {
@@ -165,7 +331,393 @@ func (a AllowedVaults) Validate() error {
 		}
 
 		denoms[v.Denom] = true
+
+		// The earn module account holds one share record per pool, so a pool
+		// cannot be shared between vaults.
+		if v.SwapLPParams != nil {
+			poolID := swaptypes.PoolID(v.Denom, v.SwapLPParams.PairedDenom)
+			if pools[poolID] {
+				return fmt.Errorf("duplicate swap lp pool %s", poolID)
+			}
+
+			pools[poolID] = true
+		}
+	}
+
+	return nil
+}
+
+// NewSwapLPStrategyParams returns a new SwapLPStrategyParams.
+func NewSwapLPStrategyParams(pairedDenom string, slippageLimit sdk.Dec) *SwapLPStrategyParams {
+	return &SwapLPStrategyParams{
+		PairedDenom:   pairedDenom,
+		SlippageLimit: slippageLimit,
+	}
+}
+
+// Validate returns an error if the SwapLPStrategyParams are invalid for a
+// vault with the given denom.
+func (p SwapLPStrategyParams) Validate(vaultDenom string) error {
+	if err := sdk.ValidateDenom(p.PairedDenom); err != nil {
+		return fmt.Errorf("invalid swap lp paired denom: %w", err)
+	}
+
+	if p.PairedDenom == vaultDenom {
+		return fmt.Errorf("swap lp paired denom cannot be the vault denom %s", vaultDenom)
+	}
+
+	if p.SlippageLimit.IsNil() || !p.SlippageLimit.IsPositive() || p.SlippageLimit.GTE(sdk.OneDec()) {
+		return fmt.Errorf("swap lp slippage limit must be between 0 and 1, got %s", p.SlippageLimit)
+	}
+
+	return nil
+}
+
+// NewCdpSavingsStrategyParams returns a new CdpSavingsStrategyParams.
+func NewCdpSavingsStrategyParams(
+	collateralType string,
+	collateralizationRatio sdk.Dec,
+	slippageLimit sdk.Dec,
+) *CdpSavingsStrategyParams {
+	return &CdpSavingsStrategyParams{
+		CollateralType:         collateralType,
+		CollateralizationRatio: collateralizationRatio,
+		SlippageLimit:          slippageLimit,
+	}
+}
+
+// Validate returns an error if the CdpSavingsStrategyParams are invalid.
+func (p CdpSavingsStrategyParams) Validate() error {
+	if strings.TrimSpace(p.CollateralType) == "" {
+		return fmt.Errorf("cdp savings collateral type cannot be blank")
+	}
+
+	if p.CollateralizationRatio.IsNil() || p.CollateralizationRatio.LTE(sdk.OneDec()) {
+		return fmt.Errorf("cdp savings collateralization ratio must be greater than 1, got %s", p.CollateralizationRatio)
+	}
+
+	if p.SlippageLimit.IsNil() || !p.SlippageLimit.IsPositive() || p.SlippageLimit.GTE(sdk.OneDec()) {
+		return fmt.Errorf("cdp savings slippage limit must be between 0 and 1, got %s", p.SlippageLimit)
+	}
+
+	return nil
+}
+
+// NewCdpSavingsDeposit returns a new CdpSavingsDeposit.
+func NewCdpSavingsDeposit(vaultDenom string, amount sdk.Coin) CdpSavingsDeposit {
+	return CdpSavingsDeposit{
+		VaultDenom: vaultDenom,
+		Amount:     amount,
+	}
+}
+
+// Validate returns an error if the CdpSavingsDeposit is invalid.
+func (d CdpSavingsDeposit) Validate() error {
+	if err := sdk.ValidateDenom(d.VaultDenom); err != nil {
+		return errorsmod.Wrap(ErrInvalidVaultDenom, err.Error())
+	}
+
+	if !d.Amount.IsValid() {
+		return fmt.Errorf("invalid cdp savings deposit amount: %s", d.Amount)
+	}
+
+	return nil
+}
+
+// CdpSavingsDeposits is a slice of CdpSavingsDeposit.
+type CdpSavingsDeposits []CdpSavingsDeposit
+
+// Validate returns an error if the CdpSavingsDeposits are invalid.
+func (ds CdpSavingsDeposits) Validate() error {
+	denoms := make(map[string]bool)
+	for _, d := range ds {
+		if err := d.Validate(); err != nil {
+			return err
+		}
+
+		if denoms[d.VaultDenom] {
+			return fmt.Errorf("duplicate cdp savings deposit for vault %s", d.VaultDenom)
+		}
+
+		denoms[d.VaultDenom] = true
+	}
+
+	return nil
+}
+
+// NewAutoCompoundParams returns a new AutoCompoundParams.
+func NewAutoCompoundParams(interval int64, slippageLimit sdk.Dec) *AutoCompoundParams {
+	return &AutoCompoundParams{
+		Interval:      interval,
+		SlippageLimit: slippageLimit,
+	}
+}
+
+// Validate returns an error if the AutoCompoundParams are invalid.
+func (p AutoCompoundParams) Validate() error {
+	if p.Interval <= 0 {
+		return fmt.Errorf("auto compound interval must be positive, got %d", p.Interval)
+	}
+
+	if p.SlippageLimit.IsNil() || !p.SlippageLimit.IsPositive() || p.SlippageLimit.GTE(sdk.OneDec()) {
+		return fmt.Errorf("auto compound slippage limit must be between 0 and 1, got %s", p.SlippageLimit)
+	}
+
+	return nil
+}
+
+// NewSwapLPResidual returns a new SwapLPResidual.
+func NewSwapLPResidual(vaultDenom string, amount sdk.Coins) SwapLPResidual {
+	return SwapLPResidual{
+		VaultDenom: vaultDenom,
+		Amount:     amount,
+	}
+}
+
+// Validate returns an error if the SwapLPResidual is invalid.
+func (r SwapLPResidual) Validate() error {
+	if err := sdk.ValidateDenom(r.VaultDenom); err != nil {
+		return errorsmod.Wrap(ErrInvalidVaultDenom, err.Error())
+	}
+
+	if !r.Amount.IsValid() {
+		return fmt.Errorf("invalid swap lp residual amount: %s", r.Amount)
 	}
 
 	return nil
 }
+
+// SwapLPResiduals is a slice of SwapLPResidual.
+type SwapLPResiduals []SwapLPResidual
+
+// Validate returns an error if the SwapLPResiduals are invalid.
+func (rs SwapLPResiduals) Validate() error {
+	denoms := make(map[string]bool)
+	for _, r := range rs {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+
+		if denoms[r.VaultDenom] {
+			return fmt.Errorf("duplicate swap lp residual for vault %s", r.VaultDenom)
+		}
+
+		denoms[r.VaultDenom] = true
+	}
+
+	return nil
+}
+
+// NewVaultRewardsRecord returns a new VaultRewardsRecord.
+func NewVaultRewardsRecord(vaultDenom string, rewards sdk.Coins) VaultRewardsRecord {
+	return VaultRewardsRecord{
+		VaultDenom: vaultDenom,
+		Rewards:    rewards,
+	}
+}
+
+// Validate returns an error if the VaultRewardsRecord is invalid.
+func (r VaultRewardsRecord) Validate() error {
+	if err := sdk.ValidateDenom(r.VaultDenom); err != nil {
+		return errorsmod.Wrap(ErrInvalidVaultDenom, err.Error())
+	}
+
+	if !r.Rewards.IsValid() {
+		return fmt.Errorf("invalid vault rewards: %s", r.Rewards)
+	}
+
+	return nil
+}
+
+// VaultRewardsRecords is a slice of VaultRewardsRecord.
+type VaultRewardsRecords []VaultRewardsRecord
+
+// Validate returns an error if the VaultRewardsRecords are invalid.
+func (rs VaultRewardsRecords) Validate() error {
+	denoms := make(map[string]bool)
+	for _, r := range rs {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+
+		if denoms[r.VaultDenom] {
+			return fmt.Errorf("duplicate rewards record for vault %s", r.VaultDenom)
+		}
+
+		denoms[r.VaultDenom] = true
+	}
+
+	return nil
+}
+
+// NewVaultFeeParams returns a new VaultFeeParams.
+func NewVaultFeeParams(performanceFee, withdrawalFee sdk.Dec, feeRecipient sdk.AccAddress) *VaultFeeParams {
+	return &VaultFeeParams{
+		PerformanceFee: performanceFee,
+		WithdrawalFee:  withdrawalFee,
+		FeeRecipient:   feeRecipient,
+	}
+}
+
+// Validate returns an error if the VaultFeeParams are invalid.
+func (p VaultFeeParams) Validate() error {
+	if p.PerformanceFee.IsNil() || p.PerformanceFee.IsNegative() || p.PerformanceFee.GTE(sdk.OneDec()) {
+		return fmt.Errorf("performance fee must be at least 0 and less than 1, got %s", p.PerformanceFee)
+	}
+
+	if p.WithdrawalFee.IsNil() || p.WithdrawalFee.IsNegative() || p.WithdrawalFee.GTE(sdk.OneDec()) {
+		return fmt.Errorf("withdrawal fee must be at least 0 and less than 1, got %s", p.WithdrawalFee)
+	}
+
+	if err := sdk.VerifyAddressFormat(p.FeeRecipient); err != nil {
+		return fmt.Errorf("invalid fee recipient: %w", err)
+	}
+
+	return nil
+}
+
+// NewVaultFeeRecord returns a new VaultFeeRecord with no accrued fees.
+func NewVaultFeeRecord(vaultDenom string, highWaterMark sdk.Dec) VaultFeeRecord {
+	return VaultFeeRecord{
+		VaultDenom:             vaultDenom,
+		HighWaterMark:          highWaterMark,
+		AccruedPerformanceFees: sdk.ZeroDec(),
+		AccruedWithdrawalFees:  sdk.ZeroDec(),
+	}
+}
+
+// Validate returns an error if the VaultFeeRecord is invalid.
+func (r VaultFeeRecord) Validate() error {
+	if err := sdk.ValidateDenom(r.VaultDenom); err != nil {
+		return errorsmod.Wrap(ErrInvalidVaultDenom, err.Error())
+	}
+
+	if r.HighWaterMark.IsNil() || !r.HighWaterMark.IsPositive() {
+		return fmt.Errorf("high-water mark must be positive, got %s", r.HighWaterMark)
+	}
+
+	if r.AccruedPerformanceFees.IsNil() || r.AccruedPerformanceFees.IsNegative() {
+		return fmt.Errorf("accrued performance fees cannot be negative, got %s", r.AccruedPerformanceFees)
+	}
+
+	if r.AccruedWithdrawalFees.IsNil() || r.AccruedWithdrawalFees.IsNegative() {
+		return fmt.Errorf("accrued withdrawal fees cannot be negative, got %s", r.AccruedWithdrawalFees)
+	}
+
+	return nil
+}
+
+// VaultFeeRecords is a slice of VaultFeeRecord.
+type VaultFeeRecords []VaultFeeRecord
+
+// Validate returns an error if the VaultFeeRecords are invalid.
+func (rs VaultFeeRecords) Validate() error {
+	denoms := make(map[string]bool)
+	for _, r := range rs {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+
+		if denoms[r.VaultDenom] {
+			return fmt.Errorf("duplicate fee record for vault %s", r.VaultDenom)
+		}
+
+		denoms[r.VaultDenom] = true
+	}
+
+	return nil
+}
+
+// NewVaultSnapshotParams returns a new VaultSnapshotParams.
+func NewVaultSnapshotParams(interval, retention time.Duration) *VaultSnapshotParams {
+	return &VaultSnapshotParams{
+		Interval:  interval,
+		Retention: retention,
+	}
+}
+
+// Validate returns an error if the VaultSnapshotParams are invalid.
+func (p VaultSnapshotParams) Validate() error {
+	if p.Interval <= 0 {
+		return fmt.Errorf("snapshot interval must be positive, got %s", p.Interval)
+	}
+
+	// A snapshot at least as old as the longest window must outlive the
+	// next snapshot interval for its realized APY to be found.
+	if minRetention := MaxRealizedAPYWindow() + p.Interval; p.Retention < minRetention {
+		return fmt.Errorf(
+			"snapshot retention must be at least the longest realized APY window plus the snapshot interval, got %s < %s",
+			p.Retention, minRetention,
+		)
+	}
+
+	return nil
+}
+
+// NewVaultSharePriceSnapshot returns a new VaultSharePriceSnapshot.
+func NewVaultSharePriceSnapshot(vaultDenom string, height int64, blockTime time.Time, sharePrice sdk.Dec) VaultSharePriceSnapshot {
+	return VaultSharePriceSnapshot{
+		VaultDenom: vaultDenom,
+		Height:     height,
+		Time:       blockTime,
+		SharePrice: sharePrice,
+	}
+}
+
+// Validate returns an error if the VaultSharePriceSnapshot is invalid.
+func (s VaultSharePriceSnapshot) Validate() error {
+	if err := sdk.ValidateDenom(s.VaultDenom); err != nil {
+		return errorsmod.Wrap(ErrInvalidVaultDenom, err.Error())
+	}
+
+	if s.Height < 0 {
+		return fmt.Errorf("snapshot height cannot be negative, got %d", s.Height)
+	}
+
+	if s.SharePrice.IsNil() || !s.SharePrice.IsPositive() {
+		return fmt.Errorf("snapshot share price must be positive, got %s", s.SharePrice)
+	}
+
+	return nil
+}
+
+// VaultSharePriceSnapshots is a slice of VaultSharePriceSnapshot.
+type VaultSharePriceSnapshots []VaultSharePriceSnapshot
+
+// Validate returns an error if the VaultSharePriceSnapshots are invalid.
+func (ss VaultSharePriceSnapshots) Validate() error {
+	keys := make(map[string]bool)
+	for _, s := range ss {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s/%d", s.VaultDenom, s.Time.UnixNano())
+		if keys[key] {
+			return fmt.Errorf("duplicate snapshot for vault %s at %s", s.VaultDenom, s.Time)
+		}
+
+		keys[key] = true
+	}
+
+	return nil
+}
+
+// RealizedAPYWindows are the lengths of time the realized APYs of vaults are
+// measured over.
+var RealizedAPYWindows = []time.Duration{
+	7 * 24 * time.Hour,
+	30 * 24 * time.Hour,
+}
+
+// MaxRealizedAPYWindow returns the longest of the RealizedAPYWindows.
+func MaxRealizedAPYWindow() time.Duration {
+	var longest time.Duration
+	for _, window := range RealizedAPYWindows {
+		if window > longest {
+			longest = window
+		}
+	}
+
+	return longest
+}
@@ -7,17 +7,23 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -40,13 +46,32 @@ type AllowedVault struct {
 	// are not allowed to deposit into this vault. If IsPrivateVault is false,
 	// this should be empty and ignored.
 	AllowedDepositors []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,rep,name=allowed_depositors,json=allowedDepositors,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"allowed_depositors,omitempty"`
+	// StrategyWeights are the target fractions of the vault's assets held by
+	// each strategy, in the same order as Strategies. They must sum to one. May
+	// be empty if the vault has a single strategy.
+	StrategyWeights []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,rep,name=strategy_weights,json=strategyWeights,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"strategy_weights"`
+	// SwapLPParams configures the swap LP strategy. Required if Strategies
+	// contains STRATEGY_TYPE_SWAP_LP.
+	SwapLPParams *SwapLPStrategyParams `protobuf:"bytes,6,opt,name=swap_lp_params,json=swapLpParams,proto3" json:"swap_lp_params,omitempty"`
+	// CdpSavingsParams configures the CDP savings strategy. Required if
+	// Strategies contains STRATEGY_TYPE_CDP_SAVINGS.
+	CdpSavingsParams *CdpSavingsStrategyParams `protobuf:"bytes,7,opt,name=cdp_savings_params,json=cdpSavingsParams,proto3" json:"cdp_savings_params,omitempty"`
+	// AutoCompoundParams enables auto-compounding of the incentive rewards
+	// earned by the vault's deposits. Auto-compounding is disabled if nil.
+	AutoCompoundParams *AutoCompoundParams `protobuf:"bytes,8,opt,name=auto_compound_params,json=autoCompoundParams,proto3" json:"auto_compound_params,omitempty"`
+	// FeeParams sets the fees charged by the vault. The vault charges no fees if
+	// nil.
+	FeeParams *VaultFeeParams `protobuf:"bytes,9,opt,name=fee_params,json=feeParams,proto3" json:"fee_params,omitempty"`
+	// SnapshotParams enables recording the vault's share price history. No
+	// history is recorded if nil.
+	SnapshotParams *VaultSnapshotParams `protobuf:"bytes,10,opt,name=snapshot_params,json=snapshotParams,proto3" json:"snapshot_params,omitempty"`
 }
 
 func (m *AllowedVault) Reset()         { *m = AllowedVault{} }
 func (m *AllowedVault) String() string { return proto.CompactTextString(m) }
 func (*AllowedVault) ProtoMessage()    {}
 func (*AllowedVault) Descriptor() ([]byte, []int) {
-	return fileDescriptor_884eb89509fbdc04, []int{0}
+	return fileDescriptor_9183aa7b63d72704, []int{0}
 }
 func (m *AllowedVault) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -103,24 +128,64 @@ func (m *AllowedVault) GetAllowedDepositors() []github_com_cosmos_cosmos_sdk_typ
 	return nil
 }
 
-// VaultRecord is the state of a vault.
-type VaultRecord struct {
-	// TotalShares is the total distributed number of shares in the vault.
-	TotalShares VaultShare `protobuf:"bytes,1,opt,name=total_shares,json=totalShares,proto3" json:"total_shares"`
+func (m *AllowedVault) GetSwapLPParams() *SwapLPStrategyParams {
+	if m != nil {
+		return m.SwapLPParams
+	}
+	return nil
 }
 
-func (m *VaultRecord) Reset()         { *m = VaultRecord{} }
-func (m *VaultRecord) String() string { return proto.CompactTextString(m) }
-func (*VaultRecord) ProtoMessage()    {}
-func (*VaultRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_884eb89509fbdc04, []int{1}
+func (m *AllowedVault) GetCdpSavingsParams() *CdpSavingsStrategyParams {
+	if m != nil {
+		return m.CdpSavingsParams
+	}
+	return nil
 }
-func (m *VaultRecord) XXX_Unmarshal(b []byte) error {
+
+func (m *AllowedVault) GetAutoCompoundParams() *AutoCompoundParams {
+	if m != nil {
+		return m.AutoCompoundParams
+	}
+	return nil
+}
+
+func (m *AllowedVault) GetFeeParams() *VaultFeeParams {
+	if m != nil {
+		return m.FeeParams
+	}
+	return nil
+}
+
+func (m *AllowedVault) GetSnapshotParams() *VaultSnapshotParams {
+	if m != nil {
+		return m.SnapshotParams
+	}
+	return nil
+}
+
+// VaultSnapshotParams defines how often a vault's share price is recorded and
+// how long the records are kept.
+type VaultSnapshotParams struct {
+	// Interval is the minimum time between share price snapshots.
+	Interval time.Duration `protobuf:"bytes,1,opt,name=interval,proto3,stdduration" json:"interval"`
+	// Retention is how long share price snapshots are kept before they are
+	// pruned. It must be at least the longest realized APY window plus the
+	// interval.
+	Retention time.Duration `protobuf:"bytes,2,opt,name=retention,proto3,stdduration" json:"retention"`
+}
+
+func (m *VaultSnapshotParams) Reset()         { *m = VaultSnapshotParams{} }
+func (m *VaultSnapshotParams) String() string { return proto.CompactTextString(m) }
+func (*VaultSnapshotParams) ProtoMessage()    {}
+func (*VaultSnapshotParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9183aa7b63d72704, []int{1}
+}
+func (m *VaultSnapshotParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VaultRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *VaultSnapshotParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VaultRecord.Marshal(b, m, deterministic)
+		return xxx_messageInfo_VaultSnapshotParams.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -130,45 +195,57 @@ func (m *VaultRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *VaultRecord) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VaultRecord.Merge(m, src)
+func (m *VaultSnapshotParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultSnapshotParams.Merge(m, src)
 }
-func (m *VaultRecord) XXX_Size() int {
+func (m *VaultSnapshotParams) XXX_Size() int {
 	return m.Size()
 }
-func (m *VaultRecord) XXX_DiscardUnknown() {
-	xxx_messageInfo_VaultRecord.DiscardUnknown(m)
+func (m *VaultSnapshotParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultSnapshotParams.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VaultRecord proto.InternalMessageInfo
+var xxx_messageInfo_VaultSnapshotParams proto.InternalMessageInfo
 
-func (m *VaultRecord) GetTotalShares() VaultShare {
+func (m *VaultSnapshotParams) GetInterval() time.Duration {
 	if m != nil {
-		return m.TotalShares
+		return m.Interval
 	}
-	return VaultShare{}
+	return 0
 }
 
-// VaultShareRecord defines the vault shares owned by a depositor.
-type VaultShareRecord struct {
-	// Depositor represents the owner of the shares
-	Depositor github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=depositor,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"depositor,omitempty"`
-	// Shares represent the vault shares owned by the depositor.
-	Shares VaultShares `protobuf:"bytes,2,rep,name=shares,proto3,castrepeated=VaultShares" json:"shares"`
+func (m *VaultSnapshotParams) GetRetention() time.Duration {
+	if m != nil {
+		return m.Retention
+	}
+	return 0
 }
 
-func (m *VaultShareRecord) Reset()         { *m = VaultShareRecord{} }
-func (m *VaultShareRecord) String() string { return proto.CompactTextString(m) }
-func (*VaultShareRecord) ProtoMessage()    {}
-func (*VaultShareRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_884eb89509fbdc04, []int{2}
+// VaultFeeParams defines the fees of a vault, paid as vault shares to the fee
+// recipient.
+type VaultFeeParams struct {
+	// PerformanceFee is the fraction of the growth of the vault's share price
+	// above its high-water mark that is paid as fees.
+	PerformanceFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=performance_fee,json=performanceFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"performance_fee"`
+	// WithdrawalFee is the fraction of withdrawn shares that is paid as fees.
+	WithdrawalFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=withdrawal_fee,json=withdrawalFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"withdrawal_fee"`
+	// FeeRecipient is the account that receives the fee shares, such as the
+	// x/community module account.
+	FeeRecipient github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=fee_recipient,json=feeRecipient,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"fee_recipient,omitempty"`
 }
-func (m *VaultShareRecord) XXX_Unmarshal(b []byte) error {
+
+func (m *VaultFeeParams) Reset()         { *m = VaultFeeParams{} }
+func (m *VaultFeeParams) String() string { return proto.CompactTextString(m) }
+func (*VaultFeeParams) ProtoMessage()    {}
+func (*VaultFeeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9183aa7b63d72704, []int{2}
+}
+func (m *VaultFeeParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VaultShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *VaultFeeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VaultShareRecord.Marshal(b, m, deterministic)
+		return xxx_messageInfo_VaultFeeParams.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -178,49 +255,95 @@ func (m *VaultShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *VaultShareRecord) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VaultShareRecord.Merge(m, src)
+func (m *VaultFeeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultFeeParams.Merge(m, src)
 }
-func (m *VaultShareRecord) XXX_Size() int {
+func (m *VaultFeeParams) XXX_Size() int {
 	return m.Size()
 }
-func (m *VaultShareRecord) XXX_DiscardUnknown() {
-	xxx_messageInfo_VaultShareRecord.DiscardUnknown(m)
+func (m *VaultFeeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultFeeParams.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VaultShareRecord proto.InternalMessageInfo
+var xxx_messageInfo_VaultFeeParams proto.InternalMessageInfo
 
-func (m *VaultShareRecord) GetDepositor() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *VaultFeeParams) GetFeeRecipient() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.Depositor
+		return m.FeeRecipient
 	}
 	return nil
 }
 
-func (m *VaultShareRecord) GetShares() VaultShares {
+// AutoCompoundParams defines how a vault reinvests its incentive rewards.
+type AutoCompoundParams struct {
+	// Interval is the number of blocks between compounds.
+	Interval int64 `protobuf:"varint,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	// SlippageLimit is the maximum slippage, relative to the pool spot price,
+	// accepted when swapping rewards for the vault denom.
+	SlippageLimit github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=slippage_limit,json=slippageLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slippage_limit"`
+}
+
+func (m *AutoCompoundParams) Reset()         { *m = AutoCompoundParams{} }
+func (m *AutoCompoundParams) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundParams) ProtoMessage()    {}
+func (*AutoCompoundParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9183aa7b63d72704, []int{3}
+}
+func (m *AutoCompoundParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundParams.Merge(m, src)
+}
+func (m *AutoCompoundParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundParams proto.InternalMessageInfo
+
+func (m *AutoCompoundParams) GetInterval() int64 {
 	if m != nil {
-		return m.Shares
+		return m.Interval
 	}
-	return nil
+	return 0
 }
 
-// VaultShare defines shares of a vault owned by a depositor.
-type VaultShare struct {
-	Denom  string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Amount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"amount"`
+// SwapLPStrategyParams defines the pool a vault provides liquidity to.
+type SwapLPStrategyParams struct {
+	// PairedDenom is the other asset of the pool, the vault denom being the first.
+	PairedDenom string `protobuf:"bytes,1,opt,name=paired_denom,json=pairedDenom,proto3" json:"paired_denom,omitempty"`
+	// SlippageLimit is the maximum slippage, relative to the pool spot price,
+	// accepted when swapping between the vault denom and the paired denom.
+	SlippageLimit github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=slippage_limit,json=slippageLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slippage_limit"`
 }
 
-func (m *VaultShare) Reset()      { *m = VaultShare{} }
-func (*VaultShare) ProtoMessage() {}
-func (*VaultShare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_884eb89509fbdc04, []int{3}
+func (m *SwapLPStrategyParams) Reset()         { *m = SwapLPStrategyParams{} }
+func (m *SwapLPStrategyParams) String() string { return proto.CompactTextString(m) }
+func (*SwapLPStrategyParams) ProtoMessage()    {}
+func (*SwapLPStrategyParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9183aa7b63d72704, []int{4}
 }
-func (m *VaultShare) XXX_Unmarshal(b []byte) error {
+func (m *SwapLPStrategyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VaultShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SwapLPStrategyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VaultShare.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SwapLPStrategyParams.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -230,345 +353,3326 @@ func (m *VaultShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *VaultShare) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VaultShare.Merge(m, src)
+func (m *SwapLPStrategyParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapLPStrategyParams.Merge(m, src)
 }
-func (m *VaultShare) XXX_Size() int {
+func (m *SwapLPStrategyParams) XXX_Size() int {
 	return m.Size()
 }
-func (m *VaultShare) XXX_DiscardUnknown() {
-	xxx_messageInfo_VaultShare.DiscardUnknown(m)
+func (m *SwapLPStrategyParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapLPStrategyParams.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VaultShare proto.InternalMessageInfo
+var xxx_messageInfo_SwapLPStrategyParams proto.InternalMessageInfo
 
-func (m *VaultShare) GetDenom() string {
+func (m *SwapLPStrategyParams) GetPairedDenom() string {
 	if m != nil {
-		return m.Denom
+		return m.PairedDenom
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*AllowedVault)(nil), "fury.earn.v1beta1.AllowedVault")
-	proto.RegisterType((*VaultRecord)(nil), "fury.earn.v1beta1.VaultRecord")
-	proto.RegisterType((*VaultShareRecord)(nil), "fury.earn.v1beta1.VaultShareRecord")
-	proto.RegisterType((*VaultShare)(nil), "fury.earn.v1beta1.VaultShare")
+// CdpSavingsStrategyParams defines the CDP a vault opens with its assets.
+type CdpSavingsStrategyParams struct {
+	// CollateralType is the CDP collateral type, which must use the vault denom
+	// as collateral.
+	CollateralType string `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	// CollateralizationRatio is the target ratio of collateral value to debt
+	// that the strategy draws debt to. It should be well above the liquidation
+	// ratio of the collateral type.
+	CollateralizationRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=collateralization_ratio,json=collateralizationRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"collateralization_ratio"`
+	// SlippageLimit is the maximum slippage accepted when swapping collateral
+	// for debt to repay a debt larger than the savings deposit.
+	SlippageLimit github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=slippage_limit,json=slippageLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slippage_limit"`
 }
 
-func init() { proto.RegisterFile("fury/earn/v1beta1/vault.proto", fileDescriptor_884eb89509fbdc04) }
-
-var fileDescriptor_884eb89509fbdc04 = []byte{
-	// 487 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xb7, 0x93, 0x10, 0xd1, 0x4b, 0xa8, 0x1a, 0xb7, 0x83, 0xa9, 0x54, 0xdb, 0xca, 0x80, 0xbc,
-	0xd8, 0x56, 0xcb, 0x86, 0x18, 0x88, 0x15, 0x21, 0xc4, 0x84, 0xae, 0x85, 0x81, 0x25, 0xba, 0xd8,
-	0x47, 0x6a, 0xd5, 0xc9, 0x45, 0x7e, 0x17, 0x97, 0x2c, 0x7c, 0x06, 0x46, 0x24, 0x16, 0xe6, 0xce,
-	0xfd, 0x0c, 0xa8, 0x63, 0xd5, 0x09, 0x31, 0xa4, 0x28, 0xf9, 0x16, 0x4c, 0xe8, 0xfe, 0xa8, 0x8e,
-	0x14, 0x10, 0x0c, 0x4c, 0xb9, 0xfb, 0xbd, 0xf7, 0x7e, 0x7f, 0x5e, 0x7c, 0xe8, 0xe0, 0x8c, 0x94,
-	0x24, 0xa2, 0xa4, 0x98, 0x44, 0xe5, 0xe1, 0x90, 0x72, 0x72, 0x18, 0x95, 0x64, 0x96, 0xf3, 0x70,
-	0x5a, 0x30, 0xce, 0xac, 0x8e, 0x28, 0x87, 0xa2, 0x1c, 0xea, 0xf2, 0xfe, 0xc3, 0x84, 0xc1, 0x98,
-	0xc1, 0x40, 0x36, 0x44, 0xea, 0xa2, 0xba, 0xf7, 0xf7, 0x46, 0x6c, 0xc4, 0x14, 0x2e, 0x4e, 0x1a,
-	0xf5, 0x36, 0x25, 0x80, 0x17, 0x84, 0xd3, 0xd1, 0x5c, 0x75, 0x74, 0x3f, 0xd7, 0x50, 0xbb, 0x97,
-	0xe7, 0xec, 0x9c, 0xa6, 0x6f, 0x84, 0xb8, 0xb5, 0x87, 0xee, 0xa5, 0x74, 0xc2, 0xc6, 0xb6, 0xe9,
-	0x99, 0xfe, 0x16, 0x56, 0x17, 0x0b, 0x23, 0xa4, 0x07, 0x33, 0x0a, 0x76, 0xcd, 0xab, 0xfb, 0xdb,
-	0x47, 0x6e, 0xb8, 0xe1, 0x30, 0x3c, 0xd6, 0xec, 0x27, 0xf3, 0x29, 0x8d, 0x3b, 0x17, 0xb7, 0xee,
-	0x83, 0x75, 0x04, 0xf0, 0x1a, 0x8b, 0xe5, 0xa3, 0x9d, 0x4c, 0x64, 0xc9, 0x4a, 0xc2, 0xe9, 0x40,
-	0x46, 0xb7, 0xeb, 0x9e, 0xe9, 0xdf, 0xc7, 0xdb, 0x19, 0xbc, 0x52, 0xb0, 0xf2, 0x74, 0x8e, 0x2c,
-	0xa2, 0x3c, 0x0e, 0x52, 0x3a, 0x65, 0x90, 0x71, 0x56, 0x80, 0xdd, 0xf0, 0xea, 0x7e, 0x3b, 0x7e,
-	0xf1, 0x73, 0xe1, 0x06, 0xa3, 0x8c, 0x9f, 0xce, 0x86, 0x61, 0xc2, 0xc6, 0x7a, 0x2b, 0xfa, 0x27,
-	0x80, 0xf4, 0x2c, 0xe2, 0x42, 0x39, 0xec, 0x25, 0x49, 0x2f, 0x4d, 0x0b, 0x0a, 0x70, 0x73, 0x19,
-	0xec, 0xea, 0xdd, 0x69, 0x24, 0x9e, 0x73, 0x0a, 0xb8, 0xa3, 0x35, 0xfa, 0x77, 0x12, 0xdd, 0xd7,
-	0xa8, 0x25, 0x1d, 0x60, 0x9a, 0xb0, 0x22, 0xb5, 0x9e, 0xa3, 0x36, 0x67, 0x9c, 0xe4, 0x03, 0x38,
-	0x25, 0x05, 0x05, 0xb9, 0xa2, 0xd6, 0xd1, 0xc1, 0x6f, 0xf6, 0x20, 0xa7, 0x8e, 0x45, 0x57, 0xdc,
-	0xb8, 0x5a, 0xb8, 0x06, 0x6e, 0xc9, 0x41, 0x89, 0x40, 0xf7, 0xab, 0x89, 0x76, 0xaa, 0x0e, 0x4d,
-	0xfe, 0x0e, 0x6d, 0xdd, 0x85, 0x93, 0xcc, 0xff, 0x33, 0x5b, 0x45, 0x6d, 0xbd, 0x44, 0x4d, 0x6d,
-	0x5f, 0xfc, 0x8d, 0x7f, 0xb5, 0xbf, 0x2b, 0xec, 0x5f, 0xdc, 0xba, 0xad, 0x0a, 0x03, 0xac, 0x19,
-	0xba, 0x1f, 0x10, 0xaa, 0xe0, 0x3f, 0x7c, 0x3a, 0x27, 0xa8, 0x49, 0xc6, 0x6c, 0x36, 0xe1, 0x76,
-	0x4d, 0xc0, 0xf1, 0x53, 0x41, 0xf8, 0x7d, 0xe1, 0x3e, 0xfa, 0x87, 0x60, 0x7d, 0x9a, 0xdc, 0x5c,
-	0x06, 0x48, 0x27, 0xea, 0xd3, 0x04, 0x6b, 0xae, 0x27, 0x8d, 0x4f, 0x5f, 0x5c, 0x23, 0x7e, 0x76,
-	0xb5, 0x74, 0xcc, 0xeb, 0xa5, 0x63, 0xfe, 0x58, 0x3a, 0xe6, 0xc7, 0x95, 0x63, 0x5c, 0xaf, 0x1c,
-	0xe3, 0xdb, 0xca, 0x31, 0xde, 0xae, 0xb3, 0x8b, 0x7c, 0x41, 0x4e, 0x86, 0x20, 0x4f, 0xd1, 0x7b,
-	0xf5, 0x20, 0xa4, 0xc2, 0xb0, 0x29, 0x9f, 0xc1, 0xe3, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa0,
-	0xa9, 0xba, 0x64, 0x8d, 0x03, 0x00, 0x00,
+func (m *CdpSavingsStrategyParams) Reset()         { *m = CdpSavingsStrategyParams{} }
+func (m *CdpSavingsStrategyParams) String() string { return proto.CompactTextString(m) }
+func (*CdpSavingsStrategyParams) ProtoMessage()    {}
+func (*CdpSavingsStrategyParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9183aa7b63d72704, []int{5}
+}
+func (m *CdpSavingsStrategyParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CdpSavingsStrategyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CdpSavingsStrategyParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CdpSavingsStrategyParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CdpSavingsStrategyParams.Merge(m, src)
+}
+func (m *CdpSavingsStrategyParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *CdpSavingsStrategyParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_CdpSavingsStrategyParams.DiscardUnknown(m)
 }
 
-func (m *AllowedVault) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CdpSavingsStrategyParams proto.InternalMessageInfo
+
+func (m *CdpSavingsStrategyParams) GetCollateralType() string {
+	if m != nil {
+		return m.CollateralType
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *AllowedVault) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CdpSavingsDeposit is the amount of debt asset a vault using the CDP savings
+// strategy holds in savings.
+type CdpSavingsDeposit struct {
+	VaultDenom string     `protobuf:"bytes,1,opt,name=vault_denom,json=vaultDenom,proto3" json:"vault_denom,omitempty"`
+	Amount     types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *AllowedVault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AllowedDepositors) > 0 {
-		for iNdEx := len(m.AllowedDepositors) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AllowedDepositors[iNdEx])
-			copy(dAtA[i:], m.AllowedDepositors[iNdEx])
-			i = encodeVarintVault(dAtA, i, uint64(len(m.AllowedDepositors[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.IsPrivateVault {
-		i--
-		if m.IsPrivateVault {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Strategies) > 0 {
-		dAtA2 := make([]byte, len(m.Strategies)*10)
-		var j1 int
-		for _, num := range m.Strategies {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
+func (m *CdpSavingsDeposit) Reset()         { *m = CdpSavingsDeposit{} }
+func (m *CdpSavingsDeposit) String() string { return proto.CompactTextString(m) }
+func (*CdpSavingsDeposit) ProtoMessage()    {}
+func (*CdpSavingsDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9183aa7b63d72704, []int{6}
+}
+func (m *CdpSavingsDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CdpSavingsDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CdpSavingsDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintVault(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *CdpSavingsDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CdpSavingsDeposit.Merge(m, src)
+}
+func (m *CdpSavingsDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *CdpSavingsDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_CdpSavingsDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CdpSavingsDeposit proto.InternalMessageInfo
+
+func (m *CdpSavingsDeposit) GetVaultDenom() string {
+	if m != nil {
+		return m.VaultDenom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *VaultRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CdpSavingsDeposit) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *VaultRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SwapLPResidual is the vault denom and paired denom a vault using the swap LP
+// strategy holds in the earn module account, left over from pool deposits and
+// withdrawals that could not use or swap back the exact amounts.
+type SwapLPResidual struct {
+	VaultDenom string                                   `protobuf:"bytes,1,opt,name=vault_denom,json=vaultDenom,proto3" json:"vault_denom,omitempty"`
+	Amount     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func (m *VaultRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
+func (m *SwapLPResidual) Reset()         { *m = SwapLPResidual{} }
+func (m *SwapLPResidual) String() string { return proto.CompactTextString(m) }
+func (*SwapLPResidual) ProtoMessage()    {}
+func (*SwapLPResidual) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9183aa7b63d72704, []int{7}
+}
+func (m *SwapLPResidual) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapLPResidual) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapLPResidual.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintVault(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *SwapLPResidual) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapLPResidual.Merge(m, src)
+}
+func (m *SwapLPResidual) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapLPResidual) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapLPResidual.DiscardUnknown(m)
 }
 
-func (m *VaultShareRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SwapLPResidual proto.InternalMessageInfo
+
+func (m *SwapLPResidual) GetVaultDenom() string {
+	if m != nil {
+		return m.VaultDenom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *VaultShareRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SwapLPResidual) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
 }
 
-func (m *VaultShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Shares) > 0 {
-		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintVault(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+// VaultRewardsRecord is the incentive rewards claimed for a vault that are
+// waiting to be compounded.
+type VaultRewardsRecord struct {
+	VaultDenom string                                   `protobuf:"bytes,1,opt,name=vault_denom,json=vaultDenom,proto3" json:"vault_denom,omitempty"`
+	Rewards    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *VaultRewardsRecord) Reset()         { *m = VaultRewardsRecord{} }
+func (m *VaultRewardsRecord) String() string { return proto.CompactTextString(m) }
+func (*VaultRewardsRecord) ProtoMessage()    {}
+func (*VaultRewardsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9183aa7b63d72704, []int{8}
+}
+func (m *VaultRewardsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultRewardsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultRewardsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.Depositor)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *VaultRewardsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultRewardsRecord.Merge(m, src)
+}
+func (m *VaultRewardsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultRewardsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultRewardsRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultRewardsRecord proto.InternalMessageInfo
+
+func (m *VaultRewardsRecord) GetVaultDenom() string {
+	if m != nil {
+		return m.VaultDenom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *VaultShare) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VaultRewardsRecord) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *VaultShare) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// VaultFeeRecord is the fee state of a vault that charges fees.
+type VaultFeeRecord struct {
+	VaultDenom string `protobuf:"bytes,1,opt,name=vault_denom,json=vaultDenom,proto3" json:"vault_denom,omitempty"`
+	// HighWaterMark is the highest share price performance fees have been
+	// charged at. Performance fees are only charged on growth above it.
+	HighWaterMark github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=high_water_mark,json=highWaterMark,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"high_water_mark"`
+	// AccruedPerformanceFees is the total number of shares paid as
+	// performance fees.
+	AccruedPerformanceFees github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=accrued_performance_fees,json=accruedPerformanceFees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"accrued_performance_fees"`
+	// AccruedWithdrawalFees is the total number of shares paid as withdrawal
+	// fees.
+	AccruedWithdrawalFees github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=accrued_withdrawal_fees,json=accruedWithdrawalFees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"accrued_withdrawal_fees"`
 }
 
-func (m *VaultShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *VaultFeeRecord) Reset()         { *m = VaultFeeRecord{} }
+func (m *VaultFeeRecord) String() string { return proto.CompactTextString(m) }
+func (*VaultFeeRecord) ProtoMessage()    {}
+func (*VaultFeeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9183aa7b63d72704, []int{9}
+}
+func (m *VaultFeeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultFeeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultFeeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintVault(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintVault(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *VaultFeeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultFeeRecord.Merge(m, src)
+}
+func (m *VaultFeeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultFeeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultFeeRecord.DiscardUnknown(m)
 }
 
-func encodeVarintVault(dAtA []byte, offset int, v uint64) int {
-	offset -= sovVault(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_VaultFeeRecord proto.InternalMessageInfo
+
+func (m *VaultFeeRecord) GetVaultDenom() string {
+	if m != nil {
+		return m.VaultDenom
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *AllowedVault) Size() (n int) {
-	if m == nil {
-		return 0
+
+// VaultSharePriceSnapshot is the share price of a vault at a block.
+type VaultSharePriceSnapshot struct {
+	VaultDenom string    `protobuf:"bytes,1,opt,name=vault_denom,json=vaultDenom,proto3" json:"vault_denom,omitempty"`
+	Height     int64     `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Time       time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// SharePrice is the value of one vault share.
+	SharePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=share_price,json=sharePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"share_price"`
+}
+
+func (m *VaultSharePriceSnapshot) Reset()         { *m = VaultSharePriceSnapshot{} }
+func (m *VaultSharePriceSnapshot) String() string { return proto.CompactTextString(m) }
+func (*VaultSharePriceSnapshot) ProtoMessage()    {}
+func (*VaultSharePriceSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9183aa7b63d72704, []int{10}
+}
+func (m *VaultSharePriceSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultSharePriceSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultSharePriceSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovVault(uint64(l))
+}
+func (m *VaultSharePriceSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultSharePriceSnapshot.Merge(m, src)
+}
+func (m *VaultSharePriceSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultSharePriceSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultSharePriceSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultSharePriceSnapshot proto.InternalMessageInfo
+
+func (m *VaultSharePriceSnapshot) GetVaultDenom() string {
+	if m != nil {
+		return m.VaultDenom
 	}
-	if len(m.Strategies) > 0 {
-		l = 0
-		for _, e := range m.Strategies {
-			l += sovVault(uint64(e))
-		}
-		n += 1 + sovVault(uint64(l)) + l
+	return ""
+}
+
+func (m *VaultSharePriceSnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if m.IsPrivateVault {
-		n += 2
+	return 0
+}
+
+func (m *VaultSharePriceSnapshot) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	if len(m.AllowedDepositors) > 0 {
-		for _, b := range m.AllowedDepositors {
-			l = len(b)
-			n += 1 + l + sovVault(uint64(l))
+	return time.Time{}
+}
+
+// VaultRecord is the state of a vault.
+type VaultRecord struct {
+	// TotalShares is the total distributed number of shares in the vault.
+	TotalShares VaultShare `protobuf:"bytes,1,opt,name=total_shares,json=totalShares,proto3" json:"total_shares"`
+	// Allocations are the assets held by each strategy of the vault as of the
+	// last deposit, withdrawal, or rebalance.
+	Allocations StrategyAllocations `protobuf:"bytes,2,rep,name=allocations,proto3,castrepeated=StrategyAllocations" json:"allocations"`
+}
+
+func (m *VaultRecord) Reset()         { *m = VaultRecord{} }
+func (m *VaultRecord) String() string { return proto.CompactTextString(m) }
+func (*VaultRecord) ProtoMessage()    {}
+func (*VaultRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9183aa7b63d72704, []int{11}
+}
+func (m *VaultRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *VaultRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultRecord.Merge(m, src)
+}
+func (m *VaultRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultRecord.DiscardUnknown(m)
 }
 
-func (m *VaultRecord) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_VaultRecord proto.InternalMessageInfo
+
+func (m *VaultRecord) GetTotalShares() VaultShare {
+	if m != nil {
+		return m.TotalShares
 	}
-	var l int
-	_ = l
-	l = m.TotalShares.Size()
-	n += 1 + l + sovVault(uint64(l))
-	return n
+	return VaultShare{}
 }
 
-func (m *VaultShareRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovVault(uint64(l))
+func (m *VaultRecord) GetAllocations() StrategyAllocations {
+	if m != nil {
+		return m.Allocations
 	}
-	if len(m.Shares) > 0 {
-		for _, e := range m.Shares {
-			l = e.Size()
-			n += 1 + l + sovVault(uint64(l))
+	return nil
+}
+
+// StrategyAllocation defines the assets of a vault held by a strategy.
+type StrategyAllocation struct {
+	Strategy StrategyType                           `protobuf:"varint,1,opt,name=strategy,proto3,enum=fury.earn.v1beta1.StrategyType" json:"strategy,omitempty"`
+	Amount   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *StrategyAllocation) Reset()         { *m = StrategyAllocation{} }
+func (m *StrategyAllocation) String() string { return proto.CompactTextString(m) }
+func (*StrategyAllocation) ProtoMessage()    {}
+func (*StrategyAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9183aa7b63d72704, []int{12}
+}
+func (m *StrategyAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StrategyAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StrategyAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *StrategyAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StrategyAllocation.Merge(m, src)
+}
+func (m *StrategyAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *StrategyAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_StrategyAllocation.DiscardUnknown(m)
 }
 
-func (m *VaultShare) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_StrategyAllocation proto.InternalMessageInfo
+
+func (m *StrategyAllocation) GetStrategy() StrategyType {
+	if m != nil {
+		return m.Strategy
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovVault(uint64(l))
+	return STRATEGY_TYPE_UNSPECIFIED
+}
+
+// VaultShareRecord defines the vault shares owned by a depositor.
+type VaultShareRecord struct {
+	// Depositor represents the owner of the shares
+	Depositor github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=depositor,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"depositor,omitempty"`
+	// Shares represent the vault shares owned by the depositor.
+	Shares VaultShares `protobuf:"bytes,2,rep,name=shares,proto3,castrepeated=VaultShares" json:"shares"`
+}
+
+func (m *VaultShareRecord) Reset()         { *m = VaultShareRecord{} }
+func (m *VaultShareRecord) String() string { return proto.CompactTextString(m) }
+func (*VaultShareRecord) ProtoMessage()    {}
+func (*VaultShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9183aa7b63d72704, []int{13}
+}
+func (m *VaultShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultShareRecord.Merge(m, src)
+}
+func (m *VaultShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultShareRecord proto.InternalMessageInfo
+
+func (m *VaultShareRecord) GetDepositor() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Depositor
+	}
+	return nil
+}
+
+func (m *VaultShareRecord) GetShares() VaultShares {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+// VaultShare defines shares of a vault owned by a depositor.
+type VaultShare struct {
+	Denom  string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"amount"`
+}
+
+func (m *VaultShare) Reset()      { *m = VaultShare{} }
+func (*VaultShare) ProtoMessage() {}
+func (*VaultShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9183aa7b63d72704, []int{14}
+}
+func (m *VaultShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultShare.Merge(m, src)
+}
+func (m *VaultShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultShare proto.InternalMessageInfo
+
+func (m *VaultShare) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*AllowedVault)(nil), "fury.earn.v1beta1.AllowedVault")
+	proto.RegisterType((*VaultSnapshotParams)(nil), "fury.earn.v1beta1.VaultSnapshotParams")
+	proto.RegisterType((*VaultFeeParams)(nil), "fury.earn.v1beta1.VaultFeeParams")
+	proto.RegisterType((*AutoCompoundParams)(nil), "fury.earn.v1beta1.AutoCompoundParams")
+	proto.RegisterType((*SwapLPStrategyParams)(nil), "fury.earn.v1beta1.SwapLPStrategyParams")
+	proto.RegisterType((*CdpSavingsStrategyParams)(nil), "fury.earn.v1beta1.CdpSavingsStrategyParams")
+	proto.RegisterType((*CdpSavingsDeposit)(nil), "fury.earn.v1beta1.CdpSavingsDeposit")
+	proto.RegisterType((*SwapLPResidual)(nil), "fury.earn.v1beta1.SwapLPResidual")
+	proto.RegisterType((*VaultRewardsRecord)(nil), "fury.earn.v1beta1.VaultRewardsRecord")
+	proto.RegisterType((*VaultFeeRecord)(nil), "fury.earn.v1beta1.VaultFeeRecord")
+	proto.RegisterType((*VaultSharePriceSnapshot)(nil), "fury.earn.v1beta1.VaultSharePriceSnapshot")
+	proto.RegisterType((*VaultRecord)(nil), "fury.earn.v1beta1.VaultRecord")
+	proto.RegisterType((*StrategyAllocation)(nil), "fury.earn.v1beta1.StrategyAllocation")
+	proto.RegisterType((*VaultShareRecord)(nil), "fury.earn.v1beta1.VaultShareRecord")
+	proto.RegisterType((*VaultShare)(nil), "fury.earn.v1beta1.VaultShare")
+}
+
+func init() { proto.RegisterFile("fury/earn/v1beta1/vault.proto", fileDescriptor_9183aa7b63d72704) }
+
+var fileDescriptor_9183aa7b63d72704 = []byte{
+	// 1278 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xc6, 0x69, 0x9a, 0x3c, 0xbb, 0x4e, 0x32, 0x09, 0xad, 0x1b, 0x54, 0xdb, 0xb5, 0xd4,
+	0xd6, 0x12, 0xc4, 0xa6, 0xe1, 0x00, 0x02, 0x24, 0x88, 0x1b, 0x45, 0x14, 0x15, 0x11, 0x6d, 0x2b,
+	0x22, 0x90, 0xd0, 0x6a, 0xb2, 0x3b, 0xb6, 0x47, 0x59, 0xef, 0xac, 0x66, 0x66, 0x63, 0xc2, 0x81,
+	0x1b, 0xf7, 0x5e, 0x40, 0x45, 0x42, 0xc0, 0x0d, 0xa9, 0xe2, 0xd8, 0x1b, 0x77, 0x54, 0x89, 0x4b,
+	0xd5, 0x13, 0xe2, 0x90, 0xa2, 0xf4, 0xc8, 0x7f, 0xc0, 0x09, 0xcd, 0xc7, 0xfa, 0xa3, 0x76, 0x9a,
+	0xa0, 0x1a, 0x2e, 0x89, 0xe7, 0xcd, 0x9b, 0xdf, 0xef, 0xed, 0xfb, 0x9a, 0x37, 0x70, 0xa9, 0x99,
+	0xf0, 0x83, 0x3a, 0xc1, 0x3c, 0xaa, 0xef, 0x5f, 0xdf, 0x25, 0x12, 0x5f, 0xaf, 0xef, 0xe3, 0x24,
+	0x94, 0xb5, 0x98, 0x33, 0xc9, 0xd0, 0x92, 0xda, 0xae, 0xa9, 0xed, 0x9a, 0xdd, 0x5e, 0x2d, 0xfa,
+	0x4c, 0x74, 0x98, 0xa8, 0xef, 0x62, 0x41, 0x7a, 0x67, 0x7c, 0x46, 0x23, 0x73, 0x64, 0xf5, 0xa2,
+	0xd9, 0xf7, 0xf4, 0xaa, 0x6e, 0x16, 0x76, 0xab, 0x3c, 0x4a, 0x26, 0x24, 0xc7, 0x92, 0xb4, 0x0e,
+	0xac, 0xc6, 0x4a, 0x8b, 0xb5, 0x98, 0x39, 0xa9, 0x7e, 0x59, 0x69, 0xb1, 0xc5, 0x58, 0x2b, 0x24,
+	0x75, 0xbd, 0xda, 0x4d, 0x9a, 0xf5, 0x20, 0xe1, 0x58, 0x52, 0x96, 0x52, 0x96, 0x9e, 0xdd, 0x97,
+	0xb4, 0x43, 0x84, 0xc4, 0x9d, 0xd8, 0x28, 0x54, 0x7e, 0x9e, 0x85, 0xdc, 0x46, 0x18, 0xb2, 0x2e,
+	0x09, 0x3e, 0x56, 0x5f, 0x87, 0x56, 0xe0, 0x4c, 0x40, 0x22, 0xd6, 0x29, 0x38, 0x65, 0xa7, 0x3a,
+	0xef, 0x9a, 0x05, 0x72, 0x01, 0xac, 0x3d, 0x94, 0x88, 0xc2, 0x74, 0x39, 0x53, 0xcd, 0xaf, 0x97,
+	0x6a, 0x23, 0x2e, 0xa8, 0xdd, 0xb6, 0x46, 0xdf, 0x39, 0x88, 0x49, 0x63, 0xe9, 0xfe, 0x93, 0xd2,
+	0xb9, 0x41, 0x89, 0x70, 0x07, 0x50, 0x50, 0x15, 0x16, 0xa9, 0x72, 0x06, 0xdd, 0xc7, 0x92, 0x78,
+	0xda, 0xb7, 0x85, 0x4c, 0xd9, 0xa9, 0xce, 0xb9, 0x79, 0x2a, 0xb6, 0x8d, 0xd8, 0xd8, 0xd4, 0x05,
+	0x84, 0x8d, 0x8d, 0x5e, 0x40, 0x62, 0x26, 0xa8, 0x64, 0x5c, 0x14, 0x66, 0xca, 0x99, 0x6a, 0xae,
+	0xf1, 0xfe, 0xdf, 0x87, 0xa5, 0xb5, 0x16, 0x95, 0xed, 0x64, 0xb7, 0xe6, 0xb3, 0x8e, 0x75, 0xab,
+	0xfd, 0xb7, 0x26, 0x82, 0xbd, 0xba, 0x54, 0xcc, 0xb5, 0x0d, 0xdf, 0xdf, 0x08, 0x02, 0x4e, 0x84,
+	0x78, 0xfc, 0x60, 0x6d, 0xd9, 0x3a, 0xdf, 0x4a, 0x1a, 0x07, 0x92, 0x08, 0x77, 0xc9, 0x72, 0x6c,
+	0xf6, 0x28, 0x50, 0x0b, 0x16, 0xd3, 0x30, 0x78, 0x5d, 0x42, 0x5b, 0x6d, 0x29, 0x0a, 0x67, 0xca,
+	0x99, 0xea, 0x7c, 0xe3, 0x9d, 0x87, 0x87, 0xa5, 0xa9, 0x3f, 0x0e, 0x4b, 0x57, 0x4f, 0x41, 0xbd,
+	0x49, 0xfc, 0xc7, 0x0f, 0xd6, 0xc0, 0x72, 0x6e, 0x12, 0xdf, 0x5d, 0x48, 0x51, 0x77, 0x0c, 0x28,
+	0xf2, 0x20, 0x2f, 0xba, 0x38, 0xf6, 0xc2, 0xd8, 0x8b, 0x31, 0xc7, 0x1d, 0x51, 0x98, 0x2d, 0x3b,
+	0xd5, 0xec, 0xfa, 0xb5, 0x71, 0x3e, 0xee, 0xe2, 0xf8, 0xd6, 0x76, 0xea, 0xd7, 0x6d, 0xad, 0xde,
+	0x58, 0x3c, 0x3a, 0x2c, 0xe5, 0xcc, 0x8e, 0x91, 0xb8, 0x39, 0x05, 0x78, 0x2b, 0x36, 0x2b, 0xf4,
+	0x09, 0x20, 0x3f, 0x88, 0x3d, 0x81, 0xf7, 0x69, 0xd4, 0x12, 0x29, 0xc9, 0x59, 0x4d, 0xf2, 0xca,
+	0x18, 0x92, 0x1b, 0x41, 0x7c, 0xdb, 0xe8, 0x0e, 0x13, 0xb9, 0x8b, 0x7e, 0x6f, 0xc7, 0x42, 0xef,
+	0xc0, 0x0a, 0x4e, 0x24, 0xf3, 0x7c, 0xd6, 0x89, 0x59, 0x12, 0x05, 0x29, 0xf8, 0x9c, 0x06, 0xbf,
+	0x32, 0x06, 0x7c, 0x23, 0x91, 0xec, 0x86, 0xd5, 0xb6, 0xb0, 0x08, 0x8f, 0xc8, 0xd0, 0x7b, 0x00,
+	0x4d, 0x42, 0x52, 0xb8, 0x79, 0x0d, 0x77, 0x79, 0x0c, 0x9c, 0x4e, 0x92, 0x2d, 0x42, 0x2c, 0xd4,
+	0x7c, 0x33, 0xfd, 0x89, 0x3e, 0x82, 0x05, 0x11, 0xe1, 0x58, 0xb4, 0x99, 0x4c, 0x61, 0x40, 0xc3,
+	0x5c, 0x3d, 0x0e, 0xe6, 0xb6, 0x55, 0xb7, 0x58, 0x79, 0x31, 0xb4, 0xae, 0x7c, 0xeb, 0xc0, 0xf2,
+	0x18, 0x3d, 0xf4, 0x2e, 0xcc, 0xd1, 0x48, 0x12, 0xbe, 0x8f, 0x43, 0x5d, 0x38, 0xd9, 0xf5, 0x8b,
+	0x35, 0x53, 0x7a, 0xb5, 0xb4, 0xf4, 0x6a, 0x9b, 0xb6, 0x34, 0x1b, 0x73, 0x2a, 0x77, 0xee, 0x3d,
+	0x29, 0x39, 0x6e, 0xef, 0x10, 0xda, 0x80, 0x79, 0x4e, 0x24, 0x89, 0x94, 0x42, 0x61, 0xfa, 0xf4,
+	0x08, 0xfd, 0x53, 0x95, 0xdf, 0xa6, 0x21, 0x3f, 0xec, 0x0a, 0x44, 0x60, 0x21, 0x26, 0xbc, 0xc9,
+	0x78, 0x07, 0x47, 0x3e, 0xf1, 0x9a, 0x84, 0x98, 0xb2, 0x7e, 0xc1, 0xf4, 0xcd, 0x0f, 0x80, 0x6e,
+	0x11, 0x82, 0x7c, 0xc8, 0x77, 0xa9, 0x6c, 0x07, 0x1c, 0x77, 0x71, 0xa8, 0x59, 0xa6, 0x27, 0xc0,
+	0x72, 0xae, 0x8f, 0xa9, 0x48, 0x3a, 0x70, 0x4e, 0x65, 0x03, 0x27, 0x3e, 0x8d, 0x29, 0x89, 0x4c,
+	0xaf, 0x98, 0x64, 0xfd, 0xe7, 0x9a, 0x84, 0xb8, 0x29, 0x7a, 0xe5, 0x6b, 0x07, 0xd0, 0x68, 0x9e,
+	0xa2, 0xd5, 0x67, 0x02, 0x9d, 0x19, 0x88, 0xa1, 0x0f, 0x79, 0x11, 0xd2, 0x38, 0xc6, 0x2d, 0xe2,
+	0x85, 0xb4, 0x43, 0xe5, 0x64, 0xdc, 0x90, 0x62, 0xde, 0x52, 0x90, 0x95, 0xef, 0x1d, 0x58, 0x19,
+	0xd7, 0x01, 0xd0, 0x65, 0xc8, 0xc5, 0x98, 0x72, 0xdd, 0x23, 0xfb, 0xfd, 0x3b, 0x6b, 0x64, 0x9b,
+	0x4a, 0xf4, 0xff, 0x18, 0xf8, 0xc3, 0x34, 0x14, 0x8e, 0xeb, 0x1e, 0xe8, 0x1a, 0x2c, 0xf8, 0x2c,
+	0x0c, 0xb1, 0x24, 0x1c, 0x87, 0x9e, 0x42, 0xb4, 0x76, 0xe6, 0xfb, 0x62, 0x75, 0x53, 0xa0, 0x04,
+	0x2e, 0xf4, 0x25, 0xf4, 0x0b, 0x9d, 0xf6, 0x9e, 0xce, 0xfe, 0x89, 0xd8, 0x7c, 0x7e, 0x04, 0xdc,
+	0x55, 0x7f, 0xc7, 0x78, 0x28, 0x33, 0x79, 0x0f, 0x75, 0x60, 0xa9, 0xef, 0x20, 0x7b, 0xdb, 0xa0,
+	0x12, 0x64, 0xf5, 0x15, 0x38, 0x14, 0x3d, 0xd0, 0x22, 0x13, 0xbc, 0x37, 0x60, 0x16, 0x77, 0x58,
+	0x12, 0xc9, 0x5e, 0x7b, 0xb0, 0x0c, 0x6a, 0xdc, 0xe8, 0xf7, 0x6d, 0x46, 0xa3, 0xc6, 0x8c, 0xb2,
+	0xd6, 0xb5, 0xea, 0x95, 0x6f, 0x1c, 0xc8, 0x9b, 0x8c, 0x71, 0x89, 0xa0, 0x41, 0x82, 0xc3, 0x93,
+	0xc9, 0xfc, 0x01, 0xb2, 0xcc, 0xf3, 0xc9, 0x5e, 0x53, 0x64, 0xf7, 0x9f, 0x94, 0xaa, 0xa7, 0x70,
+	0x8d, 0x3a, 0x20, 0x7a, 0x86, 0x7d, 0xe7, 0x00, 0xd2, 0x0d, 0xcb, 0x25, 0x5d, 0xcc, 0x03, 0xe1,
+	0x12, 0x9f, 0xf1, 0xe0, 0x64, 0xe3, 0x08, 0x9c, 0xe5, 0xe6, 0xc4, 0x7f, 0x61, 0x5d, 0x8a, 0x5d,
+	0xf9, 0x2a, 0xd3, 0xef, 0xa7, 0xa7, 0x35, 0x2d, 0x80, 0x85, 0x36, 0x6d, 0xb5, 0xbd, 0xae, 0xca,
+	0x2d, 0xaf, 0x83, 0xf9, 0xde, 0x64, 0x4a, 0x4c, 0x81, 0xee, 0x28, 0xcc, 0x0f, 0x31, 0xdf, 0x43,
+	0xfb, 0x50, 0xc0, 0xbe, 0xcf, 0x13, 0x12, 0x78, 0xcf, 0xb4, 0x77, 0x31, 0x91, 0x7c, 0x3d, 0x6f,
+	0xd1, 0xb7, 0x87, 0xda, 0xbc, 0x40, 0x12, 0x2e, 0xa4, 0xbc, 0xc3, 0xfd, 0x5e, 0x0d, 0x63, 0x2f,
+	0x4e, 0xfb, 0x92, 0x05, 0xdf, 0x19, 0xec, 0xfb, 0xa2, 0xf2, 0x97, 0x03, 0x17, 0xcc, 0x9d, 0xdb,
+	0xc6, 0x9c, 0x6c, 0x73, 0xea, 0x93, 0xf4, 0xf6, 0x3d, 0x39, 0x20, 0xe7, 0x61, 0xb6, 0xad, 0x67,
+	0x2c, 0x1d, 0x87, 0x8c, 0x6b, 0x57, 0xe8, 0x4d, 0x98, 0x51, 0xa3, 0xb0, 0x76, 0x57, 0x76, 0x7d,
+	0x75, 0xe4, 0xaa, 0xbd, 0x93, 0xce, 0xc9, 0xe6, 0xae, 0xbd, 0xab, 0xee, 0x5a, 0x7d, 0x02, 0x7d,
+	0x06, 0x59, 0xa1, 0x0c, 0x51, 0x93, 0xab, 0x4f, 0x26, 0xf2, 0xe1, 0x20, 0x7a, 0x5f, 0x56, 0xf9,
+	0xc5, 0x81, 0xac, 0x2d, 0x0a, 0x9d, 0x72, 0x5b, 0x90, 0x93, 0x4c, 0xe2, 0xd0, 0xd3, 0x3a, 0xc2,
+	0x4e, 0x17, 0x97, 0x8e, 0x9d, 0x5f, 0x94, 0x96, 0x6d, 0x00, 0x59, 0x7d, 0x50, 0x4b, 0x04, 0x0a,
+	0x20, 0xab, 0xe6, 0x5b, 0x5f, 0x37, 0xbb, 0xb4, 0x70, 0xae, 0x3c, 0x67, 0x84, 0xdf, 0xe8, 0x69,
+	0x37, 0x5e, 0xb6, 0x45, 0xb4, 0x3c, 0xba, 0x27, 0xdc, 0x41, 0xd8, 0xca, 0x4f, 0x0e, 0xa0, 0x51,
+	0x25, 0xf4, 0x36, 0xcc, 0xa5, 0x13, 0xaf, 0xfe, 0x80, 0x93, 0x1f, 0x0f, 0x6e, 0xef, 0x00, 0xba,
+	0x33, 0xd4, 0xf8, 0xfe, 0x9d, 0xaf, 0x6f, 0x46, 0x72, 0xc0, 0xd7, 0x37, 0x23, 0xd9, 0x6b, 0x3e,
+	0xbf, 0x3a, 0xb0, 0xd8, 0xf7, 0x98, 0x75, 0x76, 0x13, 0xe6, 0x7b, 0x0f, 0x8c, 0x82, 0x33, 0xe1,
+	0xf9, 0xa2, 0x0f, 0x8d, 0x3e, 0x80, 0x59, 0x1b, 0x4e, 0x13, 0x87, 0x13, 0xc2, 0xb9, 0x6c, 0xfd,
+	0x9f, 0xed, 0xcb, 0x84, 0x6b, 0x11, 0x2a, 0x5f, 0x02, 0xf4, 0xc5, 0xc7, 0x3c, 0xdf, 0x5e, 0xc4,
+	0x85, 0xa3, 0xe9, 0x6a, 0xb1, 0xde, 0x9a, 0xb9, 0xf7, 0x63, 0x69, 0xaa, 0xb1, 0xf5, 0xf0, 0xa8,
+	0xe8, 0x3c, 0x3a, 0x2a, 0x3a, 0x7f, 0x1e, 0x15, 0x9d, 0xbb, 0x4f, 0x8b, 0x53, 0x8f, 0x9e, 0x16,
+	0xa7, 0x7e, 0x7f, 0x5a, 0x9c, 0xfa, 0xf4, 0xd5, 0x01, 0x74, 0x1a, 0xf9, 0xc9, 0x6e, 0x22, 0xd6,
+	0x22, 0x22, 0xbb, 0x8c, 0xef, 0xd5, 0xf5, 0x7b, 0xf7, 0x73, 0xf3, 0xe2, 0xd5, 0x3c, 0xbb, 0xb3,
+	0xba, 0xf6, 0x5e, 0xff, 0x67, 0x00, 0x2d, 0xa9, 0xb5, 0x0a, 0x78, 0x0f, 0x00, 0x00,
+}
+
+func (m *AllowedVault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedVault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedVault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SnapshotParams != nil {
+		{
+			size, err := m.SnapshotParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVault(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.FeeParams != nil {
+		{
+			size, err := m.FeeParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVault(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.AutoCompoundParams != nil {
+		{
+			size, err := m.AutoCompoundParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVault(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.CdpSavingsParams != nil {
+		{
+			size, err := m.CdpSavingsParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVault(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SwapLPParams != nil {
+		{
+			size, err := m.SwapLPParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVault(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.StrategyWeights) > 0 {
+		for iNdEx := len(m.StrategyWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.StrategyWeights[iNdEx].Size()
+				i -= size
+				if _, err := m.StrategyWeights[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintVault(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AllowedDepositors) > 0 {
+		for iNdEx := len(m.AllowedDepositors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDepositors[iNdEx])
+			copy(dAtA[i:], m.AllowedDepositors[iNdEx])
+			i = encodeVarintVault(dAtA, i, uint64(len(m.AllowedDepositors[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.IsPrivateVault {
+		i--
+		if m.IsPrivateVault {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Strategies) > 0 {
+		dAtA7 := make([]byte, len(m.Strategies)*10)
+		var j6 int
+		for _, num := range m.Strategies {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintVault(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultSnapshotParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultSnapshotParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultSnapshotParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Retention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Retention):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintVault(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x12
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintVault(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultFeeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultFeeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultFeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeRecipient) > 0 {
+		i -= len(m.FeeRecipient)
+		copy(dAtA[i:], m.FeeRecipient)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.FeeRecipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.WithdrawalFee.Size()
+		i -= size
+		if _, err := m.WithdrawalFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.PerformanceFee.Size()
+		i -= size
+		if _, err := m.PerformanceFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoCompoundParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlippageLimit.Size()
+		i -= size
+		if _, err := m.SlippageLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Interval != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapLPStrategyParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapLPStrategyParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapLPStrategyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlippageLimit.Size()
+		i -= size
+		if _, err := m.SlippageLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PairedDenom) > 0 {
+		i -= len(m.PairedDenom)
+		copy(dAtA[i:], m.PairedDenom)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.PairedDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CdpSavingsStrategyParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CdpSavingsStrategyParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CdpSavingsStrategyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlippageLimit.Size()
+		i -= size
+		if _, err := m.SlippageLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CollateralizationRatio.Size()
+		i -= size
+		if _, err := m.CollateralizationRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CdpSavingsDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CdpSavingsDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CdpSavingsDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.VaultDenom) > 0 {
+		i -= len(m.VaultDenom)
+		copy(dAtA[i:], m.VaultDenom)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.VaultDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapLPResidual) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapLPResidual) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapLPResidual) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVault(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.VaultDenom) > 0 {
+		i -= len(m.VaultDenom)
+		copy(dAtA[i:], m.VaultDenom)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.VaultDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultRewardsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultRewardsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVault(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.VaultDenom) > 0 {
+		i -= len(m.VaultDenom)
+		copy(dAtA[i:], m.VaultDenom)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.VaultDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultFeeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultFeeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultFeeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AccruedWithdrawalFees.Size()
+		i -= size
+		if _, err := m.AccruedWithdrawalFees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.AccruedPerformanceFees.Size()
+		i -= size
+		if _, err := m.AccruedPerformanceFees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.HighWaterMark.Size()
+		i -= size
+		if _, err := m.HighWaterMark.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.VaultDenom) > 0 {
+		i -= len(m.VaultDenom)
+		copy(dAtA[i:], m.VaultDenom)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.VaultDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultSharePriceSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultSharePriceSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultSharePriceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SharePrice.Size()
+		i -= size
+		if _, err := m.SharePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintVault(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.VaultDenom) > 0 {
+		i -= len(m.VaultDenom)
+		copy(dAtA[i:], m.VaultDenom)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.VaultDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allocations) > 0 {
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVault(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StrategyAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StrategyAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StrategyAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Strategy != 0 {
+		i = encodeVarintVault(dAtA, i, uint64(m.Strategy))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVault(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVault(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVault(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AllowedVault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if len(m.Strategies) > 0 {
+		l = 0
+		for _, e := range m.Strategies {
+			l += sovVault(uint64(e))
+		}
+		n += 1 + sovVault(uint64(l)) + l
+	}
+	if m.IsPrivateVault {
+		n += 2
+	}
+	if len(m.AllowedDepositors) > 0 {
+		for _, b := range m.AllowedDepositors {
+			l = len(b)
+			n += 1 + l + sovVault(uint64(l))
+		}
+	}
+	if len(m.StrategyWeights) > 0 {
+		for _, e := range m.StrategyWeights {
+			l = e.Size()
+			n += 1 + l + sovVault(uint64(l))
+		}
+	}
+	if m.SwapLPParams != nil {
+		l = m.SwapLPParams.Size()
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if m.CdpSavingsParams != nil {
+		l = m.CdpSavingsParams.Size()
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if m.AutoCompoundParams != nil {
+		l = m.AutoCompoundParams.Size()
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if m.FeeParams != nil {
+		l = m.FeeParams.Size()
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if m.SnapshotParams != nil {
+		l = m.SnapshotParams.Size()
+		n += 1 + l + sovVault(uint64(l))
+	}
+	return n
+}
+
+func (m *VaultSnapshotParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovVault(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Retention)
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func (m *VaultFeeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PerformanceFee.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = m.WithdrawalFee.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = len(m.FeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	return n
+}
+
+func (m *AutoCompoundParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Interval != 0 {
+		n += 1 + sovVault(uint64(m.Interval))
+	}
+	l = m.SlippageLimit.Size()
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func (m *SwapLPStrategyParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PairedDenom)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = m.SlippageLimit.Size()
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func (m *CdpSavingsStrategyParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = m.CollateralizationRatio.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = m.SlippageLimit.Size()
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func (m *CdpSavingsDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VaultDenom)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func (m *SwapLPResidual) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VaultDenom)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovVault(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VaultRewardsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VaultDenom)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovVault(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VaultFeeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VaultDenom)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = m.HighWaterMark.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = m.AccruedPerformanceFees.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = m.AccruedWithdrawalFees.Size()
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func (m *VaultSharePriceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VaultDenom)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovVault(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovVault(uint64(l))
+	l = m.SharePrice.Size()
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func (m *VaultRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalShares.Size()
+	n += 1 + l + sovVault(uint64(l))
+	if len(m.Allocations) > 0 {
+		for _, e := range m.Allocations {
+			l = e.Size()
+			n += 1 + l + sovVault(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StrategyAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Strategy != 0 {
+		n += 1 + sovVault(uint64(m.Strategy))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func (m *VaultShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovVault(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VaultShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func sovVault(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVault(x uint64) (n int) {
+	return sovVault(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AllowedVault) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedVault: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedVault: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v StrategyType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowVault
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= StrategyType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Strategies = append(m.Strategies, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowVault
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthVault
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthVault
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Strategies) == 0 {
+					m.Strategies = make([]StrategyType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v StrategyType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowVault
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= StrategyType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Strategies = append(m.Strategies, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategies", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPrivateVault", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPrivateVault = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDepositors", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDepositors = append(m.AllowedDepositors, make([]byte, postIndex-iNdEx))
+			copy(m.AllowedDepositors[len(m.AllowedDepositors)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategyWeights", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.StrategyWeights = append(m.StrategyWeights, v)
+			if err := m.StrategyWeights[len(m.StrategyWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapLPParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SwapLPParams == nil {
+				m.SwapLPParams = &SwapLPStrategyParams{}
+			}
+			if err := m.SwapLPParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CdpSavingsParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CdpSavingsParams == nil {
+				m.CdpSavingsParams = &CdpSavingsStrategyParams{}
+			}
+			if err := m.CdpSavingsParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoCompoundParams == nil {
+				m.AutoCompoundParams = &AutoCompoundParams{}
+			}
+			if err := m.AutoCompoundParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeParams == nil {
+				m.FeeParams = &VaultFeeParams{}
+			}
+			if err := m.FeeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SnapshotParams == nil {
+				m.SnapshotParams = &VaultSnapshotParams{}
+			}
+			if err := m.SnapshotParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultSnapshotParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultSnapshotParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultSnapshotParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Retention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultFeeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultFeeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultFeeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PerformanceFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WithdrawalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRecipient = append(m.FeeRecipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.FeeRecipient == nil {
+				m.FeeRecipient = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoCompoundParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlippageLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlippageLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapLPStrategyParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapLPStrategyParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapLPStrategyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairedDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairedDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlippageLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlippageLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CdpSavingsStrategyParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CdpSavingsStrategyParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CdpSavingsStrategyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralizationRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollateralizationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlippageLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlippageLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CdpSavingsDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CdpSavingsDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CdpSavingsDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapLPResidual) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapLPResidual: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapLPResidual: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultRewardsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultRewardsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultFeeRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultFeeRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultFeeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighWaterMark", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HighWaterMark.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedPerformanceFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccruedPerformanceFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedWithdrawalFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccruedWithdrawalFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovVault(uint64(l))
-	return n
-}
 
-func sovVault(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozVault(x uint64) (n int) {
-	return sovVault(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *AllowedVault) Unmarshal(dAtA []byte) error {
+func (m *VaultSharePriceSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -591,15 +3695,15 @@ func (m *AllowedVault) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllowedVault: wiretype end group for non-group")
+			return fmt.Errorf("proto: VaultSharePriceSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowedVault: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VaultSharePriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -627,82 +3731,32 @@ func (m *AllowedVault) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.VaultDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType == 0 {
-				var v StrategyType
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowVault
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= StrategyType(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Strategies = append(m.Strategies, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowVault
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthVault
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthVault
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				if elementCount != 0 && len(m.Strategies) == 0 {
-					m.Strategies = make([]StrategyType, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v StrategyType
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowVault
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= StrategyType(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Strategies = append(m.Strategies, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategies", wireType)
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsPrivateVault", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVault
@@ -712,17 +3766,30 @@ func (m *AllowedVault) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsPrivateVault = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDepositors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SharePrice", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVault
@@ -732,23 +3799,25 @@ func (m *AllowedVault) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthVault
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthVault
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedDepositors = append(m.AllowedDepositors, make([]byte, postIndex-iNdEx))
-			copy(m.AllowedDepositors[len(m.AllowedDepositors)-1], dAtA[iNdEx:postIndex])
+			if err := m.SharePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -833,6 +3902,143 @@ func (m *VaultRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allocations = append(m.Allocations, StrategyAllocation{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StrategyAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StrategyAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StrategyAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			m.Strategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Strategy |= StrategyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVault(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
@@ -225,6 +226,337 @@ func TestAllowedVaultsValidate(t *testing.T) {
 				expectPass: true,
 			},
 		},
+		{
+			name: "valid - multiple strategies with weights",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:           "usdx",
+					Strategies:      []types.StrategyType{types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS},
+					StrategyWeights: []sdk.Dec{sdk.MustNewDecFromStr("0.6"), sdk.MustNewDecFromStr("0.4")},
+				},
+			},
+			errArgs: errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			name: "invalid - multiple strategies without weights",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:      "usdx",
+					Strategies: []types.StrategyType{types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS},
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "vaults with multiple strategies require StrategyWeights",
+			},
+		},
+		{
+			name: "invalid - weights do not match strategies",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:           "usdx",
+					Strategies:      []types.StrategyType{types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS},
+					StrategyWeights: []sdk.Dec{sdk.OneDec()},
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "number of StrategyWeights 1 does not match number of Strategies 2",
+			},
+		},
+		{
+			name: "invalid - weights do not sum to one",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:           "usdx",
+					Strategies:      []types.StrategyType{types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS},
+					StrategyWeights: []sdk.Dec{sdk.MustNewDecFromStr("0.6"), sdk.MustNewDecFromStr("0.6")},
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "StrategyWeights must sum to 1",
+			},
+		},
+		{
+			name: "invalid - zero weight",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:           "usdx",
+					Strategies:      []types.StrategyType{types.STRATEGY_TYPE_HARD, types.STRATEGY_TYPE_SAVINGS},
+					StrategyWeights: []sdk.Dec{sdk.OneDec(), sdk.ZeroDec()},
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "weight of strategy STRATEGY_TYPE_SAVINGS must be positive",
+			},
+		},
+		{
+			name: "valid - swap lp and cdp savings strategies with params",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:        "usdx",
+					Strategies:   []types.StrategyType{types.STRATEGY_TYPE_SWAP_LP},
+					SwapLPParams: types.NewSwapLPStrategyParams("busd", sdk.MustNewDecFromStr("0.05")),
+				},
+				{
+					Denom:      "bnb",
+					Strategies: []types.StrategyType{types.STRATEGY_TYPE_CDP_SAVINGS},
+					CdpSavingsParams: types.NewCdpSavingsStrategyParams(
+						"bnb-a",
+						sdk.MustNewDecFromStr("3"),
+						sdk.MustNewDecFromStr("0.05"),
+					),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			name: "invalid - swap lp strategy without params",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:      "usdx",
+					Strategies: []types.StrategyType{types.STRATEGY_TYPE_SWAP_LP},
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "vaults with strategy STRATEGY_TYPE_SWAP_LP require SwapLPParams",
+			},
+		},
+		{
+			name: "invalid - swap lp paired with vault denom",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:        "usdx",
+					Strategies:   []types.StrategyType{types.STRATEGY_TYPE_SWAP_LP},
+					SwapLPParams: types.NewSwapLPStrategyParams("usdx", sdk.MustNewDecFromStr("0.05")),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "swap lp paired denom cannot be the vault denom usdx",
+			},
+		},
+		{
+			name: "invalid - swap lp pool shared between vaults",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:        "usdx",
+					Strategies:   []types.StrategyType{types.STRATEGY_TYPE_SWAP_LP},
+					SwapLPParams: types.NewSwapLPStrategyParams("busd", sdk.MustNewDecFromStr("0.05")),
+				},
+				{
+					Denom:        "busd",
+					Strategies:   []types.StrategyType{types.STRATEGY_TYPE_SWAP_LP},
+					SwapLPParams: types.NewSwapLPStrategyParams("usdx", sdk.MustNewDecFromStr("0.05")),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "duplicate swap lp pool busd:usdx",
+			},
+		},
+		{
+			name: "invalid - params without strategy",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:        "usdx",
+					Strategies:   []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					SwapLPParams: types.NewSwapLPStrategyParams("busd", sdk.MustNewDecFromStr("0.05")),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "SwapLPParams set for vault without strategy STRATEGY_TYPE_SWAP_LP",
+			},
+		},
+		{
+			name: "invalid - cdp savings collateralization ratio at most 1",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:      "bnb",
+					Strategies: []types.StrategyType{types.STRATEGY_TYPE_CDP_SAVINGS},
+					CdpSavingsParams: types.NewCdpSavingsStrategyParams(
+						"bnb-a",
+						sdk.OneDec(),
+						sdk.MustNewDecFromStr("0.05"),
+					),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "cdp savings collateralization ratio must be greater than 1",
+			},
+		},
+		{
+			name: "valid - auto compound",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:              "usdx",
+					Strategies:         []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					AutoCompoundParams: types.NewAutoCompoundParams(100, sdk.MustNewDecFromStr("0.05")),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			name: "invalid - auto compound interval not positive",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:              "usdx",
+					Strategies:         []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					AutoCompoundParams: types.NewAutoCompoundParams(0, sdk.MustNewDecFromStr("0.05")),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "auto compound interval must be positive",
+			},
+		},
+		{
+			name: "invalid - auto compound slippage limit",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:              "usdx",
+					Strategies:         []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					AutoCompoundParams: types.NewAutoCompoundParams(100, sdk.OneDec()),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "auto compound slippage limit must be between 0 and 1",
+			},
+		},
+		{
+			name: "valid - fees",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:      "usdx",
+					Strategies: []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					FeeParams: types.NewVaultFeeParams(
+						sdk.MustNewDecFromStr("0.1"),
+						sdk.MustNewDecFromStr("0.005"),
+						sdk.AccAddress("fee recipient"),
+					),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			name: "invalid - performance fee",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:      "usdx",
+					Strategies: []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					FeeParams: types.NewVaultFeeParams(
+						sdk.OneDec(),
+						sdk.ZeroDec(),
+						sdk.AccAddress("fee recipient"),
+					),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "performance fee must be at least 0 and less than 1",
+			},
+		},
+		{
+			name: "invalid - withdrawal fee",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:      "usdx",
+					Strategies: []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					FeeParams: types.NewVaultFeeParams(
+						sdk.ZeroDec(),
+						sdk.MustNewDecFromStr("-0.01"),
+						sdk.AccAddress("fee recipient"),
+					),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "withdrawal fee must be at least 0 and less than 1",
+			},
+		},
+		{
+			name: "invalid - empty fee recipient",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:      "usdx",
+					Strategies: []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					FeeParams:  types.NewVaultFeeParams(sdk.ZeroDec(), sdk.ZeroDec(), nil),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "invalid fee recipient",
+			},
+		},
+		{
+			name: "valid - snapshots",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:          "usdx",
+					Strategies:     []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					SnapshotParams: types.NewVaultSnapshotParams(time.Hour, 30*24*time.Hour+time.Hour),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: true,
+			},
+		},
+		{
+			name: "invalid - snapshot interval not positive",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:          "usdx",
+					Strategies:     []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					SnapshotParams: types.NewVaultSnapshotParams(0, 30*24*time.Hour),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "snapshot interval must be positive",
+			},
+		},
+		{
+			name: "invalid - snapshot retention less than interval",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:          "usdx",
+					Strategies:     []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					SnapshotParams: types.NewVaultSnapshotParams(time.Hour, time.Minute),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "snapshot retention must be at least the longest realized APY window plus the snapshot interval",
+			},
+		},
+		{
+			name: "invalid - snapshot retention does not cover the longest realized APY window",
+			vaultRecords: types.AllowedVaults{
+				{
+					Denom:          "usdx",
+					Strategies:     []types.StrategyType{types.STRATEGY_TYPE_HARD},
+					SnapshotParams: types.NewVaultSnapshotParams(time.Hour, 30*24*time.Hour),
+				},
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "snapshot retention must be at least the longest realized APY window plus the snapshot interval",
+			},
+		},
 		{
 			name: "invalid - duplicate denom",
 			vaultRecords: types.AllowedVaults{
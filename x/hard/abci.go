@@ -5,7 +5,10 @@ import (
 	"github.com/incubus-network/fury/x/hard/keeper"
 )
 
-// BeginBlocker updates interest rates
+// BeginBlocker updates interest rates and liquidates the riskiest borrows that are outside of the valid LTV range
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	k.ApplyInterestRateUpdates(ctx)
+
+	params := k.GetParams(ctx)
+	k.LiquidateBorrows(ctx, params.CheckLtvIndexCount)
 }
@@ -16,9 +16,16 @@ import (
 
 // flags for cli queries
 const (
-	flagName  = "name"
-	flagDenom = "denom"
-	flagOwner = "owner"
+	flagName     = "name"
+	flagDenom    = "denom"
+	flagOwner    = "owner"
+	flagPoints   = "points"
+	flagSupplier = "supplier"
+	flagDelegate = "delegate"
+	flagDeposit  = "deposit"
+	flagWithdraw = "withdraw"
+	flagBorrow   = "borrow"
+	flagRepay    = "repay"
 )
 
 // GetQueryCmd returns the cli query commands for the  module
@@ -41,8 +48,11 @@ func GetQueryCmd() *cobra.Command {
 		queryUnsyncedBorrowsCmd(),
 		queryTotalBorrowedCmd(),
 		queryInterestRateCmd(),
+		queryInterestRateCurveCmd(),
 		queryReserves(),
 		queryInterestFactorsCmd(),
+		queryCreditDelegationsCmd(),
+		queryAccountHealthCmd(),
 	}
 
 	for _, cmd := range cmds {
@@ -461,6 +471,43 @@ func queryInterestRateCmd() *cobra.Command {
 	return cmd
 }
 
+func queryInterestRateCurveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "interest-rate-curve [denom]",
+		Short: "get the interest rate curve of a money market",
+		Long:  "get the supply and borrow interest rates of a money market sampled across utilization ratios from 0.0 to 1.0",
+		Example: fmt.Sprintf(`%[1]s q %[2]s interest-rate-curve bnb
+%[1]s q %[2]s interest-rate-curve bnb --points 11`, version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			points, err := cmd.Flags().GetUint32(flagPoints)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.InterestRateCurve(context.Background(), &types.QueryInterestRateCurveRequest{
+				Denom:  args[0],
+				Points: points,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32(flagPoints, 0, "(optional) number of utilization ratios to sample, defaults to 21")
+
+	return cmd
+}
+
 func queryReserves() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "reserves",
@@ -532,3 +579,110 @@ func queryInterestFactorsCmd() *cobra.Command {
 
 	return cmd
 }
+
+func queryCreditDelegationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "credit-delegations",
+		Short: "query hard module credit delegations with optional filters",
+		Long:  "query for all hard module credit delegations or the credit delegations of a specific supplier or delegate using flags",
+		Example: fmt.Sprintf(`%[1]s q %[2]s credit-delegations
+%[1]s q %[2]s credit-delegations --supplier fury1l0xsq2z7gqd7yly0g40y5836g0appumark77ny
+%[1]s q %[2]s credit-delegations --delegate fury1hgcfsuwc889wtdmt8pjy7qffua9dd2tralu64j
+%[1]s q %[2]s credit-delegations --page=2 --limit=100`, version.AppName, types.ModuleName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			supplierBech, err := cmd.Flags().GetString(flagSupplier)
+			if err != nil {
+				return err
+			}
+			delegateBech, err := cmd.Flags().GetString(flagDelegate)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CreditDelegations(context.Background(), &types.QueryCreditDelegationsRequest{
+				Supplier:   supplierBech,
+				Delegate:   delegateBech,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "credit-delegations")
+
+	cmd.Flags().String(flagSupplier, "", "(optional) filter for credit delegations by supplier address")
+	cmd.Flags().String(flagDelegate, "", "(optional) filter for credit delegations by delegate address")
+
+	return cmd
+}
+
+func queryAccountHealthCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-health [address]",
+		Short: "get the health of an account's position",
+		Long:  "get the health factor, remaining borrow capacity, and liquidation prices of an account's position, optionally simulating deposits, withdrawals, borrows, and repayments using flags",
+		Example: fmt.Sprintf(`%[1]s q %[2]s account-health fury1l0xsq2z7gqd7yly0g40y5836g0appumark77ny
+%[1]s q %[2]s account-health fury1l0xsq2z7gqd7yly0g40y5836g0appumark77ny --borrow 1000000usdx --deposit 10000000bnb`, version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return fmt.Errorf("cannot parse address from account-health owner %s", args[0])
+			}
+
+			simulated := make(map[string]sdk.Coins)
+			for _, flag := range []string{flagDeposit, flagWithdraw, flagBorrow, flagRepay} {
+				coinsStr, err := cmd.Flags().GetString(flag)
+				if err != nil {
+					return err
+				}
+				coins, err := sdk.ParseCoinsNormalized(coinsStr)
+				if err != nil {
+					return fmt.Errorf("invalid %s coins: %w", flag, err)
+				}
+				simulated[flag] = coins
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AccountHealth(context.Background(), &types.QueryAccountHealthRequest{
+				Owner:            args[0],
+				SimulateDeposit:  simulated[flagDeposit],
+				SimulateWithdraw: simulated[flagWithdraw],
+				SimulateBorrow:   simulated[flagBorrow],
+				SimulateRepay:    simulated[flagRepay],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagDeposit, "", "(optional) coins to simulate depositing")
+	cmd.Flags().String(flagWithdraw, "", "(optional) coins to simulate withdrawing")
+	cmd.Flags().String(flagBorrow, "", "(optional) coins to simulate borrowing")
+	cmd.Flags().String(flagRepay, "", "(optional) coins to simulate repaying")
+
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -31,6 +32,9 @@ func GetTxCmd() *cobra.Command {
 		getCmdBorrow(),
 		getCmdRepay(),
 		getCmdLiquidate(),
+		getCmdGrantCreditDelegation(),
+		getCmdRevokeCreditDelegation(),
+		getCmdSetEModeCategory(),
 	}
 
 	for _, cmd := range cmds {
@@ -96,32 +100,49 @@ func getCmdWithdraw() *cobra.Command {
 }
 
 func getCmdBorrow() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "borrow [amount]",
 		Short: "borrow tokens from the hard protocol",
-		Long:  strings.TrimSpace(`borrows tokens from the hard protocol`),
+		Long:  strings.TrimSpace(`borrows tokens from the hard protocol with optional --owner param to borrow against the deposit of an account that delegated credit to the sender`),
 		Args:  cobra.ExactArgs(1),
-		Example: fmt.Sprintf(
-			`%s tx %s borrow 1000000000ufury --from <key>`, version.AppName, types.ModuleName,
-		),
+		Example: fmt.Sprintf(`
+%[1]s tx %[2]s borrow 1000000000ufury --from <key>
+%[1]s tx %[2]s borrow 1000000000ufury --owner <supplier-address> --from <key>`, version.AppName, types.ModuleName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
+			ownerStr, err := cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
+
 			coins, err := sdk.ParseCoinsNormalized(args[0])
 			if err != nil {
 				return err
 			}
 
 			msg := types.NewMsgBorrow(clientCtx.GetFromAddress(), coins)
+			// Parse optional owner argument to borrow with a credit delegation
+			if len(ownerStr) > 0 {
+				owner, err := sdk.AccAddressFromBech32(ownerStr)
+				if err != nil {
+					return err
+				}
+				msg = types.NewMsgBorrowWithCreditDelegation(clientCtx.GetFromAddress(), owner, coins)
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+
+	cmd.Flags().String(flagOwner, "", "supplier's address that delegated credit to the sender")
+
+	return cmd
 }
 
 func getCmdRepay() *cobra.Command {
@@ -203,3 +224,99 @@ func getCmdLiquidate() *cobra.Command {
 		},
 	}
 }
+
+func getCmdGrantCreditDelegation() *cobra.Command {
+	return &cobra.Command{
+		Use:   "grant-credit [delegate-addr] [allowance]",
+		Short: "allow another account to borrow against your deposit",
+		Long: strings.TrimSpace(`allow another account to borrow up to an allowance of each denom against your deposit.
+The borrowed funds are sent to the delegate while your position carries the debt. Granting credit to an account
+that already has an allowance replaces the allowance.`),
+		Args: cobra.ExactArgs(2),
+		Example: fmt.Sprintf(
+			`%s tx %s grant-credit fury1hgcfsuwc889wtdmt8pjy7qffua9dd2tralu64j 1000000000usdx --from <key>`, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delegate, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			allowance, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantCreditDelegation(clientCtx.GetFromAddress(), delegate, allowance)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdRevokeCreditDelegation() *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke-credit [delegate-addr]",
+		Short: "remove another account's allowance to borrow against your deposit",
+		Long:  strings.TrimSpace(`remove another account's allowance to borrow against your deposit. Funds already borrowed remain your debt.`),
+		Args:  cobra.ExactArgs(1),
+		Example: fmt.Sprintf(
+			`%s tx %s revoke-credit fury1hgcfsuwc889wtdmt8pjy7qffua9dd2tralu64j --from <key>`, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delegate, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeCreditDelegation(clientCtx.GetFromAddress(), delegate)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdSetEModeCategory() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-e-mode [category-id]",
+		Short: "opt your account into an e-mode category",
+		Long: strings.TrimSpace(`opt your account into an e-mode category, allowing deposits of the category's denoms to be borrowed against at the category's loan-to-value.
+While in the category only its denoms can be borrowed. Use a category id of 0 to leave e-mode.`),
+		Args: cobra.ExactArgs(1),
+		Example: fmt.Sprintf(
+			`%[1]s tx %[2]s set-e-mode 1 --from <key>
+%[1]s tx %[2]s set-e-mode 0 --from <key>`, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			categoryID, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid e-mode category id %s: %w", args[0], err)
+			}
+
+			msg := types.NewMsgSetEModeCategory(clientCtx.GetFromAddress(), uint32(categoryID))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
@@ -27,12 +27,26 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, accountKeeper types.AccountKe
 		k.SetBorrowInterestFactor(ctx, gat.CollateralType, gat.BorrowInterestFactor)
 	}
 
+	for _, grt := range gs.RatesAtTarget {
+		k.SetRateAtTarget(ctx, grt.Denom, grt.RateAtTarget)
+	}
+
 	for _, deposit := range gs.Deposits {
 		k.SetDeposit(ctx, deposit)
 	}
 
+	for _, a := range gs.AccountEModeCategories {
+		k.SetAccountEModeCategory(ctx, a.Owner, a.CategoryID)
+	}
+
 	for _, borrow := range gs.Borrows {
 		k.SetBorrow(ctx, borrow)
+		k.UpdateLtvIndex(ctx, borrow.Borrower)
+		k.UpdateIsolatedDebt(ctx, borrow.Borrower)
+	}
+
+	for _, delegation := range gs.CreditDelegations {
+		k.SetCreditDelegation(ctx, delegation)
 	}
 
 	k.SetSuppliedCoins(ctx, gs.TotalSupplied)
@@ -109,8 +123,31 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		gats = append(gats, gat)
 
 	}
-	return types.NewGenesisState(
+
+	var ratesAtTarget types.GenesisRatesAtTarget
+	k.IterateRatesAtTarget(ctx, func(denom string, rateAtTarget sdk.Dec) bool {
+		ratesAtTarget = append(ratesAtTarget, types.NewGenesisRateAtTarget(denom, rateAtTarget))
+		return false
+	})
+
+	var creditDelegations types.CreditDelegations
+	k.IterateCreditDelegations(ctx, func(delegation types.CreditDelegation) bool {
+		creditDelegations = append(creditDelegations, delegation)
+		return false
+	})
+
+	var accountEModeCategories types.AccountEModeCategories
+	k.IterateAccountEModeCategories(ctx, func(category types.AccountEModeCategory) bool {
+		accountEModeCategories = append(accountEModeCategories, category)
+		return false
+	})
+
+	gs := types.NewGenesisState(
 		params, gats, deposits, borrows,
 		totalSupplied, totalBorrowed, totalReserves,
 	)
+	gs.RatesAtTarget = ratesAtTarget
+	gs.CreditDelegations = creditDelegations
+	gs.AccountEModeCategories = accountEModeCategories
+	return gs
 }
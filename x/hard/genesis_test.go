@@ -52,7 +52,7 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 				),
 				"fury:usd",
 				sdkmath.NewInt(1e6),
-				types.NewInterestRateModel(
+				types.NewJumpRateModel(
 					sdk.MustNewDecFromStr("0.05"),
 					sdk.MustNewDecFromStr("2"),
 					sdk.MustNewDecFromStr("0.8"),
@@ -63,6 +63,8 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 			),
 		},
 		sdk.NewDec(10),
+		types.DefaultCheckLtvIndexCount,
+		types.DefaultEModeCategories,
 	)
 
 	deposits := types.Deposits{
@@ -185,7 +187,13 @@ func (suite *GenesisTestSuite) Test_InitExportGenesis() {
 	expectedGenesis.Deposits = expectedDeposits
 	expectedGenesis.Borrows = expectedBorrows
 	exportedGenesis := hard.ExportGenesis(suite.ctx, suite.keeper)
-	suite.Equal(expectedGenesis, exportedGenesis)
+
+	// compare json as the interest rate model Any caches its encoding
+	expectedJson, err := suite.app.AppCodec().MarshalJSON(&expectedGenesis)
+	suite.Require().NoError(err)
+	actualJson, err := suite.app.AppCodec().MarshalJSON(&exportedGenesis)
+	suite.Require().NoError(err)
+	suite.Equal(expectedJson, actualJson)
 }
 
 func getGenesisAccumulationTime(denom string, ts types.GenesisAccumulationTimes) (types.GenesisAccumulationTime, bool) {
@@ -13,6 +13,11 @@ import (
 
 // Borrow funds
 func (k Keeper) Borrow(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins) error {
+	return k.borrow(ctx, borrower, borrower, coins)
+}
+
+// borrow funds against the deposit of the borrower and send them to the recipient
+func (k Keeper) borrow(ctx sdk.Context, borrower, recipient sdk.AccAddress, coins sdk.Coins) error {
 	// Set any new denoms' global borrow index to 1.0
 	for _, coin := range coins {
 		_, foundInterestFactor := k.GetBorrowInterestFactor(ctx, coin.Denom)
@@ -44,7 +49,7 @@ func (k Keeper) Borrow(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins
 	}
 
 	// Sends coins from Hard module account to user
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, borrower, coins)
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, recipient, coins)
 	if err != nil {
 		if errors.Is(err, sdkerrors.ErrInsufficientFunds) {
 			macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
@@ -99,6 +104,8 @@ func (k Keeper) Borrow(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins
 	} else {
 		k.AfterBorrowModified(ctx, borrow)
 	}
+	k.UpdateLtvIndex(ctx, borrower)
+	k.UpdateIsolatedDebt(ctx, borrower)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -171,6 +178,18 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 	if !found {
 		return errorsmod.Wrapf(types.ErrDepositsNotFound, "no deposits found for %s", borrower)
 	}
+
+	// Validate the requested borrow against the restrictions of the user's e-mode category and isolated collateral
+	if err := k.validateEModeBorrow(ctx, borrower, amount); err != nil {
+		return err
+	}
+	if isolatedMm, found := k.getIsolatedMoneyMarket(ctx, deposit.Amount); found {
+		if err := k.validateIsolatedBorrow(ctx, isolatedMm, amount); err != nil {
+			return err
+		}
+	}
+
+	category, inEMode := k.getEModeCategory(ctx, borrower)
 	totalBorrowableAmount := sdk.ZeroDec()
 	for _, coin := range deposit.Amount {
 		moneyMarket, found := k.GetMoneyMarket(ctx, coin.Denom)
@@ -184,7 +203,7 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 			return errorsmod.Wrapf(types.ErrPriceNotFound, "no price found for market %s", moneyMarket.SpotMarketID)
 		}
 		depositUSDValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPriceInfo.Price)
-		borrowableAmountForDeposit := depositUSDValue.Mul(moneyMarket.BorrowLimit.LoanToValue)
+		borrowableAmountForDeposit := depositUSDValue.Mul(loanToValue(moneyMarket, category, inEMode))
 		totalBorrowableAmount = totalBorrowableAmount.Add(borrowableAmountForDeposit)
 	}
 
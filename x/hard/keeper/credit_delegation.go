@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fury/x/hard/types"
+)
+
+// GrantCreditDelegation allows a delegate to borrow up to an allowance against the deposit of a supplier.
+// Granting credit to a delegate that already has a credit delegation replaces its allowance.
+func (k Keeper) GrantCreditDelegation(ctx sdk.Context, supplier, delegate sdk.AccAddress, allowance sdk.Coins) error {
+	if supplier.Equals(delegate) {
+		return errorsmod.Wrapf(types.ErrInvalidCreditDelegation, "supplier cannot delegate credit to itself: %s", supplier)
+	}
+	for _, coin := range allowance {
+		_, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if !found {
+			return errorsmod.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", coin.Denom)
+		}
+	}
+
+	delegation, found := k.GetCreditDelegation(ctx, supplier, delegate)
+	if !found {
+		delegation = types.NewCreditDelegation(supplier, delegate, sdk.NewCoins(), sdk.NewCoins())
+	}
+	delegation.Allowance = allowance
+	k.SetCreditDelegation(ctx, delegation)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardGrantCredit,
+			sdk.NewAttribute(types.AttributeKeySupplier, supplier.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegate, delegate.String()),
+			sdk.NewAttribute(types.AttributeKeyAllowance, allowance.String()),
+		),
+	)
+
+	return nil
+}
+
+// RevokeCreditDelegation removes the allowance of a delegate to borrow against the deposit of a supplier.
+// Funds already borrowed by the delegate remain a debt of the supplier.
+func (k Keeper) RevokeCreditDelegation(ctx sdk.Context, supplier, delegate sdk.AccAddress) error {
+	delegation, found := k.GetCreditDelegation(ctx, supplier, delegate)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCreditDelegationNotFound, "%s has not delegated credit to %s", supplier, delegate)
+	}
+	k.DeleteCreditDelegation(ctx, delegation)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardRevokeCredit,
+			sdk.NewAttribute(types.AttributeKeySupplier, supplier.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegate, delegate.String()),
+		),
+	)
+
+	return nil
+}
+
+// BorrowWithCreditDelegation borrows funds against the deposit of a supplier that delegated credit to the borrower.
+// The borrower receives the funds while the supplier's borrow position carries the debt.
+func (k Keeper) BorrowWithCreditDelegation(ctx sdk.Context, borrower, supplier sdk.AccAddress, coins sdk.Coins) error {
+	delegation, found := k.GetCreditDelegation(ctx, supplier, borrower)
+	if !found {
+		return errorsmod.Wrapf(types.ErrCreditDelegationNotFound, "%s has not delegated credit to %s", supplier, borrower)
+	}
+	allowance, isNegative := delegation.Allowance.SafeSub(coins...)
+	if isNegative {
+		return errorsmod.Wrapf(types.ErrExceedsCreditDelegationAllowance, "requested borrow %s > allowance %s", coins, delegation.Allowance)
+	}
+
+	if err := k.borrow(ctx, supplier, borrower, coins); err != nil {
+		return err
+	}
+
+	delegation.Allowance = allowance
+	delegation.Borrowed = delegation.Borrowed.Add(coins...)
+	k.SetCreditDelegation(ctx, delegation)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardDelegatedBorrow,
+			sdk.NewAttribute(types.AttributeKeySupplier, supplier.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegate, borrower.String()),
+			sdk.NewAttribute(types.AttributeKeyBorrowCoins, coins.String()),
+		),
+	)
+
+	return nil
+}
+
+// restoreCreditDelegation returns funds repaid by a delegate to the allowance of its credit delegation, up to the
+// amount the delegate has borrowed against the owner's deposit.
+func (k Keeper) restoreCreditDelegation(ctx sdk.Context, owner, sender sdk.AccAddress, payment sdk.Coins) {
+	delegation, found := k.GetCreditDelegation(ctx, owner, sender)
+	if !found {
+		return
+	}
+
+	restored := sdk.NewCoins()
+	for _, coin := range payment {
+		amount := sdk.MinInt(coin.Amount, delegation.Borrowed.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			restored = restored.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	if restored.IsZero() {
+		return
+	}
+
+	delegation.Borrowed = delegation.Borrowed.Sub(restored...)
+	delegation.Allowance = delegation.Allowance.Add(restored...)
+	k.SetCreditDelegation(ctx, delegation)
+}
@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/incubus-network/fury/app"
+	"github.com/incubus-network/fury/x/hard/keeper"
+	"github.com/incubus-network/fury/x/hard/types"
+)
+
+type creditDelegationTestSuite struct {
+	suite.Suite
+
+	tApp     app.TestApp
+	ctx      sdk.Context
+	keeper   keeper.Keeper
+	supplier sdk.AccAddress
+	delegate sdk.AccAddress
+}
+
+func (suite *creditDelegationTestSuite) SetupTest() {
+	suite.tApp = app.NewTestApp()
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	suite.supplier = addrs[0]
+	suite.delegate = addrs[1]
+
+	suite.ctx = suite.tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(time.Now().UTC())
+	suite.keeper = suite.tApp.GetHardKeeper()
+
+	err := suite.tApp.FundModuleAccount(suite.ctx, types.ModuleAccountName, cs(c("usdx", 10000000000)))
+	suite.Require().NoError(err)
+
+	suite.tApp.InitializeFromGenesisStates(
+		NewPricefeedGenStateMulti(suite.tApp.AppCodec()),
+		NewHARDGenState(suite.tApp.AppCodec()),
+		app.NewFundedGenStateWithSameCoins(suite.tApp.AppCodec(), cs(c("bnb", 10000000000)), addrs),
+	)
+
+	// supplier deposits 100 bnb worth $61,813, allowing up to $30,906 to be borrowed
+	err = suite.keeper.Deposit(suite.ctx, suite.supplier, cs(c("bnb", 100000000)))
+	suite.Require().NoError(err)
+}
+
+func (suite *creditDelegationTestSuite) TestGrantCreditDelegation() {
+	err := suite.keeper.GrantCreditDelegation(suite.ctx, suite.supplier, suite.delegate, cs(c("usdx", 100000000)))
+	suite.Require().NoError(err)
+
+	delegation, found := suite.keeper.GetCreditDelegation(suite.ctx, suite.supplier, suite.delegate)
+	suite.Require().True(found)
+	suite.Equal(cs(c("usdx", 100000000)), delegation.Allowance)
+
+	// granting again replaces the allowance
+	err = suite.keeper.GrantCreditDelegation(suite.ctx, suite.supplier, suite.delegate, cs(c("usdx", 50000000)))
+	suite.Require().NoError(err)
+
+	delegation, found = suite.keeper.GetCreditDelegation(suite.ctx, suite.supplier, suite.delegate)
+	suite.Require().True(found)
+	suite.Equal(cs(c("usdx", 50000000)), delegation.Allowance)
+}
+
+func (suite *creditDelegationTestSuite) TestGrantCreditDelegation_Invalid() {
+	err := suite.keeper.GrantCreditDelegation(suite.ctx, suite.supplier, suite.supplier, cs(c("usdx", 100000000)))
+	suite.Require().ErrorIs(err, types.ErrInvalidCreditDelegation)
+
+	err = suite.keeper.GrantCreditDelegation(suite.ctx, suite.supplier, suite.delegate, cs(c("xrp", 100000000)))
+	suite.Require().ErrorIs(err, types.ErrMarketNotFound)
+}
+
+func (suite *creditDelegationTestSuite) TestRevokeCreditDelegation() {
+	err := suite.keeper.RevokeCreditDelegation(suite.ctx, suite.supplier, suite.delegate)
+	suite.Require().ErrorIs(err, types.ErrCreditDelegationNotFound)
+
+	err = suite.keeper.GrantCreditDelegation(suite.ctx, suite.supplier, suite.delegate, cs(c("usdx", 100000000)))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.RevokeCreditDelegation(suite.ctx, suite.supplier, suite.delegate)
+	suite.Require().NoError(err)
+
+	_, found := suite.keeper.GetCreditDelegation(suite.ctx, suite.supplier, suite.delegate)
+	suite.False(found)
+
+	err = suite.keeper.BorrowWithCreditDelegation(suite.ctx, suite.delegate, suite.supplier, cs(c("usdx", 20000000)))
+	suite.Require().ErrorIs(err, types.ErrCreditDelegationNotFound)
+}
+
+func (suite *creditDelegationTestSuite) TestBorrowWithCreditDelegation() {
+	err := suite.keeper.GrantCreditDelegation(suite.ctx, suite.supplier, suite.delegate, cs(c("usdx", 100000000)))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.BorrowWithCreditDelegation(suite.ctx, suite.delegate, suite.supplier, cs(c("usdx", 60000000)))
+	suite.Require().NoError(err)
+
+	// the delegate receives the funds
+	bk := suite.tApp.GetBankKeeper()
+	suite.Equal(cs(c("bnb", 10000000000), c("usdx", 60000000)), bk.GetAllBalances(suite.ctx, suite.delegate))
+
+	// the supplier carries the debt
+	borrow, found := suite.keeper.GetBorrow(suite.ctx, suite.supplier)
+	suite.Require().True(found)
+	suite.Equal(cs(c("usdx", 60000000)), borrow.Amount)
+	_, found = suite.keeper.GetBorrow(suite.ctx, suite.delegate)
+	suite.False(found)
+
+	delegation, found := suite.keeper.GetCreditDelegation(suite.ctx, suite.supplier, suite.delegate)
+	suite.Require().True(found)
+	suite.Equal(cs(c("usdx", 40000000)), delegation.Allowance)
+	suite.Equal(cs(c("usdx", 60000000)), delegation.Borrowed)
+
+	// borrowing beyond the remaining allowance fails
+	err = suite.keeper.BorrowWithCreditDelegation(suite.ctx, suite.delegate, suite.supplier, cs(c("usdx", 50000000)))
+	suite.Require().ErrorIs(err, types.ErrExceedsCreditDelegationAllowance)
+
+	// denoms not covered by the allowance cannot be borrowed
+	err = suite.keeper.BorrowWithCreditDelegation(suite.ctx, suite.delegate, suite.supplier, cs(c("bnb", 10000000)))
+	suite.Require().ErrorIs(err, types.ErrExceedsCreditDelegationAllowance)
+}
+
+func (suite *creditDelegationTestSuite) TestRepayRestoresCreditDelegation() {
+	err := suite.keeper.GrantCreditDelegation(suite.ctx, suite.supplier, suite.delegate, cs(c("usdx", 100000000)))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.BorrowWithCreditDelegation(suite.ctx, suite.delegate, suite.supplier, cs(c("usdx", 60000000)))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.Repay(suite.ctx, suite.delegate, suite.supplier, cs(c("usdx", 20000000)))
+	suite.Require().NoError(err)
+
+	delegation, found := suite.keeper.GetCreditDelegation(suite.ctx, suite.supplier, suite.delegate)
+	suite.Require().True(found)
+	suite.Equal(cs(c("usdx", 60000000)), delegation.Allowance)
+	suite.Equal(cs(c("usdx", 40000000)), delegation.Borrowed)
+
+	borrow, found := suite.keeper.GetBorrow(suite.ctx, suite.supplier)
+	suite.Require().True(found)
+	suite.Equal(cs(c("usdx", 40000000)), borrow.Amount)
+
+	// repayments by the supplier itself do not restore the allowance
+	err = suite.tApp.FundAccount(suite.ctx, suite.supplier, cs(c("usdx", 20000000)))
+	suite.Require().NoError(err)
+	err = suite.keeper.Repay(suite.ctx, suite.supplier, suite.supplier, cs(c("usdx", 20000000)))
+	suite.Require().NoError(err)
+
+	delegation, found = suite.keeper.GetCreditDelegation(suite.ctx, suite.supplier, suite.delegate)
+	suite.Require().True(found)
+	suite.Equal(cs(c("usdx", 60000000)), delegation.Allowance)
+	suite.Equal(cs(c("usdx", 40000000)), delegation.Borrowed)
+}
+
+func TestCreditDelegationTestSuite(t *testing.T) {
+	suite.Run(t, new(creditDelegationTestSuite))
+}
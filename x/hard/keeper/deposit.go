@@ -38,6 +38,11 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coi
 		return err
 	}
 
+	err = k.validateIsolatedDeposit(ctx, depositor, coins)
+	if err != nil {
+		return err
+	}
+
 	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleAccountName, coins)
 	if err != nil {
 		if errors.Is(err, sdkerrors.ErrInsufficientFunds) {
@@ -92,6 +97,8 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coi
 	} else {
 		k.AfterDepositModified(ctx, deposit)
 	}
+	k.UpdateLtvIndex(ctx, depositor)
+	k.UpdateIsolatedDebt(ctx, depositor)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fury/x/hard/types"
+)
+
+// SetEModeCategory opts an account into an e-mode category, or out of e-mode when the category id is zero.
+// The account's existing borrow must only contain denoms of the category, and its position must remain
+// within the valid LTV range.
+func (k Keeper) SetEModeCategory(ctx sdk.Context, owner sdk.AccAddress, categoryID uint32) error {
+	if categoryID != 0 {
+		category, found := k.GetParams(ctx).EModeCategories.Get(categoryID)
+		if !found {
+			return errorsmod.Wrapf(types.ErrEModeCategoryNotFound, "%d", categoryID)
+		}
+		if borrow, found := k.GetBorrow(ctx, owner); found {
+			for _, coin := range borrow.Amount {
+				if !category.HasDenom(coin.Denom) {
+					return errorsmod.Wrapf(types.ErrEModeBorrowNotAllowed,
+						"borrowed %s is not in e-mode category %d", coin.Denom, categoryID)
+				}
+			}
+		}
+	}
+
+	previousCategoryID, _ := k.GetAccountEModeCategory(ctx, owner)
+	k.SetAccountEModeCategory(ctx, owner, categoryID)
+
+	deposit, foundDeposit := k.GetSyncedDeposit(ctx, owner)
+	borrow, foundBorrow := k.GetSyncedBorrow(ctx, owner)
+	if foundDeposit && foundBorrow {
+		valid, err := k.IsWithinValidLtvRange(ctx, deposit, borrow)
+		if err == nil && !valid {
+			err = errorsmod.Wrapf(types.ErrInsufficientLoanToValue,
+				"position would exceed the loan-to-value of e-mode category %d", categoryID)
+		}
+		if err != nil {
+			k.SetAccountEModeCategory(ctx, owner, previousCategoryID)
+			return err
+		}
+	}
+	k.UpdateLtvIndex(ctx, owner)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardSetEModeCategory,
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyEModeCategoryID, fmt.Sprintf("%d", categoryID)),
+		),
+	)
+
+	return nil
+}
+
+// getEModeCategory returns the e-mode category an account has opted into
+func (k Keeper) getEModeCategory(ctx sdk.Context, owner sdk.AccAddress) (types.EModeCategory, bool) {
+	categoryID, found := k.GetAccountEModeCategory(ctx, owner)
+	if !found {
+		return types.EModeCategory{}, false
+	}
+	return k.GetParams(ctx).EModeCategories.Get(categoryID)
+}
+
+// validateEModeBorrow checks that coins may be borrowed in the e-mode category of an account
+func (k Keeper) validateEModeBorrow(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins) error {
+	category, found := k.getEModeCategory(ctx, borrower)
+	if !found {
+		return nil
+	}
+	for _, coin := range coins {
+		if !category.HasDenom(coin.Denom) {
+			return errorsmod.Wrapf(types.ErrEModeBorrowNotAllowed,
+				"%s is not in e-mode category %d", coin.Denom, category.ID)
+		}
+	}
+	return nil
+}
+
+// loanToValue returns the loan-to-value of a money market's deposits, using the loan-to-value of the e-mode
+// category for the category's denoms
+func loanToValue(mm types.MoneyMarket, category types.EModeCategory, inEMode bool) sdk.Dec {
+	if inEMode && category.HasDenom(mm.Denom) {
+		return category.LoanToValue
+	}
+	return mm.BorrowLimit.LoanToValue
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/incubus-network/fury/app"
+	"github.com/incubus-network/fury/x/hard/keeper"
+	"github.com/incubus-network/fury/x/hard/types"
+)
+
+type eModeTestSuite struct {
+	suite.Suite
+
+	tApp   app.TestApp
+	ctx    sdk.Context
+	keeper keeper.Keeper
+	owner  sdk.AccAddress
+}
+
+func (suite *eModeTestSuite) SetupTest() {
+	suite.tApp = app.NewTestApp()
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	suite.owner = addrs[0]
+
+	suite.ctx = suite.tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(time.Now().UTC())
+	suite.keeper = suite.tApp.GetHardKeeper()
+
+	err := suite.tApp.FundModuleAccount(suite.ctx, types.ModuleAccountName, cs(c("usdx", 10000000000), c("bnb", 10000000000)))
+	suite.Require().NoError(err)
+
+	suite.tApp.InitializeFromGenesisStates(
+		NewPricefeedGenStateMulti(suite.tApp.AppCodec()),
+		NewHARDGenState(suite.tApp.AppCodec()),
+		app.NewFundedGenStateWithSameCoins(suite.tApp.AppCodec(), cs(c("busd", 20000000000)), addrs),
+	)
+
+	// 100 busd allows $50 to be borrowed outside of e-mode and $90 in e-mode
+	err = suite.keeper.Deposit(suite.ctx, suite.owner, cs(c("busd", 10000000000)))
+	suite.Require().NoError(err)
+}
+
+func (suite *eModeTestSuite) TestSetEModeCategory() {
+	err := suite.keeper.SetEModeCategory(suite.ctx, suite.owner, 2)
+	suite.Require().ErrorIs(err, types.ErrEModeCategoryNotFound)
+
+	err = suite.keeper.SetEModeCategory(suite.ctx, suite.owner, 1)
+	suite.Require().NoError(err)
+
+	categoryID, found := suite.keeper.GetAccountEModeCategory(suite.ctx, suite.owner)
+	suite.Require().True(found)
+	suite.Equal(uint32(1), categoryID)
+
+	err = suite.keeper.SetEModeCategory(suite.ctx, suite.owner, 0)
+	suite.Require().NoError(err)
+
+	_, found = suite.keeper.GetAccountEModeCategory(suite.ctx, suite.owner)
+	suite.False(found)
+}
+
+func (suite *eModeTestSuite) TestBorrowInEMode() {
+	// outside of e-mode the deposit's loan-to-value is 0.5
+	err := suite.keeper.Borrow(suite.ctx, suite.owner, cs(c("usdx", 80000000)))
+	suite.Require().ErrorIs(err, types.ErrInsufficientLoanToValue)
+
+	err = suite.keeper.SetEModeCategory(suite.ctx, suite.owner, 1)
+	suite.Require().NoError(err)
+
+	err = suite.keeper.Borrow(suite.ctx, suite.owner, cs(c("usdx", 80000000)))
+	suite.Require().NoError(err)
+
+	// only the category's denoms can be borrowed
+	err = suite.keeper.Borrow(suite.ctx, suite.owner, cs(c("bnb", 1000000)))
+	suite.Require().ErrorIs(err, types.ErrEModeBorrowNotAllowed)
+
+	// the position is within the valid LTV range only at the category's loan-to-value
+	err = suite.keeper.SetEModeCategory(suite.ctx, suite.owner, 0)
+	suite.Require().ErrorIs(err, types.ErrInsufficientLoanToValue)
+
+	categoryID, found := suite.keeper.GetAccountEModeCategory(suite.ctx, suite.owner)
+	suite.Require().True(found)
+	suite.Equal(uint32(1), categoryID)
+}
+
+func (suite *eModeTestSuite) TestSetEModeCategory_BorrowOutsideCategory() {
+	err := suite.keeper.Borrow(suite.ctx, suite.owner, cs(c("bnb", 50000)))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.SetEModeCategory(suite.ctx, suite.owner, 1)
+	suite.Require().ErrorIs(err, types.ErrEModeBorrowNotAllowed)
+}
+
+func (suite *eModeTestSuite) TestLiquidationRespectsEMode() {
+	err := suite.keeper.SetEModeCategory(suite.ctx, suite.owner, 1)
+	suite.Require().NoError(err)
+	err = suite.keeper.Borrow(suite.ctx, suite.owner, cs(c("usdx", 80000000)))
+	suite.Require().NoError(err)
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, addrs[1], suite.owner)
+	suite.Require().ErrorIs(err, types.ErrBorrowNotLiquidatable)
+
+	// a busd price drop to $0.85 puts the position above the category's loan-to-value
+	pfk := suite.tApp.GetPriceFeedKeeper()
+	_, err = pfk.SetPrice(suite.ctx, sdk.AccAddress{}, "busd:usd", sdk.MustNewDecFromStr("0.85"), suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	err = pfk.SetCurrentPrices(suite.ctx, "busd:usd")
+	suite.Require().NoError(err)
+
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, addrs[1], suite.owner)
+	suite.Require().NoError(err)
+}
+
+func TestEModeTestSuite(t *testing.T) {
+	suite.Run(t, new(eModeTestSuite))
+}
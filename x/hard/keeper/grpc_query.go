@@ -15,6 +15,11 @@ import (
 	"github.com/incubus-network/fury/x/hard/types"
 )
 
+const (
+	defaultInterestRateCurvePoints = 21
+	maxInterestRateCurvePoints     = 101
+)
+
 type queryServer struct {
 	keeper        Keeper
 	accountKeeper types.AccountKeeper
@@ -447,13 +452,9 @@ func (s queryServer) InterestRate(ctx context.Context, req *types.QueryInterestR
 			reserves = sdk.NewCoins()
 		}
 
-		// CalculateBorrowRate calculates the current interest rate based on utilization (the fraction of supply that has ien borrowed)
-		borrowAPY, err := CalculateBorrowRate(moneyMarket.InterestRateModel, sdk.NewDecFromInt(cash), sdk.NewDecFromInt(borrowed.Amount), sdk.NewDecFromInt(reserves.AmountOf(denom)))
-		if err != nil {
-			return nil, err
-		}
-
+		// GetBorrowRate calculates the current interest rate based on utilization (the fraction of supply that has been borrowed)
 		utilRatio := CalculateUtilizationRatio(sdk.NewDecFromInt(cash), sdk.NewDecFromInt(borrowed.Amount), sdk.NewDecFromInt(reserves.AmountOf(denom)))
+		borrowAPY := s.keeper.GetBorrowRate(sdkCtx, moneyMarket, utilRatio)
 		fullSupplyAPY := borrowAPY.Mul(utilRatio)
 		realSupplyAPY := fullSupplyAPY.Mul(sdk.OneDec().Sub(moneyMarket.ReserveFactor))
 
@@ -471,6 +472,54 @@ func (s queryServer) InterestRate(ctx context.Context, req *types.QueryInterestR
 	}, nil
 }
 
+func (s queryServer) InterestRateCurve(ctx context.Context, req *types.QueryInterestRateCurveRequest) (*types.QueryInterestRateCurveResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid denom: %s", err)
+	}
+
+	points := req.Points
+	if points == 0 {
+		points = defaultInterestRateCurvePoints
+	}
+	if points < 2 || points > maxInterestRateCurvePoints {
+		return nil, status.Errorf(codes.InvalidArgument, "points must be between 2 and %d", maxInterestRateCurvePoints)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	moneyMarket, found := s.keeper.GetMoneyMarket(sdkCtx, req.Denom)
+	if !found {
+		return nil, types.ErrMoneyMarketNotFound
+	}
+
+	macc := s.accountKeeper.GetModuleAccount(sdkCtx, types.ModuleName)
+	cash := s.bankKeeper.GetBalance(sdkCtx, macc.GetAddress(), req.Denom).Amount
+
+	borrowedCoins, _ := s.keeper.GetBorrowedCoins(sdkCtx)
+	reserves, _ := s.keeper.GetTotalReserves(sdkCtx)
+
+	currentUtilRatio := CalculateUtilizationRatio(sdk.NewDecFromInt(cash), sdk.NewDecFromInt(borrowedCoins.AmountOf(req.Denom)), sdk.NewDecFromInt(reserves.AmountOf(req.Denom)))
+
+	// Sample the curve at evenly spaced utilization ratios from 0% to 100%
+	curve := make(types.InterestRateCurvePoints, points)
+	for i := uint32(0); i < points; i++ {
+		utilRatio := sdk.NewDec(int64(i)).QuoInt64(int64(points - 1))
+		borrowAPY := s.keeper.GetBorrowRate(sdkCtx, moneyMarket, utilRatio)
+		supplyAPY := borrowAPY.Mul(utilRatio).Mul(sdk.OneDec().Sub(moneyMarket.ReserveFactor))
+
+		curve[i] = types.NewInterestRateCurvePoint(utilRatio, supplyAPY, borrowAPY)
+	}
+
+	return &types.QueryInterestRateCurveResponse{
+		Denom:              req.Denom,
+		CurrentUtilization: currentUtilRatio.String(),
+		Points:             curve,
+	}, nil
+}
+
 func (s queryServer) Reserves(ctx context.Context, req *types.QueryReservesRequest) (*types.QueryReservesResponse, error) {
 	if req == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "empty request")
@@ -544,3 +593,134 @@ func (s queryServer) InterestFactors(ctx context.Context, req *types.QueryIntere
 		InterestFactors: interestFactors,
 	}, nil
 }
+
+func (s queryServer) CreditDelegations(ctx context.Context, req *types.QueryCreditDelegationsRequest) (*types.QueryCreditDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	hasSupplier := len(req.Supplier) > 0
+	hasDelegate := len(req.Delegate) > 0
+
+	var supplier, delegate sdk.AccAddress
+	var err error
+	if hasSupplier {
+		supplier, err = sdk.AccAddressFromBech32(req.Supplier)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+	}
+	if hasDelegate {
+		delegate, err = sdk.AccAddressFromBech32(req.Delegate)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+	}
+
+	var delegations types.CreditDelegations
+	switch {
+	case hasSupplier && hasDelegate:
+		delegation, found := s.keeper.GetCreditDelegation(sdkCtx, supplier, delegate)
+		if found {
+			delegations = append(delegations, delegation)
+		}
+	case hasSupplier:
+		s.keeper.IterateCreditDelegationsBySupplier(sdkCtx, supplier, func(delegation types.CreditDelegation) (stop bool) {
+			delegations = append(delegations, delegation)
+			return false
+		})
+	case hasDelegate:
+		s.keeper.IterateCreditDelegations(sdkCtx, func(delegation types.CreditDelegation) (stop bool) {
+			if delegation.Delegate.Equals(delegate) {
+				delegations = append(delegations, delegation)
+			}
+			return false
+		})
+	default:
+		s.keeper.IterateCreditDelegations(sdkCtx, func(delegation types.CreditDelegation) (stop bool) {
+			delegations = append(delegations, delegation)
+			return false
+		})
+	}
+
+	page, limit, err := query.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := client.Paginate(len(delegations), page, limit, 100)
+	if start < 0 || end < 0 {
+		delegations = types.CreditDelegations{}
+	} else {
+		delegations = delegations[start:end]
+	}
+
+	return &types.QueryCreditDelegationsResponse{
+		CreditDelegations: delegations.ToResponse(),
+		Pagination:        nil,
+	}, nil
+}
+
+func (s queryServer) AccountHealth(ctx context.Context, req *types.QueryAccountHealthRequest) (*types.QueryAccountHealthResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	for _, coins := range []sdk.Coins{req.SimulateDeposit, req.SimulateWithdraw, req.SimulateBorrow, req.SimulateRepay} {
+		if err := coins.Validate(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid simulated coins: %s", err)
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	deposit, _ := s.keeper.GetSyncedDeposit(sdkCtx, owner)
+	depositCoins, isNegative := deposit.Amount.Add(req.SimulateDeposit...).SafeSub(req.SimulateWithdraw...)
+	if isNegative {
+		return nil, status.Errorf(codes.InvalidArgument, "simulated withdraw %s exceeds deposit", req.SimulateWithdraw)
+	}
+
+	borrow, _ := s.keeper.GetSyncedBorrow(sdkCtx, owner)
+	borrowCoins := borrow.Amount.Add(req.SimulateBorrow...)
+	if !req.SimulateRepay.Empty() {
+		repayment, err := s.keeper.CalculatePaymentAmount(borrowCoins, req.SimulateRepay)
+		if err != nil {
+			return nil, err
+		}
+		borrowCoins = borrowCoins.Sub(repayment...)
+	}
+
+	health, err := s.keeper.GetAccountHealth(
+		sdkCtx,
+		types.NewDeposit(owner, depositCoins, nil),
+		types.NewBorrow(owner, borrowCoins, nil),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	healthFactor := ""
+	if factor, ok := health.HealthFactor(); ok {
+		healthFactor = factor.String()
+	}
+
+	return &types.QueryAccountHealthResponse{
+		Owner:                   owner.String(),
+		Deposit:                 depositCoins,
+		Borrow:                  borrowCoins,
+		DepositValue:            health.DepositValue.String(),
+		BorrowValue:             health.BorrowValue.String(),
+		BorrowLimit:             health.BorrowLimit.String(),
+		HealthFactor:            healthFactor,
+		BorrowCapacity:          health.BorrowCapacity,
+		LiquidationPrices:       health.LiquidationPrices,
+		EModeCategoryID:         health.EModeCategoryID,
+		IsolatedCollateralDenom: health.IsolatedCollateralDenom,
+	}, nil
+}
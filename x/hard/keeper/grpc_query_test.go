@@ -496,6 +496,72 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryInterestRate() {
 	}
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcQueryInterestRateCurve() {
+	tests := []struct {
+		giveName    string
+		giveDenom   string
+		givePoints  uint32
+		wantPoints  types.InterestRateCurvePoints
+		shouldError bool
+	}{
+		{
+			"three points",
+			"usdx",
+			3,
+			types.InterestRateCurvePoints{
+				{
+					Utilization:        "0.000000000000000000",
+					SupplyInterestRate: "0.000000000000000000",
+					BorrowInterestRate: "0.050000000000000000",
+				},
+				{
+					Utilization:        "0.500000000000000000",
+					SupplyInterestRate: "0.498750000000000000",
+					BorrowInterestRate: "1.050000000000000000",
+				},
+				{
+					Utilization:        "1.000000000000000000",
+					SupplyInterestRate: "3.467500000000000000",
+					BorrowInterestRate: "3.650000000000000000",
+				},
+			},
+			false,
+		},
+		{
+			"too few points",
+			"usdx",
+			1,
+			nil,
+			true,
+		},
+		{
+			"invalid denom",
+			"bun",
+			3,
+			nil,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.giveName, func() {
+			res, err := suite.queryServer.InterestRateCurve(sdk.WrapSDKContext(suite.ctx), &types.QueryInterestRateCurveRequest{
+				Denom:  tt.giveDenom,
+				Points: tt.givePoints,
+			})
+
+			if tt.shouldError {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+
+				suite.Equal("0.000000000000000000", res.CurrentUtilization)
+				suite.Equal(tt.wantPoints, res.Points)
+			}
+		})
+	}
+}
+
 func (suite *grpcQueryTestSuite) TestGrpcQueryInterestFactors() {
 	res, err := suite.queryServer.InterestFactors(sdk.WrapSDKContext(suite.ctx), &types.QueryInterestFactorsRequest{
 		Denom: "usdx",
@@ -525,6 +591,171 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryReserves() {
 	}, res)
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcQueryCreditDelegations() {
+	suite.addDeposits()
+
+	err := suite.keeper.GrantCreditDelegation(suite.ctx, suite.addrs[0], suite.addrs[1], cs(c("usdx", 100000000)))
+	suite.Require().NoError(err)
+	err = suite.keeper.BorrowWithCreditDelegation(suite.ctx, suite.addrs[1], suite.addrs[0], cs(c("usdx", 10000000)))
+	suite.Require().NoError(err)
+
+	expected := types.CreditDelegationResponses{
+		types.NewCreditDelegationResponse(suite.addrs[0], suite.addrs[1], cs(c("usdx", 90000000)), cs(c("usdx", 10000000))),
+	}
+
+	tests := []struct {
+		giveName    string
+		giveRequest *types.QueryCreditDelegationsRequest
+		want        types.CreditDelegationResponses
+	}{
+		{
+			"empty query",
+			&types.QueryCreditDelegationsRequest{},
+			expected,
+		},
+		{
+			"supplier",
+			&types.QueryCreditDelegationsRequest{
+				Supplier: suite.addrs[0].String(),
+			},
+			expected,
+		},
+		{
+			"delegate",
+			&types.QueryCreditDelegationsRequest{
+				Delegate: suite.addrs[1].String(),
+			},
+			expected,
+		},
+		{
+			"supplier and delegate",
+			&types.QueryCreditDelegationsRequest{
+				Supplier: suite.addrs[0].String(),
+				Delegate: suite.addrs[1].String(),
+			},
+			expected,
+		},
+		{
+			"supplier without delegations",
+			&types.QueryCreditDelegationsRequest{
+				Supplier: suite.addrs[1].String(),
+			},
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.giveName, func() {
+			res, err := suite.queryServer.CreditDelegations(sdk.WrapSDKContext(suite.ctx), tt.giveRequest)
+			suite.Require().NoError(err)
+			suite.Equal(tt.want, res.CreditDelegations)
+		})
+	}
+}
+
+func (suite *grpcQueryTestSuite) TestGrpcQueryAccountHealth() {
+	// 100 bnb worth $61,813 allows up to $30,906.5 to be borrowed
+	err := suite.keeper.Deposit(suite.ctx, suite.addrs[0], cs(c("bnb", 100000000)))
+	suite.Require().NoError(err)
+	err = suite.keeper.Borrow(suite.ctx, suite.addrs[0], cs(c("usdx", 5000000000)))
+	suite.Require().NoError(err)
+
+	tests := []struct {
+		giveName              string
+		giveRequest           *types.QueryAccountHealthRequest
+		wantBorrow            sdk.Coins
+		wantBorrowValue       string
+		wantHealthFactor      string
+		wantBorrowCapacity    sdk.Coins
+		wantLiquidationPrices types.LiquidationPrices
+		wantErr               bool
+	}{
+		{
+			giveName: "current position",
+			giveRequest: &types.QueryAccountHealthRequest{
+				Owner: suite.addrs[0].String(),
+			},
+			wantBorrow:       cs(c("usdx", 5000000000)),
+			wantBorrowValue:  "5000.000000000000000000",
+			wantHealthFactor: "6.181300000000000000",
+			// usdx capacity is limited by the funds available to borrow
+			wantBorrowCapacity: cs(c("bnb", 41911086), c("busd", 10000000000), c("usdx", 5000000000)),
+			wantLiquidationPrices: types.LiquidationPrices{
+				types.NewLiquidationPrice("bnb", "bnb:usd", sdk.MustNewDecFromStr("100")),
+			},
+		},
+		{
+			giveName: "simulated borrow",
+			giveRequest: &types.QueryAccountHealthRequest{
+				Owner:          suite.addrs[0].String(),
+				SimulateBorrow: cs(c("usdx", 25000000000)),
+			},
+			wantBorrow:         cs(c("usdx", 30000000000)),
+			wantBorrowValue:    "30000.000000000000000000",
+			wantHealthFactor:   "1.030216666666666667",
+			wantBorrowCapacity: cs(c("bnb", 1466519), c("busd", 10000000000), c("usdx", 906500000)),
+			wantLiquidationPrices: types.LiquidationPrices{
+				types.NewLiquidationPrice("bnb", "bnb:usd", sdk.MustNewDecFromStr("600")),
+			},
+		},
+		{
+			giveName: "simulated full repayment",
+			giveRequest: &types.QueryAccountHealthRequest{
+				Owner:         suite.addrs[0].String(),
+				SimulateRepay: cs(c("usdx", 6000000000)),
+			},
+			wantBorrow:            nil,
+			wantBorrowValue:       "0.000000000000000000",
+			wantHealthFactor:      "",
+			wantBorrowCapacity:    cs(c("bnb", 50000000), c("busd", 10000000000), c("usdx", 5000000000)),
+			wantLiquidationPrices: types.LiquidationPrices{},
+		},
+		{
+			giveName: "simulated withdraw exceeds deposit",
+			giveRequest: &types.QueryAccountHealthRequest{
+				Owner:            suite.addrs[0].String(),
+				SimulateWithdraw: cs(c("bnb", 200000000)),
+			},
+			wantErr: true,
+		},
+		{
+			giveName: "simulated repay of denom not borrowed",
+			giveRequest: &types.QueryAccountHealthRequest{
+				Owner:         suite.addrs[0].String(),
+				SimulateRepay: cs(c("busd", 100000000)),
+			},
+			wantErr: true,
+		},
+		{
+			giveName: "invalid address",
+			giveRequest: &types.QueryAccountHealthRequest{
+				Owner: "invalid",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.giveName, func() {
+			res, err := suite.queryServer.AccountHealth(sdk.WrapSDKContext(suite.ctx), tt.giveRequest)
+			if tt.wantErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			suite.Equal(cs(c("bnb", 100000000)), res.Deposit)
+			suite.Equal("61813.000000000000000000", res.DepositValue)
+			suite.Equal("30906.500000000000000000", res.BorrowLimit)
+			suite.Equal(tt.wantBorrow, res.Borrow)
+			suite.Equal(tt.wantBorrowValue, res.BorrowValue)
+			suite.Equal(tt.wantHealthFactor, res.HealthFactor)
+			suite.Equal(tt.wantBorrowCapacity, res.BorrowCapacity)
+			suite.Equal(tt.wantLiquidationPrices, res.LiquidationPrices)
+		})
+	}
+}
+
 func TestGrpcQueryTestSuite(t *testing.T) {
 	suite.Run(t, new(grpcQueryTestSuite))
 }
@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fury/x/hard/types"
+)
+
+// AccountHealth summarizes the collateralization of a position at current prices
+type AccountHealth struct {
+	DepositValue      sdk.Dec
+	BorrowValue       sdk.Dec
+	BorrowLimit       sdk.Dec
+	BorrowCapacity    sdk.Coins
+	LiquidationPrices types.LiquidationPrices
+
+	EModeCategoryID         uint32
+	IsolatedCollateralDenom string
+}
+
+// HealthFactor returns the borrow limit divided by the borrow value. Positions with a health factor
+// below one can be liquidated. The boolean returned is false when nothing is borrowed.
+func (h AccountHealth) HealthFactor() (sdk.Dec, bool) {
+	if !h.BorrowValue.IsPositive() {
+		return sdk.Dec{}, false
+	}
+	return h.BorrowLimit.Quo(h.BorrowValue), true
+}
+
+// GetAccountHealth calculates the health of a position made up of the provided deposit and borrow.
+// The deposit and borrow do not need to exist in the store, allowing hypothetical positions to be evaluated.
+func (k Keeper) GetAccountHealth(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (AccountHealth, error) {
+	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
+	if err != nil {
+		return AccountHealth{}, err
+	}
+
+	health := AccountHealth{
+		DepositValue:      sdk.ZeroDec(),
+		BorrowValue:       sdk.ZeroDec(),
+		BorrowLimit:       sdk.ZeroDec(),
+		LiquidationPrices: types.LiquidationPrices{},
+	}
+	for _, depCoin := range deposit.Amount {
+		lData := liqMap[depCoin.Denom]
+		usdValue := sdk.NewDecFromInt(depCoin.Amount).Quo(sdk.NewDecFromInt(lData.conversionFactor)).Mul(lData.price)
+		health.DepositValue = health.DepositValue.Add(usdValue)
+		health.BorrowLimit = health.BorrowLimit.Add(usdValue.Mul(lData.ltv))
+	}
+	for _, coin := range borrow.Amount {
+		lData := liqMap[coin.Denom]
+		usdValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(lData.conversionFactor)).Mul(lData.price)
+		health.BorrowValue = health.BorrowValue.Add(usdValue)
+	}
+
+	if health.BorrowValue.IsPositive() {
+		for _, depCoin := range deposit.Amount {
+			lData := liqMap[depCoin.Denom]
+
+			// Both the borrow limit and the borrow value change linearly with the price of the denom, so the
+			// liquidation price is where the two lines cross with all other prices held constant.
+			collateralPerPrice := sdk.NewDecFromInt(depCoin.Amount).Quo(sdk.NewDecFromInt(lData.conversionFactor)).Mul(lData.ltv)
+			debtPerPrice := sdk.NewDecFromInt(borrow.Amount.AmountOf(depCoin.Denom)).Quo(sdk.NewDecFromInt(lData.conversionFactor))
+			if !collateralPerPrice.GT(debtPerPrice) {
+				continue
+			}
+			otherBorrowLimit := health.BorrowLimit.Sub(collateralPerPrice.Mul(lData.price))
+			otherBorrowValue := health.BorrowValue.Sub(debtPerPrice.Mul(lData.price))
+			liquidationPrice := otherBorrowValue.Sub(otherBorrowLimit).Quo(collateralPerPrice.Sub(debtPerPrice))
+			if !liquidationPrice.IsPositive() {
+				continue
+			}
+
+			mm, _ := k.GetMoneyMarket(ctx, depCoin.Denom)
+			health.LiquidationPrices = append(health.LiquidationPrices, types.NewLiquidationPrice(depCoin.Denom, mm.SpotMarketID, liquidationPrice))
+		}
+	}
+
+	remainingUSDValue := health.BorrowLimit.Sub(health.BorrowValue)
+	if !remainingUSDValue.IsPositive() {
+		remainingUSDValue = sdk.ZeroDec()
+	}
+
+	category, inEMode := k.getEModeCategory(ctx, deposit.Depositor)
+	if inEMode {
+		health.EModeCategoryID = category.ID
+	}
+	isolatedMm, isolated := k.getIsolatedMoneyMarket(ctx, deposit.Amount)
+	if isolated {
+		health.IsolatedCollateralDenom = isolatedMm.Denom
+
+		isolatedDebtUSDValue, err := k.getCoinsUSDValue(ctx, k.GetTotalIsolatedDebt(ctx, isolatedMm.Denom))
+		if err != nil {
+			return AccountHealth{}, err
+		}
+		remainingUSDValue = sdk.MinDec(remainingUSDValue, sdk.MaxDec(isolatedMm.Isolation.DebtCeiling.Sub(isolatedDebtUSDValue), sdk.ZeroDec()))
+	}
+
+	health.BorrowCapacity = k.getBorrowCapacity(ctx, remainingUSDValue, func(mm types.MoneyMarket) bool {
+		if inEMode && !category.HasDenom(mm.Denom) {
+			return false
+		}
+		return !isolated || isolatedMm.Isolation.AllowsBorrow(mm.Denom)
+	})
+
+	return health, nil
+}
+
+// getBorrowCapacity converts a USD value into the amount of each allowed money market's denom that could be borrowed,
+// limited by the funds available to borrow and each money market's global borrow limit.
+func (k Keeper) getBorrowCapacity(ctx sdk.Context, usdValue sdk.Dec, allowed func(mm types.MoneyMarket) bool) sdk.Coins {
+	capacity := sdk.NewCoins()
+	if !usdValue.IsPositive() {
+		return capacity
+	}
+
+	// The reserve coins aren't available for users to borrow
+	macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	hardMaccCoins := k.bankKeeper.GetAllBalances(ctx, macc.GetAddress())
+	reserveCoins, _ := k.GetTotalReserves(ctx)
+	borrowedCoins, _ := k.GetBorrowedCoins(ctx)
+
+	for _, mm := range k.GetParams(ctx).MoneyMarkets {
+		if !allowed(mm) {
+			continue
+		}
+
+		priceData, err := k.pricefeedKeeper.GetCurrentPrice(ctx, mm.SpotMarketID)
+		if err != nil || !priceData.Price.IsPositive() {
+			continue
+		}
+
+		amount := usdValue.Quo(priceData.Price).MulInt(mm.ConversionFactor).TruncateInt()
+
+		available := hardMaccCoins.AmountOf(mm.Denom).Sub(reserveCoins.AmountOf(mm.Denom))
+		if amount.GT(available) {
+			amount = available
+		}
+		if mm.BorrowLimit.HasMaxLimit {
+			remainingLimit := mm.BorrowLimit.MaximumLimit.Sub(sdk.NewDecFromInt(borrowedCoins.AmountOf(mm.Denom))).TruncateInt()
+			if amount.GT(remainingLimit) {
+				amount = remainingLimit
+			}
+		}
+
+		if amount.IsPositive() {
+			capacity = capacity.Add(sdk.NewCoin(mm.Denom, amount))
+		}
+	}
+	return capacity
+}
@@ -16,62 +16,66 @@ func NewHARDGenState(cdc codec.JSONCodec) app.GenesisState {
 	hardGenesis := types.GenesisState{
 		Params: types.NewParams(
 			types.MoneyMarkets{
-				types.MoneyMarket{
-					Denom: "usdx",
-					BorrowLimit: types.BorrowLimit{
-						HasMaxLimit:  true,
-						MaximumLimit: sdk.MustNewDecFromStr("100000000000"),
-						LoanToValue:  sdk.MustNewDecFromStr("1"),
-					},
-					SpotMarketID:     "usdx:usd",
-					ConversionFactor: sdkmath.NewInt(USDX_CF),
-					InterestRateModel: types.InterestRateModel{
-						BaseRateAPY:    sdk.MustNewDecFromStr("0.05"),
-						BaseMultiplier: sdk.MustNewDecFromStr("2"),
-						Kink:           sdk.MustNewDecFromStr("0.8"),
-						JumpMultiplier: sdk.MustNewDecFromStr("10"),
-					},
-					ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
-					KeeperRewardPercentage: sdk.ZeroDec(),
-				},
-				types.MoneyMarket{
-					Denom: "bnb",
-					BorrowLimit: types.BorrowLimit{
-						HasMaxLimit:  true,
-						MaximumLimit: sdk.MustNewDecFromStr("3000000000000"),
-						LoanToValue:  sdk.MustNewDecFromStr("0.5"),
-					},
-					SpotMarketID:     "bnb:usd",
-					ConversionFactor: sdkmath.NewInt(USDX_CF),
-					InterestRateModel: types.InterestRateModel{
-						BaseRateAPY:    sdk.MustNewDecFromStr("0"),
-						BaseMultiplier: sdk.MustNewDecFromStr("0.05"),
-						Kink:           sdk.MustNewDecFromStr("0.8"),
-						JumpMultiplier: sdk.MustNewDecFromStr("5.0"),
-					},
-					ReserveFactor:          sdk.MustNewDecFromStr("0.025"),
-					KeeperRewardPercentage: sdk.MustNewDecFromStr("0.02"),
-				},
-				types.MoneyMarket{
-					Denom: "busd",
-					BorrowLimit: types.BorrowLimit{
-						HasMaxLimit:  true,
-						MaximumLimit: sdk.MustNewDecFromStr("1000000000000000"),
-						LoanToValue:  sdk.MustNewDecFromStr("0.5"),
-					},
-					SpotMarketID:     "busd:usd",
-					ConversionFactor: sdkmath.NewInt(100000000),
-					InterestRateModel: types.InterestRateModel{
-						BaseRateAPY:    sdk.MustNewDecFromStr("0"),
-						BaseMultiplier: sdk.MustNewDecFromStr("0.5"),
-						Kink:           sdk.MustNewDecFromStr("0.8"),
-						JumpMultiplier: sdk.MustNewDecFromStr("5"),
-					},
-					ReserveFactor:          sdk.MustNewDecFromStr("0.025"),
-					KeeperRewardPercentage: sdk.MustNewDecFromStr("0.02"),
-				},
+				types.NewMoneyMarket(
+					"usdx",
+					types.NewBorrowLimit(
+						true,
+						sdk.MustNewDecFromStr("100000000000"),
+						sdk.MustNewDecFromStr("1"),
+					),
+					"usdx:usd",
+					sdkmath.NewInt(USDX_CF),
+					types.NewJumpRateModel(
+						sdk.MustNewDecFromStr("0.05"),
+						sdk.MustNewDecFromStr("2"),
+						sdk.MustNewDecFromStr("0.8"),
+						sdk.MustNewDecFromStr("10"),
+					),
+					sdk.MustNewDecFromStr("0.05"),
+					sdk.ZeroDec(),
+				),
+				types.NewMoneyMarket(
+					"bnb",
+					types.NewBorrowLimit(
+						true,
+						sdk.MustNewDecFromStr("3000000000000"),
+						sdk.MustNewDecFromStr("0.5"),
+					),
+					"bnb:usd",
+					sdkmath.NewInt(USDX_CF),
+					types.NewJumpRateModel(
+						sdk.MustNewDecFromStr("0"),
+						sdk.MustNewDecFromStr("0.05"),
+						sdk.MustNewDecFromStr("0.8"),
+						sdk.MustNewDecFromStr("5.0"),
+					),
+					sdk.MustNewDecFromStr("0.025"),
+					sdk.MustNewDecFromStr("0.02"),
+				),
+				types.NewMoneyMarket(
+					"busd",
+					types.NewBorrowLimit(
+						true,
+						sdk.MustNewDecFromStr("1000000000000000"),
+						sdk.MustNewDecFromStr("0.5"),
+					),
+					"busd:usd",
+					sdkmath.NewInt(100000000),
+					types.NewJumpRateModel(
+						sdk.MustNewDecFromStr("0"),
+						sdk.MustNewDecFromStr("0.5"),
+						sdk.MustNewDecFromStr("0.8"),
+						sdk.MustNewDecFromStr("5"),
+					),
+					sdk.MustNewDecFromStr("0.025"),
+					sdk.MustNewDecFromStr("0.02"),
+				),
 			},
 			sdk.MustNewDecFromStr("10"),
+			types.DefaultCheckLtvIndexCount,
+			types.EModeCategories{
+				types.NewEModeCategory(1, "stablecoins", sdk.MustNewDecFromStr("0.9"), []string{"usdx", "busd"}),
+			},
 		),
 		PreviousAccumulationTimes: types.GenesisAccumulationTimes{
 			types.NewGenesisAccumulationTime(
@@ -90,6 +94,14 @@ func NewHARDGenState(cdc codec.JSONCodec) app.GenesisState {
 	return app.GenesisState{types.ModuleName: cdc.MustMarshalJSON(&hardGenesis)}
 }
 
+// NewHARDGenStateWithParams returns the HARD genesis state of NewHARDGenState with its params modified
+func NewHARDGenStateWithParams(cdc codec.JSONCodec, modify func(params *types.Params)) app.GenesisState {
+	var hardGenesis types.GenesisState
+	cdc.MustUnmarshalJSON(NewHARDGenState(cdc)[types.ModuleName], &hardGenesis)
+	modify(&hardGenesis.Params)
+	return app.GenesisState{types.ModuleName: cdc.MustMarshalJSON(&hardGenesis)}
+}
+
 func NewPricefeedGenStateMulti(cdc codec.JSONCodec) app.GenesisState {
 	pfGenesis := pricefeedtypes.GenesisState{
 		Params: pricefeedtypes.Params{
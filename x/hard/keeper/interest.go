@@ -38,6 +38,11 @@ func (k Keeper) ApplyInterestRateUpdates(ctx sdk.Context) {
 		// Update the interest rate in the store if the params have changed
 		if !moneyMarket.Equal(mm) {
 			k.SetMoneyMarket(ctx, mm.Denom, mm)
+
+			// Only adaptive interest rate models keep a rate at target
+			if _, ok := mm.GetInterestRateModel().(*types.AdaptiveRateModel); !ok {
+				k.DeleteRateAtTarget(ctx, mm.Denom)
+			}
 		}
 		denomSet[mm.Denom] = true
 	}
@@ -53,6 +58,7 @@ func (k Keeper) ApplyInterestRateUpdates(ctx sdk.Context) {
 
 			// Delete the money market from the store
 			k.DeleteMoneyMarket(ctx, denom)
+			k.DeleteRateAtTarget(ctx, denom)
 		}
 		return false
 	})
@@ -116,10 +122,8 @@ func (k Keeper) AccrueInterest(ctx sdk.Context, denom string) error {
 	}
 
 	// GetBorrowRate calculates the current interest rate based on utilization (the fraction of supply that has been borrowed)
-	borrowRateApy, err := CalculateBorrowRate(mm.InterestRateModel, sdk.NewDecFromInt(cashPrior), sdk.NewDecFromInt(borrowedPrior.Amount), sdk.NewDecFromInt(reservesPrior.AmountOf(denom)))
-	if err != nil {
-		return err
-	}
+	utilRatio := CalculateUtilizationRatio(sdk.NewDecFromInt(cashPrior), sdk.NewDecFromInt(borrowedPrior.Amount), sdk.NewDecFromInt(reservesPrior.AmountOf(denom)))
+	borrowRateApy := k.GetBorrowRate(ctx, mm, utilRatio)
 
 	// Convert from APY to SPY, expressed as (1 + borrow rate)
 	borrowRateSpy, err := APYToSPY(sdk.OneDec().Add(borrowRateApy))
@@ -153,23 +157,41 @@ func (k Keeper) AccrueInterest(ctx sdk.Context, denom string) error {
 	k.SetTotalReserves(ctx, reservesPrior.Add(sdk.NewCoin(denom, reservesNew)))
 	k.SetPreviousAccrualTime(ctx, denom, ctx.BlockTime())
 
+	// Move the curve of adaptive interest rate models towards the target utilization
+	if model, ok := mm.GetInterestRateModel().(*types.AdaptiveRateModel); ok {
+		rateAtTarget := k.getRateAtTarget(ctx, denom, model)
+		k.SetRateAtTarget(ctx, denom, model.NextRateAtTarget(rateAtTarget, utilRatio, timeElapsed))
+	}
+
 	return nil
 }
 
+// GetBorrowRate returns the current borrow APY of a money market at a utilization ratio. Adaptive interest rate
+// models use the rate at target stored for the market.
+func (k Keeper) GetBorrowRate(ctx sdk.Context, mm types.MoneyMarket, utilRatio sdk.Dec) sdk.Dec {
+	switch model := mm.GetInterestRateModel().(type) {
+	case *types.AdaptiveRateModel:
+		return model.BorrowRateAt(utilRatio, k.getRateAtTarget(ctx, mm.Denom, model))
+	default:
+		return model.BorrowRate(utilRatio)
+	}
+}
+
+// getRateAtTarget returns the stored rate at target of a market bounded by the limits of its model, or the
+// model's initial rate at target if none has been stored yet.
+func (k Keeper) getRateAtTarget(ctx sdk.Context, denom string, model *types.AdaptiveRateModel) sdk.Dec {
+	rateAtTarget, found := k.GetRateAtTarget(ctx, denom)
+	if !found {
+		return model.InitialRateAtTarget
+	}
+	return sdk.MinDec(sdk.MaxDec(rateAtTarget, model.MinRateAtTarget), model.MaxRateAtTarget)
+}
+
 // CalculateBorrowRate calculates the borrow rate, which is the current APY expressed as a decimal
 // based on the current utilization.
 func CalculateBorrowRate(model types.InterestRateModel, cash, borrows, reserves sdk.Dec) (sdk.Dec, error) {
 	utilRatio := CalculateUtilizationRatio(cash, borrows, reserves)
-
-	// Calculate normal borrow rate (under kink)
-	if utilRatio.LTE(model.Kink) {
-		return utilRatio.Mul(model.BaseMultiplier).Add(model.BaseRateAPY), nil
-	}
-
-	// Calculate jump borrow rate (over kink)
-	normalRate := model.Kink.Mul(model.BaseMultiplier).Add(model.BaseRateAPY)
-	excessUtil := utilRatio.Sub(model.Kink)
-	return excessUtil.Mul(model.JumpMultiplier).Add(normalRate), nil
+	return model.BorrowRate(utilRatio), nil
 }
 
 // CalculateUtilizationRatio calculates an asset's current utilization rate
@@ -111,7 +111,7 @@ func (suite *InterestTestSuite) TestCalculateBorrowRate() {
 	// 	- BaseMultiplier:   0.1
 	// 	- Kink:             0.8
 	// 	- JumpMultiplier:   0.5
-	normalModel := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	normalModel := types.NewJumpRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
 
 	testCases := []test{
 		{
@@ -180,7 +180,7 @@ func (suite *InterestTestSuite) TestCalculateBorrowRate() {
 				cash:          sdk.MustNewDecFromStr("1000"),
 				borrows:       sdk.MustNewDecFromStr("5000"),
 				reserves:      sdk.MustNewDecFromStr("100"),
-				model:         types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("1.0")),
+				model:         types.NewJumpRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("1.0")),
 				expectedValue: sdk.MustNewDecFromStr("0.447457627118644068"),
 			},
 		},
@@ -190,7 +190,7 @@ func (suite *InterestTestSuite) TestCalculateBorrowRate() {
 				cash:          sdk.MustNewDecFromStr("1000"),
 				borrows:       sdk.MustNewDecFromStr("5000"),
 				reserves:      sdk.MustNewDecFromStr("100"),
-				model:         types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("1.0")),
+				model:         types.NewJumpRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("1.0")),
 				expectedValue: sdk.MustNewDecFromStr("0.797457627118644068"),
 			},
 		},
@@ -200,7 +200,7 @@ func (suite *InterestTestSuite) TestCalculateBorrowRate() {
 				cash:     sdk.MustNewDecFromStr("1000"),
 				borrows:  sdk.MustNewDecFromStr("5000"),
 				reserves: sdk.MustNewDecFromStr("100"),
-				model: types.NewInterestRateModel(
+				model: types.NewJumpRateModel(
 					sdk.MustNewDecFromStr("0.0"),
 					sdk.MustNewDecFromStr("0.0"),
 					sdk.MustNewDecFromStr("0.8"),
@@ -612,7 +612,7 @@ func (suite *KeeperTestSuite) TestBorrowInterest() {
 		errArgs errArgs
 	}
 
-	normalModel := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	normalModel := types.NewJumpRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
 
 	oneDayInSeconds := int64(86400)
 	oneWeekInSeconds := int64(604800)
@@ -851,6 +851,8 @@ func (suite *KeeperTestSuite) TestBorrowInterest() {
 						sdk.ZeroDec()),            // Keeper Reward Percentage
 				},
 				sdk.NewDec(10),
+				types.DefaultCheckLtvIndexCount,
+				types.DefaultEModeCategories,
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
 				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
 			)
@@ -1005,7 +1007,7 @@ func (suite *KeeperTestSuite) TestSupplyInterest() {
 		errArgs errArgs
 	}
 
-	normalModel := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	normalModel := types.NewJumpRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
 
 	oneDayInSeconds := int64(86400)
 	oneWeekInSeconds := int64(604800)
@@ -1263,6 +1265,8 @@ func (suite *KeeperTestSuite) TestSupplyInterest() {
 						sdk.ZeroDec()),            // Keeper Reward Percentage
 				},
 				sdk.NewDec(10),
+				0, // disable automatic liquidations so interest keeps accruing on the borrows
+				types.DefaultEModeCategories,
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
 				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
 			)
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fury/x/hard/types"
+)
+
+// UpdateIsolatedDebt updates the debt of a borrower counted towards the debt ceiling of an isolated money market to
+// match the borrower's position in the store. Borrows are counted while the borrower has deposited the denom of an
+// isolated money market.
+//
+// Debt is stored normalized by the borrow interest factor of each denom, in the same way as borrows are synced, so
+// that the total debt grows with accrued interest without updating each borrower.
+func (k Keeper) UpdateIsolatedDebt(ctx sdk.Context, borrower sdk.AccAddress) {
+	if debt, found := k.GetIsolatedDebt(ctx, borrower); found {
+		total := k.GetTotalNormalizedIsolatedDebt(ctx, debt.CollateralDenom)
+		total, _ = total.SafeSub(total.Intersect(debt.NormalizedAmount))
+		k.SetTotalNormalizedIsolatedDebt(ctx, debt.CollateralDenom, total)
+		k.DeleteIsolatedDebt(ctx, borrower)
+	}
+
+	deposit, found := k.GetDeposit(ctx, borrower)
+	if !found {
+		return
+	}
+	borrow, found := k.GetBorrow(ctx, borrower)
+	if !found || borrow.Amount.Empty() {
+		return
+	}
+	mm, found := k.getIsolatedMoneyMarket(ctx, deposit.Amount)
+	if !found {
+		return
+	}
+
+	normalized := sdk.NewDecCoins()
+	for _, coin := range borrow.Amount {
+		factor, found := borrow.Index.GetInterestFactor(coin.Denom)
+		if !found {
+			factor = sdk.OneDec()
+		}
+		normalized = normalized.Add(sdk.NewDecCoinFromDec(coin.Denom, sdk.NewDecFromInt(coin.Amount).Quo(factor)))
+	}
+
+	k.SetIsolatedDebt(ctx, types.NewIsolatedDebt(borrower, mm.Denom, normalized))
+	k.SetTotalNormalizedIsolatedDebt(ctx, mm.Denom, k.GetTotalNormalizedIsolatedDebt(ctx, mm.Denom).Add(normalized...))
+}
+
+// GetTotalIsolatedDebt returns the total debt backed by the deposits of an isolated money market, including interest
+// accrued since each borrower's debt was last updated.
+func (k Keeper) GetTotalIsolatedDebt(ctx sdk.Context, collateralDenom string) sdk.Coins {
+	total := sdk.NewCoins()
+	for _, coin := range k.GetTotalNormalizedIsolatedDebt(ctx, collateralDenom) {
+		factor, found := k.GetBorrowInterestFactor(ctx, coin.Denom)
+		if !found {
+			factor = sdk.OneDec()
+		}
+		total = total.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(factor).TruncateInt()))
+	}
+	return total
+}
+
+// getIsolatedMoneyMarket returns the isolated money market of the deposited coins, if any
+func (k Keeper) getIsolatedMoneyMarket(ctx sdk.Context, deposit sdk.Coins) (types.MoneyMarket, bool) {
+	for _, coin := range deposit {
+		mm, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if found && mm.IsIsolated() {
+			return mm, true
+		}
+	}
+	return types.MoneyMarket{}, false
+}
+
+// validateIsolatedDeposit checks that a deposit does not combine the denoms of multiple isolated money markets,
+// and that the depositor's existing borrow may be backed by newly deposited isolated collateral.
+func (k Keeper) validateIsolatedDeposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error {
+	isolatedMm, found := k.getIsolatedMoneyMarket(ctx, coins)
+	if !found {
+		return nil
+	}
+
+	var existingCoins sdk.Coins
+	if deposit, found := k.GetDeposit(ctx, depositor); found {
+		existingCoins = deposit.Amount
+	}
+	for _, coin := range existingCoins.Add(coins...) {
+		mm, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if found && mm.IsIsolated() && mm.Denom != isolatedMm.Denom {
+			return errorsmod.Wrapf(types.ErrInvalidIsolatedCollateral,
+				"cannot deposit isolated collateral %s together with isolated collateral %s", isolatedMm.Denom, mm.Denom)
+		}
+	}
+
+	// the existing borrow is already counted towards the debt ceiling
+	if existingCoins.AmountOf(isolatedMm.Denom).IsPositive() {
+		return nil
+	}
+	borrow, found := k.GetBorrow(ctx, depositor)
+	if !found {
+		return nil
+	}
+	return k.validateIsolatedBorrow(ctx, isolatedMm, borrow.Amount)
+}
+
+// validateIsolatedBorrow checks that coins may be borrowed against the deposit of an isolated money market
+// without exceeding its debt ceiling.
+func (k Keeper) validateIsolatedBorrow(ctx sdk.Context, mm types.MoneyMarket, coins sdk.Coins) error {
+	for _, coin := range coins {
+		if !mm.Isolation.AllowsBorrow(coin.Denom) {
+			return errorsmod.Wrapf(types.ErrIsolatedBorrowNotAllowed,
+				"%s cannot be borrowed against isolated collateral %s", coin.Denom, mm.Denom)
+		}
+	}
+
+	proposedDebt := k.GetTotalIsolatedDebt(ctx, mm.Denom).Add(coins...)
+	proposedDebtUSDValue, err := k.getCoinsUSDValue(ctx, proposedDebt)
+	if err != nil {
+		return err
+	}
+	if proposedDebtUSDValue.GT(mm.Isolation.DebtCeiling) {
+		return errorsmod.Wrapf(types.ErrExceedsDebtCeiling,
+			"proposed debt of %s USD backed by %s exceeds the debt ceiling of %s USD",
+			proposedDebtUSDValue, mm.Denom, mm.Isolation.DebtCeiling)
+	}
+	return nil
+}
+
+// getCoinsUSDValue returns the USD value of coins at current prices
+func (k Keeper) getCoinsUSDValue(ctx sdk.Context, coins sdk.Coins) (sdk.Dec, error) {
+	total := sdk.ZeroDec()
+	for _, coin := range coins {
+		mm, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if !found {
+			return sdk.ZeroDec(), errorsmod.Wrapf(types.ErrMarketNotFound, "no money market found for denom %s", coin.Denom)
+		}
+		priceData, err := k.pricefeedKeeper.GetCurrentPrice(ctx, mm.SpotMarketID)
+		if err != nil {
+			return sdk.ZeroDec(), errorsmod.Wrapf(types.ErrPriceNotFound, "no price found for market %s", mm.SpotMarketID)
+		}
+		total = total.Add(sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(mm.ConversionFactor)).Mul(priceData.Price))
+	}
+	return total, nil
+}
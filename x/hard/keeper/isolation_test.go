@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/incubus-network/fury/app"
+	"github.com/incubus-network/fury/x/hard/keeper"
+	"github.com/incubus-network/fury/x/hard/types"
+)
+
+type isolationTestSuite struct {
+	suite.Suite
+
+	tApp   app.TestApp
+	ctx    sdk.Context
+	keeper keeper.Keeper
+	addrs  []sdk.AccAddress
+}
+
+func (suite *isolationTestSuite) SetupTest() {
+	suite.tApp = app.NewTestApp()
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	suite.addrs = addrs
+
+	suite.ctx = suite.tApp.NewContext(true, tmprototypes.Header{}).
+		WithBlockTime(time.Now().UTC())
+	suite.keeper = suite.tApp.GetHardKeeper()
+
+	err := suite.tApp.FundModuleAccount(suite.ctx, types.ModuleAccountName, cs(c("usdx", 10000000000), c("busd", 100000000000)))
+	suite.Require().NoError(err)
+
+	suite.tApp.InitializeFromGenesisStates(
+		NewPricefeedGenStateMulti(suite.tApp.AppCodec()),
+		NewHARDGenStateWithParams(suite.tApp.AppCodec(), func(params *types.Params) {
+			for i, mm := range params.MoneyMarkets {
+				if mm.Denom == "bnb" {
+					params.MoneyMarkets[i].Isolation = types.NewIsolation(sdk.NewDec(1000), []string{"usdx"})
+				}
+			}
+		}),
+		app.NewFundedGenStateWithSameCoins(suite.tApp.AppCodec(), cs(c("bnb", 10000000000), c("busd", 20000000000), c("usdx", 1000000000)), addrs),
+	)
+}
+
+func (suite *isolationTestSuite) TestBorrowAgainstIsolatedCollateral() {
+	err := suite.keeper.Deposit(suite.ctx, suite.addrs[0], cs(c("bnb", 100000000)))
+	suite.Require().NoError(err)
+
+	// only approved denoms can be borrowed against isolated collateral
+	err = suite.keeper.Borrow(suite.ctx, suite.addrs[0], cs(c("busd", 10000000000)))
+	suite.Require().ErrorIs(err, types.ErrIsolatedBorrowNotAllowed)
+
+	err = suite.keeper.Borrow(suite.ctx, suite.addrs[0], cs(c("usdx", 600000000)))
+	suite.Require().NoError(err)
+	suite.Equal(cs(c("usdx", 600000000)), suite.keeper.GetTotalIsolatedDebt(suite.ctx, "bnb"))
+
+	// the debt ceiling limits borrows even when the position has collateral to spare
+	err = suite.keeper.Borrow(suite.ctx, suite.addrs[0], cs(c("usdx", 500000000)))
+	suite.Require().ErrorIs(err, types.ErrExceedsDebtCeiling)
+
+	err = suite.keeper.Repay(suite.ctx, suite.addrs[0], suite.addrs[0], cs(c("usdx", 200000000)))
+	suite.Require().NoError(err)
+	suite.Equal(cs(c("usdx", 400000000)), suite.keeper.GetTotalIsolatedDebt(suite.ctx, "bnb"))
+
+	debt, found := suite.keeper.GetIsolatedDebt(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Equal(types.NewIsolatedDebt(suite.addrs[0], "bnb", sdk.NewDecCoins(sdk.NewInt64DecCoin("usdx", 400000000))), debt)
+}
+
+func (suite *isolationTestSuite) TestIsolatedDebtAccruesInterest() {
+	err := suite.keeper.Deposit(suite.ctx, suite.addrs[0], cs(c("bnb", 100000000)))
+	suite.Require().NoError(err)
+	err = suite.keeper.Borrow(suite.ctx, suite.addrs[0], cs(c("usdx", 900000000)))
+	suite.Require().NoError(err)
+
+	// a year of interest is counted towards the debt ceiling without the borrower's position changing
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(365 * 24 * time.Hour))
+	suite.keeper.ApplyInterestRateUpdates(suite.ctx)
+
+	borrow, found := suite.keeper.GetSyncedBorrow(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	suite.True(borrow.Amount.AmountOf("usdx").GT(sdk.NewInt(900000000)))
+	suite.Equal(borrow.Amount, suite.keeper.GetTotalIsolatedDebt(suite.ctx, "bnb"))
+
+	// the principal leaves room below the debt ceiling, but the accrued interest does not
+	err = suite.keeper.Borrow(suite.ctx, suite.addrs[0], cs(c("usdx", 50000000)))
+	suite.Require().ErrorIs(err, types.ErrExceedsDebtCeiling)
+
+	// repaying everything removes the debt including its interest
+	err = suite.tApp.FundAccount(suite.ctx, suite.addrs[0], borrow.Amount)
+	suite.Require().NoError(err)
+	err = suite.keeper.Repay(suite.ctx, suite.addrs[0], suite.addrs[0], borrow.Amount)
+	suite.Require().NoError(err)
+	suite.Empty(suite.keeper.GetTotalIsolatedDebt(suite.ctx, "bnb"))
+}
+
+func (suite *isolationTestSuite) TestDepositIsolatedCollateral() {
+	// 200 busd allows $100 to be borrowed
+	err := suite.keeper.Deposit(suite.ctx, suite.addrs[1], cs(c("busd", 20000000000)))
+	suite.Require().NoError(err)
+	err = suite.keeper.Borrow(suite.ctx, suite.addrs[1], cs(c("usdx", 50000000)))
+	suite.Require().NoError(err)
+	suite.Empty(suite.keeper.GetTotalIsolatedDebt(suite.ctx, "bnb"))
+
+	// depositing isolated collateral brings the existing borrow under the debt ceiling
+	err = suite.keeper.Deposit(suite.ctx, suite.addrs[1], cs(c("bnb", 1000000)))
+	suite.Require().NoError(err)
+	suite.Equal(cs(c("usdx", 50000000)), suite.keeper.GetTotalIsolatedDebt(suite.ctx, "bnb"))
+
+	// withdrawing the isolated collateral removes it again
+	err = suite.keeper.Withdraw(suite.ctx, suite.addrs[1], cs(c("bnb", 1000000)))
+	suite.Require().NoError(err)
+	suite.Empty(suite.keeper.GetTotalIsolatedDebt(suite.ctx, "bnb"))
+	_, found := suite.keeper.GetIsolatedDebt(suite.ctx, suite.addrs[1])
+	suite.False(found)
+}
+
+func (suite *isolationTestSuite) TestDepositIsolatedCollateral_BorrowNotAllowed() {
+	err := suite.keeper.Deposit(suite.ctx, suite.addrs[1], cs(c("busd", 20000000000)))
+	suite.Require().NoError(err)
+	err = suite.keeper.Borrow(suite.ctx, suite.addrs[1], cs(c("busd", 5000000000)))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.Deposit(suite.ctx, suite.addrs[1], cs(c("bnb", 1000000)))
+	suite.Require().ErrorIs(err, types.ErrIsolatedBorrowNotAllowed)
+}
+
+func TestIsolationTestSuite(t *testing.T) {
+	suite.Run(t, new(isolationTestSuite))
+}
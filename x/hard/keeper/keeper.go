@@ -3,6 +3,8 @@ package keeper
 import (
 	"time"
 
+	"github.com/tendermint/tendermint/libs/log"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -54,6 +56,11 @@ func (k *Keeper) SetHooks(hooks types.HARDHooks) *Keeper {
 	return k
 }
 
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
 // GetDeposit returns a deposit from the store for a particular depositor address, deposit denom
 func (k Keeper) GetDeposit(ctx sdk.Context, depositor sdk.AccAddress) (types.Deposit, bool) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositsKeyPrefix)
@@ -364,3 +371,291 @@ func (k Keeper) IterateSupplyInterestFactors(ctx sdk.Context, cb func(denom stri
 		}
 	}
 }
+
+// GetRateAtTarget returns the current rate at target utilization for a market using an adaptive interest rate model
+func (k Keeper) GetRateAtTarget(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RateAtTargetPrefix)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return sdk.ZeroDec(), false
+	}
+	var rateAtTarget sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &rateAtTarget)
+	return rateAtTarget.Dec, true
+}
+
+// SetRateAtTarget sets the current rate at target utilization for an individual market
+func (k Keeper) SetRateAtTarget(ctx sdk.Context, denom string, rateAtTarget sdk.Dec) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RateAtTargetPrefix)
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: rateAtTarget})
+	store.Set([]byte(denom), bz)
+}
+
+// DeleteRateAtTarget deletes the rate at target utilization for an individual market
+func (k Keeper) DeleteRateAtTarget(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RateAtTargetPrefix)
+	store.Delete([]byte(denom))
+}
+
+// IterateRatesAtTarget iterates over all rates at target utilization in the store and returns
+// both the rate and the key (denom) it's stored under
+func (k Keeper) IterateRatesAtTarget(ctx sdk.Context, cb func(denom string, rateAtTarget sdk.Dec) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RateAtTargetPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var rateAtTarget sdk.DecProto
+		k.cdc.MustUnmarshal(iterator.Value(), &rateAtTarget)
+		if cb(string(iterator.Key()), rateAtTarget.Dec) {
+			break
+		}
+	}
+}
+
+// GetCreditDelegation returns the credit delegation from a supplier to a delegate
+func (k Keeper) GetCreditDelegation(ctx sdk.Context, supplier, delegate sdk.AccAddress) (types.CreditDelegation, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CreditDelegationsKeyPrefix)
+	bz := store.Get(types.CreditDelegationKey(supplier, delegate))
+	if len(bz) == 0 {
+		return types.CreditDelegation{}, false
+	}
+	var delegation types.CreditDelegation
+	k.cdc.MustUnmarshal(bz, &delegation)
+	return delegation, true
+}
+
+// SetCreditDelegation sets the input credit delegation in the store, prefixed by the supplier and delegate addresses
+func (k Keeper) SetCreditDelegation(ctx sdk.Context, delegation types.CreditDelegation) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CreditDelegationsKeyPrefix)
+	bz := k.cdc.MustMarshal(&delegation)
+	store.Set(types.CreditDelegationKey(delegation.Supplier, delegation.Delegate), bz)
+}
+
+// DeleteCreditDelegation deletes a credit delegation from the store
+func (k Keeper) DeleteCreditDelegation(ctx sdk.Context, delegation types.CreditDelegation) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CreditDelegationsKeyPrefix)
+	store.Delete(types.CreditDelegationKey(delegation.Supplier, delegation.Delegate))
+}
+
+// IterateCreditDelegations iterates over all credit delegations in the store and performs a callback function
+func (k Keeper) IterateCreditDelegations(ctx sdk.Context, cb func(delegation types.CreditDelegation) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CreditDelegationsKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.CreditDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		if cb(delegation) {
+			break
+		}
+	}
+}
+
+// IterateCreditDelegationsBySupplier iterates over the credit delegations granted by a supplier and performs a callback function
+func (k Keeper) IterateCreditDelegationsBySupplier(ctx sdk.Context, supplier sdk.AccAddress, cb func(delegation types.CreditDelegation) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CreditDelegationsKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.CreditDelegationsBySupplierKey(supplier))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.CreditDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		if cb(delegation) {
+			break
+		}
+	}
+}
+
+// GetAccountEModeCategory returns the id of the e-mode category an account has opted into
+func (k Keeper) GetAccountEModeCategory(ctx sdk.Context, owner sdk.AccAddress) (uint32, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AccountEModeCategoryPrefix)
+	bz := store.Get(owner)
+	if len(bz) == 0 {
+		return 0, false
+	}
+	return uint32(sdk.BigEndianToUint64(bz)), true
+}
+
+// SetAccountEModeCategory sets the e-mode category of an account, a category id of zero removes the account from e-mode
+func (k Keeper) SetAccountEModeCategory(ctx sdk.Context, owner sdk.AccAddress, categoryID uint32) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AccountEModeCategoryPrefix)
+	if categoryID == 0 {
+		store.Delete(owner)
+		return
+	}
+	store.Set(owner, sdk.Uint64ToBigEndian(uint64(categoryID)))
+}
+
+// IterateAccountEModeCategories iterates over all accounts in e-mode and performs a callback function
+func (k Keeper) IterateAccountEModeCategories(ctx sdk.Context, cb func(category types.AccountEModeCategory) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AccountEModeCategoryPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		category := types.NewAccountEModeCategory(iterator.Key(), uint32(sdk.BigEndianToUint64(iterator.Value())))
+		if cb(category) {
+			break
+		}
+	}
+}
+
+// GetIsolatedDebt returns the debt of a borrower backed by the deposit of an isolated money market
+func (k Keeper) GetIsolatedDebt(ctx sdk.Context, borrower sdk.AccAddress) (types.IsolatedDebt, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.IsolatedDebtPrefix)
+	bz := store.Get(borrower)
+	if len(bz) == 0 {
+		return types.IsolatedDebt{}, false
+	}
+	var debt types.IsolatedDebt
+	k.cdc.MustUnmarshal(bz, &debt)
+	return debt, true
+}
+
+// SetIsolatedDebt sets the isolated debt of a borrower in the store
+func (k Keeper) SetIsolatedDebt(ctx sdk.Context, debt types.IsolatedDebt) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.IsolatedDebtPrefix)
+	store.Set(debt.Borrower, k.cdc.MustMarshal(&debt))
+}
+
+// DeleteIsolatedDebt deletes the isolated debt of a borrower from the store
+func (k Keeper) DeleteIsolatedDebt(ctx sdk.Context, borrower sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.IsolatedDebtPrefix)
+	store.Delete(borrower)
+}
+
+// GetTotalNormalizedIsolatedDebt returns the total normalized debt backed by the deposits of an isolated money market
+func (k Keeper) GetTotalNormalizedIsolatedDebt(ctx sdk.Context, collateralDenom string) sdk.DecCoins {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.TotalIsolatedDebtPrefix)
+	bz := store.Get([]byte(collateralDenom))
+	if len(bz) == 0 {
+		return sdk.NewDecCoins()
+	}
+	var total types.DecCoinsProto
+	k.cdc.MustUnmarshal(bz, &total)
+	return total.Coins
+}
+
+// SetTotalNormalizedIsolatedDebt sets the total normalized debt backed by the deposits of an isolated money market
+func (k Keeper) SetTotalNormalizedIsolatedDebt(ctx sdk.Context, collateralDenom string, total sdk.DecCoins) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.TotalIsolatedDebtPrefix)
+	if total.Empty() {
+		store.Delete([]byte(collateralDenom))
+		return
+	}
+	store.Set([]byte(collateralDenom), k.cdc.MustMarshal(&types.DecCoinsProto{Coins: total}))
+}
+
+// InsertIntoLtvIndex indexes a borrower by its LTV relative to its borrow limit, replacing any existing index entry of
+// the borrower
+func (k Keeper) InsertIntoLtvIndex(ctx sdk.Context, ltv sdk.Dec, borrower sdk.AccAddress) {
+	k.RemoveFromLtvIndex(ctx, borrower)
+
+	if ltv.GT(sdk.MaxSortableDec) {
+		ltv = sdk.MaxSortableDec
+	}
+	indexStore := prefix.NewStore(ctx.KVStore(k.key), types.LtvIndexPrefix)
+	indexStore.Set(types.LtvIndexKey(ltv, borrower), borrower)
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowerLtvPrefix)
+	store.Set(borrower, k.cdc.MustMarshal(&sdk.DecProto{Dec: ltv}))
+}
+
+// RemoveFromLtvIndex removes a borrower from the LTV index
+func (k Keeper) RemoveFromLtvIndex(ctx sdk.Context, borrower sdk.AccAddress) {
+	ltv, found := k.GetIndexedLtv(ctx, borrower)
+	if !found {
+		return
+	}
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.key), types.LtvIndexPrefix)
+	indexStore.Delete(types.LtvIndexKey(ltv, borrower))
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowerLtvPrefix)
+	store.Delete(borrower)
+}
+
+// GetIndexedLtv returns the LTV relative to its borrow limit that a borrower is indexed by
+func (k Keeper) GetIndexedLtv(ctx sdk.Context, borrower sdk.AccAddress) (sdk.Dec, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowerLtvPrefix)
+	bz := store.Get(borrower)
+	if len(bz) == 0 {
+		return sdk.ZeroDec(), false
+	}
+	var ltv sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &ltv)
+	return ltv.Dec, true
+}
+
+// IterateLtvIndex iterates over borrowers in order of decreasing indexed LTV and performs a callback function
+func (k Keeper) IterateLtvIndex(ctx sdk.Context, cb func(borrower sdk.AccAddress) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LtvIndexPrefix)
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.AccAddress(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetLtvIndexSlice returns up to count borrowers with the highest indexed LTV
+func (k Keeper) GetLtvIndexSlice(ctx sdk.Context, count uint64) []sdk.AccAddress {
+	var borrowers []sdk.AccAddress
+	if count == 0 {
+		return borrowers
+	}
+	k.IterateLtvIndex(ctx, func(borrower sdk.AccAddress) bool {
+		borrowers = append(borrowers, borrower)
+		return uint64(len(borrowers)) >= count
+	})
+	return borrowers
+}
+
+// GetLiquidationCursor returns the borrower last checked by the liquidation sweep
+func (k Keeper) GetLiquidationCursor(ctx sdk.Context) (sdk.AccAddress, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.LiquidationCursorKey)
+	if len(bz) == 0 {
+		return nil, false
+	}
+	return sdk.AccAddress(bz), true
+}
+
+// SetLiquidationCursor sets the borrower last checked by the liquidation sweep
+func (k Keeper) SetLiquidationCursor(ctx sdk.Context, borrower sdk.AccAddress) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.LiquidationCursorKey, borrower)
+}
+
+// IterateBorrowsAfter iterates over borrows in order of borrower address, starting after the provided borrower and
+// wrapping around to the start of the store, and performs a callback function. Each borrow is visited at most once.
+func (k Keeper) IterateBorrowsAfter(ctx sdk.Context, borrower sdk.AccAddress, cb func(borrow types.Borrow) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowsKeyPrefix)
+
+	var start []byte
+	if len(borrower) > 0 {
+		// the first key after the borrower
+		start = append(append([]byte{}, borrower...), 0x00)
+	}
+	ranges := [][2][]byte{{start, nil}}
+	if start != nil {
+		ranges = append(ranges, [2][]byte{nil, start})
+	}
+
+	for _, r := range ranges {
+		stop := func() bool {
+			iterator := store.Iterator(r[0], r[1])
+			defer iterator.Close()
+			for ; iterator.Valid(); iterator.Next() {
+				var borrow types.Borrow
+				k.cdc.MustUnmarshal(iterator.Value(), &borrow)
+				if cb(borrow) {
+					return true
+				}
+			}
+			return false
+		}()
+		if stop {
+			return
+		}
+	}
+}
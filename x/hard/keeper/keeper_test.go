@@ -133,7 +133,7 @@ func (suite *KeeperTestSuite) TestIterateBorrows() {
 
 func (suite *KeeperTestSuite) TestGetSetDeleteInterestRateModel() {
 	denom := "test"
-	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+	model := types.NewJumpRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
 	borrowLimit := types.NewBorrowLimit(false, sdk.MustNewDecFromStr("0.2"), sdk.MustNewDecFromStr("0.5"))
 	moneyMarket := types.NewMoneyMarket(denom, borrowLimit, denom+":usd", sdkmath.NewInt(1000000), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
 
@@ -152,6 +152,51 @@ func (suite *KeeperTestSuite) TestGetSetDeleteInterestRateModel() {
 	suite.Require().False(f)
 }
 
+func (suite *KeeperTestSuite) TestGetSetDeleteRateAtTarget() {
+	denom := "test"
+	rateAtTarget := sdk.MustNewDecFromStr("0.04")
+
+	_, f := suite.keeper.GetRateAtTarget(suite.ctx, denom)
+	suite.Require().False(f)
+
+	suite.keeper.SetRateAtTarget(suite.ctx, denom, rateAtTarget)
+
+	testRateAtTarget, f := suite.keeper.GetRateAtTarget(suite.ctx, denom)
+	suite.Require().True(f)
+	suite.Require().Equal(rateAtTarget, testRateAtTarget)
+
+	suite.Require().NotPanics(func() { suite.keeper.DeleteRateAtTarget(suite.ctx, denom) })
+
+	_, f = suite.keeper.GetRateAtTarget(suite.ctx, denom)
+	suite.Require().False(f)
+}
+
+func (suite *KeeperTestSuite) TestGetBorrowRate_AdaptiveRateModel() {
+	denom := "test"
+	model := types.NewAdaptiveRateModel(
+		sdk.MustNewDecFromStr("0.9"),
+		sdk.MustNewDecFromStr("0.04"),
+		sdk.MustNewDecFromStr("0.01"),
+		sdk.MustNewDecFromStr("0.1"),
+		sdk.MustNewDecFromStr("50"),
+		sdk.MustNewDecFromStr("4"),
+	)
+	borrowLimit := types.NewBorrowLimit(false, sdk.MustNewDecFromStr("0.2"), sdk.MustNewDecFromStr("0.5"))
+	moneyMarket := types.NewMoneyMarket(denom, borrowLimit, denom+":usd", sdkmath.NewInt(1000000), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+	targetUtil := sdk.MustNewDecFromStr("0.9")
+
+	// Initial rate at target is used until the market has adapted
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.04"), suite.keeper.GetBorrowRate(suite.ctx, moneyMarket, targetUtil))
+
+	suite.keeper.SetRateAtTarget(suite.ctx, denom, sdk.MustNewDecFromStr("0.06"))
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.06"), suite.keeper.GetBorrowRate(suite.ctx, moneyMarket, targetUtil))
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.24"), suite.keeper.GetBorrowRate(suite.ctx, moneyMarket, sdk.OneDec()))
+
+	// Stored rates outside of the model's bounds are clamped
+	suite.keeper.SetRateAtTarget(suite.ctx, denom, sdk.MustNewDecFromStr("0.5"))
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.1"), suite.keeper.GetBorrowRate(suite.ctx, moneyMarket, targetUtil))
+}
+
 func (suite *KeeperTestSuite) TestIterateInterestRateModels() {
 	testDenom := "test"
 	var setMMs types.MoneyMarkets
@@ -159,7 +204,7 @@ func (suite *KeeperTestSuite) TestIterateInterestRateModels() {
 	for i := 0; i < 5; i++ {
 		// Initialize a new money market
 		denom := testDenom + strconv.Itoa(i)
-		model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+		model := types.NewJumpRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
 		borrowLimit := types.NewBorrowLimit(false, sdk.MustNewDecFromStr("0.2"), sdk.MustNewDecFromStr("0.5"))
 		moneyMarket := types.NewMoneyMarket(denom, borrowLimit, denom+":usd", sdkmath.NewInt(1000000), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
 
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"errors"
 	"sort"
 
 	errorsmod "cosmossdk.io/errors"
@@ -19,6 +20,79 @@ type LiqData struct {
 
 // AttemptKeeperLiquidation enables a keeper to liquidate an individual borrower's position
 func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper sdk.AccAddress, borrower sdk.AccAddress) error {
+	return k.attemptLiquidation(ctx, keeper, borrower)
+}
+
+// LiquidateBorrows checks up to count borrowers with the highest indexed LTV, then sweeps up to count more borrowers
+// in address order continuing from the previous block's sweep. Positions that are outside of the valid LTV range at
+// current prices are liquidated, while positions that remain within range are re-indexed by their current LTV.
+//
+// Index entries are only updated when a position is checked or modified, so they go stale as prices move and interest
+// accrues. The sweep refreshes every entry in turn, so positions that became unsafe lower in the index are still found.
+func (k Keeper) LiquidateBorrows(ctx sdk.Context, count uint64) {
+	if count == 0 {
+		return
+	}
+
+	checked := make(map[string]bool)
+	for _, borrower := range k.GetLtvIndexSlice(ctx, count) {
+		checked[borrower.String()] = true
+		k.checkBorrowForLiquidation(ctx, borrower)
+	}
+
+	cursor, _ := k.GetLiquidationCursor(ctx)
+	var swept []sdk.AccAddress
+	k.IterateBorrowsAfter(ctx, cursor, func(borrow types.Borrow) bool {
+		swept = append(swept, borrow.Borrower)
+		return uint64(len(swept)) >= count
+	})
+	for _, borrower := range swept {
+		if !checked[borrower.String()] {
+			k.checkBorrowForLiquidation(ctx, borrower)
+		}
+	}
+	if len(swept) > 0 {
+		k.SetLiquidationCursor(ctx, swept[len(swept)-1])
+	}
+}
+
+// checkBorrowForLiquidation liquidates a borrower's position if it is outside of the valid LTV range, or otherwise
+// re-indexes it by its current LTV.
+func (k Keeper) checkBorrowForLiquidation(ctx sdk.Context, borrower sdk.AccAddress) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	err := k.attemptLiquidation(cacheCtx, nil, borrower)
+	if err == nil {
+		writeCache()
+		return
+	}
+	if !errors.Is(err, types.ErrBorrowNotLiquidatable) {
+		k.Logger(ctx).Error("failed to liquidate borrow", "borrower", borrower.String(), "error", err.Error())
+	}
+	k.UpdateLtvIndex(ctx, borrower)
+}
+
+// UpdateLtvIndex re-indexes a borrower by the LTV of its position relative to its borrow limit, including accrued
+// interest and at current prices. Normalising by each money market's loan-to-value ratio ranks positions by how close
+// they are to liquidation: positions indexed above one can be liquidated. Borrowers without an outstanding borrow are
+// removed from the index.
+func (k Keeper) UpdateLtvIndex(ctx sdk.Context, borrower sdk.AccAddress) {
+	borrow, found := k.GetSyncedBorrow(ctx, borrower)
+	if !found {
+		k.RemoveFromLtvIndex(ctx, borrower)
+		return
+	}
+	deposit, _ := k.GetSyncedDeposit(ctx, borrower)
+
+	ltv, err := k.CalculateBorrowLimitUsage(ctx, deposit, borrow)
+	if err != nil {
+		ltv = sdk.ZeroDec()
+	}
+	k.InsertIntoLtvIndex(ctx, ltv, borrower)
+}
+
+// attemptLiquidation liquidates an individual borrower's position if it is outside of the valid LTV range. If a
+// keeper is provided it is rewarded with a portion of the borrower's deposit.
+func (k Keeper) attemptLiquidation(ctx sdk.Context, keeper sdk.AccAddress, borrower sdk.AccAddress) error {
 	deposit, found := k.GetDeposit(ctx, borrower)
 	if !found {
 		return types.ErrDepositNotFound
@@ -69,10 +143,13 @@ func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper sdk.AccAddress,
 	borrow.Amount = sdk.NewCoins()
 	k.DeleteBorrow(ctx, borrow)
 	k.AfterBorrowModified(ctx, borrow)
+
+	k.RemoveFromLtvIndex(ctx, borrower)
+	k.UpdateIsolatedDebt(ctx, borrower)
 	return nil
 }
 
-// SeizeDeposits seizes a list of deposits and sends them to auction
+// SeizeDeposits seizes a list of deposits and sends them to auction. The keeper, if any, receives a reward.
 func (k Keeper) SeizeDeposits(ctx sdk.Context, keeper sdk.AccAddress, deposit types.Deposit,
 	borrow types.Borrow, dDenoms, bDenoms []string,
 ) error {
@@ -84,6 +161,9 @@ func (k Keeper) SeizeDeposits(ctx sdk.Context, keeper sdk.AccAddress, deposit ty
 	// Seize % of every deposit and send to the keeper
 	keeperRewardCoins := sdk.Coins{}
 	for _, depCoin := range deposit.Amount {
+		if keeper.Empty() {
+			break
+		}
 		mm, _ := k.GetMoneyMarket(ctx, depCoin.Denom)
 		keeperReward := mm.KeeperRewardPercentage.MulInt(depCoin.Amount).TruncateInt()
 		if keeperReward.GT(sdk.ZeroInt()) {
@@ -132,15 +212,17 @@ func (k Keeper) SeizeDeposits(ctx sdk.Context, keeper sdk.AccAddress, deposit ty
 	liquidatedCoins, err := k.StartAuctions(ctx, deposit.Depositor, borrow.Amount, aucDeposits, depositCoinValues, borrowCoinValues, ltv, liqMap)
 	// If some coins were liquidated and sent to auction prior to error, still need to emit liquidation event
 	if !liquidatedCoins.Empty() {
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeHardLiquidation,
-				sdk.NewAttribute(types.AttributeKeyLiquidatedOwner, deposit.Depositor.String()),
-				sdk.NewAttribute(types.AttributeKeyLiquidatedCoins, liquidatedCoins.String()),
+		attributes := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyLiquidatedOwner, deposit.Depositor.String()),
+			sdk.NewAttribute(types.AttributeKeyLiquidatedCoins, liquidatedCoins.String()),
+		}
+		if !keeper.Empty() {
+			attributes = append(attributes,
 				sdk.NewAttribute(types.AttributeKeyKeeper, keeper.String()),
 				sdk.NewAttribute(types.AttributeKeyKeeperRewardCoins, keeperRewardCoins.String()),
-			),
-		)
+			)
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeHardLiquidation, attributes...))
 	}
 	// Returns nil if there's no error
 	return err
@@ -324,6 +406,37 @@ func (k Keeper) IsWithinValidLtvRange(ctx sdk.Context, deposit types.Deposit, bo
 	return true, nil
 }
 
+// CalculateBorrowLimitUsage calculates the value of a borrow as a fraction of the borrow limit of a deposit at current
+// prices. Positions using more than their whole borrow limit are outside of the valid LTV range.
+func (k Keeper) CalculateBorrowLimitUsage(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (sdk.Dec, error) {
+	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	borrowLimit := sdk.ZeroDec()
+	for _, depCoin := range deposit.Amount {
+		lData := liqMap[depCoin.Denom]
+		usdValue := sdk.NewDecFromInt(depCoin.Amount).Quo(sdk.NewDecFromInt(lData.conversionFactor)).Mul(lData.price)
+		borrowLimit = borrowLimit.Add(usdValue.Mul(lData.ltv))
+	}
+
+	borrowValue := sdk.ZeroDec()
+	for _, coin := range borrow.Amount {
+		lData := liqMap[coin.Denom]
+		usdValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(lData.conversionFactor)).Mul(lData.price)
+		borrowValue = borrowValue.Add(usdValue)
+	}
+
+	if !borrowLimit.IsPositive() {
+		if borrowValue.IsPositive() {
+			return sdk.MaxSortableDec, nil
+		}
+		return sdk.ZeroDec(), nil
+	}
+	return borrowValue.Quo(borrowLimit), nil
+}
+
 // GetStoreLTV calculates the user's current LTV based on their deposits/borrows in the store
 // and does not include any outsanding interest.
 func (k Keeper) GetStoreLTV(ctx sdk.Context, addr sdk.AccAddress) (sdk.Dec, error) {
@@ -385,6 +498,12 @@ func (k Keeper) LoadLiquidationData(ctx sdk.Context, deposit types.Deposit, borr
 	depositDenoms := getDenoms(deposit.Amount)
 	denoms := removeDuplicates(borrowDenoms, depositDenoms)
 
+	owner := deposit.Depositor
+	if owner.Empty() {
+		owner = borrow.Borrower
+	}
+	category, inEMode := k.getEModeCategory(ctx, owner)
+
 	// Load required liquidation data for every deposit/borrow denom
 	for _, denom := range denoms {
 		mm, found := k.GetMoneyMarket(ctx, denom)
@@ -397,7 +516,7 @@ func (k Keeper) LoadLiquidationData(ctx sdk.Context, deposit types.Deposit, borr
 			return liqMap, err
 		}
 
-		liqMap[denom] = LiqData{priceData.Price, mm.BorrowLimit.LoanToValue, mm.ConversionFactor}
+		liqMap[denom] = LiqData{priceData.Price, loanToValue(mm, category, inEMode), mm.ConversionFactor}
 	}
 
 	return liqMap, nil
@@ -46,7 +46,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 	}
 
 	// Set up test constants
-	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	model := types.NewJumpRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
 	reserveFactor := sdk.MustNewDecFromStr("0.05")
 	oneMonthDur := time.Second * 30 * 24 * 3600
 	borrower := sdk.AccAddress(crypto.AddressHash([]byte("testborrower")))
@@ -603,6 +603,8 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						tc.args.keeperRewardPercent), // Keeper Reward Percent
 				},
 				sdk.NewDec(10),
+				0, // disable automatic liquidations so the keeper can liquidate the position
+				types.DefaultEModeCategories,
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
 				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
 			)
@@ -763,3 +765,123 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestLiquidateBorrows() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	riskyBorrower, safeBorrower, repaidBorrower := addrs[0], addrs[1], addrs[2]
+
+	err := tApp.FundModuleAccount(ctx, types.ModuleAccountName, cs(c("usdx", 100000000000)))
+	suite.Require().NoError(err)
+
+	tApp.InitializeFromGenesisStates(
+		NewPricefeedGenStateMulti(tApp.AppCodec()),
+		NewHARDGenState(tApp.AppCodec()),
+		app.NewFundedGenStateWithSameCoins(tApp.AppCodec(), cs(c("bnb", 10000000000)), addrs),
+	)
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+	suite.auctionKeeper = tApp.GetAuctionKeeper()
+
+	// Run begin blocker to set up state
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+
+	// 100 bnb at $618.13 allows up to $30,906 to be borrowed
+	for _, borrower := range addrs {
+		err = suite.keeper.Deposit(suite.ctx, borrower, cs(c("bnb", 100000000)))
+		suite.Require().NoError(err)
+	}
+	err = suite.keeper.Borrow(suite.ctx, riskyBorrower, cs(c("usdx", 30000000000)))
+	suite.Require().NoError(err)
+	err = suite.keeper.Borrow(suite.ctx, safeBorrower, cs(c("usdx", 10000000000)))
+	suite.Require().NoError(err)
+	err = suite.keeper.Borrow(suite.ctx, repaidBorrower, cs(c("usdx", 20000000000)))
+	suite.Require().NoError(err)
+	err = suite.keeper.Repay(suite.ctx, repaidBorrower, repaidBorrower, cs(c("usdx", 20000000000)))
+	suite.Require().NoError(err)
+
+	// Borrowers are indexed in order of decreasing LTV and removed once fully repaid
+	suite.Equal([]sdk.AccAddress{riskyBorrower, safeBorrower}, suite.keeper.GetLtvIndexSlice(suite.ctx, 10))
+	suite.Equal([]sdk.AccAddress{riskyBorrower}, suite.keeper.GetLtvIndexSlice(suite.ctx, 1))
+
+	// A price drop to $500 puts the risky borrower outside the valid LTV range
+	pricefeedKeeper := tApp.GetPriceFeedKeeper()
+	_, err = pricefeedKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, "bnb:usd", sdk.MustNewDecFromStr("500.00"), suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	err = pricefeedKeeper.SetCurrentPrices(suite.ctx, "bnb:usd")
+	suite.Require().NoError(err)
+
+	// No borrowers are checked when the count is zero
+	suite.keeper.LiquidateBorrows(suite.ctx, 0)
+	_, found := suite.keeper.GetBorrow(suite.ctx, riskyBorrower)
+	suite.True(found)
+
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+
+	_, found = suite.keeper.GetBorrow(suite.ctx, riskyBorrower)
+	suite.False(found)
+	_, found = suite.keeper.GetDeposit(suite.ctx, riskyBorrower)
+	suite.False(found)
+	suite.NotEmpty(suite.auctionKeeper.GetAllAuctions(suite.ctx))
+
+	_, found = suite.keeper.GetBorrow(suite.ctx, safeBorrower)
+	suite.True(found)
+	suite.Equal([]sdk.AccAddress{safeBorrower}, suite.keeper.GetLtvIndexSlice(suite.ctx, 10))
+
+	// The safe borrower is re-indexed by its LTV relative to its borrow limit at current prices
+	ltv, found := suite.keeper.GetIndexedLtv(suite.ctx, safeBorrower)
+	suite.True(found)
+	suite.Equal(sdk.MustNewDecFromStr("0.4"), ltv)
+}
+
+func (suite *KeeperTestSuite) TestLiquidateBorrows_Sweep() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	stableBorrower, bnbBorrower := addrs[0], addrs[1]
+
+	err := tApp.FundModuleAccount(ctx, types.ModuleAccountName, cs(c("usdx", 100000000000)))
+	suite.Require().NoError(err)
+
+	tApp.InitializeFromGenesisStates(
+		NewPricefeedGenStateMulti(tApp.AppCodec()),
+		NewHARDGenState(tApp.AppCodec()),
+		app.NewFundedGenStateWithSameCoins(tApp.AppCodec(), cs(c("bnb", 10000000000), c("usdx", 10000000000)), addrs),
+	)
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+
+	// The stable borrower uses 95% of its borrow limit whatever the price of bnb, while the bnb borrower uses 81%
+	err = suite.keeper.Deposit(suite.ctx, stableBorrower, cs(c("usdx", 10000000000)))
+	suite.Require().NoError(err)
+	err = suite.keeper.Borrow(suite.ctx, stableBorrower, cs(c("usdx", 9500000000)))
+	suite.Require().NoError(err)
+	err = suite.keeper.Deposit(suite.ctx, bnbBorrower, cs(c("bnb", 100000000)))
+	suite.Require().NoError(err)
+	err = suite.keeper.Borrow(suite.ctx, bnbBorrower, cs(c("usdx", 25000000000)))
+	suite.Require().NoError(err)
+	suite.Equal([]sdk.AccAddress{stableBorrower, bnbBorrower}, suite.keeper.GetLtvIndexSlice(suite.ctx, 10))
+
+	// A price drop to $300 puts the bnb borrower outside the valid LTV range, but its index entry is out of date
+	pricefeedKeeper := tApp.GetPriceFeedKeeper()
+	_, err = pricefeedKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, "bnb:usd", sdk.MustNewDecFromStr("300.00"), suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	err = pricefeedKeeper.SetCurrentPrices(suite.ctx, "bnb:usd")
+	suite.Require().NoError(err)
+
+	// Checking only the top of the index would never reach the bnb borrower, the sweep reaches it within two blocks
+	for i := 0; i < 2; i++ {
+		suite.keeper.LiquidateBorrows(suite.ctx, 1)
+	}
+
+	_, found := suite.keeper.GetBorrow(suite.ctx, bnbBorrower)
+	suite.False(found)
+	_, found = suite.keeper.GetBorrow(suite.ctx, stableBorrower)
+	suite.True(found)
+	suite.Equal([]sdk.AccAddress{stableBorrower}, suite.keeper.GetLtvIndexSlice(suite.ctx, 10))
+}
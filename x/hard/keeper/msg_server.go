@@ -74,7 +74,16 @@ func (k msgServer) Borrow(goCtx context.Context, msg *types.MsgBorrow) (*types.M
 		return nil, err
 	}
 
-	err = k.keeper.Borrow(ctx, borrower, msg.Amount)
+	if msg.Owner == "" || msg.Owner == msg.Borrower {
+		err = k.keeper.Borrow(ctx, borrower, msg.Amount)
+	} else {
+		var owner sdk.AccAddress
+		owner, err = sdk.AccAddressFromBech32(msg.Owner)
+		if err != nil {
+			return nil, err
+		}
+		err = k.keeper.BorrowWithCreditDelegation(ctx, borrower, owner, msg.Amount)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -144,3 +153,82 @@ func (k msgServer) Liquidate(goCtx context.Context, msg *types.MsgLiquidate) (*t
 	)
 	return &types.MsgLiquidateResponse{}, nil
 }
+
+func (k msgServer) GrantCreditDelegation(goCtx context.Context, msg *types.MsgGrantCreditDelegation) (*types.MsgGrantCreditDelegationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	supplier, err := sdk.AccAddressFromBech32(msg.Supplier)
+	if err != nil {
+		return nil, err
+	}
+
+	delegate, err := sdk.AccAddressFromBech32(msg.Delegate)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.GrantCreditDelegation(ctx, supplier, delegate, msg.Allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Supplier),
+		),
+	)
+	return &types.MsgGrantCreditDelegationResponse{}, nil
+}
+
+func (k msgServer) RevokeCreditDelegation(goCtx context.Context, msg *types.MsgRevokeCreditDelegation) (*types.MsgRevokeCreditDelegationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	supplier, err := sdk.AccAddressFromBech32(msg.Supplier)
+	if err != nil {
+		return nil, err
+	}
+
+	delegate, err := sdk.AccAddressFromBech32(msg.Delegate)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.RevokeCreditDelegation(ctx, supplier, delegate)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Supplier),
+		),
+	)
+	return &types.MsgRevokeCreditDelegationResponse{}, nil
+}
+
+func (k msgServer) SetEModeCategory(goCtx context.Context, msg *types.MsgSetEModeCategory) (*types.MsgSetEModeCategoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.keeper.SetEModeCategory(ctx, owner, msg.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+		),
+	)
+	return &types.MsgSetEModeCategoryResponse{}, nil
+}
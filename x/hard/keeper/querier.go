@@ -428,13 +428,9 @@ func queryGetInterestRate(ctx sdk.Context, req abci.RequestQuery, k Keeper, lega
 			reserves = sdk.NewCoins()
 		}
 
-		// CalculateBorrowRate calculates the current interest rate based on utilization (the fraction of supply that has been borrowed)
-		borrowAPY, err := CalculateBorrowRate(moneyMarket.InterestRateModel, sdk.NewDecFromInt(cash), sdk.NewDecFromInt(borrowed.Amount), sdk.NewDecFromInt(reserves.AmountOf(denom)))
-		if err != nil {
-			return nil, err
-		}
-
+		// GetBorrowRate calculates the current interest rate based on utilization (the fraction of supply that has been borrowed)
 		utilRatio := CalculateUtilizationRatio(sdk.NewDecFromInt(cash), sdk.NewDecFromInt(borrowed.Amount), sdk.NewDecFromInt(reserves.AmountOf(denom)))
+		borrowAPY := k.GetBorrowRate(ctx, moneyMarket, utilRatio)
 		fullSupplyAPY := borrowAPY.Mul(utilRatio)
 		realSupplyAPY := fullSupplyAPY.Mul(sdk.OneDec().Sub(moneyMarket.ReserveFactor))
 
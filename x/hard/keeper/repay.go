@@ -68,6 +68,13 @@ func (k Keeper) Repay(ctx sdk.Context, sender, owner sdk.AccAddress, coins sdk.C
 
 	// Call incentive hook
 	k.AfterBorrowModified(ctx, borrow)
+	k.UpdateLtvIndex(ctx, owner)
+	k.UpdateIsolatedDebt(ctx, owner)
+
+	// Repayments by a delegate restore its credit delegation allowance
+	if !sender.Equals(owner) {
+		k.restoreCreditDelegation(ctx, owner, sender, payment)
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
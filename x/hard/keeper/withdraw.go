@@ -78,6 +78,8 @@ func (k Keeper) Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Co
 
 	// Call incentive hook
 	k.AfterDepositModified(ctx, deposit)
+	k.UpdateLtvIndex(ctx, depositor)
+	k.UpdateIsolatedDebt(ctx, depositor)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -2,6 +2,7 @@ package v0_16
 
 import (
 	sdkmath "cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	v015hard "github.com/incubus-network/fury/x/hard/legacy/v0_15"
@@ -11,6 +12,14 @@ import (
 // Denom generated via: echo -n transfer/channel-0/uatom | shasum -a 256 | awk '{printf "ibc/%s",toupper($1)}'
 const UATOM_IBC_DENOM = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
 
+func mustPackInterestRateModel(model v016hard.InterestRateModel) *codectypes.Any {
+	modelAny, err := v016hard.PackInterestRateModel(model)
+	if err != nil {
+		panic(err)
+	}
+	return modelAny
+}
+
 func migrateParams(params v015hard.Params) v016hard.Params {
 	var moneyMarkets []v016hard.MoneyMarket
 	for _, mm := range params.MoneyMarkets {
@@ -23,12 +32,12 @@ func migrateParams(params v015hard.Params) v016hard.Params {
 			},
 			SpotMarketID:     mm.SpotMarketID,
 			ConversionFactor: mm.ConversionFactor,
-			InterestRateModel: v016hard.InterestRateModel{
-				BaseRateAPY:    mm.InterestRateModel.BaseRateAPY,
-				BaseMultiplier: mm.InterestRateModel.BaseMultiplier,
-				Kink:           mm.InterestRateModel.Kink,
-				JumpMultiplier: mm.InterestRateModel.JumpMultiplier,
-			},
+			InterestRateModel: mustPackInterestRateModel(v016hard.NewJumpRateModel(
+				mm.InterestRateModel.BaseRateAPY,
+				mm.InterestRateModel.BaseMultiplier,
+				mm.InterestRateModel.Kink,
+				mm.InterestRateModel.JumpMultiplier,
+			)),
 			ReserveFactor:          mm.ReserveFactor,
 			KeeperRewardPercentage: mm.KeeperRewardPercentage,
 		}
@@ -44,12 +53,12 @@ func migrateParams(params v015hard.Params) v016hard.Params {
 		},
 		SpotMarketID:     "atom:usd:30",
 		ConversionFactor: sdkmath.NewInt(1000000),
-		InterestRateModel: v016hard.InterestRateModel{
-			BaseRateAPY:    sdk.ZeroDec(),
-			BaseMultiplier: sdk.MustNewDecFromStr("0.05"),
-			Kink:           sdk.MustNewDecFromStr("0.8"),
-			JumpMultiplier: sdk.NewDec(5),
-		},
+		InterestRateModel: mustPackInterestRateModel(v016hard.NewJumpRateModel(
+			sdk.ZeroDec(),
+			sdk.MustNewDecFromStr("0.05"),
+			sdk.MustNewDecFromStr("0.8"),
+			sdk.NewDec(5),
+		)),
 		ReserveFactor:          sdk.MustNewDecFromStr("0.025"),
 		KeeperRewardPercentage: sdk.MustNewDecFromStr("0.02"),
 	}
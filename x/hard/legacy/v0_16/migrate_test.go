@@ -124,12 +124,12 @@ func (s *migrateTestSuite) TestMigrate_GenState() {
 					},
 					SpotMarketID:     "spot-market-id",
 					ConversionFactor: sdkmath.NewInt(110),
-					InterestRateModel: v016hard.InterestRateModel{
-						BaseRateAPY:    sdk.MustNewDecFromStr("0.1"),
-						BaseMultiplier: sdk.MustNewDecFromStr("0.2"),
-						Kink:           sdk.MustNewDecFromStr("0.3"),
-						JumpMultiplier: sdk.MustNewDecFromStr("0.4"),
-					},
+					InterestRateModel: mustPackInterestRateModel(v016hard.NewJumpRateModel(
+						sdk.MustNewDecFromStr("0.1"),
+						sdk.MustNewDecFromStr("0.2"),
+						sdk.MustNewDecFromStr("0.3"),
+						sdk.MustNewDecFromStr("0.4"),
+					)),
 					ReserveFactor:          sdk.MustNewDecFromStr("0.5"),
 					KeeperRewardPercentage: sdk.MustNewDecFromStr("0.6"),
 				},
@@ -142,12 +142,12 @@ func (s *migrateTestSuite) TestMigrate_GenState() {
 					},
 					SpotMarketID:     "atom:usd:30",
 					ConversionFactor: sdkmath.NewInt(1000000),
-					InterestRateModel: v016hard.InterestRateModel{
-						BaseRateAPY:    sdk.ZeroDec(),
-						BaseMultiplier: sdk.MustNewDecFromStr("0.05"),
-						Kink:           sdk.MustNewDecFromStr("0.8"),
-						JumpMultiplier: sdk.NewDec(5),
-					},
+					InterestRateModel: mustPackInterestRateModel(v016hard.NewJumpRateModel(
+						sdk.ZeroDec(),
+						sdk.MustNewDecFromStr("0.05"),
+						sdk.MustNewDecFromStr("0.8"),
+						sdk.NewDec(5),
+					)),
 					ReserveFactor:          sdk.MustNewDecFromStr("0.025"),
 					KeeperRewardPercentage: sdk.MustNewDecFromStr("0.02"),
 				},
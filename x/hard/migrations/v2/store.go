@@ -0,0 +1,138 @@
+package v2
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/incubus-network/fury/x/hard/types"
+)
+
+// legacyInterestRateModelField is the field number of the interest rate model in a v1 money market
+const legacyInterestRateModelField = 5
+
+// MigrateStore performs in-place store migrations for consensus version 2
+// V2 wraps the interest rate model of each money market in an Any so that markets can use different models.
+// The v1 kinked model is carried over unchanged as a JumpRateModel.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, paramstore paramtypes.Subspace) error {
+	if err := migrateParamsStore(ctx, paramstore); err != nil {
+		return err
+	}
+	return migrateMoneyMarketsStore(ctx, storeKey, cdc)
+}
+
+// migrateParamsStore rewrites the money markets param with the interest rate models wrapped in an Any
+func migrateParamsStore(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	bz := paramstore.GetRaw(ctx, types.KeyMoneyMarkets)
+	if len(bz) == 0 {
+		return nil
+	}
+
+	var legacyMoneyMarkets []map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &legacyMoneyMarkets); err != nil {
+		return err
+	}
+
+	moneyMarkets := make(types.MoneyMarkets, len(legacyMoneyMarkets))
+	for i, legacyMoneyMarket := range legacyMoneyMarkets {
+		var model types.JumpRateModel
+		if err := json.Unmarshal(legacyMoneyMarket["interest_rate_model"], &model); err != nil {
+			return err
+		}
+		delete(legacyMoneyMarket, "interest_rate_model")
+
+		mmBz, err := json.Marshal(legacyMoneyMarket)
+		if err != nil {
+			return err
+		}
+		var moneyMarket types.MoneyMarket
+		if err := json.Unmarshal(mmBz, &moneyMarket); err != nil {
+			return err
+		}
+
+		moneyMarket.InterestRateModel, err = types.PackInterestRateModel(&model)
+		if err != nil {
+			return err
+		}
+		moneyMarkets[i] = moneyMarket
+	}
+
+	paramstore.Set(ctx, types.KeyMoneyMarkets, moneyMarkets)
+	return nil
+}
+
+// migrateMoneyMarketsStore rewrites the money markets kept in the module store with the interest rate models
+// wrapped in an Any
+func migrateMoneyMarketsStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.MoneyMarketsPrefix)
+
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var moneyMarkets types.MoneyMarkets
+	for ; iterator.Valid(); iterator.Next() {
+		modelBz, err := legacyInterestRateModelBytes(iterator.Value())
+		if err != nil {
+			return err
+		}
+		var model types.JumpRateModel
+		if err := cdc.Unmarshal(modelBz, &model); err != nil {
+			return err
+		}
+
+		var moneyMarket types.MoneyMarket
+		if err := cdc.Unmarshal(iterator.Value(), &moneyMarket); err != nil {
+			return err
+		}
+		moneyMarket.InterestRateModel, err = types.PackInterestRateModel(&model)
+		if err != nil {
+			return err
+		}
+		moneyMarkets = append(moneyMarkets, moneyMarket)
+	}
+
+	for _, moneyMarket := range moneyMarkets {
+		bz, err := cdc.Marshal(&moneyMarket)
+		if err != nil {
+			return err
+		}
+		store.Set([]byte(moneyMarket.Denom), bz)
+	}
+	return nil
+}
+
+// legacyInterestRateModelBytes returns the encoded interest rate model of a v1 money market
+func legacyInterestRateModelBytes(bz []byte) ([]byte, error) {
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		bz = bz[n:]
+
+		if num == legacyInterestRateModelField && typ == protowire.BytesType {
+			value, n := protowire.ConsumeBytes(bz)
+			if n < 0 {
+				return nil, protowire.ParseError(n)
+			}
+			return value, nil
+		}
+
+		n = protowire.ConsumeFieldValue(num, typ, bz)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		bz = bz[n:]
+	}
+	return nil, fmt.Errorf("money market has no interest rate model")
+}
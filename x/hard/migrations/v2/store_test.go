@@ -0,0 +1,91 @@
+package v2_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	v2hard "github.com/incubus-network/fury/x/hard/migrations/v2"
+	"github.com/incubus-network/fury/x/hard/types"
+)
+
+const legacyMoneyMarketsJSON = `[{
+	"denom": "ufury",
+	"borrow_limit": {"has_max_limit": true, "maximum_limit": "1000000.000000000000000000", "loan_to_value": "0.600000000000000000"},
+	"spot_market_id": "fury:usd",
+	"conversion_factor": "1000000",
+	"interest_rate_model": {
+		"base_rate_apy": "0.050000000000000000",
+		"base_multiplier": "2.000000000000000000",
+		"kink": "0.800000000000000000",
+		"jump_multiplier": "10.000000000000000000"
+	},
+	"reserve_factor": "0.050000000000000000",
+	"keeper_reward_percentage": "0.020000000000000000"
+}]`
+
+func TestStoreMigrationWrapsInterestRateModels(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	types.RegisterLegacyAminoCodec(encCfg.Amino)
+	types.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	hardKey := sdk.NewKVStoreKey(types.ModuleName)
+	tHardKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(hardKey, tHardKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, hardKey, tHardKey, types.ModuleName)
+	paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+
+	model := types.NewJumpRateModel(
+		sdk.MustNewDecFromStr("0.05"),
+		sdk.MustNewDecFromStr("2"),
+		sdk.MustNewDecFromStr("0.8"),
+		sdk.MustNewDecFromStr("10"),
+	)
+	expectedMoneyMarket := types.NewMoneyMarket(
+		"ufury",
+		types.NewBorrowLimit(true, sdk.NewDec(1e6), sdk.MustNewDecFromStr("0.6")),
+		"fury:usd",
+		sdkmath.NewInt(1e6),
+		model,
+		sdk.MustNewDecFromStr("0.05"),
+		sdk.MustNewDecFromStr("0.02"),
+	)
+
+	// Set the v1 params, which store the interest rate model as a plain object
+	paramsStore := prefix.NewStore(ctx.KVStore(hardKey), []byte(paramstore.Name()+"/"))
+	paramsStore.Set(types.KeyMoneyMarkets, []byte(legacyMoneyMarketsJSON))
+
+	// Set a v1 money market, which encodes the interest rate model message in field 5
+	legacyMoneyMarket := expectedMoneyMarket
+	legacyMoneyMarket.InterestRateModel = nil
+	legacyBz := encCfg.Codec.MustMarshal(&legacyMoneyMarket)
+	legacyBz = protowire.AppendTag(legacyBz, 5, protowire.BytesType)
+	legacyBz = protowire.AppendBytes(legacyBz, encCfg.Codec.MustMarshal(model))
+	store := prefix.NewStore(ctx.KVStore(hardKey), types.MoneyMarketsPrefix)
+	store.Set([]byte("ufury"), legacyBz)
+
+	// Run migrations.
+	err := v2hard.MigrateStore(ctx, hardKey, encCfg.Codec, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the params hold the wrapped interest rate model
+	var moneyMarkets types.MoneyMarkets
+	paramstore.Get(ctx, types.KeyMoneyMarkets, &moneyMarkets)
+	require.Len(t, moneyMarkets, 1)
+	require.True(t, expectedMoneyMarket.Equal(moneyMarkets[0]))
+	require.Equal(t, model, moneyMarkets[0].GetInterestRateModel())
+
+	// Make sure the stored money market holds the wrapped interest rate model
+	var moneyMarket types.MoneyMarket
+	encCfg.Codec.MustUnmarshal(store.Get([]byte("ufury")), &moneyMarket)
+	require.True(t, expectedMoneyMarket.Equal(moneyMarket))
+	require.Equal(t, model, moneyMarket.GetInterestRateModel())
+}
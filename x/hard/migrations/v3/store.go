@@ -0,0 +1,35 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/incubus-network/fury/x/hard/types"
+)
+
+// LtvIndexer indexes borrowers by their LTV
+type LtvIndexer interface {
+	IterateBorrows(ctx sdk.Context, cb func(borrow types.Borrow) (stop bool))
+	UpdateLtvIndex(ctx sdk.Context, borrower sdk.AccAddress)
+}
+
+// MigrateStore performs in-place store migrations for consensus version 3
+// V3 adds the CheckLtvIndexCount param and indexes all existing borrowers by their LTV so that the
+// riskiest positions can be liquidated each block.
+func MigrateStore(ctx sdk.Context, paramstore paramtypes.Subspace, indexer LtvIndexer) error {
+	migrateParamsStore(ctx, paramstore)
+
+	indexer.IterateBorrows(ctx, func(borrow types.Borrow) bool {
+		indexer.UpdateLtvIndex(ctx, borrow.Borrower)
+		return false
+	})
+	return nil
+}
+
+// migrateParamsStore sets the CheckLtvIndexCount param to its default value
+func migrateParamsStore(ctx sdk.Context, paramstore paramtypes.Subspace) {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+	paramstore.Set(ctx, types.KeyCheckLtvIndexCount, types.DefaultCheckLtvIndexCount)
+}
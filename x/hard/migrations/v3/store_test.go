@@ -0,0 +1,57 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	v3hard "github.com/incubus-network/fury/x/hard/migrations/v3"
+	"github.com/incubus-network/fury/x/hard/types"
+)
+
+type mockLtvIndexer struct {
+	borrows types.Borrows
+	indexed []sdk.AccAddress
+}
+
+func (m *mockLtvIndexer) IterateBorrows(_ sdk.Context, cb func(borrow types.Borrow) (stop bool)) {
+	for _, borrow := range m.borrows {
+		if cb(borrow) {
+			break
+		}
+	}
+}
+
+func (m *mockLtvIndexer) UpdateLtvIndex(_ sdk.Context, borrower sdk.AccAddress) {
+	m.indexed = append(m.indexed, borrower)
+}
+
+func TestStoreMigrationSetsCheckLtvIndexCountAndIndexesBorrowers(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	hardKey := sdk.NewKVStoreKey(types.ModuleName)
+	tHardKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(hardKey, tHardKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, hardKey, tHardKey, types.ModuleName)
+	paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+
+	borrowers := []sdk.AccAddress{sdk.AccAddress("borrower1"), sdk.AccAddress("borrower2")}
+	indexer := &mockLtvIndexer{
+		borrows: types.Borrows{
+			types.NewBorrow(borrowers[0], sdk.NewCoins(sdk.NewInt64Coin("usdx", 100)), types.BorrowInterestFactors{}),
+			types.NewBorrow(borrowers[1], sdk.NewCoins(sdk.NewInt64Coin("usdx", 200)), types.BorrowInterestFactors{}),
+		},
+	}
+
+	err := v3hard.MigrateStore(ctx, paramstore, indexer)
+	require.NoError(t, err)
+
+	var checkLtvIndexCount uint64
+	paramstore.Get(ctx, types.KeyCheckLtvIndexCount, &checkLtvIndexCount)
+	require.Equal(t, types.DefaultCheckLtvIndexCount, checkLtvIndexCount)
+	require.Equal(t, borrowers, indexer.indexed)
+}
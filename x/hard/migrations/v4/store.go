@@ -0,0 +1,24 @@
+package v4
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/incubus-network/fury/x/hard/types"
+)
+
+// MigrateStore performs in-place store migrations for consensus version 4
+// V4 adds the EModeCategories param. Existing money markets are not isolated, so no isolated debt needs to be
+// tracked for existing borrows.
+func MigrateStore(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	migrateParamsStore(ctx, paramstore)
+	return nil
+}
+
+// migrateParamsStore sets the EModeCategories param to its default value
+func migrateParamsStore(ctx sdk.Context, paramstore paramtypes.Subspace) {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+	paramstore.Set(ctx, types.KeyEModeCategories, types.DefaultEModeCategories)
+}
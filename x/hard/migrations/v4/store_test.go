@@ -0,0 +1,33 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	v4hard "github.com/incubus-network/fury/x/hard/migrations/v4"
+	"github.com/incubus-network/fury/x/hard/types"
+)
+
+func TestStoreMigrationSetsEModeCategories(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	hardKey := sdk.NewKVStoreKey(types.ModuleName)
+	tHardKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(hardKey, tHardKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, hardKey, tHardKey, types.ModuleName)
+	paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+
+	require.False(t, paramstore.Has(ctx, types.KeyEModeCategories))
+
+	err := v4hard.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	var categories types.EModeCategories
+	paramstore.Get(ctx, types.KeyEModeCategories, &categories)
+	require.Empty(t, categories)
+}
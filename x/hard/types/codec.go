@@ -15,6 +15,14 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgBorrow{}, "hard/MsgBorrow", nil)
 	cdc.RegisterConcrete(&MsgLiquidate{}, "hard/MsgLiquidate", nil)
 	cdc.RegisterConcrete(&MsgRepay{}, "hard/MsgRepay", nil)
+	cdc.RegisterConcrete(&MsgGrantCreditDelegation{}, "hard/MsgGrantCreditDelegation", nil)
+	cdc.RegisterConcrete(&MsgRevokeCreditDelegation{}, "hard/MsgRevokeCreditDelegation", nil)
+	cdc.RegisterConcrete(&MsgSetEModeCategory{}, "hard/MsgSetEModeCategory", nil)
+
+	cdc.RegisterInterface((*InterestRateModel)(nil), nil)
+	cdc.RegisterConcrete(&JumpRateModel{}, "hard/JumpRateModel", nil)
+	cdc.RegisterConcrete(&TwoKinkRateModel{}, "hard/TwoKinkRateModel", nil)
+	cdc.RegisterConcrete(&AdaptiveRateModel{}, "hard/AdaptiveRateModel", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -24,6 +32,17 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgBorrow{},
 		&MsgLiquidate{},
 		&MsgRepay{},
+		&MsgGrantCreditDelegation{},
+		&MsgRevokeCreditDelegation{},
+		&MsgSetEModeCategory{},
+	)
+
+	registry.RegisterInterface(
+		"fury.hard.v1beta1.InterestRateModel",
+		(*InterestRateModel)(nil),
+		&JumpRateModel{},
+		&TwoKinkRateModel{},
+		&AdaptiveRateModel{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
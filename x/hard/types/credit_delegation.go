@@ -0,0 +1,84 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewCreditDelegation returns a new CreditDelegation instance
+func NewCreditDelegation(supplier, delegate sdk.AccAddress, allowance, borrowed sdk.Coins) CreditDelegation {
+	return CreditDelegation{
+		Supplier:  supplier,
+		Delegate:  delegate,
+		Allowance: allowance,
+		Borrowed:  borrowed,
+	}
+}
+
+// Validate credit delegation validation
+func (cd CreditDelegation) Validate() error {
+	if cd.Supplier.Empty() {
+		return fmt.Errorf("supplier cannot be empty")
+	}
+	if cd.Delegate.Empty() {
+		return fmt.Errorf("delegate cannot be empty")
+	}
+	if cd.Supplier.Equals(cd.Delegate) {
+		return fmt.Errorf("supplier cannot delegate credit to itself: %s", cd.Supplier)
+	}
+	if !cd.Allowance.IsValid() {
+		return fmt.Errorf("invalid allowance coins: %s", cd.Allowance)
+	}
+	if !cd.Borrowed.IsValid() {
+		return fmt.Errorf("invalid borrowed coins: %s", cd.Borrowed)
+	}
+	return nil
+}
+
+// ToResponse converts CreditDelegation to CreditDelegationResponse
+func (cd CreditDelegation) ToResponse() CreditDelegationResponse {
+	return NewCreditDelegationResponse(cd.Supplier, cd.Delegate, cd.Allowance, cd.Borrowed)
+}
+
+// CreditDelegations is a slice of CreditDelegation
+type CreditDelegations []CreditDelegation
+
+// Validate validates CreditDelegations
+func (cds CreditDelegations) Validate() error {
+	seenDelegations := make(map[string]bool)
+	for _, cd := range cds {
+		if err := cd.Validate(); err != nil {
+			return err
+		}
+		key := string(CreditDelegationKey(cd.Supplier, cd.Delegate))
+		if seenDelegations[key] {
+			return fmt.Errorf("duplicate credit delegation from %s to %s", cd.Supplier, cd.Delegate)
+		}
+		seenDelegations[key] = true
+	}
+	return nil
+}
+
+// ToResponse converts CreditDelegations to CreditDelegationResponses
+func (cds CreditDelegations) ToResponse() CreditDelegationResponses {
+	var cdResponses CreditDelegationResponses
+
+	for _, cd := range cds {
+		cdResponses = append(cdResponses, cd.ToResponse())
+	}
+	return cdResponses
+}
+
+// NewCreditDelegationResponse returns a new CreditDelegationResponse instance
+func NewCreditDelegationResponse(supplier, delegate sdk.AccAddress, allowance, borrowed sdk.Coins) CreditDelegationResponse {
+	return CreditDelegationResponse{
+		Supplier:  supplier.String(),
+		Delegate:  delegate.String(),
+		Allowance: allowance,
+		Borrowed:  borrowed,
+	}
+}
+
+// CreditDelegationResponses is a slice of CreditDelegationResponse
+type CreditDelegationResponses []CreditDelegationResponse
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewAccountEModeCategory returns a new AccountEModeCategory instance
+func NewAccountEModeCategory(owner sdk.AccAddress, categoryID uint32) AccountEModeCategory {
+	return AccountEModeCategory{
+		Owner:      owner,
+		CategoryID: categoryID,
+	}
+}
+
+// Validate account e-mode category validation
+func (a AccountEModeCategory) Validate() error {
+	if a.Owner.Empty() {
+		return fmt.Errorf("owner cannot be empty")
+	}
+	if a.CategoryID == 0 {
+		return fmt.Errorf("e-mode category id cannot be zero")
+	}
+	return nil
+}
+
+// AccountEModeCategories is a slice of AccountEModeCategory
+type AccountEModeCategories []AccountEModeCategory
+
+// Validate validates AccountEModeCategories
+func (as AccountEModeCategories) Validate() error {
+	seenOwners := make(map[string]bool)
+	for _, a := range as {
+		if err := a.Validate(); err != nil {
+			return err
+		}
+		if seenOwners[a.Owner.String()] {
+			return fmt.Errorf("duplicate e-mode category for %s", a.Owner)
+		}
+		seenOwners[a.Owner.String()] = true
+	}
+	return nil
+}
+
+// NewIsolatedDebt returns a new IsolatedDebt instance
+func NewIsolatedDebt(borrower sdk.AccAddress, collateralDenom string, normalizedAmount sdk.DecCoins) IsolatedDebt {
+	return IsolatedDebt{
+		Borrower:         borrower,
+		CollateralDenom:  collateralDenom,
+		NormalizedAmount: normalizedAmount,
+	}
+}
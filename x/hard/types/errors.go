@@ -67,4 +67,20 @@ var (
 	ErrExceedsProtocolBorrowableBalance = errorsmod.Register(ModuleName, 31, "exceeds borrowable module account balance")
 	// ErrReservesExceedCash for when the protocol is insolvent because available reserves exceeds available cash
 	ErrReservesExceedCash = errorsmod.Register(ModuleName, 32, "insolvency - protocol reserves exceed available cash")
+	// ErrCreditDelegationNotFound error for when a supplier has not delegated credit to a borrower
+	ErrCreditDelegationNotFound = errorsmod.Register(ModuleName, 33, "credit delegation not found")
+	// ErrExceedsCreditDelegationAllowance error for when a requested borrow exceeds the allowance of a credit delegation
+	ErrExceedsCreditDelegationAllowance = errorsmod.Register(ModuleName, 34, "exceeds credit delegation allowance")
+	// ErrInvalidCreditDelegation error for when a credit delegation cannot be granted
+	ErrInvalidCreditDelegation = errorsmod.Register(ModuleName, 35, "invalid credit delegation")
+	// ErrInvalidIsolatedCollateral error for when a deposit would combine isolated collateral with incompatible positions
+	ErrInvalidIsolatedCollateral = errorsmod.Register(ModuleName, 36, "invalid isolated collateral")
+	// ErrIsolatedBorrowNotAllowed error for when a denom cannot be borrowed against isolated collateral
+	ErrIsolatedBorrowNotAllowed = errorsmod.Register(ModuleName, 37, "borrow not allowed against isolated collateral")
+	// ErrExceedsDebtCeiling error for when a borrow would exceed the debt ceiling of an isolated money market
+	ErrExceedsDebtCeiling = errorsmod.Register(ModuleName, 38, "exceeds isolated money market debt ceiling")
+	// ErrEModeCategoryNotFound error for when an e-mode category does not exist
+	ErrEModeCategoryNotFound = errorsmod.Register(ModuleName, 39, "e-mode category not found")
+	// ErrEModeBorrowNotAllowed error for when a denom cannot be borrowed in an account's e-mode category
+	ErrEModeBorrowNotAllowed = errorsmod.Register(ModuleName, 40, "borrow not allowed in e-mode category")
 )
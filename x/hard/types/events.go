@@ -7,6 +7,10 @@ const (
 	EventTypeHardBorrow           = "hard_borrow"
 	EventTypeHardLiquidation      = "hard_liquidation"
 	EventTypeHardRepay            = "hard_repay"
+	EventTypeHardGrantCredit      = "hard_grant_credit_delegation"
+	EventTypeHardRevokeCredit     = "hard_revoke_credit_delegation"
+	EventTypeHardDelegatedBorrow  = "hard_delegated_borrow"
+	EventTypeHardSetEModeCategory = "hard_set_e_mode_category"
 	AttributeValueCategory        = ModuleName
 	AttributeKeyDeposit           = "deposit"
 	AttributeKeyDepositDenom      = "deposit_denom"
@@ -22,4 +26,8 @@ const (
 	AttributeKeyKeeper            = "keeper"
 	AttributeKeyKeeperRewardCoins = "keeper_reward_coins"
 	AttributeKeyOwner             = "owner"
+	AttributeKeySupplier          = "supplier"
+	AttributeKeyDelegate          = "delegate"
+	AttributeKeyAllowance         = "allowance"
+	AttributeKeyEModeCategoryID   = "e_mode_category_id"
 )
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -61,9 +62,28 @@ func (gs GenesisState) Validate() error {
 	if !gs.TotalReserves.IsValid() {
 		return fmt.Errorf("invalid total reserves coins: %s", gs.TotalReserves)
 	}
+	if err := gs.RatesAtTarget.Validate(); err != nil {
+		return err
+	}
+	if err := gs.CreditDelegations.Validate(); err != nil {
+		return err
+	}
+	if err := gs.AccountEModeCategories.Validate(); err != nil {
+		return err
+	}
+	for _, a := range gs.AccountEModeCategories {
+		if _, found := gs.Params.EModeCategories.Get(a.CategoryID); !found {
+			return fmt.Errorf("e-mode category %d of %s not found", a.CategoryID, a.Owner)
+		}
+	}
 	return nil
 }
 
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return gs.Params.UnpackInterfaces(unpacker)
+}
+
 // NewGenesisAccumulationTime returns a new GenesisAccumulationTime
 func NewGenesisAccumulationTime(ctype string, prevTime time.Time, supplyFactor, borrowFactor sdk.Dec) GenesisAccumulationTime {
 	return GenesisAccumulationTime{
@@ -97,3 +117,40 @@ func (gat GenesisAccumulationTime) Validate() error {
 	}
 	return nil
 }
+
+// NewGenesisRateAtTarget returns a new GenesisRateAtTarget
+func NewGenesisRateAtTarget(denom string, rateAtTarget sdk.Dec) GenesisRateAtTarget {
+	return GenesisRateAtTarget{
+		Denom:        denom,
+		RateAtTarget: rateAtTarget,
+	}
+}
+
+// Validate performs validation of GenesisRateAtTarget
+func (grt GenesisRateAtTarget) Validate() error {
+	if err := sdk.ValidateDenom(grt.Denom); err != nil {
+		return err
+	}
+	if grt.RateAtTarget.IsNil() || grt.RateAtTarget.IsNegative() {
+		return fmt.Errorf("rate at target should be ≥ 0.0, is %s for %s", grt.RateAtTarget, grt.Denom)
+	}
+	return nil
+}
+
+// GenesisRatesAtTarget slice of GenesisRateAtTarget
+type GenesisRatesAtTarget []GenesisRateAtTarget
+
+// Validate performs validation of GenesisRatesAtTarget
+func (grts GenesisRatesAtTarget) Validate() error {
+	seenDenoms := make(map[string]bool)
+	for _, grt := range grts {
+		if err := grt.Validate(); err != nil {
+			return err
+		}
+		if seenDenoms[grt.Denom] {
+			return fmt.Errorf("duplicate rate at target for %s", grt.Denom)
+		}
+		seenDenoms[grt.Denom] = true
+	}
+	return nil
+}
@@ -39,13 +39,16 @@ type GenesisState struct {
 	TotalSupplied             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total_supplied,json=totalSupplied,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_supplied"`
 	TotalBorrowed             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=total_borrowed,json=totalBorrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_borrowed"`
 	TotalReserves             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=total_reserves,json=totalReserves,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_reserves"`
+	RatesAtTarget             GenesisRatesAtTarget                     `protobuf:"bytes,8,rep,name=rates_at_target,json=ratesAtTarget,proto3,castrepeated=GenesisRatesAtTarget" json:"rates_at_target"`
+	CreditDelegations         CreditDelegations                        `protobuf:"bytes,9,rep,name=credit_delegations,json=creditDelegations,proto3,castrepeated=CreditDelegations" json:"credit_delegations"`
+	AccountEModeCategories    AccountEModeCategories                   `protobuf:"bytes,10,rep,name=account_e_mode_categories,json=accountEModeCategories,proto3,castrepeated=AccountEModeCategories" json:"account_e_mode_categories"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20a1f6c2cf728e74, []int{0}
+	return fileDescriptor_770e279a4224a6a0, []int{0}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -123,6 +126,27 @@ func (m *GenesisState) GetTotalReserves() github_com_cosmos_cosmos_sdk_types.Coi
 	return nil
 }
 
+func (m *GenesisState) GetRatesAtTarget() GenesisRatesAtTarget {
+	if m != nil {
+		return m.RatesAtTarget
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCreditDelegations() CreditDelegations {
+	if m != nil {
+		return m.CreditDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccountEModeCategories() AccountEModeCategories {
+	if m != nil {
+		return m.AccountEModeCategories
+	}
+	return nil
+}
+
 // GenesisAccumulationTime stores the previous distribution time and its corresponding denom.
 type GenesisAccumulationTime struct {
 	CollateralType           string                                 `protobuf:"bytes,1,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
@@ -135,7 +159,7 @@ func (m *GenesisAccumulationTime) Reset()         { *m = GenesisAccumulationTime
 func (m *GenesisAccumulationTime) String() string { return proto.CompactTextString(m) }
 func (*GenesisAccumulationTime) ProtoMessage()    {}
 func (*GenesisAccumulationTime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20a1f6c2cf728e74, []int{1}
+	return fileDescriptor_770e279a4224a6a0, []int{1}
 }
 func (m *GenesisAccumulationTime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -178,52 +202,111 @@ func (m *GenesisAccumulationTime) GetPreviousAccumulationTime() time.Time {
 	return time.Time{}
 }
 
+// GenesisRateAtTarget stores the current rate at target utilization of a money market using an adaptive interest
+// rate model.
+type GenesisRateAtTarget struct {
+	Denom        string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	RateAtTarget github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate_at_target,json=rateAtTarget,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate_at_target"`
+}
+
+func (m *GenesisRateAtTarget) Reset()         { *m = GenesisRateAtTarget{} }
+func (m *GenesisRateAtTarget) String() string { return proto.CompactTextString(m) }
+func (*GenesisRateAtTarget) ProtoMessage()    {}
+func (*GenesisRateAtTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_770e279a4224a6a0, []int{2}
+}
+func (m *GenesisRateAtTarget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisRateAtTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisRateAtTarget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisRateAtTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisRateAtTarget.Merge(m, src)
+}
+func (m *GenesisRateAtTarget) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisRateAtTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisRateAtTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisRateAtTarget proto.InternalMessageInfo
+
+func (m *GenesisRateAtTarget) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "fury.hard.v1beta1.GenesisState")
 	proto.RegisterType((*GenesisAccumulationTime)(nil), "fury.hard.v1beta1.GenesisAccumulationTime")
+	proto.RegisterType((*GenesisRateAtTarget)(nil), "fury.hard.v1beta1.GenesisRateAtTarget")
 }
 
-func init() { proto.RegisterFile("fury/hard/v1beta1/genesis.proto", fileDescriptor_20a1f6c2cf728e74) }
+func init() { proto.RegisterFile("fury/hard/v1beta1/genesis.proto", fileDescriptor_770e279a4224a6a0) }
 
-var fileDescriptor_20a1f6c2cf728e74 = []byte{
-	// 590 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0xe3, 0xa6, 0x6d, 0xc2, 0x16, 0x5a, 0xb0, 0x2a, 0x70, 0x02, 0xb2, 0xa3, 0x1e, 0x20,
-	0x42, 0x8a, 0x4d, 0xcb, 0x81, 0x0b, 0x07, 0x30, 0x11, 0x1f, 0x37, 0xe4, 0xe6, 0xc4, 0xc5, 0x5a,
-	0x3b, 0x5b, 0xd7, 0xaa, 0x9d, 0xb5, 0x76, 0xd6, 0x81, 0xbc, 0x03, 0x42, 0x7d, 0x0e, 0xce, 0x3c,
-	0x01, 0xa7, 0x1e, 0x2b, 0x4e, 0x88, 0x43, 0x8b, 0x92, 0x17, 0x41, 0xfb, 0x91, 0xa4, 0x28, 0x89,
-	0xc4, 0x81, 0x9e, 0xe2, 0xdd, 0xfd, 0xcf, 0xff, 0x37, 0x3b, 0x3b, 0x13, 0xe4, 0x9c, 0xe0, 0x21,
-	0xf6, 0x8e, 0x31, 0xeb, 0x7b, 0xc3, 0xfd, 0x88, 0x70, 0xbc, 0xef, 0x25, 0x64, 0x40, 0x20, 0x05,
-	0xb7, 0x60, 0x94, 0x53, 0xf3, 0x8e, 0x10, 0xb8, 0x42, 0xe0, 0x6a, 0x41, 0xd3, 0x8e, 0x29, 0xe4,
-	0x14, 0xbc, 0x08, 0x03, 0x99, 0x45, 0xc5, 0x34, 0x1d, 0xa8, 0x90, 0x66, 0x43, 0x9d, 0x87, 0x72,
-	0xe5, 0xa9, 0x85, 0x3e, 0xda, 0x4d, 0x68, 0x42, 0xd5, 0xbe, 0xf8, 0xd2, 0xbb, 0x4e, 0x42, 0x69,
-	0x92, 0x11, 0x4f, 0xae, 0xa2, 0xf2, 0xc8, 0xe3, 0x69, 0x4e, 0x80, 0xe3, 0xbc, 0xd0, 0x82, 0x07,
-	0x8b, 0x59, 0xca, 0x8c, 0xe4, 0xe9, 0xde, 0xf7, 0x0d, 0x74, 0xf3, 0x8d, 0x4a, 0xfa, 0x90, 0x63,
-	0x4e, 0xcc, 0x67, 0x68, 0xb3, 0xc0, 0x0c, 0xe7, 0x60, 0x19, 0x2d, 0xa3, 0xbd, 0x75, 0xd0, 0x70,
-	0x17, 0x2e, 0xe1, 0xbe, 0x97, 0x02, 0x7f, 0xfd, 0xec, 0xc2, 0xa9, 0x04, 0x5a, 0x6e, 0x7e, 0x36,
-	0xd0, 0xfd, 0x82, 0x91, 0x61, 0x4a, 0x4b, 0x08, 0x71, 0x1c, 0x97, 0x79, 0x99, 0x61, 0x9e, 0xd2,
-	0x41, 0x28, 0x33, 0xb2, 0xd6, 0x5a, 0xd5, 0xf6, 0xd6, 0xc1, 0xe3, 0x25, 0x76, 0x9a, 0xff, 0xf2,
-	0x4a, 0x4c, 0x2f, 0xcd, 0x89, 0xdf, 0x12, 0xfe, 0x5f, 0x2f, 0x1d, 0x6b, 0x85, 0x00, 0x82, 0xc6,
-	0x14, 0xb8, 0x70, 0x64, 0xbe, 0x45, 0xf5, 0x3e, 0x29, 0x28, 0xa4, 0x1c, 0xac, 0xaa, 0x44, 0x37,
-	0x97, 0xa0, 0xbb, 0x4a, 0xe2, 0xdf, 0xd6, 0xa8, 0xba, 0xde, 0x80, 0x60, 0x16, 0x6d, 0x76, 0x51,
-	0x2d, 0xa2, 0x8c, 0xd1, 0x8f, 0x60, 0xad, 0x4b, 0xa3, 0x65, 0x25, 0xf1, 0xa5, 0xc2, 0xdf, 0xd1,
-	0x3e, 0x35, 0xb5, 0x86, 0x60, 0x1a, 0x6a, 0x32, 0xb4, 0xcd, 0x29, 0xc7, 0x59, 0x08, 0x65, 0x51,
-	0x64, 0x29, 0xe9, 0x5b, 0x1b, 0xda, 0x4c, 0x3f, 0xb2, 0xe8, 0x88, 0x99, 0xdd, 0x2b, 0x9a, 0x0e,
-	0xfc, 0x27, 0xda, 0xac, 0x9d, 0xa4, 0xfc, 0xb8, 0x8c, 0xdc, 0x98, 0xe6, 0xba, 0x23, 0xf4, 0x4f,
-	0x07, 0xfa, 0x27, 0x1e, 0x1f, 0x15, 0x04, 0x64, 0x00, 0x04, 0xb7, 0x24, 0xe2, 0x50, 0x13, 0xe6,
-	0x4c, 0x95, 0x04, 0xe9, 0x5b, 0x9b, 0xd7, 0xc5, 0xf4, 0x35, 0x61, 0xce, 0x64, 0x04, 0x08, 0x1b,
-	0x12, 0xb0, 0x6a, 0xd7, 0xc5, 0x0c, 0x34, 0x61, 0xef, 0x4b, 0x15, 0xdd, 0x5b, 0xd1, 0x23, 0xe6,
-	0x23, 0xb4, 0x13, 0xd3, 0x2c, 0xc3, 0x9c, 0x30, 0x9c, 0x85, 0xc2, 0x44, 0x36, 0xf6, 0x8d, 0x60,
-	0x7b, 0xbe, 0xdd, 0x1b, 0x15, 0xc4, 0x8c, 0x50, 0x73, 0x75, 0xfb, 0x5a, 0x6b, 0x72, 0x18, 0x9a,
-	0xae, 0x9a, 0x36, 0x77, 0x3a, 0x6d, 0x6e, 0x6f, 0x3a, 0x6d, 0x7e, 0x5d, 0xdc, 0xe2, 0xf4, 0xd2,
-	0x31, 0x02, 0x6b, 0x55, 0x57, 0x9a, 0x0c, 0xdd, 0x95, 0xcf, 0x3f, 0x0a, 0xd3, 0x01, 0x27, 0x8c,
-	0x00, 0x0f, 0x8f, 0x70, 0xcc, 0x29, 0xb3, 0xaa, 0x22, 0x27, 0xff, 0xb9, 0xf0, 0xf8, 0x75, 0xe1,
-	0x3c, 0xfc, 0x87, 0x4a, 0x74, 0x49, 0xfc, 0xe3, 0x5b, 0x07, 0xe9, 0xaa, 0x76, 0x49, 0x1c, 0xec,
-	0x2a, 0xef, 0x77, 0xda, 0xfa, 0xb5, 0x74, 0x16, 0x4c, 0xf5, 0xfc, 0x0b, 0xcc, 0xf5, 0xff, 0xc1,
-	0x54, 0xde, 0x7f, 0x33, 0xfd, 0x17, 0x67, 0x63, 0xdb, 0x38, 0x1f, 0xdb, 0xc6, 0xef, 0xb1, 0x6d,
-	0x9c, 0x4e, 0xec, 0xca, 0xf9, 0xc4, 0xae, 0xfc, 0x9c, 0xd8, 0x95, 0x0f, 0x57, 0x29, 0x62, 0x8a,
-	0x3a, 0x19, 0x8e, 0x40, 0x7e, 0x79, 0x9f, 0xd4, 0x9f, 0x94, 0x24, 0x45, 0x9b, 0xb2, 0xc2, 0x4f,
-	0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x16, 0xde, 0x3f, 0x0d, 0x64, 0x05, 0x00, 0x00,
+var fileDescriptor_770e279a4224a6a0 = []byte{
+	// 760 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xcf, 0x4f, 0xdb, 0x48,
+	0x14, 0xc7, 0x63, 0xc2, 0x8f, 0x30, 0xfc, 0x5a, 0xbc, 0x11, 0xeb, 0x64, 0x91, 0x13, 0xb1, 0x12,
+	0xa0, 0xd5, 0x62, 0x2f, 0xec, 0x61, 0x2f, 0x7b, 0xc1, 0x64, 0xd9, 0xed, 0xa1, 0x52, 0x65, 0x72,
+	0xea, 0xc5, 0x1a, 0xdb, 0x0f, 0x63, 0x61, 0x7b, 0xac, 0x99, 0x31, 0x34, 0xd7, 0x9e, 0xab, 0x96,
+	0x3f, 0xa2, 0xa7, 0x9e, 0xfb, 0x47, 0x70, 0x44, 0x3d, 0x55, 0x3d, 0x40, 0x05, 0xff, 0x48, 0xe5,
+	0x99, 0x49, 0x48, 0x49, 0x22, 0xf5, 0x00, 0x27, 0x78, 0xf3, 0xbe, 0xef, 0xfb, 0x19, 0x98, 0xf7,
+	0x9e, 0x51, 0xeb, 0xb8, 0xa0, 0x3d, 0xfb, 0x04, 0xd3, 0xd0, 0x3e, 0xdb, 0xf5, 0x81, 0xe3, 0x5d,
+	0x3b, 0x82, 0x0c, 0x58, 0xcc, 0xac, 0x9c, 0x12, 0x4e, 0xf4, 0xd5, 0x52, 0x60, 0x95, 0x02, 0x4b,
+	0x09, 0x9a, 0x66, 0x40, 0x58, 0x4a, 0x98, 0xed, 0x63, 0x06, 0x83, 0xaa, 0x80, 0xc4, 0x99, 0x2c,
+	0x69, 0x36, 0x64, 0xde, 0x13, 0x91, 0x2d, 0x03, 0x95, 0x5a, 0x1f, 0xc5, 0x09, 0x6b, 0x99, 0xad,
+	0x47, 0x24, 0x22, 0xb2, 0xaa, 0xfc, 0x4d, 0x9d, 0xb6, 0x22, 0x42, 0xa2, 0x04, 0x6c, 0x11, 0xf9,
+	0xc5, 0xb1, 0xcd, 0xe3, 0x14, 0x18, 0xc7, 0x69, 0x2e, 0x05, 0x1b, 0xef, 0x6b, 0x68, 0xf1, 0x3f,
+	0x79, 0xe9, 0x23, 0x8e, 0x39, 0xe8, 0x7f, 0xa3, 0xd9, 0x1c, 0x53, 0x9c, 0x32, 0x43, 0x6b, 0x6b,
+	0xdb, 0x0b, 0x7b, 0x0d, 0x6b, 0xe4, 0x8f, 0xb0, 0x5e, 0x08, 0x81, 0x33, 0x7d, 0x79, 0xdd, 0xaa,
+	0xb8, 0x4a, 0xae, 0xbf, 0xd1, 0xd0, 0xaf, 0x39, 0x85, 0xb3, 0x98, 0x14, 0xcc, 0xc3, 0x41, 0x50,
+	0xa4, 0x45, 0x82, 0x79, 0x4c, 0x32, 0x4f, 0x30, 0x8d, 0xa9, 0x76, 0x75, 0x7b, 0x61, 0xef, 0xf7,
+	0x31, 0x76, 0x8a, 0xbf, 0x3f, 0x54, 0xd3, 0x8d, 0x53, 0x70, 0xda, 0xa5, 0xff, 0x87, 0x9b, 0x96,
+	0x31, 0x41, 0xc0, 0xdc, 0x46, 0x1f, 0x38, 0x92, 0xd2, 0xff, 0x47, 0xb5, 0x10, 0x72, 0xc2, 0x62,
+	0xce, 0x8c, 0xaa, 0x40, 0x37, 0xc7, 0xa0, 0x3b, 0x52, 0xe2, 0xfc, 0xa4, 0x50, 0x35, 0x75, 0xc0,
+	0xdc, 0x41, 0xb5, 0xde, 0x41, 0x73, 0x3e, 0xa1, 0x94, 0x9c, 0x33, 0x63, 0xba, 0x5d, 0x9d, 0xf0,
+	0x2f, 0x71, 0x84, 0xc2, 0x59, 0x51, 0x3e, 0x73, 0x32, 0x66, 0x6e, 0xbf, 0x54, 0xa7, 0x68, 0x99,
+	0x13, 0x8e, 0x13, 0x8f, 0x15, 0x79, 0x9e, 0xc4, 0x10, 0x1a, 0x33, 0xca, 0x4c, 0x3d, 0x72, 0xd9,
+	0x11, 0x03, 0xbb, 0x03, 0x12, 0x67, 0xce, 0x9f, 0xca, 0x6c, 0x3b, 0x8a, 0xf9, 0x49, 0xe1, 0x5b,
+	0x01, 0x49, 0x55, 0x47, 0xa8, 0x1f, 0x3b, 0x2c, 0x3c, 0xb5, 0x79, 0x2f, 0x07, 0x26, 0x0a, 0x98,
+	0xbb, 0x24, 0x10, 0x47, 0x8a, 0x70, 0xcf, 0x94, 0x97, 0x80, 0xd0, 0x98, 0x7d, 0x2a, 0xa6, 0xa3,
+	0x08, 0xf7, 0x4c, 0x0a, 0x0c, 0xe8, 0x19, 0x30, 0x63, 0xee, 0xa9, 0x98, 0xae, 0x22, 0xe8, 0x09,
+	0x5a, 0xa1, 0x98, 0x03, 0xf3, 0x30, 0xf7, 0x38, 0xa6, 0x11, 0x70, 0xa3, 0x26, 0xa0, 0x9b, 0x93,
+	0xbb, 0xcd, 0xc5, 0x1c, 0xf6, 0x79, 0x57, 0xa8, 0x9d, 0x75, 0x75, 0x83, 0xfa, 0x50, 0x92, 0xf5,
+	0xb3, 0xee, 0x12, 0x1d, 0x0e, 0xf5, 0x14, 0xe9, 0x01, 0x85, 0x30, 0xe6, 0x5e, 0x08, 0x09, 0x44,
+	0xa2, 0xe7, 0x98, 0x31, 0x2f, 0x80, 0xbf, 0x8d, 0x01, 0x1e, 0x08, 0x71, 0x67, 0xa0, 0x75, 0x1a,
+	0x8a, 0xb6, 0xfa, 0x30, 0xc3, 0xdc, 0xd5, 0xe0, 0xe1, 0x91, 0xfe, 0x5a, 0x43, 0x0d, 0x1c, 0x04,
+	0xa4, 0xc8, 0xb8, 0x07, 0x5e, 0x4a, 0x42, 0xf0, 0x02, 0xcc, 0x21, 0x22, 0x34, 0x06, 0x66, 0x20,
+	0x81, 0xdd, 0x1a, 0x83, 0xdd, 0x97, 0x35, 0xff, 0x3e, 0x27, 0x21, 0x1c, 0xc8, 0x82, 0x9e, 0x63,
+	0x2a, 0xf4, 0xda, 0x98, 0x6c, 0x0c, 0xcc, 0x5d, 0xc3, 0x63, 0xcf, 0x37, 0xde, 0x56, 0xd1, 0x2f,
+	0x13, 0xa6, 0x50, 0xdf, 0x42, 0x2b, 0x01, 0x49, 0x12, 0xcc, 0x81, 0xe2, 0xc4, 0x2b, 0x9f, 0x49,
+	0xac, 0x8e, 0x79, 0x77, 0xf9, 0xfe, 0xb8, 0xdb, 0xcb, 0x41, 0xf7, 0x51, 0x73, 0xf2, 0x82, 0x30,
+	0xa6, 0xc4, 0xba, 0x69, 0x5a, 0x72, 0x63, 0x59, 0xfd, 0x8d, 0x65, 0x75, 0xfb, 0x1b, 0xcb, 0xa9,
+	0x95, 0x97, 0xbf, 0xb8, 0x69, 0x69, 0xae, 0x31, 0x69, 0xee, 0x75, 0x8a, 0xd6, 0xc4, 0x80, 0xf5,
+	0xbc, 0x38, 0xe3, 0x40, 0x81, 0x71, 0xef, 0x18, 0x07, 0x9c, 0x50, 0xa3, 0x5a, 0xde, 0xc9, 0xf9,
+	0xa7, 0xf4, 0xf8, 0x72, 0xdd, 0xda, 0xfc, 0x81, 0x5e, 0xeb, 0x40, 0xf0, 0xe9, 0xe3, 0x0e, 0x92,
+	0xe7, 0x65, 0xe4, 0xd6, 0xa5, 0xf7, 0x33, 0x65, 0x7d, 0x28, 0x9c, 0x4b, 0xa6, 0x1c, 0xb0, 0x11,
+	0xe6, 0xf4, 0x63, 0x30, 0xa5, 0xf7, 0xf7, 0xcc, 0x8d, 0x77, 0x1a, 0xfa, 0x79, 0x4c, 0x27, 0xeb,
+	0x75, 0x34, 0x13, 0x42, 0x46, 0x52, 0xf5, 0x04, 0x32, 0xd0, 0x7d, 0xb4, 0x5c, 0xf6, 0xf0, 0xd0,
+	0x7c, 0x4c, 0x3d, 0xc2, 0xcd, 0x16, 0xe9, 0xf0, 0x0c, 0x1d, 0x5e, 0xde, 0x9a, 0xda, 0xd5, 0xad,
+	0xa9, 0x7d, 0xbd, 0x35, 0xb5, 0x8b, 0x3b, 0xb3, 0x72, 0x75, 0x67, 0x56, 0x3e, 0xdf, 0x99, 0x95,
+	0x97, 0x7f, 0x0c, 0xb9, 0xc7, 0x59, 0x50, 0xf8, 0x05, 0xdb, 0xc9, 0x80, 0x9f, 0x13, 0x7a, 0x6a,
+	0x8b, 0x6f, 0xda, 0x2b, 0xf9, 0x55, 0x13, 0x1c, 0x7f, 0x56, 0xbc, 0xfc, 0x5f, 0xdf, 0x06, 0x00,
+	0xfe, 0x53, 0xd9, 0x7e, 0x5e, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -246,6 +329,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AccountEModeCategories) > 0 {
+		for iNdEx := len(m.AccountEModeCategories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountEModeCategories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.CreditDelegations) > 0 {
+		for iNdEx := len(m.CreditDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreditDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.RatesAtTarget) > 0 {
+		for iNdEx := len(m.RatesAtTarget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RatesAtTarget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.TotalReserves) > 0 {
 		for iNdEx := len(m.TotalReserves) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -401,6 +526,46 @@ func (m *GenesisAccumulationTime) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisRateAtTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisRateAtTarget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisRateAtTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RateAtTarget.Size()
+		i -= size
+		if _, err := m.RateAtTarget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -456,6 +621,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RatesAtTarget) > 0 {
+		for _, e := range m.RatesAtTarget {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CreditDelegations) > 0 {
+		for _, e := range m.CreditDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccountEModeCategories) > 0 {
+		for _, e := range m.AccountEModeCategories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -478,6 +661,21 @@ func (m *GenesisAccumulationTime) Size() (n int) {
 	return n
 }
 
+func (m *GenesisRateAtTarget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.RateAtTarget.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -750,6 +948,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RatesAtTarget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RatesAtTarget = append(m.RatesAtTarget, GenesisRateAtTarget{})
+			if err := m.RatesAtTarget[len(m.RatesAtTarget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditDelegations = append(m.CreditDelegations, CreditDelegation{})
+			if err := m.CreditDelegations[len(m.CreditDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountEModeCategories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountEModeCategories = append(m.AccountEModeCategories, AccountEModeCategory{})
+			if err := m.AccountEModeCategories[len(m.AccountEModeCategories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -954,6 +1254,122 @@ func (m *GenesisAccumulationTime) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GenesisRateAtTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisRateAtTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisRateAtTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateAtTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateAtTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,8 +6,9 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -30,13 +31,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	MoneyMarkets          MoneyMarkets                           `protobuf:"bytes,1,rep,name=money_markets,json=moneyMarkets,proto3,castrepeated=MoneyMarkets" json:"money_markets"`
 	MinimumBorrowUSDValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=minimum_borrow_usd_value,json=minimumBorrowUsdValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"minimum_borrow_usd_value"`
+	// check_ltv_index_count is the number of borrowers with the highest LTV, and of swept borrowers, checked for
+	// liquidation each block
+	CheckLtvIndexCount uint64 `protobuf:"varint,3,opt,name=check_ltv_index_count,json=checkLtvIndexCount,proto3" json:"check_ltv_index_count,omitempty"`
+	// e_mode_categories are groups of correlated money markets that accounts can opt into for a higher loan-to-value
+	EModeCategories EModeCategories `protobuf:"bytes,4,rep,name=e_mode_categories,json=eModeCategories,proto3,castrepeated=EModeCategories" json:"e_mode_categories"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{0}
+	return fileDescriptor_ca59072e0228ae54, []int{0}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -67,20 +73,23 @@ var xxx_messageInfo_Params proto.InternalMessageInfo
 
 // MoneyMarket is a money market for an individual asset.
 type MoneyMarket struct {
-	Denom                  string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	BorrowLimit            BorrowLimit                            `protobuf:"bytes,2,opt,name=borrow_limit,json=borrowLimit,proto3" json:"borrow_limit"`
-	SpotMarketID           string                                 `protobuf:"bytes,3,opt,name=spot_market_id,json=spotMarketId,proto3" json:"spot_market_id,omitempty"`
-	ConversionFactor       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=conversion_factor,json=conversionFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"conversion_factor"`
-	InterestRateModel      InterestRateModel                      `protobuf:"bytes,5,opt,name=interest_rate_model,json=interestRateModel,proto3" json:"interest_rate_model"`
+	Denom            string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	BorrowLimit      BorrowLimit                            `protobuf:"bytes,2,opt,name=borrow_limit,json=borrowLimit,proto3" json:"borrow_limit"`
+	SpotMarketID     string                                 `protobuf:"bytes,3,opt,name=spot_market_id,json=spotMarketId,proto3" json:"spot_market_id,omitempty"`
+	ConversionFactor github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=conversion_factor,json=conversionFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"conversion_factor"`
+	// interest_rate_model determines the borrow rate of the market from its utilization.
+	InterestRateModel      *types.Any                             `protobuf:"bytes,8,opt,name=interest_rate_model,json=interestRateModel,proto3" json:"interest_rate_model,omitempty"`
 	ReserveFactor          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=reserve_factor,json=reserveFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reserve_factor"`
 	KeeperRewardPercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=keeper_reward_percentage,json=keeperRewardPercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"keeper_reward_percentage"`
+	// isolation restricts what may be borrowed against the market's denom, unset for markets in the shared pool.
+	Isolation *Isolation `protobuf:"bytes,9,opt,name=isolation,proto3" json:"isolation,omitempty"`
 }
 
 func (m *MoneyMarket) Reset()         { *m = MoneyMarket{} }
 func (m *MoneyMarket) String() string { return proto.CompactTextString(m) }
 func (*MoneyMarket) ProtoMessage()    {}
 func (*MoneyMarket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{1}
+	return fileDescriptor_ca59072e0228ae54, []int{1}
 }
 func (m *MoneyMarket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -109,6 +118,90 @@ func (m *MoneyMarket) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MoneyMarket proto.InternalMessageInfo
 
+// Isolation restricts the borrows backed by the deposits of an isolated money market.
+type Isolation struct {
+	// debt_ceiling is the maximum USD value that may be borrowed by accounts depositing the isolated denom.
+	DebtCeiling github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=debt_ceiling,json=debtCeiling,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"debt_ceiling"`
+	// borrow_denoms are the denoms that may be borrowed by accounts depositing the isolated denom.
+	BorrowDenoms []string `protobuf:"bytes,2,rep,name=borrow_denoms,json=borrowDenoms,proto3" json:"borrow_denoms,omitempty"`
+}
+
+func (m *Isolation) Reset()         { *m = Isolation{} }
+func (m *Isolation) String() string { return proto.CompactTextString(m) }
+func (*Isolation) ProtoMessage()    {}
+func (*Isolation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca59072e0228ae54, []int{2}
+}
+func (m *Isolation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Isolation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Isolation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Isolation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Isolation.Merge(m, src)
+}
+func (m *Isolation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Isolation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Isolation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Isolation proto.InternalMessageInfo
+
+// EModeCategory is a group of correlated money markets. Accounts in the category may only borrow the category's
+// denoms, and their deposits of the category's denoms count towards their borrow limit at the category's
+// loan-to-value.
+type EModeCategory struct {
+	ID          uint32                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	LoanToValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=loan_to_value,json=loanToValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"loan_to_value"`
+	Denoms      []string                               `protobuf:"bytes,4,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *EModeCategory) Reset()         { *m = EModeCategory{} }
+func (m *EModeCategory) String() string { return proto.CompactTextString(m) }
+func (*EModeCategory) ProtoMessage()    {}
+func (*EModeCategory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca59072e0228ae54, []int{3}
+}
+func (m *EModeCategory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EModeCategory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EModeCategory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EModeCategory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EModeCategory.Merge(m, src)
+}
+func (m *EModeCategory) XXX_Size() int {
+	return m.Size()
+}
+func (m *EModeCategory) XXX_DiscardUnknown() {
+	xxx_messageInfo_EModeCategory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EModeCategory proto.InternalMessageInfo
+
 // BorrowLimit enforces restrictions on a money market.
 type BorrowLimit struct {
 	HasMaxLimit  bool                                   `protobuf:"varint,1,opt,name=has_max_limit,json=hasMaxLimit,proto3" json:"has_max_limit"`
@@ -120,7 +213,7 @@ func (m *BorrowLimit) Reset()         { *m = BorrowLimit{} }
 func (m *BorrowLimit) String() string { return proto.CompactTextString(m) }
 func (*BorrowLimit) ProtoMessage()    {}
 func (*BorrowLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{2}
+	return fileDescriptor_ca59072e0228ae54, []int{4}
 }
 func (m *BorrowLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -149,26 +242,122 @@ func (m *BorrowLimit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BorrowLimit proto.InternalMessageInfo
 
-// InterestRateModel contains information about an asset's interest rate.
-type InterestRateModel struct {
+// JumpRateModel is a kinked interest rate model. Below the kink the borrow rate increases with utilization by the
+// base multiplier, above the kink it increases by the jump multiplier.
+type JumpRateModel struct {
 	BaseRateAPY    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=base_rate_apy,json=baseRateApy,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_rate_apy"`
 	BaseMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_multiplier,json=baseMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_multiplier"`
 	Kink           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=kink,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"kink"`
 	JumpMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=jump_multiplier,json=jumpMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"jump_multiplier"`
 }
 
-func (m *InterestRateModel) Reset()         { *m = InterestRateModel{} }
-func (m *InterestRateModel) String() string { return proto.CompactTextString(m) }
-func (*InterestRateModel) ProtoMessage()    {}
-func (*InterestRateModel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{3}
+func (m *JumpRateModel) Reset()         { *m = JumpRateModel{} }
+func (m *JumpRateModel) String() string { return proto.CompactTextString(m) }
+func (*JumpRateModel) ProtoMessage()    {}
+func (*JumpRateModel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca59072e0228ae54, []int{5}
+}
+func (m *JumpRateModel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JumpRateModel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JumpRateModel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JumpRateModel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JumpRateModel.Merge(m, src)
+}
+func (m *JumpRateModel) XXX_Size() int {
+	return m.Size()
+}
+func (m *JumpRateModel) XXX_DiscardUnknown() {
+	xxx_messageInfo_JumpRateModel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JumpRateModel proto.InternalMessageInfo
+
+// TwoKinkRateModel is an interest rate model with two inflection points. The borrow rate increases by the base
+// multiplier up to the first kink, by the mid multiplier between the kinks and by the jump multiplier above the
+// second kink.
+type TwoKinkRateModel struct {
+	BaseRateAPY    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=base_rate_apy,json=baseRateApy,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_rate_apy"`
+	BaseMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_multiplier,json=baseMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_multiplier"`
+	FirstKink      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=first_kink,json=firstKink,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"first_kink"`
+	MidMultiplier  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=mid_multiplier,json=midMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mid_multiplier"`
+	SecondKink     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=second_kink,json=secondKink,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"second_kink"`
+	JumpMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=jump_multiplier,json=jumpMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"jump_multiplier"`
+}
+
+func (m *TwoKinkRateModel) Reset()         { *m = TwoKinkRateModel{} }
+func (m *TwoKinkRateModel) String() string { return proto.CompactTextString(m) }
+func (*TwoKinkRateModel) ProtoMessage()    {}
+func (*TwoKinkRateModel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca59072e0228ae54, []int{6}
+}
+func (m *TwoKinkRateModel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwoKinkRateModel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwoKinkRateModel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwoKinkRateModel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwoKinkRateModel.Merge(m, src)
+}
+func (m *TwoKinkRateModel) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwoKinkRateModel) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwoKinkRateModel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwoKinkRateModel proto.InternalMessageInfo
+
+// AdaptiveRateModel is an interest rate model whose curve drifts over time so that utilization converges on a
+// target. While utilization is above the target the rate at target increases, while below it decreases.
+type AdaptiveRateModel struct {
+	// target_utilization is the utilization ratio the model steers the market towards.
+	TargetUtilization github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=target_utilization,json=targetUtilization,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_utilization"`
+	// initial_rate_at_target is the borrow APY at the target utilization when the market is first created.
+	InitialRateAtTarget github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=initial_rate_at_target,json=initialRateAtTarget,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"initial_rate_at_target"`
+	// min_rate_at_target is the lowest the borrow APY at the target utilization can drift to.
+	MinRateAtTarget github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=min_rate_at_target,json=minRateAtTarget,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_rate_at_target"`
+	// max_rate_at_target is the highest the borrow APY at the target utilization can drift to.
+	MaxRateAtTarget github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_rate_at_target,json=maxRateAtTarget,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_rate_at_target"`
+	// adjustment_speed is the yearly relative change of the rate at target when utilization is at 100% or 0%.
+	AdjustmentSpeed github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=adjustment_speed,json=adjustmentSpeed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"adjustment_speed"`
+	// curve_steepness is the ratio between the rate at 100% utilization and the rate at the target utilization.
+	CurveSteepness github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=curve_steepness,json=curveSteepness,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"curve_steepness"`
 }
-func (m *InterestRateModel) XXX_Unmarshal(b []byte) error {
+
+func (m *AdaptiveRateModel) Reset()         { *m = AdaptiveRateModel{} }
+func (m *AdaptiveRateModel) String() string { return proto.CompactTextString(m) }
+func (*AdaptiveRateModel) ProtoMessage()    {}
+func (*AdaptiveRateModel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca59072e0228ae54, []int{7}
+}
+func (m *AdaptiveRateModel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InterestRateModel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AdaptiveRateModel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InterestRateModel.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AdaptiveRateModel.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -178,17 +367,17 @@ func (m *InterestRateModel) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *InterestRateModel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InterestRateModel.Merge(m, src)
+func (m *AdaptiveRateModel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdaptiveRateModel.Merge(m, src)
 }
-func (m *InterestRateModel) XXX_Size() int {
+func (m *AdaptiveRateModel) XXX_Size() int {
 	return m.Size()
 }
-func (m *InterestRateModel) XXX_DiscardUnknown() {
-	xxx_messageInfo_InterestRateModel.DiscardUnknown(m)
+func (m *AdaptiveRateModel) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdaptiveRateModel.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InterestRateModel proto.InternalMessageInfo
+var xxx_messageInfo_AdaptiveRateModel proto.InternalMessageInfo
 
 // Deposit defines an amount of coins deposited into a hard module account.
 type Deposit struct {
@@ -201,7 +390,7 @@ func (m *Deposit) Reset()         { *m = Deposit{} }
 func (m *Deposit) String() string { return proto.CompactTextString(m) }
 func (*Deposit) ProtoMessage()    {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{4}
+	return fileDescriptor_ca59072e0228ae54, []int{8}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -241,7 +430,7 @@ func (m *Borrow) Reset()         { *m = Borrow{} }
 func (m *Borrow) String() string { return proto.CompactTextString(m) }
 func (*Borrow) ProtoMessage()    {}
 func (*Borrow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{5}
+	return fileDescriptor_ca59072e0228ae54, []int{9}
 }
 func (m *Borrow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -270,6 +459,133 @@ func (m *Borrow) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Borrow proto.InternalMessageInfo
 
+// CreditDelegation defines an allowance granted by a supplier for a delegate to borrow against the supplier's deposit.
+type CreditDelegation struct {
+	// supplier is the depositor whose position carries the debt of the delegate's borrows.
+	Supplier github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=supplier,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"supplier,omitempty"`
+	// delegate is the address that may borrow against the supplier's deposit and receives the borrowed funds.
+	Delegate github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=delegate,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegate,omitempty"`
+	// allowance is the remaining amount of each denom the delegate may borrow.
+	Allowance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=allowance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"allowance"`
+	// borrowed is the amount borrowed by the delegate that has not been repaid by the delegate.
+	Borrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=borrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"borrowed"`
+}
+
+func (m *CreditDelegation) Reset()         { *m = CreditDelegation{} }
+func (m *CreditDelegation) String() string { return proto.CompactTextString(m) }
+func (*CreditDelegation) ProtoMessage()    {}
+func (*CreditDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca59072e0228ae54, []int{10}
+}
+func (m *CreditDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreditDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreditDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreditDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreditDelegation.Merge(m, src)
+}
+func (m *CreditDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreditDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreditDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreditDelegation proto.InternalMessageInfo
+
+// IsolatedDebt defines the amount borrowed by an account against the deposit of an isolated money market.
+type IsolatedDebt struct {
+	Borrower github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=borrower,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"borrower,omitempty"`
+	// collateral_denom is the denom of the isolated money market.
+	CollateralDenom string `protobuf:"bytes,2,opt,name=collateral_denom,json=collateralDenom,proto3" json:"collateral_denom,omitempty"`
+	// normalized_amount is the amount borrowed divided by the borrow interest factor of each denom, so that the debt
+	// including accrued interest is the normalized amount multiplied by the current interest factors.
+	NormalizedAmount github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=normalized_amount,json=normalizedAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"normalized_amount"`
+}
+
+func (m *IsolatedDebt) Reset()         { *m = IsolatedDebt{} }
+func (m *IsolatedDebt) String() string { return proto.CompactTextString(m) }
+func (*IsolatedDebt) ProtoMessage()    {}
+func (*IsolatedDebt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca59072e0228ae54, []int{11}
+}
+func (m *IsolatedDebt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IsolatedDebt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IsolatedDebt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IsolatedDebt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IsolatedDebt.Merge(m, src)
+}
+func (m *IsolatedDebt) XXX_Size() int {
+	return m.Size()
+}
+func (m *IsolatedDebt) XXX_DiscardUnknown() {
+	xxx_messageInfo_IsolatedDebt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IsolatedDebt proto.InternalMessageInfo
+
+// AccountEModeCategory defines the e-mode category an account has opted into.
+type AccountEModeCategory struct {
+	Owner      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	CategoryID uint32                                        `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+}
+
+func (m *AccountEModeCategory) Reset()         { *m = AccountEModeCategory{} }
+func (m *AccountEModeCategory) String() string { return proto.CompactTextString(m) }
+func (*AccountEModeCategory) ProtoMessage()    {}
+func (*AccountEModeCategory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca59072e0228ae54, []int{12}
+}
+func (m *AccountEModeCategory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountEModeCategory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountEModeCategory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountEModeCategory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountEModeCategory.Merge(m, src)
+}
+func (m *AccountEModeCategory) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountEModeCategory) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountEModeCategory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountEModeCategory proto.InternalMessageInfo
+
 // SupplyInterestFactor defines an individual borrow interest factor.
 type SupplyInterestFactor struct {
 	Denom string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -280,7 +596,7 @@ func (m *SupplyInterestFactor) Reset()         { *m = SupplyInterestFactor{} }
 func (m *SupplyInterestFactor) String() string { return proto.CompactTextString(m) }
 func (*SupplyInterestFactor) ProtoMessage()    {}
 func (*SupplyInterestFactor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{6}
+	return fileDescriptor_ca59072e0228ae54, []int{13}
 }
 func (m *SupplyInterestFactor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +635,7 @@ func (m *BorrowInterestFactor) Reset()         { *m = BorrowInterestFactor{} }
 func (m *BorrowInterestFactor) String() string { return proto.CompactTextString(m) }
 func (*BorrowInterestFactor) ProtoMessage()    {}
 func (*BorrowInterestFactor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{7}
+	return fileDescriptor_ca59072e0228ae54, []int{14}
 }
 func (m *BorrowInterestFactor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -357,7 +673,7 @@ func (m *CoinsProto) Reset()         { *m = CoinsProto{} }
 func (m *CoinsProto) String() string { return proto.CompactTextString(m) }
 func (*CoinsProto) ProtoMessage()    {}
 func (*CoinsProto) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a5de800263a2ff, []int{8}
+	return fileDescriptor_ca59072e0228ae54, []int{15}
 }
 func (m *CoinsProto) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,79 +702,166 @@ func (m *CoinsProto) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CoinsProto proto.InternalMessageInfo
 
+// DecCoinsProto defines a Protobuf wrapper around a DecCoins slice
+type DecCoinsProto struct {
+	Coins github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"coins"`
+}
+
+func (m *DecCoinsProto) Reset()         { *m = DecCoinsProto{} }
+func (m *DecCoinsProto) String() string { return proto.CompactTextString(m) }
+func (*DecCoinsProto) ProtoMessage()    {}
+func (*DecCoinsProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca59072e0228ae54, []int{16}
+}
+func (m *DecCoinsProto) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecCoinsProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecCoinsProto.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecCoinsProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecCoinsProto.Merge(m, src)
+}
+func (m *DecCoinsProto) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecCoinsProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecCoinsProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecCoinsProto proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "fury.hard.v1beta1.Params")
 	proto.RegisterType((*MoneyMarket)(nil), "fury.hard.v1beta1.MoneyMarket")
+	proto.RegisterType((*Isolation)(nil), "fury.hard.v1beta1.Isolation")
+	proto.RegisterType((*EModeCategory)(nil), "fury.hard.v1beta1.EModeCategory")
 	proto.RegisterType((*BorrowLimit)(nil), "fury.hard.v1beta1.BorrowLimit")
-	proto.RegisterType((*InterestRateModel)(nil), "fury.hard.v1beta1.InterestRateModel")
+	proto.RegisterType((*JumpRateModel)(nil), "fury.hard.v1beta1.JumpRateModel")
+	proto.RegisterType((*TwoKinkRateModel)(nil), "fury.hard.v1beta1.TwoKinkRateModel")
+	proto.RegisterType((*AdaptiveRateModel)(nil), "fury.hard.v1beta1.AdaptiveRateModel")
 	proto.RegisterType((*Deposit)(nil), "fury.hard.v1beta1.Deposit")
 	proto.RegisterType((*Borrow)(nil), "fury.hard.v1beta1.Borrow")
+	proto.RegisterType((*CreditDelegation)(nil), "fury.hard.v1beta1.CreditDelegation")
+	proto.RegisterType((*IsolatedDebt)(nil), "fury.hard.v1beta1.IsolatedDebt")
+	proto.RegisterType((*AccountEModeCategory)(nil), "fury.hard.v1beta1.AccountEModeCategory")
 	proto.RegisterType((*SupplyInterestFactor)(nil), "fury.hard.v1beta1.SupplyInterestFactor")
 	proto.RegisterType((*BorrowInterestFactor)(nil), "fury.hard.v1beta1.BorrowInterestFactor")
 	proto.RegisterType((*CoinsProto)(nil), "fury.hard.v1beta1.CoinsProto")
+	proto.RegisterType((*DecCoinsProto)(nil), "fury.hard.v1beta1.DecCoinsProto")
 }
 
-func init() { proto.RegisterFile("fury/hard/v1beta1/hard.proto", fileDescriptor_23a5de800263a2ff) }
-
-var fileDescriptor_23a5de800263a2ff = []byte{
-	// 911 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xbd, 0x8f, 0x1b, 0x45,
-	0x14, 0xf7, 0x9e, 0x3f, 0x92, 0x8c, 0xed, 0x23, 0xde, 0xdc, 0xa1, 0x4d, 0x04, 0xeb, 0xc8, 0x42,
-	0x70, 0x8d, 0x6d, 0x02, 0x82, 0x8a, 0xe6, 0x16, 0x0b, 0x38, 0x81, 0x25, 0x6b, 0x8f, 0x20, 0x25,
-	0x42, 0x5a, 0xc6, 0xbb, 0x2f, 0x77, 0x83, 0x3d, 0x3b, 0xab, 0x99, 0xb1, 0x63, 0x77, 0xb4, 0x34,
-	0x88, 0x3f, 0x82, 0x8a, 0x0e, 0xe9, 0xfe, 0x88, 0x2b, 0xa3, 0x54, 0x88, 0xc2, 0x80, 0xaf, 0xa3,
-	0xa6, 0xa2, 0x42, 0xf3, 0xe1, 0x8f, 0x5c, 0x1c, 0x29, 0xa7, 0x58, 0x88, 0x6a, 0x77, 0xe6, 0xbd,
-	0xf9, 0xbd, 0xdf, 0xfb, 0xcd, 0x9b, 0x99, 0x87, 0xde, 0x18, 0xe0, 0x31, 0x6e, 0x9f, 0x62, 0x9e,
-	0xb4, 0xc7, 0xf7, 0xfa, 0x20, 0xf1, 0x3d, 0x3d, 0x68, 0x65, 0x9c, 0x49, 0xe6, 0xd6, 0x94, 0xb5,
-	0xa5, 0x27, 0xac, 0xf5, 0x8e, 0x1f, 0x33, 0x41, 0x99, 0x68, 0xf7, 0xb1, 0x80, 0xe5, 0x92, 0x98,
-	0x91, 0xd4, 0x2c, 0xb9, 0x73, 0xdb, 0xd8, 0x23, 0x3d, 0x6a, 0x9b, 0x81, 0x35, 0xed, 0x9d, 0xb0,
-	0x13, 0x66, 0xe6, 0xd5, 0x9f, 0x99, 0x6d, 0xfc, 0xed, 0xa0, 0x52, 0x0f, 0x73, 0x4c, 0x85, 0xfb,
-	0x00, 0x55, 0x29, 0x4b, 0x61, 0x1a, 0x51, 0xcc, 0x07, 0x20, 0x85, 0xe7, 0xdc, 0xcd, 0x1f, 0x94,
-	0xdf, 0xf3, 0x5b, 0xcf, 0xd1, 0x68, 0x75, 0x95, 0x5f, 0x57, 0xbb, 0x05, 0x7b, 0xe7, 0xb3, 0x7a,
-	0xee, 0xe7, 0xdf, 0xeb, 0x95, 0xb5, 0x49, 0x11, 0x56, 0xe8, 0xda, 0xc8, 0xfd, 0xc1, 0x41, 0x1e,
-	0x25, 0x29, 0xa1, 0x23, 0x1a, 0xf5, 0x19, 0xe7, 0xec, 0x71, 0x34, 0x12, 0x49, 0x34, 0xc6, 0xc3,
-	0x11, 0x78, 0x3b, 0x77, 0x9d, 0x83, 0x1b, 0xc1, 0x7d, 0x05, 0xf3, 0xdb, 0xac, 0xfe, 0xf6, 0x09,
-	0x91, 0xa7, 0xa3, 0x7e, 0x2b, 0x66, 0xd4, 0xf2, 0xb7, 0x9f, 0xa6, 0x48, 0x06, 0x6d, 0x39, 0xcd,
-	0x40, 0xb4, 0x3a, 0x10, 0xcf, 0x67, 0xf5, 0xfd, 0xae, 0x41, 0x0c, 0x34, 0xe0, 0xfd, 0xe3, 0xce,
-	0x57, 0x0a, 0xee, 0xe9, 0x59, 0x13, 0xd9, 0xbc, 0x3b, 0x10, 0x87, 0xfb, 0xf4, 0x19, 0x27, 0x91,
-	0x68, 0xa7, 0xc6, 0x79, 0x01, 0x95, 0xd7, 0xf8, 0xba, 0x7b, 0xa8, 0x98, 0x40, 0xca, 0xa8, 0xe7,
-	0x28, 0x32, 0xa1, 0x19, 0xb8, 0x9f, 0xa2, 0x8a, 0x65, 0x3b, 0x24, 0x94, 0x48, 0xcd, 0x74, 0xb3,
-	0x20, 0x06, 0xfe, 0x0b, 0xe5, 0x15, 0x14, 0x54, 0x26, 0x61, 0xb9, 0xbf, 0x9a, 0x72, 0x3f, 0x44,
-	0xbb, 0x22, 0x63, 0xd2, 0x2a, 0x1b, 0x91, 0xc4, 0xcb, 0xeb, 0xa4, 0x6f, 0xce, 0x67, 0xf5, 0xca,
-	0x71, 0xc6, 0xa4, 0xa1, 0x71, 0xd4, 0x09, 0x2b, 0x62, 0x35, 0x4a, 0x5c, 0x82, 0x6a, 0x31, 0x4b,
-	0xc7, 0xc0, 0x05, 0x61, 0x69, 0xf4, 0x08, 0xc7, 0x92, 0x71, 0xaf, 0xa0, 0x97, 0x7e, 0x74, 0x05,
-	0xbd, 0x8e, 0x52, 0xb9, 0x26, 0xcb, 0x51, 0x2a, 0xc3, 0x9b, 0x2b, 0xd8, 0x4f, 0x34, 0xaa, 0xfb,
-	0x10, 0xdd, 0x22, 0xa9, 0x04, 0x0e, 0x42, 0x46, 0x1c, 0x4b, 0x88, 0x28, 0x4b, 0x60, 0xe8, 0x15,
-	0x75, 0xca, 0x6f, 0x6d, 0x48, 0xf9, 0xc8, 0x7a, 0x87, 0x58, 0x42, 0x57, 0xf9, 0xda, 0xc4, 0x6b,
-	0xe4, 0xb2, 0xc1, 0x8d, 0xd1, 0x2e, 0x07, 0x01, 0x7c, 0x0c, 0x8b, 0x1c, 0x4a, 0x57, 0xce, 0xa1,
-	0x03, 0xf1, 0xa5, 0xad, 0xad, 0x5a, 0x4c, 0x9b, 0xc0, 0x18, 0x79, 0x03, 0x80, 0x0c, 0x78, 0xc4,
-	0xe1, 0x31, 0xe6, 0x49, 0x94, 0x01, 0x8f, 0x21, 0x95, 0xf8, 0x04, 0xbc, 0x6b, 0x5b, 0x08, 0xf7,
-	0xba, 0x41, 0x0f, 0x35, 0x78, 0x6f, 0x89, 0xdd, 0xf8, 0x7e, 0x07, 0x95, 0xd7, 0xb6, 0xdf, 0xfd,
-	0x00, 0x55, 0x4f, 0xb1, 0x88, 0x28, 0x9e, 0xd8, 0xaa, 0x51, 0x25, 0x75, 0x3d, 0xa8, 0xfd, 0x35,
-	0xab, 0x3f, 0x6b, 0x08, 0xcb, 0xa7, 0x58, 0x74, 0xf1, 0xc4, 0x2c, 0xc3, 0xa8, 0x4a, 0xf1, 0x44,
-	0x9f, 0x90, 0x55, 0xb1, 0xbd, 0x2a, 0xe7, 0x8a, 0x85, 0x34, 0x21, 0xbe, 0x41, 0xd5, 0x21, 0xc3,
-	0x69, 0x24, 0x99, 0x3d, 0x79, 0xf9, 0x2d, 0x84, 0x28, 0x2b, 0xc8, 0x2f, 0x99, 0x39, 0x56, 0x3f,
-	0xe5, 0x51, 0xed, 0xb9, 0xba, 0x70, 0x19, 0xaa, 0xaa, 0xfb, 0xca, 0x94, 0x15, 0xce, 0xa6, 0xe6,
-	0x90, 0x05, 0x9f, 0x5f, 0xf9, 0xc4, 0x97, 0x03, 0x2c, 0x40, 0xe1, 0x1e, 0xf6, 0x1e, 0x5c, 0xa6,
-	0xd1, 0x5f, 0x98, 0xb2, 0xa9, 0x0b, 0xe8, 0x35, 0x1d, 0x90, 0x8e, 0x86, 0x92, 0x64, 0x43, 0x02,
-	0x7c, 0x2b, 0x6a, 0xee, 0x2a, 0xd0, 0xee, 0x12, 0xd3, 0xed, 0xa1, 0xc2, 0x80, 0xa4, 0x83, 0xad,
-	0xc8, 0xa8, 0x91, 0x14, 0xf1, 0x6f, 0x47, 0x34, 0x5b, 0x27, 0x5e, 0xd8, 0x06, 0x71, 0x05, 0xba,
-	0x22, 0xde, 0x38, 0xdb, 0x41, 0xd7, 0x3a, 0x90, 0x31, 0x41, 0xa4, 0xfb, 0x08, 0xdd, 0x48, 0xcc,
-	0x2f, 0xe3, 0x76, 0x63, 0x3e, 0xfb, 0x67, 0x56, 0x6f, 0xbe, 0x44, 0xa0, 0xc3, 0x38, 0x3e, 0x4c,
-	0x12, 0x0e, 0x42, 0x3c, 0x3d, 0x6b, 0xde, 0xb2, 0xf1, 0xec, 0x4c, 0x30, 0x95, 0x20, 0xc2, 0x15,
-	0xb4, 0x1b, 0xa3, 0x12, 0xa6, 0x6c, 0x94, 0xaa, 0xc2, 0x56, 0xcf, 0xca, 0xed, 0x96, 0x5d, 0xa0,
-	0x44, 0x5d, 0x5e, 0x2a, 0x1f, 0x33, 0x92, 0x06, 0xef, 0xda, 0x17, 0xe5, 0xe0, 0x25, 0x38, 0xa8,
-	0x05, 0x22, 0xb4, 0xd0, 0xee, 0xd7, 0xa8, 0x48, 0xd2, 0x04, 0x26, 0x5e, 0x5e, 0xc7, 0x78, 0x67,
-	0xc3, 0xb5, 0x75, 0x3c, 0xca, 0xb2, 0xe1, 0x74, 0x51, 0xa4, 0xe6, 0xee, 0x08, 0xde, 0xb4, 0x11,
-	0xf7, 0x37, 0x59, 0x45, 0x68, 0x40, 0x1b, 0xbf, 0xec, 0xa0, 0x92, 0x39, 0xe9, 0x6e, 0x82, 0xae,
-	0x9b, 0xfb, 0x1d, 0xb6, 0x2f, 0xda, 0x12, 0xf9, 0x7f, 0xa3, 0x99, 0x49, 0xfa, 0x45, 0x9a, 0x6d,
-	0xb2, 0x2e, 0x35, 0xfb, 0xce, 0x41, 0x7b, 0x9b, 0x44, 0x7d, 0xc1, 0x8b, 0x1b, 0xa2, 0xe2, 0x7a,
-	0x53, 0xf0, 0x6a, 0x65, 0x6f, 0xa0, 0x34, 0x85, 0x4d, 0x1c, 0xff, 0x43, 0x0a, 0x0c, 0x21, 0x2d,
-	0x7a, 0x4f, 0xf7, 0x75, 0x18, 0x15, 0x55, 0xcb, 0xb6, 0x68, 0xb0, 0xb6, 0xba, 0xab, 0x06, 0x39,
-	0xe8, 0x9c, 0xff, 0xe9, 0xe7, 0xce, 0xe7, 0xbe, 0xf3, 0x64, 0xee, 0x3b, 0x7f, 0xcc, 0x7d, 0xe7,
-	0xc7, 0x0b, 0x3f, 0xf7, 0xe4, 0xc2, 0xcf, 0xfd, 0x7a, 0xe1, 0xe7, 0x1e, 0xae, 0xe7, 0xa2, 0x76,
-	0xbb, 0x39, 0xc4, 0x7d, 0xa1, 0xff, 0xda, 0x13, 0xd3, 0x8d, 0x6a, 0xc8, 0x7e, 0x49, 0xf7, 0x88,
-	0xef, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x40, 0xda, 0xa7, 0xd8, 0xa7, 0x0a, 0x00, 0x00,
+func init() { proto.RegisterFile("fury/hard/v1beta1/hard.proto", fileDescriptor_ca59072e0228ae54) }
+
+var fileDescriptor_ca59072e0228ae54 = []byte{
+	// 1554 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4f, 0x6f, 0x23, 0x49,
+	0x15, 0x8f, 0xff, 0x6e, 0xfc, 0x6c, 0x27, 0x76, 0x4d, 0x12, 0x7a, 0x57, 0x83, 0x1d, 0x19, 0x09,
+	0x82, 0x20, 0x36, 0xc3, 0x0a, 0x0e, 0x2b, 0x2e, 0x71, 0xcc, 0x1f, 0xcf, 0x6c, 0xa4, 0xa8, 0x33,
+	0x83, 0xb4, 0x2c, 0xd0, 0x94, 0xbb, 0x2b, 0x4e, 0xad, 0xbb, 0xab, 0x9a, 0xae, 0x6a, 0xc7, 0xde,
+	0x03, 0xe2, 0xba, 0x42, 0x5a, 0xc1, 0x27, 0xe0, 0x88, 0xc4, 0x09, 0x50, 0x10, 0x5f, 0x61, 0xc4,
+	0x69, 0xb5, 0x27, 0xc4, 0xc1, 0x40, 0xe6, 0xc6, 0x47, 0xe0, 0x84, 0xba, 0xaa, 0xfc, 0x27, 0x19,
+	0x47, 0x9a, 0xd1, 0xf4, 0x8c, 0x10, 0x27, 0xbb, 0xea, 0x55, 0xfd, 0xde, 0x7b, 0xbf, 0xfa, 0x55,
+	0x75, 0xd5, 0x83, 0xfb, 0xe7, 0x71, 0x34, 0xed, 0x5c, 0xe0, 0xc8, 0xeb, 0x8c, 0x1f, 0x0c, 0x88,
+	0xc4, 0x0f, 0x54, 0xa3, 0x1d, 0x46, 0x5c, 0x72, 0x54, 0x4f, 0xac, 0x6d, 0xd5, 0x61, 0xac, 0xef,
+	0x34, 0x5c, 0x2e, 0x02, 0x2e, 0x3a, 0x03, 0x2c, 0xc8, 0x62, 0x8a, 0xcb, 0x29, 0xd3, 0x53, 0xde,
+	0x79, 0x5b, 0xdb, 0x1d, 0xd5, 0xea, 0xe8, 0x86, 0x31, 0xed, 0x0c, 0xf9, 0x90, 0xeb, 0xfe, 0xe4,
+	0xdf, 0x7c, 0xc2, 0x90, 0xf3, 0xa1, 0x4f, 0x3a, 0xaa, 0x35, 0x88, 0xcf, 0x3b, 0x98, 0x4d, 0xb5,
+	0xa9, 0xf5, 0x69, 0x0e, 0x8a, 0xa7, 0x38, 0xc2, 0x81, 0x40, 0x1f, 0x40, 0x35, 0xe0, 0x8c, 0x4c,
+	0x9d, 0x00, 0x47, 0x23, 0x22, 0x85, 0x95, 0xd9, 0xcf, 0x1d, 0x94, 0xbf, 0xd9, 0x68, 0x3f, 0x17,
+	0x61, 0xfb, 0x24, 0x19, 0x77, 0xa2, 0x86, 0x75, 0x77, 0x9e, 0xce, 0x9a, 0x1b, 0xbf, 0xff, 0x47,
+	0xb3, 0xb2, 0xd2, 0x29, 0xec, 0x4a, 0xb0, 0xd2, 0x42, 0x9f, 0x66, 0xc0, 0x0a, 0x28, 0xa3, 0x41,
+	0x1c, 0x38, 0x03, 0x1e, 0x45, 0xfc, 0xd2, 0x89, 0x85, 0xe7, 0x8c, 0xb1, 0x1f, 0x13, 0x2b, 0xbb,
+	0x9f, 0x39, 0x28, 0x75, 0x9f, 0x24, 0x30, 0x7f, 0x9f, 0x35, 0xbf, 0x3c, 0xa4, 0xf2, 0x22, 0x1e,
+	0xb4, 0x5d, 0x1e, 0x98, 0xd4, 0xcc, 0xcf, 0xa1, 0xf0, 0x46, 0x1d, 0x39, 0x0d, 0x89, 0x68, 0xf7,
+	0x88, 0x7b, 0x3d, 0x6b, 0xee, 0x9e, 0x68, 0xc4, 0xae, 0x02, 0x7c, 0x72, 0xd6, 0xfb, 0x61, 0x02,
+	0xf7, 0xf9, 0xd5, 0x21, 0x18, 0x4a, 0x7a, 0xc4, 0xb5, 0x77, 0x83, 0x1b, 0x83, 0x84, 0xa7, 0x06,
+	0xa1, 0x07, 0xb0, 0xeb, 0x5e, 0x10, 0x77, 0xe4, 0xf8, 0x72, 0xec, 0x50, 0xe6, 0x91, 0x89, 0xe3,
+	0xf2, 0x98, 0x49, 0x2b, 0xb7, 0x9f, 0x39, 0xc8, 0xdb, 0x48, 0x19, 0xdf, 0x97, 0xe3, 0x7e, 0x62,
+	0x3a, 0x4e, 0x2c, 0x88, 0x40, 0x9d, 0x38, 0x01, 0xf7, 0x88, 0xe3, 0x62, 0x49, 0x86, 0x3c, 0xa2,
+	0x44, 0x58, 0x79, 0x45, 0xd1, 0xfe, 0x1a, 0x8a, 0xbe, 0x7b, 0xc2, 0x3d, 0x72, 0xac, 0x47, 0x4e,
+	0xbb, 0x5f, 0x30, 0x24, 0x6d, 0xaf, 0x76, 0x53, 0x22, 0xec, 0x6d, 0x72, 0xb3, 0xa3, 0xf5, 0xab,
+	0x02, 0x94, 0x57, 0x98, 0x44, 0x3b, 0x50, 0xf0, 0x08, 0xe3, 0x81, 0x95, 0x49, 0x68, 0xb2, 0x75,
+	0x03, 0x7d, 0x1f, 0x2a, 0x86, 0x47, 0x9f, 0x06, 0x54, 0x2a, 0x0e, 0xd7, 0x2f, 0x95, 0x4e, 0xfc,
+	0xfd, 0x64, 0x54, 0x37, 0x9f, 0x44, 0x61, 0x97, 0x07, 0xcb, 0x2e, 0xf4, 0x6d, 0xd8, 0x12, 0x21,
+	0x97, 0x66, 0xcd, 0x1d, 0xea, 0x29, 0x06, 0x4a, 0xdd, 0xda, 0xf5, 0xac, 0x59, 0x39, 0x0b, 0xb9,
+	0xd4, 0x61, 0xf4, 0x7b, 0x76, 0x45, 0x2c, 0x5b, 0x1e, 0xa2, 0x50, 0x77, 0x39, 0x1b, 0x93, 0x48,
+	0x50, 0xce, 0x9c, 0x73, 0xec, 0x4a, 0x1e, 0x59, 0x79, 0x35, 0xf5, 0x3b, 0x2f, 0xb1, 0x92, 0x7d,
+	0x26, 0x57, 0x16, 0xac, 0xcf, 0xa4, 0x5d, 0x5b, 0xc2, 0x7e, 0x4f, 0xa1, 0xa2, 0x0f, 0xe1, 0x1e,
+	0x65, 0x92, 0x44, 0x44, 0x48, 0x27, 0xc2, 0x52, 0x2f, 0x82, 0x6f, 0x6d, 0xaa, 0x94, 0x77, 0xda,
+	0x5a, 0xdb, 0xed, 0xb9, 0xb6, 0xdb, 0x47, 0x6c, 0xda, 0xdd, 0xfd, 0xeb, 0xd5, 0x61, 0xbd, 0x6f,
+	0x26, 0xd9, 0x58, 0x2a, 0x92, 0x7d, 0xbb, 0x4e, 0x6f, 0x77, 0x21, 0x17, 0xb6, 0x22, 0x22, 0x48,
+	0x34, 0x26, 0xf3, 0x24, 0x8a, 0x2f, 0x9d, 0x44, 0x8f, 0xb8, 0xb7, 0x54, 0x57, 0x35, 0x98, 0x26,
+	0x83, 0x31, 0x58, 0x23, 0x42, 0x42, 0x12, 0x39, 0x11, 0xb9, 0xc4, 0x91, 0xe7, 0x84, 0x24, 0x72,
+	0x09, 0x93, 0x78, 0x48, 0xac, 0xb7, 0x52, 0x70, 0xb7, 0xa7, 0xd1, 0x6d, 0x05, 0x7e, 0xba, 0xc0,
+	0x46, 0xef, 0x41, 0x89, 0x0a, 0xee, 0x63, 0x49, 0x39, 0xb3, 0x4a, 0x8a, 0xaf, 0xfb, 0x6b, 0x24,
+	0xd2, 0x9f, 0x8f, 0xb1, 0x97, 0xc3, 0x1f, 0xe6, 0x37, 0x0b, 0xb5, 0x62, 0xeb, 0x37, 0x19, 0x28,
+	0x2d, 0xcc, 0xc8, 0x81, 0x8a, 0x47, 0x06, 0xd2, 0x71, 0x09, 0xf5, 0x29, 0x1b, 0x5a, 0x99, 0x14,
+	0x62, 0x2f, 0x27, 0x88, 0xc7, 0x1a, 0x10, 0x7d, 0x09, 0xaa, 0x46, 0xd6, 0x4a, 0xe6, 0xc2, 0xca,
+	0xee, 0xe7, 0x0e, 0x4a, 0xb6, 0xd1, 0x7a, 0x4f, 0xf5, 0xb5, 0xfe, 0x9c, 0x81, 0xea, 0x8d, 0xdd,
+	0x85, 0xf6, 0x20, 0x4b, 0x3d, 0x15, 0x4d, 0xb5, 0x5b, 0xbc, 0x9e, 0x35, 0xb3, 0xfd, 0x9e, 0x9d,
+	0xa5, 0x1e, 0x42, 0x90, 0x67, 0x38, 0x30, 0x27, 0x8c, 0xad, 0xfe, 0xa3, 0x9f, 0x41, 0xd5, 0xe7,
+	0x98, 0x39, 0x92, 0x9b, 0xe3, 0x27, 0x97, 0x46, 0x12, 0x09, 0xe4, 0x63, 0xae, 0xcf, 0x96, 0x3d,
+	0x28, 0x9a, 0xe8, 0xf3, 0x2a, 0x7a, 0xd3, 0x6a, 0x7d, 0x92, 0x85, 0xf2, 0xca, 0x6e, 0x44, 0xdf,
+	0x82, 0xea, 0x05, 0x16, 0x4e, 0x80, 0x27, 0x66, 0x13, 0x27, 0x09, 0x6c, 0x76, 0xeb, 0xff, 0x9e,
+	0x35, 0x6f, 0x1a, 0xec, 0xf2, 0x05, 0x16, 0x27, 0x78, 0xa2, 0xa7, 0x61, 0xa8, 0x06, 0x78, 0xa2,
+	0x8e, 0xd2, 0xe5, 0xde, 0x7f, 0xd5, 0x04, 0x2a, 0x06, 0x52, 0xbb, 0x78, 0xed, 0x1c, 0xb5, 0xfe,
+	0x92, 0x83, 0xea, 0xc3, 0x38, 0x08, 0x97, 0x1b, 0x91, 0x43, 0x35, 0xf9, 0xde, 0xe9, 0x1d, 0x8e,
+	0xc3, 0xa9, 0x11, 0xd7, 0xa3, 0x97, 0xfe, 0x2c, 0x94, 0xbb, 0x58, 0x90, 0x04, 0xf7, 0xe8, 0xf4,
+	0x83, 0xdb, 0x21, 0x0c, 0xe6, 0xa6, 0x70, 0x8a, 0x08, 0x6c, 0x2b, 0x87, 0x41, 0xec, 0x4b, 0x1a,
+	0xfa, 0x94, 0x44, 0xa9, 0x30, 0xb9, 0x95, 0x80, 0x9e, 0x2c, 0x30, 0xd1, 0x29, 0xe4, 0x47, 0x94,
+	0x8d, 0x52, 0xa1, 0x50, 0x21, 0x25, 0x81, 0x7f, 0x14, 0x07, 0xe1, 0x6a, 0xe0, 0xf9, 0x34, 0x02,
+	0x4f, 0x40, 0x97, 0x81, 0xbf, 0xb7, 0xfe, 0x0c, 0x6d, 0x7d, 0x52, 0x80, 0xda, 0xe3, 0x4b, 0xfe,
+	0x88, 0xb2, 0xd1, 0xff, 0xff, 0xe2, 0x7d, 0x08, 0x70, 0x4e, 0x23, 0x21, 0x9d, 0xd4, 0x96, 0xb0,
+	0xa4, 0xf0, 0x12, 0xf6, 0x92, 0x4f, 0x4f, 0x40, 0xbd, 0xb4, 0x97, 0xb1, 0x1a, 0x50, 0x6f, 0x25,
+	0x83, 0x9f, 0x40, 0x59, 0x10, 0x97, 0x33, 0x4f, 0xa7, 0x50, 0x48, 0xc1, 0x03, 0x68, 0xc0, 0x47,
+	0x77, 0x68, 0xb1, 0xf8, 0xe6, 0xb4, 0xf8, 0x87, 0x02, 0xd4, 0x8f, 0x3c, 0x1c, 0x4a, 0x3a, 0x26,
+	0x4b, 0x31, 0x8e, 0x00, 0x49, 0x1c, 0x0d, 0x89, 0x74, 0x62, 0x49, 0x7d, 0xfa, 0xb1, 0xfe, 0xfc,
+	0xa5, 0xf1, 0xad, 0xaa, 0x6b, 0xdc, 0x27, 0x4b, 0x58, 0xf4, 0x73, 0xd8, 0xa3, 0x8c, 0x4a, 0x8a,
+	0x7d, 0x23, 0x7e, 0xe9, 0xe8, 0x41, 0xa9, 0xe8, 0xf1, 0x9e, 0xc1, 0x56, 0xb2, 0x97, 0x8f, 0x15,
+	0x30, 0xa2, 0x80, 0x02, 0xca, 0x6e, 0xbb, 0x4b, 0x43, 0x9c, 0xdb, 0x01, 0x65, 0xcf, 0xb9, 0xc2,
+	0x93, 0xdb, 0xae, 0xf2, 0xa9, 0xb8, 0xc2, 0x93, 0x1b, 0xae, 0x86, 0x50, 0xc3, 0xde, 0x47, 0xb1,
+	0x90, 0x01, 0x61, 0xd2, 0x11, 0x21, 0x21, 0x5e, 0x2a, 0x6a, 0xdd, 0x5e, 0xa2, 0x9e, 0x25, 0xa0,
+	0x89, 0x64, 0xdd, 0x38, 0xb9, 0xef, 0x09, 0x49, 0x48, 0xc8, 0x88, 0x10, 0xe9, 0x48, 0x56, 0x81,
+	0x9e, 0xcd, 0x31, 0xef, 0x92, 0xec, 0x55, 0x16, 0xde, 0xea, 0x91, 0x90, 0x0b, 0x2a, 0xd1, 0x39,
+	0x94, 0x3c, 0xfd, 0x97, 0x47, 0x46, 0x9f, 0x3f, 0xf8, 0xcf, 0xac, 0x79, 0xf8, 0x02, 0xfe, 0x8f,
+	0x5c, 0xf7, 0xc8, 0xf3, 0x22, 0x22, 0xc4, 0xe7, 0x57, 0x87, 0xf7, 0x4c, 0x18, 0xa6, 0xa7, 0x3b,
+	0x95, 0x44, 0xd8, 0x4b, 0x68, 0xe4, 0x42, 0x11, 0x07, 0xea, 0x75, 0x93, 0x55, 0xcf, 0x95, 0xb7,
+	0xdb, 0x66, 0x42, 0x72, 0xda, 0x2d, 0x6e, 0x81, 0xc7, 0x9c, 0xb2, 0xee, 0x37, 0xcc, 0x3b, 0xe5,
+	0xe0, 0x05, 0x62, 0x48, 0x26, 0x08, 0xdb, 0x40, 0xa3, 0x1f, 0x43, 0x41, 0xbd, 0xa3, 0xac, 0x9c,
+	0xf2, 0xf1, 0x95, 0x35, 0xf7, 0xcc, 0xb3, 0x38, 0x0c, 0xfd, 0xe9, 0x9c, 0x13, 0x7d, 0x37, 0xee,
+	0x7e, 0xd1, 0x78, 0xdc, 0x5d, 0x67, 0x15, 0xb6, 0x06, 0x6d, 0xfd, 0x31, 0x0b, 0x45, 0x7d, 0x77,
+	0x42, 0x1e, 0x6c, 0xea, 0xeb, 0x20, 0x49, 0x9f, 0xb4, 0x05, 0xf2, 0xff, 0x0c, 0x67, 0x3a, 0xe9,
+	0xbb, 0x38, 0x5b, 0x67, 0x5d, 0x70, 0xf6, 0xa7, 0x1c, 0xd4, 0x8e, 0x23, 0xe2, 0x51, 0xd9, 0x23,
+	0x3e, 0x19, 0xea, 0xf3, 0xca, 0x83, 0x4d, 0x11, 0x87, 0xfa, 0xa4, 0x4e, 0x9d, 0xbd, 0x39, 0x72,
+	0xe2, 0xc5, 0xd3, 0x3e, 0xe7, 0xcf, 0xfb, 0x14, 0xbd, 0xcc, 0x91, 0x11, 0x85, 0x12, 0xf6, 0x7d,
+	0x7e, 0x89, 0x99, 0x4b, 0xac, 0x5c, 0xfa, 0xcb, 0xb4, 0x44, 0x47, 0xc3, 0x85, 0xe8, 0x3c, 0x2b,
+	0x9f, 0xbe, 0xa7, 0x05, 0x78, 0xeb, 0xb7, 0x59, 0xa8, 0xe8, 0x07, 0x17, 0xf1, 0x7a, 0x64, 0x20,
+	0xdf, 0x90, 0xdc, 0xbf, 0x0a, 0x35, 0x97, 0xfb, 0x89, 0xd7, 0x08, 0xfb, 0xfa, 0xf1, 0x65, 0x5e,
+	0x4d, 0xdb, 0xcb, 0x7e, 0xf5, 0xfe, 0x42, 0xbf, 0x80, 0x3a, 0xe3, 0x51, 0x80, 0x7d, 0xfa, 0x31,
+	0xf1, 0x1c, 0xb3, 0x49, 0x34, 0xfb, 0xf7, 0xd7, 0x72, 0xd2, 0x23, 0xae, 0xa2, 0xe5, 0x5d, 0x43,
+	0xcb, 0xd7, 0x5e, 0xec, 0x7c, 0xd5, 0xcc, 0xd4, 0x96, 0xbe, 0x8e, 0x94, 0xab, 0xd6, 0xef, 0x32,
+	0xb0, 0x73, 0xe4, 0xaa, 0x6a, 0xcd, 0xcd, 0x57, 0xe0, 0x4f, 0xa1, 0xc0, 0x2f, 0xd9, 0x6b, 0xa0,
+	0x49, 0xc3, 0xa2, 0x0e, 0x94, 0x4d, 0xe5, 0x67, 0x9a, 0xd4, 0x49, 0xb2, 0xea, 0xb9, 0xb9, 0x75,
+	0x3d, 0x6b, 0xc2, 0x3c, 0x84, 0x7e, 0xcf, 0x86, 0xf9, 0x90, 0xbe, 0xd7, 0xfa, 0x65, 0x06, 0x76,
+	0xd6, 0x9d, 0x6a, 0x77, 0xd4, 0x74, 0x6c, 0x28, 0xac, 0x16, 0xc4, 0x5e, 0xed, 0x73, 0xa4, 0xa1,
+	0x54, 0x08, 0xeb, 0x0e, 0x89, 0x37, 0x18, 0x02, 0x07, 0x50, 0x4b, 0x79, 0xaa, 0xca, 0x9d, 0x18,
+	0x0a, 0x49, 0x25, 0x73, 0x5e, 0x5c, 0x4c, 0x75, 0x17, 0x69, 0xe4, 0xd6, 0x04, 0xaa, 0x73, 0xf9,
+	0x68, 0x9f, 0xc3, 0x9b, 0x3e, 0x5f, 0x83, 0x4a, 0x35, 0x7e, 0xf7, 0xe1, 0xd3, 0x7f, 0x35, 0x36,
+	0x9e, 0x5e, 0x37, 0x32, 0x9f, 0x5d, 0x37, 0x32, 0xff, 0xbc, 0x6e, 0x64, 0x7e, 0xfd, 0xac, 0xb1,
+	0xf1, 0xd9, 0xb3, 0xc6, 0xc6, 0xdf, 0x9e, 0x35, 0x36, 0x7e, 0xf4, 0xf5, 0x15, 0x44, 0xca, 0xdc,
+	0x78, 0x10, 0x8b, 0x43, 0x46, 0xe4, 0x25, 0x8f, 0x46, 0x1d, 0x55, 0x22, 0x9e, 0xe8, 0x22, 0xb1,
+	0xc2, 0x1e, 0x14, 0x55, 0x41, 0xeb, 0xdd, 0xff, 0x0e, 0x00, 0x34, 0xc9, 0xb0, 0xcd, 0x3e, 0x16,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -481,6 +884,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EModeCategories) > 0 {
+		for iNdEx := len(m.EModeCategories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EModeCategories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHard(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.CheckLtvIndexCount != 0 {
+		i = encodeVarintHard(dAtA, i, uint64(m.CheckLtvIndexCount))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size := m.MinimumBorrowUSDValue.Size()
 		i -= size
@@ -528,6 +950,30 @@ func (m *MoneyMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Isolation != nil {
+		{
+			size, err := m.Isolation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHard(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.InterestRateModel != nil {
+		{
+			size, err := m.InterestRateModel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHard(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	{
 		size := m.KeeperRewardPercentage.Size()
 		i -= size
@@ -548,16 +994,6 @@ func (m *MoneyMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x32
-	{
-		size, err := m.InterestRateModel.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintHard(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
 	{
 		size := m.ConversionFactor.Size()
 		i -= size
@@ -595,7 +1031,7 @@ func (m *MoneyMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *BorrowLimit) Marshal() (dAtA []byte, err error) {
+func (m *Isolation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -605,50 +1041,39 @@ func (m *BorrowLimit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BorrowLimit) MarshalTo(dAtA []byte) (int, error) {
+func (m *Isolation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BorrowLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Isolation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.LoanToValue.Size()
-		i -= size
-		if _, err := m.LoanToValue.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.BorrowDenoms) > 0 {
+		for iNdEx := len(m.BorrowDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BorrowDenoms[iNdEx])
+			copy(dAtA[i:], m.BorrowDenoms[iNdEx])
+			i = encodeVarintHard(dAtA, i, uint64(len(m.BorrowDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
-		i = encodeVarintHard(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
 	{
-		size := m.MaximumLimit.Size()
+		size := m.DebtCeiling.Size()
 		i -= size
-		if _, err := m.MaximumLimit.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.DebtCeiling.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintHard(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if m.HasMaxLimit {
-		i--
-		if m.HasMaxLimit {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *InterestRateModel) Marshal() (dAtA []byte, err error) {
+func (m *EModeCategory) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -658,49 +1083,239 @@ func (m *InterestRateModel) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InterestRateModel) MarshalTo(dAtA []byte) (int, error) {
+func (m *EModeCategory) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InterestRateModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EModeCategory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.JumpMultiplier.Size()
-		i -= size
-		if _, err := m.JumpMultiplier.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintHard(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
-		i = encodeVarintHard(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x22
 	{
-		size := m.Kink.Size()
+		size := m.LoanToValue.Size()
 		i -= size
-		if _, err := m.Kink.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.LoanToValue.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintHard(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x1a
-	{
-		size := m.BaseMultiplier.Size()
-		i -= size
-		if _, err := m.BaseMultiplier.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintHard(dAtA, i, uint64(size))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.BaseRateAPY.Size()
-		i -= size
+	if m.ID != 0 {
+		i = encodeVarintHard(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BorrowLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BorrowLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BorrowLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LoanToValue.Size()
+		i -= size
+		if _, err := m.LoanToValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaximumLimit.Size()
+		i -= size
+		if _, err := m.MaximumLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.HasMaxLimit {
+		i--
+		if m.HasMaxLimit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JumpRateModel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JumpRateModel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JumpRateModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.JumpMultiplier.Size()
+		i -= size
+		if _, err := m.JumpMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Kink.Size()
+		i -= size
+		if _, err := m.Kink.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BaseMultiplier.Size()
+		i -= size
+		if _, err := m.BaseMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BaseRateAPY.Size()
+		i -= size
+		if _, err := m.BaseRateAPY.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TwoKinkRateModel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwoKinkRateModel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwoKinkRateModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.JumpMultiplier.Size()
+		i -= size
+		if _, err := m.JumpMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SecondKink.Size()
+		i -= size
+		if _, err := m.SecondKink.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MidMultiplier.Size()
+		i -= size
+		if _, err := m.MidMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.FirstKink.Size()
+		i -= size
+		if _, err := m.FirstKink.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BaseMultiplier.Size()
+		i -= size
+		if _, err := m.BaseMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BaseRateAPY.Size()
+		i -= size
 		if _, err := m.BaseRateAPY.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
@@ -711,6 +1326,89 @@ func (m *InterestRateModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AdaptiveRateModel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdaptiveRateModel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdaptiveRateModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CurveSteepness.Size()
+		i -= size
+		if _, err := m.CurveSteepness.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.AdjustmentSpeed.Size()
+		i -= size
+		if _, err := m.AdjustmentSpeed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxRateAtTarget.Size()
+		i -= size
+		if _, err := m.MaxRateAtTarget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinRateAtTarget.Size()
+		i -= size
+		if _, err := m.MinRateAtTarget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InitialRateAtTarget.Size()
+		i -= size
+		if _, err := m.InitialRateAtTarget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TargetUtilization.Size()
+		i -= size
+		if _, err := m.TargetUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHard(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Deposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -827,7 +1525,7 @@ func (m *Borrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SupplyInterestFactor) Marshal() (dAtA []byte, err error) {
+func (m *CreditDelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -837,18 +1535,169 @@ func (m *SupplyInterestFactor) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SupplyInterestFactor) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreditDelegation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SupplyInterestFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CreditDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Value.Size()
+	if len(m.Borrowed) > 0 {
+		for iNdEx := len(m.Borrowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Borrowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHard(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Allowance) > 0 {
+		for iNdEx := len(m.Allowance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHard(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Supplier) > 0 {
+		i -= len(m.Supplier)
+		copy(dAtA[i:], m.Supplier)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Supplier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IsolatedDebt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IsolatedDebt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IsolatedDebt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NormalizedAmount) > 0 {
+		for iNdEx := len(m.NormalizedAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NormalizedAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHard(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CollateralDenom) > 0 {
+		i -= len(m.CollateralDenom)
+		copy(dAtA[i:], m.CollateralDenom)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.CollateralDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Borrower) > 0 {
+		i -= len(m.Borrower)
+		copy(dAtA[i:], m.Borrower)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Borrower)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountEModeCategory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountEModeCategory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountEModeCategory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CategoryID != 0 {
+		i = encodeVarintHard(dAtA, i, uint64(m.CategoryID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintHard(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplyInterestFactor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyInterestFactor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyInterestFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Value.Size()
 		i -= size
 		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
@@ -944,6 +1793,43 @@ func (m *CoinsProto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DecCoinsProto) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecCoinsProto) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecCoinsProto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHard(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintHard(dAtA []byte, offset int, v uint64) int {
 	offset -= sovHard(v)
 	base := offset
@@ -969,6 +1855,15 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinimumBorrowUSDValue.Size()
 	n += 1 + l + sovHard(uint64(l))
+	if m.CheckLtvIndexCount != 0 {
+		n += 1 + sovHard(uint64(m.CheckLtvIndexCount))
+	}
+	if len(m.EModeCategories) > 0 {
+		for _, e := range m.EModeCategories {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -990,12 +1885,59 @@ func (m *MoneyMarket) Size() (n int) {
 	}
 	l = m.ConversionFactor.Size()
 	n += 1 + l + sovHard(uint64(l))
-	l = m.InterestRateModel.Size()
-	n += 1 + l + sovHard(uint64(l))
 	l = m.ReserveFactor.Size()
 	n += 1 + l + sovHard(uint64(l))
 	l = m.KeeperRewardPercentage.Size()
 	n += 1 + l + sovHard(uint64(l))
+	if m.InterestRateModel != nil {
+		l = m.InterestRateModel.Size()
+		n += 1 + l + sovHard(uint64(l))
+	}
+	if m.Isolation != nil {
+		l = m.Isolation.Size()
+		n += 1 + l + sovHard(uint64(l))
+	}
+	return n
+}
+
+func (m *Isolation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DebtCeiling.Size()
+	n += 1 + l + sovHard(uint64(l))
+	if len(m.BorrowDenoms) > 0 {
+		for _, s := range m.BorrowDenoms {
+			l = len(s)
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EModeCategory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovHard(uint64(m.ID))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	l = m.LoanToValue.Size()
+	n += 1 + l + sovHard(uint64(l))
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1015,7 +1957,7 @@ func (m *BorrowLimit) Size() (n int) {
 	return n
 }
 
-func (m *InterestRateModel) Size() (n int) {
+func (m *JumpRateModel) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1032,6 +1974,48 @@ func (m *InterestRateModel) Size() (n int) {
 	return n
 }
 
+func (m *TwoKinkRateModel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseRateAPY.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.BaseMultiplier.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.FirstKink.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.MidMultiplier.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.SecondKink.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.JumpMultiplier.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
+func (m *AdaptiveRateModel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TargetUtilization.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.InitialRateAtTarget.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.MinRateAtTarget.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.MaxRateAtTarget.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.AdjustmentSpeed.Size()
+	n += 1 + l + sovHard(uint64(l))
+	l = m.CurveSteepness.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
 func (m *Deposit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1082,47 +2066,130 @@ func (m *Borrow) Size() (n int) {
 	return n
 }
 
-func (m *SupplyInterestFactor) Size() (n int) {
+func (m *CreditDelegation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Denom)
+	l = len(m.Supplier)
 	if l > 0 {
 		n += 1 + l + sovHard(uint64(l))
 	}
-	l = m.Value.Size()
-	n += 1 + l + sovHard(uint64(l))
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	if len(m.Allowance) > 0 {
+		for _, e := range m.Allowance {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	if len(m.Borrowed) > 0 {
+		for _, e := range m.Borrowed {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *BorrowInterestFactor) Size() (n int) {
+func (m *IsolatedDebt) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Denom)
+	l = len(m.Borrower)
 	if l > 0 {
 		n += 1 + l + sovHard(uint64(l))
 	}
-	l = m.Value.Size()
-	n += 1 + l + sovHard(uint64(l))
+	l = len(m.CollateralDenom)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	if len(m.NormalizedAmount) > 0 {
+		for _, e := range m.NormalizedAmount {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *CoinsProto) Size() (n int) {
+func (m *AccountEModeCategory) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovHard(uint64(l))
-		}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	if m.CategoryID != 0 {
+		n += 1 + sovHard(uint64(m.CategoryID))
+	}
+	return n
+}
+
+func (m *SupplyInterestFactor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
+func (m *BorrowInterestFactor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovHard(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovHard(uint64(l))
+	return n
+}
+
+func (m *CoinsProto) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DecCoinsProto) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovHard(uint64(l))
+		}
 	}
 	return n
 }
@@ -1143,30 +2210,1231 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowHard
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MoneyMarkets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MoneyMarkets = append(m.MoneyMarkets, MoneyMarket{})
+			if err := m.MoneyMarkets[len(m.MoneyMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumBorrowUSDValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinimumBorrowUSDValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckLtvIndexCount", wireType)
+			}
+			m.CheckLtvIndexCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckLtvIndexCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EModeCategories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EModeCategories = append(m.EModeCategories, EModeCategory{})
+			if err := m.EModeCategories[len(m.EModeCategories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MoneyMarket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MoneyMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BorrowLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotMarketID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpotMarketID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReserveFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeeperRewardPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeeperRewardPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestRateModel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InterestRateModel == nil {
+				m.InterestRateModel = &types.Any{}
+			}
+			if err := m.InterestRateModel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Isolation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Isolation == nil {
+				m.Isolation = &Isolation{}
+			}
+			if err := m.Isolation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Isolation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Isolation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Isolation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtCeiling", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DebtCeiling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BorrowDenoms = append(m.BorrowDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EModeCategory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EModeCategory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EModeCategory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoanToValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LoanToValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BorrowLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BorrowLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BorrowLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMaxLimit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMaxLimit = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaximumLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoanToValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LoanToValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JumpRateModel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JumpRateModel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JumpRateModel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseRateAPY", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseRateAPY.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kink", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Kink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JumpMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JumpMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TwoKinkRateModel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwoKinkRateModel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwoKinkRateModel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseRateAPY", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseRateAPY.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstKink", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.FirstKink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MoneyMarkets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MidMultiplier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHard
@@ -1176,29 +3444,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthHard
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthHard
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MoneyMarkets = append(m.MoneyMarkets, MoneyMarket{})
-			if err := m.MoneyMarkets[len(m.MoneyMarkets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MidMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimumBorrowUSDValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondKink", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1226,7 +3494,41 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinimumBorrowUSDValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecondKink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JumpMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JumpMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1251,7 +3553,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
+func (m *AdaptiveRateModel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1274,15 +3576,15 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MoneyMarket: wiretype end group for non-group")
+			return fmt.Errorf("proto: AdaptiveRateModel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MoneyMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AdaptiveRateModel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetUtilization", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1310,13 +3612,15 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.TargetUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BorrowLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialRateAtTarget", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHard
@@ -1326,28 +3630,29 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthHard
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthHard
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BorrowLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitialRateAtTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotMarketID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRateAtTarget", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1375,11 +3680,13 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpotMarketID = string(dAtA[iNdEx:postIndex])
+			if err := m.MinRateAtTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRateAtTarget", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1407,15 +3714,15 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConversionFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxRateAtTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterestRateModel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdjustmentSpeed", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHard
@@ -1425,28 +3732,29 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthHard
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthHard
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InterestRateModel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AdjustmentSpeed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurveSteepness", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1474,13 +3782,63 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReserveFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurveSteepness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeeperRewardPercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1492,23 +3850,89 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthHard
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthHard
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KeeperRewardPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Index = append(m.Index, SupplyInterestFactor{})
+			if err := m.Index[len(m.Index)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1533,7 +3957,7 @@ func (m *MoneyMarket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BorrowLimit) Unmarshal(dAtA []byte) error {
+func (m *Borrow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1556,17 +3980,17 @@ func (m *BorrowLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BorrowLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: Borrow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BorrowLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Borrow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasMaxLimit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHard
@@ -1576,17 +4000,29 @@ func (m *BorrowLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasMaxLimit = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrower = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHard
@@ -1596,31 +4032,31 @@ func (m *BorrowLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthHard
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthHard
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaximumLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoanToValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHard
@@ -1630,23 +4066,23 @@ func (m *BorrowLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthHard
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthHard
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LoanToValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Index = append(m.Index, BorrowInterestFactor{})
+			if err := m.Index[len(m.Index)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1671,7 +4107,7 @@ func (m *BorrowLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
+func (m *CreditDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1694,15 +4130,15 @@ func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InterestRateModel: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreditDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InterestRateModel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreditDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseRateAPY", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Supplier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1730,13 +4166,11 @@ func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseRateAPY.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Supplier = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1764,15 +4198,13 @@ func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Delegate = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kink", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHard
@@ -1782,31 +4214,31 @@ func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthHard
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthHard
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Kink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Allowance = append(m.Allowance, types1.Coin{})
+			if err := m.Allowance[len(m.Allowance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JumpMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrowed", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHard
@@ -1816,23 +4248,23 @@ func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthHard
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthHard
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.JumpMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Borrowed = append(m.Borrowed, types1.Coin{})
+			if err := m.Borrowed[len(m.Borrowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1857,7 +4289,7 @@ func (m *InterestRateModel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Deposit) Unmarshal(dAtA []byte) error {
+func (m *IsolatedDebt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1880,15 +4312,15 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: IsolatedDebt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IsolatedDebt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1916,13 +4348,13 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
+			m.Borrower = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHard
@@ -1932,29 +4364,27 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthHard
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthHard
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1981,8 +4411,8 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = append(m.Index, SupplyInterestFactor{})
-			if err := m.Index[len(m.Index)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NormalizedAmount = append(m.NormalizedAmount, types1.DecCoin{})
+			if err := m.NormalizedAmount[len(m.NormalizedAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2007,7 +4437,7 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Borrow) Unmarshal(dAtA []byte) error {
+func (m *AccountEModeCategory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2030,15 +4460,15 @@ func (m *Borrow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Borrow: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountEModeCategory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Borrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountEModeCategory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Borrower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2066,47 +4496,13 @@ func (m *Borrow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Borrower = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
+			m.Owner = github_com_cosmos_cosmos_sdk_types.AccAddress(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHard
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthHard
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthHard
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CategoryID", wireType)
 			}
-			var msglen int
+			m.CategoryID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHard
@@ -2116,26 +4512,11 @@ func (m *Borrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CategoryID |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthHard
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthHard
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Index = append(m.Index, BorrowInterestFactor{})
-			if err := m.Index[len(m.Index)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHard(dAtA[iNdEx:])
@@ -2447,7 +4828,91 @@ func (m *CoinsProto) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHard(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHard
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecCoinsProto) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHard
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecCoinsProto: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecCoinsProto: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHard
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHard
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHard
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types1.DecCoin{})
 			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
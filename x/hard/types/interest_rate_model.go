@@ -0,0 +1,239 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// SecondsPerYear is the number of seconds in a year used to annualize interest rates.
+const SecondsPerYear = 31536000
+
+// InterestRateModel calculates the borrow rate of a money market from its utilization ratio.
+type InterestRateModel interface {
+	proto.Message
+
+	Validate() error
+	// BorrowRate returns the borrow APY at a utilization ratio between 0.0 and 1.0.
+	BorrowRate(utilization sdk.Dec) sdk.Dec
+}
+
+var (
+	_ InterestRateModel = &JumpRateModel{}
+	_ InterestRateModel = &TwoKinkRateModel{}
+	_ InterestRateModel = &AdaptiveRateModel{}
+)
+
+// PackInterestRateModel converts an InterestRateModel to an Any type.
+func PackInterestRateModel(model InterestRateModel) (*codectypes.Any, error) {
+	if model == nil {
+		return nil, fmt.Errorf("interest rate model cannot be nil")
+	}
+	return codectypes.NewAnyWithValue(model)
+}
+
+// UnpackInterestRateModel converts an Any type to an InterestRateModel.
+func UnpackInterestRateModel(modelAny *codectypes.Any) (InterestRateModel, error) {
+	if modelAny == nil {
+		return nil, fmt.Errorf("interest rate model cannot be empty")
+	}
+	model, ok := modelAny.GetCachedValue().(InterestRateModel)
+	if !ok {
+		return nil, fmt.Errorf("expected interest rate model, got %T", modelAny.GetCachedValue())
+	}
+	return model, nil
+}
+
+// NewJumpRateModel returns a new JumpRateModel
+func NewJumpRateModel(baseRateAPY, baseMultiplier, kink, jumpMultiplier sdk.Dec) *JumpRateModel {
+	return &JumpRateModel{
+		BaseRateAPY:    baseRateAPY,
+		BaseMultiplier: baseMultiplier,
+		Kink:           kink,
+		JumpMultiplier: jumpMultiplier,
+	}
+}
+
+// Validate JumpRateModel param
+func (irm JumpRateModel) Validate() error {
+	if irm.BaseRateAPY.IsNil() || irm.BaseRateAPY.IsNegative() || irm.BaseRateAPY.GT(sdk.OneDec()) {
+		return fmt.Errorf("base rate APY must be in the inclusive range 0.0-1.0")
+	}
+
+	if irm.BaseMultiplier.IsNil() || irm.BaseMultiplier.IsNegative() {
+		return fmt.Errorf("base multiplier must not be negative")
+	}
+
+	if irm.Kink.IsNil() || irm.Kink.IsNegative() || irm.Kink.GT(sdk.OneDec()) {
+		return fmt.Errorf("kink must be in the inclusive range 0.0-1.0")
+	}
+
+	if irm.JumpMultiplier.IsNil() || irm.JumpMultiplier.IsNegative() {
+		return fmt.Errorf("jump multiplier must not be negative")
+	}
+
+	return nil
+}
+
+// BorrowRate returns the borrow APY at the given utilization ratio
+func (irm JumpRateModel) BorrowRate(utilization sdk.Dec) sdk.Dec {
+	// Calculate normal borrow rate (under kink)
+	if utilization.LTE(irm.Kink) {
+		return utilization.Mul(irm.BaseMultiplier).Add(irm.BaseRateAPY)
+	}
+
+	// Calculate jump borrow rate (over kink)
+	normalRate := irm.Kink.Mul(irm.BaseMultiplier).Add(irm.BaseRateAPY)
+	excessUtil := utilization.Sub(irm.Kink)
+	return excessUtil.Mul(irm.JumpMultiplier).Add(normalRate)
+}
+
+// NewTwoKinkRateModel returns a new TwoKinkRateModel
+func NewTwoKinkRateModel(baseRateAPY, baseMultiplier, firstKink, midMultiplier, secondKink, jumpMultiplier sdk.Dec) *TwoKinkRateModel {
+	return &TwoKinkRateModel{
+		BaseRateAPY:    baseRateAPY,
+		BaseMultiplier: baseMultiplier,
+		FirstKink:      firstKink,
+		MidMultiplier:  midMultiplier,
+		SecondKink:     secondKink,
+		JumpMultiplier: jumpMultiplier,
+	}
+}
+
+// Validate TwoKinkRateModel param
+func (irm TwoKinkRateModel) Validate() error {
+	if irm.BaseRateAPY.IsNil() || irm.BaseRateAPY.IsNegative() || irm.BaseRateAPY.GT(sdk.OneDec()) {
+		return fmt.Errorf("base rate APY must be in the inclusive range 0.0-1.0")
+	}
+
+	if irm.BaseMultiplier.IsNil() || irm.BaseMultiplier.IsNegative() {
+		return fmt.Errorf("base multiplier must not be negative")
+	}
+
+	if irm.FirstKink.IsNil() || irm.FirstKink.IsNegative() || irm.FirstKink.GT(sdk.OneDec()) {
+		return fmt.Errorf("first kink must be in the inclusive range 0.0-1.0")
+	}
+
+	if irm.MidMultiplier.IsNil() || irm.MidMultiplier.IsNegative() {
+		return fmt.Errorf("mid multiplier must not be negative")
+	}
+
+	if irm.SecondKink.IsNil() || irm.SecondKink.LT(irm.FirstKink) || irm.SecondKink.GT(sdk.OneDec()) {
+		return fmt.Errorf("second kink must be in the inclusive range %s-1.0", irm.FirstKink)
+	}
+
+	if irm.JumpMultiplier.IsNil() || irm.JumpMultiplier.IsNegative() {
+		return fmt.Errorf("jump multiplier must not be negative")
+	}
+
+	return nil
+}
+
+// BorrowRate returns the borrow APY at the given utilization ratio
+func (irm TwoKinkRateModel) BorrowRate(utilization sdk.Dec) sdk.Dec {
+	// Calculate normal borrow rate (under first kink)
+	if utilization.LTE(irm.FirstKink) {
+		return utilization.Mul(irm.BaseMultiplier).Add(irm.BaseRateAPY)
+	}
+
+	// Calculate mid borrow rate (between kinks)
+	normalRate := irm.FirstKink.Mul(irm.BaseMultiplier).Add(irm.BaseRateAPY)
+	if utilization.LTE(irm.SecondKink) {
+		return utilization.Sub(irm.FirstKink).Mul(irm.MidMultiplier).Add(normalRate)
+	}
+
+	// Calculate jump borrow rate (over second kink)
+	midRate := irm.SecondKink.Sub(irm.FirstKink).Mul(irm.MidMultiplier).Add(normalRate)
+	return utilization.Sub(irm.SecondKink).Mul(irm.JumpMultiplier).Add(midRate)
+}
+
+// NewAdaptiveRateModel returns a new AdaptiveRateModel
+func NewAdaptiveRateModel(targetUtilization, initialRateAtTarget, minRateAtTarget, maxRateAtTarget,
+	adjustmentSpeed, curveSteepness sdk.Dec,
+) *AdaptiveRateModel {
+	return &AdaptiveRateModel{
+		TargetUtilization:   targetUtilization,
+		InitialRateAtTarget: initialRateAtTarget,
+		MinRateAtTarget:     minRateAtTarget,
+		MaxRateAtTarget:     maxRateAtTarget,
+		AdjustmentSpeed:     adjustmentSpeed,
+		CurveSteepness:      curveSteepness,
+	}
+}
+
+// Validate AdaptiveRateModel param
+func (irm AdaptiveRateModel) Validate() error {
+	if irm.TargetUtilization.IsNil() || !irm.TargetUtilization.IsPositive() || irm.TargetUtilization.GTE(sdk.OneDec()) {
+		return fmt.Errorf("target utilization must be in the exclusive range 0.0-1.0")
+	}
+
+	if irm.MinRateAtTarget.IsNil() || irm.MinRateAtTarget.IsNegative() {
+		return fmt.Errorf("min rate at target must not be negative")
+	}
+
+	if irm.MaxRateAtTarget.IsNil() || irm.MaxRateAtTarget.LT(irm.MinRateAtTarget) {
+		return fmt.Errorf("max rate at target must be ≥ min rate at target %s", irm.MinRateAtTarget)
+	}
+
+	if irm.InitialRateAtTarget.IsNil() || irm.InitialRateAtTarget.LT(irm.MinRateAtTarget) || irm.InitialRateAtTarget.GT(irm.MaxRateAtTarget) {
+		return fmt.Errorf("initial rate at target must be in the inclusive range %s-%s", irm.MinRateAtTarget, irm.MaxRateAtTarget)
+	}
+
+	if irm.AdjustmentSpeed.IsNil() || irm.AdjustmentSpeed.IsNegative() {
+		return fmt.Errorf("adjustment speed must not be negative")
+	}
+
+	if irm.CurveSteepness.IsNil() || irm.CurveSteepness.LT(sdk.OneDec()) {
+		return fmt.Errorf("curve steepness must be ≥ 1.0")
+	}
+
+	return nil
+}
+
+// BorrowRate returns the borrow APY at the given utilization ratio using the initial rate at target
+func (irm AdaptiveRateModel) BorrowRate(utilization sdk.Dec) sdk.Dec {
+	return irm.BorrowRateAt(utilization, irm.InitialRateAtTarget)
+}
+
+// BorrowRateAt returns the borrow APY at the given utilization ratio for a rate at target utilization.
+// The rate ranges from rateAtTarget / CurveSteepness at 0% utilization to rateAtTarget * CurveSteepness at 100%.
+func (irm AdaptiveRateModel) BorrowRateAt(utilization, rateAtTarget sdk.Dec) sdk.Dec {
+	utilErr := irm.utilizationError(utilization)
+
+	var coefficient sdk.Dec
+	if utilErr.IsNegative() {
+		coefficient = sdk.OneDec().Sub(sdk.OneDec().Quo(irm.CurveSteepness))
+	} else {
+		coefficient = irm.CurveSteepness.Sub(sdk.OneDec())
+	}
+	return coefficient.Mul(utilErr).Add(sdk.OneDec()).Mul(rateAtTarget)
+}
+
+// NextRateAtTarget returns the rate at target utilization after the given number of seconds spent at a utilization
+// ratio. The rate at target moves in the direction of the utilization error and is bounded by the min and max rate at
+// target.
+func (irm AdaptiveRateModel) NextRateAtTarget(rateAtTarget, utilization sdk.Dec, secondsElapsed int64) sdk.Dec {
+	adjustment := irm.AdjustmentSpeed.
+		Mul(irm.utilizationError(utilization)).
+		MulInt64(secondsElapsed).
+		QuoInt64(SecondsPerYear)
+
+	next := rateAtTarget.Mul(sdk.OneDec().Add(adjustment))
+	if next.LT(irm.MinRateAtTarget) {
+		return irm.MinRateAtTarget
+	}
+	if next.GT(irm.MaxRateAtTarget) {
+		return irm.MaxRateAtTarget
+	}
+	return next
+}
+
+// utilizationError returns the distance of the utilization ratio from the target, normalized to the range -1.0-1.0
+func (irm AdaptiveRateModel) utilizationError(utilization sdk.Dec) sdk.Dec {
+	if utilization.GT(irm.TargetUtilization) {
+		return utilization.Sub(irm.TargetUtilization).Quo(sdk.OneDec().Sub(irm.TargetUtilization))
+	}
+	return utilization.Sub(irm.TargetUtilization).Quo(irm.TargetUtilization)
+}
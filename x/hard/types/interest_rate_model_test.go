@@ -0,0 +1,158 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/incubus-network/fury/x/hard/types"
+)
+
+type InterestRateModelTestSuite struct {
+	suite.Suite
+}
+
+func (suite *InterestRateModelTestSuite) TestValidate() {
+	testCases := []struct {
+		name        string
+		model       types.InterestRateModel
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name:       "valid jump rate model",
+			model:      types.NewJumpRateModel(d("0.05"), d("2"), d("0.8"), d("10")),
+			expectPass: true,
+		},
+		{
+			name:        "invalid jump rate model: kink > 1",
+			model:       types.NewJumpRateModel(d("0.05"), d("2"), d("1.1"), d("10")),
+			expectPass:  false,
+			expectedErr: "kink must be in the inclusive range 0.0-1.0",
+		},
+		{
+			name:       "valid two kink rate model",
+			model:      types.NewTwoKinkRateModel(d("0.0"), d("0.05"), d("0.5"), d("0.5"), d("0.9"), d("5")),
+			expectPass: true,
+		},
+		{
+			name:        "invalid two kink rate model: second kink < first kink",
+			model:       types.NewTwoKinkRateModel(d("0.0"), d("0.05"), d("0.5"), d("0.5"), d("0.4"), d("5")),
+			expectPass:  false,
+			expectedErr: "second kink must be in the inclusive range",
+		},
+		{
+			name:       "valid adaptive rate model",
+			model:      types.NewAdaptiveRateModel(d("0.9"), d("0.04"), d("0.001"), d("2"), d("50"), d("4")),
+			expectPass: true,
+		},
+		{
+			name:        "invalid adaptive rate model: target utilization of 1",
+			model:       types.NewAdaptiveRateModel(d("1"), d("0.04"), d("0.001"), d("2"), d("50"), d("4")),
+			expectPass:  false,
+			expectedErr: "target utilization must be in the exclusive range 0.0-1.0",
+		},
+		{
+			name:        "invalid adaptive rate model: initial rate above max",
+			model:       types.NewAdaptiveRateModel(d("0.9"), d("3"), d("0.001"), d("2"), d("50"), d("4")),
+			expectPass:  false,
+			expectedErr: "initial rate at target must be in the inclusive range",
+		},
+		{
+			name:        "invalid adaptive rate model: curve steepness < 1",
+			model:       types.NewAdaptiveRateModel(d("0.9"), d("0.04"), d("0.001"), d("2"), d("50"), d("0.5")),
+			expectPass:  false,
+			expectedErr: "curve steepness must be ≥ 1.0",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.model.Validate()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Contains(err.Error(), tc.expectedErr)
+			}
+		})
+	}
+}
+
+func (suite *InterestRateModelTestSuite) TestBorrowRate() {
+	jump := types.NewJumpRateModel(d("0.05"), d("0.1"), d("0.8"), d("0.5"))
+	twoKink := types.NewTwoKinkRateModel(d("0.0"), d("0.1"), d("0.5"), d("0.5"), d("0.9"), d("5"))
+	adaptive := types.NewAdaptiveRateModel(d("0.9"), d("0.04"), d("0.001"), d("2"), d("50"), d("4"))
+
+	testCases := []struct {
+		name        string
+		model       types.InterestRateModel
+		utilization sdk.Dec
+		expected    sdk.Dec
+	}{
+		{"jump: empty market", jump, d("0"), d("0.05")},
+		{"jump: at kink", jump, d("0.8"), d("0.13")},
+		{"jump: above kink", jump, d("1"), d("0.23")},
+		{"two kink: below first kink", twoKink, d("0.25"), d("0.025")},
+		{"two kink: between kinks", twoKink, d("0.7"), d("0.15")},
+		{"two kink: above second kink", twoKink, d("1"), d("0.75")},
+		{"adaptive: empty market", adaptive, d("0"), d("0.01")},
+		{"adaptive: at target", adaptive, d("0.9"), d("0.04")},
+		{"adaptive: fully utilized", adaptive, d("1"), d("0.16")},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.Equal(tc.expected, tc.model.BorrowRate(tc.utilization))
+		})
+	}
+}
+
+func (suite *InterestRateModelTestSuite) TestNextRateAtTarget() {
+	model := types.NewAdaptiveRateModel(d("0.5"), d("0.04"), d("0.01"), d("0.08"), d("50"), d("4"))
+
+	testCases := []struct {
+		name           string
+		rateAtTarget   sdk.Dec
+		utilization    sdk.Dec
+		secondsElapsed int64
+		expected       sdk.Dec
+	}{
+		{"no time elapsed", d("0.04"), d("1"), 0, d("0.04")},
+		{"at target utilization", d("0.04"), d("0.5"), 86400, d("0.04")},
+		{"above target utilization", d("0.04"), d("1"), types.SecondsPerYear / 100, d("0.06")},
+		{"below target utilization", d("0.04"), d("0"), types.SecondsPerYear / 100, d("0.02")},
+		{"clamped to max", d("0.04"), d("1"), types.SecondsPerYear, d("0.08")},
+		{"clamped to min", d("0.04"), d("0"), types.SecondsPerYear, d("0.01")},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.Equal(tc.expected, model.NextRateAtTarget(tc.rateAtTarget, tc.utilization, tc.secondsElapsed))
+		})
+	}
+}
+
+func (suite *InterestRateModelTestSuite) TestPackUnpack() {
+	model := types.NewTwoKinkRateModel(d("0.0"), d("0.1"), d("0.5"), d("0.5"), d("0.9"), d("5"))
+
+	modelAny, err := types.PackInterestRateModel(model)
+	suite.Require().NoError(err)
+
+	unpacked, err := types.UnpackInterestRateModel(modelAny)
+	suite.Require().NoError(err)
+	suite.Equal(model, unpacked)
+
+	_, err = types.PackInterestRateModel(nil)
+	suite.Error(err)
+
+	_, err = types.UnpackInterestRateModel(nil)
+	suite.Error(err)
+}
+
+func TestInterestRateModelTestSuite(t *testing.T) {
+	suite.Run(t, new(InterestRateModelTestSuite))
+}
+
+func d(str string) sdk.Dec { return sdk.MustNewDecFromStr(str) }
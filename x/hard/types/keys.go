@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName name that will be used throughout the module
 	ModuleName = "hard"
@@ -31,6 +36,14 @@ var (
 	BorrowInterestFactorPrefix    = []byte{0x08} // denom -> sdk.Dec
 	SupplyInterestFactorPrefix    = []byte{0x09} // denom -> sdk.Dec
 	DelegatorInterestFactorPrefix = []byte{0x10} // denom -> sdk.Dec
+	RateAtTargetPrefix            = []byte{0x11} // denom -> sdk.Dec
+	CreditDelegationsKeyPrefix    = []byte{0x12} // supplier + delegate -> CreditDelegation
+	LtvIndexPrefix                = []byte{0x13} // borrow limit usage + borrower -> borrower
+	BorrowerLtvPrefix             = []byte{0x14} // borrower -> borrow limit usage
+	AccountEModeCategoryPrefix    = []byte{0x15} // owner -> e-mode category id
+	IsolatedDebtPrefix            = []byte{0x16} // borrower -> IsolatedDebt
+	TotalIsolatedDebtPrefix       = []byte{0x17} // collateral denom -> sdk.DecCoins
+	LiquidationCursorKey          = []byte{0x18} // -> borrower last checked by the liquidation sweep
 )
 
 // DepositTypeIteratorKey returns an interator prefix for interating over deposits by deposit denom
@@ -38,6 +51,21 @@ func DepositTypeIteratorKey(denom string) []byte {
 	return createKey([]byte(denom))
 }
 
+// CreditDelegationKey returns the key of a credit delegation from a supplier to a delegate
+func CreditDelegationKey(supplier, delegate sdk.AccAddress) []byte {
+	return createKey(CreditDelegationsBySupplierKey(supplier), delegate)
+}
+
+// CreditDelegationsBySupplierKey returns an iterator prefix for iterating over the credit delegations of a supplier
+func CreditDelegationsBySupplierKey(supplier sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(supplier)
+}
+
+// LtvIndexKey returns the key of a borrower in the LTV index
+func LtvIndexKey(ltv sdk.Dec, borrower sdk.AccAddress) []byte {
+	return createKey(sdk.SortableDecBytes(ltv), borrower)
+}
+
 func createKey(bytes ...[]byte) (r []byte) {
 	for _, b := range bytes {
 		r = append(r, b...)
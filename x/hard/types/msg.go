@@ -13,6 +13,9 @@ var (
 	_ sdk.Msg = &MsgBorrow{}
 	_ sdk.Msg = &MsgRepay{}
 	_ sdk.Msg = &MsgLiquidate{}
+	_ sdk.Msg = &MsgGrantCreditDelegation{}
+	_ sdk.Msg = &MsgRevokeCreditDelegation{}
+	_ sdk.Msg = &MsgSetEModeCategory{}
 )
 
 // NewMsgDeposit returns a new MsgDeposit
@@ -106,6 +109,15 @@ func NewMsgBorrow(borrower sdk.AccAddress, amount sdk.Coins) MsgBorrow {
 	}
 }
 
+// NewMsgBorrowWithCreditDelegation returns a new MsgBorrow against the deposit of a supplier that delegated credit to the borrower
+func NewMsgBorrowWithCreditDelegation(borrower, owner sdk.AccAddress, amount sdk.Coins) MsgBorrow {
+	return MsgBorrow{
+		Borrower: borrower.String(),
+		Amount:   amount,
+		Owner:    owner.String(),
+	}
+}
+
 // Route return the message type used for routing the message.
 func (msg MsgBorrow) Route() string { return RouterKey }
 
@@ -121,6 +133,12 @@ func (msg MsgBorrow) ValidateBasic() error {
 	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "borrow amount %s", msg.Amount)
 	}
+	if msg.Owner != "" {
+		_, err = sdk.AccAddressFromBech32(msg.Owner)
+		if err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+	}
 	return nil
 }
 
@@ -226,3 +244,132 @@ func (msg MsgLiquidate) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{keeper}
 }
+
+// NewMsgGrantCreditDelegation returns a new MsgGrantCreditDelegation
+func NewMsgGrantCreditDelegation(supplier, delegate sdk.AccAddress, allowance sdk.Coins) MsgGrantCreditDelegation {
+	return MsgGrantCreditDelegation{
+		Supplier:  supplier.String(),
+		Delegate:  delegate.String(),
+		Allowance: allowance,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgGrantCreditDelegation) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgGrantCreditDelegation) Type() string { return "hard_grant_credit_delegation" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgGrantCreditDelegation) ValidateBasic() error {
+	supplier, err := sdk.AccAddressFromBech32(msg.Supplier)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	delegate, err := sdk.AccAddressFromBech32(msg.Delegate)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if supplier.Equals(delegate) {
+		return errorsmod.Wrap(ErrInvalidCreditDelegation, "supplier and delegate cannot be the same address")
+	}
+	if !msg.Allowance.IsValid() || msg.Allowance.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "allowance %s", msg.Allowance)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgGrantCreditDelegation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgGrantCreditDelegation) GetSigners() []sdk.AccAddress {
+	supplier, err := sdk.AccAddressFromBech32(msg.Supplier)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{supplier}
+}
+
+// NewMsgRevokeCreditDelegation returns a new MsgRevokeCreditDelegation
+func NewMsgRevokeCreditDelegation(supplier, delegate sdk.AccAddress) MsgRevokeCreditDelegation {
+	return MsgRevokeCreditDelegation{
+		Supplier: supplier.String(),
+		Delegate: delegate.String(),
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRevokeCreditDelegation) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgRevokeCreditDelegation) Type() string { return "hard_revoke_credit_delegation" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRevokeCreditDelegation) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Supplier)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Delegate)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRevokeCreditDelegation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRevokeCreditDelegation) GetSigners() []sdk.AccAddress {
+	supplier, err := sdk.AccAddressFromBech32(msg.Supplier)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{supplier}
+}
+
+// NewMsgSetEModeCategory returns a new MsgSetEModeCategory
+func NewMsgSetEModeCategory(owner sdk.AccAddress, categoryID uint32) MsgSetEModeCategory {
+	return MsgSetEModeCategory{
+		Owner:      owner.String(),
+		CategoryID: categoryID,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSetEModeCategory) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSetEModeCategory) Type() string { return "hard_set_e_mode_category" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSetEModeCategory) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSetEModeCategory) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSetEModeCategory) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
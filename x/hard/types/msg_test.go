@@ -190,6 +190,91 @@ func (suite *MsgTestSuite) TestMsgRepay() {
 	}
 }
 
+func (suite *MsgTestSuite) TestMsgGrantCreditDelegation() {
+	type args struct {
+		supplier  sdk.AccAddress
+		delegate  sdk.AccAddress
+		allowance sdk.Coins
+	}
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress("test1"),
+		sdk.AccAddress("test2"),
+	}
+	testCases := []struct {
+		name        string
+		args        args
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			args: args{
+				supplier:  addrs[0],
+				delegate:  addrs[1],
+				allowance: sdk.NewCoins(sdk.NewCoin("test", sdkmath.NewInt(1000000))),
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid: self delegation",
+			args: args{
+				supplier:  addrs[0],
+				delegate:  addrs[0],
+				allowance: sdk.NewCoins(sdk.NewCoin("test", sdkmath.NewInt(1000000))),
+			},
+			expectPass:  false,
+			expectedErr: "supplier and delegate cannot be the same address",
+		},
+		{
+			name: "invalid: empty allowance",
+			args: args{
+				supplier:  addrs[0],
+				delegate:  addrs[1],
+				allowance: sdk.NewCoins(),
+			},
+			expectPass:  false,
+			expectedErr: "allowance",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := types.NewMsgGrantCreditDelegation(tc.args.supplier, tc.args.delegate, tc.args.allowance)
+			err := msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *MsgTestSuite) TestMsgRevokeCreditDelegation() {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress("test1"),
+		sdk.AccAddress("test2"),
+	}
+
+	msg := types.NewMsgRevokeCreditDelegation(addrs[0], addrs[1])
+	suite.NoError(msg.ValidateBasic())
+
+	msg = types.NewMsgRevokeCreditDelegation(addrs[0], sdk.AccAddress{})
+	suite.Error(msg.ValidateBasic())
+}
+
+func (suite *MsgTestSuite) TestMsgSetEModeCategory() {
+	msg := types.NewMsgSetEModeCategory(sdk.AccAddress("test1"), 1)
+	suite.NoError(msg.ValidateBasic())
+
+	msg = types.NewMsgSetEModeCategory(sdk.AccAddress("test1"), 0)
+	suite.NoError(msg.ValidateBasic())
+
+	msg = types.NewMsgSetEModeCategory(sdk.AccAddress{}, 1)
+	suite.Error(msg.ValidateBasic())
+}
+
 func TestMsgTestSuite(t *testing.T) {
 	suite.Run(t, new(MsgTestSuite))
 }
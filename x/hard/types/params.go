@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdkmath "cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -13,8 +14,12 @@ import (
 var (
 	KeyMoneyMarkets              = []byte("MoneyMarkets")
 	KeyMinimumBorrowUSDValue     = []byte("MinimumBorrowUSDValue")
+	KeyCheckLtvIndexCount        = []byte("CheckLtvIndexCount")
+	KeyEModeCategories           = []byte("EModeCategories")
 	DefaultMoneyMarkets          = MoneyMarkets{}
 	DefaultMinimumBorrowUSDValue = sdk.NewDec(10) // $10 USD minimum borrow value
+	DefaultCheckLtvIndexCount    = uint64(10)
+	DefaultEModeCategories       = EModeCategories{}
 	DefaultAccumulationTimes     = GenesisAccumulationTimes{}
 	DefaultTotalSupplied         = sdk.Coins{}
 	DefaultTotalBorrowed         = sdk.Coins{}
@@ -64,12 +69,16 @@ func (bl BorrowLimit) Equal(blCompareTo BorrowLimit) bool {
 func NewMoneyMarket(denom string, borrowLimit BorrowLimit, spotMarketID string, conversionFactor sdkmath.Int,
 	interestRateModel InterestRateModel, reserveFactor, keeperRewardPercentage sdk.Dec,
 ) MoneyMarket {
+	interestRateModelAny, err := PackInterestRateModel(interestRateModel)
+	if err != nil {
+		panic(err)
+	}
 	return MoneyMarket{
 		Denom:                  denom,
 		BorrowLimit:            borrowLimit,
 		SpotMarketID:           spotMarketID,
 		ConversionFactor:       conversionFactor,
-		InterestRateModel:      interestRateModel,
+		InterestRateModel:      interestRateModelAny,
 		ReserveFactor:          reserveFactor,
 		KeeperRewardPercentage: keeperRewardPercentage,
 	}
@@ -89,7 +98,11 @@ func (mm MoneyMarket) Validate() error {
 		return fmt.Errorf("conversion '%s' factor must be ≥ one", mm.ConversionFactor)
 	}
 
-	if err := mm.InterestRateModel.Validate(); err != nil {
+	interestRateModel, err := UnpackInterestRateModel(mm.InterestRateModel)
+	if err != nil {
+		return err
+	}
+	if err := interestRateModel.Validate(); err != nil {
 		return err
 	}
 
@@ -101,6 +114,17 @@ func (mm MoneyMarket) Validate() error {
 		return fmt.Errorf("keeper reward percentage must be between 0.0-1.0")
 	}
 
+	if mm.Isolation != nil {
+		if err := mm.Isolation.Validate(); err != nil {
+			return err
+		}
+		for _, denom := range mm.Isolation.BorrowDenoms {
+			if denom == mm.Denom {
+				return fmt.Errorf("isolated money market %s cannot be borrowed against itself", mm.Denom)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -127,9 +151,35 @@ func (mm MoneyMarket) Equal(mmCompareTo MoneyMarket) bool {
 	if !mm.KeeperRewardPercentage.Equal(mmCompareTo.KeeperRewardPercentage) {
 		return false
 	}
+	if (mm.Isolation == nil) != (mmCompareTo.Isolation == nil) {
+		return false
+	}
+	if mm.Isolation != nil && !mm.Isolation.Equal(*mmCompareTo.Isolation) {
+		return false
+	}
 	return true
 }
 
+// IsIsolated returns true if the money market's deposits may only back restricted borrows
+func (mm MoneyMarket) IsIsolated() bool {
+	return mm.Isolation != nil
+}
+
+// GetInterestRateModel returns the interest rate model of the money market
+func (mm MoneyMarket) GetInterestRateModel() InterestRateModel {
+	interestRateModel, err := UnpackInterestRateModel(mm.InterestRateModel)
+	if err != nil {
+		panic(err)
+	}
+	return interestRateModel
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (mm MoneyMarket) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var interestRateModel InterestRateModel
+	return unpacker.UnpackAny(mm.InterestRateModel, &interestRateModel)
+}
+
 // MoneyMarkets slice of MoneyMarket
 type MoneyMarkets []MoneyMarket
 
@@ -143,68 +193,176 @@ func (mms MoneyMarkets) Validate() error {
 	return nil
 }
 
-// NewInterestRateModel returns a new InterestRateModel
-func NewInterestRateModel(baseRateAPY, baseMultiplier, kink, jumpMultiplier sdk.Dec) InterestRateModel {
-	return InterestRateModel{
-		BaseRateAPY:    baseRateAPY,
-		BaseMultiplier: baseMultiplier,
-		Kink:           kink,
-		JumpMultiplier: jumpMultiplier,
+// Contains returns true if the money markets include a market for the denom
+func (mms MoneyMarkets) Contains(denom string) bool {
+	for _, mm := range mms {
+		if mm.Denom == denom {
+			return true
+		}
 	}
+	return false
 }
 
-// Validate InterestRateModel param
-func (irm InterestRateModel) Validate() error {
-	if irm.BaseRateAPY.IsNegative() || irm.BaseRateAPY.GT(sdk.OneDec()) {
-		return fmt.Errorf("base rate APY must be in the inclusive range 0.0-1.0")
+// NewIsolation returns a new Isolation
+func NewIsolation(debtCeiling sdk.Dec, borrowDenoms []string) *Isolation {
+	return &Isolation{
+		DebtCeiling:  debtCeiling,
+		BorrowDenoms: borrowDenoms,
 	}
+}
 
-	if irm.BaseMultiplier.IsNegative() {
-		return fmt.Errorf("base multiplier must not be negative")
+// Validate Isolation
+func (i Isolation) Validate() error {
+	if i.DebtCeiling.IsNil() || i.DebtCeiling.IsNegative() {
+		return fmt.Errorf("debt ceiling must be a non-negative decimal: %s", i.DebtCeiling)
 	}
-
-	if irm.Kink.IsNegative() || irm.Kink.GT(sdk.OneDec()) {
-		return fmt.Errorf("kink must be in the inclusive range 0.0-1.0")
+	if len(i.BorrowDenoms) == 0 {
+		return fmt.Errorf("isolation must allow at least one borrow denom")
 	}
-
-	if irm.JumpMultiplier.IsNegative() {
-		return fmt.Errorf("jump multiplier must not be negative")
+	seenDenoms := make(map[string]bool)
+	for _, denom := range i.BorrowDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if seenDenoms[denom] {
+			return fmt.Errorf("duplicate isolation borrow denom %s", denom)
+		}
+		seenDenoms[denom] = true
 	}
-
 	return nil
 }
 
-// Equal returns a boolean indicating if an InterestRateModel is equal to another InterestRateModel
-func (irm InterestRateModel) Equal(irmCompareTo InterestRateModel) bool {
-	if !irm.BaseRateAPY.Equal(irmCompareTo.BaseRateAPY) {
-		return false
-	}
-	if !irm.BaseMultiplier.Equal(irmCompareTo.BaseMultiplier) {
+// Equal returns a boolean indicating if an Isolation is equal to another Isolation
+func (i Isolation) Equal(iCompareTo Isolation) bool {
+	if !i.DebtCeiling.Equal(iCompareTo.DebtCeiling) {
 		return false
 	}
-	if !irm.Kink.Equal(irmCompareTo.Kink) {
+	if len(i.BorrowDenoms) != len(iCompareTo.BorrowDenoms) {
 		return false
 	}
-	if !irm.JumpMultiplier.Equal(irmCompareTo.JumpMultiplier) {
-		return false
+	for idx := range i.BorrowDenoms {
+		if i.BorrowDenoms[idx] != iCompareTo.BorrowDenoms[idx] {
+			return false
+		}
 	}
 	return true
 }
 
-// InterestRateModels slice of InterestRateModel
-type InterestRateModels []InterestRateModel
+// AllowsBorrow returns true if the denom may be borrowed against the isolated deposit
+func (i Isolation) AllowsBorrow(denom string) bool {
+	for _, borrowDenom := range i.BorrowDenoms {
+		if borrowDenom == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// NewEModeCategory returns a new EModeCategory
+func NewEModeCategory(id uint32, name string, loanToValue sdk.Dec, denoms []string) EModeCategory {
+	return EModeCategory{
+		ID:          id,
+		Name:        name,
+		LoanToValue: loanToValue,
+		Denoms:      denoms,
+	}
+}
+
+// Validate EModeCategory
+func (c EModeCategory) Validate() error {
+	if c.ID == 0 {
+		return fmt.Errorf("e-mode category id cannot be zero")
+	}
+	if c.Name == "" {
+		return fmt.Errorf("e-mode category %d name cannot be empty", c.ID)
+	}
+	if c.LoanToValue.IsNil() || c.LoanToValue.IsNegative() || c.LoanToValue.GT(sdk.OneDec()) {
+		return fmt.Errorf("e-mode category %d loan-to-value must be between 0.0-1.0: %s", c.ID, c.LoanToValue)
+	}
+	if len(c.Denoms) == 0 {
+		return fmt.Errorf("e-mode category %d must contain at least one denom", c.ID)
+	}
+	seenDenoms := make(map[string]bool)
+	for _, denom := range c.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if seenDenoms[denom] {
+			return fmt.Errorf("duplicate denom %s in e-mode category %d", denom, c.ID)
+		}
+		seenDenoms[denom] = true
+	}
+	return nil
+}
+
+// HasDenom returns true if the denom is in the e-mode category
+func (c EModeCategory) HasDenom(denom string) bool {
+	for _, d := range c.Denoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// EModeCategories slice of EModeCategory
+type EModeCategories []EModeCategory
+
+// Validate EModeCategories
+func (cs EModeCategories) Validate() error {
+	seenIDs := make(map[uint32]bool)
+	for _, c := range cs {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+		if seenIDs[c.ID] {
+			return fmt.Errorf("duplicate e-mode category id %d", c.ID)
+		}
+		seenIDs[c.ID] = true
+	}
+	return nil
+}
+
+// Get returns the e-mode category with the given id
+func (cs EModeCategories) Get(id uint32) (EModeCategory, bool) {
+	for _, c := range cs {
+		if c.ID == id {
+			return c, true
+		}
+	}
+	return EModeCategory{}, false
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (mms MoneyMarkets) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, mm := range mms {
+		if err := mm.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
 // NewParams returns a new params object
-func NewParams(moneyMarkets MoneyMarkets, minimumBorrowUSDValue sdk.Dec) Params {
+func NewParams(moneyMarkets MoneyMarkets, minimumBorrowUSDValue sdk.Dec, checkLtvIndexCount uint64,
+	eModeCategories EModeCategories,
+) Params {
 	return Params{
 		MoneyMarkets:          moneyMarkets,
 		MinimumBorrowUSDValue: minimumBorrowUSDValue,
+		CheckLtvIndexCount:    checkLtvIndexCount,
+		EModeCategories:       eModeCategories,
 	}
 }
 
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p Params) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return p.MoneyMarkets.UnpackInterfaces(unpacker)
+}
+
 // DefaultParams returns default params for hard module
 func DefaultParams() Params {
-	return NewParams(DefaultMoneyMarkets, DefaultMinimumBorrowUSDValue)
+	return NewParams(DefaultMoneyMarkets, DefaultMinimumBorrowUSDValue, DefaultCheckLtvIndexCount, DefaultEModeCategories)
 }
 
 // ParamKeyTable Key declaration for parameters
@@ -217,6 +375,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMoneyMarkets, &p.MoneyMarkets, validateMoneyMarketParams),
 		paramtypes.NewParamSetPair(KeyMinimumBorrowUSDValue, &p.MinimumBorrowUSDValue, validateMinimumBorrowUSDValue),
+		paramtypes.NewParamSetPair(KeyCheckLtvIndexCount, &p.CheckLtvIndexCount, validateCheckLtvIndexCount),
+		paramtypes.NewParamSetPair(KeyEModeCategories, &p.EModeCategories, validateEModeCategories),
 	}
 }
 
@@ -226,7 +386,38 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateMoneyMarketParams(p.MoneyMarkets)
+	if err := validateCheckLtvIndexCount(p.CheckLtvIndexCount); err != nil {
+		return err
+	}
+
+	if err := validateMoneyMarketParams(p.MoneyMarkets); err != nil {
+		return err
+	}
+
+	if err := validateEModeCategories(p.EModeCategories); err != nil {
+		return err
+	}
+
+	for _, mm := range p.MoneyMarkets {
+		if !mm.IsIsolated() {
+			continue
+		}
+		for _, denom := range mm.Isolation.BorrowDenoms {
+			if !p.MoneyMarkets.Contains(denom) {
+				return fmt.Errorf("isolated money market %s borrow denom %s has no money market", mm.Denom, denom)
+			}
+		}
+	}
+
+	for _, category := range p.EModeCategories {
+		for _, denom := range category.Denoms {
+			if !p.MoneyMarkets.Contains(denom) {
+				return fmt.Errorf("e-mode category %d denom %s has no money market", category.ID, denom)
+			}
+		}
+	}
+
+	return nil
 }
 
 func validateMinimumBorrowUSDValue(i interface{}) error {
@@ -242,6 +433,15 @@ func validateMinimumBorrowUSDValue(i interface{}) error {
 	return nil
 }
 
+func validateCheckLtvIndexCount(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
 func validateMoneyMarketParams(i interface{}) error {
 	mm, ok := i.(MoneyMarkets)
 	if !ok {
@@ -250,3 +450,12 @@ func validateMoneyMarketParams(i interface{}) error {
 
 	return mm.Validate()
 }
+
+func validateEModeCategories(i interface{}) error {
+	categories, ok := i.(EModeCategories)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return categories.Validate()
+}
@@ -48,7 +48,7 @@ func (suite *ParamTestSuite) TestParamValidation() {
 						),
 						SpotMarketID:           "btc:usd",
 						ConversionFactor:       sdkmath.NewInt(0),
-						InterestRateModel:      types.InterestRateModel{},
+						InterestRateModel:      nil,
 						ReserveFactor:          sdk.MustNewDecFromStr("0.05"),
 						KeeperRewardPercentage: sdk.MustNewDecFromStr("0.05"),
 					},
@@ -60,7 +60,7 @@ func (suite *ParamTestSuite) TestParamValidation() {
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
-			params := types.NewParams(tc.args.mms, tc.args.minBorrowVal)
+			params := types.NewParams(tc.args.mms, tc.args.minBorrowVal, types.DefaultCheckLtvIndexCount, types.DefaultEModeCategories)
 			err := params.Validate()
 			if tc.expectPass {
 				suite.NoError(err)
@@ -72,6 +72,108 @@ func (suite *ParamTestSuite) TestParamValidation() {
 	}
 }
 
+func (suite *ParamTestSuite) TestEModeAndIsolationValidation() {
+	newMoneyMarket := func(denom string, isolation *types.Isolation) types.MoneyMarket {
+		mm := types.NewMoneyMarket(
+			denom,
+			types.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.5")),
+			denom+":usd",
+			sdkmath.NewInt(1000000),
+			types.NewJumpRateModel(sdk.ZeroDec(), sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("5")),
+			sdk.MustNewDecFromStr("0.025"),
+			sdk.MustNewDecFromStr("0.02"),
+		)
+		mm.Isolation = isolation
+		return mm
+	}
+
+	testCases := []struct {
+		name        string
+		mms         types.MoneyMarkets
+		categories  types.EModeCategories
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			mms: types.MoneyMarkets{
+				newMoneyMarket("usdx", nil),
+				newMoneyMarket("busd", nil),
+				newMoneyMarket("bnb", types.NewIsolation(sdk.NewDec(1000000), []string{"usdx", "busd"})),
+			},
+			categories: types.EModeCategories{
+				types.NewEModeCategory(1, "stablecoins", sdk.MustNewDecFromStr("0.95"), []string{"usdx", "busd"}),
+			},
+		},
+		{
+			name: "invalid: isolated market borrowed against itself",
+			mms: types.MoneyMarkets{
+				newMoneyMarket("bnb", types.NewIsolation(sdk.NewDec(1000000), []string{"bnb"})),
+			},
+			expectedErr: "cannot be borrowed against itself",
+		},
+		{
+			name: "invalid: negative debt ceiling",
+			mms: types.MoneyMarkets{
+				newMoneyMarket("usdx", nil),
+				newMoneyMarket("bnb", types.NewIsolation(sdk.NewDec(-1), []string{"usdx"})),
+			},
+			expectedErr: "debt ceiling must be a non-negative decimal",
+		},
+		{
+			name: "invalid: isolation borrow denom without money market",
+			mms: types.MoneyMarkets{
+				newMoneyMarket("bnb", types.NewIsolation(sdk.NewDec(1000000), []string{"usdx"})),
+			},
+			expectedErr: "borrow denom usdx has no money market",
+		},
+		{
+			name: "invalid: e-mode category id zero",
+			mms:  types.MoneyMarkets{newMoneyMarket("usdx", nil)},
+			categories: types.EModeCategories{
+				types.NewEModeCategory(0, "stablecoins", sdk.MustNewDecFromStr("0.95"), []string{"usdx"}),
+			},
+			expectedErr: "e-mode category id cannot be zero",
+		},
+		{
+			name: "invalid: duplicate e-mode category id",
+			mms:  types.MoneyMarkets{newMoneyMarket("usdx", nil)},
+			categories: types.EModeCategories{
+				types.NewEModeCategory(1, "stablecoins", sdk.MustNewDecFromStr("0.95"), []string{"usdx"}),
+				types.NewEModeCategory(1, "dollars", sdk.MustNewDecFromStr("0.9"), []string{"usdx"}),
+			},
+			expectedErr: "duplicate e-mode category id 1",
+		},
+		{
+			name: "invalid: e-mode loan-to-value above one",
+			mms:  types.MoneyMarkets{newMoneyMarket("usdx", nil)},
+			categories: types.EModeCategories{
+				types.NewEModeCategory(1, "stablecoins", sdk.MustNewDecFromStr("1.1"), []string{"usdx"}),
+			},
+			expectedErr: "loan-to-value must be between 0.0-1.0",
+		},
+		{
+			name: "invalid: e-mode denom without money market",
+			mms:  types.MoneyMarkets{newMoneyMarket("usdx", nil)},
+			categories: types.EModeCategories{
+				types.NewEModeCategory(1, "stablecoins", sdk.MustNewDecFromStr("0.95"), []string{"usdx", "busd"}),
+			},
+			expectedErr: "denom busd has no money market",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params := types.NewParams(tc.mms, types.DefaultMinimumBorrowUSDValue, types.DefaultCheckLtvIndexCount, tc.categories)
+			err := params.Validate()
+			if tc.expectedErr == "" {
+				suite.NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expectedErr)
+			}
+		})
+	}
+}
+
 func TestParamTestSuite(t *testing.T) {
 	suite.Run(t, new(ParamTestSuite))
 }
@@ -1,6 +1,7 @@
 package types
 
 import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -163,6 +164,18 @@ func NewMoneyMarketInterestRate(denom string, supplyInterestRate, borrowInterest
 // MoneyMarketInterestRates is a slice of MoneyMarketInterestRate
 type MoneyMarketInterestRates []MoneyMarketInterestRate
 
+// NewInterestRateCurvePoint returns a new instance of InterestRateCurvePoint
+func NewInterestRateCurvePoint(utilization, supplyInterestRate, borrowInterestRate sdk.Dec) InterestRateCurvePoint {
+	return InterestRateCurvePoint{
+		Utilization:        utilization.String(),
+		SupplyInterestRate: supplyInterestRate.String(),
+		BorrowInterestRate: borrowInterestRate.String(),
+	}
+}
+
+// InterestRateCurvePoints is a slice of InterestRateCurvePoint
+type InterestRateCurvePoints []InterestRateCurvePoint
+
 // QueryReservesParams is the params for a filtered reserves query
 type QueryReservesParams struct {
 	Denom string `json:"denom" yaml:"denom"`
@@ -198,3 +211,20 @@ func NewInterestFactor(denom string, supplyInterestFactor, borrowInterestFactor
 
 // InterestFactors is a slice of InterestFactor
 type InterestFactors []InterestFactor
+
+// NewLiquidationPrice returns a new instance of LiquidationPrice
+func NewLiquidationPrice(denom, spotMarketID string, price sdk.Dec) LiquidationPrice {
+	return LiquidationPrice{
+		Denom:        denom,
+		SpotMarketID: spotMarketID,
+		Price:        price.String(),
+	}
+}
+
+// LiquidationPrices is a slice of LiquidationPrice
+type LiquidationPrices []LiquidationPrice
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (q QueryParamsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return q.Params.UnpackInterfaces(unpacker)
+}
@@ -35,4 +35,9 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 			panic(fmt.Sprintf("failed to accumulate earn rewards: %s", err))
 		}
 	}
+	for _, srp := range params.SourceRewardPeriods {
+		for _, rp := range srp.RewardPeriods {
+			k.AccumulateSourceRewards(ctx, srp.Source, rp)
+		}
+	}
 }
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -15,10 +16,12 @@ import (
 )
 
 const (
-	flagOwner    = "owner"
-	flagType     = "type"
-	flagUnsynced = "unsynced"
-	flagDenom    = "denom"
+	flagOwner          = "owner"
+	flagType           = "type"
+	flagUnsynced       = "unsynced"
+	flagDenom          = "denom"
+	flagSource         = "source"
+	flagCollateralType = "collateral-type"
 
 	typeDelegator   = "delegator"
 	typeHard        = "hard"
@@ -41,6 +44,8 @@ func GetQueryCmd() *cobra.Command {
 		queryParamsCmd(),
 		queryRewardsCmd(),
 		queryRewardFactorsCmd(),
+		queryPendingRewardsCmd(),
+		queryRewardBudgetsCmd(),
 	}
 
 	for _, cmd := range cmds {
@@ -268,6 +273,67 @@ func queryRewardFactorsCmd() *cobra.Command {
 	return cmd
 }
 
+func queryPendingRewardsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "pending-rewards [owner]",
+		Short:   "get an account's unclaimed rewards of every reward type",
+		Long:    `Get an account's unclaimed rewards of every reward type, synchronized to the current block, and their total.`,
+		Example: fmt.Sprintf(`  $ %s q %s pending-rewards fury1...`, version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(cliCtx)
+
+			res, err := queryClient.PendingRewards(context.Background(), &types.QueryPendingRewardsRequest{
+				Owner: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+}
+
+func queryRewardBudgetsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reward-budgets",
+		Short:   "get the remaining budget and runway of reward periods with a budget",
+		Long:    `Get the spent and remaining budget of reward periods with a budget, and how long they can keep paying rewards at their full rate.`,
+		Example: fmt.Sprintf(`  $ %s q %s reward-budgets --%s hard_supply`, version.AppName, types.ModuleName, flagSource),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			source, _ := cmd.Flags().GetString(flagSource)
+			collateralType, _ := cmd.Flags().GetString(flagCollateralType)
+
+			queryClient := types.NewQueryClient(cliCtx)
+
+			res, err := queryClient.RewardBudgets(context.Background(), &types.QueryRewardBudgetsRequest{
+				Source:         source,
+				CollateralType: collateralType,
+			})
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagSource, "", "(optional) filter by reward source, e.g. hard_supply, hard_borrow, delegator_claim")
+	cmd.Flags().String(flagCollateralType, "", "(optional) filter by collateral type")
+	return cmd
+}
+
 func executeHardRewardsQuery(cliCtx client.Context, params types.QueryRewardsParams) (types.HardLiquidityProviderClaims, error) {
 	bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
 	if err != nil {
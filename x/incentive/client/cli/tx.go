@@ -17,6 +17,8 @@ import (
 const (
 	multiplierFlag      = "multiplier"
 	multiplierFlagShort = "m"
+	destinationFlag     = "destination"
+	validatorFlag       = "validator"
 )
 
 // GetTxCmd returns the transaction cli commands for the incentive module
@@ -33,6 +35,8 @@ func GetTxCmd() *cobra.Command {
 		getCmdClaimSwap(),
 		getCmdClaimSavings(),
 		getCmdClaimEarn(),
+		getCmdClaimAll(),
+		getCmdClaimReward(),
 	}
 
 	for _, cmd := range cmds {
@@ -90,7 +94,10 @@ func getCmdClaimHard() *cobra.Command {
 			}
 
 			sender := cliCtx.GetFromAddress()
-			selections := types.NewSelectionsFromMap(denomsToClaim)
+			selections, err := newSelectionsWithDestination(cmd, denomsToClaim)
+			if err != nil {
+				return err
+			}
 
 			msg := types.NewMsgClaimHardReward(sender.String(), selections)
 			if err := msg.ValidateBasic(); err != nil {
@@ -103,6 +110,7 @@ func getCmdClaimHard() *cobra.Command {
 	if err := cmd.MarkFlagRequired(multiplierFlag); err != nil {
 		panic(err)
 	}
+	addDestinationFlags(cmd)
 	return cmd
 }
 
@@ -116,6 +124,7 @@ func getCmdClaimDelegator() *cobra.Command {
 		Example: strings.Join([]string{
 			fmt.Sprintf(`  $ %s tx %s claim-delegator --%s hard=large --%s swp=small`, version.AppName, types.ModuleName, multiplierFlag, multiplierFlag),
 			fmt.Sprintf(`  $ %s tx %s claim-delegator --%s hard=large,swp=small`, version.AppName, types.ModuleName, multiplierFlag),
+			fmt.Sprintf(`  $ %s tx %s claim-delegator --%s ufury=large --%s delegation --%s furyvaloper1...`, version.AppName, types.ModuleName, multiplierFlag, destinationFlag, validatorFlag),
 		}, "\n"),
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -125,7 +134,10 @@ func getCmdClaimDelegator() *cobra.Command {
 			}
 
 			sender := cliCtx.GetFromAddress()
-			selections := types.NewSelectionsFromMap(denomsToClaim)
+			selections, err := newSelectionsWithDestination(cmd, denomsToClaim)
+			if err != nil {
+				return err
+			}
 
 			msg := types.NewMsgClaimDelegatorReward(sender.String(), selections)
 			if err := msg.ValidateBasic(); err != nil {
@@ -138,6 +150,7 @@ func getCmdClaimDelegator() *cobra.Command {
 	if err := cmd.MarkFlagRequired(multiplierFlag); err != nil {
 		panic(err)
 	}
+	addDestinationFlags(cmd)
 	return cmd
 }
 
@@ -160,7 +173,10 @@ func getCmdClaimSwap() *cobra.Command {
 			}
 
 			sender := cliCtx.GetFromAddress()
-			selections := types.NewSelectionsFromMap(denomsToClaim)
+			selections, err := newSelectionsWithDestination(cmd, denomsToClaim)
+			if err != nil {
+				return err
+			}
 
 			msg := types.NewMsgClaimSwapReward(sender.String(), selections)
 			if err := msg.ValidateBasic(); err != nil {
@@ -173,6 +189,7 @@ func getCmdClaimSwap() *cobra.Command {
 	if err := cmd.MarkFlagRequired(multiplierFlag); err != nil {
 		panic(err)
 	}
+	addDestinationFlags(cmd)
 	return cmd
 }
 
@@ -195,7 +212,10 @@ func getCmdClaimSavings() *cobra.Command {
 			}
 
 			sender := cliCtx.GetFromAddress()
-			selections := types.NewSelectionsFromMap(denomsToClaim)
+			selections, err := newSelectionsWithDestination(cmd, denomsToClaim)
+			if err != nil {
+				return err
+			}
 
 			msg := types.NewMsgClaimSavingsReward(sender.String(), selections)
 			if err := msg.ValidateBasic(); err != nil {
@@ -208,6 +228,7 @@ func getCmdClaimSavings() *cobra.Command {
 	if err := cmd.MarkFlagRequired(multiplierFlag); err != nil {
 		panic(err)
 	}
+	addDestinationFlags(cmd)
 	return cmd
 }
 
@@ -227,7 +248,10 @@ func getCmdClaimEarn() *cobra.Command {
 			}
 
 			sender := cliCtx.GetFromAddress()
-			selections := types.NewSelectionsFromMap(denomsToClaim)
+			selections, err := newSelectionsWithDestination(cmd, denomsToClaim)
+			if err != nil {
+				return err
+			}
 
 			msg := types.NewMsgClaimEarnReward(sender.String(), selections)
 			if err := msg.ValidateBasic(); err != nil {
@@ -240,5 +264,110 @@ func getCmdClaimEarn() *cobra.Command {
 	if err := cmd.MarkFlagRequired(multiplierFlag); err != nil {
 		panic(err)
 	}
+	addDestinationFlags(cmd)
+	return cmd
+}
+
+func getCmdClaimAll() *cobra.Command {
+	var denomsToClaim map[string]string
+
+	cmd := &cobra.Command{
+		Use:   "claim-all",
+		Short: "claim sender's rewards of every reward type using given multipliers",
+		Long:  `Claim sender's outstanding rewards of every reward type using given multipliers. Each multiplier applies to its denom in all reward types.`,
+		Example: strings.Join([]string{
+			fmt.Sprintf(`  $ %s tx %s claim-all --%s hard=large --%s ufury=small`, version.AppName, types.ModuleName, multiplierFlag, multiplierFlag),
+			fmt.Sprintf(`  $ %s tx %s claim-all --%s hard=large,ufury=small`, version.AppName, types.ModuleName, multiplierFlag),
+		}, "\n"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+			selections, err := newSelectionsWithDestination(cmd, denomsToClaim)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimAllRewards(sender.String(), selections)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().StringToStringVarP(&denomsToClaim, multiplierFlag, multiplierFlagShort, nil, "specify the denoms to claim, each with a multiplier lockup")
+	if err := cmd.MarkFlagRequired(multiplierFlag); err != nil {
+		panic(err)
+	}
+	addDestinationFlags(cmd)
 	return cmd
 }
+
+func getCmdClaimReward() *cobra.Command {
+	var denomsToClaim map[string]string
+
+	cmd := &cobra.Command{
+		Use:     "claim-reward [source]",
+		Short:   "claim sender's rewards from a registered reward source using given multipliers",
+		Long:    `Claim sender's outstanding rewards from a reward source registered by another module using given multipliers`,
+		Example: fmt.Sprintf(`  $ %s tx %s claim-reward lending --%s hard=large`, version.AppName, types.ModuleName, multiplierFlag),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+			selections, err := newSelectionsWithDestination(cmd, denomsToClaim)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimReward(sender.String(), args[0], selections)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().StringToStringVarP(&denomsToClaim, multiplierFlag, multiplierFlagShort, nil, "specify the denoms to claim, each with a multiplier lockup")
+	if err := cmd.MarkFlagRequired(multiplierFlag); err != nil {
+		panic(err)
+	}
+	addDestinationFlags(cmd)
+	return cmd
+}
+
+// addDestinationFlags adds the flags selecting where claimed rewards are sent.
+func addDestinationFlags(cmd *cobra.Command) {
+	cmd.Flags().String(destinationFlag, "account", "where to send the claimed rewards: account, earn, savings or delegation")
+	cmd.Flags().String(validatorFlag, "", "validator to delegate the claimed rewards to, used by the delegation and earn destinations")
+}
+
+// newSelectionsWithDestination builds selections from the multiplier flag, applying the destination flags to every denom.
+func newSelectionsWithDestination(cmd *cobra.Command, denomsToClaim map[string]string) (types.Selections, error) {
+	destinationStr, err := cmd.Flags().GetString(destinationFlag)
+	if err != nil {
+		return nil, err
+	}
+	destination := types.NewClaimDestinationFromString(destinationStr)
+	if err := destination.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid destination '%s'", destinationStr)
+	}
+	validator, err := cmd.Flags().GetString(validatorFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	selections := types.NewSelectionsFromMap(denomsToClaim)
+	for i := range selections {
+		selections[i].Destination = destination
+		selections[i].Validator = validator
+	}
+	return selections, nil
+}
@@ -2,6 +2,7 @@ package incentive
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -134,6 +135,30 @@ func InitGenesis(
 	for _, mri := range gs.EarnRewardState.MultiRewardIndexes {
 		k.SetEarnRewardIndexes(ctx, mri.CollateralType, mri.RewardIndexes)
 	}
+
+	// Registered reward sources
+	for _, claim := range gs.SourceClaims {
+		k.SetSourceClaim(ctx, claim)
+	}
+	for _, srs := range gs.SourceRewardStates {
+		for _, gat := range srs.RewardState.AccumulationTimes {
+			if err := ValidateAccumulationTime(gat.PreviousAccumulationTime); err != nil {
+				panic(err.Error())
+			}
+			k.SetSourceRewardAccrualTime(ctx, srs.Source, gat.CollateralType, gat.PreviousAccumulationTime)
+		}
+		for _, mri := range srs.RewardState.MultiRewardIndexes {
+			k.SetSourceRewardIndexes(ctx, srs.Source, mri.CollateralType, mri.RewardIndexes)
+		}
+	}
+
+	// Reward budgets
+	for _, spend := range gs.RewardBudgetSpends {
+		k.SetRewardBudgetSpend(ctx, spend)
+	}
+	for _, stake := range gs.RewardBoostStakes {
+		k.SetRewardBoostStake(ctx, stake)
+	}
 }
 
 // ExportGenesis export genesis state for incentive module
@@ -159,13 +184,73 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	earnClaims := k.GetAllEarnClaims(ctx)
 	earnRewardState := getEarnGenesisRewardState(ctx, k)
 
-	return types.NewGenesisState(
+	gs := types.NewGenesisState(
 		params,
 		// Reward states
 		usdxRewardState, hardSupplyRewardState, hardBorrowRewardState, delegatorRewardState, swapRewardState, savingsRewardState, earnRewardState,
 		// Claims
 		usdxClaims, hardClaims, delegatorClaims, swapClaims, savingsClaims, earnClaims,
 	)
+	gs.SourceRewardStates, gs.SourceClaims = getSourceGenesisState(ctx, k)
+	gs.RewardBudgetSpends = k.GetAllRewardBudgetSpends(ctx)
+	gs.RewardBoostStakes = k.GetAllRewardBoostStakes(ctx)
+
+	return gs
+}
+
+// getSourceGenesisState returns the reward states and claims of all non native reward sources with stored state,
+// including sources that are no longer registered.
+func getSourceGenesisState(ctx sdk.Context, keeper keeper.Keeper) (types.SourceGenesisRewardStates, types.SourceClaims) {
+	seen := make(map[string]bool)
+	addSource := func(source string) {
+		if !types.IsNativeRewardSource(source) {
+			seen[source] = true
+		}
+	}
+	keeper.IterateAllSourceRewardAccrualTimes(ctx, func(source, _ string, _ time.Time) bool {
+		addSource(source)
+		return false
+	})
+	keeper.IterateAllSourceRewardIndexes(ctx, func(source, _ string, _ types.RewardIndexes) bool {
+		addSource(source)
+		return false
+	})
+	keeper.IterateAllSourceClaims(ctx, func(c types.SourceClaim) bool {
+		addSource(c.Source)
+		return false
+	})
+
+	sources := make([]string, 0, len(seen))
+	for source := range seen {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+
+	var states types.SourceGenesisRewardStates
+	var claims types.SourceClaims
+	for _, source := range sources {
+		var ats types.AccumulationTimes
+		keeper.IterateSourceRewardAccrualTimes(ctx, source, func(sourceID string, accTime time.Time) bool {
+			ats = append(ats, types.NewAccumulationTime(sourceID, accTime))
+			return false
+		})
+
+		var mris types.MultiRewardIndexes
+		keeper.IterateSourceRewardIndexes(ctx, source, func(sourceID string, indexes types.RewardIndexes) bool {
+			mris = append(mris, types.NewMultiRewardIndex(sourceID, indexes))
+			return false
+		})
+		if len(ats) > 0 || len(mris) > 0 {
+			states = append(states, types.NewSourceGenesisRewardState(source, types.NewGenesisRewardState(ats, mris)))
+		}
+
+		keeper.IterateSourceClaims(ctx, source, func(c types.SourceClaim) bool {
+			claims = append(claims, c)
+			return false
+		})
+	}
+
+	return states, claims
 }
 
 func getUSDXMintingGenesisRewardState(ctx sdk.Context, keeper keeper.Keeper) types.GenesisRewardState {
@@ -51,10 +51,12 @@ func (suite *GenesisTestSuite) SetupTest() {
 	hardGS := hardtypes.NewGenesisState(
 		hardtypes.NewParams(
 			hardtypes.MoneyMarkets{
-				hardtypes.NewMoneyMarket("ufury", hardtypes.NewBorrowLimit(false, borrowLimit, loanToValue), "fury:usd", sdkmath.NewInt(1000000), hardtypes.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
-				hardtypes.NewMoneyMarket("bnb", hardtypes.NewBorrowLimit(false, borrowLimit, loanToValue), "bnb:usd", sdkmath.NewInt(1000000), hardtypes.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+				hardtypes.NewMoneyMarket("ufury", hardtypes.NewBorrowLimit(false, borrowLimit, loanToValue), "fury:usd", sdkmath.NewInt(1000000), hardtypes.NewJumpRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+				hardtypes.NewMoneyMarket("bnb", hardtypes.NewBorrowLimit(false, borrowLimit, loanToValue), "bnb:usd", sdkmath.NewInt(1000000), hardtypes.NewJumpRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
 			},
 			sdk.NewDec(10),
+			hardtypes.DefaultCheckLtvIndexCount,
+			hardtypes.DefaultEModeCategories,
 		),
 		hardtypes.DefaultAccumulationTimes,
 		hardtypes.DefaultDeposits,
@@ -276,6 +278,36 @@ func (suite *GenesisTestSuite) TestExportedGenesisMatchesImported() {
 		},
 	)
 
+	genesisState.Params.SourceRewardPeriods = types.SourceMultiRewardPeriods{
+		types.NewSourceMultiRewardPeriod("lending", types.MultiRewardPeriods{
+			types.NewBudgetedMultiRewardPeriod(true, "pool-1", genesisTime.Add(-1*oneYear), genesisTime.Add(oneYear), cs(c("hard", 122354)), cs(c("hard", 1e12))),
+		}),
+	}
+	genesisState.SourceRewardStates = types.SourceGenesisRewardStates{
+		types.NewSourceGenesisRewardState("lending", types.NewGenesisRewardState(
+			types.AccumulationTimes{
+				types.NewAccumulationTime("pool-1", genesisTime.Add(-5*time.Hour)),
+			},
+			types.MultiRewardIndexes{
+				types.NewMultiRewardIndex("pool-1", types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.4")}}),
+			},
+		)),
+	}
+	genesisState.SourceClaims = types.SourceClaims{
+		types.NewSourceClaim(
+			"lending",
+			suite.addrs[3],
+			cs(c("hard", 7)),
+			types.MultiRewardIndexes{{CollateralType: "pool-1", RewardIndexes: types.RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.4")}}}},
+		),
+	}
+	genesisState.RewardBudgetSpends = types.RewardBudgetSpends{
+		types.NewRewardBudgetSpend("lending", "pool-1", genesisTime.Add(-oneYear), sdk.NewDecCoins(sdk.NewDecCoin("hard", sdkmath.NewInt(400000)))),
+	}
+	genesisState.RewardBoostStakes = types.RewardBoostStakes{
+		types.NewRewardBoostStake("lending", "pool-1", suite.addrs[3], sdkmath.NewInt(1_000_000)),
+	}
+
 	tApp := app.NewTestApp()
 	ctx := tApp.NewContext(true, tmproto.Header{Height: 0, Time: genesisTime})
 
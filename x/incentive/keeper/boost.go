@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fury/x/incentive/types"
+)
+
+// GetRewardBoost returns the multiplier applied to the rewards an owner earns from a reward source with their current
+// stake. Owners are boosted by their bonded FURY, following the RewardBoost param. It is 1 if there is no boost.
+func (k Keeper) GetRewardBoost(ctx sdk.Context, source string, owner sdk.AccAddress) sdk.Dec {
+	boost := k.GetParams(ctx).RewardBoost
+	if !boost.Boosts(source) {
+		return sdk.OneDec()
+	}
+	return boost.Multiplier(k.getBoostStake(ctx, owner))
+}
+
+// getBoostStake returns the stake boosting an owner's rewards.
+// Only bonded stake counts, so stake added just to claim a larger boost stays locked for the unbonding period.
+func (k Keeper) getBoostStake(ctx sdk.Context, owner sdk.AccAddress) sdkmath.Int {
+	return getTotalDelegated(ctx, k.stakingKeeper, owner, nil, false).TruncateInt()
+}
+
+// initializeRewardBoostStake records the current stake of an owner for a source ID of a boosted reward source, so the
+// rewards accrued until the next sync can be boosted.
+func (k Keeper) initializeRewardBoostStake(ctx sdk.Context, source, sourceID string, owner sdk.AccAddress) {
+	// Only the boost param is read, as staking genesis initializes delegator claims before incentive params are set.
+	var boost types.RewardBoost
+	k.paramSubspace.GetIfExists(ctx, types.KeyRewardBoost, &boost)
+	if !boost.Boosts(source) {
+		return
+	}
+	k.SetRewardBoostStake(ctx, types.NewRewardBoostStake(source, sourceID, owner, k.getBoostStake(ctx, owner)))
+}
+
+// syncRewardBoostStake returns the stake boosting the rewards accrued since the last sync of a source ID, and records
+// the current stake for the next sync.
+// The boost uses the lower of the stake recorded at the last sync and the current stake, so stake added just before a
+// sync does not boost rewards accrued before it. Owners without a recorded stake are not boosted until their next sync.
+func (k Keeper) syncRewardBoostStake(ctx sdk.Context, source, sourceID string, owner sdk.AccAddress) sdkmath.Int {
+	current := k.getBoostStake(ctx, owner)
+
+	staked := sdk.ZeroInt()
+	if previous, found := k.GetRewardBoostStake(ctx, source, sourceID, owner); found {
+		staked = sdkmath.MinInt(previous.Staked, current)
+	}
+
+	k.SetRewardBoostStake(ctx, types.NewRewardBoostStake(source, sourceID, owner, current))
+	return staked
+}
+
+// boostRewards adds the owner's reward boost to rewards newly synced from a source ID, rounding down.
+// It writes state, so it must only be used when the synchronized claim is stored.
+//
+// The boost is paid from the budget of the reward period of the source ID and is capped to its remaining budget, so it
+// never pays out more than governance budgeted. Rewards of reward periods without a budget are not boosted.
+func (k Keeper) boostRewards(ctx sdk.Context, source, sourceID string, owner sdk.AccAddress, rewards sdk.Coins) sdk.Coins {
+	params := k.GetParams(ctx)
+	if !params.RewardBoost.Boosts(source) {
+		return rewards
+	}
+
+	// The recorded stake is only updated when rewards accrued, so source IDs without shares or rewards store nothing.
+	// Keeping an older stake for longer only lowers the boost.
+	if rewards.IsZero() {
+		return rewards
+	}
+	multiplier := params.RewardBoost.Multiplier(k.syncRewardBoostStake(ctx, source, sourceID, owner))
+	if multiplier.Equal(sdk.OneDec()) {
+		return rewards
+	}
+
+	rewardPeriod, found := k.getBudgetedRewardPeriod(ctx, params, source, sourceID)
+	if !found {
+		return rewards
+	}
+
+	extra := sdk.NewCoins()
+	for _, coin := range rewards {
+		extra = extra.Add(sdk.NewCoin(coin.Denom, sdk.NewDecFromInt(coin.Amount).Mul(multiplier.Sub(sdk.OneDec())).TruncateInt()))
+	}
+	if extra.IsZero() {
+		return rewards
+	}
+
+	capped, _ := k.capRewardsToBudget(ctx, source, rewardPeriod, sdk.NewDecCoinsFromCoins(extra...)).TruncateDecimal()
+	return rewards.Add(capped...)
+}
+
+// getBudgetedRewardPeriod returns the reward period with a budget that pays the rewards of a source ID.
+func (k Keeper) getBudgetedRewardPeriod(ctx sdk.Context, params types.Params, source, sourceID string) (types.MultiRewardPeriod, bool) {
+	collateralType := sourceID
+	if source == types.EarnClaimType && k.liquidKeeper.IsDerivativeDenom(ctx, sourceID) {
+		// all bfury vaults are paid by the bfury reward period and share its budget
+		collateralType = "bfury"
+	}
+
+	rewardPeriods, found := params.BudgetedRewardPeriods().Get(source)
+	if !found {
+		return types.MultiRewardPeriod{}, false
+	}
+	return rewardPeriods.GetMultiRewardPeriod(collateralType)
+}
@@ -0,0 +1,195 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/incubus-network/fury/x/incentive/types"
+)
+
+const boostedPool = "pool-1"
+
+type RewardBoostTests struct {
+	unitTester
+
+	stakingKeeper *fakeStakingKeeper
+	owner         sdk.AccAddress
+}
+
+func TestRewardBoosts(t *testing.T) {
+	suite.Run(t, new(RewardBoostTests))
+}
+
+func (suite *RewardBoostTests) setupStakedOwner(bonded int64, boost types.RewardBoost, budget sdk.Coins) {
+	validatorAddress := arbitraryValidatorAddress()
+	suite.stakingKeeper = &fakeStakingKeeper{
+		delegations: stakingtypes.Delegations{{
+			ValidatorAddress: validatorAddress.String(),
+			Shares:           d("1000"),
+		}},
+		validators: stakingtypes.Validators{{
+			OperatorAddress: validatorAddress.String(),
+			Status:          stakingtypes.Bonded,
+			Tokens:          i(bonded),
+			DelegatorShares: d("1000"),
+		}},
+	}
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, suite.stakingKeeper, nil, nil, nil, nil)
+
+	params := types.DefaultParams()
+	params.RewardBoost = boost
+	params.SourceRewardPeriods = types.SourceMultiRewardPeriods{
+		types.NewSourceMultiRewardPeriod(testRewardSource, types.MultiRewardPeriods{
+			types.NewBudgetedMultiRewardPeriod(true, boostedPool, time.Unix(0, 0), distantFuture, cs(c("swap", 1)), budget),
+		}),
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+}
+
+// setStake changes the bonded stake of the owner.
+func (suite *RewardBoostTests) setStake(bonded int64) {
+	suite.stakingKeeper.validators[0].Tokens = i(bonded)
+}
+
+func (suite *RewardBoostTests) initializeClaim() {
+	suite.keeper.RegisterRewardSource(testRewardSource, newFakeRewardSource().addShares(boostedPool, suite.owner, d("1000")))
+
+	suite.keeper.SetSourceRewardIndexes(suite.ctx, testRewardSource, boostedPool, types.RewardIndexes{
+		types.NewRewardIndex("swap", d("0.1")),
+	})
+	suite.keeper.InitializeSourceReward(suite.ctx, testRewardSource, boostedPool, suite.owner)
+}
+
+// syncRewards syncs 400swap of unboosted rewards to the claim, returning the claimable rewards.
+func (suite *RewardBoostTests) syncRewards() sdk.Coins {
+	indexes, _ := suite.keeper.GetSourceRewardIndexes(suite.ctx, testRewardSource, boostedPool)
+	suite.keeper.SetSourceRewardIndexes(suite.ctx, testRewardSource, boostedPool, indexes.Add(types.RewardIndexes{
+		types.NewRewardIndex("swap", d("0.4")),
+	}))
+	suite.keeper.SynchronizeSourceReward(suite.ctx, testRewardSource, boostedPool, suite.owner)
+
+	claim, found := suite.keeper.GetSourceClaim(suite.ctx, testRewardSource, suite.owner)
+	suite.True(found)
+	return claim.Reward
+}
+
+func (suite *RewardBoostTests) SetupTest() {
+	suite.unitTester.SetupTest()
+	suite.owner = arbitraryAddress()
+}
+
+func (suite *RewardBoostTests) TestSyncedRewardsAreBoostedByStake() {
+	suite.setupStakedOwner(500, types.NewRewardBoost(d("2"), i(1000)), cs(c("swap", 1_000_000)))
+	suite.initializeClaim()
+
+	// 400swap boosted by 1.5 for half of the full boost stake
+	suite.Equal(cs(c("swap", 600)), suite.syncRewards())
+
+	// the boost is paid from the period budget
+	spend, found := suite.keeper.GetRewardBudgetSpend(suite.ctx, testRewardSource, boostedPool)
+	suite.True(found)
+	suite.Equal(sdk.NewDecCoins(sdk.NewDecCoin("swap", i(200))), spend.Spent)
+}
+
+func (suite *RewardBoostTests) TestBoostIsCapped() {
+	suite.setupStakedOwner(5000, types.NewRewardBoost(d("2"), i(1000)), cs(c("swap", 1_000_000)))
+	suite.initializeClaim()
+
+	suite.Equal(cs(c("swap", 800)), suite.syncRewards())
+}
+
+func (suite *RewardBoostTests) TestBoostIsCappedToRemainingBudget() {
+	suite.setupStakedOwner(1000, types.NewRewardBoost(d("2"), i(1000)), cs(c("swap", 1000)))
+	suite.initializeClaim()
+	suite.keeper.SetRewardBudgetSpend(suite.ctx, types.NewRewardBudgetSpend(testRewardSource, boostedPool, time.Unix(0, 0), sdk.NewDecCoins(sdk.NewDecCoin("swap", i(900)))))
+
+	suite.Equal(cs(c("swap", 500)), suite.syncRewards())
+
+	spend, _ := suite.keeper.GetRewardBudgetSpend(suite.ctx, testRewardSource, boostedPool)
+	suite.Equal(sdk.NewDecCoins(sdk.NewDecCoin("swap", i(1000))), spend.Spent)
+}
+
+func (suite *RewardBoostTests) TestPeriodsWithoutBudgetAreNotBoosted() {
+	suite.setupStakedOwner(1000, types.NewRewardBoost(d("2"), i(1000)), nil)
+	suite.initializeClaim()
+
+	suite.Equal(cs(c("swap", 400)), suite.syncRewards())
+}
+
+func (suite *RewardBoostTests) TestStakeAddedBeforeSyncDoesNotBoostEarlierRewards() {
+	suite.setupStakedOwner(0, types.NewRewardBoost(d("2"), i(1000)), cs(c("swap", 1_000_000)))
+	suite.initializeClaim()
+
+	suite.setStake(1000)
+	suite.Equal(cs(c("swap", 400)), suite.syncRewards())
+
+	// rewards accrued while the stake was held are boosted
+	suite.Equal(cs(c("swap", 1200)), suite.syncRewards())
+
+	stake, found := suite.keeper.GetRewardBoostStake(suite.ctx, testRewardSource, boostedPool, suite.owner)
+	suite.True(found)
+	suite.Equal(i(1000), stake.Staked)
+}
+
+func (suite *RewardBoostTests) TestRemovedStakeDoesNotBoostRewards() {
+	suite.setupStakedOwner(1000, types.NewRewardBoost(d("2"), i(1000)), cs(c("swap", 1_000_000)))
+	suite.initializeClaim()
+
+	suite.setStake(0)
+	suite.Equal(cs(c("swap", 400)), suite.syncRewards())
+}
+
+func (suite *RewardBoostTests) TestUnlistedSourcesAreNotBoosted() {
+	suite.setupStakedOwner(1000, types.NewRewardBoost(d("2"), i(1000), types.SwapClaimType), cs(c("swap", 1_000_000)))
+	suite.initializeClaim()
+
+	suite.Equal(cs(c("swap", 400)), suite.syncRewards())
+
+	_, found := suite.keeper.GetRewardBoostStake(suite.ctx, testRewardSource, boostedPool, suite.owner)
+	suite.False(found)
+}
+
+func (suite *RewardBoostTests) TestDefaultBoostDoesNotChangeRewards() {
+	suite.setupStakedOwner(1000, types.DefaultRewardBoost, cs(c("swap", 1_000_000)))
+	suite.initializeClaim()
+
+	suite.Equal(cs(c("swap", 400)), suite.syncRewards())
+}
+
+func (suite *RewardBoostTests) TestPendingRewardsDoNotSpendBudget() {
+	suite.setupStakedOwner(1000, types.NewRewardBoost(d("2"), i(1000)), cs(c("swap", 1_000_000)))
+	suite.initializeClaim()
+	indexes, _ := suite.keeper.GetSourceRewardIndexes(suite.ctx, testRewardSource, boostedPool)
+	suite.keeper.SetSourceRewardIndexes(suite.ctx, testRewardSource, boostedPool, indexes.Add(types.RewardIndexes{
+		types.NewRewardIndex("swap", d("0.4")),
+	}))
+
+	suite.Equal(
+		[]types.PendingReward{types.NewPendingReward(testRewardSource, cs(c("swap", 800)))},
+		suite.keeper.GetPendingRewards(suite.ctx, suite.owner),
+	)
+	_, found := suite.keeper.GetRewardBudgetSpend(suite.ctx, testRewardSource, boostedPool)
+	suite.False(found)
+
+	// the boost is only spent from the budget once the synchronized claim is stored
+	suite.keeper.SynchronizeSourceReward(suite.ctx, testRewardSource, boostedPool, suite.owner)
+	spend, found := suite.keeper.GetRewardBudgetSpend(suite.ctx, testRewardSource, boostedPool)
+	suite.True(found)
+	suite.Equal(sdk.NewDecCoins(sdk.NewDecCoin("swap", i(400))), spend.Spent)
+}
+
+func (suite *RewardBoostTests) TestSyncWithoutRewardsDoesNotRecordStake() {
+	suite.setupStakedOwner(500, types.NewRewardBoost(d("2"), i(1000)), cs(c("swap", 1_000_000)))
+	suite.initializeClaim()
+
+	suite.setStake(1000)
+	suite.keeper.SynchronizeSourceReward(suite.ctx, testRewardSource, boostedPool, suite.owner)
+
+	stake, found := suite.keeper.GetRewardBoostStake(suite.ctx, testRewardSource, boostedPool, suite.owner)
+	suite.True(found)
+	suite.Equal(i(500), stake.Staked)
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fury/x/incentive/types"
+)
+
+// accumulateWithBudget accrues the rewards of a reward period on an accumulator. Rewards of a period with a budget are
+// limited to its remaining budget and recorded as spent, so accumulation stops once the budget is used up.
+func (k Keeper) accumulateWithBudget(
+	ctx sdk.Context,
+	acc *types.Accumulator,
+	source string,
+	rewardPeriod types.MultiRewardPeriod,
+	totalSourceShares sdk.Dec,
+) {
+	if !rewardPeriod.HasBudget() {
+		acc.Accumulate(rewardPeriod, totalSourceShares, ctx.BlockTime())
+		return
+	}
+
+	spend := k.getRewardBudgetSpend(ctx, source, rewardPeriod)
+	distributed := acc.AccumulateWithBudget(rewardPeriod, totalSourceShares, ctx.BlockTime(), spend.Spent)
+	k.spendRewardBudget(ctx, rewardPeriod, spend, distributed)
+}
+
+// capRewardsToBudget limits rewards about to be distributed for a reward period to its remaining budget and records
+// them as spent.
+func (k Keeper) capRewardsToBudget(ctx sdk.Context, source string, rewardPeriod types.MultiRewardPeriod, rewards sdk.DecCoins) sdk.DecCoins {
+	if !rewardPeriod.HasBudget() {
+		return rewards
+	}
+
+	spend := k.getRewardBudgetSpend(ctx, source, rewardPeriod)
+	capped := rewardPeriod.CapRewardsToBudget(rewards, spend.Spent)
+	k.spendRewardBudget(ctx, rewardPeriod, spend, capped)
+	return capped
+}
+
+// getRewardBudgetSpend returns the budget spend of a reward period, or an empty spend if nothing has been spent.
+// The stored spend of a previous period for the same collateral type is not counted, so a new period starts with its
+// full budget.
+func (k Keeper) getRewardBudgetSpend(ctx sdk.Context, source string, rewardPeriod types.MultiRewardPeriod) types.RewardBudgetSpend {
+	spend, found := k.GetRewardBudgetSpend(ctx, source, rewardPeriod.CollateralType)
+	if !found || !spend.IsSpentFrom(rewardPeriod) {
+		spend = types.NewRewardBudgetSpend(source, rewardPeriod.CollateralType, rewardPeriod.Start, sdk.DecCoins{})
+	}
+	return spend
+}
+
+// spendRewardBudget adds distributed rewards to the budget spend of a reward period, emitting an event when the
+// budget is used up.
+func (k Keeper) spendRewardBudget(ctx sdk.Context, rewardPeriod types.MultiRewardPeriod, spend types.RewardBudgetSpend, distributed sdk.DecCoins) {
+	if distributed.IsZero() {
+		return
+	}
+	spend.Spent = spend.Spent.Add(distributed...)
+	k.SetRewardBudgetSpend(ctx, spend)
+
+	if rewardPeriod.IsBudgetExhausted(spend.Spent) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBudgetExhausted,
+				sdk.NewAttribute(types.AttributeKeyRewardSource, spend.Source),
+				sdk.NewAttribute(types.AttributeKeyCollateralType, spend.CollateralType),
+				sdk.NewAttribute(types.AttributeKeyBudget, rewardPeriod.Budget.String()),
+			),
+		)
+	}
+}
+
+// GetRewardBudgets returns the budget state of all reward periods with a budget.
+func (k Keeper) GetRewardBudgets(ctx sdk.Context) []types.RewardBudget {
+	var budgets []types.RewardBudget
+	for _, srp := range k.GetParams(ctx).BudgetedRewardPeriods() {
+		for _, rp := range srp.RewardPeriods {
+			spend := k.getRewardBudgetSpend(ctx, srp.Source, rp)
+			runway := rp.Runway(spend.Spent, ctx.BlockTime())
+
+			// the period distributes rewards from its start until its runway is used up, but no later than its end
+			projectedEnd := ctx.BlockTime()
+			if rp.Start.After(projectedEnd) {
+				projectedEnd = rp.Start
+			}
+			projectedEnd = projectedEnd.Add(runway)
+			if projectedEnd.After(rp.End) {
+				projectedEnd = rp.End
+			}
+
+			budgets = append(budgets, types.RewardBudget{
+				Source:         srp.Source,
+				CollateralType: rp.CollateralType,
+				Budget:         rp.Budget,
+				Spent:          spend.Spent,
+				Remaining:      rp.RemainingBudget(spend.Spent),
+				Runway:         runway,
+				ProjectedEnd:   projectedEnd,
+				Exhausted:      rp.IsBudgetExhausted(spend.Spent),
+			})
+		}
+	}
+	return budgets
+}
@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/incubus-network/fury/x/incentive/types"
+)
+
+type RewardBudgetTests struct {
+	unitTester
+}
+
+func TestRewardBudgets(t *testing.T) {
+	suite.Run(t, new(RewardBudgetTests))
+}
+
+func (suite *RewardBudgetTests) TestAccumulateStopsWhenBudgetIsSpent() {
+	pool := "pool-1"
+	suite.keeper.RegisterRewardSource(testRewardSource, newFakeRewardSource().addShares(pool, arbitraryAddress(), d("1000000")))
+
+	previousAccrualTime := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.keeper.SetSourceRewardAccrualTime(suite.ctx, testRewardSource, pool, previousAccrualTime)
+
+	period := types.NewBudgetedMultiRewardPeriod(
+		true, pool, time.Unix(0, 0), distantFuture,
+		cs(c("swap", 2000), c("ufury", 1000)),
+		cs(c("swap", 5_000_000), c("ufury", 10_000_000)),
+	)
+
+	// an hour of rewards is 7,200,000swap and 3,600,000ufury, the swap rewards are capped by the budget
+	suite.ctx = suite.ctx.WithBlockTime(previousAccrualTime.Add(1 * time.Hour))
+	suite.keeper.AccumulateSourceRewards(suite.ctx, testRewardSource, period)
+
+	storedIndexes, found := suite.keeper.GetSourceRewardIndexes(suite.ctx, testRewardSource, pool)
+	suite.True(found)
+	suite.Equal(types.RewardIndexes{
+		types.NewRewardIndex("swap", d("5.0")),
+		types.NewRewardIndex("ufury", d("3.6")),
+	}, storedIndexes)
+
+	spend, found := suite.keeper.GetRewardBudgetSpend(suite.ctx, testRewardSource, pool)
+	suite.True(found)
+	suite.Equal(sdk.NewDecCoins(sdk.NewDecCoin("swap", i(5_000_000)), sdk.NewDecCoin("ufury", i(3_600_000))), spend.Spent)
+	suite.False(period.IsBudgetExhausted(spend.Spent))
+
+	// the next hour uses up the rest of the ufury budget
+	suite.ctx = suite.ctx.WithBlockTime(previousAccrualTime.Add(3 * time.Hour))
+	suite.keeper.AccumulateSourceRewards(suite.ctx, testRewardSource, period)
+
+	storedIndexes, _ = suite.keeper.GetSourceRewardIndexes(suite.ctx, testRewardSource, pool)
+	suite.Equal(types.RewardIndexes{
+		types.NewRewardIndex("swap", d("5.0")),
+		types.NewRewardIndex("ufury", d("10.0")),
+	}, storedIndexes)
+
+	spend, _ = suite.keeper.GetRewardBudgetSpend(suite.ctx, testRewardSource, pool)
+	suite.True(period.IsBudgetExhausted(spend.Spent))
+	suite.Len(suite.ctx.EventManager().Events(), 1)
+	suite.Equal(types.EventTypeBudgetExhausted, suite.ctx.EventManager().Events()[0].Type)
+
+	// accumulation time keeps moving but no more rewards are distributed
+	newAccrualTime := previousAccrualTime.Add(4 * time.Hour)
+	suite.ctx = suite.ctx.WithBlockTime(newAccrualTime)
+	suite.keeper.AccumulateSourceRewards(suite.ctx, testRewardSource, period)
+
+	storedTime, _ := suite.keeper.GetSourceRewardAccrualTime(suite.ctx, testRewardSource, pool)
+	suite.Equal(newAccrualTime, storedTime)
+	storedIndexes, _ = suite.keeper.GetSourceRewardIndexes(suite.ctx, testRewardSource, pool)
+	suite.Equal(types.RewardIndexes{
+		types.NewRewardIndex("swap", d("5.0")),
+		types.NewRewardIndex("ufury", d("10.0")),
+	}, storedIndexes)
+	suite.Len(suite.ctx.EventManager().Events(), 1, "exhausted event should only be emitted once")
+}
+
+func (suite *RewardBudgetTests) TestAccumulateDoesNotSpendBudgetWithoutShares() {
+	pool := "pool-1"
+	suite.keeper.RegisterRewardSource(testRewardSource, newFakeRewardSource())
+
+	previousAccrualTime := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.keeper.SetSourceRewardAccrualTime(suite.ctx, testRewardSource, pool, previousAccrualTime)
+	suite.ctx = suite.ctx.WithBlockTime(previousAccrualTime.Add(1 * time.Hour))
+
+	period := types.NewBudgetedMultiRewardPeriod(true, pool, time.Unix(0, 0), distantFuture, cs(c("swap", 2000)), cs(c("swap", 5_000_000)))
+	suite.keeper.AccumulateSourceRewards(suite.ctx, testRewardSource, period)
+
+	_, found := suite.keeper.GetRewardBudgetSpend(suite.ctx, testRewardSource, pool)
+	suite.False(found)
+}
+
+func (suite *RewardBudgetTests) TestNextPeriodStartsWithFullBudget() {
+	pool := "pool-1"
+	suite.keeper.RegisterRewardSource(testRewardSource, newFakeRewardSource().addShares(pool, arbitraryAddress(), d("1000000")))
+
+	firstStart := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
+	secondStart := firstStart.Add(2 * time.Hour)
+	suite.keeper.SetSourceRewardAccrualTime(suite.ctx, testRewardSource, pool, firstStart)
+
+	first := types.NewBudgetedMultiRewardPeriod(true, pool, firstStart, secondStart, cs(c("swap", 1000)), cs(c("swap", 3_600_000)))
+	second := types.NewBudgetedMultiRewardPeriod(true, pool, secondStart, distantFuture, cs(c("swap", 1000)), cs(c("swap", 5_000_000)))
+
+	// the first period spends all of its budget in its first hour
+	suite.ctx = suite.ctx.WithBlockTime(firstStart.Add(time.Hour))
+	suite.keeper.AccumulateSourceRewards(suite.ctx, testRewardSource, first)
+	spend, _ := suite.keeper.GetRewardBudgetSpend(suite.ctx, testRewardSource, pool)
+	suite.True(first.IsBudgetExhausted(spend.Spent))
+
+	// the second period replaces it and distributes from its own budget
+	suite.ctx = suite.ctx.WithBlockTime(secondStart.Add(time.Hour))
+	suite.keeper.AccumulateSourceRewards(suite.ctx, testRewardSource, second)
+
+	storedIndexes, _ := suite.keeper.GetSourceRewardIndexes(suite.ctx, testRewardSource, pool)
+	suite.Equal(types.RewardIndexes{types.NewRewardIndex("swap", d("7.2"))}, storedIndexes)
+
+	spend, _ = suite.keeper.GetRewardBudgetSpend(suite.ctx, testRewardSource, pool)
+	suite.Equal(types.NewRewardBudgetSpend(testRewardSource, pool, secondStart, sdk.NewDecCoins(sdk.NewDecCoin("swap", i(3_600_000)))), spend)
+	suite.False(second.IsBudgetExhausted(spend.Spent))
+}
+
+func (suite *RewardBudgetTests) TestGetRewardBudgets() {
+	now := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.ctx = suite.ctx.WithBlockTime(now)
+
+	budgeted := types.NewBudgetedMultiRewardPeriod(true, "bnb", now.Add(-time.Hour), distantFuture, cs(c("hard", 2000)), cs(c("hard", 5_000_000)))
+	ending := types.NewBudgetedMultiRewardPeriod(true, "pool-1", now.Add(-time.Hour), now.Add(time.Hour), cs(c("swap", 1)), cs(c("swap", 1_000_000)))
+
+	params := types.DefaultParams()
+	params.HardSupplyRewardPeriods = types.MultiRewardPeriods{
+		budgeted,
+		types.NewMultiRewardPeriod(true, "btcb", now.Add(-time.Hour), distantFuture, cs(c("hard", 2000))),
+	}
+	params.SourceRewardPeriods = types.SourceMultiRewardPeriods{
+		types.NewSourceMultiRewardPeriod(testRewardSource, types.MultiRewardPeriods{ending}),
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	spent := sdk.NewDecCoins(sdk.NewDecCoin("hard", i(1_000_000)))
+	suite.keeper.SetRewardBudgetSpend(suite.ctx, types.NewRewardBudgetSpend(types.HardSupplyClaimType, "bnb", budgeted.Start, spent))
+
+	budgets := suite.keeper.GetRewardBudgets(suite.ctx)
+	suite.Equal([]types.RewardBudget{
+		{
+			Source:         types.HardSupplyClaimType,
+			CollateralType: "bnb",
+			Budget:         cs(c("hard", 5_000_000)),
+			Spent:          spent,
+			Remaining:      sdk.NewDecCoins(sdk.NewDecCoin("hard", i(4_000_000))),
+			Runway:         2000 * time.Second,
+			ProjectedEnd:   now.Add(2000 * time.Second),
+			Exhausted:      false,
+		},
+		{
+			Source:         testRewardSource,
+			CollateralType: "pool-1",
+			Budget:         cs(c("swap", 1_000_000)),
+			Spent:          sdk.DecCoins{},
+			Remaining:      sdk.NewDecCoins(sdk.NewDecCoin("swap", i(1_000_000))),
+			Runway:         time.Hour,
+			ProjectedEnd:   now.Add(time.Hour),
+			Exhausted:      false,
+		},
+	}, budgets)
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"errors"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -9,15 +11,16 @@ import (
 
 // ClaimUSDXMintingReward pays out funds from a claim to a receiver account.
 // Rewards are removed from a claim and paid out according to the multiplier, which reduces the reward amount in exchange for shorter vesting times.
-func (k Keeper) ClaimUSDXMintingReward(ctx sdk.Context, owner, receiver sdk.AccAddress, multiplierName string) error {
+// The paid rewards are then sent to the claim destination of the selection, see sendRewardsToDestination.
+func (k Keeper) ClaimUSDXMintingReward(ctx sdk.Context, owner, receiver sdk.AccAddress, selection types.Selection) error {
 	claim, found := k.GetUSDXMintingClaim(ctx, owner)
 	if !found {
 		return errorsmod.Wrapf(types.ErrClaimNotFound, "address: %s", owner)
 	}
 
-	multiplier, found := k.GetMultiplierByDenom(ctx, types.USDXMintingRewardDenom, multiplierName)
+	multiplier, found := k.GetMultiplierByDenom(ctx, types.USDXMintingRewardDenom, selection.MultiplierName)
 	if !found {
-		return errorsmod.Wrapf(types.ErrInvalidMultiplier, "denom '%s' has no multiplier '%s'", types.USDXMintingRewardDenom, multiplierName)
+		return errorsmod.Wrapf(types.ErrInvalidMultiplier, "denom '%s' has no multiplier '%s'", types.USDXMintingRewardDenom, selection.MultiplierName)
 	}
 
 	claimEnd := k.GetClaimEnd(ctx)
@@ -36,10 +39,7 @@ func (k Keeper) ClaimUSDXMintingReward(ctx sdk.Context, owner, receiver sdk.AccA
 		return types.ErrZeroClaim
 	}
 	rewardCoin := sdk.NewCoin(claim.Reward.Denom, rewardAmount)
-	length := k.GetPeriodLength(ctx.BlockTime(), multiplier.MonthsLockup)
-
-	err = k.SendTimeLockedCoinsToAccount(ctx, types.IncentiveMacc, receiver, sdk.NewCoins(rewardCoin), length)
-	if err != nil {
+	if err := k.payoutReward(ctx, receiver, sdk.NewCoins(rewardCoin), multiplier, selection); err != nil {
 		return err
 	}
 
@@ -58,10 +58,11 @@ func (k Keeper) ClaimUSDXMintingReward(ctx sdk.Context, owner, receiver sdk.AccA
 
 // ClaimHardReward pays out funds from a claim to a receiver account.
 // Rewards are removed from a claim and paid out according to the multiplier, which reduces the reward amount in exchange for shorter vesting times.
-func (k Keeper) ClaimHardReward(ctx sdk.Context, owner, receiver sdk.AccAddress, denom string, multiplierName string) error {
-	multiplier, found := k.GetMultiplierByDenom(ctx, denom, multiplierName)
+// The paid rewards are then sent to the claim destination of the selection, see sendRewardsToDestination.
+func (k Keeper) ClaimHardReward(ctx sdk.Context, owner, receiver sdk.AccAddress, selection types.Selection) error {
+	multiplier, found := k.GetMultiplierByDenom(ctx, selection.Denom, selection.MultiplierName)
 	if !found {
-		return errorsmod.Wrapf(types.ErrInvalidMultiplier, "denom '%s' has no multiplier '%s'", denom, multiplierName)
+		return errorsmod.Wrapf(types.ErrInvalidMultiplier, "denom '%s' has no multiplier '%s'", selection.Denom, selection.MultiplierName)
 	}
 
 	claimEnd := k.GetClaimEnd(ctx)
@@ -77,17 +78,14 @@ func (k Keeper) ClaimHardReward(ctx sdk.Context, owner, receiver sdk.AccAddress,
 		return errorsmod.Wrapf(types.ErrClaimNotFound, "address: %s", owner)
 	}
 
-	amt := syncedClaim.Reward.AmountOf(denom)
+	amt := syncedClaim.Reward.AmountOf(selection.Denom)
 
-	claimingCoins := sdk.NewCoins(sdk.NewCoin(denom, amt))
-	rewardCoins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewDecFromInt(amt).Mul(multiplier.Factor).RoundInt()))
+	claimingCoins := sdk.NewCoins(sdk.NewCoin(selection.Denom, amt))
+	rewardCoins := sdk.NewCoins(sdk.NewCoin(selection.Denom, sdk.NewDecFromInt(amt).Mul(multiplier.Factor).RoundInt()))
 	if rewardCoins.IsZero() {
 		return types.ErrZeroClaim
 	}
-	length := k.GetPeriodLength(ctx.BlockTime(), multiplier.MonthsLockup)
-
-	err := k.SendTimeLockedCoinsToAccount(ctx, types.IncentiveMacc, receiver, rewardCoins, length)
-	if err != nil {
+	if err := k.payoutReward(ctx, receiver, rewardCoins, multiplier, selection); err != nil {
 		return err
 	}
 
@@ -108,15 +106,25 @@ func (k Keeper) ClaimHardReward(ctx sdk.Context, owner, receiver sdk.AccAddress,
 
 // ClaimDelegatorReward pays out funds from a claim to a receiver account.
 // Rewards are removed from a claim and paid out according to the multiplier, which reduces the reward amount in exchange for shorter vesting times.
-func (k Keeper) ClaimDelegatorReward(ctx sdk.Context, owner, receiver sdk.AccAddress, denom string, multiplierName string) error {
-	claim, found := k.GetDelegatorClaim(ctx, owner)
-	if !found {
+func (k Keeper) ClaimDelegatorReward(ctx sdk.Context, owner, receiver sdk.AccAddress, selection types.Selection) error {
+	if _, found := k.GetDelegatorClaim(ctx, owner); !found {
 		return errorsmod.Wrapf(types.ErrClaimNotFound, "address: %s", owner)
 	}
 
-	multiplier, found := k.GetMultiplierByDenom(ctx, denom, multiplierName)
+	return k.ClaimSourceReward(ctx, owner, receiver, types.DelegatorClaimType, selection)
+}
+
+// ClaimSwapReward pays out funds from a claim to a receiver account.
+// Rewards are removed from a claim and paid out according to the multiplier, which reduces the reward amount in exchange for shorter vesting times.
+func (k Keeper) ClaimSwapReward(ctx sdk.Context, owner, receiver sdk.AccAddress, selection types.Selection) error {
+	return k.ClaimSourceReward(ctx, owner, receiver, types.SwapClaimType, selection)
+}
+
+// ClaimSavingsReward is a stub method for MsgServer interface compliance
+func (k Keeper) ClaimSavingsReward(ctx sdk.Context, owner, receiver sdk.AccAddress, selection types.Selection) error {
+	multiplier, found := k.GetMultiplierByDenom(ctx, selection.Denom, selection.MultiplierName)
 	if !found {
-		return errorsmod.Wrapf(types.ErrInvalidMultiplier, "denom '%s' has no multiplier '%s'", denom, multiplierName)
+		return errorsmod.Wrapf(types.ErrInvalidMultiplier, "denom '%s' has no multiplier '%s'", selection.Denom, selection.MultiplierName)
 	}
 
 	claimEnd := k.GetClaimEnd(ctx)
@@ -125,29 +133,27 @@ func (k Keeper) ClaimDelegatorReward(ctx sdk.Context, owner, receiver sdk.AccAdd
 		return errorsmod.Wrapf(types.ErrClaimExpired, "block time %s > claim end time %s", ctx.BlockTime(), claimEnd)
 	}
 
-	syncedClaim, err := k.SynchronizeDelegatorClaim(ctx, claim)
-	if err != nil {
+	k.SynchronizeSavingsClaim(ctx, owner)
+
+	syncedClaim, found := k.GetSavingsClaim(ctx, owner)
+	if !found {
 		return errorsmod.Wrapf(types.ErrClaimNotFound, "address: %s", owner)
 	}
 
-	amt := syncedClaim.Reward.AmountOf(denom)
+	amt := syncedClaim.Reward.AmountOf(selection.Denom)
 
-	claimingCoins := sdk.NewCoins(sdk.NewCoin(denom, amt))
-	rewardCoins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewDecFromInt(amt).Mul(multiplier.Factor).RoundInt()))
+	claimingCoins := sdk.NewCoins(sdk.NewCoin(selection.Denom, amt))
+	rewardCoins := sdk.NewCoins(sdk.NewCoin(selection.Denom, sdk.NewDecFromInt(amt).Mul(multiplier.Factor).RoundInt()))
 	if rewardCoins.IsZero() {
 		return types.ErrZeroClaim
 	}
-
-	length := k.GetPeriodLength(ctx.BlockTime(), multiplier.MonthsLockup)
-
-	err = k.SendTimeLockedCoinsToAccount(ctx, types.IncentiveMacc, receiver, rewardCoins, length)
-	if err != nil {
+	if err := k.payoutReward(ctx, receiver, rewardCoins, multiplier, selection); err != nil {
 		return err
 	}
 
 	// remove claimed coins (NOT reward coins)
 	syncedClaim.Reward = syncedClaim.Reward.Sub(claimingCoins...)
-	k.SetDelegatorClaim(ctx, syncedClaim)
+	k.SetSavingsClaim(ctx, syncedClaim)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -160,12 +166,19 @@ func (k Keeper) ClaimDelegatorReward(ctx sdk.Context, owner, receiver sdk.AccAdd
 	return nil
 }
 
-// ClaimSwapReward pays out funds from a claim to a receiver account.
+// ClaimEarnReward pays out funds from a claim to a receiver account.
+// Rewards are removed from a claim and paid out according to the multiplier, which reduces the reward amount in exchange for shorter vesting times.
+func (k Keeper) ClaimEarnReward(ctx sdk.Context, owner, receiver sdk.AccAddress, selection types.Selection) error {
+	return k.ClaimSourceReward(ctx, owner, receiver, types.EarnClaimType, selection)
+}
+
+// ClaimSourceReward pays out funds from a reward source claim to a receiver account.
 // Rewards are removed from a claim and paid out according to the multiplier, which reduces the reward amount in exchange for shorter vesting times.
-func (k Keeper) ClaimSwapReward(ctx sdk.Context, owner, receiver sdk.AccAddress, denom string, multiplierName string) error {
-	multiplier, found := k.GetMultiplierByDenom(ctx, denom, multiplierName)
+// The paid rewards are then sent to the claim destination of the selection, see sendRewardsToDestination.
+func (k Keeper) ClaimSourceReward(ctx sdk.Context, owner, receiver sdk.AccAddress, source string, selection types.Selection) error {
+	multiplier, found := k.GetMultiplierByDenom(ctx, selection.Denom, selection.MultiplierName)
 	if !found {
-		return errorsmod.Wrapf(types.ErrInvalidMultiplier, "denom '%s' has no multiplier '%s'", denom, multiplierName)
+		return errorsmod.Wrapf(types.ErrInvalidMultiplier, "denom '%s' has no multiplier '%s'", selection.Denom, selection.MultiplierName)
 	}
 
 	claimEnd := k.GetClaimEnd(ctx)
@@ -174,28 +187,27 @@ func (k Keeper) ClaimSwapReward(ctx sdk.Context, owner, receiver sdk.AccAddress,
 		return errorsmod.Wrapf(types.ErrClaimExpired, "block time %s > claim end time %s", ctx.BlockTime(), claimEnd)
 	}
 
-	syncedClaim, found := k.GetSynchronizedSwapClaim(ctx, owner)
+	syncedClaim, found := k.GetSynchronizedSourceClaim(ctx, source, owner)
 	if !found {
 		return errorsmod.Wrapf(types.ErrClaimNotFound, "address: %s", owner)
 	}
+	// Synchronizing spends reward budgets on boosts, so the synchronized claim is stored even if nothing is claimed.
+	k.SetSourceClaim(ctx, syncedClaim)
 
-	amt := syncedClaim.Reward.AmountOf(denom)
+	amt := syncedClaim.Reward.AmountOf(selection.Denom)
 
-	claimingCoins := sdk.NewCoins(sdk.NewCoin(denom, amt))
-	rewardCoins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewDecFromInt(amt).Mul(multiplier.Factor).RoundInt()))
+	claimingCoins := sdk.NewCoins(sdk.NewCoin(selection.Denom, amt))
+	rewardCoins := sdk.NewCoins(sdk.NewCoin(selection.Denom, sdk.NewDecFromInt(amt).Mul(multiplier.Factor).RoundInt()))
 	if rewardCoins.IsZero() {
 		return types.ErrZeroClaim
 	}
-	length := k.GetPeriodLength(ctx.BlockTime(), multiplier.MonthsLockup)
-
-	err := k.SendTimeLockedCoinsToAccount(ctx, types.IncentiveMacc, receiver, rewardCoins, length)
-	if err != nil {
+	if err := k.payoutReward(ctx, receiver, rewardCoins, multiplier, selection); err != nil {
 		return err
 	}
 
 	// remove claimed coins (NOT reward coins)
 	syncedClaim.Reward = syncedClaim.Reward.Sub(claimingCoins...)
-	k.SetSwapClaim(ctx, syncedClaim)
+	k.SetSourceClaim(ctx, syncedClaim)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -208,98 +220,185 @@ func (k Keeper) ClaimSwapReward(ctx sdk.Context, owner, receiver sdk.AccAddress,
 	return nil
 }
 
-// ClaimSavingsReward is a stub method for MsgServer interface compliance
-func (k Keeper) ClaimSavingsReward(ctx sdk.Context, owner, receiver sdk.AccAddress, denom string, multiplierName string) error {
-	multiplier, found := k.GetMultiplierByDenom(ctx, denom, multiplierName)
-	if !found {
-		return errorsmod.Wrapf(types.ErrInvalidMultiplier, "denom '%s' has no multiplier '%s'", denom, multiplierName)
+// ClaimAllRewards pays out the rewards of every claim type to a receiver account, for each selected denom.
+// Rewards of each claim type are synchronized before claiming, and each denom is paid out according to its selected
+// multiplier. Denoms without rewards in a claim type are skipped, but at least one reward must be claimed.
+// Savings rewards are not paid out as savings claims are disabled.
+func (k Keeper) ClaimAllRewards(ctx sdk.Context, owner, receiver sdk.AccAddress, selections types.Selections) error {
+	claimed := false
+	for _, pending := range k.GetPendingRewards(ctx, owner) {
+		for _, selection := range selections {
+			if !pending.Reward.AmountOf(selection.Denom).IsPositive() {
+				continue
+			}
+
+			var err error
+			switch pending.ClaimType {
+			case types.USDXMintingClaimType:
+				err = k.ClaimUSDXMintingReward(ctx, owner, receiver, selection)
+			case types.HardLiquidityProviderClaimType:
+				err = k.ClaimHardReward(ctx, owner, receiver, selection)
+			case types.DelegatorClaimType:
+				err = k.ClaimDelegatorReward(ctx, owner, receiver, selection)
+			case types.SwapClaimType:
+				err = k.ClaimSwapReward(ctx, owner, receiver, selection)
+			case types.EarnClaimType:
+				err = k.ClaimEarnReward(ctx, owner, receiver, selection)
+			case types.SavingsClaimType:
+				continue
+			default:
+				err = k.ClaimSourceReward(ctx, owner, receiver, pending.ClaimType, selection)
+			}
+			// Rewards too small to pay out after the multiplier is applied are left in the claim.
+			if errors.Is(err, types.ErrZeroClaim) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			claimed = true
+		}
+	}
+
+	if !claimed {
+		return types.ErrZeroClaim
 	}
+	return nil
+}
 
-	claimEnd := k.GetClaimEnd(ctx)
+// GetPendingRewards returns an owner's unclaimed rewards of each claim type that has rewards, as if the claims were
+// synchronized at the current block. No state is modified.
+func (k Keeper) GetPendingRewards(ctx sdk.Context, owner sdk.AccAddress) []types.PendingReward {
+	// Synchronizing reward source claims records boost stakes and spends reward budgets on boosts. The claims are not
+	// stored here, so those writes are discarded to only apply them when a synchronized claim is stored.
+	ctx, _ = ctx.CacheContext()
+
+	var rewards []types.PendingReward
+	addReward := func(claimType string, reward sdk.Coins) {
+		if !reward.IsZero() {
+			rewards = append(rewards, types.NewPendingReward(claimType, reward))
+		}
+	}
+
+	if claim, found := k.GetUSDXMintingClaim(ctx, owner); found {
+		claim = k.SimulateUSDXMintingSynchronization(ctx, claim)
+		addReward(claim.GetType(), sdk.NewCoins(claim.Reward))
+	}
+	if claim, found := k.GetHardLiquidityProviderClaim(ctx, owner); found {
+		claim = k.SimulateHardSynchronization(ctx, claim)
+		addReward(claim.GetType(), claim.Reward)
+	}
+	if claim, found := k.GetDelegatorClaim(ctx, owner); found {
+		claim = k.SimulateDelegatorSynchronization(ctx, claim)
+		addReward(claim.GetType(), claim.Reward)
+	}
+	if claim, found := k.GetSwapClaim(ctx, owner); found {
+		if syncedClaim, found := k.GetSynchronizedSwapClaim(ctx, owner); found {
+			claim = syncedClaim
+		}
+		addReward(claim.GetType(), claim.Reward)
+	}
+	if claim, found := k.GetSavingsClaim(ctx, owner); found {
+		// claims without a deposit cannot be synchronized, but may still hold rewards
+		if syncedClaim, found := k.GetSynchronizedSavingsClaim(ctx, owner); found {
+			claim = syncedClaim
+		}
+		addReward(claim.GetType(), claim.Reward)
+	}
+	if claim, found := k.GetEarnClaim(ctx, owner); found {
+		if syncedClaim, found := k.GetSynchronizedEarnClaim(ctx, owner); found {
+			claim = syncedClaim
+		}
+		addReward(claim.GetType(), claim.Reward)
+	}
+	for _, source := range k.GetRewardSourceNames() {
+		if types.IsNativeRewardSource(source) {
+			continue
+		}
+		if claim, found := k.GetSynchronizedSourceClaim(ctx, source, owner); found {
+			addReward(claim.GetType(), claim.Reward)
+		}
+	}
+
+	return rewards
+}
 
-	if ctx.BlockTime().After(claimEnd) {
-		return errorsmod.Wrapf(types.ErrClaimExpired, "block time %s > claim end time %s", ctx.BlockTime(), claimEnd)
+// ClaimHardSupplyRewardForDenom pays out the hard supply rewards an owner has accrued on a single deposit denom
+// since the denom was last synchronized, to a module account. It is used by modules that hold hard deposits for
+// several parties and need to attribute rewards to them, such as x/earn vaults.
+// Unlike ClaimHardReward no multiplier or vesting is applied, as module accounts cannot vest. Rewards already
+// synchronized into the claim are left in the claim.
+func (k Keeper) ClaimHardSupplyRewardForDenom(ctx sdk.Context, owner sdk.AccAddress, receiverModule string, denom string) (sdk.Coins, error) {
+	if ctx.BlockTime().After(k.GetClaimEnd(ctx)) {
+		return sdk.NewCoins(), nil
 	}
 
-	k.SynchronizeSavingsClaim(ctx, owner)
-
-	syncedClaim, found := k.GetSavingsClaim(ctx, owner)
+	claim, found := k.GetHardLiquidityProviderClaim(ctx, owner)
 	if !found {
-		return errorsmod.Wrapf(types.ErrClaimNotFound, "address: %s", owner)
+		return sdk.NewCoins(), nil
 	}
-
-	amt := syncedClaim.Reward.AmountOf(denom)
-
-	claimingCoins := sdk.NewCoins(sdk.NewCoin(denom, amt))
-	rewardCoins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewDecFromInt(amt).Mul(multiplier.Factor).RoundInt()))
-	if rewardCoins.IsZero() {
-		return types.ErrZeroClaim
+	deposit, found := k.hardKeeper.GetDeposit(ctx, owner)
+	if !found {
+		return sdk.NewCoins(), nil
 	}
-	length := k.GetPeriodLength(ctx.BlockTime(), multiplier.MonthsLockup)
 
-	err := k.SendTimeLockedCoinsToAccount(ctx, types.IncentiveMacc, receiver, rewardCoins, length)
+	normalizedDeposit, err := deposit.NormalizedDeposit()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// remove claimed coins (NOT reward coins)
-	syncedClaim.Reward = syncedClaim.Reward.Sub(claimingCoins...)
-	k.SetSavingsClaim(ctx, syncedClaim)
+	syncedClaim := k.synchronizeSingleHardSupplyReward(ctx, claim, denom, normalizedDeposit.AmountOf(denom))
+	rewards := syncedClaim.Reward.Sub(claim.Reward...)
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeClaim,
-			sdk.NewAttribute(types.AttributeKeyClaimedBy, owner.String()),
-			sdk.NewAttribute(types.AttributeKeyClaimAmount, claimingCoins.String()),
-			sdk.NewAttribute(types.AttributeKeyClaimType, syncedClaim.GetType()),
-		),
-	)
-	return nil
+	// keep the reward indexes of the sync, but not the rewards as they are paid out
+	syncedClaim.Reward = claim.Reward
+	k.SetHardLiquidityProviderClaim(ctx, syncedClaim)
+
+	return rewards, k.sendRewardsToModule(ctx, owner, receiverModule, rewards, syncedClaim.GetType())
 }
 
-// ClaimEarnReward pays out funds from a claim to a receiver account.
-// Rewards are removed from a claim and paid out according to the multiplier, which reduces the reward amount in exchange for shorter vesting times.
-func (k Keeper) ClaimEarnReward(ctx sdk.Context, owner, receiver sdk.AccAddress, denom string, multiplierName string) error {
-	multiplier, found := k.GetMultiplierByDenom(ctx, denom, multiplierName)
-	if !found {
-		return errorsmod.Wrapf(types.ErrInvalidMultiplier, "denom '%s' has no multiplier '%s'", denom, multiplierName)
+// ClaimSavingsRewardForDenom pays out the savings rewards an owner has accrued on a single deposit denom since the
+// denom was last synchronized, to a module account. See ClaimHardSupplyRewardForDenom.
+func (k Keeper) ClaimSavingsRewardForDenom(ctx sdk.Context, owner sdk.AccAddress, receiverModule string, denom string) (sdk.Coins, error) {
+	if ctx.BlockTime().After(k.GetClaimEnd(ctx)) {
+		return sdk.NewCoins(), nil
 	}
 
-	claimEnd := k.GetClaimEnd(ctx)
-
-	if ctx.BlockTime().After(claimEnd) {
-		return errorsmod.Wrapf(types.ErrClaimExpired, "block time %s > claim end time %s", ctx.BlockTime(), claimEnd)
+	claim, found := k.GetSavingsClaim(ctx, owner)
+	if !found {
+		return sdk.NewCoins(), nil
 	}
-
-	syncedClaim, found := k.GetSynchronizedEarnClaim(ctx, owner)
+	deposit, found := k.savingsKeeper.GetDeposit(ctx, owner)
 	if !found {
-		return errorsmod.Wrapf(types.ErrClaimNotFound, "address: %s", owner)
+		return sdk.NewCoins(), nil
 	}
 
-	amt := syncedClaim.Reward.AmountOf(denom)
+	syncedClaim := k.synchronizeSingleSavingsReward(ctx, claim, denom, deposit.SourceShares(denom))
+	rewards := syncedClaim.Reward.Sub(claim.Reward...)
 
-	claimingCoins := sdk.NewCoins(sdk.NewCoin(denom, amt))
-	rewardCoins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewDecFromInt(amt).Mul(multiplier.Factor).RoundInt()))
-	if rewardCoins.IsZero() {
-		return types.ErrZeroClaim
+	// keep the reward indexes of the sync, but not the rewards as they are paid out
+	syncedClaim.Reward = claim.Reward
+	k.SetSavingsClaim(ctx, syncedClaim)
+
+	return rewards, k.sendRewardsToModule(ctx, owner, receiverModule, rewards, syncedClaim.GetType())
+}
+
+// sendRewardsToModule sends claimed rewards to a module account and emits a claim event.
+func (k Keeper) sendRewardsToModule(ctx sdk.Context, owner sdk.AccAddress, receiverModule string, rewards sdk.Coins, claimType string) error {
+	if rewards.IsZero() {
+		return nil
 	}
-	length := k.GetPeriodLength(ctx.BlockTime(), multiplier.MonthsLockup)
 
-	err := k.SendTimeLockedCoinsToAccount(ctx, types.IncentiveMacc, receiver, rewardCoins, length)
-	if err != nil {
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.IncentiveMacc, receiverModule, rewards); err != nil {
 		return err
 	}
 
-	// remove claimed coins (NOT reward coins)
-	syncedClaim.Reward = syncedClaim.Reward.Sub(claimingCoins...)
-	k.SetEarnClaim(ctx, syncedClaim)
-
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeClaim,
 			sdk.NewAttribute(types.AttributeKeyClaimedBy, owner.String()),
-			sdk.NewAttribute(types.AttributeKeyClaimAmount, claimingCoins.String()),
-			sdk.NewAttribute(types.AttributeKeyClaimType, syncedClaim.GetType()),
+			sdk.NewAttribute(types.AttributeKeyClaimAmount, rewards.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimType, claimType),
 		),
 	)
 	return nil
@@ -46,11 +46,11 @@ func (suite *ClaimTests) TestCannotClaimWhenMultiplierNotRecognised() {
 	suite.storeDelegatorClaim(claim)
 
 	// multiplier not in params
-	err := suite.keeper.ClaimDelegatorReward(suite.ctx, claim.Owner, claim.Owner, "hard", "large")
+	err := suite.keeper.ClaimDelegatorReward(suite.ctx, claim.Owner, claim.Owner, types.NewSelection("hard", "large"))
 	suite.ErrorIs(err, types.ErrInvalidMultiplier)
 
 	// invalid multiplier name
-	err = suite.keeper.ClaimDelegatorReward(suite.ctx, claim.Owner, claim.Owner, "hard", "")
+	err = suite.keeper.ClaimDelegatorReward(suite.ctx, claim.Owner, claim.Owner, types.NewSelection("hard", ""))
 	suite.ErrorIs(err, types.ErrInvalidMultiplier)
 }
 
@@ -81,6 +81,35 @@ func (suite *ClaimTests) TestCannotClaimAfterEndTime() {
 	}
 	suite.storeDelegatorClaim(claim)
 
-	err := suite.keeper.ClaimDelegatorReward(suite.ctx, claim.Owner, claim.Owner, "hard", "small")
+	err := suite.keeper.ClaimDelegatorReward(suite.ctx, claim.Owner, claim.Owner, types.NewSelection("hard", "small"))
 	suite.ErrorIs(err, types.ErrClaimExpired)
 }
+
+func (suite *ClaimTests) TestClaimForDenomPaysNothingAfterEndTime() {
+	endTime := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	subspace := &fakeParamSubspace{
+		params: types.Params{
+			ClaimEnd: endTime,
+		},
+	}
+	suite.keeper = suite.NewKeeper(subspace, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	suite.ctx = suite.ctx.WithBlockTime(endTime.Add(time.Nanosecond))
+
+	claim := types.HardLiquidityProviderClaim{
+		BaseMultiClaim: types.BaseMultiClaim{
+			Owner:  arbitraryAddress(),
+			Reward: arbitraryCoins(),
+		},
+	}
+	suite.storeHardClaim(claim)
+
+	rewards, err := suite.keeper.ClaimHardSupplyRewardForDenom(suite.ctx, claim.Owner, "earn", "usdx")
+	suite.NoError(err)
+	suite.True(rewards.IsZero())
+
+	storedClaim, found := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, claim.Owner)
+	suite.True(found)
+	suite.Equal(claim, storedClaim)
+}
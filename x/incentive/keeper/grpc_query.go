@@ -195,6 +195,60 @@ func (s queryServer) Apy(
 	}, nil
 }
 
+func (s queryServer) PendingRewards(
+	ctx context.Context,
+	req *types.QueryPendingRewardsRequest,
+) (*types.QueryPendingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %s", err)
+	}
+
+	rewards := s.keeper.GetPendingRewards(sdkCtx, owner)
+
+	total := sdk.NewCoins()
+	for _, reward := range rewards {
+		total = total.Add(reward.Reward...)
+	}
+
+	return &types.QueryPendingRewardsResponse{
+		Rewards: rewards,
+		Total:   total,
+	}, nil
+}
+
+func (s queryServer) RewardBudgets(
+	ctx context.Context,
+	req *types.QueryRewardBudgetsRequest,
+) (*types.QueryRewardBudgetsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var budgets []types.RewardBudget
+	for _, budget := range s.keeper.GetRewardBudgets(sdkCtx) {
+		if req.Source != "" && budget.Source != req.Source {
+			continue
+		}
+		if req.CollateralType != "" && budget.CollateralType != req.CollateralType {
+			continue
+		}
+		budgets = append(budgets, budget)
+	}
+
+	return &types.QueryRewardBudgetsResponse{
+		Budgets: budgets,
+	}, nil
+}
+
 // queryRewards queries the rewards for a given owner and reward type, updating
 // the response with the results in place.
 func (s queryServer) queryRewards(
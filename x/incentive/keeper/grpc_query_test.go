@@ -55,10 +55,12 @@ func (suite *grpcQueryTestSuite) SetupTest() {
 	hardGS := hardtypes.NewGenesisState(
 		hardtypes.NewParams(
 			hardtypes.MoneyMarkets{
-				hardtypes.NewMoneyMarket("ufury", hardtypes.NewBorrowLimit(false, borrowLimit, loanToValue), "fury:usd", sdkmath.NewInt(1000000), hardtypes.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
-				hardtypes.NewMoneyMarket("bnb", hardtypes.NewBorrowLimit(false, borrowLimit, loanToValue), "bnb:usd", sdkmath.NewInt(1000000), hardtypes.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+				hardtypes.NewMoneyMarket("ufury", hardtypes.NewBorrowLimit(false, borrowLimit, loanToValue), "fury:usd", sdkmath.NewInt(1000000), hardtypes.NewJumpRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+				hardtypes.NewMoneyMarket("bnb", hardtypes.NewBorrowLimit(false, borrowLimit, loanToValue), "bnb:usd", sdkmath.NewInt(1000000), hardtypes.NewJumpRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
 			},
 			sdk.NewDec(10),
+			hardtypes.DefaultCheckLtvIndexCount,
+			hardtypes.DefaultEModeCategories,
 		),
 		hardtypes.DefaultAccumulationTimes,
 		hardtypes.DefaultDeposits,
@@ -310,6 +312,32 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryRewards_RewardType_and_Owner() {
 	suite.Empty(res.EarnClaims)
 }
 
+func (suite *grpcQueryTestSuite) TestGrpcQueryPendingRewards() {
+	res, err := suite.queryClient.PendingRewards(sdk.WrapSDKContext(suite.ctx), &types.QueryPendingRewardsRequest{
+		Owner: suite.addrs[0].String(),
+	})
+	suite.Require().NoError(err)
+
+	suite.Equal([]types.PendingReward{
+		types.NewPendingReward(types.USDXMintingClaimType, cs(c("ufury", 1e9))),
+		types.NewPendingReward(types.HardLiquidityProviderClaimType, cs(c("ufury", 1e9), c("hard", 1e9))),
+	}, res.Rewards)
+	suite.Equal(cs(c("ufury", 2e9), c("hard", 1e9)), res.Total)
+
+	// Claims without rewards are not included
+	res, err = suite.queryClient.PendingRewards(sdk.WrapSDKContext(suite.ctx), &types.QueryPendingRewardsRequest{
+		Owner: suite.addrs[3].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Empty(res.Rewards)
+	suite.True(res.Total.IsZero())
+
+	_, err = suite.queryClient.PendingRewards(sdk.WrapSDKContext(suite.ctx), &types.QueryPendingRewardsRequest{
+		Owner: "invalid",
+	})
+	suite.Error(err)
+}
+
 func (suite *grpcQueryTestSuite) TestGrpcQueryRewardFactors() {
 	res, err := suite.queryClient.RewardFactors(sdk.WrapSDKContext(suite.ctx), &types.QueryRewardFactorsRequest{})
 	suite.Require().NoError(err)
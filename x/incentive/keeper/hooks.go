@@ -10,6 +10,8 @@ import (
 	hardtypes "github.com/incubus-network/fury/x/hard/types"
 	savingstypes "github.com/incubus-network/fury/x/savings/types"
 	swaptypes "github.com/incubus-network/fury/x/swap/types"
+
+	"github.com/incubus-network/fury/x/incentive/types"
 )
 
 // Hooks wrapper struct for hooks
@@ -24,6 +26,7 @@ var (
 	_ swaptypes.SwapHooks       = Hooks{}
 	_ savingstypes.SavingsHooks = Hooks{}
 	_ earntypes.EarnHooks       = Hooks{}
+	_ types.RewardSourceHooks   = Hooks{}
 )
 
 // Hooks create new incentive hooks
@@ -219,3 +222,15 @@ func (h Hooks) BeforeVaultDepositModified(
 ) {
 	h.k.SynchronizeEarnReward(ctx, vaultDenom, depositor, sharesOwned)
 }
+
+// ------------------- Reward Source Hooks -------------------
+
+// AfterSourceSharesCreated function that runs after an owner first receives shares in a registered reward source
+func (h Hooks) AfterSourceSharesCreated(ctx sdk.Context, source, sourceID string, owner sdk.AccAddress) {
+	h.k.InitializeSourceReward(ctx, source, sourceID, owner)
+}
+
+// BeforeSourceSharesModified function that runs before an owner's shares in a registered reward source are modified
+func (h Hooks) BeforeSourceSharesModified(ctx sdk.Context, source, sourceID string, owner sdk.AccAddress) {
+	h.k.SynchronizeSourceReward(ctx, source, sourceID, owner)
+}
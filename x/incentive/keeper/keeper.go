@@ -25,11 +25,15 @@ type Keeper struct {
 	savingsKeeper types.SavingsKeeper
 	liquidKeeper  types.LiquidKeeper
 	earnKeeper    types.EarnKeeper
+	routerKeeper  types.RouterKeeper
 
 	// Keepers used for APY queries
 	mintKeeper      types.MintKeeper
 	distrKeeper     types.DistrKeeper
 	pricefeedKeeper types.PricefeedKeeper
+
+	// sources holds the registered reward sources by name
+	sources map[string]types.RewardSource
 }
 
 // NewKeeper creates a new keeper
@@ -37,13 +41,13 @@ func NewKeeper(
 	cdc codec.Codec, key storetypes.StoreKey, paramstore types.ParamSubspace, bk types.BankKeeper,
 	cdpk types.CdpKeeper, hk types.HardKeeper, ak types.AccountKeeper, stk types.StakingKeeper,
 	swpk types.SwapKeeper, svk types.SavingsKeeper, lqk types.LiquidKeeper, ek types.EarnKeeper,
-	mk types.MintKeeper, dk types.DistrKeeper, pfk types.PricefeedKeeper,
+	rk types.RouterKeeper, mk types.MintKeeper, dk types.DistrKeeper, pfk types.PricefeedKeeper,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
 	}
 
-	return Keeper{
+	k := Keeper{
 		accountKeeper: ak,
 		cdc:           cdc,
 		key:           key,
@@ -56,50 +60,43 @@ func NewKeeper(
 		savingsKeeper: svk,
 		liquidKeeper:  lqk,
 		earnKeeper:    ek,
+		routerKeeper:  rk,
 
 		mintKeeper:      mk,
 		distrKeeper:     dk,
 		pricefeedKeeper: pfk,
+
+		sources: make(map[string]types.RewardSource),
 	}
+	k.registerNativeRewardSources()
+
+	return k
 }
 
 // GetUSDXMintingClaim returns the claim in the store corresponding the the input address collateral type and id and a boolean for if the claim was found
 func (k Keeper) GetUSDXMintingClaim(ctx sdk.Context, addr sdk.AccAddress) (types.USDXMintingClaim, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.USDXMintingClaimKeyPrefix)
-	bz := store.Get(addr)
-	if bz == nil {
+	c, found := k.GetSourceClaim(ctx, types.USDXMintingClaimType, addr)
+	if !found {
 		return types.USDXMintingClaim{}, false
 	}
-	var c types.USDXMintingClaim
-	k.cdc.MustUnmarshal(bz, &c)
-	return c, true
+	return types.NewUSDXMintingClaimFromSource(c), true
 }
 
 // SetUSDXMintingClaim sets the claim in the store corresponding to the input address, collateral type, and id
 func (k Keeper) SetUSDXMintingClaim(ctx sdk.Context, c types.USDXMintingClaim) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.USDXMintingClaimKeyPrefix)
-	bz := k.cdc.MustMarshal(&c)
-	store.Set(c.Owner, bz)
+	k.SetSourceClaim(ctx, c.ToSourceClaim())
 }
 
 // DeleteUSDXMintingClaim deletes the claim in the store corresponding to the input address, collateral type, and id
 func (k Keeper) DeleteUSDXMintingClaim(ctx sdk.Context, owner sdk.AccAddress) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.USDXMintingClaimKeyPrefix)
-	store.Delete(owner)
+	k.DeleteSourceClaim(ctx, types.USDXMintingClaimType, owner)
 }
 
 // IterateUSDXMintingClaims iterates over all claim  objects in the store and preforms a callback function
 func (k Keeper) IterateUSDXMintingClaims(ctx sdk.Context, cb func(c types.USDXMintingClaim) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.USDXMintingClaimKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var c types.USDXMintingClaim
-		k.cdc.MustUnmarshal(iterator.Value(), &c)
-		if cb(c) {
-			break
-		}
-	}
+	k.IterateSourceClaims(ctx, types.USDXMintingClaimType, func(c types.SourceClaim) (stop bool) {
+		return cb(types.NewUSDXMintingClaimFromSource(c))
+	})
 }
 
 // GetAllUSDXMintingClaims returns all Claim objects in the store
@@ -114,120 +111,79 @@ func (k Keeper) GetAllUSDXMintingClaims(ctx sdk.Context) types.USDXMintingClaims
 
 // GetPreviousUSDXMintingAccrualTime returns the last time a collateral type accrued USDX minting rewards
 func (k Keeper) GetPreviousUSDXMintingAccrualTime(ctx sdk.Context, ctype string) (blockTime time.Time, found bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousUSDXMintingRewardAccrualTimeKeyPrefix)
-	b := store.Get([]byte(ctype))
-	if b == nil {
-		return time.Time{}, false
-	}
-	if err := blockTime.UnmarshalBinary(b); err != nil {
-		panic(err)
-	}
-	return blockTime, true
+	return k.GetSourceRewardAccrualTime(ctx, types.USDXMintingClaimType, ctype)
 }
 
 // SetPreviousUSDXMintingAccrualTime sets the last time a collateral type accrued USDX minting rewards
 func (k Keeper) SetPreviousUSDXMintingAccrualTime(ctx sdk.Context, ctype string, blockTime time.Time) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousUSDXMintingRewardAccrualTimeKeyPrefix)
-	bz, err := blockTime.MarshalBinary()
-	if err != nil {
-		panic(err)
-	}
-	store.Set([]byte(ctype), bz)
+	k.SetSourceRewardAccrualTime(ctx, types.USDXMintingClaimType, ctype, blockTime)
 }
 
 // IterateUSDXMintingAccrualTimes iterates over all previous USDX minting accrual times and preforms a callback function
 func (k Keeper) IterateUSDXMintingAccrualTimes(ctx sdk.Context, cb func(string, time.Time) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousUSDXMintingRewardAccrualTimeKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var accrualTime time.Time
-		if err := accrualTime.UnmarshalBinary(iterator.Value()); err != nil {
-			panic(err)
-		}
-		denom := string(iterator.Key())
-		if cb(denom, accrualTime) {
-			break
-		}
-	}
+	k.IterateSourceRewardAccrualTimes(ctx, types.USDXMintingClaimType, cb)
 }
 
 // GetUSDXMintingRewardFactor returns the current reward factor for an individual collateral type
 func (k Keeper) GetUSDXMintingRewardFactor(ctx sdk.Context, ctype string) (factor sdk.Dec, found bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.USDXMintingRewardFactorKeyPrefix)
-	bz := store.Get([]byte(ctype))
-	if bz == nil {
+	indexes, found := k.GetSourceRewardIndexes(ctx, types.USDXMintingClaimType, ctype)
+	if !found {
 		return sdk.ZeroDec(), false
 	}
-	if err := factor.Unmarshal(bz); err != nil {
-		panic(err)
-	}
-	return factor, true
+	return usdxMintingRewardFactor(indexes), true
 }
 
 // SetUSDXMintingRewardFactor sets the current reward factor for an individual collateral type
 func (k Keeper) SetUSDXMintingRewardFactor(ctx sdk.Context, ctype string, factor sdk.Dec) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.USDXMintingRewardFactorKeyPrefix)
-	bz, err := factor.Marshal()
-	if err != nil {
-		panic(err)
-	}
-	store.Set([]byte(ctype), bz)
+	indexes := types.RewardIndexes{types.NewRewardIndex(types.USDXMintingRewardDenom, factor)}
+	k.SetSourceRewardIndexes(ctx, types.USDXMintingClaimType, ctype, indexes)
 }
 
 // IterateUSDXMintingRewardFactors iterates over all USDX Minting reward factor objects in the store and preforms a callback function
 func (k Keeper) IterateUSDXMintingRewardFactors(ctx sdk.Context, cb func(denom string, factor sdk.Dec) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.USDXMintingRewardFactorKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var factor sdk.Dec
-		if err := factor.Unmarshal(iterator.Value()); err != nil {
-			panic(err)
-		}
-		if cb(string(iterator.Key()), factor) {
-			break
-		}
+	k.IterateSourceRewardIndexes(ctx, types.USDXMintingClaimType, func(ctype string, indexes types.RewardIndexes) (stop bool) {
+		return cb(ctype, usdxMintingRewardFactor(indexes))
+	})
+}
+
+// usdxMintingRewardFactor returns the usdx minting reward factor stored in the reward indexes of a collateral type.
+func usdxMintingRewardFactor(indexes types.RewardIndexes) sdk.Dec {
+	factor, found := indexes.Get(types.USDXMintingRewardDenom)
+	if !found {
+		return sdk.ZeroDec()
 	}
+	return factor
 }
 
 // GetHardLiquidityProviderClaim returns the claim in the store corresponding the the input address collateral type and id and a boolean for if the claim was found
 func (k Keeper) GetHardLiquidityProviderClaim(ctx sdk.Context, addr sdk.AccAddress) (types.HardLiquidityProviderClaim, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.HardLiquidityClaimKeyPrefix)
-	bz := store.Get(addr)
-	if bz == nil {
+	supply, found := k.GetSourceClaim(ctx, types.HardSupplyClaimType, addr)
+	if !found {
 		return types.HardLiquidityProviderClaim{}, false
 	}
-	var c types.HardLiquidityProviderClaim
-	k.cdc.MustUnmarshal(bz, &c)
-	return c, true
+	borrow, _ := k.GetSourceClaim(ctx, types.HardBorrowClaimType, addr)
+	return types.NewHardLiquidityProviderClaimFromSources(supply, borrow), true
 }
 
 // SetHardLiquidityProviderClaim sets the claim in the store corresponding to the input address, collateral type, and id
 func (k Keeper) SetHardLiquidityProviderClaim(ctx sdk.Context, c types.HardLiquidityProviderClaim) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.HardLiquidityClaimKeyPrefix)
-	bz := k.cdc.MustMarshal(&c)
-	store.Set(c.Owner, bz)
+	supply, borrow := c.ToSourceClaims()
+	k.SetSourceClaim(ctx, supply)
+	k.SetSourceClaim(ctx, borrow)
 }
 
 // DeleteHardLiquidityProviderClaim deletes the claim in the store corresponding to the input address, collateral type, and id
 func (k Keeper) DeleteHardLiquidityProviderClaim(ctx sdk.Context, owner sdk.AccAddress) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.HardLiquidityClaimKeyPrefix)
-	store.Delete(owner)
+	k.DeleteSourceClaim(ctx, types.HardSupplyClaimType, owner)
+	k.DeleteSourceClaim(ctx, types.HardBorrowClaimType, owner)
 }
 
 // IterateHardLiquidityProviderClaims iterates over all claim  objects in the store and preforms a callback function
 func (k Keeper) IterateHardLiquidityProviderClaims(ctx sdk.Context, cb func(c types.HardLiquidityProviderClaim) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.HardLiquidityClaimKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var c types.HardLiquidityProviderClaim
-		k.cdc.MustUnmarshal(iterator.Value(), &c)
-		if cb(c) {
-			break
-		}
-	}
+	k.IterateSourceClaims(ctx, types.HardSupplyClaimType, func(supply types.SourceClaim) (stop bool) {
+		borrow, _ := k.GetSourceClaim(ctx, types.HardBorrowClaimType, supply.Owner)
+		return cb(types.NewHardLiquidityProviderClaimFromSources(supply, borrow))
+	})
 }
 
 // GetAllHardLiquidityProviderClaims returns all Claim objects in the store
@@ -242,41 +198,28 @@ func (k Keeper) GetAllHardLiquidityProviderClaims(ctx sdk.Context) types.HardLiq
 
 // GetDelegatorClaim returns the claim in the store corresponding the the input address collateral type and id and a boolean for if the claim was found
 func (k Keeper) GetDelegatorClaim(ctx sdk.Context, addr sdk.AccAddress) (types.DelegatorClaim, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.DelegatorClaimKeyPrefix)
-	bz := store.Get(addr)
-	if bz == nil {
+	c, found := k.GetSourceClaim(ctx, types.DelegatorClaimType, addr)
+	if !found {
 		return types.DelegatorClaim{}, false
 	}
-	var c types.DelegatorClaim
-	k.cdc.MustUnmarshal(bz, &c)
-	return c, true
+	return types.NewDelegatorClaim(c.Owner, c.Reward, c.RewardIndexes), true
 }
 
 // SetDelegatorClaim sets the claim in the store corresponding to the input address, collateral type, and id
 func (k Keeper) SetDelegatorClaim(ctx sdk.Context, c types.DelegatorClaim) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.DelegatorClaimKeyPrefix)
-	bz := k.cdc.MustMarshal(&c)
-	store.Set(c.Owner, bz)
+	k.SetSourceClaim(ctx, types.NewSourceClaim(types.DelegatorClaimType, c.Owner, c.Reward, c.RewardIndexes))
 }
 
 // DeleteDelegatorClaim deletes the claim in the store corresponding to the input address, collateral type, and id
 func (k Keeper) DeleteDelegatorClaim(ctx sdk.Context, owner sdk.AccAddress) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.DelegatorClaimKeyPrefix)
-	store.Delete(owner)
+	k.DeleteSourceClaim(ctx, types.DelegatorClaimType, owner)
 }
 
 // IterateDelegatorClaims iterates over all claim  objects in the store and preforms a callback function
 func (k Keeper) IterateDelegatorClaims(ctx sdk.Context, cb func(c types.DelegatorClaim) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.DelegatorClaimKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var c types.DelegatorClaim
-		k.cdc.MustUnmarshal(iterator.Value(), &c)
-		if cb(c) {
-			break
-		}
-	}
+	k.IterateSourceClaims(ctx, types.DelegatorClaimType, func(c types.SourceClaim) (stop bool) {
+		return cb(types.NewDelegatorClaim(c.Owner, c.Reward, c.RewardIndexes))
+	})
 }
 
 // GetAllDelegatorClaims returns all DelegatorClaim objects in the store
@@ -291,41 +234,28 @@ func (k Keeper) GetAllDelegatorClaims(ctx sdk.Context) types.DelegatorClaims {
 
 // GetSwapClaim returns the claim in the store corresponding the the input address.
 func (k Keeper) GetSwapClaim(ctx sdk.Context, addr sdk.AccAddress) (types.SwapClaim, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SwapClaimKeyPrefix)
-	bz := store.Get(addr)
-	if bz == nil {
+	c, found := k.GetSourceClaim(ctx, types.SwapClaimType, addr)
+	if !found {
 		return types.SwapClaim{}, false
 	}
-	var c types.SwapClaim
-	k.cdc.MustUnmarshal(bz, &c)
-	return c, true
+	return types.NewSwapClaim(c.Owner, c.Reward, c.RewardIndexes), true
 }
 
 // SetSwapClaim sets the claim in the store corresponding to the input address.
 func (k Keeper) SetSwapClaim(ctx sdk.Context, c types.SwapClaim) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SwapClaimKeyPrefix)
-	bz := k.cdc.MustMarshal(&c)
-	store.Set(c.Owner, bz)
+	k.SetSourceClaim(ctx, types.NewSourceClaim(types.SwapClaimType, c.Owner, c.Reward, c.RewardIndexes))
 }
 
 // DeleteSwapClaim deletes the claim in the store corresponding to the input address.
 func (k Keeper) DeleteSwapClaim(ctx sdk.Context, owner sdk.AccAddress) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SwapClaimKeyPrefix)
-	store.Delete(owner)
+	k.DeleteSourceClaim(ctx, types.SwapClaimType, owner)
 }
 
 // IterateSwapClaims iterates over all claim  objects in the store and preforms a callback function
 func (k Keeper) IterateSwapClaims(ctx sdk.Context, cb func(c types.SwapClaim) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SwapClaimKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var c types.SwapClaim
-		k.cdc.MustUnmarshal(iterator.Value(), &c)
-		if cb(c) {
-			break
-		}
-	}
+	k.IterateSourceClaims(ctx, types.SwapClaimType, func(c types.SourceClaim) (stop bool) {
+		return cb(types.NewSwapClaim(c.Owner, c.Reward, c.RewardIndexes))
+	})
 }
 
 // GetAllSwapClaims returns all Claim objects in the store
@@ -340,41 +270,28 @@ func (k Keeper) GetAllSwapClaims(ctx sdk.Context) types.SwapClaims {
 
 // GetSavingsClaim returns the claim in the store corresponding the the input address.
 func (k Keeper) GetSavingsClaim(ctx sdk.Context, addr sdk.AccAddress) (types.SavingsClaim, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsClaimKeyPrefix)
-	bz := store.Get(addr)
-	if bz == nil {
+	c, found := k.GetSourceClaim(ctx, types.SavingsClaimType, addr)
+	if !found {
 		return types.SavingsClaim{}, false
 	}
-	var c types.SavingsClaim
-	k.cdc.MustUnmarshal(bz, &c)
-	return c, true
+	return types.NewSavingsClaim(c.Owner, c.Reward, c.RewardIndexes), true
 }
 
 // SetSavingsClaim sets the claim in the store corresponding to the input address.
 func (k Keeper) SetSavingsClaim(ctx sdk.Context, c types.SavingsClaim) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsClaimKeyPrefix)
-	bz := k.cdc.MustMarshal(&c)
-	store.Set(c.Owner, bz)
+	k.SetSourceClaim(ctx, types.NewSourceClaim(types.SavingsClaimType, c.Owner, c.Reward, c.RewardIndexes))
 }
 
 // DeleteSavingsClaim deletes the claim in the store corresponding to the input address.
 func (k Keeper) DeleteSavingsClaim(ctx sdk.Context, owner sdk.AccAddress) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsClaimKeyPrefix)
-	store.Delete(owner)
+	k.DeleteSourceClaim(ctx, types.SavingsClaimType, owner)
 }
 
 // IterateSavingsClaims iterates over all savings claim objects in the store and preforms a callback function
 func (k Keeper) IterateSavingsClaims(ctx sdk.Context, cb func(c types.SavingsClaim) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsClaimKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var c types.SavingsClaim
-		k.cdc.MustUnmarshal(iterator.Value(), &c)
-		if cb(c) {
-			break
-		}
-	}
+	k.IterateSourceClaims(ctx, types.SavingsClaimType, func(c types.SourceClaim) (stop bool) {
+		return cb(types.NewSavingsClaim(c.Owner, c.Reward, c.RewardIndexes))
+	})
 }
 
 // GetAllSavingsClaims returns all savings claim objects in the store
@@ -389,41 +306,28 @@ func (k Keeper) GetAllSavingsClaims(ctx sdk.Context) types.SavingsClaims {
 
 // GetEarnClaim returns the claim in the store corresponding the the input address.
 func (k Keeper) GetEarnClaim(ctx sdk.Context, addr sdk.AccAddress) (types.EarnClaim, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.EarnClaimKeyPrefix)
-	bz := store.Get(addr)
-	if bz == nil {
+	c, found := k.GetSourceClaim(ctx, types.EarnClaimType, addr)
+	if !found {
 		return types.EarnClaim{}, false
 	}
-	var c types.EarnClaim
-	k.cdc.MustUnmarshal(bz, &c)
-	return c, true
+	return types.NewEarnClaim(c.Owner, c.Reward, c.RewardIndexes), true
 }
 
 // SetEarnClaim sets the claim in the store corresponding to the input address.
 func (k Keeper) SetEarnClaim(ctx sdk.Context, c types.EarnClaim) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.EarnClaimKeyPrefix)
-	bz := k.cdc.MustMarshal(&c)
-	store.Set(c.Owner, bz)
+	k.SetSourceClaim(ctx, types.NewSourceClaim(types.EarnClaimType, c.Owner, c.Reward, c.RewardIndexes))
 }
 
 // DeleteEarnClaim deletes the claim in the store corresponding to the input address.
 func (k Keeper) DeleteEarnClaim(ctx sdk.Context, owner sdk.AccAddress) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.EarnClaimKeyPrefix)
-	store.Delete(owner)
+	k.DeleteSourceClaim(ctx, types.EarnClaimType, owner)
 }
 
 // IterateEarnClaims iterates over all claim  objects in the store and preforms a callback function
 func (k Keeper) IterateEarnClaims(ctx sdk.Context, cb func(c types.EarnClaim) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.EarnClaimKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var c types.EarnClaim
-		k.cdc.MustUnmarshal(iterator.Value(), &c)
-		if cb(c) {
-			break
-		}
-	}
+	k.IterateSourceClaims(ctx, types.EarnClaimType, func(c types.SourceClaim) (stop bool) {
+		return cb(types.NewEarnClaim(c.Owner, c.Reward, c.RewardIndexes))
+	})
 }
 
 // GetAllEarnClaims returns all Claim objects in the store
@@ -438,316 +342,250 @@ func (k Keeper) GetAllEarnClaims(ctx sdk.Context) types.EarnClaims {
 
 // SetHardSupplyRewardIndexes sets the current reward indexes for an individual denom
 func (k Keeper) SetHardSupplyRewardIndexes(ctx sdk.Context, denom string, indexes types.RewardIndexes) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.HardSupplyRewardIndexesKeyPrefix)
-	bz := k.cdc.MustMarshal(&types.RewardIndexesProto{
-		RewardIndexes: indexes,
-	})
-	store.Set([]byte(denom), bz)
+	k.SetSourceRewardIndexes(ctx, types.HardSupplyClaimType, denom, indexes)
 }
 
 // GetHardSupplyRewardIndexes gets the current reward indexes for an individual denom
 func (k Keeper) GetHardSupplyRewardIndexes(ctx sdk.Context, denom string) (types.RewardIndexes, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.HardSupplyRewardIndexesKeyPrefix)
-	bz := store.Get([]byte(denom))
-	if bz == nil {
-		return types.RewardIndexes{}, false
-	}
-	var proto types.RewardIndexesProto
-	k.cdc.MustUnmarshal(bz, &proto)
-
-	return proto.RewardIndexes, true
+	return k.GetSourceRewardIndexes(ctx, types.HardSupplyClaimType, denom)
 }
 
 // IterateHardSupplyRewardIndexes iterates over all Hard supply reward index objects in the store and preforms a callback function
 func (k Keeper) IterateHardSupplyRewardIndexes(ctx sdk.Context, cb func(denom string, indexes types.RewardIndexes) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.HardSupplyRewardIndexesKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var proto types.RewardIndexesProto
-		k.cdc.MustUnmarshal(iterator.Value(), &proto)
-		if cb(string(iterator.Key()), proto.RewardIndexes) {
-			break
-		}
-	}
+	k.IterateSourceRewardIndexes(ctx, types.HardSupplyClaimType, cb)
 }
 
 func (k Keeper) IterateHardSupplyRewardAccrualTimes(ctx sdk.Context, cb func(string, time.Time) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousHardSupplyRewardAccrualTimeKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var accrualTime time.Time
-		if err := accrualTime.UnmarshalBinary(iterator.Value()); err != nil {
-			panic(err)
-		}
-		denom := string(iterator.Key())
-		if cb(denom, accrualTime) {
-			break
-		}
-	}
+	k.IterateSourceRewardAccrualTimes(ctx, types.HardSupplyClaimType, cb)
 }
 
 // SetHardBorrowRewardIndexes sets the current reward indexes for an individual denom
 func (k Keeper) SetHardBorrowRewardIndexes(ctx sdk.Context, denom string, indexes types.RewardIndexes) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.HardBorrowRewardIndexesKeyPrefix)
-	bz := k.cdc.MustMarshal(&types.RewardIndexesProto{
-		RewardIndexes: indexes,
-	})
-	store.Set([]byte(denom), bz)
+	k.SetSourceRewardIndexes(ctx, types.HardBorrowClaimType, denom, indexes)
 }
 
 // GetHardBorrowRewardIndexes gets the current reward indexes for an individual denom
 func (k Keeper) GetHardBorrowRewardIndexes(ctx sdk.Context, denom string) (types.RewardIndexes, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.HardBorrowRewardIndexesKeyPrefix)
-	bz := store.Get([]byte(denom))
-	if bz == nil {
-		return types.RewardIndexes{}, false
-	}
-	var proto types.RewardIndexesProto
-	k.cdc.MustUnmarshal(bz, &proto)
-
-	return proto.RewardIndexes, true
+	return k.GetSourceRewardIndexes(ctx, types.HardBorrowClaimType, denom)
 }
 
 // IterateHardBorrowRewardIndexes iterates over all Hard borrow reward index objects in the store and preforms a callback function
 func (k Keeper) IterateHardBorrowRewardIndexes(ctx sdk.Context, cb func(denom string, indexes types.RewardIndexes) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.HardBorrowRewardIndexesKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var proto types.RewardIndexesProto
-		k.cdc.MustUnmarshal(iterator.Value(), &proto)
-		if cb(string(iterator.Key()), proto.RewardIndexes) {
-			break
-		}
-	}
+	k.IterateSourceRewardIndexes(ctx, types.HardBorrowClaimType, cb)
 }
 
 func (k Keeper) IterateHardBorrowRewardAccrualTimes(ctx sdk.Context, cb func(string, time.Time) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousHardBorrowRewardAccrualTimeKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		denom := string(iterator.Key())
-		var accrualTime time.Time
-		if err := accrualTime.UnmarshalBinary(iterator.Value()); err != nil {
-			panic(err)
-		}
-		if cb(denom, accrualTime) {
-			break
-		}
-	}
+	k.IterateSourceRewardAccrualTimes(ctx, types.HardBorrowClaimType, cb)
 }
 
 // GetDelegatorRewardIndexes gets the current reward indexes for an individual denom
 func (k Keeper) GetDelegatorRewardIndexes(ctx sdk.Context, denom string) (types.RewardIndexes, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.DelegatorRewardIndexesKeyPrefix)
-	bz := store.Get([]byte(denom))
-	if bz == nil {
-		return types.RewardIndexes{}, false
-	}
-	var proto types.RewardIndexesProto
-	k.cdc.MustUnmarshal(bz, &proto)
-
-	return proto.RewardIndexes, true
+	return k.GetSourceRewardIndexes(ctx, types.DelegatorClaimType, denom)
 }
 
 // SetDelegatorRewardIndexes sets the current reward indexes for an individual denom
 func (k Keeper) SetDelegatorRewardIndexes(ctx sdk.Context, denom string, indexes types.RewardIndexes) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.DelegatorRewardIndexesKeyPrefix)
-	bz := k.cdc.MustMarshal(&types.RewardIndexesProto{
-		RewardIndexes: indexes,
-	})
-	store.Set([]byte(denom), bz)
+	k.SetSourceRewardIndexes(ctx, types.DelegatorClaimType, denom, indexes)
 }
 
 // IterateDelegatorRewardIndexes iterates over all delegator reward index objects in the store and preforms a callback function
 func (k Keeper) IterateDelegatorRewardIndexes(ctx sdk.Context, cb func(denom string, indexes types.RewardIndexes) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.DelegatorRewardIndexesKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var proto types.RewardIndexesProto
-		k.cdc.MustUnmarshal(iterator.Value(), &proto)
-		if cb(string(iterator.Key()), proto.RewardIndexes) {
-			break
-		}
-	}
+	k.IterateSourceRewardIndexes(ctx, types.DelegatorClaimType, cb)
 }
 
 func (k Keeper) IterateDelegatorRewardAccrualTimes(ctx sdk.Context, cb func(string, time.Time) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousDelegatorRewardAccrualTimeKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		denom := string(iterator.Key())
-		var accrualTime time.Time
-		if err := accrualTime.UnmarshalBinary(iterator.Value()); err != nil {
-			panic(err)
-		}
-		if cb(denom, accrualTime) {
-			break
-		}
-	}
+	k.IterateSourceRewardAccrualTimes(ctx, types.DelegatorClaimType, cb)
 }
 
 // GetPreviousHardSupplyRewardAccrualTime returns the last time a denom accrued Hard protocol supply-side rewards
 func (k Keeper) GetPreviousHardSupplyRewardAccrualTime(ctx sdk.Context, denom string) (blockTime time.Time, found bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousHardSupplyRewardAccrualTimeKeyPrefix)
-	bz := store.Get([]byte(denom))
-	if bz == nil {
-		return time.Time{}, false
-	}
-	if err := blockTime.UnmarshalBinary(bz); err != nil {
-		panic(err)
-	}
-	return blockTime, true
+	return k.GetSourceRewardAccrualTime(ctx, types.HardSupplyClaimType, denom)
 }
 
 // SetPreviousHardSupplyRewardAccrualTime sets the last time a denom accrued Hard protocol supply-side rewards
 func (k Keeper) SetPreviousHardSupplyRewardAccrualTime(ctx sdk.Context, denom string, blockTime time.Time) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousHardSupplyRewardAccrualTimeKeyPrefix)
-	bz, err := blockTime.MarshalBinary()
-	if err != nil {
-		panic(err)
-	}
-	store.Set([]byte(denom), bz)
+	k.SetSourceRewardAccrualTime(ctx, types.HardSupplyClaimType, denom, blockTime)
 }
 
 // GetPreviousHardBorrowRewardAccrualTime returns the last time a denom accrued Hard protocol borrow-side rewards
 func (k Keeper) GetPreviousHardBorrowRewardAccrualTime(ctx sdk.Context, denom string) (blockTime time.Time, found bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousHardBorrowRewardAccrualTimeKeyPrefix)
-	b := store.Get([]byte(denom))
-	if b == nil {
-		return time.Time{}, false
-	}
-	if err := blockTime.UnmarshalBinary(b); err != nil {
-		panic(err)
-	}
-	return blockTime, true
+	return k.GetSourceRewardAccrualTime(ctx, types.HardBorrowClaimType, denom)
 }
 
 // SetPreviousHardBorrowRewardAccrualTime sets the last time a denom accrued Hard protocol borrow-side rewards
 func (k Keeper) SetPreviousHardBorrowRewardAccrualTime(ctx sdk.Context, denom string, blockTime time.Time) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousHardBorrowRewardAccrualTimeKeyPrefix)
-	bz, err := blockTime.MarshalBinary()
-	if err != nil {
-		panic(err)
-	}
-	store.Set([]byte(denom), bz)
+	k.SetSourceRewardAccrualTime(ctx, types.HardBorrowClaimType, denom, blockTime)
 }
 
 // GetPreviousDelegatorRewardAccrualTime returns the last time a denom accrued protocol delegator rewards
 func (k Keeper) GetPreviousDelegatorRewardAccrualTime(ctx sdk.Context, denom string) (blockTime time.Time, found bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousDelegatorRewardAccrualTimeKeyPrefix)
-	bz := store.Get([]byte(denom))
-	if bz == nil {
-		return time.Time{}, false
-	}
-	if err := blockTime.UnmarshalBinary(bz); err != nil {
-		panic(err)
-	}
-	return blockTime, true
+	return k.GetSourceRewardAccrualTime(ctx, types.DelegatorClaimType, denom)
 }
 
 // SetPreviousDelegatorRewardAccrualTime sets the last time a denom accrued protocol delegator rewards
 func (k Keeper) SetPreviousDelegatorRewardAccrualTime(ctx sdk.Context, denom string, blockTime time.Time) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousDelegatorRewardAccrualTimeKeyPrefix)
-	bz, err := blockTime.MarshalBinary()
-	if err != nil {
-		panic(err)
-	}
-	store.Set([]byte(denom), bz)
+	k.SetSourceRewardAccrualTime(ctx, types.DelegatorClaimType, denom, blockTime)
 }
 
 // SetSwapRewardIndexes stores the global reward indexes that track total rewards to a swap pool.
 func (k Keeper) SetSwapRewardIndexes(ctx sdk.Context, poolID string, indexes types.RewardIndexes) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SwapRewardIndexesKeyPrefix)
-	bz := k.cdc.MustMarshal(&types.RewardIndexesProto{
-		RewardIndexes: indexes,
-	})
-	store.Set([]byte(poolID), bz)
+	k.SetSourceRewardIndexes(ctx, types.SwapClaimType, poolID, indexes)
 }
 
 // GetSwapRewardIndexes fetches the global reward indexes that track total rewards to a swap pool.
 func (k Keeper) GetSwapRewardIndexes(ctx sdk.Context, poolID string) (types.RewardIndexes, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SwapRewardIndexesKeyPrefix)
-	bz := store.Get([]byte(poolID))
-	if bz == nil {
-		return types.RewardIndexes{}, false
-	}
-	var proto types.RewardIndexesProto
-	k.cdc.MustUnmarshal(bz, &proto)
-	return proto.RewardIndexes, true
+	return k.GetSourceRewardIndexes(ctx, types.SwapClaimType, poolID)
 }
 
 // IterateSwapRewardIndexes iterates over all swap reward index objects in the store and preforms a callback function
 func (k Keeper) IterateSwapRewardIndexes(ctx sdk.Context, cb func(poolID string, indexes types.RewardIndexes) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SwapRewardIndexesKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var proto types.RewardIndexesProto
-		k.cdc.MustUnmarshal(iterator.Value(), &proto)
-		if cb(string(iterator.Key()), proto.RewardIndexes) {
-			break
-		}
-	}
+	k.IterateSourceRewardIndexes(ctx, types.SwapClaimType, cb)
 }
 
 // GetSwapRewardAccrualTime fetches the last time rewards were accrued for a swap pool.
 func (k Keeper) GetSwapRewardAccrualTime(ctx sdk.Context, poolID string) (blockTime time.Time, found bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousSwapRewardAccrualTimeKeyPrefix)
-	b := store.Get([]byte(poolID))
-	if b == nil {
-		return time.Time{}, false
-	}
-	if err := blockTime.UnmarshalBinary(b); err != nil {
-		panic(err)
-	}
-	return blockTime, true
+	return k.GetSourceRewardAccrualTime(ctx, types.SwapClaimType, poolID)
 }
 
 // SetSwapRewardAccrualTime stores the last time rewards were accrued for a swap pool.
 func (k Keeper) SetSwapRewardAccrualTime(ctx sdk.Context, poolID string, blockTime time.Time) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousSwapRewardAccrualTimeKeyPrefix)
-	bz, err := blockTime.MarshalBinary()
-	if err != nil {
-		panic(err)
-	}
-	store.Set([]byte(poolID), bz)
+	k.SetSourceRewardAccrualTime(ctx, types.SwapClaimType, poolID, blockTime)
 }
 
 func (k Keeper) IterateSwapRewardAccrualTimes(ctx sdk.Context, cb func(string, time.Time) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousSwapRewardAccrualTimeKeyPrefix)
+	k.IterateSourceRewardAccrualTimes(ctx, types.SwapClaimType, cb)
+}
+
+// SetSavingsRewardIndexes stores the global reward indexes that rewards for an individual denom type
+func (k Keeper) SetSavingsRewardIndexes(ctx sdk.Context, denom string, indexes types.RewardIndexes) {
+	k.SetSourceRewardIndexes(ctx, types.SavingsClaimType, denom, indexes)
+}
+
+// GetSavingsRewardIndexes fetches the global reward indexes that track rewards for an individual denom type
+func (k Keeper) GetSavingsRewardIndexes(ctx sdk.Context, denom string) (types.RewardIndexes, bool) {
+	return k.GetSourceRewardIndexes(ctx, types.SavingsClaimType, denom)
+}
+
+// IterateSavingsRewardIndexes iterates over all savings reward index objects in the store and preforms a callback function
+func (k Keeper) IterateSavingsRewardIndexes(ctx sdk.Context, cb func(poolID string, indexes types.RewardIndexes) (stop bool)) {
+	k.IterateSourceRewardIndexes(ctx, types.SavingsClaimType, cb)
+}
+
+// GetSavingsRewardAccrualTime fetches the last time rewards were accrued for an individual denom type
+func (k Keeper) GetSavingsRewardAccrualTime(ctx sdk.Context, poolID string) (blockTime time.Time, found bool) {
+	return k.GetSourceRewardAccrualTime(ctx, types.SavingsClaimType, poolID)
+}
+
+// SetSavingsRewardAccrualTime stores the last time rewards were accrued for a savings deposit denom type
+func (k Keeper) SetSavingsRewardAccrualTime(ctx sdk.Context, poolID string, blockTime time.Time) {
+	k.SetSourceRewardAccrualTime(ctx, types.SavingsClaimType, poolID, blockTime)
+}
+
+// IterateSavingsRewardAccrualTimesiterates over all the previous savings reward accrual times in the store
+func (k Keeper) IterateSavingsRewardAccrualTimes(ctx sdk.Context, cb func(string, time.Time) (stop bool)) {
+	k.IterateSourceRewardAccrualTimes(ctx, types.SavingsClaimType, cb)
+}
+
+// SetEarnRewardIndexes stores the global reward indexes that track total rewards to a earn vault.
+func (k Keeper) SetEarnRewardIndexes(ctx sdk.Context, vaultDenom string, indexes types.RewardIndexes) {
+	k.SetSourceRewardIndexes(ctx, types.EarnClaimType, vaultDenom, indexes)
+}
+
+// GetEarnRewardIndexes fetches the global reward indexes that track total rewards to a earn vault.
+func (k Keeper) GetEarnRewardIndexes(ctx sdk.Context, vaultDenom string) (types.RewardIndexes, bool) {
+	return k.GetSourceRewardIndexes(ctx, types.EarnClaimType, vaultDenom)
+}
+
+// IterateEarnRewardIndexes iterates over all earn reward index objects in the store and preforms a callback function
+func (k Keeper) IterateEarnRewardIndexes(ctx sdk.Context, cb func(vaultDenom string, indexes types.RewardIndexes) (stop bool)) {
+	k.IterateSourceRewardIndexes(ctx, types.EarnClaimType, cb)
+}
+
+// GetEarnRewardAccrualTime fetches the last time rewards were accrued for an earn vault.
+func (k Keeper) GetEarnRewardAccrualTime(ctx sdk.Context, vaultDenom string) (blockTime time.Time, found bool) {
+	return k.GetSourceRewardAccrualTime(ctx, types.EarnClaimType, vaultDenom)
+}
+
+// SetEarnRewardAccrualTime stores the last time rewards were accrued for a earn vault.
+func (k Keeper) SetEarnRewardAccrualTime(ctx sdk.Context, vaultDenom string, blockTime time.Time) {
+	k.SetSourceRewardAccrualTime(ctx, types.EarnClaimType, vaultDenom, blockTime)
+}
+
+func (k Keeper) IterateEarnRewardAccrualTimes(ctx sdk.Context, cb func(string, time.Time) (stop bool)) {
+	k.IterateSourceRewardAccrualTimes(ctx, types.EarnClaimType, cb)
+}
+
+// sourceStore returns the store of a reward source under a key prefix.
+func (k Keeper) sourceStore(ctx sdk.Context, keyPrefix []byte, source string) prefix.Store {
+	return prefix.NewStore(prefix.NewStore(ctx.KVStore(k.key), keyPrefix), types.GetSourceKeyPrefix(source))
+}
+
+// GetSourceClaim returns the claim of a reward source in the store corresponding the the input address.
+func (k Keeper) GetSourceClaim(ctx sdk.Context, source string, addr sdk.AccAddress) (types.SourceClaim, bool) {
+	store := k.sourceStore(ctx, types.SourceClaimKeyPrefix, source)
+	bz := store.Get(addr)
+	if bz == nil {
+		return types.SourceClaim{}, false
+	}
+	var c types.SourceClaim
+	k.cdc.MustUnmarshal(bz, &c)
+	return c, true
+}
+
+// SetSourceClaim sets the claim of a reward source in the store corresponding to the input address.
+func (k Keeper) SetSourceClaim(ctx sdk.Context, c types.SourceClaim) {
+	store := k.sourceStore(ctx, types.SourceClaimKeyPrefix, c.Source)
+	bz := k.cdc.MustMarshal(&c)
+	store.Set(c.Owner, bz)
+}
+
+// DeleteSourceClaim deletes the claim of a reward source in the store corresponding to the input address.
+func (k Keeper) DeleteSourceClaim(ctx sdk.Context, source string, owner sdk.AccAddress) {
+	store := k.sourceStore(ctx, types.SourceClaimKeyPrefix, source)
+	store.Delete(owner)
+}
+
+// IterateSourceClaims iterates over all claims of a reward source in the store and preforms a callback function
+func (k Keeper) IterateSourceClaims(ctx sdk.Context, source string, cb func(c types.SourceClaim) (stop bool)) {
+	store := k.sourceStore(ctx, types.SourceClaimKeyPrefix, source)
 	iterator := sdk.KVStorePrefixIterator(store, []byte{})
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		poolID := string(iterator.Key())
-		var accrualTime time.Time
-		if err := accrualTime.UnmarshalBinary(iterator.Value()); err != nil {
-			panic(err)
+		var c types.SourceClaim
+		k.cdc.MustUnmarshal(iterator.Value(), &c)
+		if cb(c) {
+			break
 		}
-		if cb(poolID, accrualTime) {
+	}
+}
+
+// IterateAllSourceClaims iterates over the claims of all reward sources in the store and preforms a callback function
+func (k Keeper) IterateAllSourceClaims(ctx sdk.Context, cb func(c types.SourceClaim) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SourceClaimKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var c types.SourceClaim
+		k.cdc.MustUnmarshal(iterator.Value(), &c)
+		if cb(c) {
 			break
 		}
 	}
 }
 
-// SetSavingsRewardIndexes stores the global reward indexes that rewards for an individual denom type
-func (k Keeper) SetSavingsRewardIndexes(ctx sdk.Context, denom string, indexes types.RewardIndexes) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsRewardIndexesKeyPrefix)
+// SetSourceRewardIndexes stores the global reward indexes that track total rewards to a source ID of a reward source.
+func (k Keeper) SetSourceRewardIndexes(ctx sdk.Context, source, sourceID string, indexes types.RewardIndexes) {
+	store := k.sourceStore(ctx, types.SourceRewardIndexesKeyPrefix, source)
 	bz := k.cdc.MustMarshal(&types.RewardIndexesProto{
 		RewardIndexes: indexes,
 	})
-	store.Set([]byte(denom), bz)
+	store.Set([]byte(sourceID), bz)
 }
 
-// GetSavingsRewardIndexes fetches the global reward indexes that track rewards for an individual denom type
-func (k Keeper) GetSavingsRewardIndexes(ctx sdk.Context, denom string) (types.RewardIndexes, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsRewardIndexesKeyPrefix)
-	bz := store.Get([]byte(denom))
+// GetSourceRewardIndexes fetches the global reward indexes that track total rewards to a source ID of a reward source.
+func (k Keeper) GetSourceRewardIndexes(ctx sdk.Context, source, sourceID string) (types.RewardIndexes, bool) {
+	store := k.sourceStore(ctx, types.SourceRewardIndexesKeyPrefix, source)
+	bz := store.Get([]byte(sourceID))
 	if bz == nil {
 		return types.RewardIndexes{}, false
 	}
@@ -756,9 +594,9 @@ func (k Keeper) GetSavingsRewardIndexes(ctx sdk.Context, denom string) (types.Re
 	return proto.RewardIndexes, true
 }
 
-// IterateSavingsRewardIndexes iterates over all savings reward index objects in the store and preforms a callback function
-func (k Keeper) IterateSavingsRewardIndexes(ctx sdk.Context, cb func(poolID string, indexes types.RewardIndexes) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsRewardIndexesKeyPrefix)
+// IterateSourceRewardIndexes iterates over all reward indexes of a reward source in the store and preforms a callback function
+func (k Keeper) IterateSourceRewardIndexes(ctx sdk.Context, source string, cb func(sourceID string, indexes types.RewardIndexes) (stop bool)) {
+	store := k.sourceStore(ctx, types.SourceRewardIndexesKeyPrefix, source)
 	iterator := sdk.KVStorePrefixIterator(store, []byte{})
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
@@ -770,10 +608,25 @@ func (k Keeper) IterateSavingsRewardIndexes(ctx sdk.Context, cb func(poolID stri
 	}
 }
 
-// GetSavingsRewardAccrualTime fetches the last time rewards were accrued for an individual denom type
-func (k Keeper) GetSavingsRewardAccrualTime(ctx sdk.Context, poolID string) (blockTime time.Time, found bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousSavingsRewardAccrualTimeKeyPrefix)
-	b := store.Get([]byte(poolID))
+// IterateAllSourceRewardIndexes iterates over the reward indexes of all reward sources in the store and preforms a callback function
+func (k Keeper) IterateAllSourceRewardIndexes(ctx sdk.Context, cb func(source, sourceID string, indexes types.RewardIndexes) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SourceRewardIndexesKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		source, sourceID := types.SplitSourceKey(iterator.Key())
+		var proto types.RewardIndexesProto
+		k.cdc.MustUnmarshal(iterator.Value(), &proto)
+		if cb(source, string(sourceID), proto.RewardIndexes) {
+			break
+		}
+	}
+}
+
+// GetSourceRewardAccrualTime fetches the last time rewards were accrued for a source ID of a reward source.
+func (k Keeper) GetSourceRewardAccrualTime(ctx sdk.Context, source, sourceID string) (blockTime time.Time, found bool) {
+	store := k.sourceStore(ctx, types.PreviousSourceRewardAccrualTimeKeyPrefix, source)
+	b := store.Get([]byte(sourceID))
 	if b == nil {
 		return time.Time{}, false
 	}
@@ -783,103 +636,132 @@ func (k Keeper) GetSavingsRewardAccrualTime(ctx sdk.Context, poolID string) (blo
 	return blockTime, true
 }
 
-// SetSavingsRewardAccrualTime stores the last time rewards were accrued for a savings deposit denom type
-func (k Keeper) SetSavingsRewardAccrualTime(ctx sdk.Context, poolID string, blockTime time.Time) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousSavingsRewardAccrualTimeKeyPrefix)
+// SetSourceRewardAccrualTime stores the last time rewards were accrued for a source ID of a reward source.
+func (k Keeper) SetSourceRewardAccrualTime(ctx sdk.Context, source, sourceID string, blockTime time.Time) {
+	store := k.sourceStore(ctx, types.PreviousSourceRewardAccrualTimeKeyPrefix, source)
 	bz, err := blockTime.MarshalBinary()
 	if err != nil {
 		panic(err)
 	}
-	store.Set([]byte(poolID), bz)
+	store.Set([]byte(sourceID), bz)
 }
 
-// IterateSavingsRewardAccrualTimesiterates over all the previous savings reward accrual times in the store
-func (k Keeper) IterateSavingsRewardAccrualTimes(ctx sdk.Context, cb func(string, time.Time) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousSavingsRewardAccrualTimeKeyPrefix)
+// IterateSourceRewardAccrualTimes iterates over all the previous accrual times of a reward source in the store
+func (k Keeper) IterateSourceRewardAccrualTimes(ctx sdk.Context, source string, cb func(string, time.Time) (stop bool)) {
+	store := k.sourceStore(ctx, types.PreviousSourceRewardAccrualTimeKeyPrefix, source)
 	iterator := sdk.KVStorePrefixIterator(store, []byte{})
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		poolID := string(iterator.Key())
+		sourceID := string(iterator.Key())
 		var accrualTime time.Time
 		if err := accrualTime.UnmarshalBinary(iterator.Value()); err != nil {
 			panic(err)
 		}
-		if cb(poolID, accrualTime) {
+		if cb(sourceID, accrualTime) {
 			break
 		}
 	}
 }
 
-// SetEarnRewardIndexes stores the global reward indexes that track total rewards to a earn vault.
-func (k Keeper) SetEarnRewardIndexes(ctx sdk.Context, vaultDenom string, indexes types.RewardIndexes) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.EarnRewardIndexesKeyPrefix)
-	bz := k.cdc.MustMarshal(&types.RewardIndexesProto{
-		RewardIndexes: indexes,
-	})
-	store.Set([]byte(vaultDenom), bz)
+// IterateAllSourceRewardAccrualTimes iterates over the previous accrual times of all reward sources in the store
+func (k Keeper) IterateAllSourceRewardAccrualTimes(ctx sdk.Context, cb func(source, sourceID string, accrualTime time.Time) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousSourceRewardAccrualTimeKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		source, sourceID := types.SplitSourceKey(iterator.Key())
+		var accrualTime time.Time
+		if err := accrualTime.UnmarshalBinary(iterator.Value()); err != nil {
+			panic(err)
+		}
+		if cb(source, string(sourceID), accrualTime) {
+			break
+		}
+	}
 }
 
-// GetEarnRewardIndexes fetches the global reward indexes that track total rewards to a earn vault.
-func (k Keeper) GetEarnRewardIndexes(ctx sdk.Context, vaultDenom string) (types.RewardIndexes, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.EarnRewardIndexesKeyPrefix)
-	bz := store.Get([]byte(vaultDenom))
+// GetRewardBudgetSpend returns the rewards spent from the budget of a reward period of a source.
+func (k Keeper) GetRewardBudgetSpend(ctx sdk.Context, source, collateralType string) (types.RewardBudgetSpend, bool) {
+	store := k.sourceStore(ctx, types.RewardBudgetSpendKeyPrefix, source)
+	bz := store.Get([]byte(collateralType))
 	if bz == nil {
-		return types.RewardIndexes{}, false
+		return types.RewardBudgetSpend{}, false
 	}
-	var proto types.RewardIndexesProto
-	k.cdc.MustUnmarshal(bz, &proto)
-	return proto.RewardIndexes, true
+	var spend types.RewardBudgetSpend
+	k.cdc.MustUnmarshal(bz, &spend)
+	return spend, true
 }
 
-// IterateEarnRewardIndexes iterates over all earn reward index objects in the store and preforms a callback function
-func (k Keeper) IterateEarnRewardIndexes(ctx sdk.Context, cb func(vaultDenom string, indexes types.RewardIndexes) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.EarnRewardIndexesKeyPrefix)
+// SetRewardBudgetSpend stores the rewards spent from the budget of a reward period.
+func (k Keeper) SetRewardBudgetSpend(ctx sdk.Context, spend types.RewardBudgetSpend) {
+	store := k.sourceStore(ctx, types.RewardBudgetSpendKeyPrefix, spend.Source)
+	bz := k.cdc.MustMarshal(&spend)
+	store.Set([]byte(spend.CollateralType), bz)
+}
+
+// IterateRewardBudgetSpends iterates over the budget spends of all reward periods in the store and preforms a callback function
+func (k Keeper) IterateRewardBudgetSpends(ctx sdk.Context, cb func(spend types.RewardBudgetSpend) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RewardBudgetSpendKeyPrefix)
 	iterator := sdk.KVStorePrefixIterator(store, []byte{})
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		var proto types.RewardIndexesProto
-		k.cdc.MustUnmarshal(iterator.Value(), &proto)
-		if cb(string(iterator.Key()), proto.RewardIndexes) {
+		var spend types.RewardBudgetSpend
+		k.cdc.MustUnmarshal(iterator.Value(), &spend)
+		if cb(spend) {
 			break
 		}
 	}
 }
 
-// GetEarnRewardAccrualTime fetches the last time rewards were accrued for an earn vault.
-func (k Keeper) GetEarnRewardAccrualTime(ctx sdk.Context, vaultDenom string) (blockTime time.Time, found bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousEarnRewardAccrualTimeKeyPrefix)
-	b := store.Get([]byte(vaultDenom))
-	if b == nil {
-		return time.Time{}, false
-	}
-	if err := blockTime.UnmarshalBinary(b); err != nil {
-		panic(err)
-	}
-	return blockTime, true
+// GetAllRewardBudgetSpends returns the budget spends of all reward periods in the store.
+func (k Keeper) GetAllRewardBudgetSpends(ctx sdk.Context) types.RewardBudgetSpends {
+	var spends types.RewardBudgetSpends
+	k.IterateRewardBudgetSpends(ctx, func(spend types.RewardBudgetSpend) bool {
+		spends = append(spends, spend)
+		return false
+	})
+	return spends
 }
 
-// SetEarnRewardAccrualTime stores the last time rewards were accrued for a earn vault.
-func (k Keeper) SetEarnRewardAccrualTime(ctx sdk.Context, vaultDenom string, blockTime time.Time) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousEarnRewardAccrualTimeKeyPrefix)
-	bz, err := blockTime.MarshalBinary()
-	if err != nil {
-		panic(err)
+// GetRewardBoostStake returns the stake boosting the rewards of an owner for a source ID of a reward source.
+func (k Keeper) GetRewardBoostStake(ctx sdk.Context, source, collateralType string, owner sdk.AccAddress) (types.RewardBoostStake, bool) {
+	store := k.sourceStore(ctx, types.RewardBoostStakeKeyPrefix, source)
+	bz := store.Get(types.GetRewardBoostStakeKey(collateralType, owner))
+	if bz == nil {
+		return types.RewardBoostStake{}, false
 	}
-	store.Set([]byte(vaultDenom), bz)
+	var stake types.RewardBoostStake
+	k.cdc.MustUnmarshal(bz, &stake)
+	return stake, true
 }
 
-func (k Keeper) IterateEarnRewardAccrualTimes(ctx sdk.Context, cb func(string, time.Time) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousEarnRewardAccrualTimeKeyPrefix)
+// SetRewardBoostStake stores the stake boosting the rewards of an owner for a source ID.
+func (k Keeper) SetRewardBoostStake(ctx sdk.Context, stake types.RewardBoostStake) {
+	store := k.sourceStore(ctx, types.RewardBoostStakeKeyPrefix, stake.Source)
+	bz := k.cdc.MustMarshal(&stake)
+	store.Set(types.GetRewardBoostStakeKey(stake.CollateralType, stake.Owner), bz)
+}
+
+// IterateRewardBoostStakes iterates over the boost stakes of all claims in the store and preforms a callback function
+func (k Keeper) IterateRewardBoostStakes(ctx sdk.Context, cb func(stake types.RewardBoostStake) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RewardBoostStakeKeyPrefix)
 	iterator := sdk.KVStorePrefixIterator(store, []byte{})
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		poolID := string(iterator.Key())
-		var accrualTime time.Time
-		if err := accrualTime.UnmarshalBinary(iterator.Value()); err != nil {
-			panic(err)
-		}
-		if cb(poolID, accrualTime) {
+		var stake types.RewardBoostStake
+		k.cdc.MustUnmarshal(iterator.Value(), &stake)
+		if cb(stake) {
 			break
 		}
 	}
 }
+
+// GetAllRewardBoostStakes returns the boost stakes of all claims in the store.
+func (k Keeper) GetAllRewardBoostStakes(ctx sdk.Context) types.RewardBoostStakes {
+	var stakes types.RewardBoostStakes
+	k.IterateRewardBoostStakes(ctx, func(stake types.RewardBoostStake) bool {
+		stakes = append(stakes, stake)
+		return false
+	})
+	return stakes
+}
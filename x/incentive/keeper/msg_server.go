@@ -30,7 +30,7 @@ func (k msgServer) ClaimUSDXMintingReward(goCtx context.Context, msg *types.MsgC
 		return nil, err
 	}
 
-	err = k.keeper.ClaimUSDXMintingReward(ctx, sender, sender, msg.MultiplierName)
+	err = k.keeper.ClaimUSDXMintingReward(ctx, sender, sender, types.NewSelection(types.USDXMintingRewardDenom, msg.MultiplierName))
 	if err != nil {
 		return nil, err
 	}
@@ -47,7 +47,7 @@ func (k msgServer) ClaimHardReward(goCtx context.Context, msg *types.MsgClaimHar
 	}
 
 	for _, selection := range msg.DenomsToClaim {
-		err := k.keeper.ClaimHardReward(ctx, sender, sender, selection.Denom, selection.MultiplierName)
+		err := k.keeper.ClaimHardReward(ctx, sender, sender, selection)
 		if err != nil {
 			return nil, err
 		}
@@ -66,7 +66,7 @@ func (k msgServer) ClaimDelegatorReward(goCtx context.Context, msg *types.MsgCla
 	}
 
 	for _, selection := range msg.DenomsToClaim {
-		err := k.keeper.ClaimDelegatorReward(ctx, sender, sender, selection.Denom, selection.MultiplierName)
+		err := k.keeper.ClaimDelegatorReward(ctx, sender, sender, selection)
 		if err != nil {
 			return nil, err
 		}
@@ -84,7 +84,7 @@ func (k msgServer) ClaimSwapReward(goCtx context.Context, msg *types.MsgClaimSwa
 	}
 
 	for _, selection := range msg.DenomsToClaim {
-		err := k.keeper.ClaimSwapReward(ctx, sender, sender, selection.Denom, selection.MultiplierName)
+		err := k.keeper.ClaimSwapReward(ctx, sender, sender, selection)
 		if err != nil {
 			return nil, err
 		}
@@ -107,7 +107,7 @@ func (k msgServer) ClaimEarnReward(goCtx context.Context, msg *types.MsgClaimEar
 	}
 
 	for _, selection := range msg.DenomsToClaim {
-		err := k.keeper.ClaimEarnReward(ctx, sender, sender, selection.Denom, selection.MultiplierName)
+		err := k.keeper.ClaimEarnReward(ctx, sender, sender, selection)
 		if err != nil {
 			return nil, err
 		}
@@ -115,3 +115,40 @@ func (k msgServer) ClaimEarnReward(goCtx context.Context, msg *types.MsgClaimEar
 
 	return &types.MsgClaimEarnRewardResponse{}, nil
 }
+
+func (k msgServer) ClaimAllRewards(goCtx context.Context, msg *types.MsgClaimAllRewards) (*types.MsgClaimAllRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.keeper.ClaimAllRewards(ctx, sender, sender, msg.DenomsToClaim); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimAllRewardsResponse{}, nil
+}
+
+func (k msgServer) ClaimReward(goCtx context.Context, msg *types.MsgClaimReward) (*types.MsgClaimRewardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := k.keeper.GetRewardSource(msg.Source); !found {
+		return nil, errorsmod.Wrapf(types.ErrUnknownRewardSource, "%s", msg.Source)
+	}
+
+	for _, selection := range msg.DenomsToClaim {
+		err := k.keeper.ClaimSourceReward(ctx, sender, sender, msg.Source, selection)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgClaimRewardResponse{}, nil
+}
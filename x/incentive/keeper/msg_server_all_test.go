@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/incubus-network/fury/x/incentive/types"
+)
+
+func (suite *HandlerTestSuite) TestPayoutAllClaims() {
+	userAddr := suite.addrs[0]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("bnb", 1e12), c("ufury", 1e12), c("busd", 1e12)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleSupplyRewardPeriod("bnb", cs(c("hard", 1e6), c("swap", 1e6))).
+		WithSimpleBorrowRewardPeriod("bnb", cs(c("hard", 1e6), c("swap", 1e6))).
+		WithSimpleSwapRewardPeriod("busd:ufury", cs(c("hard", 1e6), c("swap", 1e6)))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	// create a hard deposit and borrow, and a swap deposit
+	suite.NoError(suite.DeliverHardMsgDeposit(userAddr, cs(c("bnb", 1e11))))
+	suite.NoError(suite.DeliverHardMsgBorrow(userAddr, cs(c("bnb", 1e10))))
+	suite.NoError(
+		suite.DeliverSwapMsgDeposit(userAddr, c("ufury", 1e9), c("busd", 1e9), d("1.0")),
+	)
+
+	// accumulate some rewards
+	suite.NextBlockAfter(7 * time.Second)
+
+	// Pending rewards include rewards accumulated since the claims were last synced
+	pending := suite.App.GetIncentiveKeeper().GetPendingRewards(suite.Ctx, userAddr)
+	suite.Equal([]types.PendingReward{
+		types.NewPendingReward(types.HardLiquidityProviderClaimType, cs(c("hard", 2*7*1e6), c("swap", 2*7*1e6))),
+		types.NewPendingReward(types.SwapClaimType, cs(c("hard", 7*1e6), c("swap", 7*1e6))),
+	}, pending)
+
+	preClaimBal := suite.GetBalance(userAddr)
+
+	// Claiming denoms without rewards fails
+	msg := types.NewMsgClaimAllRewards(
+		userAddr.String(),
+		types.Selections{
+			types.NewSelection("ufury", "large"),
+		},
+	)
+	suite.ErrorIs(suite.DeliverIncentiveMsg(&msg), types.ErrZeroClaim)
+
+	msg = types.NewMsgClaimAllRewards(
+		userAddr.String(),
+		types.Selections{
+			types.NewSelection("hard", "small"),
+			types.NewSelection("swap", "medium"),
+		},
+	)
+	suite.NoError(suite.DeliverIncentiveMsg(&msg))
+
+	// Check rewards of both claim types were paid out with the selected multipliers
+	expectedRewardsHard := c("hard", int64(0.2*float64(3*7*1e6)))
+	expectedRewardsSwap := c("swap", int64(0.5*float64(3*7*1e6)))
+	suite.BalanceEquals(userAddr, preClaimBal.Add(expectedRewardsHard, expectedRewardsSwap))
+
+	// Check that claimed coins have been removed from each claim's reward
+	suite.HardRewardEquals(userAddr, nil)
+	suite.SwapRewardEquals(userAddr, nil)
+	suite.Empty(suite.App.GetIncentiveKeeper().GetPendingRewards(suite.Ctx, userAddr))
+}
@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/incubus-network/fury/x/incentive/types"
+)
+
+func (suite *HandlerTestSuite) TestPayoutDelegatorClaimToDelegation() {
+	userAddr := suite.addrs[0]
+	valAddr := sdk.ValAddress(userAddr)
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("ufury", 1e12)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleDelegatorRewardPeriod(types.BondDenom, cs(c("ufury", 1e6)))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	// create a delegation (need to create a validator first, which will have a self delegation)
+	suite.NoError(
+		suite.DeliverMsgCreateValidator(valAddr, c("ufury", 1e9)),
+	)
+
+	// Delete genesis validator to not influence rewards
+	suite.App.DeleteGenesisValidator(suite.T(), suite.Ctx)
+
+	// new block required to bond validator
+	suite.NextBlockAfter(7 * time.Second)
+	// Now the delegation is bonded, accumulate some delegator rewards
+	suite.NextBlockAfter(7 * time.Second)
+
+	preClaimBal := suite.GetBalance(userAddr)
+
+	msg := types.NewMsgClaimDelegatorReward(
+		userAddr.String(),
+		types.Selections{
+			types.NewDestinationSelection("ufury", "small", types.CLAIM_DESTINATION_DELEGATION, valAddr.String()),
+		},
+	)
+
+	err := suite.DeliverIncentiveMsg(&msg)
+	suite.NoError(err)
+
+	// Check rewards were delegated rather than left in the account
+	expectedRewards := c("ufury", int64(0.2*float64(2*7*1e6)))
+	suite.BalanceEquals(userAddr, preClaimBal)
+
+	delegation, found := suite.App.GetStakingKeeper().GetDelegation(suite.Ctx, userAddr, valAddr)
+	suite.Require().True(found)
+	validator, found := suite.App.GetStakingKeeper().GetValidator(suite.Ctx, valAddr)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewInt(1e9).Add(expectedRewards.Amount), validator.TokensFromShares(delegation.Shares).TruncateInt())
+
+	// Check the delegated rewards are still vesting
+	suite.VestingPeriodsEqual(userAddr, []vestingtypes.Period{
+		{Length: (17+31)*secondsPerDay - 2*7, Amount: cs(expectedRewards)},
+	})
+	vacc, ok := suite.GetAccount(userAddr).(*vestingtypes.PeriodicVestingAccount)
+	suite.Require().True(ok)
+	suite.Equal(cs(expectedRewards), vacc.DelegatedVesting)
+
+	suite.DelegatorRewardEquals(userAddr, nil)
+}
+
+func (suite *HandlerTestSuite) TestPayoutClaimWithLockupToDepositDestinationFails() {
+	userAddr := suite.addrs[0]
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("ufury", 1e12)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleDelegatorRewardPeriod(types.BondDenom, cs(c("hard", 1e6)))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	suite.NoError(
+		suite.DeliverMsgCreateValidator(sdk.ValAddress(userAddr), c("ufury", 1e9)),
+	)
+	suite.App.DeleteGenesisValidator(suite.T(), suite.Ctx)
+	suite.NextBlockAfter(7 * time.Second)
+	suite.NextBlockAfter(7 * time.Second)
+
+	preClaimBal := suite.GetBalance(userAddr)
+
+	for _, destination := range []types.ClaimDestination{types.CLAIM_DESTINATION_EARN, types.CLAIM_DESTINATION_SAVINGS} {
+		msg := types.NewMsgClaimDelegatorReward(
+			userAddr.String(),
+			types.Selections{
+				types.NewDestinationSelection("hard", "small", destination, ""),
+			},
+		)
+
+		err := suite.DeliverIncentiveMsg(&msg)
+		suite.ErrorIs(err, types.ErrInvalidClaimDestination)
+	}
+
+	// Check rewards were not paid out
+	suite.BalanceEquals(userAddr, preClaimBal)
+	_, isVesting := suite.GetAccount(userAddr).(*vestingtypes.PeriodicVestingAccount)
+	suite.False(isVesting)
+}
+
+func (suite *HandlerTestSuite) TestPayoutAllClaimsSendsUSDXRewardsToDestination() {
+	userAddr := suite.addrs[0]
+	valAddr := sdk.ValAddress(userAddr)
+
+	authBulder := suite.authBuilder().
+		WithSimpleAccount(userAddr, cs(c("bnb", 1e12), c("ufury", 1e12)))
+
+	incentBuilder := suite.incentiveBuilder().
+		WithSimpleUSDXRewardPeriod("bnb-a", c(types.USDXMintingRewardDenom, 1e6))
+
+	suite.SetupWithGenState(authBulder, incentBuilder)
+
+	suite.NoError(
+		suite.DeliverMsgCreateValidator(valAddr, c("ufury", 1e9)),
+	)
+	suite.App.DeleteGenesisValidator(suite.T(), suite.Ctx)
+	suite.NextBlockAfter(7 * time.Second)
+
+	// mint some usdx and accumulate some rewards
+	suite.NoError(suite.DeliverMsgCreateCDP(userAddr, c("bnb", 1e9), c("usdx", 1e7), "bnb-a"))
+	suite.NextBlockAfter(7 * time.Second)
+
+	preClaimBal := suite.GetBalance(userAddr)
+
+	// Locked usdx minting rewards cannot be deposited
+	msg := types.NewMsgClaimAllRewards(
+		userAddr.String(),
+		types.Selections{
+			types.NewDestinationSelection(types.USDXMintingRewardDenom, "large", types.CLAIM_DESTINATION_SAVINGS, ""),
+		},
+	)
+	suite.ErrorIs(suite.DeliverIncentiveMsg(&msg), types.ErrInvalidClaimDestination)
+
+	msg = types.NewMsgClaimAllRewards(
+		userAddr.String(),
+		types.Selections{
+			types.NewDestinationSelection(types.USDXMintingRewardDenom, "large", types.CLAIM_DESTINATION_DELEGATION, valAddr.String()),
+		},
+	)
+	suite.NoError(suite.DeliverIncentiveMsg(&msg))
+
+	// Check rewards were delegated rather than left in the account
+	expectedRewards := c(types.USDXMintingRewardDenom, 7*1e6)
+	suite.BalanceEquals(userAddr, preClaimBal)
+
+	delegation, found := suite.App.GetStakingKeeper().GetDelegation(suite.Ctx, userAddr, valAddr)
+	suite.Require().True(found)
+	validator, found := suite.App.GetStakingKeeper().GetValidator(suite.Ctx, valAddr)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewInt(1e9).Add(expectedRewards.Amount), validator.TokensFromShares(delegation.Shares).TruncateInt())
+
+	suite.USDXRewardEquals(userAddr, c(types.USDXMintingRewardDenom, 0))
+}
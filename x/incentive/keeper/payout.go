@@ -196,3 +196,92 @@ func (k Keeper) addCoinsToVestingSchedule(ctx sdk.Context, addr sdk.AccAddress,
 	}
 	k.accountKeeper.SetAccount(ctx, vacc)
 }
+
+// payoutReward sends claimed rewards to a receiver account, locked for the multiplier's lockup period, then moves
+// them to the selected claim destination.
+func (k Keeper) payoutReward(ctx sdk.Context, receiver sdk.AccAddress, rewardCoins sdk.Coins, multiplier types.Multiplier, selection types.Selection) error {
+	length := k.GetPeriodLength(ctx.BlockTime(), multiplier.MonthsLockup)
+	if length > 0 && !selection.Destination.AcceptsLockedRewards() {
+		return errorsmod.Wrapf(
+			types.ErrInvalidClaimDestination,
+			"rewards claimed with a lockup can only be sent to the account or delegated, not %s", selection.Destination,
+		)
+	}
+
+	if err := k.SendTimeLockedCoinsToAccount(ctx, types.IncentiveMacc, receiver, rewardCoins, length); err != nil {
+		return err
+	}
+	return k.sendRewardsToDestination(ctx, receiver, rewardCoins, selection)
+}
+
+// sendRewardsToDestination moves rewards paid to an account into the selected claim destination.
+// Delegated vesting coins stay vesting and are returned locked if they are undelegated before the lockup ends.
+func (k Keeper) sendRewardsToDestination(ctx sdk.Context, owner sdk.AccAddress, rewardCoins sdk.Coins, selection types.Selection) error {
+	if selection.Destination == types.CLAIM_DESTINATION_ACCOUNT {
+		return nil
+	}
+	var err error
+	switch selection.Destination {
+	case types.CLAIM_DESTINATION_EARN:
+		err = k.depositRewardsToEarn(ctx, owner, rewardCoins, selection.Validator)
+	case types.CLAIM_DESTINATION_SAVINGS:
+		err = k.savingsKeeper.Deposit(ctx, owner, rewardCoins)
+	case types.CLAIM_DESTINATION_DELEGATION:
+		err = k.delegateRewards(ctx, owner, rewardCoins, selection.Validator)
+	default:
+		err = selection.Destination.Validate()
+	}
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClaimDestination,
+			sdk.NewAttribute(types.AttributeKeyClaimedBy, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimAmount, rewardCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimDestination, selection.Destination.String()),
+		),
+	)
+	return nil
+}
+
+// depositRewardsToEarn deposits rewards into the earn vault of their denom. If a validator is given, the rewards are
+// delegated to it and deposited into the bfury vault as staking derivatives through the router module.
+func (k Keeper) depositRewardsToEarn(ctx sdk.Context, owner sdk.AccAddress, rewardCoins sdk.Coins, validator string) error {
+	for _, coin := range rewardCoins {
+		if validator != "" {
+			valAddr, err := sdk.ValAddressFromBech32(validator)
+			if err != nil {
+				return err
+			}
+			if err := k.routerKeeper.DelegateMintDeposit(ctx, owner, valAddr, coin); err != nil {
+				return err
+			}
+			continue
+		}
+
+		vault, found := k.earnKeeper.GetAllowedVault(ctx, coin.Denom)
+		if !found || len(vault.Strategies) == 0 {
+			return errorsmod.Wrapf(types.ErrInvalidClaimDestination, "no earn vault for denom %s", coin.Denom)
+		}
+		if err := k.earnKeeper.Deposit(ctx, owner, coin, vault.Strategies[0]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// delegateRewards delegates rewards to a validator through the router module.
+func (k Keeper) delegateRewards(ctx sdk.Context, owner sdk.AccAddress, rewardCoins sdk.Coins, validator string) error {
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return err
+	}
+	for _, coin := range rewardCoins {
+		if _, err := k.routerKeeper.Delegate(ctx, owner, valAddr, coin); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -509,14 +509,14 @@ func GetAPYFromMultiRewardPeriod(
 	// In many cases, RewardsPerSecond are assets that are different from the
 	// CollateralType, so we need to use the USD value of CollateralType and
 	// RewardsPerSecond to determine the APY.
-	for _, reward := range rewardPeriod.RewardsPerSecond {
+	for _, reward := range rewardPeriod.RewardsPerSecondAt(ctx.BlockTime()) {
 		// Get USD value of 1 unit of reward asset type, using TWAP
 		rewardDenomUSDValue, err := k.pricefeedKeeper.GetCurrentPrice(ctx, getMarketID(reward.Denom))
 		if err != nil {
 			return sdk.ZeroDec(), fmt.Errorf("failed to get price for RewardsPerSecond asset %s: %w", reward.Denom, err)
 		}
 
-		rewardPerSecond := sdk.NewDecFromInt(reward.Amount).Mul(rewardDenomUSDValue.Price)
+		rewardPerSecond := reward.Amount.Mul(rewardDenomUSDValue.Price)
 		totalUSDRewardsPerSecond = totalUSDRewardsPerSecond.Add(rewardPerSecond)
 	}
 
@@ -28,7 +28,7 @@ func (k Keeper) AccumulateHardBorrowRewards(ctx sdk.Context, rewardPeriod types.
 
 	totalSource := k.getHardBorrowTotalSourceShares(ctx, rewardPeriod.CollateralType)
 
-	acc.Accumulate(rewardPeriod, totalSource, ctx.BlockTime())
+	k.accumulateWithBudget(ctx, acc, types.HardBorrowClaimType, rewardPeriod, totalSource)
 
 	k.SetPreviousHardBorrowRewardAccrualTime(ctx, rewardPeriod.CollateralType, acc.PreviousAccumulationTime)
 	if len(acc.Indexes) > 0 {
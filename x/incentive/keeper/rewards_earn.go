@@ -22,13 +22,7 @@ func (k Keeper) AccumulateEarnRewards(ctx sdk.Context, rewardPeriod types.MultiR
 		return k.accumulateEarnBfuryRewards(ctx, rewardPeriod)
 	}
 
-	k.accumulateEarnRewards(
-		ctx,
-		rewardPeriod.CollateralType,
-		rewardPeriod.Start,
-		rewardPeriod.End,
-		sdk.NewDecCoinsFromCoins(rewardPeriod.RewardsPerSecond...),
-	)
+	k.AccumulateSourceRewards(ctx, types.EarnClaimType, rewardPeriod)
 
 	return nil
 }
@@ -111,8 +105,7 @@ func (k Keeper) accumulateEarnBfuryRewards(ctx sdk.Context, rewardPeriod types.M
 		k.accumulateBfuryEarnRewards(
 			ctx,
 			bfuryDenom,
-			rewardPeriod.Start,
-			rewardPeriod.End,
+			rewardPeriod,
 			GetProportionalRewardsPerSecond(
 				rewardPeriod,
 				totalBfuryValue.Amount,
@@ -124,11 +117,12 @@ func (k Keeper) accumulateEarnBfuryRewards(ctx sdk.Context, rewardPeriod types.M
 	return nil
 }
 
+// accumulateBfuryEarnRewards distributes the staking rewards of a bfury vault and its share of the bfury reward
+// period's rewards. The budget of the bfury reward period is shared by all bfury vaults.
 func (k Keeper) accumulateBfuryEarnRewards(
 	ctx sdk.Context,
 	collateralType string,
-	periodStart time.Time,
-	periodEnd time.Time,
+	rewardPeriod types.MultiRewardPeriod,
 	periodRewardsPerSecond sdk.DecCoins,
 ) {
 	// Collect staking rewards for this validator, does not have any start/end
@@ -140,11 +134,18 @@ func (k Keeper) accumulateBfuryEarnRewards(
 	perSecondRewards := k.collectPerSecondRewards(
 		ctx,
 		collateralType,
-		periodStart,
-		periodEnd,
+		rewardPeriod.Start,
+		rewardPeriod.End,
+		rewardPeriod.Curve,
 		periodRewardsPerSecond,
 	)
 
+	totalSourceShares := k.sources[types.EarnClaimType].TotalShares(ctx, collateralType)
+	if totalSourceShares.GT(sdk.ZeroDec()) {
+		// Rewards are dropped when there are no source shares, so they only use up the budget when distributed.
+		perSecondRewards = k.capRewardsToBudget(ctx, types.EarnClaimType, rewardPeriod, perSecondRewards)
+	}
+
 	// **Total rewards** for vault per second, NOT per share
 	rewards := stakingRewards.Add(perSecondRewards...)
 
@@ -154,7 +155,6 @@ func (k Keeper) accumulateBfuryEarnRewards(
 		indexes = types.RewardIndexes{}
 	}
 
-	totalSourceShares := k.getEarnTotalSourceShares(ctx, collateralType)
 	var increment types.RewardIndexes
 	if totalSourceShares.GT(sdk.ZeroDec()) {
 		// Divide total rewards by total shares to get the reward **per share**
@@ -170,6 +170,12 @@ func (k Keeper) accumulateBfuryEarnRewards(
 }
 
 func (k Keeper) collectDerivativeStakingRewards(ctx sdk.Context, collateralType string) sdk.DecCoins {
+	// Staking rewards restaked by the liquid module are paid to all derivative
+	// holders through the derivative's value, not to earn depositors.
+	if k.liquidKeeper.IsCompoundingEnabled(ctx) {
+		return nil
+	}
+
 	rewards, err := k.liquidKeeper.CollectStakingRewardsByDenom(ctx, collateralType, types.IncentiveMacc)
 	if err != nil {
 		if !errors.Is(err, distrtypes.ErrNoValidatorDistInfo) &&
@@ -199,6 +205,7 @@ func (k Keeper) collectPerSecondRewards(
 	collateralType string,
 	periodStart time.Time,
 	periodEnd time.Time,
+	curve *types.RewardCurve,
 	periodRewardsPerSecond sdk.DecCoins,
 ) sdk.DecCoins {
 	previousAccrualTime, found := k.GetEarnRewardAccrualTime(ctx, collateralType)
@@ -206,9 +213,10 @@ func (k Keeper) collectPerSecondRewards(
 		previousAccrualTime = ctx.BlockTime()
 	}
 
-	rewards, accumulatedTo := types.CalculatePerSecondRewards(
+	rewards, accumulatedTo := types.CalculateCurveRewards(
 		periodStart,
 		periodEnd,
+		curve,
 		periodRewardsPerSecond,
 		previousAccrualTime,
 		ctx.BlockTime(),
@@ -220,67 +228,10 @@ func (k Keeper) collectPerSecondRewards(
 	return rewards
 }
 
-func (k Keeper) accumulateEarnRewards(
-	ctx sdk.Context,
-	collateralType string,
-	periodStart time.Time,
-	periodEnd time.Time,
-	periodRewardsPerSecond sdk.DecCoins,
-) {
-	previousAccrualTime, found := k.GetEarnRewardAccrualTime(ctx, collateralType)
-	if !found {
-		previousAccrualTime = ctx.BlockTime()
-	}
-
-	indexes, found := k.GetEarnRewardIndexes(ctx, collateralType)
-	if !found {
-		indexes = types.RewardIndexes{}
-	}
-
-	acc := types.NewAccumulator(previousAccrualTime, indexes)
-
-	totalSourceShares := k.getEarnTotalSourceShares(ctx, collateralType)
-
-	acc.AccumulateDecCoins(
-		periodStart,
-		periodEnd,
-		periodRewardsPerSecond,
-		totalSourceShares,
-		ctx.BlockTime(),
-	)
-
-	k.SetEarnRewardAccrualTime(ctx, collateralType, acc.PreviousAccumulationTime)
-	if len(acc.Indexes) > 0 {
-		// the store panics when setting empty or nil indexes
-		k.SetEarnRewardIndexes(ctx, collateralType, acc.Indexes)
-	}
-}
-
-// getEarnTotalSourceShares fetches the sum of all source shares for a earn reward.
-// In the case of earn, these are the total (earn module) shares in a particular vault.
-func (k Keeper) getEarnTotalSourceShares(ctx sdk.Context, vaultDenom string) sdk.Dec {
-	totalShares, found := k.earnKeeper.GetVaultTotalShares(ctx, vaultDenom)
-	if !found {
-		return sdk.ZeroDec()
-	}
-	return totalShares.Amount
-}
-
 // InitializeEarnReward creates a new claim with zero rewards and indexes matching the global indexes.
 // If the claim already exists it just updates the indexes.
 func (k Keeper) InitializeEarnReward(ctx sdk.Context, vaultDenom string, owner sdk.AccAddress) {
-	claim, found := k.GetEarnClaim(ctx, owner)
-	if !found {
-		claim = types.NewEarnClaim(owner, sdk.Coins{}, nil)
-	}
-
-	globalRewardIndexes, found := k.GetEarnRewardIndexes(ctx, vaultDenom)
-	if !found {
-		globalRewardIndexes = types.RewardIndexes{}
-	}
-	claim.RewardIndexes = claim.RewardIndexes.With(vaultDenom, globalRewardIndexes)
-
-	k.SetEarnClaim(ctx, claim)
+	k.InitializeSourceReward(ctx, types.EarnClaimType, vaultDenom, owner)
 }
 
 // SynchronizeEarnReward updates the claim object by adding any accumulated rewards
@@ -291,73 +242,14 @@ func (k Keeper) SynchronizeEarnReward(
 	owner sdk.AccAddress,
 	shares sdk.Dec,
 ) {
-	claim, found := k.GetEarnClaim(ctx, owner)
-	if !found {
-		return
-	}
-	claim = k.synchronizeEarnReward(ctx, claim, vaultDenom, owner, shares)
-
-	k.SetEarnClaim(ctx, claim)
-}
-
-// synchronizeEarnReward updates the reward and indexes in a earn claim for one vault.
-func (k *Keeper) synchronizeEarnReward(
-	ctx sdk.Context,
-	claim types.EarnClaim,
-	vaultDenom string,
-	owner sdk.AccAddress,
-	shares sdk.Dec,
-) types.EarnClaim {
-	globalRewardIndexes, found := k.GetEarnRewardIndexes(ctx, vaultDenom)
-	if !found {
-		// The global factor is only not found if
-		// - the vault has not started accumulating rewards yet (either there is no reward specified in params, or the reward start time hasn't been hit)
-		// - OR it was wrongly deleted from state (factors should never be removed while unsynced claims exist)
-		// If not found we could either skip this sync, or assume the global factor is zero.
-		// Skipping will avoid storing unnecessary factors in the claim for non rewarded vaults.
-		// And in the event a global factor is wrongly deleted, it will avoid this function panicking when calculating rewards.
-		return claim
-	}
-
-	userRewardIndexes, found := claim.RewardIndexes.Get(vaultDenom)
-	if !found {
-		// Normally the reward indexes should always be found.
-		// But if a vault was not rewarded then becomes rewarded (ie a reward period is added to params), then the indexes will be missing from claims for that vault.
-		// So given the reward period was just added, assume the starting value for any global reward indexes, which is an empty slice.
-		userRewardIndexes = types.RewardIndexes{}
-	}
-
-	newRewards, err := k.CalculateRewards(userRewardIndexes, globalRewardIndexes, shares)
-	if err != nil {
-		// Global reward factors should never decrease, as it would lead to a negative update to claim.Rewards.
-		// This panics if a global reward factor decreases or disappears between the old and new indexes.
-		panic(fmt.Sprintf("corrupted global reward indexes found: %v", err))
-	}
-
-	claim.Reward = claim.Reward.Add(newRewards...)
-	claim.RewardIndexes = claim.RewardIndexes.With(vaultDenom, globalRewardIndexes)
-
-	return claim
+	k.synchronizeSourceReward(ctx, types.EarnClaimType, vaultDenom, owner, shares)
 }
 
 // GetSynchronizedEarnClaim fetches a earn claim from the store and syncs rewards for all rewarded vaults.
 func (k Keeper) GetSynchronizedEarnClaim(ctx sdk.Context, owner sdk.AccAddress) (types.EarnClaim, bool) {
-	claim, found := k.GetEarnClaim(ctx, owner)
+	claim, found := k.GetSynchronizedSourceClaim(ctx, types.EarnClaimType, owner)
 	if !found {
 		return types.EarnClaim{}, false
 	}
-
-	shares, found := k.earnKeeper.GetVaultAccountShares(ctx, owner)
-	if !found {
-		shares = earntypes.NewVaultShares()
-	}
-
-	k.IterateEarnRewardIndexes(ctx, func(vaultDenom string, _ types.RewardIndexes) bool {
-		vaultAmount := shares.AmountOf(vaultDenom)
-		claim = k.synchronizeEarnReward(ctx, claim, vaultDenom, owner, vaultAmount)
-
-		return false
-	})
-
-	return claim, true
+	return types.NewEarnClaim(claim.Owner, claim.Reward, claim.RewardIndexes), true
 }
@@ -91,6 +91,61 @@ func (suite *AccumulateEarnRewardsTests) TestStateUpdatedWhenBlockTimeHasIncreas
 	})
 }
 
+func (suite *AccumulateEarnRewardsTests) TestStateUpdatedWhenBlockTimeHasIncreased_tokenizedShares() {
+	vaultDenom := "usdx"
+
+	// Half of the vault shares are tokenized, and earn rewards for the token
+	// holders
+	earnKeeper := newFakeEarnKeeper().
+		addVault(vaultDenom, earntypes.NewVaultShare(vaultDenom, d("2000000"))).
+		addShareTokens(arbitraryAddress(), earntypes.NewVaultShare(vaultDenom, d("1000000")))
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, nil, nil, nil, nil, earnKeeper)
+
+	suite.storeGlobalEarnIndexes(types.MultiRewardIndexes{
+		{
+			CollateralType: vaultDenom,
+			RewardIndexes: types.RewardIndexes{
+				{
+					CollateralType: "earn",
+					RewardFactor:   d("0.02"),
+				},
+				{
+					CollateralType: "ufury",
+					RewardFactor:   d("0.04"),
+				},
+			},
+		},
+	})
+	previousAccrualTime := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.keeper.SetEarnRewardAccrualTime(suite.ctx, vaultDenom, previousAccrualTime)
+
+	newAccrualTime := previousAccrualTime.Add(1 * time.Hour)
+	suite.ctx = suite.ctx.WithBlockTime(newAccrualTime)
+
+	period := types.NewMultiRewardPeriod(
+		true,
+		vaultDenom,
+		time.Unix(0, 0), // ensure the test is within start and end times
+		distantFuture,
+		cs(c("earn", 2000), c("ufury", 1000)), // same denoms as in global indexes
+	)
+
+	suite.keeper.AccumulateEarnRewards(suite.ctx, period)
+
+	// rewards are split over all 2000000 shares
+	suite.storedTimeEquals(vaultDenom, newAccrualTime)
+	suite.storedIndexesEqual(vaultDenom, types.RewardIndexes{
+		{
+			CollateralType: "earn",
+			RewardFactor:   d("3.62"),
+		},
+		{
+			CollateralType: "ufury",
+			RewardFactor:   d("1.84"),
+		},
+	})
+}
+
 func (suite *AccumulateEarnRewardsTests) TestStateUpdatedWhenBlockTimeHasIncreased_bfury() {
 	vaultDenom1 := "bfury-meow"
 	vaultDenom2 := "bfury-woof"
@@ -102,3 +102,50 @@ func (suite *AccumulateEarnRewardsTests) TestStakingRewardsDistributed() {
 		},
 	})
 }
+
+func (suite *AccumulateEarnRewardsTests) TestStakingRewardsNotDistributedWhenCompounding() {
+	vaultDenom := "bfury-meow"
+
+	previousAccrualTime := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.ctx = suite.ctx.WithBlockTime(previousAccrualTime)
+
+	// Staking rewards are restaked by the liquid module
+	liquidKeeper := newFakeLiquidKeeper().
+		addDerivative(suite.ctx, vaultDenom, i(800000)).
+		withCompounding()
+
+	earnKeeper := newFakeEarnKeeper().
+		addVault(vaultDenom, earntypes.NewVaultShare(vaultDenom, d("700000")))
+
+	suite.keeper = suite.NewKeeper(&fakeParamSubspace{}, nil, nil, nil, nil, nil, nil, nil, liquidKeeper, earnKeeper)
+
+	globalIndexes := types.MultiRewardIndexes{
+		{
+			CollateralType: vaultDenom,
+			RewardIndexes: types.RewardIndexes{
+				{
+					CollateralType: "ufury",
+					RewardFactor:   d("0.04"),
+				},
+			},
+		},
+	}
+
+	suite.storeGlobalEarnIndexes(globalIndexes)
+	suite.keeper.SetEarnRewardAccrualTime(suite.ctx, vaultDenom, previousAccrualTime)
+
+	newAccrualTime := previousAccrualTime.Add(1 * time.Hour)
+	suite.ctx = suite.ctx.WithBlockTime(newAccrualTime)
+
+	rewardPeriod := types.NewMultiRewardPeriod(
+		true,
+		"bfury",
+		time.Unix(0, 0),
+		distantFuture,
+		cs(),
+	)
+	suite.keeper.AccumulateEarnRewards(suite.ctx, rewardPeriod)
+
+	suite.storedTimeEquals(vaultDenom, newAccrualTime)
+	suite.storedIndexesEqual(vaultDenom, globalIndexes[0].RewardIndexes)
+}
@@ -1,8 +1,6 @@
 package keeper
 
 import (
-	"fmt"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/incubus-network/fury/x/incentive/types"
@@ -11,30 +9,8 @@ import (
 
 // AccumulateSavingsRewards calculates new rewards to distribute this block and updates the global indexes
 func (k Keeper) AccumulateSavingsRewards(ctx sdk.Context, rewardPeriod types.MultiRewardPeriod) {
-	previousAccrualTime, found := k.GetSavingsRewardAccrualTime(ctx, rewardPeriod.CollateralType)
-	if !found {
-		previousAccrualTime = ctx.BlockTime()
-	}
-
-	indexes, found := k.GetSavingsRewardIndexes(ctx, rewardPeriod.CollateralType)
-	if !found {
-		indexes = types.RewardIndexes{}
-	}
-
-	acc := types.NewAccumulator(previousAccrualTime, indexes)
-
-	savingsMacc := k.accountKeeper.GetModuleAccount(ctx, savingstypes.ModuleName)
-	maccCoins := k.bankKeeper.GetAllBalances(ctx, savingsMacc.GetAddress())
-	denomBalance := maccCoins.AmountOf(rewardPeriod.CollateralType)
-
-	acc.Accumulate(rewardPeriod, sdk.NewDecFromInt(denomBalance), ctx.BlockTime())
-
-	k.SetSavingsRewardAccrualTime(ctx, rewardPeriod.CollateralType, acc.PreviousAccumulationTime)
-
-	if len(acc.Indexes) > 0 {
-		// the store panics when setting empty or nil indexes
-		k.SetSavingsRewardIndexes(ctx, rewardPeriod.CollateralType, acc.Indexes)
-	}
+	// Locked deposits earn rewards on their amount scaled by the reward multiplier of their lockup term
+	k.AccumulateSourceRewards(ctx, types.SavingsClaimType, rewardPeriod)
 }
 
 // InitializeSavingsReward initializes a savings claim by creating the claim and
@@ -52,6 +28,7 @@ func (k Keeper) InitializeSavingsReward(ctx sdk.Context, deposit savingstypes.De
 			globalRewardIndexes = types.RewardIndexes{}
 		}
 		rewardIndexes = rewardIndexes.With(coin.Denom, globalRewardIndexes)
+		k.initializeRewardBoostStake(ctx, types.SavingsClaimType, coin.Denom, deposit.Depositor)
 	}
 	claim.RewardIndexes = rewardIndexes
 
@@ -78,7 +55,7 @@ func (k Keeper) SynchronizeSavingsReward(ctx sdk.Context, deposit savingstypes.D
 	// Existing denoms have their reward indexes + reward amount synced
 	existingDenoms := setDifference(getDenoms(deposit.Amount), incomingDenoms)
 	for _, denom := range existingDenoms {
-		claim = k.synchronizeSingleSavingsReward(ctx, claim, denom, sdk.NewDecFromInt(deposit.Amount.AmountOf(denom)))
+		claim = k.synchronizeSingleSavingsReward(ctx, claim, denom, deposit.SourceShares(denom))
 	}
 
 	k.SetSavingsClaim(ctx, claim)
@@ -88,36 +65,13 @@ func (k Keeper) SynchronizeSavingsReward(ctx sdk.Context, deposit savingstypes.D
 // It returns the claim without setting in the store.
 // The public methods for accessing and modifying claims are preferred over this one. Direct modification of claims is easy to get wrong.
 func (k Keeper) synchronizeSingleSavingsReward(ctx sdk.Context, claim types.SavingsClaim, denom string, sourceShares sdk.Dec) types.SavingsClaim {
-	globalRewardIndexes, found := k.GetSavingsRewardIndexes(ctx, denom)
-	if !found {
-		// The global factor is only not found if
-		// - the savings denom has not started accumulating rewards yet (either there is no reward specified in params, or the reward start time hasn't been hit)
-		// - OR it was wrongly deleted from state (factors should never be removed while unsynced claims exist)
-		// If not found we could either skip this sync, or assume the global factor is zero.
-		// Skipping will avoid storing unnecessary factors in the claim for non rewarded denoms.
-		// And in the event a global factor is wrongly deleted, it will avoid this function panicking when calculating rewards.
-		return claim
-	}
-
-	userRewardIndexes, found := claim.RewardIndexes.Get(denom)
-	if !found {
-		// Normally the reward indexes should always be found.
-		// But if a denom was not rewarded then becomes rewarded (ie a reward period is added to params), then the indexes will be missing from claims for that supplied denom.
-		// So given the reward period was just added, assume the starting value for any global reward indexes, which is an empty slice.
-		userRewardIndexes = types.RewardIndexes{}
-	}
-
-	newRewards, err := k.CalculateRewards(userRewardIndexes, globalRewardIndexes, sourceShares)
-	if err != nil {
-		// Global reward factors should never decrease, as it would lead to a negative update to claim.Rewards.
-		// This panics if a global reward factor decreases or disappears between the old and new indexes.
-		panic(fmt.Sprintf("corrupted global reward indexes found: %v", err))
-	}
-
-	claim.Reward = claim.Reward.Add(newRewards...)
-	claim.RewardIndexes = claim.RewardIndexes.With(denom, globalRewardIndexes)
-
-	return claim
+	syncedClaim := k.synchronizeSourceClaim(
+		ctx,
+		types.NewSourceClaim(types.SavingsClaimType, claim.Owner, claim.Reward, claim.RewardIndexes),
+		denom,
+		sourceShares,
+	)
+	return types.NewSavingsClaim(syncedClaim.Owner, syncedClaim.Reward, syncedClaim.RewardIndexes)
 }
 
 // GetSynchronizedSavingsClaim fetches a savings claim from the store and syncs rewards for all rewarded pools.
@@ -133,7 +87,7 @@ func (k Keeper) GetSynchronizedSavingsClaim(ctx sdk.Context, owner sdk.AccAddres
 	}
 
 	for _, coin := range deposit.Amount {
-		claim = k.synchronizeSingleSavingsReward(ctx, claim, coin.Denom, sdk.NewDecFromInt(coin.Amount))
+		claim = k.synchronizeSingleSavingsReward(ctx, claim, coin.Denom, deposit.SourceShares(coin.Denom))
 	}
 
 	return claim, true
@@ -125,6 +125,7 @@ func (suite *SavingsRewardsTestSuite) TestAccumulateSavingsRewards() {
 		suite.Run(tc.name, func() {
 			params := savingstypes.NewParams(
 				[]string{"ufury"},
+				nil,
 			)
 			deposits := savingstypes.Deposits{
 				savingstypes.NewDeposit(
@@ -132,7 +133,7 @@ func (suite *SavingsRewardsTestSuite) TestAccumulateSavingsRewards() {
 					sdk.NewCoins(tc.args.deposit),
 				),
 			}
-			savingsGenesis := savingstypes.NewGenesisState(params, deposits)
+			savingsGenesis := savingstypes.NewGenesisState(params, deposits, nil)
 
 			authBuilder := app.NewAuthBankGenesisBuilder().
 				WithSimpleAccount(suite.addrs[0], cs(c("ufury", 1e9))).
@@ -158,6 +159,43 @@ func (suite *SavingsRewardsTestSuite) TestAccumulateSavingsRewards() {
 	}
 }
 
+func (suite *SavingsRewardsTestSuite) TestAccumulateSavingsRewards_LockedDeposit() {
+	deposit := c("ufury", 1_000_000)
+	term := savingstypes.NewLockupTerm(90*24*time.Hour, d("2.0"), d("0.1"))
+
+	params := savingstypes.NewParams(
+		[]string{"ufury"},
+		savingstypes.LockupTerms{term},
+	)
+	lockedDeposit := savingstypes.NewDeposit(suite.addrs[0], sdk.NewCoins(deposit))
+	lockedDeposit.Locks = savingstypes.Locks{
+		savingstypes.NewLock(deposit, term, suite.genesisTime.Add(term.Duration)),
+	}
+	savingsGenesis := savingstypes.NewGenesisState(params, savingstypes.Deposits{lockedDeposit}, nil)
+
+	authBuilder := app.NewAuthBankGenesisBuilder().
+		WithSimpleAccount(suite.addrs[0], cs(c("ufury", 1e9))).
+		WithSimpleModuleAccount(savingstypes.ModuleName, sdk.NewCoins(deposit))
+
+	incentBuilder := testutil.NewIncentiveGenesisBuilder().
+		WithGenesisTime(suite.genesisTime).
+		WithSimpleSavingsRewardPeriod(deposit.Denom, cs(c("hard", 122354)))
+
+	suite.SetupWithGenState(authBuilder, incentBuilder, savingsGenesis)
+
+	runCtx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(7 * time.Second))
+
+	rewardPeriods, found := suite.keeper.GetSavingsRewardPeriods(runCtx, deposit.Denom)
+	suite.Require().True(found)
+	suite.keeper.AccumulateSavingsRewards(runCtx, rewardPeriods)
+
+	// The 2x multiplier doubles the source shares, halving the index increase
+	rewardIndexes, _ := suite.keeper.GetSavingsRewardIndexes(runCtx, deposit.Denom)
+	suite.Require().Equal(types.RewardIndexes{
+		types.NewRewardIndex("hard", d("0.428239000000000000")),
+	}, rewardIndexes)
+}
+
 func TestSavingsRewardsTestSuite(t *testing.T) {
 	suite.Run(t, new(SavingsRewardsTestSuite))
 }
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fury/x/incentive/types"
+)
+
+// AccumulateSourceRewards calculates new rewards to distribute this block for a source ID of a reward source and
+// updates the global indexes to reflect this. Reward periods of sources that are not registered are ignored.
+// The provided rewardPeriod must be valid to avoid panics in calculating time durations.
+func (k Keeper) AccumulateSourceRewards(ctx sdk.Context, source string, rewardPeriod types.MultiRewardPeriod) {
+	rewardSource, found := k.GetRewardSource(source)
+	if !found {
+		return
+	}
+
+	k.accumulateSourceRewards(ctx, source, rewardPeriod, rewardSource.TotalShares(ctx, rewardPeriod.CollateralType))
+}
+
+// accumulateSourceRewards accumulates rewards for a source ID of a reward source, distributed over the given total shares.
+func (k Keeper) accumulateSourceRewards(ctx sdk.Context, source string, rewardPeriod types.MultiRewardPeriod, totalSourceShares sdk.Dec) {
+	previousAccrualTime, found := k.GetSourceRewardAccrualTime(ctx, source, rewardPeriod.CollateralType)
+	if !found {
+		previousAccrualTime = ctx.BlockTime()
+	}
+
+	indexes, found := k.GetSourceRewardIndexes(ctx, source, rewardPeriod.CollateralType)
+	if !found {
+		indexes = types.RewardIndexes{}
+	}
+
+	acc := types.NewAccumulator(previousAccrualTime, indexes)
+
+	k.accumulateWithBudget(ctx, acc, source, rewardPeriod, totalSourceShares)
+
+	k.SetSourceRewardAccrualTime(ctx, source, rewardPeriod.CollateralType, acc.PreviousAccumulationTime)
+	if len(acc.Indexes) > 0 {
+		// the store panics when setting empty or nil indexes
+		k.SetSourceRewardIndexes(ctx, source, rewardPeriod.CollateralType, acc.Indexes)
+	}
+}
+
+// InitializeSourceReward creates a new claim of a reward source with zero rewards and indexes matching the global
+// indexes of a source ID. If the claim already exists it just updates the indexes.
+func (k Keeper) InitializeSourceReward(ctx sdk.Context, source, sourceID string, owner sdk.AccAddress) {
+	claim, found := k.GetSourceClaim(ctx, source, owner)
+	if !found {
+		claim = types.NewSourceClaim(source, owner, sdk.Coins{}, nil)
+	}
+
+	globalRewardIndexes, found := k.GetSourceRewardIndexes(ctx, source, sourceID)
+	if !found {
+		globalRewardIndexes = types.RewardIndexes{}
+	}
+	claim.RewardIndexes = claim.RewardIndexes.With(sourceID, globalRewardIndexes)
+
+	k.SetSourceClaim(ctx, claim)
+	k.initializeRewardBoostStake(ctx, source, sourceID, owner)
+}
+
+// SynchronizeSourceReward updates the claim of a registered reward source by adding the rewards accumulated on the
+// owner's current shares of a source ID, and updating the reward index value. It must be called before shares change.
+func (k Keeper) SynchronizeSourceReward(ctx sdk.Context, source, sourceID string, owner sdk.AccAddress) {
+	rewardSource, found := k.GetRewardSource(source)
+	if !found {
+		panic(fmt.Sprintf("reward source %s is not registered", source))
+	}
+
+	k.synchronizeSourceReward(ctx, source, sourceID, owner, rewardSource.OwnerShares(ctx, owner, sourceID))
+}
+
+// synchronizeSourceReward updates the stored claim of a reward source for one source ID using the given shares.
+func (k Keeper) synchronizeSourceReward(ctx sdk.Context, source, sourceID string, owner sdk.AccAddress, shares sdk.Dec) {
+	claim, found := k.GetSourceClaim(ctx, source, owner)
+	if !found {
+		return
+	}
+	claim = k.synchronizeSourceClaim(ctx, claim, sourceID, shares)
+
+	k.SetSourceClaim(ctx, claim)
+}
+
+// synchronizeSourceClaim updates the reward and indexes in a reward source claim for one source ID.
+func (k Keeper) synchronizeSourceClaim(ctx sdk.Context, claim types.SourceClaim, sourceID string, shares sdk.Dec) types.SourceClaim {
+	globalRewardIndexes, found := k.GetSourceRewardIndexes(ctx, claim.Source, sourceID)
+	if !found {
+		// The global factor is only not found if
+		// - the source ID has not started accumulating rewards yet (either there is no reward specified in params, or the reward start time hasn't been hit)
+		// - OR it was wrongly deleted from state (factors should never be removed while unsynced claims exist)
+		// If not found we could either skip this sync, or assume the global factor is zero.
+		// Skipping will avoid storing unnecessary factors in the claim for non rewarded source IDs.
+		// And in the event a global factor is wrongly deleted, it will avoid this function panicking when calculating rewards.
+		return claim
+	}
+
+	userRewardIndexes, found := claim.RewardIndexes.Get(sourceID)
+	if !found {
+		// Normally the reward indexes should always be found.
+		// But if a source ID was not rewarded then becomes rewarded (ie a reward period is added to params), then the indexes will be missing from claims for that source ID.
+		// So given the reward period was just added, assume the starting value for any global reward indexes, which is an empty slice.
+		userRewardIndexes = types.RewardIndexes{}
+	}
+
+	newRewards, err := k.CalculateRewards(userRewardIndexes, globalRewardIndexes, shares)
+	if err != nil {
+		// Global reward factors should never decrease, as it would lead to a negative update to claim.Rewards.
+		// This panics if a global reward factor decreases or disappears between the old and new indexes.
+		panic(fmt.Sprintf("corrupted global reward indexes found: %v", err))
+	}
+
+	newRewards = k.boostRewards(ctx, claim.Source, sourceID, claim.Owner, newRewards)
+
+	claim.Reward = claim.Reward.Add(newRewards...)
+	claim.RewardIndexes = claim.RewardIndexes.With(sourceID, globalRewardIndexes)
+
+	return claim
+}
+
+// GetSynchronizedSourceClaim fetches a reward source claim from the store and syncs rewards for all rewarded source IDs.
+// Claims of sources that are no longer registered are returned unsynchronized.
+func (k Keeper) GetSynchronizedSourceClaim(ctx sdk.Context, source string, owner sdk.AccAddress) (types.SourceClaim, bool) {
+	claim, found := k.GetSourceClaim(ctx, source, owner)
+	if !found {
+		return types.SourceClaim{}, false
+	}
+
+	rewardSource, found := k.GetRewardSource(source)
+	if !found {
+		return claim, true
+	}
+
+	k.IterateSourceRewardIndexes(ctx, source, func(sourceID string, _ types.RewardIndexes) bool {
+		claim = k.synchronizeSourceClaim(ctx, claim, sourceID, rewardSource.OwnerShares(ctx, owner, sourceID))
+
+		return false
+	})
+
+	return claim, true
+}
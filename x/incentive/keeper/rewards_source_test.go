@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/incubus-network/fury/x/incentive/types"
+)
+
+const testRewardSource = "lending"
+
+// fakeRewardSource is a stub reward source with fixed shares.
+type fakeRewardSource struct {
+	totalShares map[string]sdk.Dec
+	ownerShares map[string]sdk.Dec
+}
+
+var _ types.RewardSource = newFakeRewardSource()
+
+func newFakeRewardSource() *fakeRewardSource {
+	return &fakeRewardSource{
+		totalShares: map[string]sdk.Dec{},
+		ownerShares: map[string]sdk.Dec{},
+	}
+}
+
+func (s *fakeRewardSource) addShares(sourceID string, owner sdk.AccAddress, shares sdk.Dec) *fakeRewardSource {
+	total, found := s.totalShares[sourceID]
+	if !found {
+		total = sdk.ZeroDec()
+	}
+	s.totalShares[sourceID] = total.Add(shares)
+	s.ownerShares[sourceID+owner.String()] = shares
+	return s
+}
+
+func (s *fakeRewardSource) TotalShares(_ sdk.Context, sourceID string) sdk.Dec {
+	total, found := s.totalShares[sourceID]
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return total
+}
+
+func (s *fakeRewardSource) OwnerShares(_ sdk.Context, owner sdk.AccAddress, sourceID string) sdk.Dec {
+	shares, found := s.ownerShares[sourceID+owner.String()]
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return shares
+}
+
+type SourceRewardsTests struct {
+	unitTester
+}
+
+func TestSourceRewards(t *testing.T) {
+	suite.Run(t, new(SourceRewardsTests))
+}
+
+func (suite *SourceRewardsTests) TestRegisterRewardSource() {
+	suite.keeper.RegisterRewardSource(testRewardSource, newFakeRewardSource())
+
+	_, found := suite.keeper.GetRewardSource(testRewardSource)
+	suite.True(found)
+	suite.Equal(
+		append(append([]string{}, types.NativeRewardSources...), testRewardSource),
+		suite.keeper.GetRewardSourceNames(),
+	)
+
+	suite.Panics(func() {
+		suite.keeper.RegisterRewardSource(testRewardSource, newFakeRewardSource())
+	}, "duplicate source names should panic")
+	suite.Panics(func() {
+		suite.keeper.RegisterRewardSource(types.SwapClaimType, newFakeRewardSource())
+	}, "native source names should panic")
+	suite.Panics(func() {
+		suite.keeper.RegisterRewardSource(types.USDXMintingClaimType, newFakeRewardSource())
+	}, "dedicated claim types should panic")
+}
+
+func (suite *SourceRewardsTests) TestAccumulateUpdatesIndexesOfRegisteredSource() {
+	pool := "pool-1"
+	suite.keeper.RegisterRewardSource(testRewardSource, newFakeRewardSource().addShares(pool, arbitraryAddress(), d("1000000")))
+
+	suite.keeper.SetSourceRewardIndexes(suite.ctx, testRewardSource, pool, types.RewardIndexes{
+		types.NewRewardIndex("swap", d("0.02")),
+		types.NewRewardIndex("ufury", d("0.04")),
+	})
+	previousAccrualTime := time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.keeper.SetSourceRewardAccrualTime(suite.ctx, testRewardSource, pool, previousAccrualTime)
+
+	newAccrualTime := previousAccrualTime.Add(1 * time.Hour)
+	suite.ctx = suite.ctx.WithBlockTime(newAccrualTime)
+
+	period := types.NewMultiRewardPeriod(true, pool, time.Unix(0, 0), distantFuture, cs(c("swap", 2000), c("ufury", 1000)))
+	suite.keeper.AccumulateSourceRewards(suite.ctx, testRewardSource, period)
+
+	storedTime, found := suite.keeper.GetSourceRewardAccrualTime(suite.ctx, testRewardSource, pool)
+	suite.True(found)
+	suite.Equal(newAccrualTime, storedTime)
+
+	storedIndexes, found := suite.keeper.GetSourceRewardIndexes(suite.ctx, testRewardSource, pool)
+	suite.True(found)
+	suite.Equal(types.RewardIndexes{
+		types.NewRewardIndex("swap", d("7.22")),
+		types.NewRewardIndex("ufury", d("3.64")),
+	}, storedIndexes)
+}
+
+func (suite *SourceRewardsTests) TestAccumulateIgnoresUnregisteredSource() {
+	suite.ctx = suite.ctx.WithBlockTime(time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	period := types.NewMultiRewardPeriod(true, "pool-1", time.Unix(0, 0), distantFuture, cs(c("swap", 2000)))
+	suite.keeper.AccumulateSourceRewards(suite.ctx, testRewardSource, period)
+
+	_, found := suite.keeper.GetSourceRewardAccrualTime(suite.ctx, testRewardSource, "pool-1")
+	suite.False(found)
+}
+
+func (suite *SourceRewardsTests) TestSynchronizeAddsRewardsOnOwnerShares() {
+	pool := "pool-1"
+	owner := arbitraryAddress()
+	suite.keeper.RegisterRewardSource(testRewardSource, newFakeRewardSource().addShares(pool, owner, d("1000")))
+
+	suite.keeper.SetSourceRewardIndexes(suite.ctx, testRewardSource, pool, types.RewardIndexes{
+		types.NewRewardIndex("swap", d("0.1")),
+	})
+	suite.keeper.InitializeSourceReward(suite.ctx, testRewardSource, pool, owner)
+
+	suite.keeper.SetSourceRewardIndexes(suite.ctx, testRewardSource, pool, types.RewardIndexes{
+		types.NewRewardIndex("swap", d("0.5")),
+	})
+
+	// the synchronized claim is not stored
+	syncedClaim, found := suite.keeper.GetSynchronizedSourceClaim(suite.ctx, testRewardSource, owner)
+	suite.True(found)
+	suite.Equal(cs(c("swap", 400)), syncedClaim.Reward)
+	storedClaim, found := suite.keeper.GetSourceClaim(suite.ctx, testRewardSource, owner)
+	suite.True(found)
+	suite.Empty(storedClaim.Reward)
+
+	suite.keeper.SynchronizeSourceReward(suite.ctx, testRewardSource, pool, owner)
+
+	storedClaim, found = suite.keeper.GetSourceClaim(suite.ctx, testRewardSource, owner)
+	suite.True(found)
+	suite.Equal(syncedClaim, storedClaim)
+	suite.Equal(types.MultiRewardIndexes{
+		types.NewMultiRewardIndex(pool, types.RewardIndexes{types.NewRewardIndex("swap", d("0.5"))}),
+	}, storedClaim.RewardIndexes)
+}
+
+func (suite *SourceRewardsTests) TestSynchronizePanicsForUnregisteredSource() {
+	suite.Panics(func() {
+		suite.keeper.SynchronizeSourceReward(suite.ctx, testRewardSource, "pool-1", arbitraryAddress())
+	})
+}
@@ -26,7 +26,7 @@ func (k Keeper) AccumulateHardSupplyRewards(ctx sdk.Context, rewardPeriod types.
 
 	totalSource := k.getHardSupplyTotalSourceShares(ctx, rewardPeriod.CollateralType)
 
-	acc.Accumulate(rewardPeriod, totalSource, ctx.BlockTime())
+	k.accumulateWithBudget(ctx, acc, types.HardSupplyClaimType, rewardPeriod, totalSource)
 
 	k.SetPreviousHardSupplyRewardAccrualTime(ctx, rewardPeriod.CollateralType, acc.PreviousAccumulationTime)
 	if len(acc.Indexes) > 0 {
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fury/x/incentive/types"
+)
+
+// RegisterRewardSource registers a module as a source of rewards under a name. Rewards for the source are set by the
+// SourceRewardPeriods param, and the module must call the RewardSourceHooks when owners' shares change.
+// Sources must be registered when the app is constructed, before any blocks are processed.
+func (k Keeper) RegisterRewardSource(name string, source types.RewardSource) {
+	if err := types.ValidateRewardSource(name); err != nil {
+		panic(err)
+	}
+	if _, found := k.sources[name]; found {
+		panic(fmt.Sprintf("reward source %s already registered", name))
+	}
+	k.sources[name] = source
+}
+
+// GetRewardSource returns a registered reward source by name.
+func (k Keeper) GetRewardSource(name string) (types.RewardSource, bool) {
+	source, found := k.sources[name]
+	return source, found
+}
+
+// GetRewardSourceNames returns the names of the native reward sources and all registered reward sources. Native sources
+// come first in their fixed order, followed by the other sources sorted by name.
+func (k Keeper) GetRewardSourceNames() []string {
+	var registered []string
+	for name := range k.sources {
+		if !types.IsNativeRewardSource(name) {
+			registered = append(registered, name)
+		}
+	}
+	sort.Strings(registered)
+
+	return append(append([]string{}, types.NativeRewardSources...), registered...)
+}
+
+// registerNativeRewardSources registers the native sources that are synchronized through the shared claim path.
+// USDX minting and hard claims are stored as source claims, but are synchronized by their own hooks and claimed with
+// their own messages, so they are not registered.
+func (k Keeper) registerNativeRewardSources() {
+	k.sources[types.DelegatorClaimType] = delegatorRewardSource{stakingKeeper: k.stakingKeeper}
+	k.sources[types.SwapClaimType] = swapRewardSource{swapKeeper: k.swapKeeper}
+	k.sources[types.SavingsClaimType] = savingsRewardSource{savingsKeeper: k.savingsKeeper}
+	k.sources[types.EarnClaimType] = earnRewardSource{earnKeeper: k.earnKeeper}
+}
+
+// delegatorRewardSource rewards tokens delegated to bonded validators.
+type delegatorRewardSource struct {
+	stakingKeeper types.StakingKeeper
+}
+
+func (s delegatorRewardSource) TotalShares(ctx sdk.Context, _ string) sdk.Dec {
+	return sdk.NewDecFromInt(s.stakingKeeper.TotalBondedTokens(ctx))
+}
+
+func (s delegatorRewardSource) OwnerShares(ctx sdk.Context, owner sdk.AccAddress, _ string) sdk.Dec {
+	return getTotalDelegated(ctx, s.stakingKeeper, owner, nil, false)
+}
+
+// swapRewardSource rewards the shares depositors hold in a swap pool.
+type swapRewardSource struct {
+	swapKeeper types.SwapKeeper
+}
+
+func (s swapRewardSource) TotalShares(ctx sdk.Context, poolID string) sdk.Dec {
+	totalShares, found := s.swapKeeper.GetPoolShares(ctx, poolID)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecFromInt(totalShares)
+}
+
+func (s swapRewardSource) OwnerShares(ctx sdk.Context, owner sdk.AccAddress, poolID string) sdk.Dec {
+	shares, found := s.swapKeeper.GetDepositorSharesAmount(ctx, owner, poolID)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecFromInt(shares)
+}
+
+// savingsRewardSource rewards savings deposits, scaled by the reward multiplier of their lockup term.
+type savingsRewardSource struct {
+	savingsKeeper types.SavingsKeeper
+}
+
+func (s savingsRewardSource) TotalShares(ctx sdk.Context, denom string) sdk.Dec {
+	return s.savingsKeeper.GetTotalSourceShares(ctx, denom)
+}
+
+func (s savingsRewardSource) OwnerShares(ctx sdk.Context, owner sdk.AccAddress, denom string) sdk.Dec {
+	deposit, found := s.savingsKeeper.GetDeposit(ctx, owner)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return deposit.SourceShares(denom)
+}
+
+// earnRewardSource rewards the shares depositors hold in an earn vault, including the shares of the share tokens they
+// hold.
+type earnRewardSource struct {
+	earnKeeper types.EarnKeeper
+}
+
+func (s earnRewardSource) TotalShares(ctx sdk.Context, vaultDenom string) sdk.Dec {
+	totalShares, found := s.earnKeeper.GetVaultTotalShares(ctx, vaultDenom)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return totalShares.Amount
+}
+
+func (s earnRewardSource) OwnerShares(ctx sdk.Context, owner sdk.AccAddress, vaultDenom string) sdk.Dec {
+	return s.earnKeeper.GetVaultAccountRewardShares(ctx, owner, vaultDenom)
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"bytes"
 	"fmt"
 	"strings"
 	"time"
@@ -81,7 +82,7 @@ func (suite *unitTester) NewKeeper(
 	return keeper.NewKeeper(
 		suite.cdc, suite.incentiveStoreKey, paramSubspace,
 		bk, cdpk, hk, ak, stk, swk, svk, lqk, ek,
-		nil, nil, nil,
+		nil, nil, nil, nil,
 	)
 }
 
@@ -154,6 +155,7 @@ type TestKeeperBuilder struct {
 	savingsKeeper types.SavingsKeeper
 	liquidKeeper  types.LiquidKeeper
 	earnKeeper    types.EarnKeeper
+	routerKeeper  types.RouterKeeper
 
 	// Keepers used for APY queries
 	mintKeeper      types.MintKeeper
@@ -181,6 +183,7 @@ func (suite *unitTester) NewTestKeeper(
 		savingsKeeper:   nil,
 		liquidKeeper:    nil,
 		earnKeeper:      nil,
+		routerKeeper:    nil,
 		mintKeeper:      nil,
 		distrKeeper:     nil,
 		pricefeedKeeper: nil,
@@ -227,7 +230,7 @@ func (tk *TestKeeperBuilder) Build() keeper.Keeper {
 		tk.cdc, tk.key, tk.paramSubspace,
 		tk.bankKeeper, tk.cdpKeeper, tk.hardKeeper, tk.accountKeeper,
 		tk.stakingKeeper, tk.swapKeeper, tk.savingsKeeper, tk.liquidKeeper,
-		tk.earnKeeper, tk.mintKeeper, tk.distrKeeper, tk.pricefeedKeeper,
+		tk.earnKeeper, tk.routerKeeper, tk.mintKeeper, tk.distrKeeper, tk.pricefeedKeeper,
 	)
 }
 
@@ -240,10 +243,21 @@ func (subspace *fakeParamSubspace) GetParamSet(_ sdk.Context, ps paramtypes.Para
 	*(ps.(*types.Params)) = subspace.params
 }
 
+func (subspace *fakeParamSubspace) GetIfExists(_ sdk.Context, key []byte, ptr interface{}) {
+	// only the reward boost is read individually
+	if bytes.Equal(key, types.KeyRewardBoost) {
+		*(ptr.(*types.RewardBoost)) = subspace.params.RewardBoost
+	}
+}
+
 func (subspace *fakeParamSubspace) SetParamSet(_ sdk.Context, ps paramtypes.ParamSet) {
 	subspace.params = *(ps.(*types.Params))
 }
 
+func (subspace *fakeParamSubspace) Set(_ sdk.Context, _ []byte, _ interface{}) {
+	// individual params are only set by store migrations, which are not run against the fake subspace
+}
+
 func (subspace *fakeParamSubspace) HasKeyTable() bool {
 	// return true so the keeper does not try to call WithKeyTable, which does nothing
 	return true
@@ -471,6 +485,7 @@ func (k *fakeCDPKeeper) GetCollateral(_ sdk.Context, collateralType string) (cdp
 type fakeEarnKeeper struct {
 	vaultShares   map[string]earntypes.VaultShare
 	depositShares map[string]earntypes.VaultShares
+	tokenShares   map[string]earntypes.VaultShares
 }
 
 var _ types.EarnKeeper = newFakeEarnKeeper()
@@ -479,6 +494,7 @@ func newFakeEarnKeeper() *fakeEarnKeeper {
 	return &fakeEarnKeeper{
 		vaultShares:   map[string]earntypes.VaultShare{},
 		depositShares: map[string]earntypes.VaultShares{},
+		tokenShares:   map[string]earntypes.VaultShares{},
 	}
 }
 
@@ -487,6 +503,16 @@ func (k *fakeEarnKeeper) addVault(vaultDenom string, shares earntypes.VaultShare
 	return k
 }
 
+func (k *fakeEarnKeeper) addShareTokens(holder sdk.AccAddress, shares earntypes.VaultShare) *fakeEarnKeeper {
+	if k.tokenShares[holder.String()] == nil {
+		k.tokenShares[holder.String()] = earntypes.NewVaultShares()
+	}
+
+	k.tokenShares[holder.String()] = k.tokenShares[holder.String()].Add(shares)
+
+	return k
+}
+
 func (k *fakeEarnKeeper) addDeposit(
 	depositor sdk.AccAddress,
 	shares earntypes.VaultShare,
@@ -517,12 +543,8 @@ func (k *fakeEarnKeeper) GetVaultTotalValue(ctx sdk.Context, denom string) (sdk.
 	return sdk.NewCoin(denom, vaultShares.Amount.RoundInt()), nil
 }
 
-func (k *fakeEarnKeeper) GetVaultAccountShares(
-	ctx sdk.Context,
-	acc sdk.AccAddress,
-) (shares earntypes.VaultShares, found bool) {
-	accShares, found := k.depositShares[acc.String()]
-	return accShares, found
+func (k *fakeEarnKeeper) GetVaultAccountRewardShares(ctx sdk.Context, acc sdk.AccAddress, denom string) sdk.Dec {
+	return k.depositShares[acc.String()].AmountOf(denom).Add(k.tokenShares[acc.String()].AmountOf(denom))
 }
 
 func (k *fakeEarnKeeper) IterateVaultRecords(
@@ -536,11 +558,25 @@ func (k *fakeEarnKeeper) IterateVaultRecords(
 	}
 }
 
+func (k *fakeEarnKeeper) GetAllowedVault(ctx sdk.Context, vaultDenom string) (earntypes.AllowedVault, bool) {
+	return earntypes.AllowedVault{}, false
+}
+
+func (k *fakeEarnKeeper) Deposit(
+	ctx sdk.Context,
+	depositor sdk.AccAddress,
+	amount sdk.Coin,
+	depositStrategy earntypes.StrategyType,
+) error {
+	return earntypes.ErrInvalidVaultDenom
+}
+
 // fakeLiquidKeeper is a stub liquid keeper.
 // It can be used to return values to the incentive keeper without having to initialize a full liquid keeper.
 type fakeLiquidKeeper struct {
 	derivatives     map[string]sdkmath.Int
 	lastRewardClaim map[string]time.Time
+	compounding     bool
 }
 
 var _ types.LiquidKeeper = newFakeLiquidKeeper()
@@ -562,6 +598,15 @@ func (k *fakeLiquidKeeper) addDerivative(
 	return k
 }
 
+func (k *fakeLiquidKeeper) withCompounding() *fakeLiquidKeeper {
+	k.compounding = true
+	return k
+}
+
+func (k *fakeLiquidKeeper) IsCompoundingEnabled(ctx sdk.Context) bool {
+	return k.compounding
+}
+
 func (k *fakeLiquidKeeper) IsDerivativeDenom(ctx sdk.Context, denom string) bool {
 	return strings.HasPrefix(denom, "bfury-")
 }
@@ -719,6 +764,15 @@ func (k *fakeBankKeeper) SendCoinsFromModuleToAccount(
 	panic("not implemented")
 }
 
+func (k *fakeBankKeeper) SendCoinsFromModuleToModule(
+	ctx sdk.Context,
+	senderModule string,
+	recipientModule string,
+	amt sdk.Coins,
+) error {
+	panic("not implemented")
+}
+
 func (k *fakeBankKeeper) GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
 	panic("not implemented")
 }
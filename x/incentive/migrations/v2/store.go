@@ -0,0 +1,131 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fury/x/incentive/types"
+)
+
+// legacySourcePrefixes are the v1 store prefixes of the claims, reward indexes and accrual times of each native
+// reward source.
+var legacySourcePrefixes = []struct {
+	source              string
+	claimPrefix         []byte
+	rewardIndexesPrefix []byte
+	accrualTimePrefix   []byte
+	unmarshalClaim      func(cdc codec.BinaryCodec, bz []byte) types.SourceClaim
+}{
+	{
+		source:              types.DelegatorClaimType,
+		claimPrefix:         types.DelegatorClaimKeyPrefix,
+		rewardIndexesPrefix: types.DelegatorRewardIndexesKeyPrefix,
+		accrualTimePrefix:   types.PreviousDelegatorRewardAccrualTimeKeyPrefix,
+		unmarshalClaim: func(cdc codec.BinaryCodec, bz []byte) types.SourceClaim {
+			var c types.DelegatorClaim
+			cdc.MustUnmarshal(bz, &c)
+			return types.NewSourceClaim(types.DelegatorClaimType, c.Owner, c.Reward, c.RewardIndexes)
+		},
+	},
+	{
+		source:              types.SwapClaimType,
+		claimPrefix:         types.SwapClaimKeyPrefix,
+		rewardIndexesPrefix: types.SwapRewardIndexesKeyPrefix,
+		accrualTimePrefix:   types.PreviousSwapRewardAccrualTimeKeyPrefix,
+		unmarshalClaim: func(cdc codec.BinaryCodec, bz []byte) types.SourceClaim {
+			var c types.SwapClaim
+			cdc.MustUnmarshal(bz, &c)
+			return types.NewSourceClaim(types.SwapClaimType, c.Owner, c.Reward, c.RewardIndexes)
+		},
+	},
+	{
+		source:              types.SavingsClaimType,
+		claimPrefix:         types.SavingsClaimKeyPrefix,
+		rewardIndexesPrefix: types.SavingsRewardIndexesKeyPrefix,
+		accrualTimePrefix:   types.PreviousSavingsRewardAccrualTimeKeyPrefix,
+		unmarshalClaim: func(cdc codec.BinaryCodec, bz []byte) types.SourceClaim {
+			var c types.SavingsClaim
+			cdc.MustUnmarshal(bz, &c)
+			return types.NewSourceClaim(types.SavingsClaimType, c.Owner, c.Reward, c.RewardIndexes)
+		},
+	},
+	{
+		source:              types.EarnClaimType,
+		claimPrefix:         types.EarnClaimKeyPrefix,
+		rewardIndexesPrefix: types.EarnRewardIndexesKeyPrefix,
+		accrualTimePrefix:   types.PreviousEarnRewardAccrualTimeKeyPrefix,
+		unmarshalClaim: func(cdc codec.BinaryCodec, bz []byte) types.SourceClaim {
+			var c types.EarnClaim
+			cdc.MustUnmarshal(bz, &c)
+			return types.NewSourceClaim(types.EarnClaimType, c.Owner, c.Reward, c.RewardIndexes)
+		},
+	},
+}
+
+// MigrateStore performs in-place store migrations for consensus version 2
+// V2 moves the claims, reward indexes and accrual times of the delegator, swap, savings and earn claim types into the
+// reward source store, and adds the SourceRewardPeriods param.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, paramstore types.ParamSubspace) error {
+	migrateParamsStore(ctx, paramstore)
+
+	store := ctx.KVStore(storeKey)
+	for _, legacy := range legacySourcePrefixes {
+		migrateClaims(store, cdc, legacy.source, legacy.claimPrefix, legacy.unmarshalClaim)
+		migrateValues(store, legacy.rewardIndexesPrefix, types.SourceRewardIndexesKeyPrefix, legacy.source)
+		migrateValues(store, legacy.accrualTimePrefix, types.PreviousSourceRewardAccrualTimeKeyPrefix, legacy.source)
+	}
+	return nil
+}
+
+// migrateParamsStore sets the SourceRewardPeriods param to its default value
+func migrateParamsStore(ctx sdk.Context, paramstore types.ParamSubspace) {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+	paramstore.Set(ctx, types.KeySourceRewardPeriods, types.DefaultSourceRewardPeriods)
+}
+
+// migrateClaims converts the typed claims stored under a legacy prefix into source claims
+func migrateClaims(
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	source string,
+	legacyPrefix []byte,
+	unmarshalClaim func(codec.BinaryCodec, []byte) types.SourceClaim,
+) {
+	legacyStore := prefix.NewStore(store, legacyPrefix)
+	newStore := prefix.NewStore(prefix.NewStore(store, types.SourceClaimKeyPrefix), types.GetSourceKeyPrefix(source))
+
+	iterator := sdk.KVStorePrefixIterator(legacyStore, []byte{})
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		claim := unmarshalClaim(cdc, iterator.Value())
+		newStore.Set(iterator.Key(), cdc.MustMarshal(&claim))
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		legacyStore.Delete(key)
+	}
+}
+
+// migrateValues moves the values stored under a legacy prefix to a source store, keeping their encoding
+func migrateValues(store sdk.KVStore, legacyPrefix, newPrefix []byte, source string) {
+	legacyStore := prefix.NewStore(store, legacyPrefix)
+	newStore := prefix.NewStore(prefix.NewStore(store, newPrefix), types.GetSourceKeyPrefix(source))
+
+	iterator := sdk.KVStorePrefixIterator(legacyStore, []byte{})
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		newStore.Set(iterator.Key(), iterator.Value())
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		legacyStore.Delete(key)
+	}
+}
@@ -0,0 +1,72 @@
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	v2incentive "github.com/incubus-network/fury/x/incentive/migrations/v2"
+	"github.com/incubus-network/fury/x/incentive/types"
+)
+
+func TestStoreMigrationMovesNativeSourceState(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	types.RegisterInterfaces(encCfg.InterfaceRegistry)
+	incentiveKey := sdk.NewKVStoreKey(types.ModuleName)
+	tIncentiveKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(incentiveKey, tIncentiveKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, incentiveKey, tIncentiveKey, types.ModuleName)
+	paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	cdc := encCfg.Codec
+
+	owner := sdk.AccAddress("owner_______________")
+	indexes := types.RewardIndexes{types.NewRewardIndex("swp", sdk.MustNewDecFromStr("0.1"))}
+	claim := types.NewSwapClaim(
+		owner,
+		sdk.NewCoins(sdk.NewInt64Coin("swp", 100)),
+		types.MultiRewardIndexes{types.NewMultiRewardIndex("busd:ufury", indexes)},
+	)
+	accrualTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	accrualTimeBz, err := accrualTime.MarshalBinary()
+	require.NoError(t, err)
+	indexesBz := cdc.MustMarshal(&types.RewardIndexesProto{RewardIndexes: indexes})
+
+	store := ctx.KVStore(incentiveKey)
+	prefix.NewStore(store, types.SwapClaimKeyPrefix).Set(owner, cdc.MustMarshal(&claim))
+	prefix.NewStore(store, types.SwapRewardIndexesKeyPrefix).Set([]byte("busd:ufury"), indexesBz)
+	prefix.NewStore(store, types.PreviousSwapRewardAccrualTimeKeyPrefix).Set([]byte("busd:ufury"), accrualTimeBz)
+
+	require.False(t, paramstore.Has(ctx, types.KeySourceRewardPeriods))
+
+	err = v2incentive.MigrateStore(ctx, incentiveKey, cdc, paramstore)
+	require.NoError(t, err)
+
+	var periods types.SourceMultiRewardPeriods
+	paramstore.Get(ctx, types.KeySourceRewardPeriods, &periods)
+	require.Empty(t, periods)
+
+	sourcePrefix := types.GetSourceKeyPrefix(types.SwapClaimType)
+
+	var sourceClaim types.SourceClaim
+	bz := prefix.NewStore(prefix.NewStore(store, types.SourceClaimKeyPrefix), sourcePrefix).Get(owner)
+	require.NotNil(t, bz)
+	cdc.MustUnmarshal(bz, &sourceClaim)
+	require.Equal(t, types.NewSourceClaim(types.SwapClaimType, owner, claim.Reward, claim.RewardIndexes), sourceClaim)
+
+	bz = prefix.NewStore(prefix.NewStore(store, types.SourceRewardIndexesKeyPrefix), sourcePrefix).Get([]byte("busd:ufury"))
+	require.Equal(t, indexesBz, bz)
+	bz = prefix.NewStore(prefix.NewStore(store, types.PreviousSourceRewardAccrualTimeKeyPrefix), sourcePrefix).Get([]byte("busd:ufury"))
+	require.Equal(t, accrualTimeBz, bz)
+
+	// legacy state is removed
+	require.Nil(t, prefix.NewStore(store, types.SwapClaimKeyPrefix).Get(owner))
+	require.Nil(t, prefix.NewStore(store, types.SwapRewardIndexesKeyPrefix).Get([]byte("busd:ufury")))
+	require.Nil(t, prefix.NewStore(store, types.PreviousSwapRewardAccrualTimeKeyPrefix).Get([]byte("busd:ufury")))
+}
@@ -0,0 +1,22 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fury/x/incentive/types"
+)
+
+// MigrateStore performs in-place store migrations for consensus version 3
+// V3 adds the RewardBoost param.
+func MigrateStore(ctx sdk.Context, paramstore types.ParamSubspace) error {
+	migrateParamsStore(ctx, paramstore)
+	return nil
+}
+
+// migrateParamsStore sets the RewardBoost param to its default value
+func migrateParamsStore(ctx sdk.Context, paramstore types.ParamSubspace) {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+	paramstore.Set(ctx, types.KeyRewardBoost, types.DefaultRewardBoost)
+}
@@ -0,0 +1,35 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	v3incentive "github.com/incubus-network/fury/x/incentive/migrations/v3"
+	"github.com/incubus-network/fury/x/incentive/types"
+)
+
+func TestStoreMigrationAddsRewardBoostParam(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	incentiveKey := sdk.NewKVStoreKey(types.ModuleName)
+	tIncentiveKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(incentiveKey, tIncentiveKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, incentiveKey, tIncentiveKey, types.ModuleName)
+	paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+
+	require.False(t, paramstore.Has(ctx, types.KeyRewardBoost))
+
+	err := v3incentive.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	require.True(t, paramstore.Has(ctx, types.KeyRewardBoost))
+	var boost types.RewardBoost
+	paramstore.Get(ctx, types.KeyRewardBoost, &boost)
+	require.Equal(t, types.DefaultRewardBoost, boost)
+	require.False(t, boost.IsEnabled())
+}
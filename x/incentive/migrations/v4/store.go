@@ -0,0 +1,95 @@
+package v4
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fury/x/incentive/types"
+)
+
+// MigrateStore performs in-place store migrations for consensus version 4
+// V4 moves the USDX minting and hard claims, reward indexes and accrual times into the reward source store. Hard
+// claims are split into a hard supply and a hard borrow source claim.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	migrateUSDXMintingClaims(store, cdc)
+	migrateUSDXMintingRewardFactors(store, cdc)
+	migrateValues(store, types.PreviousUSDXMintingRewardAccrualTimeKeyPrefix, types.PreviousSourceRewardAccrualTimeKeyPrefix, types.USDXMintingClaimType)
+
+	migrateHardLiquidityProviderClaims(store, cdc)
+	migrateValues(store, types.HardSupplyRewardIndexesKeyPrefix, types.SourceRewardIndexesKeyPrefix, types.HardSupplyClaimType)
+	migrateValues(store, types.PreviousHardSupplyRewardAccrualTimeKeyPrefix, types.PreviousSourceRewardAccrualTimeKeyPrefix, types.HardSupplyClaimType)
+	migrateValues(store, types.HardBorrowRewardIndexesKeyPrefix, types.SourceRewardIndexesKeyPrefix, types.HardBorrowClaimType)
+	migrateValues(store, types.PreviousHardBorrowRewardAccrualTimeKeyPrefix, types.PreviousSourceRewardAccrualTimeKeyPrefix, types.HardBorrowClaimType)
+	return nil
+}
+
+// migrateUSDXMintingClaims converts the USDX minting claims into usdx minting source claims
+func migrateUSDXMintingClaims(store sdk.KVStore, cdc codec.BinaryCodec) {
+	migrateEntries(store, types.USDXMintingClaimKeyPrefix, func(key, value []byte) {
+		var c types.USDXMintingClaim
+		cdc.MustUnmarshal(value, &c)
+		setSourceClaim(store, cdc, key, c.ToSourceClaim())
+	})
+}
+
+// migrateUSDXMintingRewardFactors converts the USDX minting reward factors into reward indexes of the usdx minting
+// reward denom
+func migrateUSDXMintingRewardFactors(store sdk.KVStore, cdc codec.BinaryCodec) {
+	newStore := sourceStore(store, types.SourceRewardIndexesKeyPrefix, types.USDXMintingClaimType)
+	migrateEntries(store, types.USDXMintingRewardFactorKeyPrefix, func(key, value []byte) {
+		var factor sdk.Dec
+		if err := factor.Unmarshal(value); err != nil {
+			panic(err)
+		}
+		indexes := types.RewardIndexes{types.NewRewardIndex(types.USDXMintingRewardDenom, factor)}
+		newStore.Set(key, cdc.MustMarshal(&types.RewardIndexesProto{RewardIndexes: indexes}))
+	})
+}
+
+// migrateHardLiquidityProviderClaims converts the hard claims into hard supply and hard borrow source claims
+func migrateHardLiquidityProviderClaims(store sdk.KVStore, cdc codec.BinaryCodec) {
+	migrateEntries(store, types.HardLiquidityClaimKeyPrefix, func(key, value []byte) {
+		var c types.HardLiquidityProviderClaim
+		cdc.MustUnmarshal(value, &c)
+		supply, borrow := c.ToSourceClaims()
+		setSourceClaim(store, cdc, key, supply)
+		setSourceClaim(store, cdc, key, borrow)
+	})
+}
+
+// migrateValues moves the values stored under a legacy prefix to a source store, keeping their encoding
+func migrateValues(store sdk.KVStore, legacyPrefix, newPrefix []byte, source string) {
+	newStore := sourceStore(store, newPrefix, source)
+	migrateEntries(store, legacyPrefix, func(key, value []byte) {
+		newStore.Set(key, value)
+	})
+}
+
+// migrateEntries calls migrate for every entry stored under a legacy prefix, then deletes them
+func migrateEntries(store sdk.KVStore, legacyPrefix []byte, migrate func(key, value []byte)) {
+	legacyStore := prefix.NewStore(store, legacyPrefix)
+
+	iterator := sdk.KVStorePrefixIterator(legacyStore, []byte{})
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		migrate(iterator.Key(), iterator.Value())
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		legacyStore.Delete(key)
+	}
+}
+
+func setSourceClaim(store sdk.KVStore, cdc codec.BinaryCodec, owner []byte, claim types.SourceClaim) {
+	sourceStore(store, types.SourceClaimKeyPrefix, claim.Source).Set(owner, cdc.MustMarshal(&claim))
+}
+
+func sourceStore(store sdk.KVStore, keyPrefix []byte, source string) prefix.Store {
+	return prefix.NewStore(prefix.NewStore(store, keyPrefix), types.GetSourceKeyPrefix(source))
+}
@@ -0,0 +1,136 @@
+package v4_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v4incentive "github.com/incubus-network/fury/x/incentive/migrations/v4"
+	"github.com/incubus-network/fury/x/incentive/types"
+)
+
+func TestStoreMigrationMovesUSDXMintingState(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	types.RegisterInterfaces(encCfg.InterfaceRegistry)
+	incentiveKey := sdk.NewKVStoreKey(types.ModuleName)
+	tIncentiveKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(incentiveKey, tIncentiveKey)
+	cdc := encCfg.Codec
+
+	owner := sdk.AccAddress("owner_______________")
+	factor := sdk.MustNewDecFromStr("0.1")
+	claim := types.NewUSDXMintingClaim(
+		owner,
+		sdk.NewInt64Coin(types.USDXMintingRewardDenom, 100),
+		types.RewardIndexes{types.NewRewardIndex("bnb-a", factor)},
+	)
+	accrualTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	accrualTimeBz, err := accrualTime.MarshalBinary()
+	require.NoError(t, err)
+	factorBz, err := factor.Marshal()
+	require.NoError(t, err)
+
+	store := ctx.KVStore(incentiveKey)
+	prefix.NewStore(store, types.USDXMintingClaimKeyPrefix).Set(owner, cdc.MustMarshal(&claim))
+	prefix.NewStore(store, types.USDXMintingRewardFactorKeyPrefix).Set([]byte("bnb-a"), factorBz)
+	prefix.NewStore(store, types.PreviousUSDXMintingRewardAccrualTimeKeyPrefix).Set([]byte("bnb-a"), accrualTimeBz)
+
+	err = v4incentive.MigrateStore(ctx, incentiveKey, cdc)
+	require.NoError(t, err)
+
+	sourcePrefix := types.GetSourceKeyPrefix(types.USDXMintingClaimType)
+	indexes := types.RewardIndexes{types.NewRewardIndex(types.USDXMintingRewardDenom, factor)}
+
+	var sourceClaim types.SourceClaim
+	bz := prefix.NewStore(prefix.NewStore(store, types.SourceClaimKeyPrefix), sourcePrefix).Get(owner)
+	require.NotNil(t, bz)
+	cdc.MustUnmarshal(bz, &sourceClaim)
+	require.Equal(t, types.NewSourceClaim(
+		types.USDXMintingClaimType,
+		owner,
+		sdk.NewCoins(claim.Reward),
+		types.MultiRewardIndexes{types.NewMultiRewardIndex("bnb-a", indexes)},
+	), sourceClaim)
+	require.Equal(t, claim, types.NewUSDXMintingClaimFromSource(sourceClaim))
+
+	bz = prefix.NewStore(prefix.NewStore(store, types.SourceRewardIndexesKeyPrefix), sourcePrefix).Get([]byte("bnb-a"))
+	require.Equal(t, cdc.MustMarshal(&types.RewardIndexesProto{RewardIndexes: indexes}), bz)
+	bz = prefix.NewStore(prefix.NewStore(store, types.PreviousSourceRewardAccrualTimeKeyPrefix), sourcePrefix).Get([]byte("bnb-a"))
+	require.Equal(t, accrualTimeBz, bz)
+
+	// legacy state is removed
+	require.Nil(t, prefix.NewStore(store, types.USDXMintingClaimKeyPrefix).Get(owner))
+	require.Nil(t, prefix.NewStore(store, types.USDXMintingRewardFactorKeyPrefix).Get([]byte("bnb-a")))
+	require.Nil(t, prefix.NewStore(store, types.PreviousUSDXMintingRewardAccrualTimeKeyPrefix).Get([]byte("bnb-a")))
+}
+
+func TestStoreMigrationMovesHardState(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	types.RegisterInterfaces(encCfg.InterfaceRegistry)
+	incentiveKey := sdk.NewKVStoreKey(types.ModuleName)
+	tIncentiveKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(incentiveKey, tIncentiveKey)
+	cdc := encCfg.Codec
+
+	owner := sdk.AccAddress("owner_______________")
+	supplyIndexes := types.RewardIndexes{types.NewRewardIndex("hard", sdk.MustNewDecFromStr("0.1"))}
+	borrowIndexes := types.RewardIndexes{types.NewRewardIndex("hard", sdk.MustNewDecFromStr("0.2"))}
+	claim := types.NewHardLiquidityProviderClaim(
+		owner,
+		sdk.NewCoins(sdk.NewInt64Coin("hard", 100)),
+		types.MultiRewardIndexes{types.NewMultiRewardIndex("bnb", supplyIndexes)},
+		types.MultiRewardIndexes{types.NewMultiRewardIndex("usdx", borrowIndexes)},
+	)
+	accrualTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	accrualTimeBz, err := accrualTime.MarshalBinary()
+	require.NoError(t, err)
+	supplyIndexesBz := cdc.MustMarshal(&types.RewardIndexesProto{RewardIndexes: supplyIndexes})
+	borrowIndexesBz := cdc.MustMarshal(&types.RewardIndexesProto{RewardIndexes: borrowIndexes})
+
+	store := ctx.KVStore(incentiveKey)
+	prefix.NewStore(store, types.HardLiquidityClaimKeyPrefix).Set(owner, cdc.MustMarshal(&claim))
+	prefix.NewStore(store, types.HardSupplyRewardIndexesKeyPrefix).Set([]byte("bnb"), supplyIndexesBz)
+	prefix.NewStore(store, types.PreviousHardSupplyRewardAccrualTimeKeyPrefix).Set([]byte("bnb"), accrualTimeBz)
+	prefix.NewStore(store, types.HardBorrowRewardIndexesKeyPrefix).Set([]byte("usdx"), borrowIndexesBz)
+	prefix.NewStore(store, types.PreviousHardBorrowRewardAccrualTimeKeyPrefix).Set([]byte("usdx"), accrualTimeBz)
+
+	err = v4incentive.MigrateStore(ctx, incentiveKey, cdc)
+	require.NoError(t, err)
+
+	supplyPrefix := types.GetSourceKeyPrefix(types.HardSupplyClaimType)
+	borrowPrefix := types.GetSourceKeyPrefix(types.HardBorrowClaimType)
+	claimStore := prefix.NewStore(store, types.SourceClaimKeyPrefix)
+
+	// the reward is kept in the supply claim
+	var supplyClaim, borrowClaim types.SourceClaim
+	bz := prefix.NewStore(claimStore, supplyPrefix).Get(owner)
+	require.NotNil(t, bz)
+	cdc.MustUnmarshal(bz, &supplyClaim)
+	require.Equal(t, types.NewSourceClaim(types.HardSupplyClaimType, owner, claim.Reward, claim.SupplyRewardIndexes), supplyClaim)
+
+	bz = prefix.NewStore(claimStore, borrowPrefix).Get(owner)
+	require.NotNil(t, bz)
+	cdc.MustUnmarshal(bz, &borrowClaim)
+	require.Equal(t, types.NewSourceClaim(types.HardBorrowClaimType, owner, nil, claim.BorrowRewardIndexes), borrowClaim)
+	require.Equal(t, claim, types.NewHardLiquidityProviderClaimFromSources(supplyClaim, borrowClaim))
+
+	indexesStore := prefix.NewStore(store, types.SourceRewardIndexesKeyPrefix)
+	accrualTimeStore := prefix.NewStore(store, types.PreviousSourceRewardAccrualTimeKeyPrefix)
+	require.Equal(t, supplyIndexesBz, prefix.NewStore(indexesStore, supplyPrefix).Get([]byte("bnb")))
+	require.Equal(t, accrualTimeBz, prefix.NewStore(accrualTimeStore, supplyPrefix).Get([]byte("bnb")))
+	require.Equal(t, borrowIndexesBz, prefix.NewStore(indexesStore, borrowPrefix).Get([]byte("usdx")))
+	require.Equal(t, accrualTimeBz, prefix.NewStore(accrualTimeStore, borrowPrefix).Get([]byte("usdx")))
+
+	// legacy state is removed
+	require.Nil(t, prefix.NewStore(store, types.HardLiquidityClaimKeyPrefix).Get(owner))
+	require.Nil(t, prefix.NewStore(store, types.HardSupplyRewardIndexesKeyPrefix).Get([]byte("bnb")))
+	require.Nil(t, prefix.NewStore(store, types.PreviousHardSupplyRewardAccrualTimeKeyPrefix).Get([]byte("bnb")))
+	require.Nil(t, prefix.NewStore(store, types.HardBorrowRewardIndexesKeyPrefix).Get([]byte("usdx")))
+	require.Nil(t, prefix.NewStore(store, types.PreviousHardBorrowRewardAccrualTimeKeyPrefix).Get([]byte("usdx")))
+}
@@ -272,7 +272,7 @@ func NewStandardMoneyMarket(denom string) hardtypes.MoneyMarket {
 		),
 		denom+":usd",
 		sdkmath.NewInt(1e6),
-		hardtypes.NewInterestRateModel(
+		hardtypes.NewJumpRateModel(
 			sdk.MustNewDecFromStr("0.05"),
 			sdk.MustNewDecFromStr("2"),
 			sdk.MustNewDecFromStr("0.8"),
@@ -154,6 +154,10 @@ func (suite *IntegrationTester) DeliverIncentiveMsg(msg sdk.Msg) error {
 		_, err = msgServer.ClaimDelegatorReward(sdk.WrapSDKContext(suite.Ctx), msg)
 	case *types.MsgClaimEarnReward:
 		_, err = msgServer.ClaimEarnReward(sdk.WrapSDKContext(suite.Ctx), msg)
+	case *types.MsgClaimAllRewards:
+		_, err = msgServer.ClaimAllRewards(sdk.WrapSDKContext(suite.Ctx), msg)
+	case *types.MsgClaimReward:
+		_, err = msgServer.ClaimReward(sdk.WrapSDKContext(suite.Ctx), msg)
 	default:
 		panic("unhandled incentive msg")
 	}
@@ -30,7 +30,14 @@ func NewAccumulator(previousAccrual time.Time, indexes RewardIndexes) *Accumulat
 // If a period ends before currentTime, the PreviousAccrualTime is shortened to the end time. This allows accumulate to be called sequentially on consecutive reward periods.
 //
 // totalSourceShares is the sum of all users' source shares. For example:total btcb supplied to hard, total usdx borrowed from all bnb CDPs, or total shares in a swap pool.
+//
+// Periods with an emission curve accrue the rewards emitted by the curve over the accumulation time.
 func (acc *Accumulator) Accumulate(period MultiRewardPeriod, totalSourceShares sdk.Dec, currentTime time.Time) {
+	if period.Curve != nil {
+		// rewards of periods without a budget are not capped
+		acc.AccumulateWithBudget(period, totalSourceShares, currentTime, nil)
+		return
+	}
 	acc.AccumulateDecCoins(
 		period.Start,
 		period.End,
@@ -40,6 +47,26 @@ func (acc *Accumulator) Accumulate(period MultiRewardPeriod, totalSourceShares s
 	)
 }
 
+// AccumulateWithBudget accrues rewards up to the current time like Accumulate, but limits the rewards distributed to
+// the remaining budget of the period given the amount already spent. It returns the rewards distributed, which are
+// empty if there are no source shares to distribute them to.
+func (acc *Accumulator) AccumulateWithBudget(period MultiRewardPeriod, totalSourceShares sdk.Dec, currentTime time.Time, spent sdk.DecCoins) sdk.DecCoins {
+	rewards, accumulatedTo := period.RewardsBetween(acc.PreviousAccumulationTime, currentTime)
+	acc.PreviousAccumulationTime = accumulatedTo
+
+	if totalSourceShares.LTE(sdk.ZeroDec()) {
+		// Rewards are dropped when there are no source shares, so they do not use up the budget.
+		return nil
+	}
+	rewards = period.CapRewardsToBudget(rewards, spent)
+	if rewards.IsZero() {
+		return nil
+	}
+
+	acc.Indexes = acc.Indexes.Add(NewRewardIndexesFromCoins(rewards).Quo(totalSourceShares))
+	return rewards
+}
+
 // AccumulateDecCoins
 func (acc *Accumulator) AccumulateDecCoins(
 	periodStart time.Time,
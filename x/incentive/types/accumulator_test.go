@@ -317,6 +317,27 @@ func TestAccumulator(t *testing.T) {
 					Indexes:                  RewardIndexes{{CollateralType: "hard", RewardFactor: d("10.1")}},
 				},
 			},
+			{
+				name: "rewards follow the emission curve of the period",
+				args: args{
+					accumulator: Accumulator{
+						PreviousAccumulationTime: time.Date(1998, 1, 1, 0, 0, 5, 0, time.UTC),
+						Indexes:                  RewardIndexes{{CollateralType: "hard", RewardFactor: d("0.1")}},
+					},
+					period: MultiRewardPeriod{
+						Start:            time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC),
+						End:              time.Date(1998, 1, 1, 0, 1, 40, 0, time.UTC),
+						RewardsPerSecond: cs(c("hard", 1000)),
+						Curve:            NewStepRewardCurve(NewRewardStep(10*time.Second, d("0.5"))),
+					},
+					totalSourceShares: d("1000"),
+					currentTime:       time.Date(1998, 1, 1, 0, 0, 20, 0, time.UTC),
+				},
+				expected: Accumulator{
+					PreviousAccumulationTime: time.Date(1998, 1, 1, 0, 0, 20, 0, time.UTC),
+					Indexes:                  RewardIndexes{{CollateralType: "hard", RewardFactor: d("10.1")}},
+				},
+			},
 		}
 
 		for _, tc := range testcases {
@@ -36,7 +36,7 @@ func (m *Apy) Reset()         { *m = Apy{} }
 func (m *Apy) String() string { return proto.CompactTextString(m) }
 func (*Apy) ProtoMessage()    {}
 func (*Apy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b2c1ad571f25cae9, []int{0}
+	return fileDescriptor_220fedc522d35cc7, []int{0}
 }
 func (m *Apy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -76,11 +76,11 @@ func init() {
 	proto.RegisterType((*Apy)(nil), "fury.incentive.v1beta1.Apy")
 }
 
-func init() { proto.RegisterFile("fury/incentive/v1beta1/apy.proto", fileDescriptor_b2c1ad571f25cae9) }
+func init() { proto.RegisterFile("fury/incentive/v1beta1/apy.proto", fileDescriptor_220fedc522d35cc7) }
 
-var fileDescriptor_b2c1ad571f25cae9 = []byte{
-	// 248 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc8, 0x4e, 0x2c, 0x4b,
+var fileDescriptor_220fedc522d35cc7 = []byte{
+	// 253 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0x2b, 0x2d, 0xaa,
 	0xd4, 0xcf, 0xcc, 0x4b, 0x4e, 0xcd, 0x2b, 0xc9, 0x2c, 0x4b, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
 	0x49, 0x34, 0xd4, 0x4f, 0x2c, 0xa8, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x03, 0xa9,
 	0xd0, 0x83, 0xab, 0xd0, 0x83, 0xaa, 0x90, 0x92, 0x4c, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0x8e, 0x07,
@@ -91,11 +91,11 @@ var fileDescriptor_b2c1ad571f25cae9 = []byte{
 	0x29, 0xc1, 0x04, 0x92, 0x74, 0xb2, 0x39, 0x71, 0x4f, 0x9e, 0xe1, 0xd6, 0x3d, 0x79, 0xb5, 0xf4,
 	0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xa8, 0x9d, 0x50, 0x4a, 0xb7, 0x38, 0x25,
 	0x5b, 0x1f, 0x64, 0x5a, 0xb1, 0x9e, 0x4b, 0x6a, 0xf2, 0xa5, 0x2d, 0xba, 0x5c, 0x50, 0x27, 0xb9,
-	0xa4, 0x26, 0x07, 0x81, 0x0c, 0x72, 0x72, 0x3d, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6,
+	0xa4, 0x26, 0x07, 0x81, 0x0c, 0x72, 0xf2, 0x3e, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6,
 	0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39,
-	0x86, 0x28, 0x6d, 0x24, 0x43, 0x41, 0xbe, 0xd5, 0xcd, 0x49, 0x4c, 0x2a, 0x06, 0xb3, 0xf4, 0x2b,
-	0x90, 0xc2, 0x06, 0x6c, 0x7a, 0x12, 0x1b, 0xd8, 0x37, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x95, 0x59, 0xa8, 0x77, 0x3a, 0x01, 0x00, 0x00,
+	0x86, 0x28, 0x43, 0x24, 0x43, 0x33, 0xf3, 0x92, 0x4b, 0x93, 0x4a, 0x8b, 0x75, 0xf3, 0x52, 0x4b,
+	0xca, 0xf3, 0x8b, 0xb2, 0xf5, 0xc1, 0xe1, 0x53, 0x81, 0x14, 0x42, 0x60, 0x3b, 0x92, 0xd8, 0xc0,
+	0x7e, 0x32, 0x06, 0x0c, 0x00, 0xd5, 0x0a, 0x59, 0x13, 0x40, 0x01, 0x00, 0x00,
 }
 
 func (m *Apy) Marshal() (dAtA []byte, err error) {
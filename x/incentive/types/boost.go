@@ -0,0 +1,118 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewRewardBoost returns a new RewardBoost
+func NewRewardBoost(maxBoost sdk.Dec, fullBoostStake sdkmath.Int, sources ...string) RewardBoost {
+	return RewardBoost{
+		MaxBoost:       maxBoost,
+		FullBoostStake: fullBoostStake,
+		Sources:        sources,
+	}
+}
+
+// Validate performs a basic check of a RewardBoost.
+func (rb RewardBoost) Validate() error {
+	if !rb.MaxBoost.IsNil() && !rb.MaxBoost.IsZero() && rb.MaxBoost.LT(sdk.OneDec()) {
+		return fmt.Errorf("max reward boost must be at least 1: %s", rb.MaxBoost)
+	}
+	if rb.IsEnabled() && (rb.FullBoostStake.IsNil() || !rb.FullBoostStake.IsPositive()) {
+		return fmt.Errorf("full boost stake must be positive: %s", rb.FullBoostStake)
+	}
+
+	seen := make(map[string]bool)
+	for _, source := range rb.Sources {
+		if strings.TrimSpace(source) == "" {
+			return fmt.Errorf("boosted reward source cannot be blank")
+		}
+		if seen[source] {
+			return fmt.Errorf("duplicated boosted reward source %s", source)
+		}
+		seen[source] = true
+	}
+	return nil
+}
+
+// IsEnabled returns true if the boost can scale rewards. A max boost that is unset or 1 disables it.
+func (rb RewardBoost) IsEnabled() bool {
+	return !rb.MaxBoost.IsNil() && rb.MaxBoost.GT(sdk.OneDec())
+}
+
+// Boosts returns true if the rewards of the reward source are boosted.
+func (rb RewardBoost) Boosts(source string) bool {
+	if !rb.IsEnabled() {
+		return false
+	}
+	if len(rb.Sources) == 0 {
+		return true
+	}
+	for _, s := range rb.Sources {
+		if s == source {
+			return true
+		}
+	}
+	return false
+}
+
+// Multiplier returns the factor rewards are scaled by for an owner with the given stake.
+// It grows linearly from 1 with no stake to the max boost with the full boost stake, and is capped there.
+func (rb RewardBoost) Multiplier(staked sdkmath.Int) sdk.Dec {
+	if !rb.IsEnabled() || !staked.IsPositive() {
+		return sdk.OneDec()
+	}
+	fraction := sdk.MinDec(sdk.NewDecFromInt(staked).QuoInt(rb.FullBoostStake), sdk.OneDec())
+	return sdk.OneDec().Add(rb.MaxBoost.Sub(sdk.OneDec()).Mul(fraction))
+}
+
+// NewRewardBoostStake returns a new RewardBoostStake
+func NewRewardBoostStake(source, collateralType string, owner sdk.AccAddress, staked sdkmath.Int) RewardBoostStake {
+	return RewardBoostStake{
+		Source:         source,
+		CollateralType: collateralType,
+		Owner:          owner,
+		Staked:         staked,
+	}
+}
+
+// Validate performs validation of a RewardBoostStake
+func (rbs RewardBoostStake) Validate() error {
+	if rbs.Source == "" {
+		return errors.New("reward boost source cannot be blank")
+	}
+	if rbs.CollateralType == "" {
+		return errors.New("reward boost collateral type cannot be blank")
+	}
+	if err := sdk.VerifyAddressFormat(rbs.Owner); err != nil {
+		return fmt.Errorf("invalid reward boost owner: %w", err)
+	}
+	if rbs.Staked.IsNil() || rbs.Staked.IsNegative() {
+		return fmt.Errorf("reward boost stake cannot be negative: %s", rbs.Staked)
+	}
+	return nil
+}
+
+// RewardBoostStakes slice of RewardBoostStake
+type RewardBoostStakes []RewardBoostStake
+
+// Validate performs validation of RewardBoostStakes
+func (rbss RewardBoostStakes) Validate() error {
+	seen := make(map[string]bool)
+	for _, rbs := range rbss {
+		if err := rbs.Validate(); err != nil {
+			return err
+		}
+		key := rbs.Source + "|" + rbs.CollateralType + "|" + rbs.Owner.String()
+		if seen[key] {
+			return fmt.Errorf("duplicated reward boost stake for source %s, collateral type %s and owner %s", rbs.Source, rbs.CollateralType, rbs.Owner)
+		}
+		seen[key] = true
+	}
+	return nil
+}
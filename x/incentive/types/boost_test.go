@@ -0,0 +1,80 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewardBoost_Validate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		boost   RewardBoost
+		wantErr bool
+	}{
+		{"default", DefaultRewardBoost, false},
+		{"unset", RewardBoost{}, false},
+		{"boost", NewRewardBoost(d("2"), sdk.NewInt(1_000_000)), false},
+		{"boost for sources", NewRewardBoost(d("1.5"), sdk.NewInt(1_000_000), SwapClaimType, EarnClaimType), false},
+		{"max boost below 1", NewRewardBoost(d("0.5"), sdk.NewInt(1_000_000)), true},
+		{"zero full boost stake", NewRewardBoost(d("2"), sdk.ZeroInt()), true},
+		{"blank source", NewRewardBoost(d("2"), sdk.NewInt(1_000_000), " "), true},
+		{"duplicate source", NewRewardBoost(d("2"), sdk.NewInt(1_000_000), SwapClaimType, SwapClaimType), true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.boost.Validate()
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRewardBoost_Multiplier(t *testing.T) {
+	boost := NewRewardBoost(d("2.5"), sdk.NewInt(1000), SwapClaimType)
+
+	require.Equal(t, d("1"), boost.Multiplier(sdk.ZeroInt()))
+	require.Equal(t, d("1.75"), boost.Multiplier(sdk.NewInt(500)))
+	require.Equal(t, d("2.5"), boost.Multiplier(sdk.NewInt(1000)))
+	require.Equal(t, d("2.5"), boost.Multiplier(sdk.NewInt(5000)), "boost should be capped")
+
+	require.True(t, boost.Boosts(SwapClaimType))
+	require.False(t, boost.Boosts(EarnClaimType))
+	require.True(t, NewRewardBoost(d("2.5"), sdk.NewInt(1000)).Boosts(EarnClaimType))
+
+	require.False(t, DefaultRewardBoost.Boosts(SwapClaimType))
+	require.Equal(t, d("1"), DefaultRewardBoost.Multiplier(sdk.NewInt(5000)))
+}
+
+func TestRewardBoostStakes_Validate(t *testing.T) {
+	owner := sdk.AccAddress("test_owner__________")
+	stake := NewRewardBoostStake(SwapClaimType, "busd:ufury", owner, sdk.NewInt(1000))
+
+	testCases := []struct {
+		name    string
+		stakes  RewardBoostStakes
+		wantErr bool
+	}{
+		{"empty", nil, false},
+		{"valid", RewardBoostStakes{stake, NewRewardBoostStake(EarnClaimType, "usdx", owner, sdk.ZeroInt())}, false},
+		{"blank source", RewardBoostStakes{NewRewardBoostStake("", "usdx", owner, sdk.ZeroInt())}, true},
+		{"blank collateral type", RewardBoostStakes{NewRewardBoostStake(SwapClaimType, "", owner, sdk.ZeroInt())}, true},
+		{"empty owner", RewardBoostStakes{NewRewardBoostStake(SwapClaimType, "usdx", nil, sdk.ZeroInt())}, true},
+		{"negative stake", RewardBoostStakes{NewRewardBoostStake(SwapClaimType, "usdx", owner, sdk.NewInt(-1))}, true},
+		{"duplicate", RewardBoostStakes{stake, stake}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.stakes.Validate()
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewBudgetedMultiRewardPeriod returns a new MultiRewardPeriod that stops distributing rewards once the budget is spent.
+func NewBudgetedMultiRewardPeriod(
+	active bool, collateralType string, start time.Time, end time.Time, reward sdk.Coins, budget sdk.Coins,
+) MultiRewardPeriod {
+	period := NewMultiRewardPeriod(active, collateralType, start, end, reward)
+	period.Budget = budget
+	return period
+}
+
+// HasBudget returns true if the reward period has a budget limiting its rewards.
+func (mrp MultiRewardPeriod) HasBudget() bool {
+	return !mrp.Budget.Empty()
+}
+
+// validateBudget checks a budget, if set, is valid and covers exactly the reward denoms of the period.
+func (mrp MultiRewardPeriod) validateBudget() error {
+	if !mrp.HasBudget() {
+		return nil
+	}
+	if !mrp.Budget.IsValid() {
+		return fmt.Errorf("invalid reward budget: %s", mrp.Budget)
+	}
+	if !mrp.Budget.DenomsSubsetOf(mrp.RewardsPerSecond) || !mrp.RewardsPerSecond.DenomsSubsetOf(mrp.Budget) {
+		return fmt.Errorf("reward budget %s must have the same denoms as the rewards %s", mrp.Budget, mrp.RewardsPerSecond)
+	}
+	return nil
+}
+
+// RemainingBudget returns the amount of the period's budget that has not been spent.
+func (mrp MultiRewardPeriod) RemainingBudget(spent sdk.DecCoins) sdk.DecCoins {
+	remaining := sdk.NewDecCoins()
+	for _, coin := range mrp.Budget {
+		amount := sdk.NewDecFromInt(coin.Amount).Sub(spent.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			remaining = remaining.Add(sdk.NewDecCoinFromDec(coin.Denom, amount))
+		}
+	}
+	return remaining
+}
+
+// IsBudgetExhausted returns true if the period has a budget and all of it has been spent.
+func (mrp MultiRewardPeriod) IsBudgetExhausted(spent sdk.DecCoins) bool {
+	return mrp.HasBudget() && mrp.RemainingBudget(spent).IsZero()
+}
+
+// CapRewardsToBudget limits rewards to the remaining budget of the period. Periods without a budget are not limited.
+func (mrp MultiRewardPeriod) CapRewardsToBudget(rewards sdk.DecCoins, spent sdk.DecCoins) sdk.DecCoins {
+	if !mrp.HasBudget() {
+		return rewards
+	}
+	remaining := mrp.RemainingBudget(spent)
+
+	capped := sdk.NewDecCoins()
+	for _, coin := range rewards {
+		amount := sdk.MinDec(coin.Amount, remaining.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			capped = capped.Add(sdk.NewDecCoinFromDec(coin.Denom, amount))
+		}
+	}
+	return capped
+}
+
+// Runway returns how long the period can distribute rewards at its rate at the given time before its budget is spent
+// or the period ends, whichever comes first.
+func (mrp MultiRewardPeriod) Runway(spent sdk.DecCoins, currentTime time.Time) time.Duration {
+	start := maxTime(mrp.Start, currentTime)
+	if !start.Before(mrp.End) {
+		return 0
+	}
+	runway := mrp.End.Sub(start)
+
+	remaining := mrp.RemainingBudget(spent)
+	for _, coin := range mrp.RewardsPerSecondAt(start) {
+		if !coin.Amount.IsPositive() {
+			continue
+		}
+		// compare in seconds before converting, as a large budget at a tiny rate overflows a duration
+		seconds := remaining.AmountOf(coin.Denom).Quo(coin.Amount).TruncateDec()
+		if seconds.LT(sdk.NewDec(int64(runway)).QuoInt64(int64(time.Second))) {
+			runway = time.Duration(seconds.TruncateInt64()) * time.Second
+		}
+	}
+	return runway
+}
+
+// NewRewardBudgetSpend returns a new RewardBudgetSpend
+func NewRewardBudgetSpend(source, collateralType string, periodStart time.Time, spent sdk.DecCoins) RewardBudgetSpend {
+	return RewardBudgetSpend{
+		Source:         source,
+		CollateralType: collateralType,
+		Spent:          spent,
+		PeriodStart:    periodStart,
+	}
+}
+
+// IsSpentFrom returns true if the spend was recorded for a reward period. A reward period replaced by one with a
+// different start has its own budget, so the spend of the previous period does not count against it.
+func (rbs RewardBudgetSpend) IsSpentFrom(rewardPeriod MultiRewardPeriod) bool {
+	return rbs.PeriodStart.Equal(rewardPeriod.Start)
+}
+
+// Validate performs validation of a RewardBudgetSpend
+func (rbs RewardBudgetSpend) Validate() error {
+	if rbs.Source == "" {
+		return errors.New("reward budget source cannot be blank")
+	}
+	if rbs.CollateralType == "" {
+		return errors.New("reward budget collateral type cannot be blank")
+	}
+	return rbs.Spent.Validate()
+}
+
+// RewardBudgetSpends slice of RewardBudgetSpend
+type RewardBudgetSpends []RewardBudgetSpend
+
+// Validate performs validation of RewardBudgetSpends
+func (rbss RewardBudgetSpends) Validate() error {
+	seen := make(map[string]bool)
+	for _, rbs := range rbss {
+		if err := rbs.Validate(); err != nil {
+			return err
+		}
+		key := rbs.Source + "|" + rbs.CollateralType
+		if seen[key] {
+			return fmt.Errorf("duplicated reward budget spend for source %s and collateral type %s", rbs.Source, rbs.CollateralType)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// BudgetedRewardPeriods returns the reward periods with a budget, grouped by the source their budgets are tracked under.
+func (p Params) BudgetedRewardPeriods() SourceMultiRewardPeriods {
+	sources := []SourceMultiRewardPeriod{
+		NewSourceMultiRewardPeriod(HardSupplyClaimType, p.HardSupplyRewardPeriods),
+		NewSourceMultiRewardPeriod(HardBorrowClaimType, p.HardBorrowRewardPeriods),
+		NewSourceMultiRewardPeriod(DelegatorClaimType, p.DelegatorRewardPeriods),
+		NewSourceMultiRewardPeriod(SwapClaimType, p.SwapRewardPeriods),
+		NewSourceMultiRewardPeriod(SavingsClaimType, p.SavingsRewardPeriods),
+		NewSourceMultiRewardPeriod(EarnClaimType, p.EarnRewardPeriods),
+	}
+	sources = append(sources, p.SourceRewardPeriods...)
+
+	var budgeted SourceMultiRewardPeriods
+	for _, srp := range sources {
+		var periods MultiRewardPeriods
+		for _, rp := range srp.RewardPeriods {
+			if rp.HasBudget() {
+				periods = append(periods, rp)
+			}
+		}
+		if len(periods) > 0 {
+			budgeted = append(budgeted, NewSourceMultiRewardPeriod(srp.Source, periods))
+		}
+	}
+	return budgeted
+}
@@ -0,0 +1,109 @@
+package types
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiRewardPeriod_CapRewardsToBudget(t *testing.T) {
+	period := NewBudgetedMultiRewardPeriod(
+		true, "bnb", time.Unix(0, 0), time.Unix(1e9, 0),
+		cs(c("hard", 100), c("swap", 100)),
+		cs(c("hard", 1000), c("swap", 1000)),
+	)
+	spent := sdk.NewDecCoins(sdk.NewDecCoin("hard", sdk.NewInt(900)), sdk.NewDecCoin("swap", sdk.NewInt(1000)))
+
+	require.Equal(t,
+		sdk.NewDecCoins(sdk.NewDecCoin("hard", sdk.NewInt(100))),
+		period.CapRewardsToBudget(sdk.NewDecCoinsFromCoins(c("hard", 500), c("swap", 500)), spent),
+	)
+	require.Equal(t,
+		sdk.NewDecCoins(sdk.NewDecCoin("hard", sdk.NewInt(100))),
+		period.RemainingBudget(spent),
+	)
+	require.False(t, period.IsBudgetExhausted(spent))
+	require.True(t, period.IsBudgetExhausted(spent.Add(sdk.NewDecCoin("hard", sdk.NewInt(100)))))
+
+	unbudgeted := NewMultiRewardPeriod(true, "bnb", time.Unix(0, 0), time.Unix(1e9, 0), cs(c("hard", 100)))
+	require.Equal(t, sdk.NewDecCoinsFromCoins(c("hard", 500)), unbudgeted.CapRewardsToBudget(sdk.NewDecCoinsFromCoins(c("hard", 500)), spent))
+	require.False(t, unbudgeted.IsBudgetExhausted(spent))
+}
+
+func TestMultiRewardPeriod_Runway(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	period := NewBudgetedMultiRewardPeriod(
+		true, "bnb", start, start.Add(24*time.Hour),
+		cs(c("hard", 10), c("swap", 20)),
+		cs(c("hard", 36_000), c("swap", 36_000)),
+	)
+
+	testCases := []struct {
+		name   string
+		spent  sdk.DecCoins
+		now    time.Time
+		runway time.Duration
+	}{
+		{
+			name:   "runway is limited by the denom running out first",
+			spent:  sdk.DecCoins{},
+			now:    start,
+			runway: 1800 * time.Second,
+		},
+		{
+			name:   "runway is counted from the start of a future period",
+			spent:  sdk.DecCoins{},
+			now:    start.Add(-time.Hour),
+			runway: 1800 * time.Second,
+		},
+		{
+			name:   "runway is limited by the period end",
+			spent:  sdk.DecCoins{},
+			now:    start.Add(23*time.Hour + 50*time.Minute),
+			runway: 10 * time.Minute,
+		},
+		{
+			name:   "spent budget has no runway",
+			spent:  sdk.NewDecCoinsFromCoins(c("swap", 36_000)),
+			now:    start,
+			runway: 0,
+		},
+		{
+			name:   "ended period has no runway",
+			spent:  sdk.DecCoins{},
+			now:    start.Add(25 * time.Hour),
+			runway: 0,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.runway, period.Runway(tc.spent, tc.now))
+		})
+	}
+}
+
+func TestMultiRewardPeriod_Runway_LargeBudget(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	budget, ok := sdk.NewIntFromString("100000000000000000000000000000")
+	require.True(t, ok)
+
+	// the budget lasts far longer than an int64 of seconds or a duration can hold, so the runway is the time left
+	period := NewBudgetedMultiRewardPeriod(true, "bnb", start, end, cs(c("hard", 1)), sdk.NewCoins(sdk.NewCoin("hard", budget)))
+	require.Equal(t, 24*time.Hour, period.Runway(sdk.DecCoins{}, start))
+
+	period.End = time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, time.Duration(math.MaxInt64), period.Runway(sdk.DecCoins{}, start))
+}
+
+func TestRewardBudgetSpends_Validate(t *testing.T) {
+	spend := NewRewardBudgetSpend(HardSupplyClaimType, "bnb", time.Unix(0, 0), sdk.NewDecCoinsFromCoins(c("hard", 1)))
+
+	require.NoError(t, RewardBudgetSpends{spend}.Validate())
+	require.Error(t, RewardBudgetSpends{spend, spend}.Validate())
+	require.Error(t, RewardBudgetSpends{NewRewardBudgetSpend("", "bnb", time.Unix(0, 0), nil)}.Validate())
+	require.Error(t, RewardBudgetSpends{NewRewardBudgetSpend(HardSupplyClaimType, "", time.Unix(0, 0), nil)}.Validate())
+}
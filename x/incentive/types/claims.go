@@ -11,6 +11,8 @@ import (
 const (
 	USDXMintingClaimType           = "usdx_minting"
 	HardLiquidityProviderClaimType = "hard_liquidity_provider"
+	HardSupplyClaimType            = "hard_supply"
+	HardBorrowClaimType            = "hard_borrow"
 	DelegatorClaimType             = "delegator_claim"
 	SwapClaimType                  = "swap"
 	SavingsClaimType               = "savings"
@@ -96,6 +98,37 @@ func (c USDXMintingClaim) HasRewardIndex(collateralType string) (int64, bool) {
 	return 0, false
 }
 
+// ToSourceClaim returns the claim as a usdx minting reward source claim, which stores the reward factor of each
+// collateral type as a reward index of the usdx minting reward denom.
+func (c USDXMintingClaim) ToSourceClaim() SourceClaim {
+	reward := sdk.NewCoins()
+	if !c.Reward.Amount.IsNil() && c.Reward.IsPositive() {
+		reward = reward.Add(c.Reward)
+	}
+
+	var rewardIndexes MultiRewardIndexes
+	for _, ri := range c.RewardIndexes {
+		rewardIndexes = append(rewardIndexes, NewMultiRewardIndex(
+			ri.CollateralType, RewardIndexes{NewRewardIndex(USDXMintingRewardDenom, ri.RewardFactor)},
+		))
+	}
+	return NewSourceClaim(USDXMintingClaimType, c.Owner, reward, rewardIndexes)
+}
+
+// NewUSDXMintingClaimFromSource returns the usdx minting claim stored as a usdx minting reward source claim.
+func NewUSDXMintingClaimFromSource(c SourceClaim) USDXMintingClaim {
+	var rewardIndexes RewardIndexes
+	for _, mri := range c.RewardIndexes {
+		factor, found := mri.RewardIndexes.Get(USDXMintingRewardDenom)
+		if !found {
+			factor = sdk.ZeroDec()
+		}
+		rewardIndexes = append(rewardIndexes, NewRewardIndex(mri.CollateralType, factor))
+	}
+	reward := sdk.NewCoin(USDXMintingRewardDenom, c.Reward.AmountOf(USDXMintingRewardDenom))
+	return NewUSDXMintingClaim(c.Owner, reward, rewardIndexes)
+}
+
 // USDXMintingClaims slice of USDXMintingClaim
 type USDXMintingClaims []USDXMintingClaim
 
@@ -167,6 +200,24 @@ func (c HardLiquidityProviderClaim) HasBorrowRewardIndex(denom string) (int64, b
 	return 0, false
 }
 
+// ToSourceClaims returns the claim as a hard supply and a hard borrow reward source claim.
+// Supply and borrow rewards are paid out together, so the whole reward is kept in the supply claim.
+func (c HardLiquidityProviderClaim) ToSourceClaims() (supply SourceClaim, borrow SourceClaim) {
+	supply = NewSourceClaim(HardSupplyClaimType, c.Owner, c.Reward, c.SupplyRewardIndexes)
+	borrow = NewSourceClaim(HardBorrowClaimType, c.Owner, sdk.Coins{}, c.BorrowRewardIndexes)
+	return supply, borrow
+}
+
+// NewHardLiquidityProviderClaimFromSources returns the hard claim stored as a hard supply and a hard borrow reward
+// source claim.
+func NewHardLiquidityProviderClaimFromSources(supply, borrow SourceClaim) HardLiquidityProviderClaim {
+	reward := supply.Reward
+	if !borrow.Reward.IsZero() {
+		reward = reward.Add(borrow.Reward...)
+	}
+	return NewHardLiquidityProviderClaim(supply.Owner, reward, supply.RewardIndexes, borrow.RewardIndexes)
+}
+
 // HardLiquidityProviderClaims slice of HardLiquidityProviderClaim
 type HardLiquidityProviderClaims []HardLiquidityProviderClaim
 
@@ -392,6 +443,67 @@ func (cs EarnClaims) Validate() error {
 	return nil
 }
 
+// NewSourceClaim returns a new SourceClaim
+func NewSourceClaim(source string, owner sdk.AccAddress, rewards sdk.Coins, rewardIndexes MultiRewardIndexes) SourceClaim {
+	return SourceClaim{
+		Source: source,
+		BaseMultiClaim: BaseMultiClaim{
+			Owner:  owner,
+			Reward: rewards,
+		},
+		RewardIndexes: rewardIndexes,
+	}
+}
+
+// GetType returns the claim's type, which is the reward source
+func (c SourceClaim) GetType() string { return c.Source }
+
+// GetReward returns the claim's reward coin
+func (c SourceClaim) GetReward() sdk.Coins { return c.Reward }
+
+// GetOwner returns the claim's owner
+func (c SourceClaim) GetOwner() sdk.AccAddress { return c.Owner }
+
+// Validate performs a basic check of a SourceClaim fields
+func (c SourceClaim) Validate() error {
+	if strings.TrimSpace(c.Source) == "" {
+		return errors.New("claim source cannot be blank")
+	}
+	if err := c.RewardIndexes.Validate(); err != nil {
+		return err
+	}
+	return c.BaseMultiClaim.Validate()
+}
+
+// SourceClaims slice of SourceClaim
+type SourceClaims []SourceClaim
+
+// Validate checks if all the claims are valid and there are no duplicated entries.
+func (cs SourceClaims) Validate() error {
+	seenClaims := make(map[string]bool)
+	for _, c := range cs {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+
+		key := c.Source + "/" + c.Owner.String()
+		if seenClaims[key] {
+			return fmt.Errorf("duplicated %s claim for owner %s", c.Source, c.Owner)
+		}
+		seenClaims[key] = true
+	}
+
+	return nil
+}
+
+// NewPendingReward returns a new PendingReward
+func NewPendingReward(claimType string, reward sdk.Coins) PendingReward {
+	return PendingReward{
+		ClaimType: claimType,
+		Reward:    reward,
+	}
+}
+
 // ---------------------- Reward indexes are used internally in the store ----------------------
 
 // NewRewardIndex returns a new RewardIndex
@@ -36,7 +36,7 @@ func (m *BaseClaim) Reset()         { *m = BaseClaim{} }
 func (m *BaseClaim) String() string { return proto.CompactTextString(m) }
 func (*BaseClaim) ProtoMessage()    {}
 func (*BaseClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{0}
+	return fileDescriptor_ef6fd6bfd393e290, []int{0}
 }
 func (m *BaseClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -75,7 +75,7 @@ func (m *BaseMultiClaim) Reset()         { *m = BaseMultiClaim{} }
 func (m *BaseMultiClaim) String() string { return proto.CompactTextString(m) }
 func (*BaseMultiClaim) ProtoMessage()    {}
 func (*BaseMultiClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{1}
+	return fileDescriptor_ef6fd6bfd393e290, []int{1}
 }
 func (m *BaseMultiClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -114,7 +114,7 @@ func (m *RewardIndex) Reset()         { *m = RewardIndex{} }
 func (m *RewardIndex) String() string { return proto.CompactTextString(m) }
 func (*RewardIndex) ProtoMessage()    {}
 func (*RewardIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{2}
+	return fileDescriptor_ef6fd6bfd393e290, []int{2}
 }
 func (m *RewardIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -152,7 +152,7 @@ func (m *RewardIndexesProto) Reset()         { *m = RewardIndexesProto{} }
 func (m *RewardIndexesProto) String() string { return proto.CompactTextString(m) }
 func (*RewardIndexesProto) ProtoMessage()    {}
 func (*RewardIndexesProto) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{3}
+	return fileDescriptor_ef6fd6bfd393e290, []int{3}
 }
 func (m *RewardIndexesProto) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -191,7 +191,7 @@ func (m *MultiRewardIndex) Reset()         { *m = MultiRewardIndex{} }
 func (m *MultiRewardIndex) String() string { return proto.CompactTextString(m) }
 func (*MultiRewardIndex) ProtoMessage()    {}
 func (*MultiRewardIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{4}
+	return fileDescriptor_ef6fd6bfd393e290, []int{4}
 }
 func (m *MultiRewardIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -229,7 +229,7 @@ func (m *MultiRewardIndexesProto) Reset()         { *m = MultiRewardIndexesProto
 func (m *MultiRewardIndexesProto) String() string { return proto.CompactTextString(m) }
 func (*MultiRewardIndexesProto) ProtoMessage()    {}
 func (*MultiRewardIndexesProto) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{5}
+	return fileDescriptor_ef6fd6bfd393e290, []int{5}
 }
 func (m *MultiRewardIndexesProto) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -268,7 +268,7 @@ func (m *USDXMintingClaim) Reset()         { *m = USDXMintingClaim{} }
 func (m *USDXMintingClaim) String() string { return proto.CompactTextString(m) }
 func (*USDXMintingClaim) ProtoMessage()    {}
 func (*USDXMintingClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{6}
+	return fileDescriptor_ef6fd6bfd393e290, []int{6}
 }
 func (m *USDXMintingClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -308,7 +308,7 @@ func (m *HardLiquidityProviderClaim) Reset()         { *m = HardLiquidityProvide
 func (m *HardLiquidityProviderClaim) String() string { return proto.CompactTextString(m) }
 func (*HardLiquidityProviderClaim) ProtoMessage()    {}
 func (*HardLiquidityProviderClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{7}
+	return fileDescriptor_ef6fd6bfd393e290, []int{7}
 }
 func (m *HardLiquidityProviderClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,7 +347,7 @@ func (m *DelegatorClaim) Reset()         { *m = DelegatorClaim{} }
 func (m *DelegatorClaim) String() string { return proto.CompactTextString(m) }
 func (*DelegatorClaim) ProtoMessage()    {}
 func (*DelegatorClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{8}
+	return fileDescriptor_ef6fd6bfd393e290, []int{8}
 }
 func (m *DelegatorClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,7 +386,7 @@ func (m *SwapClaim) Reset()         { *m = SwapClaim{} }
 func (m *SwapClaim) String() string { return proto.CompactTextString(m) }
 func (*SwapClaim) ProtoMessage()    {}
 func (*SwapClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{9}
+	return fileDescriptor_ef6fd6bfd393e290, []int{9}
 }
 func (m *SwapClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -425,7 +425,7 @@ func (m *SavingsClaim) Reset()         { *m = SavingsClaim{} }
 func (m *SavingsClaim) String() string { return proto.CompactTextString(m) }
 func (*SavingsClaim) ProtoMessage()    {}
 func (*SavingsClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{10}
+	return fileDescriptor_ef6fd6bfd393e290, []int{10}
 }
 func (m *SavingsClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -464,7 +464,7 @@ func (m *EarnClaim) Reset()         { *m = EarnClaim{} }
 func (m *EarnClaim) String() string { return proto.CompactTextString(m) }
 func (*EarnClaim) ProtoMessage()    {}
 func (*EarnClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f7515029623a895, []int{11}
+	return fileDescriptor_ef6fd6bfd393e290, []int{11}
 }
 func (m *EarnClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -493,6 +493,47 @@ func (m *EarnClaim) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EarnClaim proto.InternalMessageInfo
 
+// SourceClaim stores the rewards that can be claimed by owner from a reward source. Reward indexes are tracked per
+// source ID, such as a pool or vault of the source.
+type SourceClaim struct {
+	Source         string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	BaseMultiClaim `protobuf:"bytes,2,opt,name=base_claim,json=baseClaim,proto3,embedded=base_claim" json:"base_claim"`
+	RewardIndexes  MultiRewardIndexes `protobuf:"bytes,3,rep,name=reward_indexes,json=rewardIndexes,proto3,castrepeated=MultiRewardIndexes" json:"reward_indexes"`
+}
+
+func (m *SourceClaim) Reset()         { *m = SourceClaim{} }
+func (m *SourceClaim) String() string { return proto.CompactTextString(m) }
+func (*SourceClaim) ProtoMessage()    {}
+func (*SourceClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef6fd6bfd393e290, []int{12}
+}
+func (m *SourceClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SourceClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SourceClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SourceClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceClaim.Merge(m, src)
+}
+func (m *SourceClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *SourceClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourceClaim proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*BaseClaim)(nil), "fury.incentive.v1beta1.BaseClaim")
 	proto.RegisterType((*BaseMultiClaim)(nil), "fury.incentive.v1beta1.BaseMultiClaim")
@@ -506,58 +547,61 @@ func init() {
 	proto.RegisterType((*SwapClaim)(nil), "fury.incentive.v1beta1.SwapClaim")
 	proto.RegisterType((*SavingsClaim)(nil), "fury.incentive.v1beta1.SavingsClaim")
 	proto.RegisterType((*EarnClaim)(nil), "fury.incentive.v1beta1.EarnClaim")
+	proto.RegisterType((*SourceClaim)(nil), "fury.incentive.v1beta1.SourceClaim")
 }
 
 func init() {
-	proto.RegisterFile("fury/incentive/v1beta1/claims.proto", fileDescriptor_5f7515029623a895)
-}
-
-var fileDescriptor_5f7515029623a895 = []byte{
-	// 691 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4f, 0x4f, 0x13, 0x4d,
-	0x18, 0xef, 0xc0, 0x0b, 0x79, 0x3b, 0x94, 0xbe, 0x64, 0x81, 0x57, 0xe8, 0x61, 0x8b, 0x25, 0xc1,
-	0x26, 0xa6, 0xbb, 0x82, 0x07, 0x13, 0x6f, 0x2c, 0x68, 0xc0, 0x48, 0x24, 0x5b, 0x4d, 0x8c, 0x07,
-	0x9b, 0xd9, 0xdd, 0xb1, 0x4e, 0xd8, 0xee, 0xd4, 0x99, 0x6d, 0x4b, 0x3f, 0x83, 0x17, 0xfd, 0x02,
-	0x7e, 0x00, 0x2f, 0x5e, 0xf8, 0x10, 0xc4, 0x78, 0x20, 0xc6, 0xc4, 0x3f, 0x87, 0x8a, 0x70, 0xf5,
-	0x13, 0x78, 0x32, 0xf3, 0x07, 0x58, 0xa0, 0x25, 0xc4, 0x14, 0x0f, 0x9c, 0x76, 0xe7, 0x99, 0x67,
-	0x9e, 0xdf, 0x9f, 0x79, 0x76, 0x76, 0xe0, 0xec, 0x06, 0x6a, 0x22, 0x9b, 0x44, 0x3e, 0x8e, 0x62,
-	0xd2, 0xc4, 0x76, 0x73, 0xde, 0xc3, 0x31, 0x9a, 0xb7, 0xfd, 0x10, 0x91, 0x1a, 0xb7, 0xea, 0x8c,
-	0xc6, 0xd4, 0xf8, 0x5f, 0x24, 0x59, 0x87, 0x49, 0x96, 0x4e, 0xca, 0x99, 0x3e, 0xe5, 0x35, 0xca,
-	0x6d, 0x0f, 0xf1, 0xc4, 0x4a, 0x4a, 0x22, 0xb5, 0x2e, 0x37, 0xad, 0xe6, 0x2b, 0x72, 0x64, 0xab,
-	0x81, 0x9e, 0x9a, 0xa8, 0xd2, 0x2a, 0x55, 0x71, 0xf1, 0xa6, 0xa2, 0x85, 0x77, 0x00, 0xa6, 0x1d,
-	0xc4, 0xf1, 0x92, 0x40, 0x37, 0x9e, 0xc2, 0x21, 0xda, 0x8a, 0x30, 0x9b, 0x02, 0x33, 0xa0, 0x98,
-	0x71, 0x56, 0x7e, 0x75, 0xf2, 0xa5, 0x2a, 0x89, 0x9f, 0x37, 0x3c, 0xcb, 0xa7, 0x35, 0x5d, 0x4f,
-	0x3f, 0x4a, 0x3c, 0xd8, 0xb0, 0xe3, 0x76, 0x1d, 0x73, 0x6b, 0xd1, 0xf7, 0x17, 0x83, 0x80, 0x61,
-	0xce, 0x3f, 0x6e, 0x95, 0xc6, 0x35, 0xaa, 0x8e, 0x38, 0xed, 0x18, 0x73, 0x57, 0x95, 0x35, 0x6e,
-	0xc1, 0x61, 0x86, 0x5b, 0x88, 0x05, 0x53, 0x03, 0x33, 0xa0, 0x38, 0xb2, 0x30, 0x6d, 0xe9, 0x64,
-	0xa1, 0xe7, 0x40, 0xa4, 0xb5, 0x44, 0x49, 0xe4, 0xfc, 0xb3, 0xdd, 0xc9, 0xa7, 0x5c, 0x9d, 0x7e,
-	0x3b, 0xfd, 0x7e, 0xab, 0x34, 0x24, 0x39, 0x16, 0x76, 0x01, 0xcc, 0x0a, 0xc6, 0x6b, 0x8d, 0x30,
-	0x26, 0x7f, 0x87, 0xb6, 0x9f, 0xa0, 0x3d, 0x78, 0x36, 0xed, 0x1b, 0x82, 0xf6, 0xdb, 0xef, 0xf9,
-	0xe2, 0x39, 0xf0, 0xc5, 0x02, 0xde, 0x4d, 0xe2, 0x4b, 0x00, 0x47, 0x5c, 0x19, 0x5d, 0x8d, 0x02,
-	0xbc, 0x69, 0x5c, 0x83, 0xff, 0xf9, 0x34, 0x0c, 0x51, 0x8c, 0x19, 0x0a, 0x2b, 0x62, 0xb1, 0x54,
-	0x9a, 0x76, 0xb3, 0x47, 0xe1, 0x87, 0xed, 0x3a, 0x36, 0xca, 0x70, 0x54, 0x55, 0xab, 0x3c, 0x43,
-	0x7e, 0x4c, 0x99, 0xb4, 0x39, 0xe3, 0x58, 0x82, 0xd4, 0xb7, 0x4e, 0x7e, 0xee, 0x1c, 0xa4, 0x96,
-	0xb1, 0xef, 0x66, 0x54, 0x91, 0xbb, 0xb2, 0x46, 0xa1, 0x05, 0x8d, 0x04, 0x19, 0xcc, 0xd7, 0x65,
-	0x87, 0x22, 0x98, 0xd5, 0x50, 0x44, 0x85, 0xa7, 0x80, 0xf4, 0x66, 0xd6, 0xea, 0xde, 0xba, 0x56,
-	0xa2, 0x86, 0x33, 0xa9, 0x5d, 0x1a, 0x3d, 0x56, 0xd8, 0xd5, 0xe4, 0xf5, 0xb0, 0xf0, 0x06, 0xc0,
-	0x31, 0xb9, 0xcb, 0x7f, 0xe4, 0xc5, 0x69, 0x82, 0x03, 0xfd, 0x26, 0xf8, 0x1a, 0xc0, 0x2b, 0x27,
-	0x09, 0x1e, 0xf8, 0xd3, 0x84, 0x13, 0x35, 0x31, 0x55, 0xe9, 0xea, 0x52, 0xb1, 0x17, 0x89, 0x93,
-	0xe5, 0x9c, 0x9c, 0x66, 0x62, 0x9c, 0x06, 0x72, 0x8d, 0xda, 0xa9, 0x58, 0xe1, 0x03, 0x80, 0x63,
-	0x8f, 0xca, 0xcb, 0x8f, 0xd7, 0x48, 0x14, 0x93, 0xa8, 0xaa, 0x3e, 0x90, 0x7b, 0x10, 0x8a, 0x56,
-	0xad, 0xc8, 0x33, 0x46, 0xfa, 0x35, 0xb2, 0x70, 0xb5, 0x17, 0x85, 0xc3, 0xe3, 0xc0, 0xf9, 0x57,
-	0x60, 0xef, 0x74, 0xf2, 0xc0, 0x4d, 0x7b, 0x87, 0x67, 0xc4, 0xc5, 0xfb, 0x9a, 0xfc, 0x14, 0x7e,
-	0x0e, 0xc0, 0xdc, 0x0a, 0x62, 0xc1, 0x7d, 0xf2, 0xa2, 0x41, 0x02, 0x12, 0xb7, 0xd7, 0x19, 0x6d,
-	0x92, 0x00, 0x33, 0x45, 0xe6, 0x41, 0x17, 0x61, 0x73, 0x67, 0x09, 0x3b, 0x3a, 0x35, 0xba, 0xab,
-	0xdb, 0x84, 0x93, 0xbc, 0x51, 0xaf, 0x87, 0xed, 0x4a, 0x57, 0x91, 0xfd, 0xd9, 0xb7, 0x71, 0x05,
-	0x71, 0x2c, 0x28, 0x90, 0x3d, 0xca, 0x18, 0x6d, 0x9d, 0x44, 0x1e, 0xec, 0x27, 0xb2, 0x82, 0x70,
-	0x7b, 0xd9, 0xfd, 0x15, 0xc0, 0xec, 0x32, 0x0e, 0x71, 0x15, 0xc5, 0xf4, 0xa2, 0x2c, 0xde, 0xe8,
-	0xd1, 0x40, 0xfd, 0x51, 0xd8, 0xbb, 0x95, 0x3e, 0x01, 0x98, 0x2e, 0xb7, 0x50, 0xfd, 0x92, 0xc9,
-	0xfa, 0x0c, 0x60, 0xa6, 0x8c, 0x9a, 0x24, 0xaa, 0xf2, 0x4b, 0xb8, 0x61, 0x77, 0x10, 0x8b, 0x2e,
-	0x97, 0x2c, 0x67, 0x75, 0xfb, 0x87, 0x99, 0xda, 0xde, 0x33, 0xc1, 0xce, 0x9e, 0x09, 0x76, 0xf7,
-	0x4c, 0xf0, 0x6a, 0xdf, 0x4c, 0xed, 0xec, 0x9b, 0xa9, 0x2f, 0xfb, 0x66, 0xea, 0xc9, 0xf5, 0xc4,
-	0x3f, 0x5a, 0xf0, 0x28, 0x85, 0xc8, 0xe3, 0xf2, 0xcd, 0xde, 0x4c, 0xdc, 0x1a, 0xe5, 0xcf, 0xda,
-	0x1b, 0x96, 0x97, 0xb8, 0x9b, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x88, 0xd7, 0x8a, 0x4f, 0x54,
-	0x0a, 0x00, 0x00,
+	proto.RegisterFile("fury/incentive/v1beta1/claims.proto", fileDescriptor_ef6fd6bfd393e290)
+}
+
+var fileDescriptor_ef6fd6bfd393e290 = []byte{
+	// 721 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4d, 0x4f, 0x13, 0x4f,
+	0x18, 0xef, 0x94, 0x3f, 0xe4, 0xdf, 0x69, 0xa9, 0x64, 0x79, 0x11, 0x7a, 0xd8, 0x62, 0x49, 0xb0,
+	0x97, 0xee, 0x0a, 0x1e, 0x4c, 0xbc, 0xb1, 0xa0, 0x41, 0x23, 0x81, 0x6c, 0x35, 0x31, 0x1e, 0x6c,
+	0x66, 0x77, 0x87, 0x3a, 0x61, 0xbb, 0x53, 0x67, 0x76, 0x5b, 0xfa, 0x19, 0xbc, 0xe8, 0x17, 0xf0,
+	0x03, 0x78, 0xf1, 0xc2, 0x87, 0x20, 0xc6, 0x03, 0x31, 0x26, 0xbe, 0x1c, 0x2a, 0xc2, 0xd5, 0xf8,
+	0x01, 0x3c, 0x99, 0x9d, 0x1d, 0x60, 0x29, 0x2d, 0x21, 0xa6, 0x70, 0xe8, 0x09, 0xe6, 0x99, 0x67,
+	0x9e, 0xdf, 0xcb, 0x3c, 0x3b, 0x7d, 0xe0, 0xdc, 0x66, 0xc0, 0x5a, 0x3a, 0xf1, 0x6c, 0xec, 0xf9,
+	0xa4, 0x81, 0xf5, 0xc6, 0x82, 0x85, 0x7d, 0xb4, 0xa0, 0xdb, 0x2e, 0x22, 0x35, 0xae, 0xd5, 0x19,
+	0xf5, 0xa9, 0x32, 0x15, 0x26, 0x69, 0xc7, 0x49, 0x9a, 0x4c, 0xca, 0xa9, 0x36, 0xe5, 0x35, 0xca,
+	0x75, 0x0b, 0xf1, 0xd8, 0x49, 0x4a, 0xbc, 0xe8, 0x5c, 0x6e, 0x26, 0xda, 0xaf, 0x88, 0x95, 0x1e,
+	0x2d, 0xe4, 0xd6, 0x44, 0x95, 0x56, 0x69, 0x14, 0x0f, 0xff, 0x8b, 0xa2, 0x85, 0xf7, 0x00, 0xa6,
+	0x0c, 0xc4, 0xf1, 0x72, 0x88, 0xae, 0x3c, 0x87, 0xc3, 0xb4, 0xe9, 0x61, 0x36, 0x0d, 0x66, 0x41,
+	0x31, 0x63, 0xac, 0xfe, 0x69, 0xe7, 0x4b, 0x55, 0xe2, 0xbf, 0x08, 0x2c, 0xcd, 0xa6, 0x35, 0x59,
+	0x4f, 0xfe, 0x29, 0x71, 0x67, 0x4b, 0xf7, 0x5b, 0x75, 0xcc, 0xb5, 0x25, 0xdb, 0x5e, 0x72, 0x1c,
+	0x86, 0x39, 0xff, 0xb4, 0x53, 0x1a, 0x97, 0xa8, 0x32, 0x62, 0xb4, 0x7c, 0xcc, 0xcd, 0xa8, 0xac,
+	0x72, 0x07, 0x8e, 0x30, 0xdc, 0x44, 0xcc, 0x99, 0x4e, 0xce, 0x82, 0x62, 0x7a, 0x71, 0x46, 0x93,
+	0xc9, 0xa1, 0x9e, 0x23, 0x91, 0xda, 0x32, 0x25, 0x9e, 0xf1, 0xdf, 0x6e, 0x3b, 0x9f, 0x30, 0x65,
+	0xfa, 0xdd, 0xd4, 0x87, 0x9d, 0xd2, 0xb0, 0xe0, 0x58, 0xd8, 0x07, 0x30, 0x1b, 0x32, 0x5e, 0x0b,
+	0x5c, 0x9f, 0x5c, 0x0d, 0x6d, 0x3b, 0x46, 0x7b, 0xe8, 0x7c, 0xda, 0xb7, 0x42, 0xda, 0xef, 0x7e,
+	0xe4, 0x8b, 0x17, 0xc0, 0x0f, 0x0f, 0xf0, 0x6e, 0x12, 0x5f, 0x01, 0x98, 0x36, 0x45, 0xf4, 0x81,
+	0xe7, 0xe0, 0x6d, 0xe5, 0x26, 0xbc, 0x66, 0x53, 0xd7, 0x45, 0x3e, 0x66, 0xc8, 0xad, 0x84, 0x87,
+	0x85, 0xd2, 0x94, 0x99, 0x3d, 0x09, 0x3f, 0x6e, 0xd5, 0xb1, 0x52, 0x86, 0xa3, 0x51, 0xb5, 0xca,
+	0x26, 0xb2, 0x7d, 0xca, 0x84, 0xcd, 0x19, 0x43, 0x0b, 0x49, 0x7d, 0x6f, 0xe7, 0xe7, 0x2f, 0x40,
+	0x6a, 0x05, 0xdb, 0x66, 0x26, 0x2a, 0x72, 0x5f, 0xd4, 0x28, 0x34, 0xa1, 0x12, 0x23, 0x83, 0xf9,
+	0x86, 0xe8, 0x50, 0x04, 0xb3, 0x12, 0x8a, 0x44, 0xe1, 0x69, 0x20, 0xbc, 0x99, 0xd3, 0xba, 0xb7,
+	0xae, 0x16, 0xab, 0x61, 0x4c, 0x4a, 0x97, 0x46, 0x4f, 0x15, 0x36, 0x47, 0x59, 0x7c, 0x59, 0x78,
+	0x0b, 0xe0, 0x98, 0xb8, 0xe5, 0x7f, 0xf2, 0xe2, 0x2c, 0xc1, 0x64, 0xbf, 0x09, 0xbe, 0x01, 0xf0,
+	0x7a, 0x27, 0xc1, 0x23, 0x7f, 0x1a, 0x70, 0xa2, 0x16, 0x6e, 0x55, 0xba, 0xba, 0x54, 0xec, 0x45,
+	0xa2, 0xb3, 0x9c, 0x91, 0x93, 0x4c, 0x94, 0xb3, 0x40, 0xa6, 0x52, 0x3b, 0x13, 0x2b, 0x7c, 0x04,
+	0x70, 0xec, 0x49, 0x79, 0xe5, 0xe9, 0x1a, 0xf1, 0x7c, 0xe2, 0x55, 0xa3, 0x0f, 0xe4, 0x21, 0x84,
+	0x61, 0xab, 0x56, 0xc4, 0x1b, 0x23, 0xfc, 0x4a, 0x2f, 0xde, 0xe8, 0x45, 0xe1, 0xf8, 0x39, 0x30,
+	0xfe, 0x0f, 0xb1, 0xf7, 0xda, 0x79, 0x60, 0xa6, 0xac, 0xa3, 0xe0, 0x15, 0xf8, 0x1a, 0xff, 0x14,
+	0x7e, 0x25, 0x61, 0x6e, 0x15, 0x31, 0xe7, 0x11, 0x79, 0x19, 0x10, 0x87, 0xf8, 0xad, 0x0d, 0x46,
+	0x1b, 0xc4, 0xc1, 0x2c, 0x22, 0xb3, 0xde, 0x45, 0xd8, 0xfc, 0x79, 0xc2, 0x4e, 0x5e, 0x8d, 0xee,
+	0xea, 0xb6, 0xe1, 0x24, 0x0f, 0xea, 0x75, 0xb7, 0x55, 0xe9, 0x2a, 0xb2, 0x3f, 0xf7, 0x36, 0x1e,
+	0x41, 0x9c, 0x0a, 0x86, 0xc8, 0x16, 0x65, 0x8c, 0x36, 0x3b, 0x91, 0x87, 0xfa, 0x89, 0x1c, 0x41,
+	0x98, 0xbd, 0xec, 0xfe, 0x06, 0x60, 0x76, 0x05, 0xbb, 0xb8, 0x8a, 0x7c, 0x7a, 0x59, 0x16, 0x6f,
+	0xf5, 0x68, 0xa0, 0xfe, 0x28, 0xec, 0xdd, 0x4a, 0x9f, 0x01, 0x4c, 0x95, 0x9b, 0xa8, 0x3e, 0x60,
+	0xb2, 0xbe, 0x00, 0x98, 0x29, 0xa3, 0x06, 0xf1, 0xaa, 0x7c, 0x00, 0x2f, 0xec, 0x1e, 0x62, 0xde,
+	0x80, 0xc9, 0xfa, 0x0d, 0x60, 0xba, 0x4c, 0x03, 0x66, 0x4b, 0x1e, 0x53, 0x70, 0x84, 0x8b, 0xa5,
+	0xfc, 0x21, 0x93, 0xab, 0x0e, 0xc1, 0xc9, 0xcb, 0x10, 0x3c, 0x74, 0x15, 0x82, 0x8d, 0xf5, 0xdd,
+	0x9f, 0x6a, 0x62, 0xf7, 0x40, 0x05, 0x7b, 0x07, 0x2a, 0xd8, 0x3f, 0x50, 0xc1, 0xeb, 0x43, 0x35,
+	0xb1, 0x77, 0xa8, 0x26, 0xbe, 0x1e, 0xaa, 0x89, 0x67, 0x0b, 0xb1, 0xa1, 0x84, 0x78, 0x76, 0x60,
+	0x05, 0xbc, 0xe4, 0x61, 0xbf, 0x49, 0xd9, 0x96, 0x2e, 0x46, 0xe5, 0xed, 0xd8, 0xb0, 0x2c, 0x66,
+	0x14, 0x6b, 0x44, 0xcc, 0xae, 0xb7, 0xff, 0x0e, 0x00, 0xb5, 0x11, 0x03, 0x02, 0x4b, 0x0b, 0x00,
+	0x00,
 }
 
 func (m *BaseClaim) Marshal() (dAtA []byte, err error) {
@@ -1098,6 +1142,60 @@ func (m *EarnClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SourceClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SourceClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SourceClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardIndexes) > 0 {
+		for iNdEx := len(m.RewardIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardIndexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClaims(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.BaseMultiClaim.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClaims(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintClaims(dAtA []byte, offset int, v uint64) int {
 	offset -= sovClaims(v)
 	base := offset
@@ -1315,6 +1413,27 @@ func (m *EarnClaim) Size() (n int) {
 	return n
 }
 
+func (m *SourceClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	l = m.BaseMultiClaim.Size()
+	n += 1 + l + sovClaims(uint64(l))
+	if len(m.RewardIndexes) > 0 {
+		for _, e := range m.RewardIndexes {
+			l = e.Size()
+			n += 1 + l + sovClaims(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovClaims(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2691,6 +2810,155 @@ func (m *EarnClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SourceClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaims
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SourceClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SourceClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseMultiClaim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseMultiClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardIndexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardIndexes = append(m.RewardIndexes, MultiRewardIndex{})
+			if err := m.RewardIndexes[len(m.RewardIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaims(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipClaims(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
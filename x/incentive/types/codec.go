@@ -18,6 +18,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgClaimSwapReward{}, "incentive/MsgClaimSwapReward", nil)
 	cdc.RegisterConcrete(&MsgClaimSavingsReward{}, "incentive/MsgClaimSavingsReward", nil)
 	cdc.RegisterConcrete(&MsgClaimEarnReward{}, "incentive/MsgClaimEarnReward", nil)
+	cdc.RegisterConcrete(&MsgClaimAllRewards{}, "incentive/MsgClaimAllRewards", nil)
+	cdc.RegisterConcrete(&MsgClaimReward{}, "incentive/MsgClaimReward", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -28,6 +30,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgClaimSwapReward{},
 		&MsgClaimSavingsReward{},
 		&MsgClaimEarnReward{},
+		&MsgClaimAllRewards{},
+		&MsgClaimReward{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
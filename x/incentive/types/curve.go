@@ -0,0 +1,232 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxHalvings is the number of halvings after which a halving curve stops paying rewards, as the rate rounds to zero.
+const MaxHalvings = 64
+
+// NewLinearRewardCurve returns a curve decaying the reward rate linearly to endFactor of the rate at the period end.
+func NewLinearRewardCurve(endFactor sdk.Dec) *RewardCurve {
+	return &RewardCurve{
+		Type:      REWARD_CURVE_TYPE_LINEAR,
+		EndFactor: endFactor,
+	}
+}
+
+// NewStepRewardCurve returns a curve scaling the reward rate by the factor of the latest step that has started.
+func NewStepRewardCurve(steps ...RewardStep) *RewardCurve {
+	return &RewardCurve{
+		Type:      REWARD_CURVE_TYPE_STEPS,
+		EndFactor: sdk.ZeroDec(),
+		Steps:     steps,
+	}
+}
+
+// NewHalvingRewardCurve returns a curve halving the reward rate every interval.
+func NewHalvingRewardCurve(interval time.Duration) *RewardCurve {
+	return &RewardCurve{
+		Type:            REWARD_CURVE_TYPE_HALVING,
+		EndFactor:       sdk.ZeroDec(),
+		HalvingInterval: interval,
+	}
+}
+
+// NewRewardStep returns a new RewardStep
+func NewRewardStep(offset time.Duration, factor sdk.Dec) RewardStep {
+	return RewardStep{
+		Offset: offset,
+		Factor: factor,
+	}
+}
+
+// Validate performs a basic check of a RewardCurve for a period of the given length.
+func (rc RewardCurve) Validate(periodLength time.Duration) error {
+	switch rc.Type {
+	case REWARD_CURVE_TYPE_CONSTANT:
+		return nil
+	case REWARD_CURVE_TYPE_LINEAR:
+		if rc.EndFactor.IsNil() || rc.EndFactor.IsNegative() {
+			return fmt.Errorf("linear reward curve end factor must be non negative: %s", rc.EndFactor)
+		}
+		if periodLength < time.Second {
+			return errors.New("linear reward curve period must be at least a second long")
+		}
+		return nil
+	case REWARD_CURVE_TYPE_STEPS:
+		if len(rc.Steps) == 0 {
+			return errors.New("step reward curve must have steps")
+		}
+		var previousOffset time.Duration
+		for i, step := range rc.Steps {
+			if step.Offset < 0 || (i > 0 && step.Offset <= previousOffset) {
+				return fmt.Errorf("reward curve step offsets must be increasing and non negative: %s", step.Offset)
+			}
+			if step.Offset >= periodLength {
+				return fmt.Errorf("reward curve step offset %s must be before the period end", step.Offset)
+			}
+			if step.Factor.IsNil() || step.Factor.IsNegative() {
+				return fmt.Errorf("reward curve step factor must be non negative: %s", step.Factor)
+			}
+			previousOffset = step.Offset
+		}
+		return nil
+	case REWARD_CURVE_TYPE_HALVING:
+		if rc.HalvingInterval < time.Second {
+			return fmt.Errorf("reward curve halving interval must be at least a second: %s", rc.HalvingInterval)
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid reward curve type: %s", rc.Type)
+	}
+}
+
+// FactorAt returns the fraction of the base reward rate paid the given number of seconds after the period start, for
+// a period lasting periodSeconds.
+func (rc RewardCurve) FactorAt(periodSeconds, offsetSeconds int64) sdk.Dec {
+	switch rc.Type {
+	case REWARD_CURVE_TYPE_LINEAR:
+		// f(t) = 1 + (endFactor - 1) * t / T
+		return sdk.OneDec().Add(
+			rc.EndFactor.Sub(sdk.OneDec()).MulInt64(offsetSeconds).QuoInt64(periodSeconds),
+		)
+	case REWARD_CURVE_TYPE_STEPS:
+		factor := sdk.OneDec()
+		for _, step := range rc.Steps {
+			if stepSeconds(step) > offsetSeconds {
+				break
+			}
+			factor = step.Factor
+		}
+		return factor
+	case REWARD_CURVE_TYPE_HALVING:
+		halvings := offsetSeconds / durationSeconds(rc.HalvingInterval)
+		if halvings >= MaxHalvings {
+			return sdk.ZeroDec()
+		}
+		return sdk.OneDec().Quo(sdk.NewDec(2).Power(uint64(halvings)))
+	default:
+		return sdk.OneDec()
+	}
+}
+
+// EmissionSeconds returns the integral of the curve's factor between two offsets from the period start, in seconds.
+// Multiplying the base reward rate by it gives the rewards emitted between the offsets.
+func (rc RewardCurve) EmissionSeconds(periodSeconds, fromSeconds, toSeconds int64) sdk.Dec {
+	if toSeconds <= fromSeconds {
+		return sdk.ZeroDec()
+	}
+
+	switch rc.Type {
+	case REWARD_CURVE_TYPE_LINEAR:
+		// the area under a straight line is its length times its average height
+		average := rc.FactorAt(periodSeconds, fromSeconds).Add(rc.FactorAt(periodSeconds, toSeconds)).QuoInt64(2)
+		return average.MulInt64(toSeconds - fromSeconds)
+	case REWARD_CURVE_TYPE_STEPS, REWARD_CURVE_TYPE_HALVING:
+		// the curve is constant between changes, so sum the constant segments in the range
+		total := sdk.ZeroDec()
+		for segmentStart := fromSeconds; segmentStart < toSeconds; {
+			segmentEnd := minInt64(rc.nextChange(segmentStart), toSeconds)
+			total = total.Add(rc.FactorAt(periodSeconds, segmentStart).MulInt64(segmentEnd - segmentStart))
+			segmentStart = segmentEnd
+		}
+		return total
+	default:
+		return sdk.NewDec(toSeconds - fromSeconds)
+	}
+}
+
+// nextChange returns the offset of the next change of a piecewise constant curve after the given offset.
+func (rc RewardCurve) nextChange(offsetSeconds int64) int64 {
+	switch rc.Type {
+	case REWARD_CURVE_TYPE_STEPS:
+		for _, step := range rc.Steps {
+			if stepSeconds(step) > offsetSeconds {
+				return stepSeconds(step)
+			}
+		}
+	case REWARD_CURVE_TYPE_HALVING:
+		interval := durationSeconds(rc.HalvingInterval)
+		halvings := offsetSeconds / interval
+		if halvings < MaxHalvings {
+			return (halvings + 1) * interval
+		}
+	}
+	return math.MaxInt64
+}
+
+// RewardsPerSecondAt returns the reward rate of the period at the given time, following its emission curve.
+func (mrp MultiRewardPeriod) RewardsPerSecondAt(t time.Time) sdk.DecCoins {
+	rewardsPerSecond := sdk.NewDecCoinsFromCoins(mrp.RewardsPerSecond...)
+	if mrp.Curve == nil {
+		return rewardsPerSecond
+	}
+	offset := maxTime(mrp.Start, minTime(t, mrp.End)).Sub(mrp.Start)
+	return rewardsPerSecond.MulDec(mrp.Curve.FactorAt(durationSeconds(mrp.End.Sub(mrp.Start)), durationSeconds(offset)))
+}
+
+// RewardsBetween returns the rewards the period emits between two times, following its emission curve, and the time
+// up to which they were calculated. Rewards are not emitted outside of the start and end times of the period.
+func (mrp MultiRewardPeriod) RewardsBetween(previousTime, currentTime time.Time) (sdk.DecCoins, time.Time) {
+	return CalculateCurveRewards(
+		mrp.Start,
+		mrp.End,
+		mrp.Curve,
+		sdk.NewDecCoinsFromCoins(mrp.RewardsPerSecond...),
+		previousTime,
+		currentTime,
+	)
+}
+
+// CalculateCurveRewards calculates the rewards emitted between two times by a base reward rate following an
+// emission curve, and the time up to which they were calculated. A nil curve emits rewards at a constant rate.
+func CalculateCurveRewards(
+	periodStart time.Time,
+	periodEnd time.Time,
+	curve *RewardCurve,
+	periodRewardsPerSecond sdk.DecCoins,
+	previousTime, currentTime time.Time,
+) (sdk.DecCoins, time.Time) {
+	if curve == nil || curve.Type == REWARD_CURVE_TYPE_CONSTANT {
+		return CalculatePerSecondRewards(periodStart, periodEnd, periodRewardsPerSecond, previousTime, currentTime)
+	}
+
+	duration := (&Accumulator{}).getTimeElapsedWithinLimits(previousTime, currentTime, periodStart, periodEnd)
+	upTo := minTime(periodEnd, currentTime)
+	if duration <= 0 {
+		return nil, upTo
+	}
+
+	from := maxTime(previousTime, periodStart).Sub(periodStart)
+	emissionSeconds := curve.EmissionSeconds(
+		durationSeconds(periodEnd.Sub(periodStart)),
+		durationSeconds(from),
+		durationSeconds(from+duration),
+	)
+	if !emissionSeconds.IsPositive() {
+		return nil, upTo
+	}
+	return periodRewardsPerSecond.MulDec(emissionSeconds), upTo
+}
+
+// durationSeconds rounds a duration to seconds, consistent with the rounding of constant rate rewards.
+func durationSeconds(d time.Duration) int64 {
+	return int64(math.RoundToEven(d.Seconds()))
+}
+
+func stepSeconds(step RewardStep) int64 {
+	return durationSeconds(step.Offset)
+}
+
+func minInt64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,130 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewardCurve_Validate(t *testing.T) {
+	period := 100 * time.Second
+
+	testCases := []struct {
+		name    string
+		curve   RewardCurve
+		wantErr bool
+	}{
+		{"constant", RewardCurve{}, false},
+		{"linear", *NewLinearRewardCurve(d("0.25")), false},
+		{"linear to zero", *NewLinearRewardCurve(sdk.ZeroDec()), false},
+		{"linear negative end factor", *NewLinearRewardCurve(d("-0.1")), true},
+		{"linear nil end factor", RewardCurve{Type: REWARD_CURVE_TYPE_LINEAR}, true},
+		{"steps", *NewStepRewardCurve(NewRewardStep(10*time.Second, d("0.5")), NewRewardStep(50*time.Second, d("0.1"))), false},
+		{"steps empty", *NewStepRewardCurve(), true},
+		{"steps out of order", *NewStepRewardCurve(NewRewardStep(50*time.Second, d("0.5")), NewRewardStep(10*time.Second, d("0.1"))), true},
+		{"steps after period end", *NewStepRewardCurve(NewRewardStep(100*time.Second, d("0.5"))), true},
+		{"steps negative factor", *NewStepRewardCurve(NewRewardStep(10*time.Second, d("-0.5"))), true},
+		{"halving", *NewHalvingRewardCurve(10 * time.Second), false},
+		{"halving interval too short", *NewHalvingRewardCurve(time.Millisecond), true},
+		{"unknown type", RewardCurve{Type: 99}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.curve.Validate(period)
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRewardCurve_FactorAt(t *testing.T) {
+	linear := NewLinearRewardCurve(d("0.2"))
+	require.Equal(t, d("1.0"), linear.FactorAt(100, 0))
+	require.Equal(t, d("0.6"), linear.FactorAt(100, 50))
+	require.Equal(t, d("0.2"), linear.FactorAt(100, 100))
+
+	steps := NewStepRewardCurve(NewRewardStep(10*time.Second, d("0.5")), NewRewardStep(50*time.Second, d("0.1")))
+	require.Equal(t, d("1.0"), steps.FactorAt(100, 9))
+	require.Equal(t, d("0.5"), steps.FactorAt(100, 10))
+	require.Equal(t, d("0.5"), steps.FactorAt(100, 49))
+	require.Equal(t, d("0.1"), steps.FactorAt(100, 99))
+
+	halving := NewHalvingRewardCurve(10 * time.Second)
+	require.Equal(t, d("1.0"), halving.FactorAt(100, 9))
+	require.Equal(t, d("0.5"), halving.FactorAt(100, 10))
+	require.Equal(t, d("0.125"), halving.FactorAt(100, 35))
+	require.Equal(t, sdk.ZeroDec(), halving.FactorAt(100, 10*MaxHalvings))
+}
+
+func TestRewardCurve_EmissionSeconds(t *testing.T) {
+	curves := map[string]*RewardCurve{
+		"linear":  NewLinearRewardCurve(d("0.2")),
+		"steps":   NewStepRewardCurve(NewRewardStep(10*time.Second, d("0.5")), NewRewardStep(50*time.Second, d("0.1"))),
+		"halving": NewHalvingRewardCurve(10 * time.Second),
+	}
+	expectedTotals := map[string]sdk.Dec{
+		"linear":  d("60"),          // average factor of 0.6 over 100 seconds
+		"steps":   d("35"),          // 10*1 + 40*0.5 + 50*0.1
+		"halving": d("19.98046875"), // 10 * (1 + 1/2 + ... + 1/512)
+	}
+
+	for name, curve := range curves {
+		t.Run(name, func(t *testing.T) {
+			total := curve.EmissionSeconds(100, 0, 100)
+			require.Equal(t, expectedTotals[name], total)
+
+			// emissions are additive, so accumulating in many small blocks pays the same as in one large block
+			sum := sdk.ZeroDec()
+			for offset := int64(0); offset < 100; offset += 7 {
+				sum = sum.Add(curve.EmissionSeconds(100, offset, minInt64(offset+7, 100)))
+			}
+			require.Equal(t, total, sum)
+
+			require.Equal(t, sdk.ZeroDec(), curve.EmissionSeconds(100, 50, 50))
+		})
+	}
+}
+
+func TestCalculateCurveRewards(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(100 * time.Second)
+	rewardsPerSecond := sdk.NewDecCoinsFromCoins(c("hard", 1000))
+
+	t.Run("nil curve emits at a constant rate", func(t *testing.T) {
+		rewards, upTo := CalculateCurveRewards(start, end, nil, rewardsPerSecond, start, start.Add(10*time.Second))
+		require.Equal(t, sdk.NewDecCoinsFromCoins(c("hard", 10_000)), rewards)
+		require.Equal(t, start.Add(10*time.Second), upTo)
+	})
+	t.Run("steps emit at the rate of each step", func(t *testing.T) {
+		curve := NewStepRewardCurve(NewRewardStep(10*time.Second, d("0.5")))
+		rewards, upTo := CalculateCurveRewards(start, end, curve, rewardsPerSecond, start.Add(5*time.Second), start.Add(20*time.Second))
+		require.Equal(t, sdk.NewDecCoinsFromCoins(c("hard", 10_000)), rewards) // 5*1000 + 10*500
+		require.Equal(t, start.Add(20*time.Second), upTo)
+	})
+	t.Run("rewards stop at the period end", func(t *testing.T) {
+		curve := NewLinearRewardCurve(sdk.ZeroDec())
+		rewards, upTo := CalculateCurveRewards(start, end, curve, rewardsPerSecond, start.Add(90*time.Second), end.Add(time.Hour))
+		require.Equal(t, sdk.NewDecCoinsFromCoins(c("hard", 500)), rewards) // average factor of 0.05 over 10 seconds
+		require.Equal(t, end, upTo)
+	})
+	t.Run("no rewards once a curve reaches zero", func(t *testing.T) {
+		curve := NewStepRewardCurve(NewRewardStep(10*time.Second, sdk.ZeroDec()))
+		rewards, _ := CalculateCurveRewards(start, end, curve, rewardsPerSecond, start.Add(20*time.Second), start.Add(30*time.Second))
+		require.Nil(t, rewards)
+	})
+}
+
+func TestMultiRewardPeriod_RewardsPerSecondAt(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	period := NewMultiRewardPeriod(true, "bnb", start, start.Add(100*time.Second), cs(c("hard", 1000)))
+	require.Equal(t, sdk.NewDecCoinsFromCoins(c("hard", 1000)), period.RewardsPerSecondAt(start.Add(50*time.Second)))
+
+	period.Curve = NewHalvingRewardCurve(10 * time.Second)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(c("hard", 1000)), period.RewardsPerSecondAt(start.Add(-time.Hour)))
+	require.Equal(t, sdk.NewDecCoinsFromCoins(c("hard", 250)), period.RewardsPerSecondAt(start.Add(25*time.Second)))
+}
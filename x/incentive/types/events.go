@@ -3,14 +3,20 @@ package types
 // Events emitted by the incentive module
 const (
 	EventTypeClaim             = "claim_reward"
+	EventTypeClaimDestination  = "claim_reward_destination"
 	EventTypeRewardPeriod      = "new_reward_period"
 	EventTypeClaimPeriod       = "new_claim_period"
 	EventTypeClaimPeriodExpiry = "claim_period_expiry"
+	EventTypeBudgetExhausted   = "reward_budget_exhausted"
 
-	AttributeValueCategory   = ModuleName
-	AttributeKeyClaimedBy    = "claimed_by"
-	AttributeKeyClaimAmount  = "claim_amount"
-	AttributeKeyClaimType    = "claim_type"
-	AttributeKeyRewardPeriod = "reward_period"
-	AttributeKeyClaimPeriod  = "claim_period"
+	AttributeValueCategory       = ModuleName
+	AttributeKeyClaimedBy        = "claimed_by"
+	AttributeKeyClaimAmount      = "claim_amount"
+	AttributeKeyClaimType        = "claim_type"
+	AttributeKeyClaimDestination = "claim_destination"
+	AttributeKeyRewardPeriod     = "reward_period"
+	AttributeKeyClaimPeriod      = "claim_period"
+	AttributeKeyRewardSource     = "reward_source"
+	AttributeKeyCollateralType   = "collateral_type"
+	AttributeKeyBudget           = "budget"
 )
@@ -17,7 +17,9 @@ import (
 // ParamSubspace defines the expected Subspace interfacace
 type ParamSubspace interface {
 	GetParamSet(sdk.Context, paramtypes.ParamSet)
+	GetIfExists(ctx sdk.Context, key []byte, ptr interface{})
 	SetParamSet(sdk.Context, paramtypes.ParamSet)
+	Set(ctx sdk.Context, key []byte, value interface{})
 	WithKeyTable(paramtypes.KeyTable) paramtypes.Subspace
 	HasKeyTable() bool
 }
@@ -25,6 +27,7 @@ type ParamSubspace interface {
 // BankKeeper defines the expected interface needed to send coins
 type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 }
@@ -66,14 +69,24 @@ type SwapKeeper interface {
 type SavingsKeeper interface {
 	GetDeposit(ctx sdk.Context, depositor sdk.AccAddress) (savingstypes.Deposit, bool)
 	GetSavingsModuleAccountBalances(ctx sdk.Context) sdk.Coins
+	GetTotalSourceShares(ctx sdk.Context, denom string) sdk.Dec
+	Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error
 }
 
 // EarnKeeper defines the required methods needed by this modules keeper
 type EarnKeeper interface {
 	GetVaultTotalShares(ctx sdk.Context, denom string) (shares earntypes.VaultShare, found bool)
 	GetVaultTotalValue(ctx sdk.Context, denom string) (sdk.Coin, error)
-	GetVaultAccountShares(ctx sdk.Context, acc sdk.AccAddress) (shares earntypes.VaultShares, found bool)
+	GetVaultAccountRewardShares(ctx sdk.Context, acc sdk.AccAddress, denom string) sdk.Dec
 	IterateVaultRecords(ctx sdk.Context, cb func(record earntypes.VaultRecord) (stop bool))
+	GetAllowedVault(ctx sdk.Context, vaultDenom string) (earntypes.AllowedVault, bool)
+	Deposit(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.Coin, depositStrategy earntypes.StrategyType) error
+}
+
+// RouterKeeper defines the required methods needed by this modules keeper
+type RouterKeeper interface {
+	Delegate(ctx sdk.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) (sdk.Dec, error)
+	DelegateMintDeposit(ctx sdk.Context, depositor sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) error
 }
 
 // LiquidKeeper defines the required methods needed by this modules keeper
@@ -86,6 +99,7 @@ type LiquidKeeper interface {
 		derivativeDenom string,
 		destinationModAccount string,
 	) (sdk.Coins, error)
+	IsCompoundingEnabled(ctx sdk.Context) bool
 }
 
 // AccountKeeper expected interface for the account keeper (noalias)
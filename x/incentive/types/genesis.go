@@ -111,7 +111,26 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
-	return gs.EarnClaims.Validate()
+	if err := gs.EarnClaims.Validate(); err != nil {
+		return err
+	}
+
+	if err := gs.SourceRewardStates.Validate(); err != nil {
+		return err
+	}
+	for _, claim := range gs.SourceClaims {
+		// claims of native sources are set in their own genesis fields
+		if err := ValidateRewardSource(claim.Source); err != nil {
+			return err
+		}
+	}
+	if err := gs.SourceClaims.Validate(); err != nil {
+		return err
+	}
+	if err := gs.RewardBudgetSpends.Validate(); err != nil {
+		return err
+	}
+	return gs.RewardBoostStakes.Validate()
 }
 
 // NewGenesisRewardState returns a new GenesisRewardState
@@ -130,6 +149,40 @@ func (grs GenesisRewardState) Validate() error {
 	return grs.MultiRewardIndexes.Validate()
 }
 
+// NewSourceGenesisRewardState returns a new SourceGenesisRewardState
+func NewSourceGenesisRewardState(source string, rewardState GenesisRewardState) SourceGenesisRewardState {
+	return SourceGenesisRewardState{
+		Source:      source,
+		RewardState: rewardState,
+	}
+}
+
+// Validate performs validation of a SourceGenesisRewardState
+func (sgrs SourceGenesisRewardState) Validate() error {
+	if err := ValidateRewardSource(sgrs.Source); err != nil {
+		return err
+	}
+	return sgrs.RewardState.Validate()
+}
+
+// SourceGenesisRewardStates slice of SourceGenesisRewardState
+type SourceGenesisRewardStates []SourceGenesisRewardState
+
+// Validate performs validation of SourceGenesisRewardStates
+func (sgrss SourceGenesisRewardStates) Validate() error {
+	seenSources := make(map[string]bool)
+	for _, sgrs := range sgrss {
+		if seenSources[sgrs.Source] {
+			return fmt.Errorf("duplicated reward state for source %s", sgrs.Source)
+		}
+		if err := sgrs.Validate(); err != nil {
+			return err
+		}
+		seenSources[sgrs.Source] = true
+	}
+	return nil
+}
+
 // NewAccumulationTime returns a new GenesisAccumulationTime
 func NewAccumulationTime(ctype string, prevTime time.Time) AccumulationTime {
 	return AccumulationTime{
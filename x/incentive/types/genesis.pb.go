@@ -4,7 +4,11 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -37,7 +41,7 @@ func (m *AccumulationTime) Reset()         { *m = AccumulationTime{} }
 func (m *AccumulationTime) String() string { return proto.CompactTextString(m) }
 func (*AccumulationTime) ProtoMessage()    {}
 func (*AccumulationTime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8b76737885d05afd, []int{0}
+	return fileDescriptor_da10610f52b06a94, []int{0}
 }
 func (m *AccumulationTime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -76,7 +80,7 @@ func (m *GenesisRewardState) Reset()         { *m = GenesisRewardState{} }
 func (m *GenesisRewardState) String() string { return proto.CompactTextString(m) }
 func (*GenesisRewardState) ProtoMessage()    {}
 func (*GenesisRewardState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8b76737885d05afd, []int{1}
+	return fileDescriptor_da10610f52b06a94, []int{1}
 }
 func (m *GenesisRewardState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -105,6 +109,131 @@ func (m *GenesisRewardState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisRewardState proto.InternalMessageInfo
 
+// SourceGenesisRewardState is the global state of a reward source for genesis.
+type SourceGenesisRewardState struct {
+	Source      string             `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	RewardState GenesisRewardState `protobuf:"bytes,2,opt,name=reward_state,json=rewardState,proto3" json:"reward_state"`
+}
+
+func (m *SourceGenesisRewardState) Reset()         { *m = SourceGenesisRewardState{} }
+func (m *SourceGenesisRewardState) String() string { return proto.CompactTextString(m) }
+func (*SourceGenesisRewardState) ProtoMessage()    {}
+func (*SourceGenesisRewardState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da10610f52b06a94, []int{2}
+}
+func (m *SourceGenesisRewardState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SourceGenesisRewardState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SourceGenesisRewardState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SourceGenesisRewardState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceGenesisRewardState.Merge(m, src)
+}
+func (m *SourceGenesisRewardState) XXX_Size() int {
+	return m.Size()
+}
+func (m *SourceGenesisRewardState) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceGenesisRewardState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourceGenesisRewardState proto.InternalMessageInfo
+
+// RewardBudgetSpend is the amount of rewards distributed from the budget of a reward period.
+type RewardBudgetSpend struct {
+	// source is the reward source of the period, e.g. hard_supply, delegator_claim or a registered source.
+	Source         string                                      `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	CollateralType string                                      `protobuf:"bytes,2,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	Spent          github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"spent"`
+	// period_start is the start of the reward period the rewards were spent from. A period with a different start
+	// replacing it begins with nothing spent.
+	PeriodStart time.Time `protobuf:"bytes,4,opt,name=period_start,json=periodStart,proto3,stdtime" json:"period_start"`
+}
+
+func (m *RewardBudgetSpend) Reset()         { *m = RewardBudgetSpend{} }
+func (m *RewardBudgetSpend) String() string { return proto.CompactTextString(m) }
+func (*RewardBudgetSpend) ProtoMessage()    {}
+func (*RewardBudgetSpend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da10610f52b06a94, []int{3}
+}
+func (m *RewardBudgetSpend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardBudgetSpend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardBudgetSpend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardBudgetSpend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardBudgetSpend.Merge(m, src)
+}
+func (m *RewardBudgetSpend) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardBudgetSpend) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardBudgetSpend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardBudgetSpend proto.InternalMessageInfo
+
+// RewardBoostStake is the bonded stake of a claim owner recorded when rewards of a source ID were last synced. It
+// limits the stake that boosts the rewards accrued until the next sync.
+type RewardBoostStake struct {
+	Source         string                                        `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	CollateralType string                                        `protobuf:"bytes,2,opt,name=collateral_type,json=collateralType,proto3" json:"collateral_type,omitempty"`
+	Owner          github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	Staked         cosmossdk_io_math.Int                         `protobuf:"bytes,4,opt,name=staked,proto3,customtype=cosmossdk.io/math.Int" json:"staked"`
+}
+
+func (m *RewardBoostStake) Reset()         { *m = RewardBoostStake{} }
+func (m *RewardBoostStake) String() string { return proto.CompactTextString(m) }
+func (*RewardBoostStake) ProtoMessage()    {}
+func (*RewardBoostStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da10610f52b06a94, []int{4}
+}
+func (m *RewardBoostStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardBoostStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardBoostStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardBoostStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardBoostStake.Merge(m, src)
+}
+func (m *RewardBoostStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardBoostStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardBoostStake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardBoostStake proto.InternalMessageInfo
+
 // GenesisState is the state that must be provided at genesis.
 type GenesisState struct {
 	Params                      Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
@@ -121,13 +250,17 @@ type GenesisState struct {
 	SavingsClaims               SavingsClaims               `protobuf:"bytes,12,rep,name=savings_claims,json=savingsClaims,proto3,castrepeated=SavingsClaims" json:"savings_claims"`
 	EarnRewardState             GenesisRewardState          `protobuf:"bytes,13,opt,name=earn_reward_state,json=earnRewardState,proto3" json:"earn_reward_state"`
 	EarnClaims                  EarnClaims                  `protobuf:"bytes,14,rep,name=earn_claims,json=earnClaims,proto3,castrepeated=EarnClaims" json:"earn_claims"`
+	SourceRewardStates          SourceGenesisRewardStates   `protobuf:"bytes,15,rep,name=source_reward_states,json=sourceRewardStates,proto3,castrepeated=SourceGenesisRewardStates" json:"source_reward_states"`
+	SourceClaims                SourceClaims                `protobuf:"bytes,16,rep,name=source_claims,json=sourceClaims,proto3,castrepeated=SourceClaims" json:"source_claims"`
+	RewardBudgetSpends          RewardBudgetSpends          `protobuf:"bytes,17,rep,name=reward_budget_spends,json=rewardBudgetSpends,proto3,castrepeated=RewardBudgetSpends" json:"reward_budget_spends"`
+	RewardBoostStakes           RewardBoostStakes           `protobuf:"bytes,18,rep,name=reward_boost_stakes,json=rewardBoostStakes,proto3,castrepeated=RewardBoostStakes" json:"reward_boost_stakes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8b76737885d05afd, []int{2}
+	return fileDescriptor_da10610f52b06a94, []int{5}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -159,65 +292,91 @@ var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*AccumulationTime)(nil), "fury.incentive.v1beta1.AccumulationTime")
 	proto.RegisterType((*GenesisRewardState)(nil), "fury.incentive.v1beta1.GenesisRewardState")
+	proto.RegisterType((*SourceGenesisRewardState)(nil), "fury.incentive.v1beta1.SourceGenesisRewardState")
+	proto.RegisterType((*RewardBudgetSpend)(nil), "fury.incentive.v1beta1.RewardBudgetSpend")
+	proto.RegisterType((*RewardBoostStake)(nil), "fury.incentive.v1beta1.RewardBoostStake")
 	proto.RegisterType((*GenesisState)(nil), "fury.incentive.v1beta1.GenesisState")
 }
 
 func init() {
-	proto.RegisterFile("fury/incentive/v1beta1/genesis.proto", fileDescriptor_8b76737885d05afd)
+	proto.RegisterFile("fury/incentive/v1beta1/genesis.proto", fileDescriptor_da10610f52b06a94)
 }
 
-var fileDescriptor_8b76737885d05afd = []byte{
-	// 785 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xcf, 0x4f, 0xdb, 0x48,
-	0x14, 0xc7, 0x63, 0x60, 0x59, 0x98, 0x00, 0x21, 0xb3, 0x01, 0xb2, 0x41, 0x72, 0x58, 0x40, 0xbb,
-	0xd1, 0x56, 0xb5, 0x45, 0x7a, 0xed, 0xa5, 0x2e, 0x55, 0x8b, 0x54, 0x24, 0xe4, 0x50, 0x54, 0x55,
-	0x95, 0xa2, 0x71, 0x3c, 0x98, 0x69, 0xfd, 0xab, 0x1e, 0x3b, 0x21, 0xb7, 0x1e, 0x7b, 0xe4, 0x0f,
-	0xa8, 0xd4, 0x7b, 0xff, 0x8f, 0x4a, 0x1c, 0x39, 0xf6, 0x04, 0x6d, 0xf8, 0x47, 0xaa, 0x19, 0x8f,
-	0x83, 0x9d, 0x60, 0xaa, 0xa6, 0xb7, 0xc9, 0x9b, 0x37, 0xdf, 0xcf, 0xf7, 0xcd, 0x7b, 0x13, 0x83,
-	0xed, 0xb7, 0xa8, 0x8b, 0x54, 0xe2, 0x76, 0xb0, 0x1b, 0x92, 0x2e, 0x56, 0xbb, 0x3b, 0x06, 0x0e,
-	0xd1, 0x8e, 0x6a, 0x61, 0x17, 0x53, 0x42, 0x15, 0x3f, 0xf0, 0x42, 0x0f, 0xae, 0xb2, 0x2c, 0x65,
-	0x98, 0xa5, 0x88, 0xac, 0x5a, 0xc5, 0xf2, 0x2c, 0x8f, 0xa7, 0xa8, 0x6c, 0x15, 0x67, 0xd7, 0xea,
-	0x96, 0xe7, 0x59, 0x36, 0x56, 0xf9, 0x2f, 0x23, 0x3a, 0x56, 0x43, 0xe2, 0x60, 0x1a, 0x22, 0xc7,
-	0x17, 0x09, 0x5b, 0x39, 0xd0, 0x8e, 0x8d, 0x88, 0x43, 0x7f, 0x92, 0xe4, 0xa3, 0x00, 0x25, 0x49,
-	0x9b, 0x9f, 0x24, 0xb0, 0xfc, 0xa8, 0xd3, 0x89, 0x9c, 0xc8, 0x46, 0x21, 0xf1, 0xdc, 0x43, 0xe2,
-	0x60, 0xf8, 0x1f, 0x28, 0x75, 0x3c, 0xdb, 0x46, 0x21, 0x0e, 0x90, 0xdd, 0x0e, 0xfb, 0x3e, 0xae,
-	0x4a, 0x1b, 0x52, 0x63, 0x5e, 0x5f, 0xba, 0x09, 0x1f, 0xf6, 0x7d, 0x0c, 0x0d, 0x50, 0xf3, 0x03,
-	0xdc, 0x25, 0x5e, 0x44, 0xdb, 0x28, 0xa5, 0xd2, 0x66, 0x86, 0xab, 0x53, 0x1b, 0x52, 0xa3, 0xd8,
-	0xac, 0x29, 0x71, 0x35, 0x4a, 0x52, 0x8d, 0x72, 0x98, 0x54, 0xa3, 0xcd, 0x9d, 0x5f, 0xd6, 0x0b,
-	0x67, 0x57, 0x75, 0x49, 0xaf, 0x26, 0x3a, 0xa3, 0x66, 0x36, 0xdf, 0x4f, 0x01, 0xf8, 0x34, 0xbe,
-	0x4c, 0x1d, 0xf7, 0x50, 0x60, 0xb6, 0x42, 0x14, 0x62, 0x18, 0x00, 0x38, 0x46, 0xa4, 0x55, 0x69,
-	0x63, 0xba, 0x51, 0x6c, 0x36, 0x94, 0xdb, 0xaf, 0x5b, 0x19, 0x15, 0xd7, 0xfe, 0x66, 0x06, 0x3e,
-	0x5f, 0xd5, 0xcb, 0xa3, 0x3b, 0x54, 0x2f, 0xa3, 0xd1, 0x10, 0xec, 0x82, 0x8a, 0x13, 0xd9, 0x21,
-	0x69, 0x07, 0xdc, 0x48, 0x9b, 0xb8, 0x26, 0x3e, 0xc5, 0xb4, 0x3a, 0x75, 0x37, 0x75, 0x9f, 0x9d,
-	0x89, 0xbd, 0xef, 0xb1, 0x13, 0x5a, 0x4d, 0x50, 0xe1, 0xe8, 0x0e, 0xa6, 0x3a, 0x74, 0xc6, 0x62,
-	0x9b, 0x5f, 0x8a, 0x60, 0x41, 0x5c, 0x41, 0x5c, 0xfc, 0x43, 0x30, 0x1b, 0x77, 0x91, 0xf7, 0xa5,
-	0xd8, 0x94, 0xf3, 0xd0, 0x07, 0x3c, 0x4b, 0x9b, 0x61, 0x40, 0x5d, 0x9c, 0x81, 0x1e, 0x28, 0x47,
-	0xd4, 0x3c, 0x4d, 0xaa, 0xa0, 0x4c, 0x52, 0x34, 0xeb, 0xff, 0x3c, 0xa1, 0xf1, 0x0e, 0x68, 0x6b,
-	0x4c, 0x74, 0x70, 0x59, 0x2f, 0xbd, 0x68, 0xed, 0xbe, 0x4c, 0x6d, 0xe8, 0x25, 0xa6, 0x9e, 0xee,
-	0x15, 0x01, 0xd5, 0x13, 0x4e, 0x8a, 0x7c, 0xdf, 0xee, 0x67, 0xb9, 0xd3, 0xbf, 0xcc, 0x8d, 0x8b,
-	0x59, 0x61, 0x8a, 0x2d, 0x2e, 0x78, 0x1b, 0xca, 0xf0, 0x82, 0xc0, 0xeb, 0x65, 0x51, 0x33, 0xbf,
-	0x83, 0xd2, 0xb8, 0x60, 0x1a, 0x75, 0x0c, 0x56, 0x4d, 0x6c, 0x63, 0x0b, 0x85, 0x5e, 0x90, 0x05,
-	0xfd, 0x31, 0x21, 0xa8, 0x32, 0xd4, 0x4b, 0x73, 0x5e, 0x83, 0x32, 0xed, 0x21, 0x3f, 0x8b, 0x98,
-	0x9d, 0x10, 0x51, 0x62, 0x52, 0x69, 0xf5, 0x0f, 0x12, 0xf8, 0x8b, 0x4f, 0x83, 0x43, 0xdc, 0x90,
-	0xb8, 0x56, 0x3b, 0xfe, 0x0f, 0xa9, 0xfe, 0x79, 0xf7, 0x4c, 0xb3, 0x9e, 0xef, 0xc7, 0x27, 0x1e,
-	0xb3, 0x03, 0x9a, 0x22, 0xa6, 0xa1, 0x3c, 0xba, 0x43, 0xd9, 0xf3, 0x1a, 0x0b, 0xea, 0x7c, 0x04,
-	0x33, 0x21, 0xf8, 0x51, 0x02, 0x32, 0x6f, 0x9e, 0x4d, 0xde, 0x45, 0xc4, 0x24, 0x61, 0xbf, 0xed,
-	0x07, 0x5e, 0x97, 0x98, 0x38, 0x48, 0x5c, 0xcd, 0x71, 0x57, 0xcd, 0x3c, 0x57, 0xcf, 0x50, 0x60,
-	0x3e, 0x4f, 0x0e, 0x1f, 0x88, 0xb3, 0xb1, 0xbf, 0x2d, 0xf1, 0xe6, 0xd6, 0xf3, 0x73, 0xa8, 0xbe,
-	0x7e, 0x92, 0xbf, 0x09, 0xdf, 0x80, 0xe5, 0x9b, 0x7e, 0x0b, 0x3f, 0xf3, 0xdc, 0xcf, 0xbf, 0x79,
-	0x7e, 0x76, 0x93, 0xfc, 0xd8, 0xc3, 0x9a, 0xf0, 0x50, 0xca, 0xc6, 0xa9, 0x5e, 0x32, 0xb3, 0x01,
-	0x78, 0x04, 0x8a, 0xbc, 0xe7, 0x02, 0x03, 0x38, 0xe6, 0x9f, 0x3c, 0x4c, 0xab, 0x87, 0xfc, 0x98,
-	0x00, 0x05, 0x01, 0x0c, 0x43, 0x54, 0x07, 0x74, 0xb8, 0x86, 0x06, 0xa8, 0x50, 0xd4, 0x25, 0xae,
-	0x45, 0xb3, 0xe3, 0x54, 0x9c, 0x70, 0x9c, 0xa0, 0x50, 0x4b, 0x4f, 0x94, 0x01, 0x96, 0x12, 0x86,
-	0xb0, 0xbf, 0xc0, 0xed, 0x6f, 0xe7, 0xda, 0x8f, 0xb3, 0xe3, 0x0a, 0x56, 0x44, 0x05, 0x8b, 0xe9,
-	0x28, 0xd5, 0x17, 0x69, 0xfa, 0x27, 0x7b, 0x13, 0x18, 0x05, 0x6e, 0xb6, 0x88, 0xc5, 0x49, 0xdf,
-	0x04, 0x93, 0x4a, 0x57, 0x70, 0x04, 0x8a, 0x5c, 0x5d, 0xd8, 0x5f, 0xba, 0xfb, 0xf6, 0x9f, 0xa0,
-	0xc0, 0x1d, 0xb9, 0xfd, 0x61, 0x88, 0xea, 0x00, 0x0f, 0xd7, 0xda, 0xde, 0xf9, 0x77, 0xb9, 0x70,
-	0x3e, 0x90, 0xa5, 0x8b, 0x81, 0x2c, 0x7d, 0x1b, 0xc8, 0xd2, 0xd9, 0xb5, 0x5c, 0xb8, 0xb8, 0x96,
-	0x0b, 0x5f, 0xaf, 0xe5, 0xc2, 0xab, 0x7b, 0x16, 0x09, 0x4f, 0x22, 0x43, 0xe9, 0x78, 0x8e, 0xca,
-	0x50, 0xf7, 0x6d, 0x64, 0x50, 0xbe, 0x52, 0x4f, 0x53, 0x9f, 0x71, 0xf6, 0x39, 0xa6, 0xc6, 0x2c,
-	0xff, 0x9a, 0x3e, 0xf8, 0x11, 0x00, 0x00, 0xff, 0xff, 0xbc, 0xa8, 0x1c, 0xb0, 0x7f, 0x08, 0x00,
-	0x00,
+var fileDescriptor_da10610f52b06a94 = []byte{
+	// 1155 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcd, 0x4f, 0x1b, 0x47,
+	0x14, 0x67, 0xf9, 0x6a, 0x18, 0x1b, 0x8c, 0x07, 0x43, 0x16, 0x52, 0xd9, 0xc4, 0x44, 0x2d, 0x6d,
+	0xc4, 0xba, 0x90, 0x6b, 0x2f, 0x2c, 0x54, 0x09, 0x52, 0xa3, 0x46, 0x6b, 0x1a, 0x55, 0x55, 0x15,
+	0x6b, 0xbd, 0x3b, 0x98, 0x29, 0xde, 0x9d, 0xed, 0xcc, 0xac, 0x8d, 0x6f, 0xbd, 0xf4, 0xe3, 0x98,
+	0x7b, 0x2b, 0xf5, 0x9e, 0x73, 0xfe, 0x08, 0x8e, 0x51, 0x4e, 0x55, 0x0f, 0xd0, 0xc2, 0x3f, 0x51,
+	0xf5, 0x54, 0xcd, 0x87, 0xed, 0xf5, 0xc7, 0xd2, 0x06, 0x4e, 0xde, 0x79, 0xf3, 0xde, 0xef, 0xf7,
+	0x7b, 0x6f, 0xde, 0xec, 0x3e, 0x83, 0x07, 0x47, 0x31, 0xed, 0x54, 0x70, 0xe8, 0xa1, 0x90, 0xe3,
+	0x16, 0xaa, 0xb4, 0xb6, 0xeb, 0x88, 0xbb, 0xdb, 0x95, 0x06, 0x0a, 0x11, 0xc3, 0xcc, 0x8a, 0x28,
+	0xe1, 0x04, 0xae, 0x08, 0x2f, 0xab, 0xe7, 0x65, 0x69, 0xaf, 0xb5, 0xa2, 0x47, 0x58, 0x40, 0x58,
+	0xa5, 0xee, 0xb2, 0x7e, 0xa8, 0x47, 0x70, 0xa8, 0xe2, 0xd6, 0x56, 0xd5, 0x7e, 0x4d, 0xae, 0x2a,
+	0x6a, 0xa1, 0xb7, 0x36, 0x52, 0x88, 0xbd, 0xa6, 0x8b, 0x83, 0xff, 0x72, 0x8a, 0x5c, 0xea, 0xf6,
+	0x9c, 0x0a, 0x0d, 0xd2, 0x20, 0x8a, 0x41, 0x3c, 0x69, 0x6b, 0xa9, 0x41, 0x48, 0xa3, 0x89, 0x2a,
+	0x72, 0x55, 0x8f, 0x8f, 0x2a, 0x1c, 0x07, 0x88, 0x71, 0x37, 0x88, 0x94, 0x43, 0xf9, 0x37, 0x03,
+	0x2c, 0xee, 0x7a, 0x5e, 0x1c, 0xc4, 0x4d, 0x97, 0x63, 0x12, 0x1e, 0xe2, 0x00, 0xc1, 0x0f, 0x41,
+	0xce, 0x23, 0xcd, 0xa6, 0xcb, 0x11, 0x75, 0x9b, 0x35, 0xde, 0x89, 0x90, 0x69, 0xac, 0x1b, 0x9b,
+	0x73, 0xce, 0x42, 0xdf, 0x7c, 0xd8, 0x89, 0x10, 0xac, 0x83, 0xb5, 0x88, 0xa2, 0x16, 0x26, 0x31,
+	0xab, 0xb9, 0x09, 0x94, 0x9a, 0xa0, 0x31, 0x27, 0xd7, 0x8d, 0xcd, 0xcc, 0xce, 0x9a, 0xa5, 0x34,
+	0x58, 0x5d, 0x0d, 0xd6, 0x61, 0x57, 0x83, 0x7d, 0xe7, 0xec, 0xbc, 0x34, 0xf1, 0xf2, 0xa2, 0x64,
+	0x38, 0x66, 0x17, 0x67, 0x58, 0x4c, 0xf9, 0xfb, 0x49, 0x00, 0x1f, 0xab, 0x73, 0x70, 0x50, 0xdb,
+	0xa5, 0x7e, 0x95, 0xbb, 0x1c, 0x41, 0x0a, 0xe0, 0x08, 0x23, 0x33, 0x8d, 0xf5, 0xa9, 0xcd, 0xcc,
+	0xce, 0xa6, 0x35, 0xfe, 0xa4, 0xac, 0x61, 0x70, 0x7b, 0x55, 0x08, 0x78, 0x75, 0x51, 0xca, 0x0f,
+	0xef, 0x30, 0x27, 0xef, 0x0e, 0x9b, 0x60, 0x0b, 0x14, 0x82, 0xb8, 0xc9, 0x71, 0x8d, 0x4a, 0x21,
+	0x35, 0x1c, 0xfa, 0xe8, 0x14, 0x31, 0x73, 0xf2, 0x7a, 0xd6, 0xa7, 0x22, 0x46, 0x69, 0x3f, 0x10,
+	0x11, 0xf6, 0x9a, 0x66, 0x85, 0xc3, 0x3b, 0x88, 0x39, 0x30, 0x18, 0xb1, 0x95, 0x7f, 0x32, 0x80,
+	0x59, 0x25, 0x31, 0xf5, 0xd0, 0x98, 0x42, 0xac, 0x80, 0x59, 0x26, 0xf7, 0xf4, 0x19, 0xe9, 0x15,
+	0xac, 0x82, 0xac, 0x96, 0xc9, 0x84, 0x9f, 0x3e, 0x8d, 0x8f, 0xd3, 0x44, 0x8e, 0x22, 0xdb, 0xd3,
+	0x42, 0xa6, 0x93, 0xa1, 0x7d, 0x53, 0xf9, 0xc7, 0x49, 0x90, 0x57, 0x2e, 0x76, 0xec, 0x37, 0x10,
+	0xaf, 0x46, 0x28, 0xf4, 0x53, 0x25, 0x8c, 0xe9, 0xa3, 0xc9, 0xb1, 0x7d, 0xd4, 0x00, 0x33, 0x2c,
+	0x42, 0x21, 0x37, 0xa7, 0x64, 0x25, 0xdf, 0xb7, 0xf4, 0x25, 0x11, 0x37, 0xaa, 0xa7, 0x70, 0x1f,
+	0x79, 0x7b, 0x04, 0x87, 0xf6, 0x23, 0x5d, 0xbd, 0x87, 0x0d, 0xcc, 0x8f, 0xe3, 0xba, 0xe5, 0x91,
+	0x40, 0x5f, 0x2a, 0xfd, 0xb3, 0xc5, 0xfc, 0x93, 0x8a, 0x60, 0x63, 0xdd, 0x18, 0xe6, 0x28, 0x7c,
+	0xf8, 0x18, 0x64, 0x23, 0x44, 0x31, 0x91, 0x45, 0xa1, 0xdc, 0x9c, 0x7e, 0x87, 0x16, 0xcd, 0xa8,
+	0xc8, 0xaa, 0x08, 0x2c, 0xff, 0x6d, 0x80, 0x45, 0x5d, 0x08, 0x42, 0x18, 0xaf, 0x72, 0xf7, 0x04,
+	0xdd, 0xbe, 0x0e, 0x2f, 0xc0, 0x0c, 0x69, 0x87, 0x88, 0x9a, 0x53, 0xeb, 0xc6, 0x66, 0xd6, 0x7e,
+	0xf2, 0xcf, 0x79, 0x69, 0xeb, 0x7f, 0x64, 0xb9, 0xeb, 0x79, 0xbb, 0xbe, 0x4f, 0x11, 0x63, 0x6f,
+	0x5f, 0x6f, 0x2d, 0xe9, 0xda, 0x69, 0x8b, 0xdd, 0xe1, 0x88, 0x39, 0x0a, 0x16, 0xee, 0x81, 0x59,
+	0x26, 0x94, 0xfa, 0x32, 0xf1, 0x39, 0xfb, 0xa1, 0x48, 0xee, 0x8f, 0xf3, 0xd2, 0xb2, 0x8a, 0x61,
+	0xfe, 0x89, 0x85, 0x49, 0x25, 0x70, 0xf9, 0xb1, 0x75, 0x10, 0xf2, 0xb7, 0xaf, 0xb7, 0x80, 0x06,
+	0x3b, 0x08, 0xb9, 0xa3, 0x43, 0xcb, 0xbf, 0xe4, 0x40, 0x56, 0x77, 0x8b, 0xea, 0xc0, 0x4f, 0xc1,
+	0xac, 0x7a, 0x15, 0xc9, 0xb4, 0x33, 0x3b, 0xc5, 0xb4, 0x1e, 0x7b, 0x26, 0xbd, 0x74, 0x5f, 0xe9,
+	0x18, 0x48, 0x40, 0x3e, 0x66, 0xfe, 0x69, 0xed, 0x96, 0xcd, 0x7a, 0x57, 0x80, 0x5e, 0x9e, 0x97,
+	0x72, 0x5f, 0x56, 0xf7, 0xbf, 0x4a, 0x6c, 0x38, 0x39, 0x81, 0x9e, 0xbc, 0x30, 0x18, 0x98, 0xc7,
+	0x92, 0x29, 0x8e, 0xa2, 0x66, 0x67, 0x90, 0x77, 0xea, 0x86, 0x97, 0x64, 0x59, 0x20, 0x56, 0x25,
+	0xe0, 0x38, 0xaa, 0x3a, 0xa1, 0x94, 0xb4, 0x07, 0xa9, 0xa6, 0x6f, 0x43, 0x65, 0x4b, 0xc0, 0x24,
+	0xd5, 0x11, 0x58, 0xf1, 0x51, 0x13, 0x35, 0x5c, 0x4e, 0xe8, 0x20, 0xd1, 0xcc, 0x0d, 0x89, 0x0a,
+	0x3d, 0xbc, 0x24, 0xcf, 0x37, 0x20, 0xcf, 0xda, 0x6e, 0x34, 0x48, 0x31, 0x7b, 0x43, 0x8a, 0x9c,
+	0x80, 0x4a, 0xa2, 0xff, 0x6c, 0x80, 0x25, 0xd9, 0x0d, 0x01, 0x0e, 0x39, 0x0e, 0x1b, 0x35, 0xf5,
+	0x21, 0x34, 0xdf, 0xbb, 0xfe, 0x0d, 0x2b, 0xce, 0xfc, 0xa9, 0x8a, 0xd8, 0x13, 0x01, 0xb6, 0xa5,
+	0xbb, 0x21, 0x3f, 0xbc, 0xc3, 0x5e, 0x5d, 0x8c, 0x31, 0x3a, 0xb2, 0x05, 0x07, 0x4c, 0xf0, 0x57,
+	0x03, 0x14, 0xe5, 0xe1, 0x35, 0xf1, 0x77, 0x31, 0xf6, 0x31, 0xef, 0x88, 0x0f, 0x78, 0x0b, 0xfb,
+	0x88, 0x76, 0x55, 0xdd, 0x91, 0xaa, 0x76, 0xd2, 0x54, 0x3d, 0x71, 0xa9, 0xff, 0x79, 0x37, 0xf8,
+	0x99, 0x8e, 0x55, 0xfa, 0x36, 0xf4, 0x3b, 0xec, 0x5e, 0xba, 0x0f, 0x73, 0xee, 0x1d, 0xa7, 0x6f,
+	0xc2, 0x6f, 0xc1, 0x62, 0xff, 0xbc, 0xb5, 0x9e, 0x39, 0xa9, 0xe7, 0x83, 0x34, 0x3d, 0xfb, 0x5d,
+	0x7f, 0xa5, 0xe1, 0xae, 0xd6, 0x90, 0x1b, 0xb4, 0x33, 0x27, 0xe7, 0x0f, 0x1a, 0xe0, 0x73, 0x90,
+	0x91, 0x67, 0xae, 0x69, 0x80, 0xa4, 0xb9, 0x9f, 0x46, 0x53, 0x6d, 0xbb, 0x91, 0x62, 0x80, 0x9a,
+	0x01, 0xf4, 0x4c, 0xcc, 0x01, 0xac, 0xf7, 0x0c, 0xeb, 0xa0, 0xc0, 0xdc, 0x16, 0x0e, 0x1b, 0x6c,
+	0xb0, 0x9d, 0x32, 0x37, 0x6c, 0x27, 0xa8, 0xd1, 0x92, 0x1d, 0x55, 0x07, 0x0b, 0x5d, 0x0e, 0x2d,
+	0x3f, 0x2b, 0xe5, 0x3f, 0x48, 0x95, 0xaf, 0xbc, 0x55, 0x06, 0xcb, 0x3a, 0x83, 0xf9, 0xa4, 0x95,
+	0x39, 0xf3, 0x2c, 0xb9, 0x14, 0x77, 0x02, 0xb9, 0x34, 0x1c, 0x4c, 0x62, 0xfe, 0xa6, 0x77, 0x42,
+	0x40, 0x25, 0x33, 0x78, 0x0e, 0x32, 0x12, 0x5d, 0xcb, 0x5f, 0xb8, 0xbe, 0xfa, 0x9f, 0xb9, 0x34,
+	0x1c, 0xaa, 0x7e, 0xcf, 0xc4, 0x1c, 0x80, 0x7a, 0xcf, 0xf0, 0x07, 0x03, 0x14, 0xd4, 0x07, 0x6a,
+	0x40, 0x38, 0x33, 0x73, 0x92, 0xe1, 0x93, 0xd4, 0x02, 0xa5, 0x4c, 0x22, 0xf6, 0x7d, 0x4d, 0xb8,
+	0x9a, 0xe6, 0xc1, 0x1c, 0xa8, 0x08, 0x93, 0x36, 0xf8, 0x02, 0xcc, 0x6b, 0x19, 0x3a, 0xc3, 0x45,
+	0xc9, 0xbf, 0x71, 0x3d, 0xbf, 0xca, 0xb1, 0xa0, 0x29, 0xb3, 0x09, 0x23, 0x73, 0xb2, 0x2c, 0xb1,
+	0x82, 0x6d, 0x50, 0xd0, 0xf9, 0xd5, 0xe5, 0xcc, 0x52, 0x13, 0xa3, 0x80, 0xcf, 0xcc, 0xbc, 0xa4,
+	0xf9, 0x28, 0x8d, 0x66, 0x64, 0xcc, 0xe9, 0x8f, 0x6d, 0x23, 0x5b, 0xcc, 0x81, 0x74, 0xc4, 0x06,
+	0x19, 0x58, 0xea, 0x12, 0x8b, 0x19, 0xa1, 0x26, 0xbf, 0x9f, 0xcc, 0x84, 0xd7, 0xbf, 0xcb, 0x86,
+	0xa7, 0x8a, 0xfe, 0x8c, 0x3a, 0xbc, 0xc3, 0x9c, 0x3c, 0x1d, 0x36, 0xd9, 0x5f, 0x9c, 0xfd, 0x55,
+	0x9c, 0x38, 0xbb, 0x2c, 0x1a, 0x6f, 0x2e, 0x8b, 0xc6, 0x9f, 0x97, 0x45, 0xe3, 0xe5, 0x55, 0x71,
+	0xe2, 0xcd, 0x55, 0x71, 0xe2, 0xf7, 0xab, 0xe2, 0xc4, 0xd7, 0xdb, 0x89, 0x69, 0x02, 0x87, 0x5e,
+	0x5c, 0x8f, 0xd9, 0x56, 0x88, 0x78, 0x9b, 0xd0, 0x93, 0x8a, 0xfc, 0x97, 0x71, 0x9a, 0xf8, 0x9f,
+	0x21, 0x87, 0x8b, 0xfa, 0xac, 0x1c, 0x8a, 0x1e, 0xfd, 0x3b, 0x00, 0x32, 0xfc, 0x3d, 0x64, 0x24,
+	0x0d, 0x00, 0x00,
 }
 
 func (m *AccumulationTime) Marshal() (dAtA []byte, err error) {
@@ -309,6 +468,159 @@ func (m *GenesisRewardState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SourceGenesisRewardState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SourceGenesisRewardState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SourceGenesisRewardState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RewardState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardBudgetSpend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardBudgetSpend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardBudgetSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PeriodStart, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PeriodStart):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardBoostStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardBoostStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardBoostStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Staked.Size()
+		i -= size
+		if _, err := m.Staked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CollateralType) > 0 {
+		i -= len(m.CollateralType)
+		copy(dAtA[i:], m.CollateralType)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CollateralType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -329,6 +641,68 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RewardBoostStakes) > 0 {
+		for iNdEx := len(m.RewardBoostStakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardBoostStakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.RewardBudgetSpends) > 0 {
+		for iNdEx := len(m.RewardBudgetSpends) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardBudgetSpends[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.SourceClaims) > 0 {
+		for iNdEx := len(m.SourceClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.SourceRewardStates) > 0 {
+		for iNdEx := len(m.SourceRewardStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceRewardStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	if len(m.EarnClaims) > 0 {
 		for iNdEx := len(m.EarnClaims) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -543,37 +917,100 @@ func (m *GenesisRewardState) Size() (n int) {
 	return n
 }
 
-func (m *GenesisState) Size() (n int) {
+func (m *SourceGenesisRewardState) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.USDXRewardState.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.HardSupplyRewardState.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.HardBorrowRewardState.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.DelegatorRewardState.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.SwapRewardState.Size()
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.RewardState.Size()
 	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.USDXMintingClaims) > 0 {
-		for _, e := range m.USDXMintingClaims {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return n
+}
+
+func (m *RewardBudgetSpend) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if len(m.HardLiquidityProviderClaims) > 0 {
-		for _, e := range m.HardLiquidityProviderClaims {
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
 			l = e.Size()
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	if len(m.DelegatorClaims) > 0 {
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PeriodStart)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *RewardBoostStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.CollateralType)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Staked.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.USDXRewardState.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.HardSupplyRewardState.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.HardBorrowRewardState.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.DelegatorRewardState.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SwapRewardState.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.USDXMintingClaims) > 0 {
+		for _, e := range m.USDXMintingClaims {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HardLiquidityProviderClaims) > 0 {
+		for _, e := range m.HardLiquidityProviderClaims {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegatorClaims) > 0 {
 		for _, e := range m.DelegatorClaims {
 			l = e.Size()
 			n += 1 + l + sovGenesis(uint64(l))
@@ -601,6 +1038,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SourceRewardStates) > 0 {
+		for _, e := range m.SourceRewardStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SourceClaims) > 0 {
+		for _, e := range m.SourceClaims {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardBudgetSpends) > 0 {
+		for _, e := range m.RewardBudgetSpends {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardBoostStakes) > 0 {
+		for _, e := range m.RewardBoostStakes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -629,17 +1090,397 @@ func (m *AccumulationTime) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AccumulationTime: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccumulationTime: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccumulationTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccumulationTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAccumulationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PreviousAccumulationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisRewardState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisRewardState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisRewardState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulationTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccumulationTimes = append(m.AccumulationTimes, AccumulationTime{})
+			if err := m.AccumulationTimes[len(m.AccumulationTimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiRewardIndexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultiRewardIndexes = append(m.MultiRewardIndexes, MultiRewardIndex{})
+			if err := m.MultiRewardIndexes[len(m.MultiRewardIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SourceGenesisRewardState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SourceGenesisRewardState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SourceGenesisRewardState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardBudgetSpend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardBudgetSpend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardBudgetSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
 			}
@@ -671,9 +1512,9 @@ func (m *AccumulationTime) Unmarshal(dAtA []byte) error {
 			}
 			m.CollateralType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAccumulationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -700,7 +1541,41 @@ func (m *AccumulationTime) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PreviousAccumulationTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Spent = append(m.Spent, types.DecCoin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PeriodStart, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -725,7 +1600,7 @@ func (m *AccumulationTime) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisRewardState) Unmarshal(dAtA []byte) error {
+func (m *RewardBoostStake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -748,17 +1623,17 @@ func (m *GenesisRewardState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisRewardState: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardBoostStake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisRewardState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardBoostStake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccumulationTimes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -768,31 +1643,29 @@ func (m *GenesisRewardState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccumulationTimes = append(m.AccumulationTimes, AccumulationTime{})
-			if err := m.AccumulationTimes[len(m.AccumulationTimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultiRewardIndexes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -802,23 +1675,89 @@ func (m *GenesisRewardState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MultiRewardIndexes = append(m.MultiRewardIndexes, MultiRewardIndex{})
-			if err := m.MultiRewardIndexes[len(m.MultiRewardIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Staked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1340,6 +2279,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceRewardStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceRewardStates = append(m.SourceRewardStates, SourceGenesisRewardState{})
+			if err := m.SourceRewardStates[len(m.SourceRewardStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceClaims = append(m.SourceClaims, SourceClaim{})
+			if err := m.SourceClaims[len(m.SourceClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBudgetSpends", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardBudgetSpends = append(m.RewardBudgetSpends, RewardBudgetSpend{})
+			if err := m.RewardBudgetSpends[len(m.RewardBudgetSpends)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBoostStakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardBoostStakes = append(m.RewardBoostStakes, RewardBoostStake{})
+			if err := m.RewardBoostStakes[len(m.RewardBoostStakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName The name that will be used throughout the module
 	ModuleName = "incentive"
@@ -19,24 +23,52 @@ const (
 
 // Key Prefixes
 var (
-	USDXMintingClaimKeyPrefix                     = []byte{0x01} // prefix for keys that store USDX minting claims
-	USDXMintingRewardFactorKeyPrefix              = []byte{0x02} // prefix for key that stores USDX minting reward factors
-	PreviousUSDXMintingRewardAccrualTimeKeyPrefix = []byte{0x03} // prefix for key that stores the blocktime
-	HardLiquidityClaimKeyPrefix                   = []byte{0x04} // prefix for keys that store Hard liquidity claims
-	HardSupplyRewardIndexesKeyPrefix              = []byte{0x05} // prefix for key that stores Hard supply reward indexes
-	PreviousHardSupplyRewardAccrualTimeKeyPrefix  = []byte{0x06} // prefix for key that stores the previous time Hard supply rewards accrued
-	HardBorrowRewardIndexesKeyPrefix              = []byte{0x07} // prefix for key that stores Hard borrow reward indexes
-	PreviousHardBorrowRewardAccrualTimeKeyPrefix  = []byte{0x08} // prefix for key that stores the previous time Hard borrow rewards accrued
-	DelegatorClaimKeyPrefix                       = []byte{0x09} // prefix for keys that store delegator claims
-	DelegatorRewardIndexesKeyPrefix               = []byte{0x10} // prefix for key that stores delegator reward indexes
-	PreviousDelegatorRewardAccrualTimeKeyPrefix   = []byte{0x11} // prefix for key that stores the previous time delegator rewards accrued
-	SwapClaimKeyPrefix                            = []byte{0x12} // prefix for keys that store swap claims
-	SwapRewardIndexesKeyPrefix                    = []byte{0x13} // prefix for key that stores swap reward indexes
-	PreviousSwapRewardAccrualTimeKeyPrefix        = []byte{0x14} // prefix for key that stores the previous time swap rewards accrued
-	SavingsClaimKeyPrefix                         = []byte{0x15} // prefix for keys that store savings claims
-	SavingsRewardIndexesKeyPrefix                 = []byte{0x16} // prefix for key that stores savings reward indexes
-	PreviousSavingsRewardAccrualTimeKeyPrefix     = []byte{0x17} // prefix for key that stores the previous time savings rewards accrued
-	EarnClaimKeyPrefix                            = []byte{0x18} // prefix for keys that store earn claims
-	EarnRewardIndexesKeyPrefix                    = []byte{0x19} // prefix for key that stores earn reward indexes
-	PreviousEarnRewardAccrualTimeKeyPrefix        = []byte{0x20} // prefix for key that stores the previous time earn rewards accrued
+	USDXMintingClaimKeyPrefix                     = []byte{0x01} // legacy prefix for keys that store USDX minting claims, migrated to SourceClaimKeyPrefix
+	USDXMintingRewardFactorKeyPrefix              = []byte{0x02} // legacy prefix for key that stores USDX minting reward factors
+	PreviousUSDXMintingRewardAccrualTimeKeyPrefix = []byte{0x03} // legacy prefix for key that stores the previous time USDX minting rewards accrued
+	HardLiquidityClaimKeyPrefix                   = []byte{0x04} // legacy prefix for keys that store Hard liquidity claims, migrated to SourceClaimKeyPrefix
+	HardSupplyRewardIndexesKeyPrefix              = []byte{0x05} // legacy prefix for key that stores Hard supply reward indexes
+	PreviousHardSupplyRewardAccrualTimeKeyPrefix  = []byte{0x06} // legacy prefix for key that stores the previous time Hard supply rewards accrued
+	HardBorrowRewardIndexesKeyPrefix              = []byte{0x07} // legacy prefix for key that stores Hard borrow reward indexes
+	PreviousHardBorrowRewardAccrualTimeKeyPrefix  = []byte{0x08} // legacy prefix for key that stores the previous time Hard borrow rewards accrued
+	DelegatorClaimKeyPrefix                       = []byte{0x09} // legacy prefix for keys that store delegator claims, migrated to SourceClaimKeyPrefix
+	DelegatorRewardIndexesKeyPrefix               = []byte{0x10} // legacy prefix for key that stores delegator reward indexes
+	PreviousDelegatorRewardAccrualTimeKeyPrefix   = []byte{0x11} // legacy prefix for key that stores the previous time delegator rewards accrued
+	SwapClaimKeyPrefix                            = []byte{0x12} // legacy prefix for keys that store swap claims, migrated to SourceClaimKeyPrefix
+	SwapRewardIndexesKeyPrefix                    = []byte{0x13} // legacy prefix for key that stores swap reward indexes
+	PreviousSwapRewardAccrualTimeKeyPrefix        = []byte{0x14} // legacy prefix for key that stores the previous time swap rewards accrued
+	SavingsClaimKeyPrefix                         = []byte{0x15} // legacy prefix for keys that store savings claims, migrated to SourceClaimKeyPrefix
+	SavingsRewardIndexesKeyPrefix                 = []byte{0x16} // legacy prefix for key that stores savings reward indexes
+	PreviousSavingsRewardAccrualTimeKeyPrefix     = []byte{0x17} // legacy prefix for key that stores the previous time savings rewards accrued
+	EarnClaimKeyPrefix                            = []byte{0x18} // legacy prefix for keys that store earn claims, migrated to SourceClaimKeyPrefix
+	EarnRewardIndexesKeyPrefix                    = []byte{0x19} // legacy prefix for key that stores earn reward indexes
+	PreviousEarnRewardAccrualTimeKeyPrefix        = []byte{0x20} // legacy prefix for key that stores the previous time earn rewards accrued
+	SourceClaimKeyPrefix                          = []byte{0x21} // prefix for keys that store reward source claims
+	SourceRewardIndexesKeyPrefix                  = []byte{0x22} // prefix for key that stores reward source indexes
+	PreviousSourceRewardAccrualTimeKeyPrefix      = []byte{0x23} // prefix for key that stores the previous time reward source rewards accrued
+	RewardBudgetSpendKeyPrefix                    = []byte{0x24} // prefix for keys that store the rewards spent from reward period budgets
+	RewardBoostStakeKeyPrefix                     = []byte{0x25} // prefix for keys that store the stake boosting rewards of claims
 )
+
+// GetSourceKeyPrefix returns the length prefixed key prefix of a reward source, under which its claims, indexes,
+// accrual times, budget spends and boost stakes are stored.
+func GetSourceKeyPrefix(source string) []byte {
+	return append([]byte{byte(len(source))}, []byte(source)...)
+}
+
+// SplitSourceKey splits a key stored under a source key prefix into the reward source and the rest of the key.
+func SplitSourceKey(key []byte) (string, []byte) {
+	sourceLen := int(key[0])
+	return string(key[1 : 1+sourceLen]), key[1+sourceLen:]
+}
+
+// GetRewardBoostStakeKey returns the key of the boost stake of an owner for a source ID, under a source key prefix.
+func GetRewardBoostStakeKey(collateralType string, owner sdk.AccAddress) []byte {
+	return append(GetSourceKeyPrefix(collateralType), owner...)
+}
+
+// SplitRewardBoostStakeKey splits a key stored under a source key prefix into the source ID and owner.
+func SplitRewardBoostStakeKey(key []byte) (string, sdk.AccAddress) {
+	collateralType, owner := SplitSourceKey(key)
+	return collateralType, sdk.AccAddress(owner)
+}
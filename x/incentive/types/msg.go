@@ -17,6 +17,8 @@ var (
 	_ sdk.Msg = &MsgClaimSwapReward{}
 	_ sdk.Msg = &MsgClaimSavingsReward{}
 	_ sdk.Msg = &MsgClaimEarnReward{}
+	_ sdk.Msg = &MsgClaimAllRewards{}
+	_ sdk.Msg = &MsgClaimReward{}
 
 	_ legacytx.LegacyMsg = &MsgClaimUSDXMintingReward{}
 	_ legacytx.LegacyMsg = &MsgClaimHardReward{}
@@ -24,6 +26,8 @@ var (
 	_ legacytx.LegacyMsg = &MsgClaimSwapReward{}
 	_ legacytx.LegacyMsg = &MsgClaimSavingsReward{}
 	_ legacytx.LegacyMsg = &MsgClaimEarnReward{}
+	_ legacytx.LegacyMsg = &MsgClaimAllRewards{}
+	_ legacytx.LegacyMsg = &MsgClaimReward{}
 )
 
 const (
@@ -33,6 +37,8 @@ const (
 	TypeMsgClaimSwapReward        = "claim_swap_reward"
 	TypeMsgClaimSavingsReward     = "claim_savings_reward"
 	TypeMsgClaimEarnReward        = "claim_earn_reward"
+	TypeMsgClaimAllRewards        = "claim_all_rewards"
+	TypeMsgClaimReward            = "claim_reward"
 )
 
 // NewMsgClaimUSDXMintingReward returns a new MsgClaimUSDXMintingReward.
@@ -290,3 +296,93 @@ func (msg MsgClaimEarnReward) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+// NewMsgClaimAllRewards returns a new MsgClaimAllRewards.
+func NewMsgClaimAllRewards(sender string, denomsToClaim Selections) MsgClaimAllRewards {
+	return MsgClaimAllRewards{
+		Sender:        sender,
+		DenomsToClaim: denomsToClaim,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgClaimAllRewards) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgClaimAllRewards) Type() string {
+	return TypeMsgClaimAllRewards
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgClaimAllRewards) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty or invalid")
+	}
+	if err := msg.DenomsToClaim.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgClaimAllRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgClaimAllRewards) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgClaimReward returns a new MsgClaimReward.
+func NewMsgClaimReward(sender string, source string, denomsToClaim Selections) MsgClaimReward {
+	return MsgClaimReward{
+		Sender:        sender,
+		Source:        source,
+		DenomsToClaim: denomsToClaim,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgClaimReward) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgClaimReward) Type() string {
+	return TypeMsgClaimReward
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgClaimReward) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty or invalid")
+	}
+	if err := ValidateRewardSource(msg.Source); err != nil {
+		return errorsmod.Wrap(ErrUnknownRewardSource, err.Error())
+	}
+	if err := msg.DenomsToClaim.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgClaimReward) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgClaimReward) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
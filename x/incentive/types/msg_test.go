@@ -15,6 +15,7 @@ import (
 
 func TestMsgClaim_Validate(t *testing.T) {
 	validAddress := sdk.AccAddress(crypto.AddressHash([]byte("FuryTest1"))).String()
+	validValAddress := sdk.ValAddress(crypto.AddressHash([]byte("FuryTest1"))).String()
 
 	type expectedErr struct {
 		wraps error
@@ -59,6 +60,66 @@ func TestMsgClaim_Validate(t *testing.T) {
 				wraps: types.ErrInvalidMultiplier,
 			},
 		},
+		{
+			name: "delegation destination with validator is valid",
+			msgArgs: msgArgs{
+				sender: validAddress,
+				denomsToClaim: types.Selections{
+					types.NewDestinationSelection("hard", "large", types.CLAIM_DESTINATION_DELEGATION, validValAddress),
+				},
+			},
+			expect: expectedErr{
+				pass: true,
+			},
+		},
+		{
+			name: "earn destination without validator is valid",
+			msgArgs: msgArgs{
+				sender: validAddress,
+				denomsToClaim: types.Selections{
+					types.NewDestinationSelection("hard", "small", types.CLAIM_DESTINATION_EARN, ""),
+				},
+			},
+			expect: expectedErr{
+				pass: true,
+			},
+		},
+		{
+			name: "delegation destination without validator is invalid",
+			msgArgs: msgArgs{
+				sender: validAddress,
+				denomsToClaim: types.Selections{
+					types.NewDestinationSelection("hard", "large", types.CLAIM_DESTINATION_DELEGATION, ""),
+				},
+			},
+			expect: expectedErr{
+				wraps: types.ErrInvalidClaimDestination,
+			},
+		},
+		{
+			name: "savings destination with validator is invalid",
+			msgArgs: msgArgs{
+				sender: validAddress,
+				denomsToClaim: types.Selections{
+					types.NewDestinationSelection("hard", "large", types.CLAIM_DESTINATION_SAVINGS, validValAddress),
+				},
+			},
+			expect: expectedErr{
+				wraps: types.ErrInvalidClaimDestination,
+			},
+		},
+		{
+			name: "unknown destination is invalid",
+			msgArgs: msgArgs{
+				sender: validAddress,
+				denomsToClaim: types.Selections{
+					types.NewDestinationSelection("hard", "large", types.ClaimDestination(10), ""),
+				},
+			},
+			expect: expectedErr{
+				wraps: types.ErrInvalidClaimDestination,
+			},
+		},
 		{
 			name: "empty denoms to claim is not valid",
 			msgArgs: msgArgs{
@@ -145,7 +206,9 @@ func TestMsgClaim_Validate(t *testing.T) {
 		msgClaimDelegatorReward := types.NewMsgClaimDelegatorReward(tc.msgArgs.sender, tc.msgArgs.denomsToClaim)
 		msgClaimSwapReward := types.NewMsgClaimSwapReward(tc.msgArgs.sender, tc.msgArgs.denomsToClaim)
 		msgClaimSavingsReward := types.NewMsgClaimSavingsReward(tc.msgArgs.sender, tc.msgArgs.denomsToClaim)
-		msgs := []sdk.Msg{&msgClaimHardReward, &msgClaimDelegatorReward, &msgClaimSwapReward, &msgClaimSavingsReward}
+		msgClaimAllRewards := types.NewMsgClaimAllRewards(tc.msgArgs.sender, tc.msgArgs.denomsToClaim)
+		msgClaimReward := types.NewMsgClaimReward(tc.msgArgs.sender, "lending", tc.msgArgs.denomsToClaim)
+		msgs := []sdk.Msg{&msgClaimHardReward, &msgClaimDelegatorReward, &msgClaimSwapReward, &msgClaimSavingsReward, &msgClaimAllRewards, &msgClaimReward}
 		for _, msg := range msgs {
 			t.Run(tc.name, func(t *testing.T) {
 				err := msg.ValidateBasic()
@@ -159,6 +222,20 @@ func TestMsgClaim_Validate(t *testing.T) {
 	}
 }
 
+func TestMsgClaimReward_ValidateSource(t *testing.T) {
+	validAddress := sdk.AccAddress(crypto.AddressHash([]byte("FuryTest1"))).String()
+	selections := types.Selections{{Denom: "hard", MultiplierName: "large"}}
+
+	for _, source := range []string{"", types.USDXMintingClaimType, types.HardLiquidityProviderClaimType, types.SwapClaimType} {
+		msg := types.NewMsgClaimReward(validAddress, source, selections)
+		err := msg.ValidateBasic()
+		require.Truef(t, errors.Is(err, types.ErrUnknownRewardSource), "source '%s' gave error '%s'", source, err)
+	}
+
+	msg := types.NewMsgClaimReward(validAddress, "lending", selections)
+	require.NoError(t, msg.ValidateBasic())
+}
+
 func TestMsgClaimUSDXMintingReward_Validate(t *testing.T) {
 	validAddress := sdk.AccAddress(crypto.AddressHash([]byte("FuryTest1"))).String()
 
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"sort"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -86,6 +87,17 @@ func NewSelection(denom, multiplierName string) Selection {
 	}
 }
 
+// NewDestinationSelection returns a new Selection that sends claimed rewards to a claim destination. The validator
+// is only used by the delegation destination, and the earn destination when depositing into the bfury vault.
+func NewDestinationSelection(denom, multiplierName string, destination ClaimDestination, validator string) Selection {
+	return Selection{
+		Denom:          denom,
+		MultiplierName: multiplierName,
+		Destination:    destination,
+		Validator:      validator,
+	}
+}
+
 // Validate performs basic validation checks
 func (s Selection) Validate() error {
 	if err := sdk.ValidateDenom(s.Denom); err != nil {
@@ -94,9 +106,69 @@ func (s Selection) Validate() error {
 	if s.MultiplierName == "" {
 		return errorsmod.Wrap(ErrInvalidMultiplier, "multiplier name cannot be empty")
 	}
+	if err := s.Destination.Validate(); err != nil {
+		return err
+	}
+	switch s.Destination {
+	case CLAIM_DESTINATION_DELEGATION:
+		if _, err := sdk.ValAddressFromBech32(s.Validator); err != nil {
+			return errorsmod.Wrapf(ErrInvalidClaimDestination, "invalid validator address: %s", err)
+		}
+	case CLAIM_DESTINATION_EARN:
+		if s.Validator == "" {
+			break
+		}
+		if _, err := sdk.ValAddressFromBech32(s.Validator); err != nil {
+			return errorsmod.Wrapf(ErrInvalidClaimDestination, "invalid validator address: %s", err)
+		}
+	default:
+		if s.Validator != "" {
+			return errorsmod.Wrapf(ErrInvalidClaimDestination, "validator cannot be set for destination %s", s.Destination)
+		}
+	}
 	return nil
 }
 
+// IsValid returns true if the claim destination is one of the defined destinations.
+func (d ClaimDestination) IsValid() bool {
+	switch d {
+	case CLAIM_DESTINATION_ACCOUNT, CLAIM_DESTINATION_EARN, CLAIM_DESTINATION_SAVINGS, CLAIM_DESTINATION_DELEGATION:
+		return true
+	default:
+		return false
+	}
+}
+
+// Validate returns an error if the claim destination is invalid.
+func (d ClaimDestination) Validate() error {
+	if !d.IsValid() {
+		return errorsmod.Wrapf(ErrInvalidClaimDestination, "%s", d)
+	}
+	return nil
+}
+
+// AcceptsLockedRewards returns true if rewards still under a lockup can be sent to the destination.
+// Locked coins cannot be transferred out of an account, they can only be kept or delegated.
+func (d ClaimDestination) AcceptsLockedRewards() bool {
+	return d == CLAIM_DESTINATION_ACCOUNT || d == CLAIM_DESTINATION_DELEGATION
+}
+
+// NewClaimDestinationFromString converts a string to a ClaimDestination. Unknown strings return an invalid destination.
+func NewClaimDestinationFromString(str string) ClaimDestination {
+	switch strings.ToLower(str) {
+	case "", "account":
+		return CLAIM_DESTINATION_ACCOUNT
+	case "earn":
+		return CLAIM_DESTINATION_EARN
+	case "savings":
+		return CLAIM_DESTINATION_SAVINGS
+	case "delegation":
+		return CLAIM_DESTINATION_DELEGATION
+	default:
+		return -1
+	}
+}
+
 // Selections are a list of denom - multiplier pairs that specify what rewards to claim and with what lockups.
 type Selections []Selection
 
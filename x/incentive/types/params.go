@@ -22,8 +22,10 @@ var (
 	KeySwapRewardPeriods        = []byte("SwapRewardPeriods")
 	KeySavingsRewardPeriods     = []byte("SavingsRewardPeriods")
 	KeyEarnRewardPeriods        = []byte("EarnRewardPeriods")
+	KeySourceRewardPeriods      = []byte("SourceRewardPeriods")
 	KeyClaimEnd                 = []byte("ClaimEnd")
 	KeyMultipliers              = []byte("ClaimMultipliers")
+	KeyRewardBoost              = []byte("RewardBoost")
 
 	DefaultActive             = false
 	DefaultRewardPeriods      = RewardPeriods{}
@@ -31,6 +33,11 @@ var (
 	DefaultMultipliers        = MultipliersPerDenoms{}
 	DefaultClaimEnd           = tmtime.Canonical(time.Unix(1, 0))
 
+	// DefaultSourceRewardPeriods is nil as empty slices are not preserved by the params store
+	DefaultSourceRewardPeriods SourceMultiRewardPeriods
+	// DefaultRewardBoost does not boost rewards
+	DefaultRewardBoost = RewardBoost{MaxBoost: sdk.OneDec(), FullBoostStake: sdk.ZeroInt()}
+
 	BondDenom              = "ufury"
 	USDXMintingRewardDenom = "ufury"
 
@@ -54,6 +61,7 @@ func NewParams(
 		SavingsRewardPeriods:     savings,
 		ClaimMultipliers:         multipliers,
 		ClaimEnd:                 claimEnd,
+		RewardBoost:              DefaultRewardBoost,
 	}
 }
 
@@ -87,8 +95,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeySwapRewardPeriods, &p.SwapRewardPeriods, validateMultiRewardPeriodsParam),
 		paramtypes.NewParamSetPair(KeySavingsRewardPeriods, &p.SavingsRewardPeriods, validateMultiRewardPeriodsParam),
 		paramtypes.NewParamSetPair(KeyEarnRewardPeriods, &p.EarnRewardPeriods, validateMultiRewardPeriodsParam),
+		paramtypes.NewParamSetPair(KeySourceRewardPeriods, &p.SourceRewardPeriods, validateSourceRewardPeriodsParam),
 		paramtypes.NewParamSetPair(KeyMultipliers, &p.ClaimMultipliers, validateMultipliersPerDenomParam),
 		paramtypes.NewParamSetPair(KeyClaimEnd, &p.ClaimEnd, validateClaimEndParam),
+		paramtypes.NewParamSetPair(KeyRewardBoost, &p.RewardBoost, validateRewardBoostParam),
 	}
 }
 
@@ -126,6 +136,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateSourceRewardPeriodsParam(p.SourceRewardPeriods); err != nil {
+		return err
+	}
+
+	if err := validateRewardBoostParam(p.RewardBoost); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -147,6 +165,15 @@ func validateMultiRewardPeriodsParam(i interface{}) error {
 	return rewards.Validate()
 }
 
+func validateSourceRewardPeriodsParam(i interface{}) error {
+	rewards, ok := i.(SourceMultiRewardPeriods)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return rewards.Validate()
+}
+
 func validateMultipliersPerDenomParam(i interface{}) error {
 	multipliers, ok := i.(MultipliersPerDenoms)
 	if !ok {
@@ -155,6 +182,15 @@ func validateMultipliersPerDenomParam(i interface{}) error {
 	return multipliers.Validate()
 }
 
+func validateRewardBoostParam(i interface{}) error {
+	boost, ok := i.(RewardBoost)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return boost.Validate()
+}
+
 func validateClaimEndParam(i interface{}) error {
 	endTime, ok := i.(time.Time)
 	if !ok {
@@ -270,7 +306,12 @@ func (mrp MultiRewardPeriod) Validate() error {
 	if strings.TrimSpace(mrp.CollateralType) == "" {
 		return fmt.Errorf("reward period collateral type cannot be blank: %v", mrp)
 	}
-	return nil
+	if mrp.Curve != nil {
+		if err := mrp.Curve.Validate(mrp.End.Sub(mrp.Start)); err != nil {
+			return err
+		}
+	}
+	return mrp.validateBudget()
 }
 
 // MultiRewardPeriods array of MultiRewardPeriod
@@ -313,3 +354,49 @@ func (mrps MultiRewardPeriods) Validate() error {
 
 	return nil
 }
+
+// NewSourceMultiRewardPeriod returns a new SourceMultiRewardPeriod
+func NewSourceMultiRewardPeriod(source string, rewardPeriods MultiRewardPeriods) SourceMultiRewardPeriod {
+	return SourceMultiRewardPeriod{
+		Source:        source,
+		RewardPeriods: rewardPeriods,
+	}
+}
+
+// Validate performs a basic check of a SourceMultiRewardPeriod.
+func (srp SourceMultiRewardPeriod) Validate() error {
+	if err := ValidateRewardSource(srp.Source); err != nil {
+		return err
+	}
+	return srp.RewardPeriods.Validate()
+}
+
+// SourceMultiRewardPeriods array of SourceMultiRewardPeriod
+type SourceMultiRewardPeriods []SourceMultiRewardPeriod
+
+// Get fetches the reward periods of a reward source
+func (srps SourceMultiRewardPeriods) Get(source string) (MultiRewardPeriods, bool) {
+	for _, srp := range srps {
+		if srp.Source == source {
+			return srp.RewardPeriods, true
+		}
+	}
+	return nil, false
+}
+
+// Validate checks if all the SourceMultiRewardPeriods are valid and there are no duplicated sources.
+func (srps SourceMultiRewardPeriods) Validate() error {
+	seenSources := make(map[string]bool)
+	for _, srp := range srps {
+		if seenSources[srp.Source] {
+			return fmt.Errorf("duplicated reward periods for source %s", srp.Source)
+		}
+
+		if err := srp.Validate(); err != nil {
+			return err
+		}
+		seenSources[srp.Source] = true
+	}
+
+	return nil
+}
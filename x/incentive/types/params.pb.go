@@ -4,12 +4,15 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -29,6 +32,42 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RewardCurveType is the shape of an emission curve.
+type RewardCurveType int32
+
+const (
+	// REWARD_CURVE_TYPE_CONSTANT pays rewards_per_second for the whole period.
+	REWARD_CURVE_TYPE_CONSTANT RewardCurveType = 0
+	// REWARD_CURVE_TYPE_LINEAR decays the rate linearly from rewards_per_second at the start to end_factor at the end.
+	REWARD_CURVE_TYPE_LINEAR RewardCurveType = 1
+	// REWARD_CURVE_TYPE_STEPS scales the rate by the factor of the latest step that has started.
+	REWARD_CURVE_TYPE_STEPS RewardCurveType = 2
+	// REWARD_CURVE_TYPE_HALVING halves the rate every halving_interval after the start.
+	REWARD_CURVE_TYPE_HALVING RewardCurveType = 3
+)
+
+var RewardCurveType_name = map[int32]string{
+	0: "REWARD_CURVE_TYPE_CONSTANT",
+	1: "REWARD_CURVE_TYPE_LINEAR",
+	2: "REWARD_CURVE_TYPE_STEPS",
+	3: "REWARD_CURVE_TYPE_HALVING",
+}
+
+var RewardCurveType_value = map[string]int32{
+	"REWARD_CURVE_TYPE_CONSTANT": 0,
+	"REWARD_CURVE_TYPE_LINEAR":   1,
+	"REWARD_CURVE_TYPE_STEPS":    2,
+	"REWARD_CURVE_TYPE_HALVING":  3,
+}
+
+func (x RewardCurveType) String() string {
+	return proto.EnumName(RewardCurveType_name, int32(x))
+}
+
+func (RewardCurveType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9f1760ab583d7e90, []int{0}
+}
+
 // RewardPeriod stores the state of an ongoing reward
 type RewardPeriod struct {
 	Active           bool       `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
@@ -42,7 +81,7 @@ func (m *RewardPeriod) Reset()         { *m = RewardPeriod{} }
 func (m *RewardPeriod) String() string { return proto.CompactTextString(m) }
 func (*RewardPeriod) ProtoMessage()    {}
 func (*RewardPeriod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb8833f5d745eac9, []int{0}
+	return fileDescriptor_9f1760ab583d7e90, []int{0}
 }
 func (m *RewardPeriod) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -78,13 +117,18 @@ type MultiRewardPeriod struct {
 	Start            time.Time                                `protobuf:"bytes,3,opt,name=start,proto3,stdtime" json:"start"`
 	End              time.Time                                `protobuf:"bytes,4,opt,name=end,proto3,stdtime" json:"end"`
 	RewardsPerSecond github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=rewards_per_second,json=rewardsPerSecond,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards_per_second"`
+	// budget is the optional total amount of rewards the period can distribute, covering every reward denom.
+	// Accumulation of a denom stops once its budget is spent.
+	Budget github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=budget,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"budget"`
+	// curve is the optional emission curve scaling rewards_per_second over the period. Rewards are constant if unset.
+	Curve *RewardCurve `protobuf:"bytes,7,opt,name=curve,proto3" json:"curve,omitempty"`
 }
 
 func (m *MultiRewardPeriod) Reset()         { *m = MultiRewardPeriod{} }
 func (m *MultiRewardPeriod) String() string { return proto.CompactTextString(m) }
 func (*MultiRewardPeriod) ProtoMessage()    {}
 func (*MultiRewardPeriod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb8833f5d745eac9, []int{1}
+	return fileDescriptor_9f1760ab583d7e90, []int{1}
 }
 func (m *MultiRewardPeriod) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -113,6 +157,134 @@ func (m *MultiRewardPeriod) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MultiRewardPeriod proto.InternalMessageInfo
 
+// RewardCurve scales the rewards per second of a reward period over time.
+type RewardCurve struct {
+	Type RewardCurveType `protobuf:"varint,1,opt,name=type,proto3,enum=fury.incentive.v1beta1.RewardCurveType" json:"type,omitempty"`
+	// end_factor is the fraction of rewards_per_second paid at the end of a linear curve.
+	EndFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=end_factor,json=endFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"end_factor"`
+	// steps are the steps of a step curve, ordered by offset.
+	Steps []RewardStep `protobuf:"bytes,3,rep,name=steps,proto3" json:"steps"`
+	// halving_interval is the time between halvings of a halving curve.
+	HalvingInterval time.Duration `protobuf:"bytes,4,opt,name=halving_interval,json=halvingInterval,proto3,stdduration" json:"halving_interval"`
+}
+
+func (m *RewardCurve) Reset()         { *m = RewardCurve{} }
+func (m *RewardCurve) String() string { return proto.CompactTextString(m) }
+func (*RewardCurve) ProtoMessage()    {}
+func (*RewardCurve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f1760ab583d7e90, []int{2}
+}
+func (m *RewardCurve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardCurve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardCurve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardCurve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardCurve.Merge(m, src)
+}
+func (m *RewardCurve) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardCurve) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardCurve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardCurve proto.InternalMessageInfo
+
+// RewardStep is a step of a step emission curve.
+type RewardStep struct {
+	// offset is the time after the period start that the step begins.
+	Offset time.Duration `protobuf:"bytes,1,opt,name=offset,proto3,stdduration" json:"offset"`
+	// factor is the fraction of rewards_per_second paid from the step onwards.
+	Factor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=factor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"factor"`
+}
+
+func (m *RewardStep) Reset()         { *m = RewardStep{} }
+func (m *RewardStep) String() string { return proto.CompactTextString(m) }
+func (*RewardStep) ProtoMessage()    {}
+func (*RewardStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f1760ab583d7e90, []int{3}
+}
+func (m *RewardStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardStep.Merge(m, src)
+}
+func (m *RewardStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardStep proto.InternalMessageInfo
+
+// RewardBoost scales the rewards synced to reward source claims by the owner's bonded FURY, up to a cap.
+type RewardBoost struct {
+	// max_boost is the cap of the boost multiplier, reached by owners with full_boost_stake bonded.
+	MaxBoost github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=max_boost,json=maxBoost,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_boost"`
+	// full_boost_stake is the amount of bonded ufury needed for the max boost. The boost grows linearly up to it.
+	FullBoostStake cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=full_boost_stake,json=fullBoostStake,proto3,customtype=cosmossdk.io/math.Int" json:"full_boost_stake"`
+	// sources are the reward sources whose rewards are boosted. All reward sources are boosted if empty.
+	Sources []string `protobuf:"bytes,3,rep,name=sources,proto3" json:"sources,omitempty"`
+}
+
+func (m *RewardBoost) Reset()         { *m = RewardBoost{} }
+func (m *RewardBoost) String() string { return proto.CompactTextString(m) }
+func (*RewardBoost) ProtoMessage()    {}
+func (*RewardBoost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f1760ab583d7e90, []int{4}
+}
+func (m *RewardBoost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardBoost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardBoost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardBoost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardBoost.Merge(m, src)
+}
+func (m *RewardBoost) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardBoost) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardBoost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardBoost proto.InternalMessageInfo
+
 // Multiplier amount the claim rewards get increased by, along with how long the claim rewards are locked
 type Multiplier struct {
 	Name         string                                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -124,7 +296,7 @@ func (m *Multiplier) Reset()         { *m = Multiplier{} }
 func (m *Multiplier) String() string { return proto.CompactTextString(m) }
 func (*Multiplier) ProtoMessage()    {}
 func (*Multiplier) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb8833f5d745eac9, []int{2}
+	return fileDescriptor_9f1760ab583d7e90, []int{5}
 }
 func (m *Multiplier) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -163,7 +335,7 @@ func (m *MultipliersPerDenom) Reset()         { *m = MultipliersPerDenom{} }
 func (m *MultipliersPerDenom) String() string { return proto.CompactTextString(m) }
 func (*MultipliersPerDenom) ProtoMessage()    {}
 func (*MultipliersPerDenom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb8833f5d745eac9, []int{3}
+	return fileDescriptor_9f1760ab583d7e90, []int{6}
 }
 func (m *MultipliersPerDenom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -192,24 +364,66 @@ func (m *MultipliersPerDenom) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MultipliersPerDenom proto.InternalMessageInfo
 
+// SourceMultiRewardPeriod groups the reward periods of a reward source
+type SourceMultiRewardPeriod struct {
+	Source        string             `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	RewardPeriods MultiRewardPeriods `protobuf:"bytes,2,rep,name=reward_periods,json=rewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"reward_periods"`
+}
+
+func (m *SourceMultiRewardPeriod) Reset()         { *m = SourceMultiRewardPeriod{} }
+func (m *SourceMultiRewardPeriod) String() string { return proto.CompactTextString(m) }
+func (*SourceMultiRewardPeriod) ProtoMessage()    {}
+func (*SourceMultiRewardPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f1760ab583d7e90, []int{7}
+}
+func (m *SourceMultiRewardPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SourceMultiRewardPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SourceMultiRewardPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SourceMultiRewardPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceMultiRewardPeriod.Merge(m, src)
+}
+func (m *SourceMultiRewardPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *SourceMultiRewardPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceMultiRewardPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourceMultiRewardPeriod proto.InternalMessageInfo
+
 // Params
 type Params struct {
-	USDXMintingRewardPeriods RewardPeriods        `protobuf:"bytes,1,rep,name=usdx_minting_reward_periods,json=usdxMintingRewardPeriods,proto3,castrepeated=RewardPeriods" json:"usdx_minting_reward_periods"`
-	HardSupplyRewardPeriods  MultiRewardPeriods   `protobuf:"bytes,2,rep,name=hard_supply_reward_periods,json=hardSupplyRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"hard_supply_reward_periods"`
-	HardBorrowRewardPeriods  MultiRewardPeriods   `protobuf:"bytes,3,rep,name=hard_borrow_reward_periods,json=hardBorrowRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"hard_borrow_reward_periods"`
-	DelegatorRewardPeriods   MultiRewardPeriods   `protobuf:"bytes,4,rep,name=delegator_reward_periods,json=delegatorRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"delegator_reward_periods"`
-	SwapRewardPeriods        MultiRewardPeriods   `protobuf:"bytes,5,rep,name=swap_reward_periods,json=swapRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"swap_reward_periods"`
-	ClaimMultipliers         MultipliersPerDenoms `protobuf:"bytes,6,rep,name=claim_multipliers,json=claimMultipliers,proto3,castrepeated=MultipliersPerDenoms" json:"claim_multipliers"`
-	ClaimEnd                 time.Time            `protobuf:"bytes,7,opt,name=claim_end,json=claimEnd,proto3,stdtime" json:"claim_end"`
-	SavingsRewardPeriods     MultiRewardPeriods   `protobuf:"bytes,8,rep,name=savings_reward_periods,json=savingsRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"savings_reward_periods"`
-	EarnRewardPeriods        MultiRewardPeriods   `protobuf:"bytes,9,rep,name=earn_reward_periods,json=earnRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"earn_reward_periods"`
+	USDXMintingRewardPeriods RewardPeriods            `protobuf:"bytes,1,rep,name=usdx_minting_reward_periods,json=usdxMintingRewardPeriods,proto3,castrepeated=RewardPeriods" json:"usdx_minting_reward_periods"`
+	HardSupplyRewardPeriods  MultiRewardPeriods       `protobuf:"bytes,2,rep,name=hard_supply_reward_periods,json=hardSupplyRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"hard_supply_reward_periods"`
+	HardBorrowRewardPeriods  MultiRewardPeriods       `protobuf:"bytes,3,rep,name=hard_borrow_reward_periods,json=hardBorrowRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"hard_borrow_reward_periods"`
+	DelegatorRewardPeriods   MultiRewardPeriods       `protobuf:"bytes,4,rep,name=delegator_reward_periods,json=delegatorRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"delegator_reward_periods"`
+	SwapRewardPeriods        MultiRewardPeriods       `protobuf:"bytes,5,rep,name=swap_reward_periods,json=swapRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"swap_reward_periods"`
+	ClaimMultipliers         MultipliersPerDenoms     `protobuf:"bytes,6,rep,name=claim_multipliers,json=claimMultipliers,proto3,castrepeated=MultipliersPerDenoms" json:"claim_multipliers"`
+	ClaimEnd                 time.Time                `protobuf:"bytes,7,opt,name=claim_end,json=claimEnd,proto3,stdtime" json:"claim_end"`
+	SavingsRewardPeriods     MultiRewardPeriods       `protobuf:"bytes,8,rep,name=savings_reward_periods,json=savingsRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"savings_reward_periods"`
+	EarnRewardPeriods        MultiRewardPeriods       `protobuf:"bytes,9,rep,name=earn_reward_periods,json=earnRewardPeriods,proto3,castrepeated=MultiRewardPeriods" json:"earn_reward_periods"`
+	SourceRewardPeriods      SourceMultiRewardPeriods `protobuf:"bytes,10,rep,name=source_reward_periods,json=sourceRewardPeriods,proto3,castrepeated=SourceMultiRewardPeriods" json:"source_reward_periods"`
+	// reward_boost boosts synced rewards of reward sources by the owner's bonded FURY. It is disabled if max_boost is unset or 1.
+	RewardBoost RewardBoost `protobuf:"bytes,11,opt,name=reward_boost,json=rewardBoost,proto3" json:"reward_boost"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bb8833f5d745eac9, []int{4}
+	return fileDescriptor_9f1760ab583d7e90, []int{8}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -239,68 +453,100 @@ func (m *Params) XXX_DiscardUnknown() {
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("fury.incentive.v1beta1.RewardCurveType", RewardCurveType_name, RewardCurveType_value)
 	proto.RegisterType((*RewardPeriod)(nil), "fury.incentive.v1beta1.RewardPeriod")
 	proto.RegisterType((*MultiRewardPeriod)(nil), "fury.incentive.v1beta1.MultiRewardPeriod")
+	proto.RegisterType((*RewardCurve)(nil), "fury.incentive.v1beta1.RewardCurve")
+	proto.RegisterType((*RewardStep)(nil), "fury.incentive.v1beta1.RewardStep")
+	proto.RegisterType((*RewardBoost)(nil), "fury.incentive.v1beta1.RewardBoost")
 	proto.RegisterType((*Multiplier)(nil), "fury.incentive.v1beta1.Multiplier")
 	proto.RegisterType((*MultipliersPerDenom)(nil), "fury.incentive.v1beta1.MultipliersPerDenom")
+	proto.RegisterType((*SourceMultiRewardPeriod)(nil), "fury.incentive.v1beta1.SourceMultiRewardPeriod")
 	proto.RegisterType((*Params)(nil), "fury.incentive.v1beta1.Params")
 }
 
 func init() {
-	proto.RegisterFile("fury/incentive/v1beta1/params.proto", fileDescriptor_bb8833f5d745eac9)
-}
-
-var fileDescriptor_bb8833f5d745eac9 = []byte{
-	// 774 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0xcf, 0x6e, 0xd3, 0x4a,
-	0x14, 0xc6, 0xe3, 0xfc, 0xbb, 0xc9, 0xb4, 0xbd, 0xb7, 0x9d, 0x46, 0xb9, 0xbe, 0xb9, 0xc8, 0xa9,
-	0x52, 0x04, 0x41, 0x55, 0x6d, 0x0a, 0x12, 0x0b, 0x76, 0x98, 0x82, 0x84, 0x44, 0xa5, 0xca, 0x2d,
-	0x12, 0xb0, 0x89, 0x26, 0xf6, 0xd4, 0xb5, 0x6a, 0x7b, 0xac, 0x99, 0x49, 0xda, 0x88, 0x05, 0x12,
-	0x0b, 0x76, 0x48, 0x15, 0x0b, 0x1e, 0xa2, 0xaf, 0xc1, 0xa6, 0xcb, 0x8a, 0x15, 0x62, 0xd1, 0x42,
-	0xfa, 0x22, 0x68, 0xc6, 0x6e, 0xe3, 0xa4, 0x69, 0xa1, 0x52, 0x36, 0xac, 0x32, 0x3e, 0x73, 0xce,
-	0xf9, 0x7d, 0xfe, 0xc6, 0x67, 0x14, 0xb0, 0xb8, 0x83, 0xba, 0xc8, 0xf0, 0x42, 0x1b, 0x87, 0xdc,
-	0xeb, 0x62, 0xa3, 0xbb, 0xd2, 0xc6, 0x1c, 0xad, 0x18, 0x11, 0xa2, 0x28, 0x60, 0x7a, 0x44, 0x09,
-	0x27, 0xb0, 0x2a, 0x92, 0xf4, 0xf3, 0x24, 0x3d, 0x49, 0xaa, 0x69, 0x36, 0x61, 0x01, 0x61, 0x46,
-	0x1b, 0xb1, 0x41, 0xa5, 0x4d, 0xbc, 0x30, 0xae, 0xab, 0x55, 0x5c, 0xe2, 0x12, 0xb9, 0x34, 0xc4,
-	0x2a, 0x89, 0xd6, 0x5d, 0x42, 0x5c, 0x1f, 0x1b, 0xf2, 0xa9, 0xdd, 0xd9, 0x32, 0xb8, 0x17, 0x60,
-	0xc6, 0x51, 0x10, 0xc5, 0x09, 0x8d, 0x8f, 0x59, 0x30, 0x6d, 0xe1, 0x5d, 0x44, 0x9d, 0x75, 0x4c,
-	0x3d, 0xe2, 0xc0, 0x2a, 0x28, 0x22, 0x5b, 0x90, 0x55, 0x65, 0x41, 0x69, 0x96, 0xac, 0xe4, 0x09,
-	0xde, 0x06, 0xff, 0xd8, 0xc4, 0xf7, 0x11, 0xc7, 0x14, 0xf9, 0x2d, 0xde, 0x8b, 0xb0, 0x9a, 0x5d,
-	0x50, 0x9a, 0x65, 0xeb, 0xef, 0x41, 0x78, 0xb3, 0x17, 0x61, 0xf8, 0x10, 0x14, 0x18, 0x47, 0x94,
-	0xab, 0xb9, 0x05, 0xa5, 0x39, 0x75, 0xaf, 0xa6, 0xc7, 0x12, 0xf4, 0x33, 0x09, 0xfa, 0xe6, 0x99,
-	0x04, 0xb3, 0x74, 0x78, 0x5c, 0xcf, 0xec, 0x9f, 0xd4, 0x15, 0x2b, 0x2e, 0x81, 0x0f, 0x40, 0x0e,
-	0x87, 0x8e, 0x9a, 0xbf, 0x46, 0xa5, 0x28, 0x80, 0x6b, 0x00, 0x52, 0xf9, 0x12, 0xac, 0x15, 0x61,
-	0xda, 0x62, 0xd8, 0x26, 0xa1, 0xa3, 0x16, 0x64, 0x9b, 0xff, 0xf4, 0xd8, 0x39, 0x5d, 0x38, 0x77,
-	0x66, 0xa7, 0xfe, 0x98, 0x78, 0xa1, 0x99, 0x17, 0x5d, 0xac, 0xd9, 0xa4, 0x74, 0x1d, 0xd3, 0x0d,
-	0x59, 0xd8, 0xf8, 0x9c, 0x05, 0x73, 0x6b, 0x1d, 0x9f, 0x7b, 0x7f, 0xbe, 0x33, 0xbd, 0x4b, 0x9c,
-	0xc9, 0x5d, 0xed, 0xcc, 0x5d, 0xd1, 0xe5, 0xe0, 0xa4, 0xde, 0x74, 0x3d, 0xbe, 0xdd, 0x69, 0xeb,
-	0x36, 0x09, 0x8c, 0xe4, 0x03, 0x8c, 0x7f, 0x96, 0x99, 0xb3, 0x63, 0x88, 0x77, 0x65, 0xb2, 0x80,
-	0x8d, 0x71, 0xf1, 0x83, 0x02, 0x80, 0x74, 0x31, 0xf2, 0x3d, 0x4c, 0x21, 0x04, 0xf9, 0x10, 0x05,
-	0xb1, 0x79, 0x65, 0x4b, 0xae, 0xe1, 0x22, 0x98, 0x09, 0x48, 0xc8, 0xb7, 0x59, 0xcb, 0x27, 0xf6,
-	0x4e, 0x27, 0x92, 0xc6, 0xe5, 0xac, 0xe9, 0x38, 0xf8, 0x5c, 0xc6, 0xe0, 0x53, 0x50, 0xdc, 0x42,
-	0x36, 0x27, 0x54, 0xfa, 0x36, 0x6d, 0xea, 0x42, 0xdb, 0xb7, 0xe3, 0xfa, 0xad, 0xdf, 0xd0, 0xb6,
-	0x8a, 0x6d, 0x2b, 0xa9, 0x6e, 0xbc, 0x57, 0xc0, 0xfc, 0x40, 0x8f, 0x10, 0xba, 0x8a, 0x43, 0x12,
-	0xc0, 0x0a, 0x28, 0x38, 0x62, 0x91, 0x28, 0x8b, 0x1f, 0xe0, 0x2b, 0x30, 0x15, 0x0c, 0x92, 0xd5,
-	0xac, 0x74, 0xac, 0xa1, 0x8f, 0x9f, 0x4e, 0x7d, 0xd0, 0xd7, 0x9c, 0x4f, 0xac, 0x9b, 0x4a, 0xb1,
-	0xac, 0x74, 0xaf, 0xc6, 0x97, 0x12, 0x28, 0xae, 0xcb, 0x99, 0x87, 0x9f, 0x14, 0xf0, 0x7f, 0x87,
-	0x39, 0x7b, 0xad, 0xc0, 0x0b, 0xb9, 0x17, 0xba, 0xad, 0xd8, 0x45, 0x71, 0x56, 0x1e, 0x71, 0x98,
-	0xaa, 0x48, 0xec, 0xcd, 0xcb, 0xb0, 0xe9, 0xef, 0xd3, 0x5c, 0x11, 0xe0, 0xfe, 0x71, 0x5d, 0x7d,
-	0xb1, 0xb1, 0xfa, 0x72, 0x2d, 0xee, 0x97, 0x4e, 0x60, 0x07, 0x27, 0xf5, 0x99, 0xa1, 0x80, 0xa5,
-	0x0a, 0xf6, 0xb8, 0x54, 0xf8, 0x4e, 0x01, 0xb5, 0x6d, 0xa1, 0x84, 0x75, 0xa2, 0xc8, 0xef, 0x8d,
-	0xea, 0x8a, 0xed, 0xb8, 0x73, 0xa5, 0x1d, 0x43, 0xe2, 0x6a, 0x89, 0x2b, 0xf0, 0xc2, 0x16, 0xb3,
-	0xfe, 0x15, 0xa0, 0x0d, 0xc9, 0xb9, 0x44, 0x44, 0x9b, 0x50, 0x4a, 0x76, 0x47, 0x45, 0xe4, 0x26,
-	0x2e, 0xc2, 0x94, 0x9c, 0x61, 0x11, 0x6f, 0x81, 0xea, 0x60, 0x1f, 0xbb, 0x88, 0x13, 0x3a, 0xaa,
-	0x20, 0x3f, 0x49, 0x05, 0xd5, 0x73, 0xcc, 0xb0, 0x80, 0x0e, 0x98, 0x67, 0xbb, 0x28, 0x1a, 0x65,
-	0x17, 0x26, 0xc9, 0x9e, 0x13, 0x84, 0x61, 0x6c, 0x17, 0xcc, 0xd9, 0x3e, 0xf2, 0x82, 0x56, 0x7a,
-	0x0c, 0x8a, 0x12, 0xba, 0xf4, 0xeb, 0x31, 0x38, 0x1f, 0x2f, 0xf3, 0x46, 0x82, 0xad, 0x8c, 0xd9,
-	0x64, 0xd6, 0xac, 0x64, 0xa4, 0xb6, 0xe0, 0x23, 0x50, 0x8e, 0xb9, 0xe2, 0xbe, 0xfb, 0xeb, 0x1a,
-	0xf7, 0x5d, 0x49, 0x96, 0x3d, 0x09, 0x1d, 0xf8, 0x06, 0x54, 0x19, 0xea, 0x7a, 0xa1, 0xcb, 0x46,
-	0x4d, 0x2b, 0x4d, 0xd2, 0xb4, 0x4a, 0x02, 0xb9, 0x70, 0x5c, 0x18, 0xd1, 0x70, 0x94, 0x5c, 0x9e,
-	0xe8, 0x71, 0x09, 0xc2, 0x50, 0xc8, 0x7c, 0x76, 0xf8, 0x43, 0xcb, 0x1c, 0xf6, 0x35, 0xe5, 0xa8,
-	0xaf, 0x29, 0xdf, 0xfb, 0x9a, 0xb2, 0x7f, 0xaa, 0x65, 0x8e, 0x4e, 0xb5, 0xcc, 0xd7, 0x53, 0x2d,
-	0xf3, 0x7a, 0x29, 0x75, 0x57, 0x0a, 0x05, 0xcb, 0x3e, 0x6a, 0x33, 0xb9, 0x32, 0xf6, 0x52, 0xff,
-	0x48, 0xe4, 0xa5, 0xd9, 0x2e, 0x4a, 0x9b, 0xef, 0xff, 0x0c, 0x00, 0x00, 0xff, 0xff, 0xca, 0x77,
-	0xf8, 0x09, 0xb0, 0x08, 0x00, 0x00,
+	proto.RegisterFile("fury/incentive/v1beta1/params.proto", fileDescriptor_9f1760ab583d7e90)
+}
+
+var fileDescriptor_9f1760ab583d7e90 = []byte{
+	// 1204 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0xc6, 0x89, 0x1b, 0xbf, 0xa4, 0xa9, 0x3b, 0x49, 0xd3, 0x8d, 0xdb, 0xaf, 0x1d, 0xb9,
+	0x5f, 0xd1, 0x40, 0xd5, 0x35, 0x29, 0x12, 0x12, 0x54, 0x42, 0x8a, 0x93, 0x14, 0x22, 0x92, 0x34,
+	0x5a, 0xa7, 0x85, 0x72, 0x59, 0x8d, 0x77, 0x27, 0xce, 0x2a, 0xbb, 0x3b, 0xab, 0x9d, 0xd9, 0xfc,
+	0x10, 0x07, 0x24, 0x24, 0x10, 0x17, 0xa4, 0x96, 0x03, 0xe2, 0xc6, 0x81, 0x0b, 0xf4, 0xcc, 0x1f,
+	0x51, 0x89, 0x4b, 0xc5, 0x09, 0x71, 0x68, 0x21, 0xf9, 0x47, 0xd0, 0xfc, 0x70, 0xfd, 0x2b, 0x09,
+	0x29, 0x35, 0x07, 0x4e, 0xde, 0x37, 0x6f, 0xde, 0xe7, 0xf3, 0x99, 0xf7, 0xde, 0xfc, 0x30, 0x5c,
+	0xdb, 0x4a, 0x93, 0x83, 0xaa, 0x1f, 0xb9, 0x24, 0xe2, 0xfe, 0x2e, 0xa9, 0xee, 0xce, 0x37, 0x08,
+	0xc7, 0xf3, 0xd5, 0x18, 0x27, 0x38, 0x64, 0x56, 0x9c, 0x50, 0x4e, 0xd1, 0xb4, 0x98, 0x64, 0xbd,
+	0x98, 0x64, 0xe9, 0x49, 0xc5, 0x92, 0x4b, 0x59, 0x48, 0x59, 0xb5, 0x81, 0x59, 0x3b, 0xd2, 0xa5,
+	0x7e, 0xa4, 0xe2, 0x8a, 0x33, 0xca, 0xef, 0x48, 0xab, 0xaa, 0x0c, 0xed, 0x9a, 0x6a, 0xd2, 0x26,
+	0x55, 0xe3, 0xe2, 0x4b, 0x8f, 0x96, 0x9a, 0x94, 0x36, 0x03, 0x52, 0x95, 0x56, 0x23, 0xdd, 0xaa,
+	0x7a, 0x69, 0x82, 0xb9, 0x4f, 0x5b, 0x80, 0xe5, 0x5e, 0x3f, 0xf7, 0x43, 0xc2, 0x38, 0x0e, 0x63,
+	0x35, 0xa1, 0xf2, 0xcd, 0x10, 0x8c, 0xdb, 0x64, 0x0f, 0x27, 0xde, 0x06, 0x49, 0x7c, 0xea, 0xa1,
+	0x69, 0xc8, 0x61, 0x57, 0x88, 0x36, 0x8d, 0x59, 0x63, 0x6e, 0xd4, 0xd6, 0x16, 0xba, 0x0e, 0x17,
+	0x5c, 0x1a, 0x04, 0x98, 0x93, 0x04, 0x07, 0x0e, 0x3f, 0x88, 0x89, 0x39, 0x34, 0x6b, 0xcc, 0xe5,
+	0xed, 0x89, 0xf6, 0xf0, 0xe6, 0x41, 0x4c, 0xd0, 0xbb, 0x30, 0xc2, 0x38, 0x4e, 0xb8, 0x99, 0x9d,
+	0x35, 0xe6, 0xc6, 0x6e, 0x15, 0x2d, 0x25, 0xc1, 0x6a, 0x49, 0xb0, 0x36, 0x5b, 0x12, 0x6a, 0xa3,
+	0x4f, 0x9e, 0x95, 0x33, 0x0f, 0x9f, 0x97, 0x0d, 0x5b, 0x85, 0xa0, 0xb7, 0x21, 0x4b, 0x22, 0xcf,
+	0x1c, 0x7e, 0x89, 0x48, 0x11, 0x80, 0xd6, 0x00, 0x25, 0x72, 0x11, 0xcc, 0x89, 0x49, 0xe2, 0x30,
+	0xe2, 0xd2, 0xc8, 0x33, 0x47, 0x24, 0xcc, 0x8c, 0xa5, 0xf3, 0x28, 0x92, 0xde, 0xaa, 0x84, 0xb5,
+	0x48, 0xfd, 0xa8, 0x36, 0x2c, 0x50, 0xec, 0x82, 0x0e, 0xdd, 0x20, 0x49, 0x5d, 0x06, 0x56, 0x8e,
+	0xb2, 0x70, 0x71, 0x2d, 0x0d, 0xb8, 0xff, 0xdf, 0xcf, 0xcc, 0xc1, 0x09, 0x99, 0xc9, 0x9e, 0x9e,
+	0x99, 0x37, 0x05, 0xca, 0xe3, 0xe7, 0xe5, 0xb9, 0xa6, 0xcf, 0xb7, 0xd3, 0x86, 0xe5, 0xd2, 0x50,
+	0xb7, 0xa3, 0xfe, 0xb9, 0xc9, 0xbc, 0x9d, 0xaa, 0x58, 0x2b, 0x93, 0x01, 0xac, 0x3f, 0x8b, 0xc8,
+	0x85, 0x5c, 0x23, 0xf5, 0x9a, 0x84, 0x9b, 0xb9, 0xc1, 0xd3, 0x69, 0x68, 0xf4, 0x0e, 0x8c, 0xb8,
+	0x69, 0xb2, 0x4b, 0xcc, 0x73, 0x32, 0x33, 0xd7, 0xac, 0xe3, 0x77, 0x9e, 0xa5, 0x2a, 0xb9, 0x28,
+	0xa6, 0xda, 0x2a, 0xa2, 0xf2, 0xd3, 0x10, 0x8c, 0x75, 0x0c, 0xa3, 0xdb, 0x30, 0x2c, 0x8b, 0x27,
+	0xaa, 0x3b, 0x71, 0xeb, 0xfa, 0x19, 0x90, 0x44, 0x55, 0x6d, 0x19, 0x84, 0xd6, 0x00, 0x48, 0xe4,
+	0x39, 0x5b, 0xd8, 0xe5, 0x34, 0x91, 0xf5, 0x1f, 0xaf, 0x59, 0x62, 0x55, 0xbf, 0x3f, 0x2b, 0xbf,
+	0x76, 0x86, 0x55, 0x2d, 0x11, 0xd7, 0xce, 0x93, 0xc8, 0xbb, 0x23, 0x01, 0xd0, 0x7b, 0xa2, 0x55,
+	0x48, 0xcc, 0xcc, 0xac, 0x4c, 0x5d, 0xe5, 0x74, 0x31, 0x75, 0x4e, 0x62, 0xdd, 0xcc, 0x2a, 0x0c,
+	0xad, 0x43, 0x61, 0x1b, 0x07, 0xbb, 0x7e, 0xd4, 0x74, 0xfc, 0x88, 0x93, 0x64, 0x17, 0x07, 0xba,
+	0x77, 0x66, 0xfa, 0x7a, 0x67, 0x49, 0x1f, 0x19, 0xaa, 0x75, 0xbe, 0x13, 0xad, 0x73, 0x41, 0x07,
+	0xaf, 0xe8, 0xd8, 0xca, 0x23, 0x03, 0xa0, 0xcd, 0x85, 0x6e, 0x43, 0x8e, 0x6e, 0x6d, 0x31, 0xc2,
+	0x4d, 0xe3, 0xec, 0xa0, 0x3a, 0x04, 0xdd, 0x81, 0xdc, 0x2b, 0xa5, 0x49, 0x47, 0x57, 0x7e, 0x31,
+	0x5a, 0xf5, 0xab, 0x51, 0xca, 0x38, 0xfa, 0x10, 0xf2, 0x21, 0xde, 0x77, 0x1a, 0xc2, 0x30, 0x8d,
+	0x7f, 0x04, 0x3d, 0x1a, 0xe2, 0x7d, 0x05, 0x76, 0x0f, 0x0a, 0x5b, 0x69, 0x10, 0x28, 0x34, 0x87,
+	0x71, 0xbc, 0xa3, 0x77, 0x75, 0xed, 0x86, 0xc6, 0xbc, 0xa4, 0x10, 0x98, 0xb7, 0x63, 0xf9, 0xb4,
+	0x1a, 0x62, 0xbe, 0x6d, 0xad, 0x44, 0xfc, 0xd7, 0x9f, 0x6f, 0x82, 0x72, 0x08, 0xcb, 0x9e, 0x10,
+	0x20, 0x12, 0xb1, 0x2e, 0x20, 0x90, 0x09, 0xe7, 0x18, 0x4d, 0x13, 0x97, 0xa8, 0xca, 0xe6, 0xed,
+	0x96, 0x59, 0xf9, 0xda, 0x00, 0x90, 0x67, 0x4e, 0x1c, 0xf8, 0x24, 0x41, 0x08, 0x86, 0x23, 0x1c,
+	0xaa, 0x66, 0xcc, 0xdb, 0xf2, 0x1b, 0x5d, 0x83, 0xf3, 0x21, 0x8d, 0xf8, 0x36, 0x73, 0x02, 0xea,
+	0xee, 0xa4, 0xb1, 0x14, 0x94, 0xb5, 0xc7, 0xd5, 0xe0, 0xaa, 0x1c, 0xeb, 0xc8, 0x6e, 0xf6, 0x95,
+	0xb2, 0xfb, 0xa5, 0x01, 0x93, 0x6d, 0x3d, 0x62, 0x5b, 0x2f, 0x91, 0x88, 0x86, 0x68, 0x0a, 0x46,
+	0x3c, 0xf1, 0xa1, 0x95, 0x29, 0x03, 0x3d, 0x80, 0xb1, 0xb0, 0x3d, 0xd9, 0x1c, 0x3a, 0xbd, 0x6b,
+	0xdb, 0xb8, 0xb5, 0x49, 0xbd, 0xf3, 0xc7, 0x3a, 0xb8, 0xec, 0x4e, 0xac, 0xca, 0xf7, 0x06, 0x5c,
+	0xae, 0xcb, 0x24, 0x1d, 0x7b, 0x24, 0xab, 0xfc, 0x69, 0x35, 0xda, 0x42, 0x01, 0x4c, 0xa8, 0xe3,
+	0x48, 0x1c, 0x7a, 0x3e, 0xf5, 0x5a, 0x8a, 0x5e, 0x3f, 0x55, 0x51, 0x27, 0x74, 0xad, 0xa8, 0x85,
+	0xa1, 0x3e, 0x17, 0xb3, 0xcf, 0x27, 0x9d, 0x66, 0xe5, 0x47, 0x80, 0xdc, 0x86, 0xbc, 0xfe, 0xd1,
+	0xb7, 0x06, 0x5c, 0x49, 0x99, 0xb7, 0xef, 0x84, 0x7e, 0xc4, 0xc5, 0xee, 0xeb, 0x91, 0x61, 0x48,
+	0x19, 0xff, 0x3f, 0x7d, 0x3b, 0x6b, 0x05, 0xf3, 0x42, 0xc1, 0xe1, 0xb3, 0xb2, 0x79, 0xaf, 0xbe,
+	0xf4, 0xf1, 0x9a, 0xc2, 0xeb, 0xd2, 0xf1, 0xf8, 0x79, 0xf9, 0x7c, 0xb7, 0x30, 0x53, 0x70, 0x1f,
+	0x37, 0x15, 0x7d, 0x6e, 0x40, 0x71, 0x5b, 0x28, 0x61, 0x69, 0x1c, 0x07, 0x07, 0xce, 0xbf, 0x99,
+	0x9e, 0xcb, 0x82, 0xa8, 0x2e, 0x79, 0x4e, 0x10, 0xd1, 0xa0, 0x49, 0x42, 0xf7, 0x7a, 0x45, 0x64,
+	0x07, 0x2e, 0xa2, 0x26, 0x79, 0xba, 0x45, 0x7c, 0x06, 0xa6, 0x47, 0x02, 0xd2, 0xc4, 0x9c, 0x26,
+	0xbd, 0x0a, 0x86, 0x07, 0xa9, 0x60, 0xfa, 0x05, 0x4d, 0xb7, 0x80, 0x14, 0x26, 0xd9, 0x1e, 0x8e,
+	0x7b, 0xb9, 0x47, 0x06, 0xc9, 0x7d, 0x51, 0x30, 0x74, 0xd3, 0xee, 0xc2, 0x45, 0x37, 0xc0, 0x7e,
+	0xe8, 0x74, 0x6e, 0x54, 0x75, 0x33, 0xdf, 0xf8, 0xfb, 0x8d, 0xfa, 0xe2, 0x00, 0xa8, 0x5d, 0xd5,
+	0xb4, 0x53, 0xc7, 0x38, 0x99, 0x5d, 0x90, 0x1c, 0x1d, 0x2e, 0xb4, 0x00, 0x79, 0xc5, 0x2b, 0xde,
+	0x2f, 0xe7, 0x5e, 0xe2, 0xfd, 0x32, 0x2a, 0xc3, 0x96, 0x23, 0x0f, 0x7d, 0x0a, 0xd3, 0x0c, 0x8b,
+	0xfb, 0x88, 0xf5, 0x26, 0x6d, 0x74, 0x90, 0x49, 0x9b, 0xd2, 0x24, 0x7d, 0xe5, 0x22, 0x38, 0x89,
+	0x7a, 0x99, 0xf3, 0x03, 0x2d, 0x97, 0x60, 0xe8, 0xa6, 0xfd, 0xc2, 0x80, 0x4b, 0xea, 0x34, 0xeb,
+	0x65, 0x06, 0xc9, 0x5c, 0x3d, 0x89, 0xf9, 0x84, 0xb3, 0xb2, 0x36, 0xab, 0xf9, 0xcd, 0x13, 0x26,
+	0x30, 0x7b, 0x52, 0xf1, 0x75, 0xeb, 0x58, 0x85, 0x71, 0xcd, 0xaf, 0x2e, 0xd6, 0xb1, 0xb3, 0xbc,
+	0xb3, 0xe4, 0x8d, 0xa7, 0x5f, 0x24, 0x63, 0x49, 0x7b, 0xe8, 0x8d, 0x47, 0x06, 0x5c, 0xe8, 0x79,
+	0x40, 0xa1, 0x12, 0x14, 0xed, 0xe5, 0x8f, 0x16, 0xec, 0x25, 0x67, 0xf1, 0x9e, 0x7d, 0x7f, 0xd9,
+	0xd9, 0x7c, 0xb0, 0xb1, 0xec, 0x2c, 0xde, 0x5d, 0xaf, 0x6f, 0x2e, 0xac, 0x6f, 0x16, 0x32, 0xe8,
+	0x2a, 0x98, 0xfd, 0xfe, 0xd5, 0x95, 0xf5, 0xe5, 0x05, 0xbb, 0x60, 0xa0, 0x2b, 0x70, 0xb9, 0xdf,
+	0x5b, 0xdf, 0x5c, 0xde, 0xa8, 0x17, 0x86, 0xd0, 0xff, 0x60, 0xa6, 0xdf, 0xf9, 0xc1, 0xc2, 0xea,
+	0xfd, 0x95, 0xf5, 0xf7, 0x0b, 0xd9, 0xe2, 0xf0, 0x57, 0x3f, 0x94, 0x32, 0xb5, 0xbb, 0x4f, 0xfe,
+	0x2c, 0x65, 0x9e, 0x1c, 0x96, 0x8c, 0xa7, 0x87, 0x25, 0xe3, 0x8f, 0xc3, 0x92, 0xf1, 0xf0, 0xa8,
+	0x94, 0x79, 0x7a, 0x54, 0xca, 0xfc, 0x76, 0x54, 0xca, 0x7c, 0x32, 0xdf, 0x71, 0x6f, 0xfa, 0x91,
+	0x9b, 0x36, 0x52, 0x76, 0x33, 0x22, 0x7c, 0x8f, 0x26, 0x3b, 0x55, 0xf9, 0x57, 0x70, 0xbf, 0xe3,
+	0xcf, 0xa0, 0xbc, 0x46, 0x1b, 0x39, 0xd9, 0xd6, 0x6f, 0xfd, 0x35, 0x00, 0x34, 0x32, 0xda, 0x55,
+	0x2b, 0x0e, 0x00, 0x00,
 }
 
 func (m *RewardPeriod) Marshal() (dAtA []byte, err error) {
@@ -389,6 +635,32 @@ func (m *MultiRewardPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Curve != nil {
+		{
+			size, err := m.Curve.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Budget) > 0 {
+		for iNdEx := len(m.Budget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Budget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.RewardsPerSecond) > 0 {
 		for iNdEx := len(m.RewardsPerSecond) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -403,21 +675,21 @@ func (m *MultiRewardPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x2a
 		}
 	}
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.End, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.End):])
-	if err4 != nil {
-		return 0, err4
-	}
-	i -= n4
-	i = encodeVarintParams(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x22
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Start):])
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.End, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.End):])
 	if err5 != nil {
 		return 0, err5
 	}
 	i -= n5
 	i = encodeVarintParams(dAtA, i, uint64(n5))
 	i--
+	dAtA[i] = 0x22
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Start):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintParams(dAtA, i, uint64(n6))
+	i--
 	dAtA[i] = 0x1a
 	if len(m.CollateralType) > 0 {
 		i -= len(m.CollateralType)
@@ -439,6 +711,159 @@ func (m *MultiRewardPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RewardCurve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardCurve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardCurve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.HalvingInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.HalvingInterval):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintParams(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.EndFactor.Size()
+		i -= size
+		if _, err := m.EndFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Type != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Factor.Size()
+		i -= size
+		if _, err := m.Factor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Offset, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Offset):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintParams(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardBoost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardBoost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardBoost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Sources[iNdEx])
+			copy(dAtA[i:], m.Sources[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Sources[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.FullBoostStake.Size()
+		i -= size
+		if _, err := m.FullBoostStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxBoost.Size()
+		i -= size
+		if _, err := m.MaxBoost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Multiplier) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -528,7 +953,7 @@ func (m *MultipliersPerDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
+func (m *SourceMultiRewardPeriod) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -538,20 +963,20 @@ func (m *Params) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+func (m *SourceMultiRewardPeriod) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SourceMultiRewardPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.EarnRewardPeriods) > 0 {
-		for iNdEx := len(m.EarnRewardPeriods) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.RewardPeriods) > 0 {
+		for iNdEx := len(m.RewardPeriods) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.EarnRewardPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RewardPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -559,29 +984,97 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintParams(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.SavingsRewardPeriods) > 0 {
-		for iNdEx := len(m.SavingsRewardPeriods) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SavingsRewardPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintParams(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RewardBoost.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ClaimEnd, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ClaimEnd):])
-	if err6 != nil {
-		return 0, err6
+	i--
+	dAtA[i] = 0x5a
+	if len(m.SourceRewardPeriods) > 0 {
+		for iNdEx := len(m.SourceRewardPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceRewardPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	i -= n6
-	i = encodeVarintParams(dAtA, i, uint64(n6))
+	if len(m.EarnRewardPeriods) > 0 {
+		for iNdEx := len(m.EarnRewardPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EarnRewardPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.SavingsRewardPeriods) > 0 {
+		for iNdEx := len(m.SavingsRewardPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SavingsRewardPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ClaimEnd, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ClaimEnd):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintParams(dAtA, i, uint64(n10))
 	i--
 	dAtA[i] = 0x3a
 	if len(m.ClaimMultipliers) > 0 {
@@ -727,6 +1220,70 @@ func (m *MultiRewardPeriod) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if len(m.Budget) > 0 {
+		for _, e := range m.Budget {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.Curve != nil {
+		l = m.Curve.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *RewardCurve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovParams(uint64(m.Type))
+	}
+	l = m.EndFactor.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.HalvingInterval)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *RewardStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Offset)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Factor.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *RewardBoost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxBoost.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.FullBoostStake.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.Sources) > 0 {
+		for _, s := range m.Sources {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -767,6 +1324,25 @@ func (m *MultipliersPerDenom) Size() (n int) {
 	return n
 }
 
+func (m *SourceMultiRewardPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.RewardPeriods) > 0 {
+		for _, e := range m.RewardPeriods {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -823,6 +1399,14 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if len(m.SourceRewardPeriods) > 0 {
+		for _, e := range m.SourceRewardPeriods {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.RewardBoost.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -915,9 +1499,715 @@ func (m *RewardPeriod) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.End, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPerSecond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardsPerSecond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiRewardPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiRewardPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiRewardPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.End, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPerSecond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsPerSecond = append(m.RewardsPerSecond, types.Coin{})
+			if err := m.RewardsPerSecond[len(m.RewardsPerSecond)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Budget = append(m.Budget, types.Coin{})
+			if err := m.Budget[len(m.Budget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Curve == nil {
+				m.Curve = &RewardCurve{}
+			}
+			if err := m.Curve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardCurve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardCurve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardCurve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= RewardCurveType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndFactor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Steps = append(m.Steps, RewardStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.HalvingInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Offset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Factor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardBoost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardBoost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardBoost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBoost", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -927,30 +2217,30 @@ func (m *RewardPeriod) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxBoost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FullBoostStake", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -960,30 +2250,31 @@ func (m *RewardPeriod) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.End, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FullBoostStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPerSecond", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -993,24 +2284,23 @@ func (m *RewardPeriod) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardsPerSecond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sources = append(m.Sources, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1033,7 +2323,7 @@ func (m *RewardPeriod) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiRewardPeriod) Unmarshal(dAtA []byte) error {
+func (m *Multiplier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1056,35 +2346,15 @@ func (m *MultiRewardPeriod) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiRewardPeriod: wiretype end group for non-group")
+			return fmt.Errorf("proto: Multiplier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiRewardPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Multiplier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowParams
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Active = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1112,46 +2382,13 @@ func (m *MultiRewardPeriod) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowParams
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthsLockup", wireType)
 			}
-			var msglen int
+			m.MonthsLockup = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1161,30 +2398,16 @@ func (m *MultiRewardPeriod) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MonthsLockup |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.End, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPerSecond", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1194,23 +2417,22 @@ func (m *MultiRewardPeriod) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardsPerSecond = append(m.RewardsPerSecond, types.Coin{})
-			if err := m.RewardsPerSecond[len(m.RewardsPerSecond)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Factor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1235,7 +2457,7 @@ func (m *MultiRewardPeriod) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Multiplier) Unmarshal(dAtA []byte) error {
+func (m *MultipliersPerDenom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1258,15 +2480,15 @@ func (m *Multiplier) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Multiplier: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultipliersPerDenom: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Multiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultipliersPerDenom: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1294,32 +2516,13 @@ func (m *Multiplier) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MonthsLockup", wireType)
-			}
-			m.MonthsLockup = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowParams
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MonthsLockup |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Multipliers", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1329,22 +2532,23 @@ func (m *Multiplier) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Factor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Multipliers = append(m.Multipliers, Multiplier{})
+			if err := m.Multipliers[len(m.Multipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1369,7 +2573,7 @@ func (m *Multiplier) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultipliersPerDenom) Unmarshal(dAtA []byte) error {
+func (m *SourceMultiRewardPeriod) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1392,15 +2596,15 @@ func (m *MultipliersPerDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultipliersPerDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: SourceMultiRewardPeriod: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultipliersPerDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SourceMultiRewardPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1428,11 +2632,11 @@ func (m *MultipliersPerDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Multipliers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPeriods", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1459,8 +2663,8 @@ func (m *MultipliersPerDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Multipliers = append(m.Multipliers, Multiplier{})
-			if err := m.Multipliers[len(m.Multipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardPeriods = append(m.RewardPeriods, MultiRewardPeriod{})
+			if err := m.RewardPeriods[len(m.RewardPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1819,6 +3023,73 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceRewardPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceRewardPeriods = append(m.SourceRewardPeriods, SourceMultiRewardPeriod{})
+			if err := m.SourceRewardPeriods[len(m.SourceRewardPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBoost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardBoost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
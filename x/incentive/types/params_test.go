@@ -272,6 +272,18 @@ func (suite *ParamTestSuite) TestParamValidation() {
 				contains:   "reward amount cannot be zero: 0ufury",
 			},
 		},
+		{
+			"invalid reward boost",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.RewardBoost = types.NewRewardBoost(sdk.MustNewDecFromStr("2"), sdk.ZeroInt())
+				return params
+			}(),
+			errArgs{
+				expectPass: false,
+				contains:   "full boost stake must be positive",
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -387,6 +399,82 @@ func (suite *ParamTestSuite) TestMultiRewardPeriods() {
 					contains: "invalid reward amount",
 				},
 			},
+			{
+				name: "period with budget is valid",
+				periods: types.MultiRewardPeriods{
+					types.NewBudgetedMultiRewardPeriod(
+						true, "bnb", validMultiRewardPeriod.Start, validMultiRewardPeriod.End,
+						validMultiRewardPeriod.RewardsPerSecond, sdk.NewCoins(sdk.NewInt64Coin("swap", 1e15)),
+					),
+				},
+				expect: err{
+					pass: true,
+				},
+			},
+			{
+				name: "budget missing a reward denom is invalid",
+				periods: types.MultiRewardPeriods{
+					types.NewBudgetedMultiRewardPeriod(
+						true, "bnb", validMultiRewardPeriod.Start, validMultiRewardPeriod.End,
+						sdk.NewCoins(sdk.NewInt64Coin("swap", 1e9), sdk.NewInt64Coin("ufury", 1e9)), sdk.NewCoins(sdk.NewInt64Coin("swap", 1e15)),
+					),
+				},
+				expect: err{
+					contains: "must have the same denoms as the rewards",
+				},
+			},
+			{
+				name: "budget with an extra denom is invalid",
+				periods: types.MultiRewardPeriods{
+					types.NewBudgetedMultiRewardPeriod(
+						true, "bnb", validMultiRewardPeriod.Start, validMultiRewardPeriod.End,
+						validMultiRewardPeriod.RewardsPerSecond, sdk.NewCoins(sdk.NewInt64Coin("swap", 1e15), sdk.NewInt64Coin("ufury", 1e15)),
+					),
+				},
+				expect: err{
+					contains: "must have the same denoms as the rewards",
+				},
+			},
+			{
+				name: "invalid budget is invalid",
+				periods: types.MultiRewardPeriods{
+					types.NewBudgetedMultiRewardPeriod(
+						true, "bnb", validMultiRewardPeriod.Start, validMultiRewardPeriod.End,
+						validMultiRewardPeriod.RewardsPerSecond, sdk.Coins{sdk.Coin{Denom: "swap", Amount: sdk.NewInt(-1)}},
+					),
+				},
+				expect: err{
+					contains: "invalid reward budget",
+				},
+			},
+			{
+				name: "period with curve is valid",
+				periods: types.MultiRewardPeriods{
+					func() types.MultiRewardPeriod {
+						period := validMultiRewardPeriod
+						period.Curve = types.NewLinearRewardCurve(sdk.MustNewDecFromStr("0.5"))
+						return period
+					}(),
+				},
+				expect: err{
+					pass: true,
+				},
+			},
+			{
+				name: "curve with a step after the period end is invalid",
+				periods: types.MultiRewardPeriods{
+					func() types.MultiRewardPeriod {
+						period := validMultiRewardPeriod
+						period.Curve = types.NewStepRewardCurve(
+							types.NewRewardStep(period.End.Sub(period.Start)+time.Hour, sdk.MustNewDecFromStr("0.5")),
+						)
+						return period
+					}(),
+				},
+				expect: err{
+					contains: "must be before the period end",
+				},
+			},
 		}
 		for _, tc := range testCases {
 
@@ -402,6 +490,68 @@ func (suite *ParamTestSuite) TestMultiRewardPeriods() {
 	})
 }
 
+func (suite *ParamTestSuite) TestSourceMultiRewardPeriods() {
+	type err struct {
+		pass     bool
+		contains string
+	}
+	testCases := []struct {
+		name    string
+		periods types.SourceMultiRewardPeriods
+		expect  err
+	}{
+		{
+			name: "registered source periods are valid",
+			periods: types.SourceMultiRewardPeriods{
+				types.NewSourceMultiRewardPeriod("lending", types.MultiRewardPeriods{validMultiRewardPeriod}),
+			},
+			expect: err{
+				pass: true,
+			},
+		},
+		{
+			name: "duplicated source is invalid",
+			periods: types.SourceMultiRewardPeriods{
+				types.NewSourceMultiRewardPeriod("lending", types.MultiRewardPeriods{validMultiRewardPeriod}),
+				types.NewSourceMultiRewardPeriod("lending", nil),
+			},
+			expect: err{
+				contains: "duplicated reward periods for source",
+			},
+		},
+		{
+			name: "native source is invalid",
+			periods: types.SourceMultiRewardPeriods{
+				types.NewSourceMultiRewardPeriod(types.SwapClaimType, types.MultiRewardPeriods{validMultiRewardPeriod}),
+			},
+			expect: err{
+				contains: "reserved for a native claim type",
+			},
+		},
+		{
+			name: "invalid reward period is invalid",
+			periods: types.SourceMultiRewardPeriods{
+				types.NewSourceMultiRewardPeriod("lending", types.MultiRewardPeriods{rewardMultiPeriodWithInvalidRewardsPerSecond}),
+			},
+			expect: err{
+				contains: "invalid reward amount",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.periods.Validate()
+
+			if tc.expect.pass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.expect.contains)
+			}
+		})
+	}
+}
+
 func TestParamTestSuite(t *testing.T) {
 	suite.Run(t, new(ParamTestSuite))
 }
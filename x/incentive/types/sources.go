@@ -0,0 +1,55 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NativeRewardSources are the reward sources of the module's dedicated claim types. Their reward periods are set by
+// their own params fields and their state is exported in their own genesis fields.
+// Hard claims are stored as a hard supply and a hard borrow source claim.
+var NativeRewardSources = []string{
+	USDXMintingClaimType, HardSupplyClaimType, HardBorrowClaimType, DelegatorClaimType, SwapClaimType, SavingsClaimType, EarnClaimType,
+}
+
+// RewardSource is implemented by modules that register with the incentive module as a source of rewards.
+// Rewards of a source are accumulated per source ID, such as a pool or vault, and distributed to owners pro rata to
+// the shares they hold in it.
+type RewardSource interface {
+	// TotalShares returns the total shares earning rewards in a source ID.
+	TotalShares(ctx sdk.Context, sourceID string) sdk.Dec
+	// OwnerShares returns the shares an owner holds in a source ID.
+	OwnerShares(ctx sdk.Context, owner sdk.AccAddress, sourceID string) sdk.Dec
+}
+
+// RewardSourceHooks are called by registered reward sources around changes to an owner's shares. A source module
+// declares a matching interface in its own types package, which the incentive module hooks implement.
+type RewardSourceHooks interface {
+	// AfterSourceSharesCreated is called after an owner first receives shares in a source ID.
+	AfterSourceSharesCreated(ctx sdk.Context, source, sourceID string, owner sdk.AccAddress)
+	// BeforeSourceSharesModified is called before an owner's shares in a source ID change.
+	BeforeSourceSharesModified(ctx sdk.Context, source, sourceID string, owner sdk.AccAddress)
+}
+
+// IsNativeRewardSource returns true if the source is one of the module's dedicated claim types.
+func IsNativeRewardSource(source string) bool {
+	for _, s := range NativeRewardSources {
+		if s == source {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateRewardSource checks a reward source name is not empty and not one of the module's own claim types.
+func ValidateRewardSource(source string) error {
+	if source == "" {
+		return errors.New("reward source cannot be blank")
+	}
+	if source == HardLiquidityProviderClaimType || IsNativeRewardSource(source) {
+		return fmt.Errorf("reward source %s is reserved for a native claim type", source)
+	}
+	return nil
+}
@@ -28,18 +28,58 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ClaimDestination is where claimed rewards are sent after they are paid to the claimer.
+type ClaimDestination int32
+
+const (
+	// CLAIM_DESTINATION_ACCOUNT leaves claimed rewards in the claimer's account.
+	CLAIM_DESTINATION_ACCOUNT ClaimDestination = 0
+	// CLAIM_DESTINATION_EARN deposits claimed rewards into the earn vault of their denom, or into the bfury vault
+	// through the router module when a validator is selected.
+	CLAIM_DESTINATION_EARN ClaimDestination = 1
+	// CLAIM_DESTINATION_SAVINGS deposits claimed rewards into the savings module.
+	CLAIM_DESTINATION_SAVINGS ClaimDestination = 2
+	// CLAIM_DESTINATION_DELEGATION delegates claimed rewards to a validator through the router module.
+	CLAIM_DESTINATION_DELEGATION ClaimDestination = 3
+)
+
+var ClaimDestination_name = map[int32]string{
+	0: "CLAIM_DESTINATION_ACCOUNT",
+	1: "CLAIM_DESTINATION_EARN",
+	2: "CLAIM_DESTINATION_SAVINGS",
+	3: "CLAIM_DESTINATION_DELEGATION",
+}
+
+var ClaimDestination_value = map[string]int32{
+	"CLAIM_DESTINATION_ACCOUNT":    0,
+	"CLAIM_DESTINATION_EARN":       1,
+	"CLAIM_DESTINATION_SAVINGS":    2,
+	"CLAIM_DESTINATION_DELEGATION": 3,
+}
+
+func (x ClaimDestination) String() string {
+	return proto.EnumName(ClaimDestination_name, int32(x))
+}
+
+func (ClaimDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{0}
+}
+
 // Selection is a pair of denom and multiplier name. It holds the choice of multiplier a user makes when they claim a
-// denom.
+// denom, and where the claimed rewards are sent.
 type Selection struct {
-	Denom          string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	MultiplierName string `protobuf:"bytes,2,opt,name=multiplier_name,json=multiplierName,proto3" json:"multiplier_name,omitempty"`
+	Denom          string           `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	MultiplierName string           `protobuf:"bytes,2,opt,name=multiplier_name,json=multiplierName,proto3" json:"multiplier_name,omitempty"`
+	Destination    ClaimDestination `protobuf:"varint,3,opt,name=destination,proto3,enum=fury.incentive.v1beta1.ClaimDestination" json:"destination,omitempty"`
+	// validator is the operator address rewards are delegated to, for delegation and bfury earn destinations.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
 }
 
 func (m *Selection) Reset()         { *m = Selection{} }
 func (m *Selection) String() string { return proto.CompactTextString(m) }
 func (*Selection) ProtoMessage()    {}
 func (*Selection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1cec058e3ff75d5, []int{0}
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{0}
 }
 func (m *Selection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -78,7 +118,7 @@ func (m *MsgClaimUSDXMintingReward) Reset()         { *m = MsgClaimUSDXMintingRe
 func (m *MsgClaimUSDXMintingReward) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimUSDXMintingReward) ProtoMessage()    {}
 func (*MsgClaimUSDXMintingReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1cec058e3ff75d5, []int{1}
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{1}
 }
 func (m *MsgClaimUSDXMintingReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -115,7 +155,7 @@ func (m *MsgClaimUSDXMintingRewardResponse) Reset()         { *m = MsgClaimUSDXM
 func (m *MsgClaimUSDXMintingRewardResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimUSDXMintingRewardResponse) ProtoMessage()    {}
 func (*MsgClaimUSDXMintingRewardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1cec058e3ff75d5, []int{2}
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{2}
 }
 func (m *MsgClaimUSDXMintingRewardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -154,7 +194,7 @@ func (m *MsgClaimHardReward) Reset()         { *m = MsgClaimHardReward{} }
 func (m *MsgClaimHardReward) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimHardReward) ProtoMessage()    {}
 func (*MsgClaimHardReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1cec058e3ff75d5, []int{3}
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{3}
 }
 func (m *MsgClaimHardReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -191,7 +231,7 @@ func (m *MsgClaimHardRewardResponse) Reset()         { *m = MsgClaimHardRewardRe
 func (m *MsgClaimHardRewardResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimHardRewardResponse) ProtoMessage()    {}
 func (*MsgClaimHardRewardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1cec058e3ff75d5, []int{4}
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{4}
 }
 func (m *MsgClaimHardRewardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -230,7 +270,7 @@ func (m *MsgClaimDelegatorReward) Reset()         { *m = MsgClaimDelegatorReward
 func (m *MsgClaimDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimDelegatorReward) ProtoMessage()    {}
 func (*MsgClaimDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1cec058e3ff75d5, []int{5}
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{5}
 }
 func (m *MsgClaimDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -267,7 +307,7 @@ func (m *MsgClaimDelegatorRewardResponse) Reset()         { *m = MsgClaimDelegat
 func (m *MsgClaimDelegatorRewardResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimDelegatorRewardResponse) ProtoMessage()    {}
 func (*MsgClaimDelegatorRewardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1cec058e3ff75d5, []int{6}
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{6}
 }
 func (m *MsgClaimDelegatorRewardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -306,7 +346,7 @@ func (m *MsgClaimSwapReward) Reset()         { *m = MsgClaimSwapReward{} }
 func (m *MsgClaimSwapReward) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimSwapReward) ProtoMessage()    {}
 func (*MsgClaimSwapReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1cec058e3ff75d5, []int{7}
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{7}
 }
 func (m *MsgClaimSwapReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -343,7 +383,7 @@ func (m *MsgClaimSwapRewardResponse) Reset()         { *m = MsgClaimSwapRewardRe
 func (m *MsgClaimSwapRewardResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimSwapRewardResponse) ProtoMessage()    {}
 func (*MsgClaimSwapRewardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1cec058e3ff75d5, []int{8}
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{8}
 }
 func (m *MsgClaimSwapRewardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -382,7 +422,7 @@ func (m *MsgClaimSavingsReward) Reset()         { *m = MsgClaimSavingsReward{} }
 func (m *MsgClaimSavingsReward) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimSavingsReward) ProtoMessage()    {}
 func (*MsgClaimSavingsReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1cec058e3ff75d5, []int{9}
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{9}
 }
 func (m *MsgClaimSavingsReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -419,7 +459,7 @@ func (m *MsgClaimSavingsRewardResponse) Reset()         { *m = MsgClaimSavingsRe
 func (m *MsgClaimSavingsRewardResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimSavingsRewardResponse) ProtoMessage()    {}
 func (*MsgClaimSavingsRewardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1cec058e3ff75d5, []int{10}
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{10}
 }
 func (m *MsgClaimSavingsRewardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -458,7 +498,7 @@ func (m *MsgClaimEarnReward) Reset()         { *m = MsgClaimEarnReward{} }
 func (m *MsgClaimEarnReward) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimEarnReward) ProtoMessage()    {}
 func (*MsgClaimEarnReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1cec058e3ff75d5, []int{11}
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{11}
 }
 func (m *MsgClaimEarnReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -495,7 +535,7 @@ func (m *MsgClaimEarnRewardResponse) Reset()         { *m = MsgClaimEarnRewardRe
 func (m *MsgClaimEarnRewardResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgClaimEarnRewardResponse) ProtoMessage()    {}
 func (*MsgClaimEarnRewardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1cec058e3ff75d5, []int{12}
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{12}
 }
 func (m *MsgClaimEarnRewardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -524,7 +564,161 @@ func (m *MsgClaimEarnRewardResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClaimEarnRewardResponse proto.InternalMessageInfo
 
+// MsgClaimAllRewards message type used to claim rewards of every claim type at once
+type MsgClaimAllRewards struct {
+	Sender        string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	DenomsToClaim Selections `protobuf:"bytes,2,rep,name=denoms_to_claim,json=denomsToClaim,proto3,castrepeated=Selections" json:"denoms_to_claim"`
+}
+
+func (m *MsgClaimAllRewards) Reset()         { *m = MsgClaimAllRewards{} }
+func (m *MsgClaimAllRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAllRewards) ProtoMessage()    {}
+func (*MsgClaimAllRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{13}
+}
+func (m *MsgClaimAllRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAllRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAllRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimAllRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAllRewards.Merge(m, src)
+}
+func (m *MsgClaimAllRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAllRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAllRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimAllRewards proto.InternalMessageInfo
+
+// MsgClaimAllRewardsResponse defines the Msg/ClaimAllRewards response type.
+type MsgClaimAllRewardsResponse struct {
+}
+
+func (m *MsgClaimAllRewardsResponse) Reset()         { *m = MsgClaimAllRewardsResponse{} }
+func (m *MsgClaimAllRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAllRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimAllRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{14}
+}
+func (m *MsgClaimAllRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAllRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAllRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimAllRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAllRewardsResponse.Merge(m, src)
+}
+func (m *MsgClaimAllRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAllRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAllRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimAllRewardsResponse proto.InternalMessageInfo
+
+// MsgClaimReward message type used to claim rewards of a reward source
+type MsgClaimReward struct {
+	Sender        string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Source        string     `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	DenomsToClaim Selections `protobuf:"bytes,3,rep,name=denoms_to_claim,json=denomsToClaim,proto3,castrepeated=Selections" json:"denoms_to_claim"`
+}
+
+func (m *MsgClaimReward) Reset()         { *m = MsgClaimReward{} }
+func (m *MsgClaimReward) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimReward) ProtoMessage()    {}
+func (*MsgClaimReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{15}
+}
+func (m *MsgClaimReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimReward.Merge(m, src)
+}
+func (m *MsgClaimReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimReward proto.InternalMessageInfo
+
+// MsgClaimRewardResponse defines the Msg/ClaimReward response type.
+type MsgClaimRewardResponse struct {
+}
+
+func (m *MsgClaimRewardResponse) Reset()         { *m = MsgClaimRewardResponse{} }
+func (m *MsgClaimRewardResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewardResponse) ProtoMessage()    {}
+func (*MsgClaimRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6e1c6edfdd8e91a, []int{16}
+}
+func (m *MsgClaimRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewardResponse.Merge(m, src)
+}
+func (m *MsgClaimRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimRewardResponse proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("fury.incentive.v1beta1.ClaimDestination", ClaimDestination_name, ClaimDestination_value)
 	proto.RegisterType((*Selection)(nil), "fury.incentive.v1beta1.Selection")
 	proto.RegisterType((*MsgClaimUSDXMintingReward)(nil), "fury.incentive.v1beta1.MsgClaimUSDXMintingReward")
 	proto.RegisterType((*MsgClaimUSDXMintingRewardResponse)(nil), "fury.incentive.v1beta1.MsgClaimUSDXMintingRewardResponse")
@@ -538,45 +732,62 @@ func init() {
 	proto.RegisterType((*MsgClaimSavingsRewardResponse)(nil), "fury.incentive.v1beta1.MsgClaimSavingsRewardResponse")
 	proto.RegisterType((*MsgClaimEarnReward)(nil), "fury.incentive.v1beta1.MsgClaimEarnReward")
 	proto.RegisterType((*MsgClaimEarnRewardResponse)(nil), "fury.incentive.v1beta1.MsgClaimEarnRewardResponse")
-}
-
-func init() { proto.RegisterFile("fury/incentive/v1beta1/tx.proto", fileDescriptor_b1cec058e3ff75d5) }
-
-var fileDescriptor_b1cec058e3ff75d5 = []byte{
-	// 525 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x7d, 0xad, 0x5a, 0xd1, 0x87, 0x20, 0xd2, 0x29, 0x84, 0x60, 0x81, 0xdd, 0x84, 0x81,
-	0x0a, 0x54, 0x5b, 0x09, 0x42, 0x08, 0xc6, 0xd2, 0x4a, 0x2c, 0x61, 0x48, 0x8a, 0x84, 0x90, 0x50,
-	0x74, 0x49, 0x0e, 0x73, 0xc2, 0xbe, 0x33, 0xbe, 0x6b, 0x5a, 0x98, 0x98, 0x10, 0x23, 0x0b, 0x12,
-	0x62, 0xea, 0xcc, 0x27, 0xe9, 0xd8, 0x91, 0x09, 0x50, 0x22, 0x21, 0x3e, 0x06, 0x8a, 0x93, 0xd8,
-	0x56, 0x6d, 0x63, 0xd2, 0x29, 0x9b, 0xed, 0xf7, 0x7f, 0xef, 0xfd, 0xfe, 0x4f, 0x7e, 0x77, 0x60,
-	0xbe, 0x26, 0x43, 0x62, 0x33, 0xde, 0xa7, 0x5c, 0xb1, 0x21, 0xb5, 0x87, 0x8d, 0x1e, 0x55, 0xa4,
-	0x61, 0xab, 0x23, 0xcb, 0x0f, 0x84, 0x12, 0xb8, 0x32, 0x11, 0x58, 0x91, 0xc0, 0x9a, 0x09, 0xf4,
-	0xb2, 0x23, 0x1c, 0x11, 0x4a, 0xec, 0xc9, 0xd3, 0x54, 0x5d, 0xdf, 0x87, 0x8d, 0x0e, 0x75, 0x69,
-	0x5f, 0x31, 0xc1, 0x71, 0x19, 0xd6, 0x06, 0x94, 0x0b, 0xaf, 0x8a, 0x36, 0xd1, 0xd6, 0x46, 0x7b,
-	0xfa, 0x82, 0x6f, 0x41, 0xc9, 0x3b, 0x70, 0x15, 0xf3, 0x5d, 0x46, 0x83, 0x2e, 0x27, 0x1e, 0xad,
-	0xae, 0x84, 0xf1, 0xcb, 0xf1, 0xe7, 0x27, 0xc4, 0xa3, 0x0f, 0x2f, 0x7c, 0x3c, 0x36, 0xb5, 0x3f,
-	0xc7, 0xa6, 0x56, 0x7f, 0x09, 0xd7, 0x5a, 0xd2, 0x79, 0xe4, 0x12, 0xe6, 0x3d, 0xed, 0xec, 0x3e,
-	0x6b, 0x31, 0xae, 0x18, 0x77, 0xda, 0xf4, 0x90, 0x04, 0x03, 0x5c, 0x81, 0x75, 0x49, 0xf9, 0x80,
-	0x06, 0xb3, 0x36, 0xb3, 0xb7, 0xf3, 0xf4, 0xb9, 0x09, 0xb5, 0xdc, 0x3e, 0x6d, 0x2a, 0x7d, 0xc1,
-	0x25, 0xad, 0x7f, 0x46, 0x80, 0xe7, 0xaa, 0xc7, 0x61, 0xe0, 0x9f, 0x18, 0x2f, 0xa0, 0x14, 0xfa,
-	0x96, 0x5d, 0x25, 0xba, 0xfd, 0x49, 0x52, 0x75, 0x65, 0x73, 0x75, 0xeb, 0x62, 0xb3, 0x66, 0x65,
-	0x4f, 0xd6, 0x8a, 0x06, 0xb8, 0x83, 0x4f, 0x7e, 0x98, 0xda, 0xb7, 0x9f, 0x26, 0x44, 0x9f, 0x64,
-	0xfb, 0xd2, 0xb4, 0xda, 0xbe, 0x08, 0x01, 0x12, 0xf0, 0xd7, 0x41, 0x4f, 0x63, 0x45, 0xd4, 0x5f,
-	0x11, 0x5c, 0x9d, 0x87, 0x77, 0xa9, 0x4b, 0x1d, 0xa2, 0x44, 0xb0, 0x2c, 0xe8, 0x35, 0x30, 0x73,
-	0xd8, 0x32, 0xa7, 0xde, 0x39, 0x24, 0xfe, 0x12, 0x4e, 0x3d, 0xc6, 0x8a, 0xa8, 0xbf, 0x20, 0xb8,
-	0x12, 0x85, 0xc9, 0x90, 0x71, 0x47, 0x2e, 0x0b, 0xb8, 0x09, 0x37, 0x32, 0xc9, 0x32, 0x27, 0xbe,
-	0x47, 0x02, 0xbe, 0x84, 0x13, 0x8f, 0xb1, 0xe6, 0xd4, 0xcd, 0xdf, 0x6b, 0xb0, 0xda, 0x92, 0x0e,
-	0xfe, 0x80, 0xa0, 0x92, 0x73, 0x60, 0x34, 0xf2, 0x80, 0x72, 0x77, 0x5f, 0x7f, 0xb0, 0x70, 0xca,
-	0x1c, 0x08, 0xbf, 0x81, 0xd2, 0xd9, 0xa3, 0xe2, 0x76, 0x51, 0xb5, 0x58, 0xab, 0x37, 0xff, 0x5f,
-	0x1b, 0xb5, 0x7c, 0x8f, 0xa0, 0x9c, 0xb9, 0xe8, 0x76, 0x51, 0xb1, 0x33, 0x09, 0xfa, 0xfd, 0x05,
-	0x13, 0x52, 0xae, 0x13, 0xab, 0x5a, 0xe8, 0x3a, 0xd6, 0x16, 0xbb, 0x4e, 0xef, 0x1a, 0x7e, 0x07,
-	0x38, 0x63, 0xcf, 0xb6, 0x0b, 0x2b, 0x25, 0xe5, 0xfa, 0xbd, 0x85, 0xe4, 0x29, 0xbb, 0x89, 0x3d,
-	0x29, 0xb4, 0x1b, 0x6b, 0x8b, 0xed, 0xa6, 0x7f, 0xf4, 0x9d, 0xbd, 0x93, 0x91, 0x81, 0x4e, 0x47,
-	0x06, 0xfa, 0x35, 0x32, 0xd0, 0xa7, 0xb1, 0xa1, 0x9d, 0x8e, 0x0d, 0xed, 0xfb, 0xd8, 0xd0, 0x9e,
-	0xdf, 0x71, 0x98, 0x7a, 0x75, 0xd0, 0xb3, 0xfa, 0xc2, 0xb3, 0x27, 0x75, 0xb7, 0x5d, 0xd2, 0x93,
-	0xe1, 0x93, 0x7d, 0x94, 0xb8, 0xe9, 0xd5, 0x5b, 0x9f, 0xca, 0xde, 0x7a, 0x78, 0x6f, 0xdf, 0xfd,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0x28, 0x8b, 0x70, 0xee, 0x08, 0x08, 0x00, 0x00,
+	proto.RegisterType((*MsgClaimAllRewards)(nil), "fury.incentive.v1beta1.MsgClaimAllRewards")
+	proto.RegisterType((*MsgClaimAllRewardsResponse)(nil), "fury.incentive.v1beta1.MsgClaimAllRewardsResponse")
+	proto.RegisterType((*MsgClaimReward)(nil), "fury.incentive.v1beta1.MsgClaimReward")
+	proto.RegisterType((*MsgClaimRewardResponse)(nil), "fury.incentive.v1beta1.MsgClaimRewardResponse")
+}
+
+func init() { proto.RegisterFile("fury/incentive/v1beta1/tx.proto", fileDescriptor_e6e1c6edfdd8e91a) }
+
+var fileDescriptor_e6e1c6edfdd8e91a = []byte{
+	// 727 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x6b, 0xd3, 0x60,
+	0x18, 0xce, 0xb7, 0xce, 0xe9, 0xde, 0xe1, 0x56, 0x3e, 0x66, 0xed, 0xc2, 0x96, 0x74, 0x13, 0xb4,
+	0x0c, 0x96, 0xd2, 0x88, 0x88, 0xde, 0xba, 0xb6, 0xcc, 0xea, 0xda, 0x41, 0xdb, 0x89, 0x08, 0x52,
+	0xd2, 0xf6, 0x5b, 0x0c, 0xa6, 0x49, 0x4d, 0xbe, 0x76, 0x9b, 0x27, 0x4f, 0xb2, 0xa3, 0x07, 0x05,
+	0xf1, 0x34, 0xf0, 0xa4, 0x7f, 0x84, 0x78, 0xdc, 0x71, 0x47, 0x4f, 0x2a, 0xdb, 0xc5, 0x3f, 0x43,
+	0x92, 0x36, 0x3f, 0x5c, 0x53, 0xd3, 0x1e, 0x84, 0xde, 0xf2, 0x7d, 0xef, 0xf3, 0xbe, 0xef, 0xf3,
+	0x3c, 0x81, 0xf7, 0x7b, 0x81, 0xdf, 0xeb, 0x18, 0x87, 0x29, 0x45, 0x6b, 0x10, 0x8d, 0x2a, 0x5d,
+	0x92, 0xea, 0xa6, 0xeb, 0x84, 0x4a, 0xe9, 0x14, 0x3d, 0x10, 0xda, 0x86, 0x4e, 0x75, 0x1c, 0xb3,
+	0x00, 0x82, 0x0b, 0x10, 0xfa, 0x00, 0x76, 0x51, 0xd6, 0x65, 0xdd, 0x86, 0xa4, 0xac, 0xaf, 0x1e,
+	0x7a, 0xed, 0x2b, 0x82, 0xd9, 0x0a, 0x51, 0x49, 0x83, 0x2a, 0xba, 0x86, 0x17, 0xe1, 0x52, 0x93,
+	0x68, 0x7a, 0x2b, 0x8e, 0x12, 0x28, 0x39, 0x5b, 0xee, 0x1d, 0xf0, 0x2d, 0x58, 0x68, 0x75, 0x54,
+	0xaa, 0xb4, 0x55, 0x85, 0x18, 0x35, 0x4d, 0x6a, 0x91, 0xf8, 0x94, 0x1d, 0x9f, 0xf7, 0xae, 0x4b,
+	0x52, 0x8b, 0xe0, 0x87, 0x30, 0xd7, 0x24, 0x26, 0x55, 0x34, 0xc9, 0xaa, 0x16, 0x8f, 0x24, 0x50,
+	0x72, 0x5e, 0x4c, 0x0a, 0xc1, 0x84, 0x84, 0xac, 0x2a, 0x29, 0xad, 0x9c, 0x87, 0x2f, 0xfb, 0x93,
+	0xf1, 0x32, 0xcc, 0x76, 0x25, 0x55, 0x69, 0x4a, 0x54, 0x37, 0xe2, 0xd3, 0x76, 0x3b, 0xef, 0xe2,
+	0xfe, 0x95, 0xa3, 0x63, 0x9e, 0xf9, 0x7d, 0xcc, 0x33, 0x6b, 0x7b, 0xb0, 0x54, 0x34, 0x65, 0xbb,
+	0xd6, 0x6e, 0x25, 0xf7, 0xa4, 0xa8, 0x68, 0x54, 0xd1, 0xe4, 0x32, 0xd9, 0x97, 0x8c, 0x26, 0x8e,
+	0xc1, 0x8c, 0x49, 0xb4, 0x26, 0x31, 0xfa, 0x82, 0xfa, 0xa7, 0x91, 0x15, 0xf9, 0xfa, 0xdc, 0x80,
+	0xd5, 0xa1, 0x7d, 0xca, 0xc4, 0x6c, 0xeb, 0x9a, 0x49, 0xd6, 0xde, 0x23, 0xc0, 0x0e, 0xea, 0x81,
+	0x1d, 0xf8, 0x27, 0x8d, 0x67, 0xb0, 0x60, 0x3b, 0x6c, 0xd6, 0xa8, 0x5e, 0x6b, 0x58, 0x49, 0xf1,
+	0xa9, 0x44, 0x24, 0x39, 0x27, 0xae, 0x0e, 0xf3, 0xcc, 0xfd, 0x55, 0x9b, 0xf8, 0xe4, 0x07, 0xcf,
+	0x7c, 0xf9, 0xc9, 0x83, 0x7b, 0x65, 0x96, 0xaf, 0xf6, 0xaa, 0x55, 0x75, 0x9b, 0x80, 0x8f, 0xfc,
+	0x32, 0xb0, 0x83, 0xb4, 0x5c, 0xd6, 0x1f, 0x11, 0x5c, 0x77, 0xc2, 0x39, 0xa2, 0x12, 0xd9, 0xb2,
+	0x78, 0x52, 0xa8, 0xaf, 0x02, 0x3f, 0x84, 0x5b, 0xa0, 0xeb, 0x95, 0x7d, 0xa9, 0x3d, 0x81, 0xae,
+	0x7b, 0xb4, 0x5c, 0xd6, 0x1f, 0x10, 0x5c, 0x73, 0xc3, 0x52, 0x57, 0xd1, 0x64, 0x73, 0x52, 0x88,
+	0xf3, 0xb0, 0x12, 0xc8, 0x2c, 0xd0, 0xf1, 0xbc, 0x64, 0x68, 0x13, 0xe8, 0xb8, 0x47, 0x2b, 0x90,
+	0x75, 0x46, 0x55, 0x7b, 0x51, 0x73, 0xa2, 0x58, 0x7b, 0xb4, 0x5c, 0xd6, 0x9f, 0x11, 0xcc, 0x3b,
+	0xe1, 0x10, 0x9f, 0xad, 0x7b, 0xbd, 0x63, 0x34, 0x9c, 0x69, 0xd6, 0x3f, 0x05, 0x29, 0x89, 0xfc,
+	0x17, 0x25, 0x71, 0x88, 0xfd, 0x4d, 0xd5, 0x51, 0xb1, 0xfe, 0x0e, 0x41, 0xf4, 0xe2, 0xc0, 0xc7,
+	0x2b, 0xb0, 0x94, 0xdd, 0xce, 0x14, 0x8a, 0xb5, 0x5c, 0xbe, 0x52, 0x2d, 0x94, 0x32, 0xd5, 0xc2,
+	0x4e, 0xa9, 0x96, 0xc9, 0x66, 0x77, 0x76, 0x4b, 0xd5, 0x28, 0x83, 0x59, 0x88, 0x0d, 0x86, 0xf3,
+	0x99, 0x72, 0x29, 0x8a, 0x82, 0x53, 0x2b, 0x99, 0xc7, 0x85, 0xd2, 0x56, 0x25, 0x3a, 0x85, 0x13,
+	0xb0, 0x3c, 0x18, 0xce, 0xe5, 0xb7, 0xf3, 0x5b, 0xf6, 0x67, 0x34, 0xc2, 0x4e, 0x1f, 0x7d, 0xe2,
+	0x18, 0xf1, 0xdb, 0x65, 0x88, 0x14, 0x4d, 0x19, 0xbf, 0x41, 0x10, 0x1b, 0xf2, 0x86, 0xa4, 0x87,
+	0x79, 0x34, 0xf4, 0x39, 0x60, 0xef, 0x8d, 0x9d, 0xe2, 0xf8, 0x84, 0x5f, 0xc2, 0xc2, 0xc5, 0xd7,
+	0x63, 0x3d, 0xac, 0x9a, 0x87, 0x65, 0xc5, 0xd1, 0xb1, 0x6e, 0xcb, 0xd7, 0x08, 0x16, 0x03, 0x67,
+	0x7f, 0x2a, 0xac, 0xd8, 0x85, 0x04, 0xf6, 0xee, 0x98, 0x09, 0x03, 0xaa, 0x7d, 0xd3, 0x3b, 0x54,
+	0xb5, 0x87, 0x65, 0xc5, 0xd1, 0xb1, 0x6e, 0xcb, 0x57, 0x80, 0x03, 0x46, 0xef, 0x46, 0x68, 0x25,
+	0x3f, 0x9c, 0xbd, 0x33, 0x16, 0x7c, 0x40, 0xae, 0x6f, 0x74, 0x86, 0xca, 0xf5, 0xb0, 0xac, 0x38,
+	0x3a, 0x76, 0xa0, 0xa5, 0x6f, 0xee, 0x85, 0xb6, 0xf4, 0xb0, 0xac, 0x38, 0x3a, 0xd6, 0x6d, 0x49,
+	0x60, 0xce, 0x3f, 0xb4, 0x6e, 0x86, 0x95, 0xe8, 0xab, 0x13, 0x46, 0xc3, 0x39, 0x6d, 0x36, 0x1f,
+	0x9d, 0x9c, 0x71, 0xe8, 0xf4, 0x8c, 0x43, 0xbf, 0xce, 0x38, 0xf4, 0xf6, 0x9c, 0x63, 0x4e, 0xcf,
+	0x39, 0xe6, 0xfb, 0x39, 0xc7, 0x3c, 0x4d, 0xcb, 0x0a, 0x7d, 0xde, 0xa9, 0x0b, 0x0d, 0xbd, 0x65,
+	0x2d, 0xcc, 0x9d, 0x7a, 0xc7, 0xdc, 0xd0, 0x08, 0xdd, 0xd7, 0x8d, 0x17, 0x29, 0x7b, 0x8b, 0x3e,
+	0xf0, 0xed, 0xd1, 0xf4, 0xb0, 0x4d, 0xcc, 0xfa, 0x8c, 0xbd, 0x15, 0xdf, 0xfe, 0x33, 0x00, 0xaa,
+	0xfe, 0xbf, 0x7d, 0x66, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -603,6 +814,10 @@ type MsgClient interface {
 	ClaimSavingsReward(ctx context.Context, in *MsgClaimSavingsReward, opts ...grpc.CallOption) (*MsgClaimSavingsRewardResponse, error)
 	// ClaimEarnReward is a message type used to claim earn rewards
 	ClaimEarnReward(ctx context.Context, in *MsgClaimEarnReward, opts ...grpc.CallOption) (*MsgClaimEarnRewardResponse, error)
+	// ClaimAllRewards is a message type used to claim rewards of every claim type at once
+	ClaimAllRewards(ctx context.Context, in *MsgClaimAllRewards, opts ...grpc.CallOption) (*MsgClaimAllRewardsResponse, error)
+	// ClaimReward is a message type used to claim rewards of a reward source
+	ClaimReward(ctx context.Context, in *MsgClaimReward, opts ...grpc.CallOption) (*MsgClaimRewardResponse, error)
 }
 
 type msgClient struct {
@@ -667,6 +882,24 @@ func (c *msgClient) ClaimEarnReward(ctx context.Context, in *MsgClaimEarnReward,
 	return out, nil
 }
 
+func (c *msgClient) ClaimAllRewards(ctx context.Context, in *MsgClaimAllRewards, opts ...grpc.CallOption) (*MsgClaimAllRewardsResponse, error) {
+	out := new(MsgClaimAllRewardsResponse)
+	err := c.cc.Invoke(ctx, "/fury.incentive.v1beta1.Msg/ClaimAllRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimReward(ctx context.Context, in *MsgClaimReward, opts ...grpc.CallOption) (*MsgClaimRewardResponse, error) {
+	out := new(MsgClaimRewardResponse)
+	err := c.cc.Invoke(ctx, "/fury.incentive.v1beta1.Msg/ClaimReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ClaimUSDXMintingReward is a message type used to claim USDX minting rewards
@@ -681,6 +914,10 @@ type MsgServer interface {
 	ClaimSavingsReward(context.Context, *MsgClaimSavingsReward) (*MsgClaimSavingsRewardResponse, error)
 	// ClaimEarnReward is a message type used to claim earn rewards
 	ClaimEarnReward(context.Context, *MsgClaimEarnReward) (*MsgClaimEarnRewardResponse, error)
+	// ClaimAllRewards is a message type used to claim rewards of every claim type at once
+	ClaimAllRewards(context.Context, *MsgClaimAllRewards) (*MsgClaimAllRewardsResponse, error)
+	// ClaimReward is a message type used to claim rewards of a reward source
+	ClaimReward(context.Context, *MsgClaimReward) (*MsgClaimRewardResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -705,6 +942,12 @@ func (*UnimplementedMsgServer) ClaimSavingsReward(ctx context.Context, req *MsgC
 func (*UnimplementedMsgServer) ClaimEarnReward(ctx context.Context, req *MsgClaimEarnReward) (*MsgClaimEarnRewardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimEarnReward not implemented")
 }
+func (*UnimplementedMsgServer) ClaimAllRewards(ctx context.Context, req *MsgClaimAllRewards) (*MsgClaimAllRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAllRewards not implemented")
+}
+func (*UnimplementedMsgServer) ClaimReward(ctx context.Context, req *MsgClaimReward) (*MsgClaimRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimReward not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -818,6 +1061,42 @@ func _Msg_ClaimEarnReward_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ClaimAllRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimAllRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimAllRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.incentive.v1beta1.Msg/ClaimAllRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimAllRewards(ctx, req.(*MsgClaimAllRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.incentive.v1beta1.Msg/ClaimReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimReward(ctx, req.(*MsgClaimReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "fury.incentive.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -846,6 +1125,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimEarnReward",
 			Handler:    _Msg_ClaimEarnReward_Handler,
 		},
+		{
+			MethodName: "ClaimAllRewards",
+			Handler:    _Msg_ClaimAllRewards_Handler,
+		},
+		{
+			MethodName: "ClaimReward",
+			Handler:    _Msg_ClaimReward_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "fury/incentive/v1beta1/tx.proto",
@@ -871,6 +1158,18 @@ func (m *Selection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Destination != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.MultiplierName) > 0 {
 		i -= len(m.MultiplierName)
 		copy(dAtA[i:], m.MultiplierName)
@@ -1283,91 +1582,239 @@ func (m *MsgClaimEarnRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgClaimAllRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Selection) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.MultiplierName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+
+func (m *MsgClaimAllRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgClaimUSDXMintingReward) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgClaimAllRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.DenomsToClaim) > 0 {
+		for iNdEx := len(m.DenomsToClaim) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomsToClaim[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.MultiplierName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgClaimUSDXMintingRewardResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgClaimAllRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgClaimHardReward) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.DenomsToClaim) > 0 {
-		for _, e := range m.DenomsToClaim {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *MsgClaimAllRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgClaimHardRewardResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgClaimAllRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgClaimDelegatorReward) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgClaimReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomsToClaim) > 0 {
+		for iNdEx := len(m.DenomsToClaim) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomsToClaim[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Selection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MultiplierName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Destination != 0 {
+		n += 1 + sovTx(uint64(m.Destination))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimUSDXMintingReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MultiplierName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimUSDXMintingRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimHardReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DenomsToClaim) > 0 {
+		for _, e := range m.DenomsToClaim {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimHardRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimDelegatorReward) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1477,6 +1924,66 @@ func (m *MsgClaimEarnRewardResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgClaimAllRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DenomsToClaim) > 0 {
+		for _, e := range m.DenomsToClaim {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimAllRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DenomsToClaim) > 0 {
+		for _, e := range m.DenomsToClaim {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1576,6 +2083,57 @@ func (m *Selection) Unmarshal(dAtA []byte) error {
 			}
 			m.MultiplierName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= ClaimDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2591,6 +3149,370 @@ func (m *MsgClaimEarnRewardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgClaimAllRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAllRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAllRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomsToClaim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomsToClaim = append(m.DenomsToClaim, Selection{})
+			if err := m.DenomsToClaim[len(m.DenomsToClaim)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimAllRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAllRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAllRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomsToClaim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomsToClaim = append(m.DenomsToClaim, Selection{})
+			if err := m.DenomsToClaim[len(m.DenomsToClaim)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,14 @@
+package liquid
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fury/x/liquid/keeper"
+)
+
+// BeginBlocker restakes the staking rewards of the module's delegations, and rebalances the basket
+// derivative's delegations when the basket validators change.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.CompoundStakingRewards(ctx)
+	k.RebalanceBasket(ctx)
+}
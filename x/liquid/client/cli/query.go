@@ -1,10 +1,14 @@
 package cli
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/incubus-network/fury/x/liquid/types"
 )
@@ -19,7 +23,12 @@ func GetQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmds := []*cobra.Command{}
+	cmds := []*cobra.Command{
+		GetCmdQueryParams(),
+		GetCmdQueryExchangeRate(),
+		GetCmdQueryBasket(),
+		GetCmdQuerySlashHistory(),
+	}
 
 	for _, cmd := range cmds {
 		flags.AddQueryFlagsToCmd(cmd)
@@ -29,3 +38,119 @@ func GetQueryCmd() *cobra.Command {
 
 	return liquidQueryCmd
 }
+
+// GetCmdQueryParams queries the liquid module parameters
+func GetCmdQueryParams() *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "get the liquid module parameters",
+		Long:  "Get the current global liquid module parameters.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+}
+
+// GetCmdQueryExchangeRate queries the value of a staking derivative in staked tokens
+func GetCmdQueryExchangeRate() *cobra.Command {
+	return &cobra.Command{
+		Use:     "exchange-rate [denom]",
+		Short:   "get the exchange rate of a staking derivative",
+		Long:    "Get the amount of staked tokens one unit of a staking derivative is worth.",
+		Example: fmt.Sprintf("%s q %s exchange-rate bfury-furyvaloper1...", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ExchangeRate(context.Background(), &types.QueryExchangeRateRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// GetCmdQueryBasket queries the basket derivative's supply, value, and delegations
+func GetCmdQueryBasket() *cobra.Command {
+	return &cobra.Command{
+		Use:   "basket",
+		Short: "get the basket derivative's delegations",
+		Long:  "Get the supply and value of the basket derivative, and its delegations to each validator.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Basket(context.Background(), &types.QueryBasketRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+}
+
+// GetCmdQuerySlashHistory queries the slash history of a staking derivative
+func GetCmdQuerySlashHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "slash-history [denom]",
+		Short:   "get the slash history of a staking derivative",
+		Long:    "Get the cumulative value a staking derivative lost to validator slashes, and each recorded slash.",
+		Example: fmt.Sprintf("%s q %s slash-history bfury-furyvaloper1...", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SlashHistory(context.Background(), &types.QuerySlashHistoryRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "slash events")
+
+	return cmd
+}
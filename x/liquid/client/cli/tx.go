@@ -29,6 +29,8 @@ func GetTxCmd() *cobra.Command {
 	cmds := []*cobra.Command{
 		getCmdMintDerivative(),
 		getCmdBurnDerivative(),
+		getCmdMintBasketDerivative(),
+		getCmdBurnBasketDerivative(),
 	}
 
 	for _, cmd := range cmds {
@@ -107,3 +109,61 @@ func getCmdBurnDerivative() *cobra.Command {
 		},
 	}
 }
+
+func getCmdMintBasketDerivative() *cobra.Command {
+	return &cobra.Command{
+		Use:   "mint-basket [amount]",
+		Short: "mints basket staking derivative from staking tokens",
+		Long:  "Mint basket delegates a user's staking tokens across the weighted basket validators and issues them basket staking derivative tokens.",
+		Args:  cobra.ExactArgs(1),
+		Example: fmt.Sprintf(
+			`%s tx %s mint-basket 10000000ufury --from <key>`, version.AppName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMintBasketDerivative(clientCtx.GetFromAddress(), coin)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
+
+func getCmdBurnBasketDerivative() *cobra.Command {
+	return &cobra.Command{
+		Use:   "burn-basket [amount]",
+		Short: "burns basket staking derivative to redeem delegations",
+		Long:  "Burn basket removes some basket staking derivative from a user's account and converts it to delegations to the basket validators.",
+		Example: fmt.Sprintf(
+			`%s tx %s burn-basket 10000000bfury-basket --from <key>`, version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurnBasketDerivative(clientCtx.GetFromAddress(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+}
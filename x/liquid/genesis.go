@@ -0,0 +1,41 @@
+package liquid
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fury/x/liquid/keeper"
+	"github.com/incubus-network/fury/x/liquid/types"
+)
+
+// InitGenesis initializes the store state from a genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", types.ModuleName, err))
+	}
+
+	k.SetParams(ctx, gs.Params)
+	// The basket's delegations are imported with the staking state, so are taken as balanced to the current weights.
+	k.SetRebalancedBasketWeights(ctx, gs.Params.BasketValidators)
+
+	// only set the last compound time if it's different than default
+	if !gs.LastCompoundTime.Equal(types.DefaultLastCompoundTime) {
+		k.SetLastCompoundTime(ctx, gs.LastCompoundTime)
+	}
+
+	// The cumulative slash histories are rebuilt from the slash events.
+	for _, event := range gs.SlashEvents {
+		k.RecordSlashEvent(ctx, event)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	lastCompoundTime, found := k.GetLastCompoundTime(ctx)
+	if !found {
+		lastCompoundTime = types.DefaultLastCompoundTime
+	}
+
+	return types.NewGenesisState(k.GetParams(ctx), lastCompoundTime, k.GetAllSlashEvents(ctx))
+}
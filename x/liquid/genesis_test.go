@@ -0,0 +1,108 @@
+package liquid_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/incubus-network/fury/app"
+	"github.com/incubus-network/fury/x/liquid"
+	"github.com/incubus-network/fury/x/liquid/keeper"
+	"github.com/incubus-network/fury/x/liquid/types"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+
+	app     app.TestApp
+	genTime time.Time
+	ctx     sdk.Context
+	keeper  keeper.Keeper
+}
+
+func (suite *GenesisTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	suite.genTime = tmtime.Canonical(time.Date(2022, 1, 1, 1, 1, 1, 1, time.UTC))
+	suite.ctx = tApp.NewContext(true, tmproto.Header{Height: 1, Time: suite.genTime})
+	suite.keeper = tApp.GetLiquidKeeper()
+	suite.app = tApp
+}
+
+func (suite *GenesisTestSuite) TestInitExportGenesis() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	liquidGenesis := types.NewGenesisState(
+		types.NewParams(24*time.Hour, types.BasketValidators{
+			types.NewBasketValidator(sdk.ValAddress(addrs[0]), sdk.MustNewDecFromStr("0.4")),
+			types.NewBasketValidator(sdk.ValAddress(addrs[1]), sdk.MustNewDecFromStr("0.6")),
+		}),
+		suite.genTime.Add(-time.Hour),
+		types.SlashEvents{
+			// events are exported grouped by denom, shorter denoms first
+			types.NewSlashEvent(
+				"bfury-basket", sdk.ValAddress(addrs[0]), 5, suite.genTime.Add(-2*time.Hour),
+				sdk.MustNewDecFromStr("0.02"), sdk.NewInt64Coin("ufury", 20e6), sdk.MustNewDecFromStr("0.98"),
+			),
+			types.NewSlashEvent(
+				types.GetLiquidStakingTokenDenom("bfury", sdk.ValAddress(addrs[0])), sdk.ValAddress(addrs[0]), 5, suite.genTime.Add(-2*time.Hour),
+				sdk.MustNewDecFromStr("0.05"), sdk.NewInt64Coin("ufury", 50e6), sdk.MustNewDecFromStr("0.95"),
+			),
+			types.NewSlashEvent(
+				types.GetLiquidStakingTokenDenom("bfury", sdk.ValAddress(addrs[0])), sdk.ValAddress(addrs[0]), 9, suite.genTime.Add(-time.Hour),
+				sdk.MustNewDecFromStr("0.1"), sdk.NewInt64Coin("ufury", 95e6), sdk.MustNewDecFromStr("0.855"),
+			),
+		},
+	)
+
+	cdc := suite.app.AppCodec()
+	suite.NotPanics(
+		func() {
+			suite.app.InitializeFromGenesisStatesWithTime(
+				suite.genTime,
+				app.GenesisState{types.ModuleName: cdc.MustMarshalJSON(&liquidGenesis)},
+			)
+		},
+	)
+
+	exportedGenesis := liquid.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Equal(liquidGenesis, exportedGenesis)
+
+	// The imported basket is not rebalanced
+	suite.Len(suite.keeper.GetRebalancedBasketWeights(suite.ctx), 2)
+
+	// The slash histories are rebuilt from the slash events
+	history, found := suite.keeper.GetSlashHistory(suite.ctx, types.GetLiquidStakingTokenDenom("bfury", sdk.ValAddress(addrs[0])))
+	suite.True(found)
+	suite.Equal(uint64(2), history.SlashCount)
+	suite.Equal(sdk.MustNewDecFromStr("0.145"), history.CumulativeFraction)
+	suite.Equal(sdk.NewInt64Coin("ufury", 145e6), history.TotalTokensSlashed)
+}
+
+func (suite *GenesisTestSuite) TestInitExportGenesis_Default() {
+	suite.app.InitializeFromGenesisStatesWithTime(suite.genTime)
+
+	exportedGenesis := liquid.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Equal(types.DefaultGenesisState(), exportedGenesis)
+}
+
+func (suite *GenesisTestSuite) TestValidateGenesis() {
+	gs := types.NewGenesisState(types.NewParams(-time.Hour, types.DefaultBasketValidators), time.Time{}, nil)
+	suite.Error(gs.Validate())
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	gs = types.NewGenesisState(types.DefaultParams(), time.Time{}, types.SlashEvents{
+		types.NewSlashEvent(
+			"bfury-basket", sdk.ValAddress(addrs[0]), 5, suite.genTime,
+			sdk.MustNewDecFromStr("1.5"), sdk.NewInt64Coin("ufury", 20e6), sdk.OneDec(),
+		),
+	})
+	suite.Error(gs.Validate())
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
@@ -0,0 +1,544 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/incubus-network/fury/x/liquid/types"
+)
+
+// basketHolding is the basket's delegation to a validator, and its value in staked tokens.
+type basketHolding struct {
+	validator stakingtypes.Validator
+	shares    sdk.Dec
+	tokens    sdk.Dec
+}
+
+// GetBasketDenom returns the denom of the basket derivative.
+func (k Keeper) GetBasketDenom() string {
+	return types.GetBasketDenom(k.derivativeDenom)
+}
+
+// IsBasketDenom returns true if the denom is the basket derivative denom.
+func (k Keeper) IsBasketDenom(denom string) bool {
+	return denom == k.GetBasketDenom()
+}
+
+// MintBasketDerivative delegates a user's staking tokens across the basket validators and mints them basket derivative coins.
+//
+// The tokens are split between the basket validators by weight and delegated from the basket module account.
+// The basket derivative is minted at the current exchange rate, so the value of existing basket derivatives is unchanged.
+func (k Keeper) MintBasketDerivative(ctx sdk.Context, delegatorAddr sdk.AccAddress, amount sdk.Coin) (sdk.Coin, error) {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	if amount.Denom != bondDenom {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidDenom, "expected %s", bondDenom)
+	}
+
+	basketValidators := k.GetParams(ctx).BasketValidators
+	if len(basketValidators) == 0 {
+		return sdk.Coin{}, types.ErrBasketDisabled
+	}
+
+	var targets []sdk.ValAddress
+	var delegationAmounts []sdkmath.Int
+	remaining := amount.Amount
+	for i, bv := range basketValidators {
+		delegationAmount := bv.Weight.MulInt(amount.Amount).TruncateInt()
+		// The last validator receives any remainder from truncation.
+		if i == len(basketValidators)-1 {
+			delegationAmount = remaining
+		}
+		remaining = remaining.Sub(delegationAmount)
+
+		if !delegationAmount.IsPositive() {
+			continue
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(bv.ValidatorAddress)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		targets = append(targets, valAddr)
+		delegationAmounts = append(delegationAmounts, delegationAmount)
+	}
+
+	// Delegating withdraws the pending rewards of the basket's delegation into the basket balance, so they are settled
+	// first to be priced in for existing holders rather than shared with the minter.
+	for _, valAddr := range targets {
+		if err := k.settleBasketRewards(ctx, valAddr); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	// The derivative amount must be calculated before delegating, as delegating increases the basket value.
+	derivativeAmount, err := k.basketDerivativeFromTokens(ctx, amount.Amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// Fetching the module account will create it if it doesn't exist.
+	// This is necessary as otherwise delegating will create a normal account.
+	basketAcc := k.accountKeeper.GetModuleAccount(ctx, types.BasketAccountName)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delegatorAddr, types.BasketAccountName, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	for i, valAddr := range targets {
+		if _, err := k.delegateFromAccount(ctx, valAddr, basketAcc.GetAddress(), delegationAmounts[i]); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	basketToken := sdk.NewCoin(k.GetBasketDenom(), derivativeAmount)
+	if err := k.mintCoins(ctx, delegatorAddr, sdk.NewCoins(basketToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMintBasket,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegatorAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, basketToken.String()),
+			sdk.NewAttribute(types.AttributeKeyTokensDelegated, amount.String()),
+		),
+	)
+
+	return basketToken, nil
+}
+
+// BurnBasketDerivative burns an user's basket derivative coins and transfers them an equivalent share of the basket's value.
+//
+// Delegations are transferred proportionally from each basket validator. Delegations with active redelegations cannot be
+// transferred, so the value is taken from the remaining delegations instead. If those are not large enough, the rest is
+// undelegated from the delegations with active redelegations to the user, and received after the unbonding period.
+// The user also receives their share of the basket's liquid staking tokens, such as withdrawn staking rewards.
+// It returns the value in staked tokens received by the user.
+func (k Keeper) BurnBasketDerivative(ctx sdk.Context, delegatorAddr sdk.AccAddress, amount sdk.Coin) (sdk.Coin, error) {
+	if !k.IsBasketDenom(amount.Denom) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidDenom, "expected %s", k.GetBasketDenom())
+	}
+	if !amount.Amount.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrUntransferableShares, "amount must be positive")
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	basketAddr := k.accountKeeper.GetModuleAddress(types.BasketAccountName)
+	holdings, delegatedValue := k.getBasketHoldings(ctx)
+
+	supply := k.bankKeeper.GetSupply(ctx, k.GetBasketDenom()).Amount
+	if amount.Amount.GT(supply) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInsufficientBasketValue, "%s exceeds supply %s", amount.Amount, supply)
+	}
+	owedValue := delegatedValue.MulInt(amount.Amount).QuoInt(supply)
+	owedBalance := k.getBasketBalance(ctx).Mul(amount.Amount).Quo(supply)
+
+	var transferable, locked []basketHolding
+	transferableValue := sdk.ZeroDec()
+	lockedValue := sdk.ZeroDec()
+	for _, holding := range holdings {
+		if k.stakingKeeper.HasReceivingRedelegation(ctx, basketAddr, holding.validator.GetOperator()) {
+			locked = append(locked, holding)
+			lockedValue = lockedValue.Add(holding.tokens)
+			continue
+		}
+		transferable = append(transferable, holding)
+		transferableValue = transferableValue.Add(holding.tokens)
+	}
+
+	transferFraction := sdk.ZeroDec()
+	if transferableValue.IsPositive() {
+		transferFraction = sdk.MinDec(owedValue.Quo(transferableValue), sdk.OneDec())
+	}
+	unbondFraction := sdk.ZeroDec()
+	if remaining := owedValue.Sub(transferableValue.Mul(transferFraction)); remaining.IsPositive() && lockedValue.IsPositive() {
+		unbondFraction = sdk.MinDec(remaining.Quo(lockedValue), sdk.OneDec())
+	}
+
+	if err := k.burnCoins(ctx, delegatorAddr, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	transferred := sdk.ZeroInt()
+	for _, holding := range transferable {
+		shares := holding.shares.Mul(transferFraction)
+		tokens := holding.validator.TokensFromShares(shares).TruncateInt()
+		// Skip transfers too small to be worth any tokens.
+		if !tokens.IsPositive() {
+			continue
+		}
+
+		if _, err := k.TransferDelegation(ctx, holding.validator.GetOperator(), basketAddr, delegatorAddr, shares); err != nil {
+			return sdk.Coin{}, err
+		}
+		transferred = transferred.Add(tokens)
+	}
+
+	unbonding := sdk.ZeroInt()
+	for _, holding := range locked {
+		shares := holding.shares.Mul(unbondFraction)
+		if !holding.validator.TokensFromShares(shares).TruncateInt().IsPositive() {
+			continue
+		}
+
+		tokens, err := k.undelegateToAccount(ctx, holding.validator.GetOperator(), basketAddr, delegatorAddr, shares)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		unbonding = unbonding.Add(tokens)
+	}
+
+	if owedBalance.IsPositive() {
+		balance := sdk.NewCoins(sdk.NewCoin(bondDenom, owedBalance))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.BasketAccountName, delegatorAddr, balance); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	received := transferred.Add(unbonding).Add(owedBalance)
+	if !received.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrUntransferableShares, "amount is too small to transfer any delegations")
+	}
+
+	receivedCoin := sdk.NewCoin(bondDenom, received)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurnBasket,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegatorAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyTokensDelegated, sdk.NewCoin(bondDenom, transferred).String()),
+			sdk.NewAttribute(types.AttributeKeyTokensUnbonding, sdk.NewCoin(bondDenom, unbonding).String()),
+			sdk.NewAttribute(types.AttributeKeyLiquidTokens, sdk.NewCoin(bondDenom, owedBalance).String()),
+		),
+	)
+
+	return receivedCoin, nil
+}
+
+// GetBasketValue returns the value in staked tokens of all the basket's delegations and liquid staking tokens.
+func (k Keeper) GetBasketValue(ctx sdk.Context) sdk.Coin {
+	return sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), k.getBasketTotalValue(ctx).TruncateInt())
+}
+
+// GetBasketExchangeRate returns the amount of staked tokens that one unit of the basket derivative is worth.
+func (k Keeper) GetBasketExchangeRate(ctx sdk.Context) sdk.Dec {
+	supply := k.bankKeeper.GetSupply(ctx, k.GetBasketDenom()).Amount
+	if !supply.IsPositive() {
+		return sdk.OneDec()
+	}
+
+	return k.getBasketTotalValue(ctx).QuoInt(supply)
+}
+
+// GetBasketDelegations returns the basket's delegation to each validator, including validators removed from the basket
+// that have not been rebalanced.
+func (k Keeper) GetBasketDelegations(ctx sdk.Context) []types.BasketDelegation {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	weights := k.GetParams(ctx).BasketValidators.Weights()
+	holdings, _ := k.getBasketHoldings(ctx)
+
+	delegations := make([]types.BasketDelegation, 0, len(holdings))
+	for _, holding := range holdings {
+		valAddr := holding.validator.GetOperator().String()
+		weight, found := weights[valAddr]
+		if !found {
+			weight = sdk.ZeroDec()
+		}
+
+		delegations = append(delegations, types.BasketDelegation{
+			ValidatorAddress: valAddr,
+			Weight:           weight,
+			Shares:           holding.shares,
+			Tokens:           sdk.NewCoin(bondDenom, holding.tokens.TruncateInt()),
+		})
+	}
+	return delegations
+}
+
+// basketTokensFromDerivative returns the value in staked tokens of an amount of the basket derivative.
+func (k Keeper) basketTokensFromDerivative(ctx sdk.Context, amount sdkmath.Int) sdk.Dec {
+	return k.GetBasketExchangeRate(ctx).MulInt(amount)
+}
+
+// BasketSharesFromDerivative returns the basket's delegation shares, by validator operator address, that back an amount
+// of the basket derivative.
+func (k Keeper) BasketSharesFromDerivative(ctx sdk.Context, amount sdkmath.Int) map[string]sdk.Dec {
+	shares := make(map[string]sdk.Dec)
+
+	supply := k.bankKeeper.GetSupply(ctx, k.GetBasketDenom()).Amount
+	if !supply.IsPositive() {
+		return shares
+	}
+
+	holdings, _ := k.getBasketHoldings(ctx)
+	for _, holding := range holdings {
+		shares[holding.validator.GetOperator().String()] = holding.shares.MulInt(amount).QuoInt(supply)
+	}
+	return shares
+}
+
+// basketDerivativeFromTokens returns the amount of basket derivative worth an amount of staked tokens.
+func (k Keeper) basketDerivativeFromTokens(ctx sdk.Context, tokens sdkmath.Int) (sdkmath.Int, error) {
+	if !tokens.IsPositive() {
+		return sdkmath.Int{}, errorsmod.Wrap(types.ErrUntransferableShares, "token amount must be positive")
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, k.GetBasketDenom()).Amount
+	if !supply.IsPositive() {
+		return tokens, nil
+	}
+
+	totalValue := k.getBasketTotalValue(ctx)
+	if !totalValue.IsPositive() {
+		return sdkmath.Int{}, errorsmod.Wrap(types.ErrInsufficientBasketValue, "basket has no value")
+	}
+
+	derivativeAmount := sdk.NewDecFromInt(tokens).MulInt(supply).QuoTruncate(totalValue).TruncateInt()
+	if !derivativeAmount.IsPositive() {
+		return sdkmath.Int{}, errorsmod.Wrap(types.ErrUntransferableShares, "amount is too small to mint any basket derivative")
+	}
+	return derivativeAmount, nil
+}
+
+// settleBasketRewards moves the pending staking rewards of the basket's delegation to a validator into the basket value.
+// They are restaked if compounding is enabled, otherwise they are withdrawn into the basket balance.
+func (k Keeper) settleBasketRewards(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	if k.GetParams(ctx).IsCompoundingEnabled() {
+		return k.compoundBeforeSharesModified(ctx, types.BasketAccountName, valAddr)
+	}
+
+	// Use GetModuleAddress instead of GetModuleAccount to avoid creating a module account if it doesn't exist.
+	basketAddr := k.accountKeeper.GetModuleAddress(types.BasketAccountName)
+	if _, found := k.stakingKeeper.GetDelegation(ctx, basketAddr, valAddr); !found {
+		return nil
+	}
+	_, err := k.withdrawStakingRewards(ctx, types.BasketAccountName, valAddr)
+	return err
+}
+
+// getBasketHoldings returns the basket's delegations and their total value in staked tokens.
+func (k Keeper) getBasketHoldings(ctx sdk.Context) ([]basketHolding, sdk.Dec) {
+	// Use GetModuleAddress instead of GetModuleAccount to avoid creating a module account if it doesn't exist.
+	basketAddr := k.accountKeeper.GetModuleAddress(types.BasketAccountName)
+
+	var holdings []basketHolding
+	totalValue := sdk.ZeroDec()
+	k.stakingKeeper.IterateDelegatorDelegations(ctx, basketAddr, func(delegation stakingtypes.Delegation) bool {
+		validator, found := k.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			panic(fmt.Sprintf("validator %s not found for basket delegation", delegation.ValidatorAddress))
+		}
+
+		tokens := validator.TokensFromShares(delegation.Shares)
+		holdings = append(holdings, basketHolding{
+			validator: validator,
+			shares:    delegation.Shares,
+			tokens:    tokens,
+		})
+		totalValue = totalValue.Add(tokens)
+		return false
+	})
+	return holdings, totalValue
+}
+
+// getBasketBalance returns the basket's liquid staking tokens, such as staking rewards withdrawn when its delegations
+// change.
+func (k Keeper) getBasketBalance(ctx sdk.Context) sdkmath.Int {
+	basketAddr := k.accountKeeper.GetModuleAddress(types.BasketAccountName)
+	return k.bankKeeper.GetBalance(ctx, basketAddr, k.stakingKeeper.BondDenom(ctx)).Amount
+}
+
+// getBasketTotalValue returns the value in staked tokens of the basket's delegations and liquid staking tokens.
+func (k Keeper) getBasketTotalValue(ctx sdk.Context) sdk.Dec {
+	_, delegatedValue := k.getBasketHoldings(ctx)
+	return delegatedValue.Add(sdk.NewDecFromInt(k.getBasketBalance(ctx)))
+}
+
+// RebalanceBasket redelegates the basket's delegations to match the basket validator weights, after the weights or
+// validators are changed by governance.
+//
+// Rebalancing is retried each block until all redelegations succeed. Delegations that received a redelegation cannot
+// be redelegated again until the redelegation completes.
+func (k Keeper) RebalanceBasket(ctx sdk.Context) {
+	basketValidators := k.GetParams(ctx).BasketValidators
+	if k.isBasketRebalanced(ctx, basketValidators) {
+		return
+	}
+
+	// The basket's delegations are left in place when the basket is disabled.
+	if len(basketValidators) == 0 || k.rebalanceBasket(ctx, basketValidators) {
+		k.SetRebalancedBasketWeights(ctx, basketValidators)
+	}
+}
+
+// rebalanceBasket redelegates from validators above their target weight to validators below it, returning true if all
+// redelegations succeeded.
+func (k Keeper) rebalanceBasket(ctx sdk.Context, basketValidators types.BasketValidators) bool {
+	basketAddr := k.accountKeeper.GetModuleAddress(types.BasketAccountName)
+	weights := basketValidators.Weights()
+	holdings, totalValue := k.getBasketHoldings(ctx)
+
+	current := make(map[string]sdk.Dec, len(holdings))
+	type surplus struct {
+		holding basketHolding
+		tokens  sdk.Dec
+		removed bool
+	}
+	var surpluses []surplus
+	for _, holding := range holdings {
+		valAddr := holding.validator.GetOperator().String()
+		current[valAddr] = holding.tokens
+
+		weight, found := weights[valAddr]
+		if !found {
+			weight = sdk.ZeroDec()
+		}
+		if excess := holding.tokens.Sub(totalValue.Mul(weight)); excess.IsPositive() {
+			surpluses = append(surpluses, surplus{holding: holding, tokens: excess, removed: !found})
+		}
+	}
+
+	type deficit struct {
+		valAddr sdk.ValAddress
+		tokens  sdk.Dec
+	}
+	var deficits []deficit
+	for _, bv := range basketValidators {
+		tokens, found := current[bv.ValidatorAddress]
+		if !found {
+			tokens = sdk.ZeroDec()
+		}
+		if shortfall := totalValue.Mul(bv.Weight).Sub(tokens); shortfall.IsPositive() {
+			valAddr, err := sdk.ValAddressFromBech32(bv.ValidatorAddress)
+			if err != nil {
+				panic(err)
+			}
+			deficits = append(deficits, deficit{valAddr: valAddr, tokens: shortfall})
+		}
+	}
+
+	complete := true
+	d := 0
+	for _, s := range surpluses {
+		srcAddr := s.holding.validator.GetOperator()
+		// Redelegations cannot be chained, so wait for any redelegation to the source validator to complete.
+		if k.stakingKeeper.HasReceivingRedelegation(ctx, basketAddr, srcAddr) {
+			complete = false
+			continue
+		}
+
+		remainingShares := s.holding.shares
+		for ; d < len(deficits) && s.tokens.IsPositive(); d++ {
+			moved := sdk.MinDec(s.tokens, deficits[d].tokens)
+			s.tokens = s.tokens.Sub(moved)
+			deficits[d].tokens = deficits[d].tokens.Sub(moved)
+
+			shares, err := s.holding.validator.SharesFromTokens(moved.TruncateInt())
+			// Move the whole delegation of a removed validator once its last surplus is moved.
+			if err != nil || shares.GT(remainingShares) || (s.removed && s.tokens.LT(sdk.OneDec())) {
+				shares = remainingShares
+			}
+			remainingShares = remainingShares.Sub(shares)
+
+			if shares.IsPositive() {
+				if err := k.redelegateBasket(ctx, srcAddr, deficits[d].valAddr, shares, moved.TruncateInt()); err != nil {
+					k.Logger(ctx).Error(fmt.Sprintf("failed to rebalance basket from %s to %s: %s", srcAddr, deficits[d].valAddr, err))
+					complete = false
+				}
+			}
+
+			// Keep filling the same deficit with the next surplus.
+			if deficits[d].tokens.IsPositive() {
+				break
+			}
+		}
+	}
+	return complete
+}
+
+// redelegateBasket redelegates basket shares between validators, leaving the store unchanged on failure.
+func (k Keeper) redelegateBasket(ctx sdk.Context, srcAddr, dstAddr sdk.ValAddress, shares sdk.Dec, tokens sdkmath.Int) error {
+	basketAddr := k.accountKeeper.GetModuleAddress(types.BasketAccountName)
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if _, err := k.stakingKeeper.BeginRedelegation(cacheCtx, basketAddr, srcAddr, dstAddr, shares); err != nil {
+		return err
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRebalanceBasket,
+			sdk.NewAttribute(types.AttributeKeySourceValidator, srcAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyDestValidator, dstAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), tokens).String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+		),
+	)
+	return nil
+}
+
+// isBasketRebalanced returns true if the basket was last rebalanced to the given validators and weights.
+func (k Keeper) isBasketRebalanced(ctx sdk.Context, basketValidators types.BasketValidators) bool {
+	rebalanced := k.GetRebalancedBasketWeights(ctx)
+	if len(rebalanced) != len(basketValidators) {
+		return false
+	}
+
+	for _, bv := range basketValidators {
+		weight, found := rebalanced[bv.ValidatorAddress]
+		if !found || !weight.Equal(bv.Weight) {
+			return false
+		}
+	}
+	return true
+}
+
+// GetRebalancedBasketWeights returns the basket validator weights the basket was last rebalanced to.
+func (k Keeper) GetRebalancedBasketWeights(ctx sdk.Context) map[string]sdk.Dec {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RebalancedBasketWeightPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	weights := make(map[string]sdk.Dec)
+	for ; iterator.Valid(); iterator.Next() {
+		var weight sdk.Dec
+		if err := weight.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		weights[sdk.ValAddress(iterator.Key()).String()] = weight
+	}
+	return weights
+}
+
+// SetRebalancedBasketWeights records the basket validator weights the basket was rebalanced to.
+func (k Keeper) SetRebalancedBasketWeights(ctx sdk.Context, basketValidators types.BasketValidators) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RebalancedBasketWeightPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for _, bv := range basketValidators {
+		valAddr, err := sdk.ValAddressFromBech32(bv.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		bz, err := bv.Weight.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		store.Set(valAddr, bz)
+	}
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/incubus-network/fury/x/liquid/types"
+)
+
+// BasketRewardSource is an x/incentive reward source rewarding holders of the basket derivative, pro rata to their
+// balances. The basket derivative denom is its only source ID.
+type BasketRewardSource struct {
+	keeper *Keeper
+}
+
+// NewBasketRewardSource returns the reward source of the basket derivative.
+func NewBasketRewardSource(k *Keeper) BasketRewardSource {
+	return BasketRewardSource{keeper: k}
+}
+
+// TotalShares returns the supply of the basket derivative.
+func (s BasketRewardSource) TotalShares(ctx sdk.Context, sourceID string) sdk.Dec {
+	if !s.keeper.IsBasketDenom(sourceID) {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecFromInt(s.keeper.bankKeeper.GetSupply(ctx, sourceID).Amount)
+}
+
+// OwnerShares returns an account's balance of the basket derivative.
+func (s BasketRewardSource) OwnerShares(ctx sdk.Context, owner sdk.AccAddress, sourceID string) sdk.Dec {
+	if !s.keeper.IsBasketDenom(sourceID) {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecFromInt(s.keeper.bankKeeper.GetBalance(ctx, owner, sourceID).Amount)
+}
+
+// BeforeBalancesModified calls the reward source hooks of the accounts before their basket derivative balances
+// change, so the rewards they earned on their current balances are synced. It is called by the app bank keeper.
+func (k Keeper) BeforeBalancesModified(ctx sdk.Context, accs []sdk.AccAddress, coins sdk.Coins) {
+	k.iterateBasketHolders(ctx, accs, coins, func(acc sdk.AccAddress) {
+		k.rewardSourceHooks.BeforeSourceSharesModified(ctx, types.BasketRewardSource, k.GetBasketDenom(), acc)
+	})
+}
+
+// AfterBalancesModified calls the reward source hooks of the accounts after their basket derivative balances change,
+// so accounts receiving their first basket derivatives start earning rewards. It is called by the app bank keeper.
+func (k Keeper) AfterBalancesModified(ctx sdk.Context, accs []sdk.AccAddress, coins sdk.Coins) {
+	k.iterateBasketHolders(ctx, accs, coins, func(acc sdk.AccAddress) {
+		k.rewardSourceHooks.AfterSourceSharesCreated(ctx, types.BasketRewardSource, k.GetBasketDenom(), acc)
+	})
+}
+
+// iterateBasketHolders calls cb for each account holding the basket derivative if it is in coins. Module accounts are
+// skipped as they cannot claim rewards.
+func (k Keeper) iterateBasketHolders(ctx sdk.Context, accs []sdk.AccAddress, coins sdk.Coins, cb func(acc sdk.AccAddress)) {
+	basketDenom := k.GetBasketDenom()
+	if k.rewardSourceHooks == nil || coins.AmountOf(basketDenom).IsZero() {
+		return
+	}
+
+	for _, acc := range accs {
+		if _, ok := k.accountKeeper.GetAccount(ctx, acc).(authtypes.ModuleAccountI); ok {
+			continue
+		}
+		if k.bankKeeper.GetBalance(ctx, acc, basketDenom).IsPositive() {
+			cb(acc)
+		}
+	}
+}
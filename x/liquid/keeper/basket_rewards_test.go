@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fury/app"
+	incentivetypes "github.com/incubus-network/fury/x/incentive/types"
+	"github.com/incubus-network/fury/x/liquid/types"
+)
+
+func (suite *KeeperTestSuite) TestBasketRewardSource() {
+	_, delegator := suite.setupBasket(1, "1")
+	basketDenom := suite.Keeper.GetBasketDenom()
+	incentiveKeeper := suite.App.GetIncentiveKeeper()
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(10)
+	holder := addrs[9]
+
+	// Minting the basket derivative creates a claim for the holder
+	_, err := suite.Keeper.MintBasketDerivative(suite.Ctx, delegator, suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+	_, found := incentiveKeeper.GetSourceClaim(suite.Ctx, types.BasketRewardSource, delegator)
+	suite.Require().True(found)
+
+	period := incentivetypes.NewMultiRewardPeriod(
+		true, basketDenom, time.Unix(0, 0), time.Unix(1e10, 0), sdk.NewCoins(sdk.NewInt64Coin("reward", 1000)),
+	)
+	incentiveKeeper.AccumulateSourceRewards(suite.Ctx, types.BasketRewardSource, period)
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(10 * time.Second))
+	incentiveKeeper.AccumulateSourceRewards(suite.Ctx, types.BasketRewardSource, period)
+
+	// Transfers sync the sender's rewards and create a claim for the receiver
+	err = suite.BankKeeper.SendCoins(suite.Ctx, delegator, holder, sdk.NewCoins(sdk.NewCoin(basketDenom, i(25e6))))
+	suite.Require().NoError(err)
+
+	claim, found := incentiveKeeper.GetSourceClaim(suite.Ctx, types.BasketRewardSource, delegator)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("reward", 10000)), claim.Reward)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(10 * time.Second))
+	incentiveKeeper.AccumulateSourceRewards(suite.Ctx, types.BasketRewardSource, period)
+
+	claim, found = incentiveKeeper.GetSynchronizedSourceClaim(suite.Ctx, types.BasketRewardSource, holder)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("reward", 2500)), claim.Reward)
+	claim, found = incentiveKeeper.GetSynchronizedSourceClaim(suite.Ctx, types.BasketRewardSource, delegator)
+	suite.Require().True(found)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("reward", 17500)), claim.Reward)
+
+	// Module accounts receiving the basket derivative do not get claims
+	_, err = suite.Keeper.BurnBasketDerivative(suite.Ctx, holder, sdk.NewCoin(basketDenom, i(10e6)))
+	suite.Require().NoError(err)
+	liquidAddr := suite.App.GetAccountKeeper().GetModuleAddress(types.ModuleAccountName)
+	_, found = incentiveKeeper.GetSourceClaim(suite.Ctx, types.BasketRewardSource, liquidAddr)
+	suite.False(found)
+}
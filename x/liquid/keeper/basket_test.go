@@ -0,0 +1,284 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/incubus-network/fury/app"
+	"github.com/incubus-network/fury/x/liquid"
+	"github.com/incubus-network/fury/x/liquid/types"
+)
+
+// setupBasket creates bonded validators and a funded delegator, and sets the basket validator weights.
+func (suite *KeeperTestSuite) setupBasket(numValidators int, weights ...string) ([]sdk.ValAddress, sdk.AccAddress) {
+	initialBalance := i(1e9)
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(numValidators + 1)
+	valAddrs := make([]sdk.ValAddress, numValidators)
+	for idx := range valAddrs {
+		valAddrs[idx] = sdk.ValAddress(addrs[idx])
+		suite.CreateAccountWithAddress(addrs[idx], suite.NewBondCoins(initialBalance))
+		suite.CreateNewUnbondedValidator(valAddrs[idx], initialBalance)
+	}
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	delegator := addrs[numValidators]
+	suite.CreateAccountWithAddress(delegator, suite.NewBondCoins(initialBalance))
+
+	suite.setBasketWeights(valAddrs, weights...)
+	return valAddrs, delegator
+}
+
+// setBasketWeights sets the basket validators to the validators with a non empty weight.
+func (suite *KeeperTestSuite) setBasketWeights(valAddrs []sdk.ValAddress, weights ...string) {
+	var basketValidators types.BasketValidators
+	for idx, weight := range weights {
+		if weight == "" {
+			continue
+		}
+		basketValidators = append(basketValidators, types.NewBasketValidator(valAddrs[idx], sdk.MustNewDecFromStr(weight)))
+	}
+
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.BasketValidators = basketValidators
+	suite.Keeper.SetParams(suite.Ctx, params)
+}
+
+// basketSharesEqual checks the basket module account's delegation shares to a validator.
+func (suite *KeeperTestSuite) basketSharesEqual(valAddr sdk.ValAddress, shares sdkmath.Int) {
+	suite.DelegationSharesEqual(valAddr, authtypes.NewModuleAddress(types.BasketAccountName), sdk.NewDecFromInt(shares))
+}
+
+func (suite *KeeperTestSuite) TestMintBasketDerivative() {
+	valAddrs, delegator := suite.setupBasket(2, "0.25", "0.75")
+	basketDenom := suite.Keeper.GetBasketDenom()
+
+	_, err := suite.Keeper.MintBasketDerivative(suite.Ctx, delegator, sdk.NewInt64Coin("invalid", 100e6))
+	suite.ErrorIs(err, types.ErrInvalidDenom)
+
+	minted, err := suite.Keeper.MintBasketDerivative(suite.Ctx, delegator, suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewCoin(basketDenom, i(100e6)), minted)
+
+	// The tokens are delegated across the basket validators by weight
+	suite.basketSharesEqual(valAddrs[0], i(25e6))
+	suite.basketSharesEqual(valAddrs[1], i(75e6))
+	suite.AccountBalanceEqual(delegator, sdk.NewCoins(suite.NewBondCoin(i(900e6)), minted))
+	suite.AccountBalanceEqual(authtypes.NewModuleAddress(types.BasketAccountName), sdk.NewCoins())
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeMintBasket,
+		sdk.NewAttribute(types.AttributeKeyDelegator, delegator.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, minted.String()),
+		sdk.NewAttribute(types.AttributeKeyTokensDelegated, suite.NewBondCoin(i(100e6)).String()),
+	))
+
+	// Slashing a basket validator lowers the value of the basket, so more derivative is minted per token
+	suite.SlashValidator(valAddrs[0], sdk.MustNewDecFromStr("0.1"))
+	suite.Equal(sdk.MustNewDecFromStr("0.975"), suite.Keeper.GetBasketExchangeRate(suite.Ctx))
+
+	minted, err = suite.Keeper.MintBasketDerivative(suite.Ctx, delegator, suite.NewBondCoin(i(97_500_000)))
+	suite.Require().NoError(err)
+	suite.Equal(sdk.NewCoin(basketDenom, i(100e6)), minted)
+
+	// Minting is disabled when there are no basket validators
+	suite.setBasketWeights(valAddrs)
+	_, err = suite.Keeper.MintBasketDerivative(suite.Ctx, delegator, suite.NewBondCoin(i(100e6)))
+	suite.ErrorIs(err, types.ErrBasketDisabled)
+}
+
+func (suite *KeeperTestSuite) TestMintBasketDerivative_PendingRewards() {
+	valAddrs, delegator := suite.setupBasket(2, "0.5", "0.5")
+	suite.NoError(suite.App.FundModuleAccount(suite.Ctx, "distribution", suite.NewBondCoins(i(1e9))))
+
+	_, err := suite.Keeper.MintBasketDerivative(suite.Ctx, delegator, suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+	suite.Ctx = suite.Ctx.WithBlockHeight(2)
+
+	distrKeeper := suite.App.GetDistrKeeper()
+	for _, valAddr := range valAddrs {
+		validator, found := suite.StakingKeeper.GetValidator(suite.Ctx, valAddr)
+		suite.Require().True(found)
+		distrKeeper.AllocateTokensToValidator(suite.Ctx, validator, sdk.NewDecCoins(sdk.NewDecCoinFromCoin(suite.NewBondCoin(i(110e6)))))
+	}
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(4)
+	minter := addrs[3]
+	suite.CreateAccountWithAddress(minter, suite.NewBondCoins(i(100e6)))
+
+	minted, err := suite.Keeper.MintBasketDerivative(suite.Ctx, minter, suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+
+	// The rewards earned before the mint are priced in, so the minter receives fewer derivatives than tokens
+	basketBalance := suite.App.GetBankKeeper().GetBalance(suite.Ctx, authtypes.NewModuleAddress(types.BasketAccountName), "ufury")
+	suite.True(basketBalance.Amount.IsPositive())
+	suite.True(minted.Amount.LT(i(100e6)))
+
+	// The minter's derivatives are only worth what they delegated, the rewards stay with the existing holders
+	minterValue, err := suite.Keeper.GetStakedTokensForDerivatives(suite.Ctx, sdk.NewCoins(minted))
+	suite.Require().NoError(err)
+	suite.InDelta(100e6, float64(minterValue.Amount.Int64()), 2)
+
+	delegatorValue, err := suite.Keeper.GetStakedTokensForDerivatives(suite.Ctx, sdk.NewCoins(sdk.NewCoin(suite.Keeper.GetBasketDenom(), i(100e6))))
+	suite.Require().NoError(err)
+	suite.InDelta(float64(i(100e6).Add(basketBalance.Amount).Int64()), float64(delegatorValue.Amount.Int64()), 2)
+}
+
+func (suite *KeeperTestSuite) TestBurnBasketDerivative() {
+	valAddrs, delegator := suite.setupBasket(2, "0.25", "0.75")
+	basketDenom := suite.Keeper.GetBasketDenom()
+
+	_, err := suite.Keeper.MintBasketDerivative(suite.Ctx, delegator, suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+
+	suite.True(suite.Keeper.IsDerivativeDenom(suite.Ctx, basketDenom))
+	value, err := suite.Keeper.GetDerivativeValue(suite.Ctx, basketDenom)
+	suite.Require().NoError(err)
+	suite.Equal(suite.NewBondCoin(i(100e6)), value)
+
+	_, err = suite.Keeper.BurnBasketDerivative(suite.Ctx, delegator, suite.NewBondCoin(i(40e6)))
+	suite.ErrorIs(err, types.ErrInvalidDenom)
+
+	received, err := suite.Keeper.BurnBasketDerivative(suite.Ctx, delegator, sdk.NewCoin(basketDenom, i(40e6)))
+	suite.Require().NoError(err)
+	suite.Equal(suite.NewBondCoin(i(40e6)), received)
+
+	// Delegations are transferred proportionally from each basket validator
+	suite.DelegationSharesEqual(valAddrs[0], delegator, sdk.NewDec(10e6))
+	suite.DelegationSharesEqual(valAddrs[1], delegator, sdk.NewDec(30e6))
+	suite.basketSharesEqual(valAddrs[0], i(15e6))
+	suite.basketSharesEqual(valAddrs[1], i(45e6))
+	suite.Equal(i(60e6), suite.BankKeeper.GetSupply(suite.Ctx, basketDenom).Amount)
+
+	_, err = suite.Keeper.BurnBasketDerivative(suite.Ctx, delegator, sdk.NewCoin(basketDenom, i(61e6)))
+	suite.ErrorIs(err, types.ErrInsufficientBasketValue)
+
+	// Burning the remaining supply transfers all the basket's delegations
+	_, err = suite.Keeper.BurnBasketDerivative(suite.Ctx, delegator, sdk.NewCoin(basketDenom, i(60e6)))
+	suite.Require().NoError(err)
+	suite.basketSharesEqual(valAddrs[0], sdk.ZeroInt())
+	suite.basketSharesEqual(valAddrs[1], sdk.ZeroInt())
+	suite.DelegationSharesEqual(valAddrs[0], delegator, sdk.NewDec(25e6))
+	suite.DelegationSharesEqual(valAddrs[1], delegator, sdk.NewDec(75e6))
+}
+
+func (suite *KeeperTestSuite) TestBurnBasketDerivative_Unbonding() {
+	valAddrs, delegator := suite.setupBasket(3, "0.5", "0.5", "")
+	basketDenom := suite.Keeper.GetBasketDenom()
+
+	_, err := suite.Keeper.MintBasketDerivative(suite.Ctx, delegator, suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+
+	// Move a delegation so it receives a redelegation and cannot be transferred
+	suite.setBasketWeights(valAddrs, "", "0.5", "0.5")
+	liquid.BeginBlocker(suite.Ctx, suite.Keeper)
+
+	// Liquid tokens held by the basket account are part of its value
+	suite.Require().NoError(suite.App.FundModuleAccount(suite.Ctx, types.BasketAccountName, suite.NewBondCoins(i(10e6))))
+	suite.Equal(suite.NewBondCoin(i(110e6)), suite.Keeper.GetBasketValue(suite.Ctx))
+	suite.Equal(sdk.MustNewDecFromStr("1.1"), suite.Keeper.GetBasketExchangeRate(suite.Ctx))
+
+	// The part of the value the transferable delegations can't cover is undelegated to the user
+	received, err := suite.Keeper.BurnBasketDerivative(suite.Ctx, delegator, sdk.NewCoin(basketDenom, i(60e6)))
+	suite.Require().NoError(err)
+	suite.Equal(suite.NewBondCoin(i(66e6)), received)
+
+	suite.basketSharesEqual(valAddrs[1], sdk.ZeroInt())
+	suite.basketSharesEqual(valAddrs[2], i(40e6))
+	suite.DelegationSharesEqual(valAddrs[1], delegator, sdk.NewDec(50e6))
+	ubd, found := suite.StakingKeeper.GetUnbondingDelegation(suite.Ctx, delegator, valAddrs[2])
+	suite.Require().True(found)
+	suite.Require().Len(ubd.Entries, 1)
+	suite.Equal(i(10e6), ubd.Entries[0].Balance)
+
+	// The user receives their share of the liquid tokens
+	basketAddr := authtypes.NewModuleAddress(types.BasketAccountName)
+	suite.Equal(suite.NewBondCoin(i(4e6)), suite.BankKeeper.GetBalance(suite.Ctx, basketAddr, suite.StakingKeeper.BondDenom(suite.Ctx)))
+	suite.Equal(sdk.MustNewDecFromStr("1.1"), suite.Keeper.GetBasketExchangeRate(suite.Ctx))
+}
+
+func (suite *KeeperTestSuite) TestRebalanceBasket() {
+	valAddrs, delegator := suite.setupBasket(3, "0.5", "0.5", "")
+	basketAddr := authtypes.NewModuleAddress(types.BasketAccountName)
+
+	_, err := suite.Keeper.MintBasketDerivative(suite.Ctx, delegator, suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+
+	// Replacing a validator moves its whole delegation to the new validator
+	suite.setBasketWeights(valAddrs, "", "0.5", "0.5")
+	liquid.BeginBlocker(suite.Ctx, suite.Keeper)
+
+	suite.basketSharesEqual(valAddrs[0], sdk.ZeroInt())
+	suite.basketSharesEqual(valAddrs[1], i(50e6))
+	suite.basketSharesEqual(valAddrs[2], i(50e6))
+	suite.True(suite.StakingKeeper.HasReceivingRedelegation(suite.Ctx, basketAddr, valAddrs[2]))
+	suite.Equal(
+		map[string]sdk.Dec{valAddrs[1].String(): sdk.MustNewDecFromStr("0.5"), valAddrs[2].String(): sdk.MustNewDecFromStr("0.5")},
+		suite.Keeper.GetRebalancedBasketWeights(suite.Ctx),
+	)
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeRebalanceBasket,
+		sdk.NewAttribute(types.AttributeKeySourceValidator, valAddrs[0].String()),
+		sdk.NewAttribute(types.AttributeKeyDestValidator, valAddrs[2].String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, suite.NewBondCoin(i(50e6)).String()),
+		sdk.NewAttribute(types.AttributeKeyShares, sdk.NewDec(50e6).String()),
+	))
+
+	// Burning skips delegations that received a redelegation, as they cannot be transferred
+	received, err := suite.Keeper.BurnBasketDerivative(suite.Ctx, delegator, sdk.NewCoin(suite.Keeper.GetBasketDenom(), i(20e6)))
+	suite.Require().NoError(err)
+	suite.Equal(suite.NewBondCoin(i(20e6)), received)
+	suite.basketSharesEqual(valAddrs[1], i(30e6))
+	suite.basketSharesEqual(valAddrs[2], i(50e6))
+
+	// Changing weights moves the surplus of overweight validators
+	suite.setBasketWeights(valAddrs, "", "0.75", "0.25")
+	liquid.BeginBlocker(suite.Ctx, suite.Keeper)
+
+	// The redelegated delegation cannot be redelegated again until the redelegation completes
+	suite.basketSharesEqual(valAddrs[1], i(30e6))
+	suite.basketSharesEqual(valAddrs[2], i(50e6))
+	suite.Len(suite.Keeper.GetRebalancedBasketWeights(suite.Ctx), 2)
+	suite.Equal(sdk.MustNewDecFromStr("0.5"), suite.Keeper.GetRebalancedBasketWeights(suite.Ctx)[valAddrs[1].String()])
+
+	// Validators that can be rebalanced are moved, the rest are retried once the redelegation completes
+	suite.setBasketWeights(valAddrs, "0.25", "0.25", "0.5")
+	liquid.BeginBlocker(suite.Ctx, suite.Keeper)
+
+	suite.basketSharesEqual(valAddrs[0], i(10e6))
+	suite.basketSharesEqual(valAddrs[1], i(20e6))
+	suite.basketSharesEqual(valAddrs[2], i(50e6))
+	suite.Len(suite.Keeper.GetRebalancedBasketWeights(suite.Ctx), 2)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(suite.StakingKeeper.UnbondingTime(suite.Ctx)))
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+	liquid.BeginBlocker(suite.Ctx, suite.Keeper)
+
+	suite.basketSharesEqual(valAddrs[0], i(20e6))
+	suite.basketSharesEqual(valAddrs[1], i(20e6))
+	suite.basketSharesEqual(valAddrs[2], i(40e6))
+	suite.Len(suite.Keeper.GetRebalancedBasketWeights(suite.Ctx), 3)
+}
+
+func (suite *KeeperTestSuite) TestCollectStakingRewardsByDenom_Basket() {
+	valAddrs, delegator := suite.setupBasket(2, "0.5", "0.5")
+	suite.NoError(suite.App.FundModuleAccount(suite.Ctx, "distribution", suite.NewBondCoins(i(1e9))))
+
+	_, err := suite.Keeper.MintBasketDerivative(suite.Ctx, delegator, suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+	suite.Ctx = suite.Ctx.WithBlockHeight(2)
+
+	distrKeeper := suite.App.GetDistrKeeper()
+	for _, valAddr := range valAddrs {
+		validator, found := suite.StakingKeeper.GetValidator(suite.Ctx, valAddr)
+		suite.Require().True(found)
+		distrKeeper.AllocateTokensToValidator(suite.Ctx, validator, sdk.NewDecCoins(sdk.NewDecCoinFromCoin(suite.NewBondCoin(i(110e6)))))
+	}
+
+	rewards, err := suite.Keeper.CollectStakingRewardsByDenom(suite.Ctx, suite.Keeper.GetBasketDenom(), types.ModuleAccountName)
+	suite.Require().NoError(err)
+	suite.True(rewards.AmountOf("ufury").IsPositive())
+	suite.AccountBalanceEqual(authtypes.NewModuleAddress(types.ModuleAccountName), rewards)
+}
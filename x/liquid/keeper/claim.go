@@ -13,18 +13,18 @@ func (k Keeper) CollectStakingRewards(
 	validator sdk.ValAddress,
 	destinationModAccount string,
 ) (sdk.Coins, error) {
-	macc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
-
-	// Ensure withdraw address is as expected
-	withdrawAddr := k.distributionKeeper.GetDelegatorWithdrawAddr(ctx, macc.GetAddress())
-	if !withdrawAddr.Equals(macc.GetAddress()) {
-		panic(fmt.Sprintf(
-			"unexpected withdraw address for liquid staking module account, expected %s, got %s",
-			macc.GetAddress(), withdrawAddr,
-		))
-	}
+	return k.collectStakingRewards(ctx, types.ModuleAccountName, validator, destinationModAccount)
+}
 
-	rewards, err := k.distributionKeeper.WithdrawDelegationRewards(ctx, macc.GetAddress(), validator)
+// collectStakingRewards withdraws the staking rewards of a module account's delegation to a validator and sends them
+// to another module account.
+func (k Keeper) collectStakingRewards(
+	ctx sdk.Context,
+	moduleName string,
+	validator sdk.ValAddress,
+	destinationModAccount string,
+) (sdk.Coins, error) {
+	rewards, err := k.withdrawStakingRewards(ctx, moduleName, validator)
 	if err != nil {
 		return nil, err
 	}
@@ -33,7 +33,7 @@ func (k Keeper) CollectStakingRewards(
 		return rewards, nil
 	}
 
-	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleAccountName, destinationModAccount, rewards)
+	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, moduleName, destinationModAccount, rewards)
 	if err != nil {
 		return nil, err
 	}
@@ -46,6 +46,10 @@ func (k Keeper) CollectStakingRewardsByDenom(
 	derivativeDenom string,
 	destinationModAccount string,
 ) (sdk.Coins, error) {
+	if k.IsBasketDenom(derivativeDenom) {
+		return k.collectBasketStakingRewards(ctx, destinationModAccount)
+	}
+
 	valAddr, err := types.ParseLiquidStakingTokenDenom(derivativeDenom)
 	if err != nil {
 		return nil, err
@@ -53,3 +57,33 @@ func (k Keeper) CollectStakingRewardsByDenom(
 
 	return k.CollectStakingRewards(ctx, valAddr, destinationModAccount)
 }
+
+// collectBasketStakingRewards withdraws the staking rewards of all the basket's delegations and sends them to a module
+// account.
+func (k Keeper) collectBasketStakingRewards(ctx sdk.Context, destinationModAccount string) (sdk.Coins, error) {
+	rewards := sdk.NewCoins()
+	for _, valAddr := range k.getDelegatedValidators(ctx, types.BasketAccountName) {
+		validatorRewards, err := k.collectStakingRewards(ctx, types.BasketAccountName, valAddr, destinationModAccount)
+		if err != nil {
+			return nil, err
+		}
+		rewards = rewards.Add(validatorRewards...)
+	}
+	return rewards, nil
+}
+
+// withdrawStakingRewards withdraws the staking rewards of a module account's delegation to a validator into the module account.
+func (k Keeper) withdrawStakingRewards(ctx sdk.Context, moduleName string, validator sdk.ValAddress) (sdk.Coins, error) {
+	macc := k.accountKeeper.GetModuleAccount(ctx, moduleName)
+
+	// Ensure withdraw address is as expected
+	withdrawAddr := k.distributionKeeper.GetDelegatorWithdrawAddr(ctx, macc.GetAddress())
+	if !withdrawAddr.Equals(macc.GetAddress()) {
+		panic(fmt.Sprintf(
+			"unexpected withdraw address for liquid staking module account %s, expected %s, got %s",
+			moduleName, macc.GetAddress(), withdrawAddr,
+		))
+	}
+
+	return k.distributionKeeper.WithdrawDelegationRewards(ctx, macc.GetAddress(), validator)
+}
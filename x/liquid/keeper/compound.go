@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/incubus-network/fury/x/liquid/types"
+)
+
+// GetLastCompoundTime returns the block time staking rewards were last restaked.
+func (k Keeper) GetLastCompoundTime(ctx sdk.Context) (time.Time, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.LastCompoundTimeKey)
+	if bz == nil {
+		return time.Time{}, false
+	}
+
+	var blockTime time.Time
+	if err := blockTime.UnmarshalBinary(bz); err != nil {
+		panic(err)
+	}
+	return blockTime, true
+}
+
+// SetLastCompoundTime sets the block time staking rewards were last restaked.
+func (k Keeper) SetLastCompoundTime(ctx sdk.Context, blockTime time.Time) {
+	store := ctx.KVStore(k.key)
+	bz, err := blockTime.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.LastCompoundTimeKey, bz)
+}
+
+// CompoundStakingRewards restakes the staking rewards of all the module's delegations once the compound interval has
+// passed. The restaked rewards increase the delegation shares backing each derivative, so the value of the derivatives
+// rises for all holders. The basket derivative's delegations are compounded in the same way.
+func (k Keeper) CompoundStakingRewards(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if !params.IsCompoundingEnabled() {
+		return
+	}
+
+	lastCompoundTime, found := k.GetLastCompoundTime(ctx)
+	if found && ctx.BlockTime().Before(lastCompoundTime.Add(params.CompoundInterval)) {
+		return
+	}
+	k.SetLastCompoundTime(ctx, ctx.BlockTime())
+
+	for _, moduleName := range []string{types.ModuleAccountName, types.BasketAccountName} {
+		for _, valAddr := range k.getDelegatedValidators(ctx, moduleName) {
+			// Rewards are left with the distribution module on failure, and restaked at the next compound.
+			cacheCtx, writeCache := ctx.CacheContext()
+			if err := k.compoundValidatorRewards(cacheCtx, moduleName, valAddr); err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("failed to compound staking rewards of %s for validator %s: %s", moduleName, valAddr, err))
+				continue
+			}
+			writeCache()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		}
+	}
+}
+
+// getDelegatedValidators returns the validators a module account delegates to.
+func (k Keeper) getDelegatedValidators(ctx sdk.Context, moduleName string) []sdk.ValAddress {
+	// Use GetModuleAddress instead of GetModuleAccount to avoid creating a module account if it doesn't exist.
+	modAddress := k.accountKeeper.GetModuleAddress(moduleName)
+
+	var validators []sdk.ValAddress
+	k.stakingKeeper.IterateDelegatorDelegations(ctx, modAddress, func(delegation stakingtypes.Delegation) bool {
+		validators = append(validators, delegation.GetValidatorAddr())
+		return false
+	})
+	return validators
+}
+
+// compoundBeforeSharesModified restakes the pending staking rewards of a module account's delegation to a validator
+// before the delegation's shares change, if compounding is enabled. Changing the shares withdraws the pending rewards
+// into the module account, where they would not be restaked or counted in the exchange rate of the derivatives.
+func (k Keeper) compoundBeforeSharesModified(ctx sdk.Context, moduleName string, valAddr sdk.ValAddress) error {
+	if !k.GetParams(ctx).IsCompoundingEnabled() {
+		return nil
+	}
+
+	// Use GetModuleAddress instead of GetModuleAccount to avoid creating a module account if it doesn't exist.
+	modAddress := k.accountKeeper.GetModuleAddress(moduleName)
+	if _, found := k.stakingKeeper.GetDelegation(ctx, modAddress, valAddr); !found {
+		return nil
+	}
+
+	return k.compoundValidatorRewards(ctx, moduleName, valAddr)
+}
+
+// compoundValidatorRewards withdraws the staking rewards of a module account's delegation to a validator and delegates
+// them back to the validator. Rewards that are not in the bond denom cannot be restaked and are sent to the community pool.
+func (k Keeper) compoundValidatorRewards(ctx sdk.Context, moduleName string, valAddr sdk.ValAddress) error {
+	rewards, err := k.withdrawStakingRewards(ctx, moduleName, valAddr)
+	if err != nil {
+		return err
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	modAddress := k.accountKeeper.GetModuleAddress(moduleName)
+
+	bondRewards := sdk.NewCoin(bondDenom, rewards.AmountOf(bondDenom))
+	otherRewards := rewards.Sub(bondRewards)
+
+	if !otherRewards.IsZero() {
+		if err := k.distributionKeeper.FundCommunityPool(ctx, otherRewards, modAddress); err != nil {
+			return err
+		}
+	}
+
+	if bondRewards.IsZero() {
+		return nil
+	}
+
+	shares, err := k.delegateFromAccount(ctx, valAddr, modAddress, bondRewards.Amount)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCompoundRewards,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, bondRewards.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+		),
+	)
+
+	return nil
+}
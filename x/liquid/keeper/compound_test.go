@@ -0,0 +1,191 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/incubus-network/fury/app"
+	"github.com/incubus-network/fury/x/liquid"
+	"github.com/incubus-network/fury/x/liquid/types"
+)
+
+// setupCompounding creates a bonded validator with a derivative minted from a
+// delegation, and enables auto-compounding.
+func (suite *KeeperTestSuite) setupCompounding(valAddr sdk.ValAddress, delegator sdk.AccAddress, delegateAmount sdkmath.Int) {
+	initialBalance := i(1e9)
+
+	suite.NoError(suite.App.FundModuleAccount(suite.Ctx, distrtypes.ModuleName, suite.NewBondCoins(initialBalance)))
+
+	suite.CreateAccountWithAddress(sdk.AccAddress(valAddr), suite.NewBondCoins(initialBalance))
+	suite.CreateAccountWithAddress(delegator, suite.NewBondCoins(initialBalance))
+
+	suite.CreateNewUnbondedValidator(valAddr, initialBalance)
+	suite.CreateDelegation(valAddr, delegator, delegateAmount)
+	staking.EndBlocker(suite.Ctx, suite.StakingKeeper)
+
+	_, err := suite.Keeper.MintDerivative(suite.Ctx, delegator, valAddr, suite.NewBondCoin(delegateAmount))
+	suite.Require().NoError(err)
+
+	suite.Keeper.SetParams(suite.Ctx, types.NewParams(time.Hour, types.DefaultBasketValidators))
+	suite.Ctx = suite.Ctx.WithBlockHeight(2)
+}
+
+// nextBlock moves the context to the next block, which has a block time
+// increased by the given duration.
+func (suite *KeeperTestSuite) nextBlock(duration time.Duration) {
+	suite.Ctx = suite.Ctx.
+		WithBlockHeight(suite.Ctx.BlockHeight() + 1).
+		WithBlockTime(suite.Ctx.BlockTime().Add(duration))
+}
+
+// allocateRewards adds staking rewards to a validator, returning the rewards of
+// the module's delegation.
+func (suite *KeeperTestSuite) allocateRewards(valAddr sdk.ValAddress, amount sdkmath.Int) sdk.Coins {
+	distrKeeper := suite.App.GetDistrKeeper()
+
+	validator, found := suite.StakingKeeper.GetValidator(suite.Ctx, valAddr)
+	suite.Require().True(found)
+	distrKeeper.AllocateTokensToValidator(suite.Ctx, validator, sdk.NewDecCoins(sdk.NewDecCoinFromCoin(suite.NewBondCoin(amount))))
+
+	delegation, found := suite.StakingKeeper.GetDelegation(suite.Ctx, authtypes.NewModuleAddress(types.ModuleAccountName), valAddr)
+	suite.Require().True(found)
+
+	// Calculating the rewards increments the validator period, so calculate them in a cache context
+	cacheCtx, _ := suite.Ctx.CacheContext()
+	endingPeriod := distrKeeper.IncrementValidatorPeriod(cacheCtx, validator)
+	rewards, _ := distrKeeper.CalculateDelegationRewards(cacheCtx, validator, delegation, endingPeriod).TruncateDecimal()
+	return rewards
+}
+
+func (suite *KeeperTestSuite) TestCompoundStakingRewards() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	valAddr, delegator := sdk.ValAddress(addrs[0]), addrs[1]
+	moduleAccAddress := authtypes.NewModuleAddress(types.ModuleAccountName)
+	derivativeDenom := suite.Keeper.GetLiquidStakingTokenDenom(valAddr)
+
+	delegateAmount := i(100e6)
+	suite.setupCompounding(valAddr, delegator, delegateAmount)
+
+	rewards := suite.allocateRewards(valAddr, i(500e6))
+	suite.True(rewards.AmountOf("ufury").IsPositive())
+
+	liquid.BeginBlocker(suite.Ctx, suite.Keeper)
+
+	// Rewards are restaked to the validator
+	restakedShares := sdk.NewDecFromInt(delegateAmount.Add(rewards.AmountOf("ufury")))
+	suite.DelegationSharesEqual(valAddr, moduleAccAddress, restakedShares)
+	suite.AccountBalanceEqual(moduleAccAddress, sdk.NewCoins())
+
+	lastCompoundTime, found := suite.Keeper.GetLastCompoundTime(suite.Ctx)
+	suite.True(found)
+	suite.Equal(suite.Ctx.BlockTime(), lastCompoundTime)
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeCompoundRewards,
+		sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+		sdk.NewAttribute(types.AttributeKeyShares, sdk.NewDecFromInt(rewards.AmountOf("ufury")).String()),
+	))
+
+	// The derivative supply is unchanged, so each derivative is worth more
+	exchangeRate, err := suite.Keeper.GetExchangeRate(suite.Ctx, derivativeDenom)
+	suite.Require().NoError(err)
+	suite.Equal(restakedShares.QuoInt(delegateAmount), exchangeRate)
+
+	value, err := suite.Keeper.GetDerivativeValue(suite.Ctx, derivativeDenom)
+	suite.Require().NoError(err)
+	suite.Equal(suite.NewBondCoin(restakedShares.TruncateInt()), value)
+
+	// Burning derivatives returns the restaked rewards
+	burnAmount := delegateAmount.QuoRaw(2)
+	receivedShares, err := suite.Keeper.BurnDerivative(suite.Ctx, delegator, valAddr, sdk.NewCoin(derivativeDenom, burnAmount))
+	suite.Require().NoError(err)
+	suite.InDelta(restakedShares.QuoInt64(2).MustFloat64(), receivedShares.MustFloat64(), 2)
+
+	// Minting derivatives after a compound mints fewer derivatives than shares
+	mintAmount := receivedShares.TruncateInt()
+	minted, err := suite.Keeper.MintDerivative(suite.Ctx, delegator, valAddr, suite.NewBondCoin(mintAmount))
+	suite.Require().NoError(err)
+	suite.InDelta(sdk.NewDecFromInt(mintAmount).Quo(exchangeRate).MustFloat64(), float64(minted.Amount.Int64()), 2)
+}
+
+func (suite *KeeperTestSuite) TestCompoundStakingRewards_MintBetweenCompounds() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	valAddr, delegator, minter := sdk.ValAddress(addrs[0]), addrs[1], addrs[2]
+	moduleAccAddress := authtypes.NewModuleAddress(types.ModuleAccountName)
+	derivativeDenom := suite.Keeper.GetLiquidStakingTokenDenom(valAddr)
+
+	delegateAmount := i(100e6)
+	suite.setupCompounding(valAddr, delegator, delegateAmount)
+
+	rewards := suite.allocateRewards(valAddr, i(500e6))
+	liquid.BeginBlocker(suite.Ctx, suite.Keeper)
+	restakedShares := sdk.NewDecFromInt(delegateAmount.Add(rewards.AmountOf("ufury")))
+
+	// Rewards accrue before the next compound
+	suite.nextBlock(time.Hour / 2)
+	pendingRewards := suite.allocateRewards(valAddr, i(500e6))
+	suite.True(pendingRewards.AmountOf("ufury").IsPositive())
+
+	// Minting changes the module's delegation shares, which restakes the pending rewards first
+	suite.CreateAccountWithAddress(minter, suite.NewBondCoins(delegateAmount))
+	mintedShares := suite.CreateDelegation(valAddr, minter, delegateAmount)
+	minted, err := suite.Keeper.MintDerivative(suite.Ctx, minter, valAddr, suite.NewBondCoin(delegateAmount))
+	suite.Require().NoError(err)
+
+	restakedShares = restakedShares.Add(sdk.NewDecFromInt(pendingRewards.AmountOf("ufury")))
+	suite.DelegationSharesEqual(valAddr, moduleAccAddress, restakedShares.Add(mintedShares))
+	suite.AccountBalanceEqual(moduleAccAddress, sdk.NewCoins())
+
+	// The pending rewards belong to the existing holders, the minter's derivatives are only worth what they delegated
+	delegatorValue, err := suite.Keeper.GetStakedTokensForDerivatives(suite.Ctx, sdk.NewCoins(sdk.NewCoin(derivativeDenom, delegateAmount)))
+	suite.Require().NoError(err)
+	suite.InDelta(restakedShares.MustFloat64(), float64(delegatorValue.Amount.Int64()), 2)
+
+	minterValue, err := suite.Keeper.GetStakedTokensForDerivatives(suite.Ctx, sdk.NewCoins(minted))
+	suite.Require().NoError(err)
+	suite.InDelta(float64(delegateAmount.Int64()), float64(minterValue.Amount.Int64()), 2)
+
+	// The next compound has no rewards left behind in the module account
+	suite.nextBlock(time.Hour / 2)
+	liquid.BeginBlocker(suite.Ctx, suite.Keeper)
+	suite.DelegationSharesEqual(valAddr, moduleAccAddress, restakedShares.Add(mintedShares))
+	suite.AccountBalanceEqual(moduleAccAddress, sdk.NewCoins())
+}
+
+func (suite *KeeperTestSuite) TestCompoundStakingRewards_Interval() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	valAddr, delegator := sdk.ValAddress(addrs[0]), addrs[1]
+	moduleAccAddress := authtypes.NewModuleAddress(types.ModuleAccountName)
+
+	delegateAmount := i(100e6)
+	suite.setupCompounding(valAddr, delegator, delegateAmount)
+
+	rewards := suite.allocateRewards(valAddr, i(500e6))
+	liquid.BeginBlocker(suite.Ctx, suite.Keeper)
+	restakedShares := sdk.NewDecFromInt(delegateAmount.Add(rewards.AmountOf("ufury")))
+
+	// Rewards are not restaked before the interval has passed
+	suite.nextBlock(time.Hour - time.Second)
+	suite.allocateRewards(valAddr, i(500e6))
+	liquid.BeginBlocker(suite.Ctx, suite.Keeper)
+	suite.DelegationSharesEqual(valAddr, moduleAccAddress, restakedShares)
+
+	suite.nextBlock(time.Second)
+	liquid.BeginBlocker(suite.Ctx, suite.Keeper)
+	del, found := suite.StakingKeeper.GetDelegation(suite.Ctx, moduleAccAddress, valAddr)
+	suite.Require().True(found)
+	suite.True(del.Shares.GT(restakedShares))
+
+	// Rewards are not restaked when compounding is disabled
+	suite.Keeper.SetParams(suite.Ctx, types.DefaultParams())
+	suite.nextBlock(24 * time.Hour)
+	suite.allocateRewards(valAddr, i(500e6))
+	liquid.BeginBlocker(suite.Ctx, suite.Keeper)
+	suite.DelegationSharesEqual(valAddr, moduleAccAddress, del.Shares)
+}
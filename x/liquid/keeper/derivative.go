@@ -20,6 +20,11 @@ func (k Keeper) MintDerivative(ctx sdk.Context, delegatorAddr sdk.AccAddress, va
 		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidDenom, "expected %s", bondDenom)
 	}
 
+	// Pending rewards are restaked first so they are priced into the derivative for existing holders.
+	if err := k.compoundBeforeSharesModified(ctx, types.ModuleAccountName, valAddr); err != nil {
+		return sdk.Coin{}, err
+	}
+
 	derivativeAmount, shares, err := k.CalculateDerivativeSharesFromTokens(ctx, delegatorAddr, valAddr, amount.Amount)
 	if err != nil {
 		return sdk.Coin{}, err
@@ -61,7 +66,73 @@ func (k Keeper) CalculateDerivativeSharesFromTokens(ctx sdk.Context, delegator s
 	if err != nil {
 		return sdkmath.Int{}, sdk.Dec{}, err
 	}
-	return shares.TruncateInt(), shares, nil
+	return k.derivativeFromShares(ctx, validator, shares), shares, nil
+}
+
+// SharesFromDerivative returns the module's delegation shares that back an amount of a validator's staking derivative.
+//
+// Derivatives are minted 1:1 to delegation shares, restaking the rewards of the module's delegation then increases
+// the shares backing each derivative coin.
+func (k Keeper) SharesFromDerivative(ctx sdk.Context, valAddr sdk.ValAddress, amount sdkmath.Int) sdk.Dec {
+	totalShares, supply, found := k.getRestakedBacking(ctx, valAddr)
+	if !found {
+		return sdk.NewDecFromInt(amount)
+	}
+
+	return totalShares.MulInt(amount).QuoInt(supply)
+}
+
+// derivativeFromShares returns the amount of a validator's staking derivative that is backed by some delegation shares.
+func (k Keeper) derivativeFromShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) sdkmath.Int {
+	totalShares, supply, found := k.getRestakedBacking(ctx, valAddr)
+	if !found {
+		return shares.TruncateInt()
+	}
+
+	return shares.MulInt(supply).QuoTruncate(totalShares).TruncateInt()
+}
+
+// getRestakedBacking returns the module's delegation shares for a validator and the supply of the validator's staking
+// derivative, if restaked rewards increased the shares above the supply. Otherwise derivatives are 1:1 to shares.
+func (k Keeper) getRestakedBacking(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.Dec, sdkmath.Int, bool) {
+	// Use GetModuleAddress instead of GetModuleAccount to avoid creating a module account if it doesn't exist.
+	modAddress := k.accountKeeper.GetModuleAddress(types.ModuleAccountName)
+
+	delegation, found := k.stakingKeeper.GetDelegation(ctx, modAddress, valAddr)
+	if !found {
+		return sdk.Dec{}, sdkmath.Int{}, false
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, k.GetLiquidStakingTokenDenom(valAddr)).Amount
+	if !supply.IsPositive() || delegation.Shares.LTE(sdk.NewDecFromInt(supply)) {
+		return sdk.Dec{}, sdkmath.Int{}, false
+	}
+
+	return delegation.Shares, supply, true
+}
+
+// GetExchangeRate returns the amount of staked tokens that one unit of a staking derivative is worth.
+func (k Keeper) GetExchangeRate(ctx sdk.Context, derivativeDenom string) (sdk.Dec, error) {
+	if k.IsBasketDenom(derivativeDenom) {
+		return k.GetBasketExchangeRate(ctx), nil
+	}
+
+	valAddr, err := types.ParseLiquidStakingTokenDenom(derivativeDenom)
+	if err != nil {
+		return sdk.Dec{}, errorsmod.Wrap(types.ErrInvalidDenom, err.Error())
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Dec{}, types.ErrNoValidatorFound
+	}
+
+	totalShares, supply, found := k.getRestakedBacking(ctx, valAddr)
+	if !found {
+		return validator.TokensFromShares(sdk.OneDec()), nil
+	}
+
+	return validator.TokensFromShares(totalShares).QuoInt(supply), nil
 }
 
 // BurnDerivative burns an user's staking derivative coins and returns them an equivalent staking delegation.
@@ -73,12 +144,19 @@ func (k Keeper) BurnDerivative(ctx sdk.Context, delegatorAddr sdk.AccAddress, va
 		return sdk.Dec{}, errorsmod.Wrap(types.ErrInvalidDenom, "derivative denom does not match validator")
 	}
 
+	// Pending rewards are restaked first so the burned derivatives are redeemed with them.
+	if err := k.compoundBeforeSharesModified(ctx, types.ModuleAccountName, valAddr); err != nil {
+		return sdk.Dec{}, err
+	}
+
+	// The shares must be calculated before burning, as burning reduces the derivative supply.
+	shares := k.SharesFromDerivative(ctx, valAddr, amount.Amount)
+
 	if err := k.burnCoins(ctx, delegatorAddr, sdk.NewCoins(amount)); err != nil {
 		return sdk.Dec{}, err
 	}
 
 	modAcc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
-	shares := sdk.NewDecFromInt(amount.Amount)
 	receivedShares, err := k.TransferDelegation(ctx, valAddr, modAcc.GetAddress(), delegatorAddr, shares)
 	if err != nil {
 		return sdk.Dec{}, err
@@ -101,8 +179,12 @@ func (k Keeper) GetLiquidStakingTokenDenom(valAddr sdk.ValAddress) string {
 }
 
 // IsDerivativeDenom returns true if the denom is a valid derivative denom and
-// corresponds to a valid validator.
+// corresponds to a valid validator, or is the basket derivative denom.
 func (k Keeper) IsDerivativeDenom(ctx sdk.Context, denom string) bool {
+	if k.IsBasketDenom(denom) {
+		return true
+	}
+
 	valAddr, err := types.ParseLiquidStakingTokenDenom(denom)
 	if err != nil {
 		return false
@@ -118,6 +200,11 @@ func (k Keeper) GetStakedTokensForDerivatives(ctx sdk.Context, coins sdk.Coins)
 	total := sdk.ZeroInt()
 
 	for _, coin := range coins {
+		if k.IsBasketDenom(coin.Denom) {
+			total = total.Add(k.basketTokensFromDerivative(ctx, coin.Amount).TruncateInt())
+			continue
+		}
+
 		valAddr, err := types.ParseLiquidStakingTokenDenom(coin.Denom)
 		if err != nil {
 			return sdk.Coin{}, fmt.Errorf("invalid derivative denom: %w", err)
@@ -128,8 +215,8 @@ func (k Keeper) GetStakedTokensForDerivatives(ctx sdk.Context, coins sdk.Coins)
 			return sdk.Coin{}, fmt.Errorf("invalid derivative denom %s: validator not found", coin.Denom)
 		}
 
-		// bfury is 1:1 to delegation shares
-		valTokens := validator.TokensFromSharesTruncated(sdk.NewDecFromInt(coin.Amount))
+		shares := k.SharesFromDerivative(ctx, valAddr, coin.Amount)
+		valTokens := validator.TokensFromSharesTruncated(shares)
 		total = total.Add(valTokens.TruncateInt())
 	}
 
@@ -5,7 +5,9 @@ import (
 	"fmt"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"google.golang.org/grpc/codes"
@@ -25,6 +27,17 @@ func NewQueryServerImpl(k Keeper) types.QueryServer {
 
 var _ types.QueryServer = queryServer{}
 
+func (s queryServer) Params(
+	goCtx context.Context,
+	req *types.QueryParamsRequest,
+) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParamsResponse{
+		Params: s.keeper.GetParams(ctx),
+	}, nil
+}
+
 func (s queryServer) DelegatedBalance(
 	goCtx context.Context,
 	req *types.QueryDelegatedBalanceRequest,
@@ -68,6 +81,87 @@ func (s queryServer) TotalSupply(
 	}, nil
 }
 
+func (s queryServer) ExchangeRate(
+	goCtx context.Context,
+	req *types.QueryExchangeRateRequest,
+) (*types.QueryExchangeRateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !s.keeper.IsDerivativeDenom(ctx, req.Denom) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid derivative denom %s", req.Denom)
+	}
+
+	exchangeRate, err := s.keeper.GetExchangeRate(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	stakedValue, err := s.keeper.GetDerivativeValue(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryExchangeRateResponse{
+		ExchangeRate: exchangeRate,
+		Supply:       s.keeper.bankKeeper.GetSupply(ctx, req.Denom),
+		StakedValue:  stakedValue,
+	}, nil
+}
+
+func (s queryServer) Basket(
+	goCtx context.Context,
+	req *types.QueryBasketRequest,
+) (*types.QueryBasketResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryBasketResponse{
+		Supply:       s.keeper.bankKeeper.GetSupply(ctx, s.keeper.GetBasketDenom()),
+		StakedValue:  s.keeper.GetBasketValue(ctx),
+		ExchangeRate: s.keeper.GetBasketExchangeRate(ctx),
+		Delegations:  s.keeper.GetBasketDelegations(ctx),
+	}, nil
+}
+
+func (s queryServer) SlashHistory(
+	goCtx context.Context,
+	req *types.QuerySlashHistoryRequest,
+) (*types.QuerySlashHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Slash history is kept after a validator is removed, so the denom may no longer be a derivative.
+	history, found := s.keeper.GetSlashHistory(ctx, req.Denom)
+	if !found {
+		if !s.keeper.IsDerivativeDenom(ctx, req.Denom) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid derivative denom %s", req.Denom)
+		}
+		history = types.NewSlashHistory(req.Denom, s.keeper.stakingKeeper.BondDenom(ctx))
+	}
+
+	var events []types.SlashEvent
+	store := prefix.NewStore(
+		prefix.NewStore(ctx.KVStore(s.keeper.key), types.SlashEventPrefix),
+		types.GetSlashEventPrefix(req.Denom),
+	)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var event types.SlashEvent
+		if err := s.keeper.cdc.Unmarshal(value, &event); err != nil {
+			return err
+		}
+
+		events = append(events, event)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySlashHistoryResponse{
+		History:     history,
+		SlashEvents: events,
+		Pagination:  pageRes,
+	}, nil
+}
+
 func (s queryServer) getDelegatedBalance(ctx sdk.Context, delegator sdk.AccAddress) sdkmath.Int {
 	balance := sdk.ZeroDec()
 
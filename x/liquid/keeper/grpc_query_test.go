@@ -3,14 +3,19 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/incubus-network/fury/app"
+	"github.com/incubus-network/fury/x/liquid"
 	"github.com/incubus-network/fury/x/liquid/keeper"
 	"github.com/incubus-network/fury/x/liquid/types"
 )
@@ -290,3 +295,186 @@ func (suite *grpcQueryTestSuite) TestQueryTotalSupply() {
 		})
 	}
 }
+
+func (suite *grpcQueryTestSuite) TestQueryParams() {
+	params := types.NewParams(time.Hour, types.DefaultBasketValidators)
+	suite.Keeper.SetParams(suite.Ctx, params)
+
+	res, err := suite.queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Equal(params, res.Params)
+}
+
+func (suite *grpcQueryTestSuite) TestQueryExchangeRate() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	valAddr, delegator := sdk.ValAddress(addrs[0]), addrs[1]
+	derivativeDenom := suite.Keeper.GetLiquidStakingTokenDenom(valAddr)
+
+	testCases := []struct {
+		name        string
+		setup       func()
+		denom       string
+		expectedRes func() *types.QueryExchangeRateResponse
+		expectedErr error
+	}{
+		{
+			name: "derivatives are 1:1 to staked tokens",
+			setup: func() {
+				suite.setupCompounding(valAddr, delegator, i(100e6))
+			},
+			denom: derivativeDenom,
+			expectedRes: func() *types.QueryExchangeRateResponse {
+				return &types.QueryExchangeRateResponse{
+					ExchangeRate: sdk.OneDec(),
+					Supply:       c(derivativeDenom, 100e6),
+					StakedValue:  suite.NewBondCoin(i(100e6)),
+				}
+			},
+		},
+		{
+			name: "exchange rate includes restaked rewards",
+			setup: func() {
+				suite.setupCompounding(valAddr, delegator, i(100e6))
+				suite.allocateRewards(valAddr, i(500e6))
+				liquid.BeginBlocker(suite.Ctx, suite.Keeper)
+			},
+			denom: derivativeDenom,
+			expectedRes: func() *types.QueryExchangeRateResponse {
+				value, err := suite.Keeper.GetDerivativeValue(suite.Ctx, derivativeDenom)
+				suite.Require().NoError(err)
+				suite.Require().True(value.Amount.GT(i(100e6)))
+
+				return &types.QueryExchangeRateResponse{
+					ExchangeRate: sdk.NewDecFromInt(value.Amount).QuoInt64(100e6),
+					Supply:       c(derivativeDenom, 100e6),
+					StakedValue:  value,
+				}
+			},
+		},
+		{
+			name: "exchange rate includes slashes",
+			setup: func() {
+				suite.setupCompounding(valAddr, delegator, i(100e6))
+				suite.SlashValidator(valAddr, d("0.1"))
+			},
+			denom: derivativeDenom,
+			expectedRes: func() *types.QueryExchangeRateResponse {
+				return &types.QueryExchangeRateResponse{
+					ExchangeRate: d("0.9"),
+					Supply:       c(derivativeDenom, 100e6),
+					StakedValue:  suite.NewBondCoin(i(90e6)),
+				}
+			},
+		},
+		{
+			name:        "error when denom is not a derivative",
+			setup:       func() {},
+			denom:       "ufury",
+			expectedErr: status.Error(codes.InvalidArgument, "invalid derivative denom ufury"),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.setup()
+
+			res, err := suite.queryClient.ExchangeRate(
+				sdk.WrapSDKContext(suite.Ctx),
+				&types.QueryExchangeRateRequest{Denom: tc.denom},
+			)
+
+			if tc.expectedErr != nil {
+				suite.Require().Equal(tc.expectedErr, err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Equal(tc.expectedRes(), res)
+		})
+	}
+}
+
+func (suite *grpcQueryTestSuite) TestQueryBasket() {
+	res, err := suite.queryClient.Basket(sdk.WrapSDKContext(suite.Ctx), &types.QueryBasketRequest{})
+	suite.Require().NoError(err)
+	suite.Equal(&types.QueryBasketResponse{
+		Supply:       c(suite.Keeper.GetBasketDenom(), 0),
+		StakedValue:  suite.NewBondCoin(sdk.ZeroInt()),
+		ExchangeRate: sdk.OneDec(),
+		Delegations:  nil,
+	}, res)
+
+	valAddrs, delegator := suite.setupBasket(2, "0.25", "0.75")
+	_, err = suite.Keeper.MintBasketDerivative(suite.Ctx, delegator, suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+	suite.SlashValidator(valAddrs[1], d("0.2"))
+
+	// A validator removed from the basket has no weight until rebalanced
+	suite.setBasketWeights(valAddrs, "1")
+
+	res, err = suite.queryClient.Basket(sdk.WrapSDKContext(suite.Ctx), &types.QueryBasketRequest{})
+	suite.Require().NoError(err)
+
+	expectedDelegations := map[string]types.BasketDelegation{
+		valAddrs[0].String(): {
+			ValidatorAddress: valAddrs[0].String(),
+			Weight:           sdk.OneDec(),
+			Shares:           sdk.NewDec(25e6),
+			Tokens:           suite.NewBondCoin(i(25e6)),
+		},
+		valAddrs[1].String(): {
+			ValidatorAddress: valAddrs[1].String(),
+			Weight:           sdk.ZeroDec(),
+			Shares:           sdk.NewDec(75e6),
+			Tokens:           suite.NewBondCoin(i(60e6)),
+		},
+	}
+	suite.Equal(c(suite.Keeper.GetBasketDenom(), 100e6), res.Supply)
+	suite.Equal(suite.NewBondCoin(i(85e6)), res.StakedValue)
+	suite.Equal(d("0.85"), res.ExchangeRate)
+	suite.Require().Len(res.Delegations, 2)
+	for _, delegation := range res.Delegations {
+		suite.Equal(expectedDelegations[delegation.ValidatorAddress], delegation)
+	}
+}
+
+func (suite *grpcQueryTestSuite) TestQuerySlashHistory() {
+	valAddrs, delegator := suite.setupBasket(2, "", "")
+	derivativeDenom := suite.Keeper.GetLiquidStakingTokenDenom(valAddrs[0])
+
+	_, err := suite.queryClient.SlashHistory(sdk.WrapSDKContext(suite.Ctx), &types.QuerySlashHistoryRequest{Denom: "invalid"})
+	suite.Error(err)
+
+	// A derivative that was never slashed has an empty history
+	res, err := suite.queryClient.SlashHistory(sdk.WrapSDKContext(suite.Ctx), &types.QuerySlashHistoryRequest{Denom: derivativeDenom})
+	suite.Require().NoError(err)
+	suite.Equal(types.NewSlashHistory(derivativeDenom, suite.StakingKeeper.BondDenom(suite.Ctx)), res.History)
+	suite.Empty(res.SlashEvents)
+
+	suite.CreateDelegation(valAddrs[0], delegator, i(100e6))
+	_, err = suite.Keeper.MintDerivative(suite.Ctx, delegator, valAddrs[0], suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+
+	suite.SlashValidator(valAddrs[0], d("0.1"))
+	suite.SlashValidator(valAddrs[0], d("0.5"))
+	suite.SlashValidator(valAddrs[1], d("0.1"))
+
+	res, err = suite.queryClient.SlashHistory(sdk.WrapSDKContext(suite.Ctx), &types.QuerySlashHistoryRequest{
+		Denom:      derivativeDenom,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Equal(types.SlashHistory{
+		Denom:              derivativeDenom,
+		SlashCount:         2,
+		CumulativeFraction: d("0.55"),
+		TotalTokensSlashed: suite.NewBondCoin(i(55e6)),
+	}, res.History)
+	suite.Equal(uint64(2), res.Pagination.Total)
+	suite.Equal([]types.SlashEvent{
+		types.NewSlashEvent(
+			derivativeDenom, valAddrs[0], suite.Ctx.BlockHeight(), suite.Ctx.BlockTime(),
+			d("0.1"), suite.NewBondCoin(i(10e6)), d("0.9"),
+		),
+	}, res.SlashEvents)
+}
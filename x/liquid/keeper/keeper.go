@@ -4,7 +4,9 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/incubus-network/fury/x/liquid/types"
@@ -12,25 +14,33 @@ import (
 
 // Keeper struct for the liquid module.
 type Keeper struct {
-	cdc codec.Codec
+	key           storetypes.StoreKey
+	cdc           codec.Codec
+	paramSubspace paramtypes.Subspace
 
 	accountKeeper      types.AccountKeeper
 	bankKeeper         types.BankKeeper
 	stakingKeeper      types.StakingKeeper
 	distributionKeeper types.DistributionKeeper
+	rewardSourceHooks  types.RewardSourceHooks
 
 	derivativeDenom string
 }
 
 // NewKeeper returns a new keeper for the liquid module.
 func NewKeeper(
-	cdc codec.Codec,
+	cdc codec.Codec, key storetypes.StoreKey, paramstore paramtypes.Subspace,
 	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, dk types.DistributionKeeper,
 	derivativeDenom string,
 ) Keeper {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
 
 	return Keeper{
+		key:                key,
 		cdc:                cdc,
+		paramSubspace:      paramstore,
 		accountKeeper:      ak,
 		bankKeeper:         bk,
 		stakingKeeper:      sk,
@@ -41,11 +51,20 @@ func NewKeeper(
 
 // NewDefaultKeeper returns a new keeper for the liquid module with default values.
 func NewDefaultKeeper(
-	cdc codec.Codec,
+	cdc codec.Codec, key storetypes.StoreKey, paramstore paramtypes.Subspace,
 	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, dk types.DistributionKeeper,
 ) Keeper {
 
-	return NewKeeper(cdc, ak, bk, sk, dk, types.DefaultDerivativeDenom)
+	return NewKeeper(cdc, key, paramstore, ak, bk, sk, dk, types.DefaultDerivativeDenom)
+}
+
+// SetRewardSourceHooks sets the hooks called when basket derivative balances change.
+func (k *Keeper) SetRewardSourceHooks(hooks types.RewardSourceHooks) *Keeper {
+	if k.rewardSourceHooks != nil {
+		panic("cannot set liquid reward source hooks twice")
+	}
+	k.rewardSourceHooks = hooks
+	return k
 }
 
 // Logger returns a module-specific logger.
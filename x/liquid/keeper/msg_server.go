@@ -82,3 +82,56 @@ func (k msgServer) BurnDerivative(goCtx context.Context, msg *types.MsgBurnDeriv
 		Received: sharesReceived,
 	}, nil
 }
+
+// MintBasketDerivative handles MintBasketDerivative msgs.
+func (k msgServer) MintBasketDerivative(goCtx context.Context, msg *types.MsgMintBasketDerivative) (*types.MsgMintBasketDerivativeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	mintedDerivative, err := k.keeper.MintBasketDerivative(ctx, sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &types.MsgMintBasketDerivativeResponse{
+		Received: mintedDerivative,
+	}, nil
+}
+
+// BurnBasketDerivative handles BurnBasketDerivative msgs.
+func (k msgServer) BurnBasketDerivative(goCtx context.Context, msg *types.MsgBurnBasketDerivative) (*types.MsgBurnBasketDerivativeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	received, err := k.keeper.BurnBasketDerivative(ctx, sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+	return &types.MsgBurnBasketDerivativeResponse{
+		Received: received,
+	}, nil
+}
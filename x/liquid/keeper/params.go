@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fury/x/liquid/types"
+)
+
+// GetParams returns the params from the store
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var p types.Params
+	k.paramSubspace.GetParamSetIfExists(ctx, &p)
+	return p
+}
+
+// SetParams sets params on the store
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSubspace.SetParamSet(ctx, &params)
+}
+
+// IsCompoundingEnabled returns true if the staking rewards of the module's
+// delegations are restaked, increasing the value of the derivatives.
+func (k Keeper) IsCompoundingEnabled(ctx sdk.Context) bool {
+	return k.GetParams(ctx).IsCompoundingEnabled()
+}
@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fury/x/liquid/types"
+)
+
+// RecordValidatorSlash records a slash event for each staking derivative backed by a validator that is about to be
+// slashed by a fraction of its tokens.
+//
+// It must be called before the validator's tokens are reduced, as the value lost is calculated from the derivative
+// values before the slash.
+func (k Keeper) RecordValidatorSlash(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) {
+	if !fraction.IsPositive() {
+		return
+	}
+	fraction = sdk.MinDec(fraction, sdk.OneDec())
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+
+	denom := k.GetLiquidStakingTokenDenom(valAddr)
+	if k.bankKeeper.GetSupply(ctx, denom).IsPositive() {
+		// Slashing must not be blocked by the liquid module, so failures are logged and the derivative is skipped.
+		if err := k.recordDerivativeSlash(ctx, denom, valAddr, fraction); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to record slash of derivative %s: %s", denom, err))
+		}
+	}
+
+	// The basket only loses the part of its value delegated to the slashed validator.
+	basketDenom := k.GetBasketDenom()
+	if !k.bankKeeper.GetSupply(ctx, basketDenom).IsPositive() {
+		return
+	}
+	holdings, _ := k.getBasketHoldings(ctx)
+	totalValue := k.getBasketTotalValue(ctx)
+	if !totalValue.IsPositive() {
+		return
+	}
+	for _, holding := range holdings {
+		if !holding.validator.GetOperator().Equals(valAddr) {
+			continue
+		}
+
+		slashedTokens := holding.tokens.Mul(fraction)
+		basketFraction := slashedTokens.Quo(totalValue)
+		if !basketFraction.IsPositive() {
+			return
+		}
+		rate := k.GetBasketExchangeRate(ctx)
+
+		k.recordSlash(ctx, types.NewSlashEvent(
+			basketDenom, valAddr, ctx.BlockHeight(), ctx.BlockTime(),
+			basketFraction,
+			sdk.NewCoin(bondDenom, slashedTokens.TruncateInt()),
+			rate.Mul(sdk.OneDec().Sub(basketFraction)),
+		))
+		return
+	}
+}
+
+// recordDerivativeSlash records a slash event for the derivative of a single validator.
+func (k Keeper) recordDerivativeSlash(ctx sdk.Context, denom string, valAddr sdk.ValAddress, fraction sdk.Dec) error {
+	value, err := k.GetDerivativeValue(ctx, denom)
+	if err != nil {
+		return err
+	}
+	rate, err := k.GetExchangeRate(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	k.recordSlash(ctx, types.NewSlashEvent(
+		denom, valAddr, ctx.BlockHeight(), ctx.BlockTime(),
+		fraction,
+		sdk.NewCoin(value.Denom, fraction.MulInt(value.Amount).TruncateInt()),
+		rate.Mul(sdk.OneDec().Sub(fraction)),
+	))
+	return nil
+}
+
+// RecordSlashEvent stores a slash event and adds it to the slash history of its derivative denom.
+func (k Keeper) RecordSlashEvent(ctx sdk.Context, event types.SlashEvent) {
+	history, found := k.GetSlashHistory(ctx, event.Denom)
+	if !found {
+		history = types.NewSlashHistory(event.Denom, event.TokensSlashed.Denom)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SlashEventPrefix)
+	store.Set(types.GetSlashEventKey(event.Denom, history.SlashCount), k.cdc.MustMarshal(&event))
+
+	k.SetSlashHistory(ctx, history.AddSlash(event))
+}
+
+// recordSlash records a slash event and emits it.
+func (k Keeper) recordSlash(ctx sdk.Context, event types.SlashEvent) {
+	k.RecordSlashEvent(ctx, event)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDerivativeSlash,
+			sdk.NewAttribute(types.AttributeKeyDenom, event.Denom),
+			sdk.NewAttribute(types.AttributeKeyValidator, event.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeySlashFraction, event.Fraction.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, event.TokensSlashed.String()),
+			sdk.NewAttribute(types.AttributeKeyExchangeRate, event.ExchangeRate.String()),
+		),
+	)
+}
+
+// GetSlashHistory returns the cumulative slash history of a derivative denom.
+func (k Keeper) GetSlashHistory(ctx sdk.Context, denom string) (types.SlashHistory, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SlashHistoryPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return types.SlashHistory{}, false
+	}
+
+	var history types.SlashHistory
+	k.cdc.MustUnmarshal(bz, &history)
+	return history, true
+}
+
+// SetSlashHistory stores the cumulative slash history of a derivative denom.
+func (k Keeper) SetSlashHistory(ctx sdk.Context, history types.SlashHistory) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SlashHistoryPrefix)
+	store.Set([]byte(history.Denom), k.cdc.MustMarshal(&history))
+}
+
+// IterateSlashEvents iterates over all slash events, grouped by denom and in the order they occurred.
+func (k Keeper) IterateSlashEvents(ctx sdk.Context, cb func(event types.SlashEvent) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.SlashEventPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var event types.SlashEvent
+		k.cdc.MustUnmarshal(iterator.Value(), &event)
+		if cb(event) {
+			break
+		}
+	}
+}
+
+// GetAllSlashEvents returns all slash events, grouped by denom and in the order they occurred.
+func (k Keeper) GetAllSlashEvents(ctx sdk.Context) types.SlashEvents {
+	var events types.SlashEvents
+	k.IterateSlashEvents(ctx, func(event types.SlashEvent) bool {
+		events = append(events, event)
+		return false
+	})
+	return events
+}
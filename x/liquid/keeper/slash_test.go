@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fury/x/liquid/types"
+	pricefeedtypes "github.com/incubus-network/fury/x/pricefeed/types"
+)
+
+func (suite *KeeperTestSuite) TestRecordValidatorSlash() {
+	valAddrs, delegator := suite.setupBasket(2, "0.5", "0.5")
+	derivativeDenom := suite.Keeper.GetLiquidStakingTokenDenom(valAddrs[0])
+	basketDenom := suite.Keeper.GetBasketDenom()
+
+	suite.CreateDelegation(valAddrs[0], delegator, i(100e6))
+	_, err := suite.Keeper.MintDerivative(suite.Ctx, delegator, valAddrs[0], suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+	_, err = suite.Keeper.MintBasketDerivative(suite.Ctx, delegator, suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+
+	suite.SlashValidator(valAddrs[0], d("0.1"))
+
+	history, found := suite.Keeper.GetSlashHistory(suite.Ctx, derivativeDenom)
+	suite.Require().True(found)
+	suite.Equal(types.SlashHistory{
+		Denom:              derivativeDenom,
+		SlashCount:         1,
+		CumulativeFraction: d("0.1"),
+		TotalTokensSlashed: suite.NewBondCoin(i(10e6)),
+	}, history)
+
+	// The recorded exchange rate matches the rate after the slash
+	rate, err := suite.Keeper.GetExchangeRate(suite.Ctx, derivativeDenom)
+	suite.Require().NoError(err)
+	suite.Equal(d("0.9"), rate)
+
+	suite.EventsContains(suite.Ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeDerivativeSlash,
+		sdk.NewAttribute(types.AttributeKeyDenom, derivativeDenom),
+		sdk.NewAttribute(types.AttributeKeyValidator, valAddrs[0].String()),
+		sdk.NewAttribute(types.AttributeKeySlashFraction, d("0.1").String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, suite.NewBondCoin(i(10e6)).String()),
+		sdk.NewAttribute(types.AttributeKeyExchangeRate, rate.String()),
+	))
+
+	// The basket loses the slashed fraction of the half of its value delegated to the validator
+	basketHistory, found := suite.Keeper.GetSlashHistory(suite.Ctx, basketDenom)
+	suite.Require().True(found)
+	suite.Equal(types.SlashHistory{
+		Denom:              basketDenom,
+		SlashCount:         1,
+		CumulativeFraction: d("0.05"),
+		TotalTokensSlashed: suite.NewBondCoin(i(5e6)),
+	}, basketHistory)
+	suite.Equal(d("0.95"), suite.Keeper.GetBasketExchangeRate(suite.Ctx))
+
+	// Slashes compound
+	suite.SlashValidator(valAddrs[0], d("0.1"))
+
+	history, found = suite.Keeper.GetSlashHistory(suite.Ctx, derivativeDenom)
+	suite.Require().True(found)
+	suite.Equal(uint64(2), history.SlashCount)
+	suite.Equal(d("0.19"), history.CumulativeFraction)
+	suite.Equal(suite.NewBondCoin(i(19e6)), history.TotalTokensSlashed)
+
+	events := suite.Keeper.GetAllSlashEvents(suite.Ctx)
+	suite.Require().Len(events, 4)
+	suite.Equal(
+		types.NewSlashEvent(
+			derivativeDenom, valAddrs[0], suite.Ctx.BlockHeight(), suite.Ctx.BlockTime(),
+			d("0.1"), suite.NewBondCoin(i(9e6)), d("0.81"),
+		),
+		events[3],
+	)
+
+	// A validator without derivatives only records a slash for the basket
+	suite.SlashValidator(valAddrs[1], d("0.1"))
+	_, found = suite.Keeper.GetSlashHistory(suite.Ctx, suite.Keeper.GetLiquidStakingTokenDenom(valAddrs[1]))
+	suite.False(found)
+	basketHistory, found = suite.Keeper.GetSlashHistory(suite.Ctx, basketDenom)
+	suite.Require().True(found)
+	suite.Equal(uint64(3), basketHistory.SlashCount)
+}
+
+func (suite *KeeperTestSuite) TestRecordValidatorSlash_PriceFeed() {
+	valAddrs, delegator := suite.setupBasket(1, "1")
+	derivativeDenom := suite.Keeper.GetLiquidStakingTokenDenom(valAddrs[0])
+	bondDenom := suite.StakingKeeper.BondDenom(suite.Ctx)
+	marketID := derivativeDenom + ":" + bondDenom
+
+	pricefeedKeeper := suite.App.GetPriceFeedKeeper()
+	pricefeedKeeper.SetParams(suite.Ctx, pricefeedtypes.NewParams([]pricefeedtypes.Market{
+		{MarketID: marketID, BaseAsset: derivativeDenom, QuoteAsset: bondDenom, Active: true},
+	}))
+
+	suite.CreateDelegation(valAddrs[0], delegator, i(100e6))
+	_, err := suite.Keeper.MintDerivative(suite.Ctx, delegator, valAddrs[0], suite.NewBondCoin(i(100e6)))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(pricefeedKeeper.SetCurrentPrices(suite.Ctx, marketID))
+	price, err := pricefeedKeeper.GetCurrentPrice(suite.Ctx, marketID)
+	suite.Require().NoError(err)
+	suite.Equal(sdk.OneDec(), price.Price)
+
+	// The derivative price reflects a slash without waiting for the end blocker
+	suite.SlashValidator(valAddrs[0], d("0.2"))
+	price, err = pricefeedKeeper.GetCurrentPrice(suite.Ctx, marketID)
+	suite.Require().NoError(err)
+	suite.Equal(d("0.8"), price.Price)
+
+	suite.Require().NoError(pricefeedKeeper.SetCurrentPrices(suite.Ctx, marketID))
+	suite.Equal(pricefeedtypes.CurrentPrices{pricefeedtypes.NewCurrentPrice(marketID, d("0.8"))}, pricefeedKeeper.GetCurrentPrices(suite.Ctx))
+}
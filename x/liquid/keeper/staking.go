@@ -95,6 +95,39 @@ func (k Keeper) fastUndelegate(ctx sdk.Context, valAddr sdk.ValAddress, delegato
 	return returnAmount, nil
 }
 
+// undelegateToAccount undelegates shares of one delegator, creating an unbonding delegation owned by another account.
+//
+// Unlike TransferDelegation it can be used on delegations with active redelegations, but the tokens are only received
+// once the unbonding period has passed.
+func (k Keeper) undelegateToAccount(ctx sdk.Context, valAddr sdk.ValAddress, fromDelegator, toDelegator sdk.AccAddress, shares sdk.Dec) (sdkmath.Int, error) {
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return sdkmath.Int{}, types.ErrNoValidatorFound
+	}
+	if k.stakingKeeper.HasMaxUnbondingDelegationEntries(ctx, toDelegator, valAddr) {
+		return sdkmath.Int{}, stakingtypes.ErrMaxUnbondingDelegationEntries
+	}
+
+	returnAmount, err := k.stakingKeeper.Unbond(ctx, fromDelegator, valAddr, shares)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	// transfer the validator tokens to the not bonded pool
+	if validator.IsBonded() {
+		returnCoins := sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), returnAmount))
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, returnCoins); err != nil {
+			panic(err)
+		}
+	}
+
+	completionTime := ctx.BlockHeader().Time.Add(k.stakingKeeper.UnbondingTime(ctx))
+	ubd := k.stakingKeeper.SetUnbondingDelegationEntry(ctx, toDelegator, valAddr, ctx.BlockHeight(), completionTime, returnAmount)
+	k.stakingKeeper.InsertUBDQueue(ctx, ubd, completionTime)
+
+	return returnAmount, nil
+}
+
 // delegateFromAccount delegates to a validator from an account (vs redelegating from an existing delegation)
 func (k Keeper) delegateFromAccount(ctx sdk.Context, valAddr sdk.ValAddress, delegator sdk.AccAddress, amount sdkmath.Int) (sdk.Dec, error) {
 	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
@@ -13,6 +13,8 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgMintDerivative{}, "liquid/MsgMintDerivative", nil)
 	cdc.RegisterConcrete(&MsgBurnDerivative{}, "liquid/MsgBurnDerivative", nil)
+	cdc.RegisterConcrete(&MsgMintBasketDerivative{}, "liquid/MsgMintBasketDerivative", nil)
+	cdc.RegisterConcrete(&MsgBurnBasketDerivative{}, "liquid/MsgBurnBasketDerivative", nil)
 }
 
 // RegisterInterfaces registers proto messages under their interfaces for unmarshalling,
@@ -21,6 +23,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgMintDerivative{},
 		&MsgBurnDerivative{},
+		&MsgMintBasketDerivative{},
+		&MsgBurnBasketDerivative{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
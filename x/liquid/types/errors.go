@@ -10,4 +10,6 @@ var (
 	ErrRedelegationsNotCompleted  = errorsmod.Register(ModuleName, 6, "active redelegations cannot be transferred")
 	ErrUntransferableShares       = errorsmod.Register(ModuleName, 7, "shares cannot be transferred")
 	ErrSelfDelegationBelowMinimum = errorsmod.Register(ModuleName, 8, "validator's self delegation must be greater than their minimum self delegation")
+	ErrBasketDisabled             = errorsmod.Register(ModuleName, 9, "basket derivative has no validators")
+	ErrInsufficientBasketValue    = errorsmod.Register(ModuleName, 10, "basket delegations are not large enough")
 )
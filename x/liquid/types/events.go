@@ -1,11 +1,25 @@
 package types
 
 const (
-	EventTypeMintDerivative = "mint_derivative"
-	EventTypeBurnDerivative = "burn_derivative"
+	EventTypeMintDerivative  = "mint_derivative"
+	EventTypeBurnDerivative  = "burn_derivative"
+	EventTypeCompoundRewards = "compound_staking_rewards"
+	EventTypeMintBasket      = "mint_basket_derivative"
+	EventTypeBurnBasket      = "burn_basket_derivative"
+	EventTypeRebalanceBasket = "rebalance_basket"
+	EventTypeDerivativeSlash = "derivative_slashed"
 
 	AttributeValueCategory        = ModuleName
 	AttributeKeyDelegator         = "delegator"
 	AttributeKeyValidator         = "validator"
 	AttributeKeySharesTransferred = "shares_transferred"
+	AttributeKeyShares            = "shares"
+	AttributeKeySourceValidator   = "source_validator"
+	AttributeKeyDestValidator     = "destination_validator"
+	AttributeKeyTokensDelegated   = "tokens_delegated"
+	AttributeKeyTokensUnbonding   = "tokens_unbonding"
+	AttributeKeyLiquidTokens      = "liquid_tokens"
+	AttributeKeyDenom             = "denom"
+	AttributeKeySlashFraction     = "slash_fraction"
+	AttributeKeyExchangeRate      = "exchange_rate"
 )
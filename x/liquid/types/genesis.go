@@ -0,0 +1,31 @@
+package types
+
+import (
+	"time"
+)
+
+// DefaultLastCompoundTime is the last compound time used when none is set.
+var DefaultLastCompoundTime = time.Time{}
+
+// NewGenesisState returns a new genesis state.
+func NewGenesisState(params Params, lastCompoundTime time.Time, slashEvents SlashEvents) GenesisState {
+	return GenesisState{
+		Params:           params,
+		LastCompoundTime: lastCompoundTime,
+		SlashEvents:      slashEvents,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state.
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), DefaultLastCompoundTime, nil)
+}
+
+// Validate performs basic validation of genesis data returning an error for
+// any failed validation criteria.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	return gs.SlashEvents.Validate()
+}
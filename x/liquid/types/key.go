@@ -5,27 +5,62 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
 	// ModuleName The name that will be used throughout the module
 	ModuleName = "liquid"
 
+	// StoreKey Top level store key where all module items will be stored
+	StoreKey = ModuleName
+
 	// RouterKey Top level router key
 	RouterKey = ModuleName
 
 	// ModuleAccountName is the module account's name
 	ModuleAccountName = ModuleName
 
+	// BasketAccountName is the name of the module account holding the basket derivative's delegations
+	BasketAccountName = "liquid-basket"
+
+	// BasketRewardSource is the name of the x/incentive reward source rewarding basket derivative holders
+	BasketRewardSource = "liquid_basket"
+
 	DefaultDerivativeDenom = "bfury"
 
 	DenomSeparator = "-"
+
+	// BasketDenomSuffix is appended to the derivative denom to form the basket derivative denom
+	BasketDenomSuffix = "basket"
+)
+
+var (
+	LastCompoundTimeKey          = []byte{0x01}
+	RebalancedBasketWeightPrefix = []byte{0x02}
+	SlashHistoryPrefix           = []byte{0x03}
+	SlashEventPrefix             = []byte{0x04}
 )
 
+// GetSlashEventPrefix returns the prefix of the slash events of a derivative denom.
+func GetSlashEventPrefix(denom string) []byte {
+	return address.MustLengthPrefix([]byte(denom))
+}
+
+// GetSlashEventKey returns the key of a derivative denom's slash event, ordered by the slash's sequence number.
+func GetSlashEventKey(denom string, sequence uint64) []byte {
+	return append(GetSlashEventPrefix(denom), sdk.Uint64ToBigEndian(sequence)...)
+}
+
 func GetLiquidStakingTokenDenom(bondDenom string, valAddr sdk.ValAddress) string {
 	return fmt.Sprintf("%s%s%s", bondDenom, DenomSeparator, valAddr.String())
 }
 
+// GetBasketDenom returns the denom of the basket derivative, which is backed by delegations to many validators.
+func GetBasketDenom(derivativeDenom string) string {
+	return fmt.Sprintf("%s%s%s", derivativeDenom, DenomSeparator, BasketDenomSuffix)
+}
+
 // ParseLiquidStakingTokenDenom extracts a validator address from a derivative denom.
 func ParseLiquidStakingTokenDenom(denom string) (sdk.ValAddress, error) {
 	elements := strings.Split(denom, DenomSeparator)
@@ -0,0 +1,891 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: fury/liquid/v1beta1/liquid.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SlashEvent records a slash of a validator's delegations that reduced the value of a staking derivative.
+type SlashEvent struct {
+	// denom is the staking derivative denom that lost value
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// validator_address is the operator address of the slashed validator
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// height is the block height the slash was applied at
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time the slash was applied at
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// fraction is the fraction of the derivative's staked value that was slashed
+	Fraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=fraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fraction"`
+	// tokens_slashed is the staked value of the derivative supply that was slashed
+	TokensSlashed types.Coin `protobuf:"bytes,6,opt,name=tokens_slashed,json=tokensSlashed,proto3" json:"tokens_slashed"`
+	// exchange_rate is the amount of staked tokens one unit of the derivative is worth after the slash
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate"`
+}
+
+func (m *SlashEvent) Reset()         { *m = SlashEvent{} }
+func (m *SlashEvent) String() string { return proto.CompactTextString(m) }
+func (*SlashEvent) ProtoMessage()    {}
+func (*SlashEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8bfe70b622ee10a, []int{0}
+}
+func (m *SlashEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashEvent.Merge(m, src)
+}
+func (m *SlashEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashEvent proto.InternalMessageInfo
+
+// SlashHistory is the cumulative record of all slashes that reduced the value of a staking derivative.
+type SlashHistory struct {
+	// denom is the staking derivative denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// slash_count is the number of slashes recorded for the derivative
+	SlashCount uint64 `protobuf:"varint,2,opt,name=slash_count,json=slashCount,proto3" json:"slash_count,omitempty"`
+	// cumulative_fraction is the fraction of the derivative's value lost to all recorded slashes combined
+	CumulativeFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=cumulative_fraction,json=cumulativeFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_fraction"`
+	// total_tokens_slashed is the staked value of the derivative supply lost to all recorded slashes
+	TotalTokensSlashed types.Coin `protobuf:"bytes,4,opt,name=total_tokens_slashed,json=totalTokensSlashed,proto3" json:"total_tokens_slashed"`
+}
+
+func (m *SlashHistory) Reset()         { *m = SlashHistory{} }
+func (m *SlashHistory) String() string { return proto.CompactTextString(m) }
+func (*SlashHistory) ProtoMessage()    {}
+func (*SlashHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8bfe70b622ee10a, []int{1}
+}
+func (m *SlashHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashHistory.Merge(m, src)
+}
+func (m *SlashHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashHistory proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*SlashEvent)(nil), "fury.liquid.v1beta1.SlashEvent")
+	proto.RegisterType((*SlashHistory)(nil), "fury.liquid.v1beta1.SlashHistory")
+}
+
+func init() { proto.RegisterFile("fury/liquid/v1beta1/liquid.proto", fileDescriptor_e8bfe70b622ee10a) }
+
+var fileDescriptor_e8bfe70b622ee10a = []byte{
+	// 527 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x41, 0x6f, 0xd3, 0x3e,
+	0x1c, 0x6d, 0xd6, 0xae, 0xff, 0xfd, 0xbd, 0x0d, 0x81, 0x57, 0xa1, 0xac, 0x12, 0x69, 0xd9, 0x01,
+	0xf5, 0xd2, 0x44, 0x83, 0x0b, 0x07, 0x2e, 0x74, 0x63, 0xe2, 0x80, 0x90, 0x48, 0x27, 0x84, 0xb8,
+	0x44, 0x4e, 0xe2, 0xa6, 0x56, 0x13, 0xbb, 0xc4, 0xbf, 0x94, 0xf5, 0x13, 0x70, 0xdd, 0x87, 0xd9,
+	0x87, 0x28, 0xb7, 0x69, 0x27, 0xc4, 0x61, 0x40, 0xfb, 0x45, 0x50, 0x6c, 0xa7, 0x4c, 0x93, 0x90,
+	0x38, 0xec, 0xd4, 0xbe, 0xe7, 0xf7, 0xec, 0xe7, 0xf7, 0x8b, 0x51, 0x77, 0x54, 0xe4, 0x73, 0x2f,
+	0x65, 0x9f, 0x0a, 0x16, 0x7b, 0xb3, 0xc3, 0x90, 0x02, 0x39, 0x34, 0xd0, 0x9d, 0xe6, 0x02, 0x04,
+	0xde, 0x2b, 0x15, 0xae, 0xa1, 0x8c, 0xa2, 0xed, 0x44, 0x42, 0x66, 0x42, 0x7a, 0x21, 0x91, 0x74,
+	0x6d, 0x8b, 0x04, 0xe3, 0xda, 0xd4, 0xde, 0xd7, 0xeb, 0x81, 0x42, 0x9e, 0x06, 0x66, 0xa9, 0x95,
+	0x88, 0x44, 0x68, 0xbe, 0xfc, 0x67, 0xd8, 0x4e, 0x22, 0x44, 0x92, 0x52, 0x4f, 0xa1, 0xb0, 0x18,
+	0x79, 0xc0, 0x32, 0x2a, 0x81, 0x64, 0x53, 0x2d, 0x38, 0xf8, 0x5a, 0x47, 0x68, 0x98, 0x12, 0x39,
+	0x7e, 0x35, 0xa3, 0x1c, 0x70, 0x0b, 0x6d, 0xc6, 0x94, 0x8b, 0xcc, 0xb6, 0xba, 0x56, 0xef, 0x7f,
+	0x5f, 0x03, 0xfc, 0x16, 0x3d, 0x98, 0x91, 0x94, 0xc5, 0x04, 0x44, 0x1e, 0x90, 0x38, 0xce, 0xa9,
+	0x94, 0xf6, 0x46, 0xa9, 0x18, 0x3c, 0xbe, 0xba, 0xe8, 0x3f, 0x32, 0x41, 0xde, 0x57, 0x9a, 0x97,
+	0x5a, 0x32, 0x84, 0x9c, 0xf1, 0xc4, 0xbf, 0x3f, 0xbb, 0xc5, 0xe3, 0x87, 0xa8, 0x39, 0xa6, 0x2c,
+	0x19, 0x83, 0x5d, 0xef, 0x5a, 0xbd, 0xba, 0x6f, 0x10, 0x7e, 0x8e, 0x1a, 0x65, 0x3e, 0xbb, 0xd1,
+	0xb5, 0x7a, 0xdb, 0x4f, 0xdb, 0xae, 0x0e, 0xef, 0x56, 0xe1, 0xdd, 0xd3, 0x2a, 0xfc, 0x60, 0x6b,
+	0x71, 0xdd, 0xa9, 0x9d, 0xff, 0xe8, 0x58, 0xbe, 0x72, 0xe0, 0x0f, 0x68, 0x6b, 0x94, 0x93, 0x08,
+	0x98, 0xe0, 0xf6, 0xa6, 0x0a, 0xf6, 0xa2, 0x54, 0x7c, 0xbf, 0xee, 0x3c, 0x49, 0x18, 0x8c, 0x8b,
+	0xd0, 0x8d, 0x44, 0x66, 0x0a, 0x33, 0x3f, 0x7d, 0x19, 0x4f, 0x3c, 0x98, 0x4f, 0xa9, 0x74, 0x8f,
+	0x69, 0x74, 0x75, 0xd1, 0x47, 0xe6, 0x1a, 0xc7, 0x34, 0xf2, 0xd7, 0xbb, 0xe1, 0x13, 0x74, 0x0f,
+	0xc4, 0x84, 0x72, 0x19, 0xc8, 0xb2, 0x26, 0x1a, 0xdb, 0x4d, 0x95, 0x6e, 0xdf, 0x35, 0xf2, 0x72,
+	0x56, 0xd5, 0x00, 0xdd, 0x23, 0xc1, 0xf8, 0xa0, 0x51, 0x1e, 0xed, 0xef, 0x6a, 0xdb, 0x50, 0xbb,
+	0x30, 0x41, 0xbb, 0xf4, 0x2c, 0x1a, 0x13, 0x9e, 0xd0, 0x20, 0x27, 0x40, 0xed, 0xff, 0xee, 0x20,
+	0xe6, 0x4e, 0xb5, 0xa5, 0x4f, 0x80, 0x1e, 0x7c, 0xd9, 0x40, 0x3b, 0xea, 0xb8, 0xd7, 0x4c, 0x82,
+	0xc8, 0xe7, 0x7f, 0x99, 0x66, 0x07, 0x6d, 0xab, 0xab, 0x04, 0x91, 0x28, 0x38, 0xa8, 0x39, 0x36,
+	0x7c, 0xa4, 0xa8, 0xa3, 0x92, 0xc1, 0x19, 0xda, 0x8b, 0x8a, 0xac, 0x48, 0x09, 0xb0, 0x19, 0x0d,
+	0xd6, 0xbd, 0xd6, 0xef, 0x20, 0x30, 0xfe, 0xb3, 0xf1, 0x49, 0xd5, 0xf0, 0x3b, 0xd4, 0x02, 0x01,
+	0x24, 0x0d, 0x6e, 0xf5, 0xdc, 0xf8, 0xb7, 0x9e, 0xb1, 0x32, 0x9f, 0xde, 0x2c, 0x7b, 0xf0, 0x66,
+	0xf1, 0xcb, 0xa9, 0x2d, 0x96, 0x8e, 0x75, 0xb9, 0x74, 0xac, 0x9f, 0x4b, 0xc7, 0x3a, 0x5f, 0x39,
+	0xb5, 0xcb, 0x95, 0x53, 0xfb, 0xb6, 0x72, 0x6a, 0x1f, 0xdd, 0x1b, 0xd1, 0x19, 0x8f, 0x8a, 0xb0,
+	0x90, 0x7d, 0x4e, 0xe1, 0xb3, 0xc8, 0x27, 0x9e, 0x7a, 0xb7, 0x67, 0xd5, 0xcb, 0x55, 0xd7, 0x08,
+	0x9b, 0xea, 0x03, 0x7c, 0xf6, 0x7b, 0x00, 0xc1, 0x88, 0x2a, 0xc8, 0xd5, 0x03, 0x00, 0x00,
+}
+
+func (m *SlashEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.TokensSlashed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintLiquid(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintLiquid(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLiquid(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintLiquid(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TotalTokensSlashed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CumulativeFraction.Size()
+		i -= size
+		if _, err := m.CumulativeFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.SlashCount != 0 {
+		i = encodeVarintLiquid(dAtA, i, uint64(m.SlashCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintLiquid(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLiquid(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLiquid(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SlashEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovLiquid(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquid(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovLiquid(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLiquid(uint64(l))
+	l = m.Fraction.Size()
+	n += 1 + l + sovLiquid(uint64(l))
+	l = m.TokensSlashed.Size()
+	n += 1 + l + sovLiquid(uint64(l))
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovLiquid(uint64(l))
+	return n
+}
+
+func (m *SlashHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovLiquid(uint64(l))
+	}
+	if m.SlashCount != 0 {
+		n += 1 + sovLiquid(uint64(m.SlashCount))
+	}
+	l = m.CumulativeFraction.Size()
+	n += 1 + l + sovLiquid(uint64(l))
+	l = m.TotalTokensSlashed.Size()
+	n += 1 + l + sovLiquid(uint64(l))
+	return n
+}
+
+func sovLiquid(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLiquid(x uint64) (n int) {
+	return sovLiquid(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SlashEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensSlashed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokensSlashed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashCount", wireType)
+			}
+			m.SlashCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativeFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTokensSlashed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalTokensSlashed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLiquid(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLiquid
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLiquid
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLiquid
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLiquid
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLiquid
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLiquid
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLiquid        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLiquid          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLiquid = fmt.Errorf("proto: unexpected end of group")
+)
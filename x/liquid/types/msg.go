@@ -12,6 +12,10 @@ const (
 	TypeMsgMintDerivative = "mint_derivative"
 	// TypeMsgBurnDerivative represents the type string for MsgBurnDerivative
 	TypeMsgBurnDerivative = "burn_derivative"
+	// TypeMsgMintBasketDerivative represents the type string for MsgMintBasketDerivative
+	TypeMsgMintBasketDerivative = "mint_basket_derivative"
+	// TypeMsgBurnBasketDerivative represents the type string for MsgBurnBasketDerivative
+	TypeMsgBurnBasketDerivative = "burn_basket_derivative"
 )
 
 // ensure Msg interface compliance at compile time
@@ -20,6 +24,10 @@ var (
 	_ legacytx.LegacyMsg = &MsgMintDerivative{}
 	_ sdk.Msg            = &MsgBurnDerivative{}
 	_ legacytx.LegacyMsg = &MsgBurnDerivative{}
+	_ sdk.Msg            = &MsgMintBasketDerivative{}
+	_ legacytx.LegacyMsg = &MsgMintBasketDerivative{}
+	_ sdk.Msg            = &MsgBurnBasketDerivative{}
+	_ legacytx.LegacyMsg = &MsgBurnBasketDerivative{}
 )
 
 // NewMsgMintDerivative returns a new MsgMintDerivative
@@ -119,3 +127,89 @@ func (msg MsgBurnDerivative) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+// NewMsgMintBasketDerivative returns a new MsgMintBasketDerivative
+func NewMsgMintBasketDerivative(sender sdk.AccAddress, amount sdk.Coin) MsgMintBasketDerivative {
+	return MsgMintBasketDerivative{
+		Sender: sender.String(),
+		Amount: amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgMintBasketDerivative) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgMintBasketDerivative) Type() string { return TypeMsgMintBasketDerivative }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgMintBasketDerivative) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "'%s'", msg.Amount)
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgMintBasketDerivative) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgMintBasketDerivative) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgBurnBasketDerivative returns a new MsgBurnBasketDerivative
+func NewMsgBurnBasketDerivative(sender sdk.AccAddress, amount sdk.Coin) MsgBurnBasketDerivative {
+	return MsgBurnBasketDerivative{
+		Sender: sender.String(),
+		Amount: amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgBurnBasketDerivative) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgBurnBasketDerivative) Type() string { return TypeMsgBurnBasketDerivative }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgBurnBasketDerivative) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "'%s'", msg.Amount)
+	}
+
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgBurnBasketDerivative) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgBurnBasketDerivative) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -51,6 +51,45 @@ func TestMsgBurnDerivative_Signing(t *testing.T) {
 	assert.Equal(t, signBytes, msg.GetSignBytes())
 }
 
+func TestMsgMintBasketDerivative_Signing(t *testing.T) {
+	address := mustAccAddressFromBech32("fury1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+
+	msg := types.NewMsgMintBasketDerivative(
+		address,
+		sdk.NewCoin("ufury", sdkmath.NewInt(1e9)),
+	)
+
+	// checking for the "type" field ensures the msg is registered on the amino codec
+	signBytes := []byte(
+		`{"type":"liquid/MsgMintBasketDerivative","value":{"amount":{"amount":"1000000000","denom":"ufury"},"sender":"fury1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d"}}`,
+	)
+
+	assert.Equal(t, []sdk.AccAddress{address}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+	assert.NoError(t, msg.ValidateBasic())
+}
+
+func TestMsgBurnBasketDerivative_Signing(t *testing.T) {
+	address := mustAccAddressFromBech32("fury1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
+
+	msg := types.NewMsgBurnBasketDerivative(
+		address,
+		sdk.NewCoin("bfury-basket", sdkmath.NewInt(1e9)),
+	)
+
+	// checking for the "type" field ensures the msg is registered on the amino codec
+	signBytes := []byte(
+		`{"type":"liquid/MsgBurnBasketDerivative","value":{"amount":{"amount":"1000000000","denom":"bfury-basket"},"sender":"fury1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d"}}`,
+	)
+
+	assert.Equal(t, []sdk.AccAddress{address}, msg.GetSigners())
+	assert.Equal(t, signBytes, msg.GetSignBytes())
+	assert.NoError(t, msg.ValidateBasic())
+
+	msg.Amount = sdk.Coin{Denom: "bfury-basket", Amount: sdkmath.ZeroInt()}
+	assert.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidCoins)
+}
+
 func TestMsg_Validate(t *testing.T) {
 	validAddress := mustAccAddressFromBech32("fury1gepm4nwzz40gtpur93alv9f9wm5ht4l0hzzw9d")
 	validValidatorAddress := mustValAddressFromBech32("furyvaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42")
@@ -0,0 +1,140 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter keys and default values
+var (
+	KeyCompoundInterval     = []byte("CompoundInterval")
+	KeyBasketValidators     = []byte("BasketValidators")
+	DefaultCompoundInterval = time.Duration(0)
+	DefaultBasketValidators = BasketValidators(nil) // basket disabled
+)
+
+// NewParams returns a new params object
+func NewParams(compoundInterval time.Duration, basketValidators BasketValidators) Params {
+	return Params{
+		CompoundInterval: compoundInterval,
+		BasketValidators: basketValidators,
+	}
+}
+
+// DefaultParams returns default params for liquid module
+func DefaultParams() Params {
+	return NewParams(DefaultCompoundInterval, DefaultBasketValidators)
+}
+
+// ParamKeyTable for liquid module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyCompoundInterval, &p.CompoundInterval, validateCompoundInterval),
+		paramtypes.NewParamSetPair(KeyBasketValidators, &p.BasketValidators, validateBasketValidators),
+	}
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if err := validateCompoundInterval(p.CompoundInterval); err != nil {
+		return err
+	}
+
+	return validateBasketValidators(p.BasketValidators)
+}
+
+// IsCompoundingEnabled returns true if staking rewards are restaked.
+func (p Params) IsCompoundingEnabled() bool {
+	return p.CompoundInterval > 0
+}
+
+// NewBasketValidator returns a new BasketValidator
+func NewBasketValidator(valAddr sdk.ValAddress, weight sdk.Dec) BasketValidator {
+	return BasketValidator{
+		ValidatorAddress: valAddr.String(),
+		Weight:           weight,
+	}
+}
+
+// Validate checks the basket validator has a valid address and a positive weight.
+func (bv BasketValidator) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(bv.ValidatorAddress); err != nil {
+		return fmt.Errorf("invalid basket validator address: %w", err)
+	}
+
+	if bv.Weight.IsNil() || !bv.Weight.IsPositive() {
+		return fmt.Errorf("basket validator %s weight must be positive: %s", bv.ValidatorAddress, bv.Weight)
+	}
+
+	return nil
+}
+
+// BasketValidators is a slice of BasketValidator
+type BasketValidators []BasketValidator
+
+// Validate checks each basket validator is valid and unique, and that the weights sum to one.
+func (bvs BasketValidators) Validate() error {
+	if len(bvs) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	totalWeight := sdk.ZeroDec()
+	for _, bv := range bvs {
+		if err := bv.Validate(); err != nil {
+			return err
+		}
+
+		if seen[bv.ValidatorAddress] {
+			return fmt.Errorf("duplicate basket validator %s", bv.ValidatorAddress)
+		}
+		seen[bv.ValidatorAddress] = true
+
+		totalWeight = totalWeight.Add(bv.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return fmt.Errorf("basket validator weights must sum to 1, got %s", totalWeight)
+	}
+
+	return nil
+}
+
+// Weights returns the weight of each basket validator by operator address.
+func (bvs BasketValidators) Weights() map[string]sdk.Dec {
+	weights := make(map[string]sdk.Dec, len(bvs))
+	for _, bv := range bvs {
+		weights[bv.ValidatorAddress] = bv.Weight
+	}
+	return weights
+}
+
+func validateCompoundInterval(i interface{}) error {
+	interval, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if interval < 0 {
+		return fmt.Errorf("compound interval must not be negative: %s", interval)
+	}
+
+	return nil
+}
+
+func validateBasketValidators(i interface{}) error {
+	basketValidators, ok := i.(BasketValidators)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return basketValidators.Validate()
+}
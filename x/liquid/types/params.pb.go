@@ -0,0 +1,604 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: fury/liquid/v1beta1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the liquid module.
+type Params struct {
+	// compound_interval is the minimum time between restaking the staking rewards
+	// of the module's delegations. A zero interval disables auto-compounding.
+	CompoundInterval time.Duration `protobuf:"bytes,1,opt,name=compound_interval,json=compoundInterval,proto3,stdduration" json:"compound_interval"`
+	// basket_validators is the weighted set of validators backing the basket derivative.
+	// An empty set disables minting the basket derivative.
+	BasketValidators BasketValidators `protobuf:"bytes,2,rep,name=basket_validators,json=basketValidators,proto3,castrepeated=BasketValidators" json:"basket_validators"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283e524925097991, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+// BasketValidator defines a validator backing the basket derivative, and the share of the
+// basket's delegations it receives.
+type BasketValidator struct {
+	// validator_address is the operator address of the validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// weight is the fraction of the basket's delegations delegated to the validator
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *BasketValidator) Reset()         { *m = BasketValidator{} }
+func (m *BasketValidator) String() string { return proto.CompactTextString(m) }
+func (*BasketValidator) ProtoMessage()    {}
+func (*BasketValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283e524925097991, []int{1}
+}
+func (m *BasketValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BasketValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BasketValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BasketValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BasketValidator.Merge(m, src)
+}
+func (m *BasketValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *BasketValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_BasketValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BasketValidator proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Params)(nil), "fury.liquid.v1beta1.Params")
+	proto.RegisterType((*BasketValidator)(nil), "fury.liquid.v1beta1.BasketValidator")
+}
+
+func init() { proto.RegisterFile("fury/liquid/v1beta1/params.proto", fileDescriptor_283e524925097991) }
+
+var fileDescriptor_283e524925097991 = []byte{
+	// 406 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x3f, 0x6f, 0xd4, 0x30,
+	0x18, 0xc6, 0xe3, 0x22, 0x9d, 0xc0, 0x1d, 0xb8, 0x0b, 0x0c, 0xd7, 0x4a, 0xf8, 0x8e, 0x0a, 0xa1,
+	0x2e, 0xb1, 0xd5, 0xb2, 0xb2, 0x10, 0xdd, 0x82, 0x84, 0x50, 0x15, 0x10, 0x03, 0x4b, 0xe4, 0x24,
+	0x6e, 0x6a, 0xe5, 0x8f, 0x83, 0xff, 0xa4, 0xf4, 0x5b, 0x30, 0xf2, 0x19, 0x98, 0x2b, 0xf1, 0x11,
+	0xb8, 0xb1, 0xea, 0x84, 0x18, 0x5a, 0xb8, 0xfb, 0x22, 0x28, 0x8e, 0x53, 0xa1, 0x88, 0x29, 0xf1,
+	0xe3, 0xdf, 0xfb, 0x3c, 0x79, 0xf3, 0xbe, 0x70, 0x79, 0x6a, 0xe4, 0x05, 0x29, 0xf9, 0x27, 0xc3,
+	0x33, 0xd2, 0x1e, 0x25, 0x4c, 0xd3, 0x23, 0xd2, 0x50, 0x49, 0x2b, 0x85, 0x1b, 0x29, 0xb4, 0xf0,
+	0x1f, 0x75, 0x04, 0xee, 0x09, 0xec, 0x88, 0xfd, 0xbd, 0x54, 0xa8, 0x4a, 0xa8, 0xd8, 0x22, 0xa4,
+	0x3f, 0xf4, 0xfc, 0xfe, 0xe3, 0x5c, 0xe4, 0xa2, 0xd7, 0xbb, 0x37, 0xa7, 0xa2, 0x5c, 0x88, 0xbc,
+	0x64, 0xc4, 0x9e, 0x12, 0x73, 0x4a, 0x32, 0x23, 0xa9, 0xe6, 0xa2, 0xee, 0xef, 0x0f, 0x7e, 0x00,
+	0x38, 0x39, 0xb1, 0xb1, 0xfe, 0x09, 0x9c, 0xa5, 0xa2, 0x6a, 0x84, 0xa9, 0xb3, 0x98, 0xd7, 0x9a,
+	0xc9, 0x96, 0x96, 0x73, 0xb0, 0x04, 0x87, 0xbb, 0xc7, 0x7b, 0xb8, 0xb7, 0xc1, 0x83, 0x0d, 0x5e,
+	0x39, 0x9b, 0xf0, 0xfe, 0xfa, 0x66, 0xe1, 0x7d, 0xbd, 0x5d, 0x80, 0x68, 0x3a, 0x54, 0xbf, 0x76,
+	0xc5, 0x7e, 0x01, 0x67, 0x09, 0x55, 0x05, 0xd3, 0x71, 0x4b, 0x4b, 0x9e, 0x51, 0x2d, 0xa4, 0x9a,
+	0xef, 0x2c, 0xef, 0x1d, 0xee, 0x1e, 0x3f, 0xc3, 0xff, 0x69, 0x0f, 0x87, 0x96, 0xfe, 0x30, 0xc0,
+	0xe1, 0xbc, 0x33, 0xff, 0x76, 0xbb, 0x98, 0x8e, 0x2e, 0x54, 0x34, 0x4d, 0x46, 0xca, 0xc1, 0x77,
+	0x00, 0x1f, 0x8e, 0x30, 0xff, 0x2d, 0x9c, 0xdd, 0x25, 0xc7, 0x34, 0xcb, 0x24, 0x53, 0xca, 0xb6,
+	0xf4, 0x20, 0x7c, 0x7a, 0x7d, 0x19, 0x3c, 0x71, 0x3f, 0xf0, 0xae, 0xe0, 0x55, 0x8f, 0xbc, 0xd3,
+	0x92, 0xd7, 0x79, 0x34, 0x6d, 0x47, 0xba, 0xff, 0x1e, 0x4e, 0xce, 0x19, 0xcf, 0xcf, 0xf4, 0x7c,
+	0xc7, 0x9a, 0xbc, 0xec, 0xbe, 0xef, 0xd7, 0xcd, 0xe2, 0x79, 0xce, 0xf5, 0x99, 0x49, 0x70, 0x2a,
+	0x2a, 0x37, 0x14, 0xf7, 0x08, 0x54, 0x56, 0x10, 0x7d, 0xd1, 0x30, 0x85, 0x57, 0x2c, 0xbd, 0xbe,
+	0x0c, 0xa0, 0x8b, 0x5c, 0xb1, 0x34, 0x72, 0x5e, 0xe1, 0x9b, 0xf5, 0x1f, 0xe4, 0xad, 0x37, 0x08,
+	0x5c, 0x6d, 0x10, 0xf8, 0xbd, 0x41, 0xe0, 0xcb, 0x16, 0x79, 0x57, 0x5b, 0xe4, 0xfd, 0xdc, 0x22,
+	0xef, 0x23, 0xfe, 0xc7, 0x9b, 0xd7, 0xa9, 0x49, 0x8c, 0x0a, 0x6a, 0xa6, 0xcf, 0x85, 0x2c, 0x88,
+	0x5d, 0xa2, 0xcf, 0xc3, 0x1a, 0xd9, 0x9c, 0x64, 0x62, 0x67, 0xf4, 0xe2, 0xef, 0x00, 0x69, 0xe6,
+	0x21, 0xce, 0x62, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BasketValidators) > 0 {
+		for iNdEx := len(m.BasketValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BasketValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CompoundInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CompoundInterval):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BasketValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BasketValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BasketValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CompoundInterval)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.BasketValidators) > 0 {
+		for _, e := range m.BasketValidators {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BasketValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompoundInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CompoundInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasketValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BasketValidators = append(m.BasketValidators, BasketValidator{})
+			if err := m.BasketValidators[len(m.BasketValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BasketValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasketValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasketValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,80 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/incubus-network/fury/x/liquid/types"
+)
+
+func TestParams_Validate(t *testing.T) {
+	valAddr1 := mustValAddressFromBech32("furyvaloper1ypjp0m04pyp73hwgtc0dgkx0e9rrydeckewa42")
+	valAddr2 := mustValAddressFromBech32("furyvaloper1ze7y9qwdddejmy7jlw4cymqqlt2wh05y6cpt5a")
+
+	tests := []struct {
+		name    string
+		params  types.Params
+		wantErr string
+	}{
+		{
+			name:   "default params",
+			params: types.DefaultParams(),
+		},
+		{
+			name: "weighted basket",
+			params: types.NewParams(time.Hour, types.BasketValidators{
+				types.NewBasketValidator(valAddr1, sdk.MustNewDecFromStr("0.25")),
+				types.NewBasketValidator(valAddr2, sdk.MustNewDecFromStr("0.75")),
+			}),
+		},
+		{
+			name:    "negative compound interval",
+			params:  types.NewParams(-time.Hour, types.DefaultBasketValidators),
+			wantErr: "compound interval must not be negative",
+		},
+		{
+			name: "invalid basket validator address",
+			params: types.NewParams(0, types.BasketValidators{
+				{ValidatorAddress: "furyvaloper1invalid", Weight: sdk.OneDec()},
+			}),
+			wantErr: "invalid basket validator address",
+		},
+		{
+			name: "zero weight",
+			params: types.NewParams(0, types.BasketValidators{
+				types.NewBasketValidator(valAddr1, sdk.OneDec()),
+				types.NewBasketValidator(valAddr2, sdk.ZeroDec()),
+			}),
+			wantErr: "weight must be positive",
+		},
+		{
+			name: "duplicate validator",
+			params: types.NewParams(0, types.BasketValidators{
+				types.NewBasketValidator(valAddr1, sdk.MustNewDecFromStr("0.5")),
+				types.NewBasketValidator(valAddr1, sdk.MustNewDecFromStr("0.5")),
+			}),
+			wantErr: "duplicate basket validator",
+		},
+		{
+			name: "weights do not sum to one",
+			params: types.NewParams(0, types.BasketValidators{
+				types.NewBasketValidator(valAddr1, sdk.MustNewDecFromStr("0.5")),
+				types.NewBasketValidator(valAddr2, sdk.MustNewDecFromStr("0.4")),
+			}),
+			wantErr: "weights must sum to 1",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewSlashEvent returns a new SlashEvent.
+func NewSlashEvent(
+	denom string, valAddr sdk.ValAddress, height int64, blockTime time.Time,
+	fraction sdk.Dec, tokensSlashed sdk.Coin, exchangeRate sdk.Dec,
+) SlashEvent {
+	return SlashEvent{
+		Denom:            denom,
+		ValidatorAddress: valAddr.String(),
+		Height:           height,
+		Time:             blockTime,
+		Fraction:         fraction,
+		TokensSlashed:    tokensSlashed,
+		ExchangeRate:     exchangeRate,
+	}
+}
+
+// Validate performs basic validation of a slash event.
+func (e SlashEvent) Validate() error {
+	if err := sdk.ValidateDenom(e.Denom); err != nil {
+		return fmt.Errorf("invalid slash event denom: %w", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(e.ValidatorAddress); err != nil {
+		return fmt.Errorf("invalid slash event validator address: %w", err)
+	}
+	if e.Height < 0 {
+		return fmt.Errorf("slash event height cannot be negative: %d", e.Height)
+	}
+	if e.Fraction.IsNil() || !e.Fraction.IsPositive() || e.Fraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash event fraction must be within (0, 1]: %s", e.Fraction)
+	}
+	if err := e.TokensSlashed.Validate(); err != nil {
+		return fmt.Errorf("invalid slash event tokens slashed: %w", err)
+	}
+	if e.ExchangeRate.IsNil() || e.ExchangeRate.IsNegative() {
+		return fmt.Errorf("slash event exchange rate cannot be negative: %s", e.ExchangeRate)
+	}
+	return nil
+}
+
+// SlashEvents is a slice of SlashEvent.
+type SlashEvents []SlashEvent
+
+// Validate performs basic validation of each slash event.
+func (es SlashEvents) Validate() error {
+	for _, e := range es {
+		if err := e.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewSlashHistory returns a slash history for a derivative denom with no slashes recorded.
+func NewSlashHistory(denom string, bondDenom string) SlashHistory {
+	return SlashHistory{
+		Denom:              denom,
+		SlashCount:         0,
+		CumulativeFraction: sdk.ZeroDec(),
+		TotalTokensSlashed: sdk.NewCoin(bondDenom, sdk.ZeroInt()),
+	}
+}
+
+// AddSlash returns the slash history updated with a new slash event.
+//
+// Slashes compound, so the cumulative fraction is the fraction of value lost after applying each slash in turn:
+// 1 - (1 - f1)(1 - f2)...(1 - fn).
+func (h SlashHistory) AddSlash(event SlashEvent) SlashHistory {
+	remaining := sdk.OneDec().Sub(h.CumulativeFraction).Mul(sdk.OneDec().Sub(event.Fraction))
+
+	h.SlashCount++
+	h.CumulativeFraction = sdk.OneDec().Sub(remaining)
+	h.TotalTokensSlashed = h.TotalTokensSlashed.Add(event.TokensSlashed)
+	return h
+}
@@ -45,7 +45,7 @@ func (m *MsgMintDerivative) Reset()         { *m = MsgMintDerivative{} }
 func (m *MsgMintDerivative) String() string { return proto.CompactTextString(m) }
 func (*MsgMintDerivative) ProtoMessage()    {}
 func (*MsgMintDerivative) Descriptor() ([]byte, []int) {
-	return fileDescriptor_738981106e50f269, []int{0}
+	return fileDescriptor_928a8dc767d67df5, []int{0}
 }
 func (m *MsgMintDerivative) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -105,7 +105,7 @@ func (m *MsgMintDerivativeResponse) Reset()         { *m = MsgMintDerivativeResp
 func (m *MsgMintDerivativeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMintDerivativeResponse) ProtoMessage()    {}
 func (*MsgMintDerivativeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_738981106e50f269, []int{1}
+	return fileDescriptor_928a8dc767d67df5, []int{1}
 }
 func (m *MsgMintDerivativeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -155,7 +155,7 @@ func (m *MsgBurnDerivative) Reset()         { *m = MsgBurnDerivative{} }
 func (m *MsgBurnDerivative) String() string { return proto.CompactTextString(m) }
 func (*MsgBurnDerivative) ProtoMessage()    {}
 func (*MsgBurnDerivative) Descriptor() ([]byte, []int) {
-	return fileDescriptor_738981106e50f269, []int{2}
+	return fileDescriptor_928a8dc767d67df5, []int{2}
 }
 func (m *MsgBurnDerivative) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -215,7 +215,7 @@ func (m *MsgBurnDerivativeResponse) Reset()         { *m = MsgBurnDerivativeResp
 func (m *MsgBurnDerivativeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBurnDerivativeResponse) ProtoMessage()    {}
 func (*MsgBurnDerivativeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_738981106e50f269, []int{3}
+	return fileDescriptor_928a8dc767d67df5, []int{3}
 }
 func (m *MsgBurnDerivativeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -244,44 +244,255 @@ func (m *MsgBurnDerivativeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgBurnDerivativeResponse proto.InternalMessageInfo
 
+// MsgMintBasketDerivative defines the Msg/MintBasketDerivative request type.
+type MsgMintBasketDerivative struct {
+	// sender is the owner of the tokens to be delegated
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// amount is the quantity of staking tokens to be delegated across the basket validators
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgMintBasketDerivative) Reset()         { *m = MsgMintBasketDerivative{} }
+func (m *MsgMintBasketDerivative) String() string { return proto.CompactTextString(m) }
+func (*MsgMintBasketDerivative) ProtoMessage()    {}
+func (*MsgMintBasketDerivative) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928a8dc767d67df5, []int{4}
+}
+func (m *MsgMintBasketDerivative) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMintBasketDerivative) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMintBasketDerivative.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMintBasketDerivative) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMintBasketDerivative.Merge(m, src)
+}
+func (m *MsgMintBasketDerivative) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMintBasketDerivative) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMintBasketDerivative.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMintBasketDerivative proto.InternalMessageInfo
+
+func (m *MsgMintBasketDerivative) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgMintBasketDerivative) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// MsgMintBasketDerivativeResponse defines the Msg/MintBasketDerivative response type.
+type MsgMintBasketDerivativeResponse struct {
+	// received is the amount of basket derivative minted and sent to the sender
+	Received types.Coin `protobuf:"bytes,1,opt,name=received,proto3" json:"received"`
+}
+
+func (m *MsgMintBasketDerivativeResponse) Reset()         { *m = MsgMintBasketDerivativeResponse{} }
+func (m *MsgMintBasketDerivativeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMintBasketDerivativeResponse) ProtoMessage()    {}
+func (*MsgMintBasketDerivativeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928a8dc767d67df5, []int{5}
+}
+func (m *MsgMintBasketDerivativeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMintBasketDerivativeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMintBasketDerivativeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMintBasketDerivativeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMintBasketDerivativeResponse.Merge(m, src)
+}
+func (m *MsgMintBasketDerivativeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMintBasketDerivativeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMintBasketDerivativeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMintBasketDerivativeResponse proto.InternalMessageInfo
+
+func (m *MsgMintBasketDerivativeResponse) GetReceived() types.Coin {
+	if m != nil {
+		return m.Received
+	}
+	return types.Coin{}
+}
+
+// MsgBurnBasketDerivative defines the Msg/BurnBasketDerivative request type.
+type MsgBurnBasketDerivative struct {
+	// sender is the owner of the basket derivative to be converted
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// amount is the quantity of basket derivative to be converted
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgBurnBasketDerivative) Reset()         { *m = MsgBurnBasketDerivative{} }
+func (m *MsgBurnBasketDerivative) String() string { return proto.CompactTextString(m) }
+func (*MsgBurnBasketDerivative) ProtoMessage()    {}
+func (*MsgBurnBasketDerivative) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928a8dc767d67df5, []int{6}
+}
+func (m *MsgBurnBasketDerivative) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBurnBasketDerivative) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBurnBasketDerivative.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBurnBasketDerivative) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurnBasketDerivative.Merge(m, src)
+}
+func (m *MsgBurnBasketDerivative) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBurnBasketDerivative) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurnBasketDerivative.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBurnBasketDerivative proto.InternalMessageInfo
+
+func (m *MsgBurnBasketDerivative) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgBurnBasketDerivative) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// MsgBurnBasketDerivativeResponse defines the Msg/BurnBasketDerivative response type.
+type MsgBurnBasketDerivativeResponse struct {
+	// received is the value in staked tokens of the delegations sent to the sender
+	Received types.Coin `protobuf:"bytes,1,opt,name=received,proto3" json:"received"`
+}
+
+func (m *MsgBurnBasketDerivativeResponse) Reset()         { *m = MsgBurnBasketDerivativeResponse{} }
+func (m *MsgBurnBasketDerivativeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBurnBasketDerivativeResponse) ProtoMessage()    {}
+func (*MsgBurnBasketDerivativeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_928a8dc767d67df5, []int{7}
+}
+func (m *MsgBurnBasketDerivativeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBurnBasketDerivativeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBurnBasketDerivativeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBurnBasketDerivativeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurnBasketDerivativeResponse.Merge(m, src)
+}
+func (m *MsgBurnBasketDerivativeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBurnBasketDerivativeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurnBasketDerivativeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBurnBasketDerivativeResponse proto.InternalMessageInfo
+
+func (m *MsgBurnBasketDerivativeResponse) GetReceived() types.Coin {
+	if m != nil {
+		return m.Received
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*MsgMintDerivative)(nil), "fury.liquid.v1beta1.MsgMintDerivative")
 	proto.RegisterType((*MsgMintDerivativeResponse)(nil), "fury.liquid.v1beta1.MsgMintDerivativeResponse")
 	proto.RegisterType((*MsgBurnDerivative)(nil), "fury.liquid.v1beta1.MsgBurnDerivative")
 	proto.RegisterType((*MsgBurnDerivativeResponse)(nil), "fury.liquid.v1beta1.MsgBurnDerivativeResponse")
+	proto.RegisterType((*MsgMintBasketDerivative)(nil), "fury.liquid.v1beta1.MsgMintBasketDerivative")
+	proto.RegisterType((*MsgMintBasketDerivativeResponse)(nil), "fury.liquid.v1beta1.MsgMintBasketDerivativeResponse")
+	proto.RegisterType((*MsgBurnBasketDerivative)(nil), "fury.liquid.v1beta1.MsgBurnBasketDerivative")
+	proto.RegisterType((*MsgBurnBasketDerivativeResponse)(nil), "fury.liquid.v1beta1.MsgBurnBasketDerivativeResponse")
 }
 
-func init() { proto.RegisterFile("fury/liquid/v1beta1/tx.proto", fileDescriptor_738981106e50f269) }
-
-var fileDescriptor_738981106e50f269 = []byte{
-	// 421 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x53, 0xbd, 0xae, 0xda, 0x30,
-	0x14, 0x8e, 0x7b, 0x2b, 0x54, 0x5c, 0xe9, 0x4a, 0x4d, 0xef, 0x10, 0xd0, 0x55, 0x40, 0x0c, 0x88,
-	0x25, 0x4e, 0xa1, 0x43, 0x87, 0x76, 0x69, 0xca, 0xca, 0x92, 0x2e, 0xa8, 0x4b, 0xe5, 0x24, 0x56,
-	0xb0, 0x00, 0x9b, 0xda, 0x4e, 0x44, 0xdf, 0xa2, 0x0f, 0xd0, 0xc7, 0xe0, 0x21, 0x18, 0x11, 0x53,
-	0xdb, 0x01, 0x55, 0xf0, 0x22, 0x55, 0x12, 0x13, 0xca, 0x9f, 0xc4, 0x78, 0x27, 0xdb, 0xe7, 0x3b,
-	0xdf, 0xf1, 0xf9, 0xbe, 0x63, 0xc3, 0xc7, 0x31, 0x4e, 0xb1, 0x3b, 0xa1, 0xdf, 0x12, 0x1a, 0xb9,
-	0x69, 0x37, 0x20, 0x0a, 0x77, 0x5d, 0x35, 0x47, 0x33, 0xc1, 0x15, 0x37, 0x5f, 0x67, 0x28, 0x2a,
-	0x50, 0xa4, 0xd1, 0xba, 0x1d, 0x72, 0x39, 0xe5, 0xd2, 0x0d, 0xb0, 0x24, 0x25, 0x25, 0xe4, 0x94,
-	0x15, 0xa4, 0x7a, 0xad, 0xc0, 0xbf, 0xe6, 0x27, 0xb7, 0x38, 0x68, 0xe8, 0x21, 0xe6, 0x31, 0x2f,
-	0xe2, 0xd9, 0xae, 0x88, 0xb6, 0x7e, 0x02, 0xf8, 0x6a, 0x20, 0xe3, 0x01, 0x65, 0xaa, 0x4f, 0x04,
-	0x4d, 0xb1, 0xa2, 0x29, 0x31, 0xdf, 0xc0, 0x8a, 0x24, 0x2c, 0x22, 0xc2, 0x02, 0x4d, 0xd0, 0xa9,
-	0x7a, 0xd6, 0x7a, 0xe1, 0x3c, 0xe8, 0x6a, 0x1f, 0xa3, 0x48, 0x10, 0x29, 0x3f, 0x2b, 0x41, 0x59,
-	0xec, 0xeb, 0x3c, 0xf3, 0x11, 0x56, 0x53, 0x3c, 0xa1, 0x11, 0x56, 0x5c, 0x58, 0xcf, 0x32, 0x92,
-	0x7f, 0x08, 0x98, 0xef, 0x60, 0x05, 0x4f, 0x79, 0xc2, 0x94, 0x75, 0xd7, 0x04, 0x9d, 0x97, 0xbd,
-	0x1a, 0xd2, 0xc5, 0x32, 0x1d, 0x7b, 0x71, 0xe8, 0x13, 0xa7, 0xcc, 0x7b, 0xbe, 0xdc, 0x34, 0x0c,
-	0x5f, 0xa7, 0xb7, 0x86, 0xb0, 0x76, 0xd6, 0x9d, 0x4f, 0xe4, 0x8c, 0x33, 0x49, 0xcc, 0xf7, 0xf0,
-	0x85, 0x20, 0x21, 0xa1, 0x29, 0x89, 0xf2, 0x3e, 0x6f, 0xa8, 0x5b, 0x12, 0xf6, 0xc2, 0xbd, 0x44,
-	0xb0, 0xa7, 0x28, 0x3c, 0xc9, 0x85, 0x1f, 0x77, 0x57, 0x0a, 0x1f, 0x9e, 0x08, 0xaf, 0x7a, 0x1f,
-	0x32, 0xf2, 0x9f, 0x4d, 0xa3, 0x1d, 0x53, 0x35, 0x4a, 0x02, 0x14, 0xf2, 0xa9, 0x9e, 0xbe, 0x5e,
-	0x1c, 0x19, 0x8d, 0x5d, 0xf5, 0x7d, 0x46, 0x24, 0xea, 0x93, 0x70, 0xbd, 0x70, 0xa0, 0x6e, 0xa4,
-	0x4f, 0xc2, 0x83, 0x2b, 0xbd, 0xdf, 0x00, 0xde, 0x0d, 0x64, 0x6c, 0x8e, 0xe0, 0xfd, 0xc9, 0x93,
-	0x68, 0xa3, 0x0b, 0xef, 0x11, 0x9d, 0x0d, 0xa7, 0x8e, 0x6e, 0xcb, 0x2b, 0xb5, 0x8c, 0xe0, 0xfd,
-	0xc9, 0x0c, 0xae, 0xde, 0x74, 0x9c, 0x77, 0xfd, 0xa6, 0xcb, 0xae, 0x79, 0xde, 0x72, 0x6b, 0x83,
-	0xd5, 0xd6, 0x06, 0x7f, 0xb7, 0x36, 0xf8, 0xb1, 0xb3, 0x8d, 0xd5, 0xce, 0x36, 0x7e, 0xed, 0x6c,
-	0xe3, 0x4b, 0xe7, 0x3f, 0xd7, 0xb2, 0x9a, 0xce, 0x04, 0x07, 0x32, 0xdf, 0xb9, 0xf3, 0xfd, 0xff,
-	0xcc, 0xbd, 0x0b, 0x2a, 0xf9, 0xaf, 0x79, 0xfb, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x40, 0x82, 0xe3,
-	0xbf, 0xbb, 0x03, 0x00, 0x00,
+func init() { proto.RegisterFile("fury/liquid/v1beta1/tx.proto", fileDescriptor_928a8dc767d67df5) }
+
+var fileDescriptor_928a8dc767d67df5 = []byte{
+	// 502 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xbd, 0x6e, 0x13, 0x41,
+	0x10, 0xf6, 0xc6, 0xc8, 0xc2, 0x8b, 0x14, 0x89, 0xc3, 0x12, 0xe7, 0x53, 0x74, 0x8e, 0x52, 0x44,
+	0x29, 0xe2, 0x3d, 0x12, 0x90, 0x28, 0xa0, 0xe1, 0x70, 0x41, 0xe3, 0xc6, 0x34, 0x11, 0x05, 0xe8,
+	0x7e, 0x86, 0xf3, 0xca, 0xf1, 0xae, 0xd9, 0x9f, 0x23, 0xa1, 0xe6, 0x01, 0x78, 0x00, 0x1e, 0x23,
+	0x0f, 0x91, 0x32, 0xa4, 0x42, 0x14, 0x11, 0xb2, 0x5f, 0x04, 0x9d, 0x6f, 0x7d, 0x31, 0xb6, 0x63,
+	0x39, 0xc2, 0x12, 0xa9, 0xee, 0x76, 0xe7, 0x9b, 0x9d, 0xef, 0x9b, 0x9d, 0xd9, 0xc1, 0x5b, 0x1f,
+	0xb5, 0x38, 0xf5, 0x8e, 0xe9, 0x27, 0x4d, 0x63, 0x2f, 0x3d, 0x08, 0x41, 0x05, 0x07, 0x9e, 0x3a,
+	0x21, 0x03, 0xc1, 0x15, 0xb7, 0x1e, 0x65, 0x56, 0x92, 0x5b, 0x89, 0xb1, 0x3a, 0x6e, 0xc4, 0x65,
+	0x9f, 0x4b, 0x2f, 0x0c, 0x24, 0x14, 0x2e, 0x11, 0xa7, 0x2c, 0x77, 0x72, 0xea, 0xb9, 0xfd, 0xc3,
+	0x78, 0xe5, 0xe5, 0x0b, 0x63, 0xaa, 0x25, 0x3c, 0xe1, 0xf9, 0x7e, 0xf6, 0x97, 0xef, 0xee, 0x7c,
+	0x47, 0xf8, 0x61, 0x5b, 0x26, 0x6d, 0xca, 0x54, 0x0b, 0x04, 0x4d, 0x03, 0x45, 0x53, 0xb0, 0x9e,
+	0xe0, 0x8a, 0x04, 0x16, 0x83, 0xb0, 0xd1, 0x36, 0xda, 0xab, 0xfa, 0xf6, 0xe5, 0x59, 0xb3, 0x66,
+	0x4e, 0x7b, 0x15, 0xc7, 0x02, 0xa4, 0x7c, 0xab, 0x04, 0x65, 0x49, 0xc7, 0xe0, 0xac, 0x2d, 0x5c,
+	0x4d, 0x83, 0x63, 0x1a, 0x07, 0x8a, 0x0b, 0x7b, 0x23, 0x73, 0xea, 0x5c, 0x6f, 0x58, 0xcf, 0x71,
+	0x25, 0xe8, 0x73, 0xcd, 0x94, 0x5d, 0xde, 0x46, 0x7b, 0x0f, 0x0e, 0xeb, 0xc4, 0x1c, 0x96, 0xe9,
+	0x98, 0x88, 0x23, 0xaf, 0x39, 0x65, 0xfe, 0xbd, 0xf3, 0xab, 0x46, 0xa9, 0x63, 0xe0, 0x3b, 0x47,
+	0xb8, 0x3e, 0xc7, 0xae, 0x03, 0x72, 0xc0, 0x99, 0x04, 0xeb, 0x05, 0xbe, 0x2f, 0x20, 0x02, 0x9a,
+	0x42, 0x6c, 0xa3, 0xd5, 0xce, 0x2d, 0x1c, 0x26, 0xc2, 0x7d, 0x2d, 0xd8, 0x5d, 0x14, 0xae, 0x71,
+	0x7d, 0x8e, 0x5d, 0x21, 0xfc, 0x68, 0x46, 0x78, 0xd5, 0x7f, 0x99, 0x39, 0xff, 0xba, 0x6a, 0xec,
+	0x26, 0x54, 0x75, 0x75, 0x48, 0x22, 0xde, 0x37, 0xb7, 0x6f, 0x3e, 0x4d, 0x19, 0xf7, 0x3c, 0x75,
+	0x3a, 0x00, 0x49, 0x5a, 0x10, 0x5d, 0x9e, 0x35, 0xb1, 0x21, 0xd2, 0x82, 0x68, 0x2a, 0x2b, 0x5f,
+	0x11, 0x7e, 0x6c, 0x12, 0xee, 0x07, 0xb2, 0x07, 0xff, 0x56, 0x14, 0xd7, 0xea, 0x37, 0x6e, 0xa7,
+	0xfe, 0x3d, 0x6e, 0xdc, 0xc0, 0x62, 0x3d, 0x97, 0x6f, 0x64, 0x66, 0xe9, 0xfd, 0xff, 0x32, 0x17,
+	0xb1, 0x58, 0x8b, 0xcc, 0xc3, 0x1f, 0x65, 0x5c, 0x6e, 0xcb, 0xc4, 0xea, 0xe2, 0xcd, 0x99, 0x06,
+	0xdf, 0x25, 0x0b, 0x5e, 0x17, 0x32, 0xd7, 0x6a, 0x0e, 0x59, 0x0d, 0x57, 0xd0, 0xed, 0xe2, 0xcd,
+	0x99, 0x8e, 0xba, 0x31, 0xd2, 0xdf, 0x38, 0x87, 0xac, 0x86, 0x2b, 0x22, 0x7d, 0xc1, 0xb5, 0x85,
+	0x55, 0xba, 0xbf, 0x8c, 0xf1, 0x2c, 0xda, 0x79, 0x76, 0x1b, 0xf4, 0x74, 0xec, 0x85, 0xa5, 0xb3,
+	0xbf, 0x4c, 0xc3, 0xea, 0xb1, 0x97, 0x15, 0x84, 0xff, 0xe6, 0x7c, 0xe8, 0xa2, 0x8b, 0xa1, 0x8b,
+	0x7e, 0x0f, 0x5d, 0xf4, 0x6d, 0xe4, 0x96, 0x2e, 0x46, 0x6e, 0xe9, 0xe7, 0xc8, 0x2d, 0xbd, 0x23,
+	0x53, 0xbd, 0x4f, 0x59, 0xa4, 0x43, 0x2d, 0x9b, 0x0c, 0xd4, 0x67, 0x2e, 0x7a, 0xde, 0x78, 0xd2,
+	0x9c, 0x4c, 0x66, 0xcd, 0xf8, 0x1d, 0x08, 0x2b, 0xe3, 0x09, 0xf0, 0xf4, 0xcf, 0x00, 0x4e, 0xbe,
+	0xb2, 0xc2, 0x87, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -300,6 +511,12 @@ type MsgClient interface {
 	MintDerivative(ctx context.Context, in *MsgMintDerivative, opts ...grpc.CallOption) (*MsgMintDerivativeResponse, error)
 	// BurnDerivative defines a method for converting staking deriviatives into a delegation.
 	BurnDerivative(ctx context.Context, in *MsgBurnDerivative, opts ...grpc.CallOption) (*MsgBurnDerivativeResponse, error)
+	// MintBasketDerivative defines a method for delegating tokens across the basket validators in exchange for
+	// the basket derivative.
+	MintBasketDerivative(ctx context.Context, in *MsgMintBasketDerivative, opts ...grpc.CallOption) (*MsgMintBasketDerivativeResponse, error)
+	// BurnBasketDerivative defines a method for converting the basket derivative into delegations to the basket
+	// validators.
+	BurnBasketDerivative(ctx context.Context, in *MsgBurnBasketDerivative, opts ...grpc.CallOption) (*MsgBurnBasketDerivativeResponse, error)
 }
 
 type msgClient struct {
@@ -328,12 +545,36 @@ func (c *msgClient) BurnDerivative(ctx context.Context, in *MsgBurnDerivative, o
 	return out, nil
 }
 
+func (c *msgClient) MintBasketDerivative(ctx context.Context, in *MsgMintBasketDerivative, opts ...grpc.CallOption) (*MsgMintBasketDerivativeResponse, error) {
+	out := new(MsgMintBasketDerivativeResponse)
+	err := c.cc.Invoke(ctx, "/fury.liquid.v1beta1.Msg/MintBasketDerivative", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BurnBasketDerivative(ctx context.Context, in *MsgBurnBasketDerivative, opts ...grpc.CallOption) (*MsgBurnBasketDerivativeResponse, error) {
+	out := new(MsgBurnBasketDerivativeResponse)
+	err := c.cc.Invoke(ctx, "/fury.liquid.v1beta1.Msg/BurnBasketDerivative", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// MintDerivative defines a method for converting a delegation into staking deriviatives.
 	MintDerivative(context.Context, *MsgMintDerivative) (*MsgMintDerivativeResponse, error)
 	// BurnDerivative defines a method for converting staking deriviatives into a delegation.
 	BurnDerivative(context.Context, *MsgBurnDerivative) (*MsgBurnDerivativeResponse, error)
+	// MintBasketDerivative defines a method for delegating tokens across the basket validators in exchange for
+	// the basket derivative.
+	MintBasketDerivative(context.Context, *MsgMintBasketDerivative) (*MsgMintBasketDerivativeResponse, error)
+	// BurnBasketDerivative defines a method for converting the basket derivative into delegations to the basket
+	// validators.
+	BurnBasketDerivative(context.Context, *MsgBurnBasketDerivative) (*MsgBurnBasketDerivativeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -346,6 +587,12 @@ func (*UnimplementedMsgServer) MintDerivative(ctx context.Context, req *MsgMintD
 func (*UnimplementedMsgServer) BurnDerivative(ctx context.Context, req *MsgBurnDerivative) (*MsgBurnDerivativeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BurnDerivative not implemented")
 }
+func (*UnimplementedMsgServer) MintBasketDerivative(ctx context.Context, req *MsgMintBasketDerivative) (*MsgMintBasketDerivativeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintBasketDerivative not implemented")
+}
+func (*UnimplementedMsgServer) BurnBasketDerivative(ctx context.Context, req *MsgBurnBasketDerivative) (*MsgBurnBasketDerivativeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnBasketDerivative not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -387,6 +634,42 @@ func _Msg_BurnDerivative_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MintBasketDerivative_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintBasketDerivative)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MintBasketDerivative(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.liquid.v1beta1.Msg/MintBasketDerivative",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MintBasketDerivative(ctx, req.(*MsgMintBasketDerivative))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BurnBasketDerivative_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurnBasketDerivative)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BurnBasketDerivative(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fury.liquid.v1beta1.Msg/BurnBasketDerivative",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BurnBasketDerivative(ctx, req.(*MsgBurnBasketDerivative))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "fury.liquid.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -399,6 +682,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BurnDerivative",
 			Handler:    _Msg_BurnDerivative_Handler,
 		},
+		{
+			MethodName: "MintBasketDerivative",
+			Handler:    _Msg_MintBasketDerivative_Handler,
+		},
+		{
+			MethodName: "BurnBasketDerivative",
+			Handler:    _Msg_BurnBasketDerivative_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "fury/liquid/v1beta1/tx.proto",
@@ -564,84 +855,512 @@ func (m *MsgBurnDerivativeResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgMintBasketDerivative) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgMintDerivative) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgMintBasketDerivative) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintBasketDerivative) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMintDerivativeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMintBasketDerivativeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Received.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgBurnDerivative) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgMintBasketDerivativeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintBasketDerivativeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Received.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnBasketDerivative) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnBasketDerivative) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnBasketDerivative) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnBasketDerivativeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnBasketDerivativeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnBasketDerivativeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Received.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgMintDerivative) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMintDerivativeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Received.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBurnDerivative) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBurnDerivativeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Received.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMintBasketDerivative) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMintBasketDerivativeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Received.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBurnBasketDerivative) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBurnBasketDerivativeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Received.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgMintDerivative) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintDerivative: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintDerivative: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintDerivativeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintDerivativeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintDerivativeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Received.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgBurnDerivativeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Received.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgMintDerivative) Unmarshal(dAtA []byte) error {
+func (m *MsgBurnDerivative) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -664,10 +1383,10 @@ func (m *MsgMintDerivative) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintDerivative: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurnDerivative: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintDerivative: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurnDerivative: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -788,7 +1507,7 @@ func (m *MsgMintDerivative) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintDerivativeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBurnDerivativeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -811,17 +1530,17 @@ func (m *MsgMintDerivativeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintDerivativeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurnDerivativeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintDerivativeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurnDerivativeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -831,15 +1550,16 @@ func (m *MsgMintDerivativeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
@@ -871,7 +1591,7 @@ func (m *MsgMintDerivativeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnDerivative) Unmarshal(dAtA []byte) error {
+func (m *MsgMintBasketDerivative) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -894,10 +1614,10 @@ func (m *MsgBurnDerivative) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnDerivative: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMintBasketDerivative: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnDerivative: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMintBasketDerivative: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -934,7 +1654,173 @@ func (m *MsgBurnDerivative) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintBasketDerivativeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintBasketDerivativeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintBasketDerivativeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Received.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnBasketDerivative) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnBasketDerivative: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnBasketDerivative: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -962,9 +1848,9 @@ func (m *MsgBurnDerivative) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -1018,7 +1904,7 @@ func (m *MsgBurnDerivative) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnDerivativeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBurnBasketDerivativeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1041,17 +1927,17 @@ func (m *MsgBurnDerivativeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnDerivativeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurnBasketDerivativeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnDerivativeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurnBasketDerivativeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1061,16 +1947,15 @@ func (m *MsgBurnDerivativeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
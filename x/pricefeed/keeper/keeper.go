@@ -24,11 +24,15 @@ type Keeper struct {
 	cdc codec.Codec
 	// The reference to the Paramstore to get and set pricefeed specific params
 	paramSubspace paramtypes.Subspace
+	// The liquid and staking keepers used to price staking derivative markets, which may be nil
+	liquidKeeper  types.LiquidKeeper
+	stakingKeeper types.StakingKeeper
 }
 
 // NewKeeper returns a new keeper for the pricefeed module.
 func NewKeeper(
-	cdc codec.Codec, key storetypes.StoreKey, paramstore paramtypes.Subspace,
+	cdc codec.Codec, key storetypes.StoreKey, paramstore paramtypes.Subspace, lk types.LiquidKeeper,
+	sk types.StakingKeeper,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
@@ -38,6 +42,8 @@ func NewKeeper(
 		cdc:           cdc,
 		key:           key,
 		paramSubspace: paramstore,
+		liquidKeeper:  lk,
+		stakingKeeper: sk,
 	}
 }
 
@@ -79,19 +85,24 @@ func (k Keeper) SetPrice(
 	return newRawPrice, nil
 }
 
-// SetCurrentPrices updates the price of an asset to the median of all valid oracle inputs
+// SetCurrentPrices updates the price of an asset to the median of all valid oracle inputs.
+// Staking derivative markets are updated to the derivative's exchange rate instead.
 func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
-	_, ok := k.GetMarket(ctx, marketID)
+	market, ok := k.GetMarket(ctx, marketID)
 	if !ok {
 		return errorsmod.Wrap(types.ErrInvalidMarket, marketID)
 	}
 	// store current price
 	validPrevPrice := true
-	prevPrice, err := k.GetCurrentPrice(ctx, marketID)
+	prevPrice, err := k.getStoredCurrentPrice(ctx, marketID)
 	if err != nil {
 		validPrevPrice = false
 	}
 
+	if k.isDerivativeMarket(ctx, market) {
+		return k.setDerivativePrice(ctx, market, prevPrice, validPrevPrice)
+	}
+
 	prices := k.GetRawPrices(ctx, marketID)
 
 	var notExpiredPrices []types.CurrentPrice
@@ -131,6 +142,49 @@ func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
 	return nil
 }
 
+// setDerivativePrice updates the price of a staking derivative market to the derivative's exchange rate.
+func (k Keeper) setDerivativePrice(ctx sdk.Context, market types.Market, prevPrice types.CurrentPrice, validPrevPrice bool) error {
+	currentPrice, err := k.getDerivativePrice(ctx, market)
+	if err != nil {
+		k.setCurrentPrice(ctx, market.MarketID, types.CurrentPrice{})
+		return err
+	}
+
+	if validPrevPrice && !currentPrice.Price.Equal(prevPrice.Price) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMarketPriceUpdated,
+				sdk.NewAttribute(types.AttributeMarketID, market.MarketID),
+				sdk.NewAttribute(types.AttributeMarketPrice, currentPrice.Price.String()),
+			),
+		)
+	}
+
+	k.setCurrentPrice(ctx, market.MarketID, currentPrice)
+	return nil
+}
+
+// isDerivativeMarket returns true if a market is active, its base asset is a staking derivative, and its quote asset
+// is the staking token.
+//
+// The price of a derivative market is the amount of staked tokens one unit of the derivative is worth, so derivative
+// markets quoted in other assets, such as usd, use oracle prices.
+func (k Keeper) isDerivativeMarket(ctx sdk.Context, market types.Market) bool {
+	if k.liquidKeeper == nil || k.stakingKeeper == nil || !market.Active {
+		return false
+	}
+	return k.liquidKeeper.IsDerivativeDenom(ctx, market.BaseAsset) && market.QuoteAsset == k.stakingKeeper.BondDenom(ctx)
+}
+
+// getDerivativePrice returns the exchange rate of a derivative market's staking derivative as its current price.
+func (k Keeper) getDerivativePrice(ctx sdk.Context, market types.Market) (types.CurrentPrice, error) {
+	rate, err := k.liquidKeeper.GetExchangeRate(ctx, market.BaseAsset)
+	if err != nil || !rate.IsPositive() {
+		return types.CurrentPrice{}, types.ErrNoValidPrice
+	}
+	return types.NewCurrentPrice(market.MarketID, rate), nil
+}
+
 func (k Keeper) setCurrentPrice(ctx sdk.Context, marketID string, currentPrice types.CurrentPrice) {
 	store := ctx.KVStore(k.key)
 	store.Set(types.CurrentPriceKey(marketID), k.cdc.MustMarshal(&currentPrice))
@@ -163,8 +217,20 @@ func (k Keeper) calculateMeanPrice(priceA, priceB types.CurrentPrice) sdk.Dec {
 	return mean
 }
 
-// GetCurrentPrice fetches the current median price of all oracles for a specific market
+// GetCurrentPrice fetches the current median price of all oracles for a specific market.
+//
+// The price of a staking derivative market is read from the derivative's exchange rate when requested, so changes such
+// as validator slashes are reflected immediately rather than at the end of the block.
 func (k Keeper) GetCurrentPrice(ctx sdk.Context, marketID string) (types.CurrentPrice, error) {
+	if market, found := k.GetMarket(ctx, marketID); found && k.isDerivativeMarket(ctx, market) {
+		return k.getDerivativePrice(ctx, market)
+	}
+
+	return k.getStoredCurrentPrice(ctx, marketID)
+}
+
+// getStoredCurrentPrice fetches the current price of a market last stored by SetCurrentPrices
+func (k Keeper) getStoredCurrentPrice(ctx sdk.Context, marketID string) (types.CurrentPrice, error) {
 	store := ctx.KVStore(k.key)
 	bz := store.Get(types.CurrentPriceKey(marketID))
 
@@ -236,3 +236,38 @@ func TestKeeper_ExpiredSetCurrentPrices(t *testing.T) {
 	_, err = keeper.GetCurrentPrice(ctx, "tstusd")
 	require.ErrorIs(t, types.ErrNoValidPrice, err, "current prices should be invalid")
 }
+
+// TestKeeper_DerivativeMarkets tests that only derivative markets quoted in the staking token are priced at the
+// derivative's exchange rate
+func TestKeeper_DerivativeMarkets(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(true, tmprototypes.Header{})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	bondDenom := tApp.GetStakingKeeper().BondDenom(ctx)
+	basketDenom := tApp.GetLiquidKeeper().GetBasketDenom()
+	mp := types.Params{
+		Markets: []types.Market{
+			{MarketID: "basket:fury", BaseAsset: basketDenom, QuoteAsset: bondDenom, Oracles: []sdk.AccAddress{addrs[0]}, Active: true},
+			{MarketID: "basket:usd", BaseAsset: basketDenom, QuoteAsset: "usd", Oracles: []sdk.AccAddress{addrs[0]}, Active: true},
+		},
+	}
+	keeper.SetParams(ctx, mp)
+
+	for _, market := range mp.Markets {
+		_, err := keeper.SetPrice(ctx, addrs[0], market.MarketID, sdk.MustNewDecFromStr("2.5"), time.Now().UTC().Add(1*time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, keeper.SetCurrentPrices(ctx, market.MarketID))
+	}
+
+	// The basket has no supply, so its exchange rate is one
+	price, err := keeper.GetCurrentPrice(ctx, "basket:fury")
+	require.NoError(t, err)
+	require.Equal(t, sdk.OneDec(), price.Price)
+
+	price, err = keeper.GetCurrentPrice(ctx, "basket:usd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("2.5"), price.Price)
+}
@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LiquidKeeper defines the expected interface needed to price staking derivatives
+type LiquidKeeper interface {
+	IsDerivativeDenom(ctx sdk.Context, denom string) bool
+	GetExchangeRate(ctx sdk.Context, derivativeDenom string) (sdk.Dec, error)
+}
+
+// StakingKeeper defines the expected interface needed to find the staking token of derivative markets
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) string
+}
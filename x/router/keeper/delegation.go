@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	earntypes "github.com/incubus-network/fury/x/earn/types"
+)
+
+// Delegate delegates bond denom tokens from an account to a validator. Vesting tokens can be delegated, and remain
+// vesting when they are undelegated.
+func (k Keeper) Delegate(ctx sdk.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) (sdk.Dec, error) {
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Dec{}, stakingtypes.ErrNoValidatorFound
+	}
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	if amount.Denom != bondDenom {
+		return sdk.Dec{}, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", amount.Denom, bondDenom,
+		)
+	}
+	newShares, err := k.stakingKeeper.Delegate(ctx, delegator, amount.Amount, stakingtypes.Unbonded, validator, true)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			stakingtypes.EventTypeDelegate,
+			sdk.NewAttribute(stakingtypes.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(stakingtypes.AttributeKeyNewShares, newShares.String()),
+		),
+	)
+	return newShares, nil
+}
+
+// DelegateMintDeposit delegates tokens to a validator, then converts them into staking derivatives,
+// then deposits them to an earn vault.
+func (k Keeper) DelegateMintDeposit(ctx sdk.Context, depositor sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) error {
+	if _, err := k.Delegate(ctx, depositor, valAddr, amount); err != nil {
+		return err
+	}
+
+	derivativeMinted, err := k.liquidKeeper.MintDerivative(ctx, depositor, valAddr, amount)
+	if err != nil {
+		return err
+	}
+
+	return k.earnKeeper.Deposit(ctx, depositor, derivativeMinted, earntypes.STRATEGY_TYPE_SAVINGS)
+}
@@ -0,0 +1,12 @@
+package savings
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fury/x/savings/keeper"
+)
+
+// BeginBlocker unlocks deposits that reached the maturity of their lockup term
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.UnlockMaturedDeposits(ctx)
+}
@@ -14,6 +14,11 @@ import (
 	"github.com/incubus-network/fury/x/savings/types"
 )
 
+const (
+	flagLockupTerm      = "lockup-term"
+	flagEarlyWithdrawal = "early-withdrawal"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	savingsTxCmd := &cobra.Command{
@@ -39,11 +44,12 @@ func GetTxCmd() *cobra.Command {
 }
 
 func getCmdDeposit() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "deposit [amount]",
 		Short: "deposit coins to savings",
 		Example: fmt.Sprintf(
-			`%s tx %s deposit 10000000ufury,100000000usdx --from <key>`, version.AppName, types.ModuleName,
+			`%[1]s tx %[2]s deposit 10000000ufury,100000000usdx --from <key>
+%[1]s tx %[2]s deposit 100000000usdx --%[3]s 2160h --from <key>`, version.AppName, types.ModuleName, flagLockupTerm,
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -55,21 +61,34 @@ func getCmdDeposit() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			lockupTerm, err := cmd.Flags().GetDuration(flagLockupTerm)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgDeposit(clientCtx.GetFromAddress(), amount)
+			if lockupTerm != 0 {
+				msg = types.NewMsgDepositWithLockup(clientCtx.GetFromAddress(), amount, lockupTerm)
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+
+	cmd.Flags().Duration(flagLockupTerm, 0, "(optional) lock the deposit for one of the lockup terms in params")
+
+	return cmd
 }
 
 func getCmdWithdraw() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "withdraw [amount]",
 		Short: "withdraw coins from savings",
 		Example: fmt.Sprintf(
-			`%s tx %s withdraw 10000000ufury,100000000usdx --from <key>`, version.AppName, types.ModuleName,
+			`%[1]s tx %[2]s withdraw 10000000ufury,100000000usdx --from <key>
+%[1]s tx %[2]s withdraw 100000000usdx --%[3]s --from <key>`, version.AppName, types.ModuleName, flagEarlyWithdrawal,
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -81,11 +100,23 @@ func getCmdWithdraw() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			early, err := cmd.Flags().GetBool(flagEarlyWithdrawal)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgWithdraw(clientCtx.GetFromAddress(), amount)
+			if early {
+				msg = types.NewMsgEarlyWithdraw(clientCtx.GetFromAddress(), amount)
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+
+	cmd.Flags().Bool(flagEarlyWithdrawal, false, "(optional) withdraw locked deposits before maturity, paying the early withdrawal penalty")
+
+	return cmd
 }
@@ -18,7 +18,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, ak types.AccountKeeper, gs ty
 	k.SetParams(ctx, gs.Params)
 
 	for _, deposit := range gs.Deposits {
-		k.SetDeposit(ctx, deposit)
+		k.InitializeDeposit(ctx, deposit)
+	}
+
+	for _, pool := range gs.PenaltyPools {
+		k.SetPenaltyPool(ctx, pool)
 	}
 
 	// check if the module account exists
@@ -32,5 +36,6 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, ak types.AccountKeeper, gs ty
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	params := k.GetParams(ctx)
 	deposits := k.GetAllDeposits(ctx)
-	return types.NewGenesisState(params, deposits)
+	penaltyPools := k.GetAllPenaltyPools(ctx)
+	return types.NewGenesisState(params, deposits, penaltyPools)
 }
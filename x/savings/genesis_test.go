@@ -41,6 +41,7 @@ func (suite *GenesisTestSuite) SetupTest() {
 func (suite *GenesisTestSuite) TestInitExportGenesis() {
 	params := types.NewParams(
 		[]string{"btc", "ufury", "bnb"},
+		nil,
 	)
 
 	depositAmt := sdk.NewCoins(sdk.NewCoin("ufury", sdkmath.NewInt(1e8)))
@@ -51,7 +52,7 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 			depositAmt, // 100 ufury
 		),
 	}
-	savingsGenesis := types.NewGenesisState(params, deposits)
+	savingsGenesis := types.NewGenesisState(params, deposits, nil)
 
 	authBuilder := app.NewAuthBankGenesisBuilder().
 		WithSimpleModuleAccount(types.ModuleAccountName, depositAmt)
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -10,6 +12,21 @@ import (
 
 // Deposit deposit
 func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error {
+	return k.deposit(ctx, depositor, coins, nil)
+}
+
+// DepositWithLockup deposits coins and locks them for one of the lockup terms
+// in params
+func (k Keeper) DepositWithLockup(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins, lockupTerm time.Duration) error {
+	term, found := k.GetParams(ctx).LockupTerms.Get(lockupTerm)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidLockupTerm, ": %s", lockupTerm)
+	}
+
+	return k.deposit(ctx, depositor, coins, &term)
+}
+
+func (k Keeper) deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins, lockupTerm *types.LockupTerm) error {
 	err := k.ValidateDeposit(ctx, coins)
 	if err != nil {
 		return err
@@ -21,13 +38,43 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coi
 	}
 
 	currDeposit, foundDeposit := k.GetDeposit(ctx, depositor)
+	if foundDeposit {
+		currDeposit = k.syncDepositPenalties(ctx, currDeposit)
+	}
 
 	deposit := types.NewDeposit(depositor, coins)
 	if foundDeposit {
 		deposit.Amount = deposit.Amount.Add(currDeposit.Amount...)
+		deposit.Locks = currDeposit.Locks
+		deposit.PenaltyIndexes = currDeposit.PenaltyIndexes
 		k.BeforeSavingsDepositModified(ctx, deposit, setDifference(getDenoms(coins), getDenoms(deposit.Amount)))
 
 	}
+	deposit = k.initializePenaltyIndexes(ctx, deposit, setDifference(getDenoms(coins), getDenoms(currDeposit.Amount)))
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+		sdk.NewAttribute(types.AttributeKeyDepositor, deposit.Depositor.String()),
+	}
+
+	if lockupTerm != nil {
+		maturity := ctx.BlockTime().Add(lockupTerm.Duration)
+
+		locks := make(types.Locks, len(deposit.Locks), len(deposit.Locks)+len(coins))
+		copy(locks, deposit.Locks)
+		for _, coin := range coins {
+			locks = append(locks, types.NewLock(coin, *lockupTerm, maturity))
+		}
+
+		k.updateLockBonusShares(ctx, deposit.Locks, locks)
+		deposit.Locks = locks
+		k.insertLockMaturity(ctx, maturity, depositor)
+
+		attributes = append(attributes,
+			sdk.NewAttribute(types.AttributeKeyLockupTerm, lockupTerm.Duration.String()),
+			sdk.NewAttribute(types.AttributeKeyMaturity, maturity.String()),
+		)
+	}
 
 	k.SetDeposit(ctx, deposit)
 
@@ -36,11 +83,7 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coi
 	}
 
 	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeSavingsDeposit,
-			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
-			sdk.NewAttribute(types.AttributeKeyDepositor, deposit.Depositor.String()),
-		),
+		sdk.NewEvent(types.EventTypeSavingsDeposit, attributes...),
 	)
 
 	return nil
@@ -162,8 +162,9 @@ func (suite *KeeperTestSuite) TestDeposit() {
 				[]sdk.AccAddress{tc.args.depositor},
 			)
 			savingsGS := types.NewGenesisState(
-				types.NewParams(tc.args.allowedDenoms),
+				types.NewParams(tc.args.allowedDenoms, nil),
 				types.Deposits{},
+				nil,
 			)
 
 			stakingParams := stakingtypes.DefaultParams()
@@ -58,10 +58,14 @@ func (s queryServer) Deposits(ctx context.Context, req *types.QueryDepositsReque
 		}
 	}
 
+	// Deposits are returned with their share of early withdrawal penalties,
+	// synced in a cache context that is discarded
+	syncCtx, _ := sdkCtx.CacheContext()
+
 	var deposits types.Deposits
 	switch {
 	case hasOwner && hasDenom:
-		deposit, found := s.keeper.GetDeposit(sdkCtx, owner)
+		deposit, found := s.keeper.GetSyncedDeposit(sdkCtx, owner)
 		if found {
 			for _, coin := range deposit.Amount {
 				if coin.Denom == req.Denom {
@@ -70,20 +74,20 @@ func (s queryServer) Deposits(ctx context.Context, req *types.QueryDepositsReque
 			}
 		}
 	case hasOwner:
-		deposit, found := s.keeper.GetDeposit(sdkCtx, owner)
+		deposit, found := s.keeper.GetSyncedDeposit(sdkCtx, owner)
 		if found {
 			deposits = append(deposits, deposit)
 		}
 	case hasDenom:
 		s.keeper.IterateDeposits(sdkCtx, func(deposit types.Deposit) (stop bool) {
 			if deposit.Amount.AmountOf(req.Denom).IsPositive() {
-				deposits = append(deposits, deposit)
+				deposits = append(deposits, s.keeper.syncDepositPenalties(syncCtx, deposit))
 			}
 			return false
 		})
 	default:
 		s.keeper.IterateDeposits(sdkCtx, func(deposit types.Deposit) (stop bool) {
-			deposits = append(deposits, deposit)
+			deposits = append(deposits, s.keeper.syncDepositPenalties(syncCtx, deposit))
 			return false
 		})
 	}
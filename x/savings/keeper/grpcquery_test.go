@@ -25,6 +25,8 @@ var dep = types.NewDeposit
 const (
 	bfury1 = "bfury-furyvaloper15gqc744d05xacn4n6w2furuads9fu4pqn6zxlu"
 	bfury2 = "bfury-furyvaloper15qdefkmwswysgg4qxgqpqr35k3m49pkx8yhpte"
+
+	lockupTerm = 90 * 24 * time.Hour
 )
 
 type grpcQueryTestSuite struct {
@@ -59,7 +61,7 @@ func (suite *grpcQueryTestSuite) SetupTest() {
 	suite.Require().NoError(err)
 
 	savingsGenesis := types.GenesisState{
-		Params: types.NewParams([]string{"bnb", "busd", bfury1, bfury2}),
+		Params: types.NewParams([]string{"bnb", "busd", bfury1, bfury2}, testLockupTerms()),
 	}
 	savingsGenState := app.GenesisState{types.ModuleName: suite.tApp.AppCodec().MustMarshalJSON(&savingsGenesis)}
 
@@ -82,7 +84,7 @@ func (suite *grpcQueryTestSuite) TestGrpcQueryParams() {
 
 	var expected types.GenesisState
 	savingsGenesis := types.GenesisState{
-		Params: types.NewParams([]string{"bnb", "busd", bfury1, bfury2}),
+		Params: types.NewParams([]string{"bnb", "busd", bfury1, bfury2}, testLockupTerms()),
 	}
 	savingsGenState := app.GenesisState{types.ModuleName: suite.tApp.AppCodec().MustMarshalJSON(&savingsGenesis)}
 	suite.tApp.AppCodec().MustUnmarshalJSON(savingsGenState[types.ModuleName], &expected)
@@ -352,3 +354,11 @@ func (suite *grpcQueryTestSuite) slashValidator(address sdk.ValAddress, slashFra
 func TestGrpcQueryTestSuite(t *testing.T) {
 	suite.Run(t, new(grpcQueryTestSuite))
 }
+
+// testLockupTerms returns a 90 day lockup term with a 2x reward multiplier and
+// a 10% early withdrawal penalty.
+func testLockupTerms() types.LockupTerms {
+	return types.LockupTerms{
+		types.NewLockupTerm(lockupTerm, sdk.NewDec(2), sdk.MustNewDecFromStr("0.1")),
+	}
+}
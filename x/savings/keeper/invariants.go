@@ -10,6 +10,7 @@ import (
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "deposits", DepositsInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "solvency", SolvencyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "lock-bonus-shares", LockBonusSharesInvariant(k))
 }
 
 // AllInvariants runs all invariants of the savings module
@@ -19,7 +20,11 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			return res, stop
 		}
 
-		res, stop := SolvencyInvariant(k)(ctx)
+		if res, stop := SolvencyInvariant(k)(ctx); stop {
+			return res, stop
+		}
+
+		res, stop := LockBonusSharesInvariant(k)(ctx)
 		return res, stop
 	}
 }
@@ -46,14 +51,14 @@ func DepositsInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
-// SolvencyInvariant iterates all deposits and ensures the total amount matches the module account coins
+// SolvencyInvariant iterates all deposits and ensures the total amount and penalty pool balances match the module account coins
 func SolvencyInvariant(k Keeper) sdk.Invariant {
 	message := sdk.FormatInvariant(types.ModuleName, "module solvency broken", "total deposited amount does not match module account")
 
 	return func(ctx sdk.Context) (string, bool) {
 		balance := k.GetSavingsModuleAccountBalances(ctx)
 
-		deposited := sdk.Coins{}
+		deposited := k.GetPenaltyPoolBalances(ctx)
 		k.IterateDeposits(ctx, func(deposit types.Deposit) bool {
 			for _, coin := range deposit.Amount {
 				deposited = deposited.Add(coin)
@@ -65,3 +70,42 @@ func SolvencyInvariant(k Keeper) sdk.Invariant {
 		return message, broken
 	}
 }
+
+// LockBonusSharesInvariant iterates all deposits and ensures the lock bonus shares of each denom match the locks of the deposits
+func LockBonusSharesInvariant(k Keeper) sdk.Invariant {
+	message := sdk.FormatInvariant(types.ModuleName, "lock bonus shares broken", "lock bonus shares do not match deposit locks")
+
+	return func(ctx sdk.Context) (string, bool) {
+		bonusShares := make(map[string]sdk.Dec)
+		k.IterateDeposits(ctx, func(deposit types.Deposit) bool {
+			for _, lock := range deposit.Locks {
+				shares, found := bonusShares[lock.Amount.Denom]
+				if !found {
+					shares = sdk.ZeroDec()
+				}
+				bonusShares[lock.Amount.Denom] = shares.Add(lock.BonusShares())
+			}
+			return false
+		})
+
+		broken := false
+		k.IterateLockBonusShares(ctx, func(denom string, shares sdk.Dec) bool {
+			expected, found := bonusShares[denom]
+			if !found || !expected.Equal(shares) {
+				broken = true
+				return true
+			}
+			delete(bonusShares, denom)
+			return false
+		})
+
+		// any remaining denoms have locks without stored bonus shares
+		for _, shares := range bonusShares {
+			if !shares.IsZero() {
+				broken = true
+			}
+		}
+
+		return message, broken
+	}
+}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fury/x/savings/types"
+)
+
+// GetLockBonusShares returns the total bonus source shares of the locked
+// deposits of a denom
+func (k Keeper) GetLockBonusShares(ctx sdk.Context, denom string) sdk.Dec {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LockBonusSharesKeyPrefix)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return sdk.ZeroDec()
+	}
+	var shares sdk.Dec
+	if err := shares.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return shares
+}
+
+// SetLockBonusShares sets the total bonus source shares of the locked deposits
+// of a denom
+func (k Keeper) SetLockBonusShares(ctx sdk.Context, denom string, shares sdk.Dec) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LockBonusSharesKeyPrefix)
+	if shares.IsZero() {
+		store.Delete([]byte(denom))
+		return
+	}
+	bz, err := shares.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(denom), bz)
+}
+
+// IterateLockBonusShares iterates over the lock bonus shares of all denoms and performs a callback function
+func (k Keeper) IterateLockBonusShares(ctx sdk.Context, cb func(denom string, shares sdk.Dec) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LockBonusSharesKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var shares sdk.Dec
+		if err := shares.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		if cb(string(iterator.Key()), shares) {
+			break
+		}
+	}
+}
+
+// GetTotalSourceShares returns the total savings reward source shares of a
+// denom, which is the total deposited amount with locked amounts scaled by the
+// reward multiplier of their lockup term
+func (k Keeper) GetTotalSourceShares(ctx sdk.Context, denom string) sdk.Dec {
+	deposited := k.GetTotalDeposited(ctx, denom)
+	if pool, found := k.GetPenaltyPool(ctx, denom); found {
+		deposited = deposited.Sub(pool.Balance)
+	}
+
+	return sdk.NewDecFromInt(deposited).Add(k.GetLockBonusShares(ctx, denom))
+}
+
+// updateLockBonusShares updates the total bonus source shares for the locks of
+// a deposit changing from before to after
+func (k Keeper) updateLockBonusShares(ctx sdk.Context, before, after types.Locks) {
+	seenDenoms := make(map[string]bool)
+	for _, lock := range append(append(types.Locks{}, before...), after...) {
+		denom := lock.Amount.Denom
+		if seenDenoms[denom] {
+			continue
+		}
+		seenDenoms[denom] = true
+
+		diff := after.BonusShares(denom).Sub(before.BonusShares(denom))
+		if diff.IsZero() {
+			continue
+		}
+		k.SetLockBonusShares(ctx, denom, k.GetLockBonusShares(ctx, denom).Add(diff))
+	}
+}
+
+// InitializeDeposit sets a deposit in the store along with the lock bonus
+// shares and maturities of its locks, for use when importing genesis
+func (k Keeper) InitializeDeposit(ctx sdk.Context, deposit types.Deposit) {
+	k.SetDeposit(ctx, deposit)
+
+	k.updateLockBonusShares(ctx, types.Locks{}, deposit.Locks)
+	for _, lock := range deposit.Locks {
+		k.insertLockMaturity(ctx, lock.Maturity, deposit.Depositor)
+	}
+}
+
+// insertLockMaturity adds a depositor to the lock maturity queue
+func (k Keeper) insertLockMaturity(ctx sdk.Context, maturity time.Time, depositor sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LockMaturityQueueKeyPrefix)
+	store.Set(types.LockMaturityKey(maturity, depositor), depositor)
+}
+
+// UnlockMaturedDeposits unlocks the locked deposits that reached their
+// maturity, removing their reward multiplier
+func (k Keeper) UnlockMaturedDeposits(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LockMaturityQueueKeyPrefix)
+	iterator := store.Iterator(nil, sdk.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime())))
+
+	var keys [][]byte
+	var depositors []sdk.AccAddress
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		depositors = append(depositors, sdk.AccAddress(iterator.Value()))
+	}
+	iterator.Close()
+
+	for i, key := range keys {
+		store.Delete(key)
+		k.unlockMaturedLocks(ctx, depositors[i])
+	}
+}
+
+// unlockMaturedLocks removes the locks of a deposit that reached their maturity
+func (k Keeper) unlockMaturedLocks(ctx sdk.Context, depositor sdk.AccAddress) {
+	deposit, found := k.GetDeposit(ctx, depositor)
+	if !found {
+		return
+	}
+
+	var locks, matured types.Locks
+	for _, lock := range deposit.Locks {
+		if lock.Maturity.After(ctx.BlockTime()) {
+			locks = append(locks, lock)
+		} else {
+			matured = append(matured, lock)
+		}
+	}
+	if len(matured) == 0 {
+		return
+	}
+
+	k.BeforeSavingsDepositModified(ctx, deposit, []string{})
+
+	k.updateLockBonusShares(ctx, deposit.Locks, locks)
+	deposit.Locks = locks
+	k.SetDeposit(ctx, deposit)
+
+	for _, lock := range matured {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSavingsUnlock,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, lock.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+				sdk.NewAttribute(types.AttributeKeyLockupTerm, lock.Term.String()),
+				sdk.NewAttribute(types.AttributeKeyMaturity, lock.Maturity.String()),
+			),
+		)
+	}
+}
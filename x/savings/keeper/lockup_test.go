@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/incubus-network/fury/x/savings"
+	"github.com/incubus-network/fury/x/savings/keeper"
+	"github.com/incubus-network/fury/x/savings/types"
+)
+
+// setupLockupTerms supports usdx deposits with a 90 day lockup term that has a
+// 2x reward multiplier and a 10% early withdrawal penalty.
+func (suite *KeeperTestSuite) setupLockupTerms() {
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]string{"usdx"}, testLockupTerms()))
+}
+
+// requireInvariantsHold asserts that the savings invariants are not broken.
+func (suite *KeeperTestSuite) requireInvariantsHold() {
+	message, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.Require().False(broken, message)
+}
+
+func (suite *KeeperTestSuite) TestDepositWithLockup() {
+	suite.setupLockupTerms()
+
+	depositor := suite.CreateAccountWithAddress(sdk.AccAddress(crypto.AddressHash([]byte("depositor"))), cs(c("usdx", 1000))).GetAddress()
+
+	err := suite.keeper.DepositWithLockup(suite.ctx, depositor, cs(c("usdx", 100)), 30*24*time.Hour)
+	suite.Require().ErrorIs(err, types.ErrInvalidLockupTerm)
+
+	err = suite.keeper.DepositWithLockup(suite.ctx, depositor, cs(c("usdx", 100)), lockupTerm)
+	suite.Require().NoError(err)
+	err = suite.keeper.Deposit(suite.ctx, depositor, cs(c("usdx", 50)))
+	suite.Require().NoError(err)
+
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, depositor)
+	suite.Require().True(found)
+	suite.Equal(cs(c("usdx", 150)), deposit.Amount)
+	suite.Equal(types.Locks{
+		types.NewLock(c("usdx", 100), testLockupTerms()[0], suite.ctx.BlockTime().Add(lockupTerm)),
+	}, deposit.Locks)
+	suite.Equal(cs(c("usdx", 50)), deposit.UnlockedAmount())
+
+	// Locked amounts earn rewards on twice their amount
+	suite.Equal(sdk.NewDec(250), deposit.SourceShares("usdx"))
+	suite.Equal(sdk.NewDec(100), suite.keeper.GetLockBonusShares(suite.ctx, "usdx"))
+	suite.Equal(sdk.NewDec(250), suite.keeper.GetTotalSourceShares(suite.ctx, "usdx"))
+
+	// Only the unlocked amount can be withdrawn without penalty
+	err = suite.keeper.Withdraw(suite.ctx, depositor, cs(c("usdx", 60)))
+	suite.Require().ErrorIs(err, types.ErrDepositLocked)
+
+	err = suite.keeper.Withdraw(suite.ctx, depositor, cs(c("usdx", 50)))
+	suite.Require().NoError(err)
+	suite.Equal(cs(c("usdx", 900)), suite.getAccountCoins(suite.getAccount(depositor)))
+
+	suite.requireInvariantsHold()
+}
+
+func (suite *KeeperTestSuite) TestUnlockMaturedDeposits() {
+	suite.setupLockupTerms()
+
+	depositor := suite.CreateAccountWithAddress(sdk.AccAddress(crypto.AddressHash([]byte("depositor"))), cs(c("usdx", 1000))).GetAddress()
+
+	err := suite.keeper.DepositWithLockup(suite.ctx, depositor, cs(c("usdx", 100)), lockupTerm)
+	suite.Require().NoError(err)
+	maturity := suite.ctx.BlockTime().Add(lockupTerm)
+
+	// Deposits stay locked until their maturity
+	suite.ctx = suite.ctx.WithBlockTime(maturity.Add(-time.Second))
+	savings.BeginBlocker(suite.ctx, suite.keeper)
+
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, depositor)
+	suite.Require().True(found)
+	suite.Len(deposit.Locks, 1)
+
+	suite.ctx = suite.ctx.WithBlockTime(maturity)
+	savings.BeginBlocker(suite.ctx, suite.keeper)
+
+	deposit, found = suite.keeper.GetDeposit(suite.ctx, depositor)
+	suite.Require().True(found)
+	suite.Empty(deposit.Locks)
+	suite.True(suite.keeper.GetLockBonusShares(suite.ctx, "usdx").IsZero())
+	suite.Equal(sdk.NewDec(100), suite.keeper.GetTotalSourceShares(suite.ctx, "usdx"))
+
+	suite.Contains(suite.ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSavingsUnlock,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, c("usdx", 100).String()),
+		sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+		sdk.NewAttribute(types.AttributeKeyLockupTerm, lockupTerm.String()),
+		sdk.NewAttribute(types.AttributeKeyMaturity, maturity.String()),
+	))
+
+	err = suite.keeper.Withdraw(suite.ctx, depositor, cs(c("usdx", 100)))
+	suite.Require().NoError(err)
+	suite.Equal(cs(c("usdx", 1000)), suite.getAccountCoins(suite.getAccount(depositor)))
+
+	suite.requireInvariantsHold()
+}
+
+func (suite *KeeperTestSuite) TestWithdrawEarly() {
+	suite.setupLockupTerms()
+
+	locker := suite.CreateAccountWithAddress(sdk.AccAddress(crypto.AddressHash([]byte("locker"))), cs(c("usdx", 1000))).GetAddress()
+	saver := suite.CreateAccountWithAddress(sdk.AccAddress(crypto.AddressHash([]byte("saver"))), cs(c("usdx", 1000))).GetAddress()
+
+	err := suite.keeper.DepositWithLockup(suite.ctx, locker, cs(c("usdx", 100)), lockupTerm)
+	suite.Require().NoError(err)
+	err = suite.keeper.Deposit(suite.ctx, saver, cs(c("usdx", 400)))
+	suite.Require().NoError(err)
+
+	// 10% of the locked amount is kept as a penalty
+	err = suite.keeper.WithdrawEarly(suite.ctx, locker, cs(c("usdx", 100)))
+	suite.Require().NoError(err)
+	suite.Equal(cs(c("usdx", 990)), suite.getAccountCoins(suite.getAccount(locker)))
+
+	_, found := suite.keeper.GetDeposit(suite.ctx, locker)
+	suite.False(found)
+	suite.True(suite.keeper.GetLockBonusShares(suite.ctx, "usdx").IsZero())
+
+	suite.Contains(suite.ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeSavingsWithdrawal,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, cs(c("usdx", 100)).String()),
+		sdk.NewAttribute(types.AttributeKeyDepositor, locker.String()),
+		sdk.NewAttribute(types.AttributeKeyPenalty, cs(c("usdx", 10)).String()),
+	))
+
+	// The penalty is shared with the remaining savers
+	pool, found := suite.keeper.GetPenaltyPool(suite.ctx, "usdx")
+	suite.Require().True(found)
+	suite.Equal(types.NewPenaltyPool("usdx", sdk.MustNewDecFromStr("1.025"), sdk.NewInt(10)), pool)
+
+	deposit, found := suite.keeper.GetSyncedDeposit(suite.ctx, saver)
+	suite.Require().True(found)
+	suite.Equal(cs(c("usdx", 410)), deposit.Amount)
+	suite.requireInvariantsHold()
+
+	err = suite.keeper.Withdraw(suite.ctx, saver, cs(c("usdx", 410)))
+	suite.Require().NoError(err)
+	suite.Equal(cs(c("usdx", 1010)), suite.getAccountCoins(suite.getAccount(saver)))
+
+	pool, found = suite.keeper.GetPenaltyPool(suite.ctx, "usdx")
+	suite.Require().True(found)
+	suite.True(pool.Balance.IsZero())
+	suite.requireInvariantsHold()
+}
+
+func (suite *KeeperTestSuite) TestWithdrawEarly_NoRemainingSavers() {
+	suite.setupLockupTerms()
+
+	depositor := suite.CreateAccountWithAddress(sdk.AccAddress(crypto.AddressHash([]byte("depositor"))), cs(c("usdx", 1000))).GetAddress()
+
+	err := suite.keeper.DepositWithLockup(suite.ctx, depositor, cs(c("usdx", 100)), lockupTerm)
+	suite.Require().NoError(err)
+
+	// The penalty is returned as there is nobody to share it with
+	err = suite.keeper.WithdrawEarly(suite.ctx, depositor, cs(c("usdx", 100)))
+	suite.Require().NoError(err)
+	suite.Equal(cs(c("usdx", 1000)), suite.getAccountCoins(suite.getAccount(depositor)))
+
+	_, found := suite.keeper.GetPenaltyPool(suite.ctx, "usdx")
+	suite.False(found)
+	suite.requireInvariantsHold()
+}
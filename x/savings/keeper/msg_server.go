@@ -28,7 +28,11 @@ func (k msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types
 		return nil, err
 	}
 
-	err = k.keeper.Deposit(ctx, depositor, msg.Amount)
+	if msg.LockupTerm != nil {
+		err = k.keeper.DepositWithLockup(ctx, depositor, msg.Amount, *msg.LockupTerm)
+	} else {
+		err = k.keeper.Deposit(ctx, depositor, msg.Amount)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +55,11 @@ func (k msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdraw) (*typ
 		return nil, err
 	}
 
-	err = k.keeper.Withdraw(ctx, depositor, msg.Amount)
+	if msg.EarlyWithdrawal {
+		err = k.keeper.WithdrawEarly(ctx, depositor, msg.Amount)
+	} else {
+		err = k.keeper.Withdraw(ctx, depositor, msg.Amount)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/incubus-network/fury/x/savings/types"
+)
+
+// GetPenaltyPool returns the early withdrawal penalty pool of a denom
+func (k Keeper) GetPenaltyPool(ctx sdk.Context, denom string) (types.PenaltyPool, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PenaltyPoolsKeyPrefix)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return types.PenaltyPool{}, false
+	}
+	var pool types.PenaltyPool
+	k.cdc.MustUnmarshal(bz, &pool)
+	return pool, true
+}
+
+// SetPenaltyPool sets the early withdrawal penalty pool of a denom
+func (k Keeper) SetPenaltyPool(ctx sdk.Context, pool types.PenaltyPool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PenaltyPoolsKeyPrefix)
+	bz := k.cdc.MustMarshal(&pool)
+	store.Set([]byte(pool.Denom), bz)
+}
+
+// IteratePenaltyPools iterates over all penalty pools in the store and performs a callback function
+func (k Keeper) IteratePenaltyPools(ctx sdk.Context, cb func(pool types.PenaltyPool) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PenaltyPoolsKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var pool types.PenaltyPool
+		k.cdc.MustUnmarshal(iterator.Value(), &pool)
+		if cb(pool) {
+			break
+		}
+	}
+}
+
+// GetAllPenaltyPools returns all penalty pools from the store
+func (k Keeper) GetAllPenaltyPools(ctx sdk.Context) (pools types.PenaltyPools) {
+	k.IteratePenaltyPools(ctx, func(pool types.PenaltyPool) bool {
+		pools = append(pools, pool)
+		return false
+	})
+	return
+}
+
+// GetPenaltyPoolBalances returns the penalties held by all penalty pools
+func (k Keeper) GetPenaltyPoolBalances(ctx sdk.Context) sdk.Coins {
+	balances := sdk.NewCoins()
+	k.IteratePenaltyPools(ctx, func(pool types.PenaltyPool) bool {
+		balances = balances.Add(sdk.NewCoin(pool.Denom, pool.Balance))
+		return false
+	})
+	return balances
+}
+
+// GetSyncedDeposit returns a deposit including its share of early withdrawal
+// penalties that have not been added to it yet, without modifying the store
+func (k Keeper) GetSyncedDeposit(ctx sdk.Context, depositor sdk.AccAddress) (types.Deposit, bool) {
+	deposit, found := k.GetDeposit(ctx, depositor)
+	if !found {
+		return types.Deposit{}, false
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	return k.syncDepositPenalties(cacheCtx, deposit), true
+}
+
+// syncDepositPenalties adds the deposit's share of the early withdrawal
+// penalties distributed since it was last synced to its amount and moves them
+// out of the penalty pools. The deposit is returned without being stored.
+func (k Keeper) syncDepositPenalties(ctx sdk.Context, deposit types.Deposit) types.Deposit {
+	for _, coin := range deposit.Amount {
+		pool, found := k.GetPenaltyPool(ctx, coin.Denom)
+		if !found {
+			continue
+		}
+
+		index, found := deposit.PenaltyIndexes.Get(coin.Denom)
+		if !found {
+			// Deposits made before the pool existed start from the initial factor
+			index = sdk.OneDec()
+		}
+
+		penaltyShare := sdk.NewDecFromInt(coin.Amount).Mul(pool.Factor).Quo(index).TruncateInt().Sub(coin.Amount)
+		if penaltyShare.GT(pool.Balance) {
+			penaltyShare = pool.Balance
+		}
+
+		if penaltyShare.IsPositive() {
+			deposit.Amount = deposit.Amount.Add(sdk.NewCoin(coin.Denom, penaltyShare))
+			pool.Balance = pool.Balance.Sub(penaltyShare)
+			k.SetPenaltyPool(ctx, pool)
+		}
+
+		deposit.PenaltyIndexes = deposit.PenaltyIndexes.SetIndex(coin.Denom, pool.Factor)
+	}
+
+	return deposit
+}
+
+// initializePenaltyIndexes sets the penalty indexes of denoms newly added to a
+// deposit to the current penalty pool factors, so they only share in future
+// penalties.
+func (k Keeper) initializePenaltyIndexes(ctx sdk.Context, deposit types.Deposit, denoms []string) types.Deposit {
+	for _, denom := range denoms {
+		pool, found := k.GetPenaltyPool(ctx, denom)
+		if !found {
+			continue
+		}
+		deposit.PenaltyIndexes = deposit.PenaltyIndexes.SetIndex(denom, pool.Factor)
+	}
+
+	return deposit
+}
+
+// distributePenalty shares an early withdrawal penalty held by the module
+// account among the remaining deposits of its denom by growing the penalty
+// pool factor. It returns false if there are no remaining deposits.
+func (k Keeper) distributePenalty(ctx sdk.Context, penalty sdk.Coin) bool {
+	// The remaining deposits include penalties not yet added to them, which
+	// rounds the growth down so the pool can always cover the deposit shares.
+	remaining := k.GetTotalDeposited(ctx, penalty.Denom).Sub(penalty.Amount)
+	if !remaining.IsPositive() {
+		return false
+	}
+
+	pool, found := k.GetPenaltyPool(ctx, penalty.Denom)
+	if !found {
+		pool = types.NewPenaltyPool(penalty.Denom, sdk.OneDec(), sdk.ZeroInt())
+	}
+
+	growth := sdk.NewDecFromInt(penalty.Amount).QuoInt(remaining)
+	pool.Factor = pool.Factor.Mul(sdk.OneDec().Add(growth))
+	pool.Balance = pool.Balance.Add(penalty.Amount)
+
+	k.SetPenaltyPool(ctx, pool)
+
+	return true
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/incubus-network/fury/x/savings/types"
@@ -11,17 +12,48 @@ import (
 
 // Withdraw returns some or all of a deposit back to original depositor
 func (k Keeper) Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error {
+	return k.withdraw(ctx, depositor, coins, false)
+}
+
+// WithdrawEarly returns some or all of a deposit back to original depositor,
+// including locked amounts before their maturity. The early withdrawal penalty
+// of their lockup terms is kept and shared among the remaining savers.
+func (k Keeper) WithdrawEarly(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error {
+	return k.withdraw(ctx, depositor, coins, true)
+}
+
+func (k Keeper) withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins, early bool) error {
 	deposit, found := k.GetDeposit(ctx, depositor)
 	if !found {
 		return errorsmod.Wrap(types.ErrNoDepositFound, fmt.Sprintf(" for address: %s", depositor.String()))
 	}
+	deposit = k.syncDepositPenalties(ctx, deposit)
 
 	amount, err := k.CalculateWithdrawAmount(deposit.Amount, coins)
 	if err != nil {
 		return err
 	}
 
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, depositor, amount)
+	// Amounts above the unlocked balance are withdrawn from locks
+	unlocked := deposit.UnlockedAmount()
+	fromLocks := sdk.NewCoins()
+	for _, coin := range amount {
+		fromLocks = fromLocks.Add(sdk.NewCoin(coin.Denom, sdkmath.MaxInt(coin.Amount.Sub(unlocked.AmountOf(coin.Denom)), sdk.ZeroInt())))
+	}
+
+	penalty := sdk.NewCoins()
+	if !fromLocks.IsZero() {
+		if !early {
+			return errorsmod.Wrapf(types.ErrDepositLocked, "%s locked until maturity", fromLocks)
+		}
+
+		var locks types.Locks
+		locks, penalty = deposit.Locks.Withdraw(fromLocks)
+		k.updateLockBonusShares(ctx, deposit.Locks, locks)
+		deposit.Locks = locks
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, depositor, amount.Sub(penalty...))
 	if err != nil {
 		return err
 	}
@@ -33,12 +65,30 @@ func (k Keeper) Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Co
 		k.SetDeposit(ctx, deposit)
 	}
 
+	// Penalties are returned if there are no remaining savers to share them
+	refund := sdk.NewCoins()
+	for _, coin := range penalty {
+		if !k.distributePenalty(ctx, coin) {
+			refund = refund.Add(coin)
+		}
+	}
+	if !refund.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, depositor, refund); err != nil {
+			return err
+		}
+		penalty = penalty.Sub(refund...)
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+	}
+	if !penalty.IsZero() {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyPenalty, penalty.String()))
+	}
+
 	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeSavingsWithdrawal,
-			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
-			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
-		),
+		sdk.NewEvent(types.EventTypeSavingsWithdrawal, attributes...),
 	)
 	return nil
 }
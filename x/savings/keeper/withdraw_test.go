@@ -148,8 +148,9 @@ func (suite *KeeperTestSuite) TestWithdraw() {
 				[]sdk.AccAddress{tc.args.depositor},
 			)
 			savingsGS := types.NewGenesisState(
-				types.NewParams(tc.args.allowedDenoms),
+				types.NewParams(tc.args.allowedDenoms, nil),
 				types.Deposits{},
+				nil,
 			)
 
 			stakingParams := stakingtypes.DefaultParams()
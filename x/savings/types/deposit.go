@@ -2,7 +2,10 @@ package types
 
 import (
 	"fmt"
+	"sort"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -22,8 +25,37 @@ func (d Deposit) Validate() error {
 	if !d.Amount.IsValid() {
 		return fmt.Errorf("invalid deposit coins: %s", d.Amount)
 	}
+	if err := d.Locks.Validate(); err != nil {
+		return err
+	}
+	if locked := d.LockedAmount(); !locked.IsAllLTE(d.Amount) {
+		return fmt.Errorf("locked amount %s exceeds deposit amount %s", locked, d.Amount)
+	}
 
-	return nil
+	return d.PenaltyIndexes.Validate()
+}
+
+// LockedAmount returns the total amount of the deposit that is locked.
+func (d Deposit) LockedAmount() sdk.Coins {
+	locked := sdk.NewCoins()
+	for _, lock := range d.Locks {
+		locked = locked.Add(lock.Amount)
+	}
+	return locked
+}
+
+// UnlockedAmount returns the amount of the deposit that can be withdrawn
+// without penalty.
+func (d Deposit) UnlockedAmount() sdk.Coins {
+	unlocked, _ := d.Amount.SafeSub(d.LockedAmount()...)
+	return unlocked
+}
+
+// SourceShares returns the savings reward source shares of a denom in the
+// deposit, which is the deposited amount with locked amounts scaled by the
+// reward multiplier of their lockup term.
+func (d Deposit) SourceShares(denom string) sdk.Dec {
+	return sdk.NewDecFromInt(d.Amount.AmountOf(denom)).Add(d.Locks.BonusShares(denom))
 }
 
 // Deposits is a slice of Deposit
@@ -44,3 +76,181 @@ func (ds Deposits) Validate() error {
 	}
 	return nil
 }
+
+// NewLock returns a new lock of an amount for a lockup term
+func NewLock(amount sdk.Coin, term LockupTerm, maturity time.Time) Lock {
+	return Lock{
+		Amount:                 amount,
+		Term:                   term.Duration,
+		Maturity:               maturity,
+		RewardMultiplier:       term.RewardMultiplier,
+		EarlyWithdrawalPenalty: term.EarlyWithdrawalPenalty,
+	}
+}
+
+// Validate validates a lock
+func (l Lock) Validate() error {
+	if !l.Amount.IsValid() || !l.Amount.IsPositive() {
+		return fmt.Errorf("invalid lock amount: %s", l.Amount)
+	}
+	if l.Maturity.IsZero() {
+		return fmt.Errorf("lock maturity cannot be empty")
+	}
+
+	return NewLockupTerm(l.Term, l.RewardMultiplier, l.EarlyWithdrawalPenalty).Validate()
+}
+
+// BonusShares returns the source shares the lock earns in addition to its
+// amount.
+func (l Lock) BonusShares() sdk.Dec {
+	return sdk.NewDecFromInt(l.Amount.Amount).Mul(l.RewardMultiplier.Sub(sdk.OneDec()))
+}
+
+// Locks is a slice of Lock
+type Locks []Lock
+
+// Validate validates Locks
+func (ls Locks) Validate() error {
+	for _, l := range ls {
+		if err := l.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BonusShares returns the total bonus source shares of locks of a denom.
+func (ls Locks) BonusShares(denom string) sdk.Dec {
+	bonus := sdk.ZeroDec()
+	for _, l := range ls {
+		if l.Amount.Denom == denom {
+			bonus = bonus.Add(l.BonusShares())
+		}
+	}
+	return bonus
+}
+
+// Withdraw removes an amount from the locks, soonest maturing first. It returns
+// the remaining locks and the early withdrawal penalty owed on the amount.
+func (ls Locks) Withdraw(amount sdk.Coins) (Locks, sdk.Coins) {
+	sorted := make(Locks, len(ls))
+	copy(sorted, ls)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Maturity.Before(sorted[j].Maturity)
+	})
+
+	var remaining Locks
+	penalty := sdk.NewCoins()
+	for _, lock := range sorted {
+		withdrawn := sdkmath.MinInt(lock.Amount.Amount, amount.AmountOf(lock.Amount.Denom))
+		if withdrawn.IsPositive() {
+			amount = amount.Sub(sdk.NewCoin(lock.Amount.Denom, withdrawn))
+			penalty = penalty.Add(sdk.NewCoin(
+				lock.Amount.Denom,
+				sdk.NewDecFromInt(withdrawn).Mul(lock.EarlyWithdrawalPenalty).TruncateInt(),
+			))
+			lock.Amount = lock.Amount.SubAmount(withdrawn)
+		}
+
+		if lock.Amount.IsPositive() {
+			remaining = append(remaining, lock)
+		}
+	}
+
+	return remaining, penalty
+}
+
+// NewPenaltyIndex returns a new PenaltyIndex
+func NewPenaltyIndex(denom string, value sdk.Dec) PenaltyIndex {
+	return PenaltyIndex{
+		Denom: denom,
+		Value: value,
+	}
+}
+
+// PenaltyIndexes is a slice of PenaltyIndex
+type PenaltyIndexes []PenaltyIndex
+
+// Validate validates PenaltyIndexes
+func (is PenaltyIndexes) Validate() error {
+	seenDenoms := make(map[string]bool)
+	for _, i := range is {
+		if err := sdk.ValidateDenom(i.Denom); err != nil {
+			return err
+		}
+		if seenDenoms[i.Denom] {
+			return fmt.Errorf("duplicated penalty index denom %s", i.Denom)
+		}
+		seenDenoms[i.Denom] = true
+
+		if i.Value.IsNil() || !i.Value.IsPositive() {
+			return fmt.Errorf("penalty index of %s must be positive: %s", i.Denom, i.Value)
+		}
+	}
+	return nil
+}
+
+// Get returns the penalty index of a denom
+func (is PenaltyIndexes) Get(denom string) (sdk.Dec, bool) {
+	for _, i := range is {
+		if i.Denom == denom {
+			return i.Value, true
+		}
+	}
+	return sdk.Dec{}, false
+}
+
+// SetIndex sets the penalty index of a denom, adding it if not found
+func (is PenaltyIndexes) SetIndex(denom string, value sdk.Dec) PenaltyIndexes {
+	newIndexes := make(PenaltyIndexes, len(is))
+	copy(newIndexes, is)
+
+	for i := range newIndexes {
+		if newIndexes[i].Denom == denom {
+			newIndexes[i].Value = value
+			return newIndexes
+		}
+	}
+	return append(newIndexes, NewPenaltyIndex(denom, value))
+}
+
+// NewPenaltyPool returns a new PenaltyPool
+func NewPenaltyPool(denom string, factor sdk.Dec, balance sdkmath.Int) PenaltyPool {
+	return PenaltyPool{
+		Denom:   denom,
+		Factor:  factor,
+		Balance: balance,
+	}
+}
+
+// Validate validates a penalty pool
+func (p PenaltyPool) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return err
+	}
+	if p.Factor.IsNil() || p.Factor.LT(sdk.OneDec()) {
+		return fmt.Errorf("penalty pool factor of %s must be at least 1: %s", p.Denom, p.Factor)
+	}
+	if p.Balance.IsNil() || p.Balance.IsNegative() {
+		return fmt.Errorf("penalty pool balance of %s cannot be negative: %s", p.Denom, p.Balance)
+	}
+	return nil
+}
+
+// PenaltyPools is a slice of PenaltyPool
+type PenaltyPools []PenaltyPool
+
+// Validate validates PenaltyPools
+func (ps PenaltyPools) Validate() error {
+	seenDenoms := make(map[string]bool)
+	for _, p := range ps {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+		if seenDenoms[p.Denom] {
+			return fmt.Errorf("duplicated penalty pool denom %s", p.Denom)
+		}
+		seenDenoms[p.Denom] = true
+	}
+	return nil
+}
@@ -13,4 +13,8 @@ var (
 	ErrInvalidDepositDenom = errorsmod.Register(ModuleName, 4, "invalid deposit denom")
 	// ErrInvalidWithdrawDenom error for invalid withdraw denoms
 	ErrInvalidWithdrawDenom = errorsmod.Register(ModuleName, 5, "invalid withdraw denom")
+	// ErrInvalidLockupTerm error for a lockup term that is not in params
+	ErrInvalidLockupTerm = errorsmod.Register(ModuleName, 6, "invalid lockup term")
+	// ErrDepositLocked error when withdrawing a locked deposit before maturity
+	ErrDepositLocked = errorsmod.Register(ModuleName, 7, "deposit is locked")
 )
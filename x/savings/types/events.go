@@ -3,8 +3,12 @@ package types
 const (
 	EventTypeSavingsDeposit    = "deposit_savings"
 	EventTypeSavingsWithdrawal = "withdraw_savings"
+	EventTypeSavingsUnlock     = "unlock_savings"
 
 	AttributeValueCategory = ModuleName
 	AttributeKeyAmount     = "amount"
 	AttributeKeyDepositor  = "depositor"
+	AttributeKeyLockupTerm = "lockup_term"
+	AttributeKeyMaturity   = "maturity"
+	AttributeKeyPenalty    = "penalty"
 )
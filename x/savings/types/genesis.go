@@ -1,10 +1,11 @@
 package types
 
 // NewGenesisState creates a new genesis state for the savings module
-func NewGenesisState(p Params, deposits Deposits) GenesisState {
+func NewGenesisState(p Params, deposits Deposits, penaltyPools PenaltyPools) GenesisState {
 	return GenesisState{
-		Params:   p,
-		Deposits: deposits,
+		Params:       p,
+		Deposits:     deposits,
+		PenaltyPools: penaltyPools,
 	}
 }
 
@@ -13,6 +14,7 @@ func DefaultGenesisState() GenesisState {
 	return NewGenesisState(
 		DefaultParams(),
 		Deposits{},
+		PenaltyPools{},
 	)
 }
 
@@ -23,5 +25,9 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
-	return gs.Deposits.Validate()
+	if err := gs.Deposits.Validate(); err != nil {
+		return err
+	}
+
+	return gs.PenaltyPools.Validate()
 }
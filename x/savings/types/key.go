@@ -1,5 +1,11 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName The name that will be used throughout the module
 	ModuleName = "savings"
@@ -20,4 +26,14 @@ const (
 	ModuleAccountName = ModuleName
 )
 
-var DepositsKeyPrefix = []byte{0x01}
+var (
+	DepositsKeyPrefix          = []byte{0x01}
+	PenaltyPoolsKeyPrefix      = []byte{0x02}
+	LockBonusSharesKeyPrefix   = []byte{0x03}
+	LockMaturityQueueKeyPrefix = []byte{0x04}
+)
+
+// LockMaturityKey returns the key of a depositor in the lock maturity queue.
+func LockMaturityKey(maturity time.Time, depositor sdk.AccAddress) []byte {
+	return append(sdk.FormatTimeBytes(maturity), depositor...)
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -20,6 +22,14 @@ func NewMsgDeposit(depositor sdk.AccAddress, amount sdk.Coins) MsgDeposit {
 	}
 }
 
+// NewMsgDepositWithLockup returns a new MsgDeposit that locks the deposit for
+// a lockup term
+func NewMsgDepositWithLockup(depositor sdk.AccAddress, amount sdk.Coins, lockupTerm time.Duration) MsgDeposit {
+	msg := NewMsgDeposit(depositor, amount)
+	msg.LockupTerm = &lockupTerm
+	return msg
+}
+
 // Route return the message type used for routing the message.
 func (msg MsgDeposit) Route() string { return RouterKey }
 
@@ -36,6 +46,10 @@ func (msg MsgDeposit) ValidateBasic() error {
 	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "deposit amount %s", msg.Amount)
 	}
+
+	if msg.LockupTerm != nil && *msg.LockupTerm <= 0 {
+		return errorsmod.Wrapf(ErrInvalidLockupTerm, "lockup term must be positive: %s", msg.LockupTerm)
+	}
 	return nil
 }
 
@@ -62,6 +76,14 @@ func NewMsgWithdraw(depositor sdk.AccAddress, amount sdk.Coins) MsgWithdraw {
 	}
 }
 
+// NewMsgEarlyWithdraw returns a new MsgWithdraw that can withdraw locked
+// deposits before maturity
+func NewMsgEarlyWithdraw(depositor sdk.AccAddress, amount sdk.Coins) MsgWithdraw {
+	msg := NewMsgWithdraw(depositor, amount)
+	msg.EarlyWithdrawal = true
+	return msg
+}
+
 // Route return the message type used for routing the message.
 func (msg MsgWithdraw) Route() string { return RouterKey }
 
@@ -2,26 +2,31 @@ package types
 
 import (
 	"fmt"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Parameter keys
 var (
 	KeySupportedDenoms     = []byte("SupportedDenoms")
+	KeyLockupTerms         = []byte("LockupTerms")
 	DefaultSupportedDenoms = []string{}
+	DefaultLockupTerms     = LockupTerms{}
 )
 
 // NewParams creates a new Params object
-func NewParams(supportedDenoms []string) Params {
+func NewParams(supportedDenoms []string, lockupTerms LockupTerms) Params {
 	return Params{
 		SupportedDenoms: supportedDenoms,
+		LockupTerms:     lockupTerms,
 	}
 }
 
 // DefaultParams default params for savings
 func DefaultParams() Params {
-	return NewParams(DefaultSupportedDenoms)
+	return NewParams(DefaultSupportedDenoms, DefaultLockupTerms)
 }
 
 // ParamKeyTable Key declaration for parameters
@@ -34,12 +39,17 @@ func ParamKeyTable() paramtypes.KeyTable {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeySupportedDenoms, &p.SupportedDenoms, validateSupportedDenoms),
+		paramtypes.NewParamSetPair(KeyLockupTerms, &p.LockupTerms, validateLockupTerms),
 	}
 }
 
 // Validate ensure that params have valid values
 func (p Params) Validate() error {
-	return validateSupportedDenoms(p.SupportedDenoms)
+	if err := validateSupportedDenoms(p.SupportedDenoms); err != nil {
+		return err
+	}
+
+	return validateLockupTerms(p.LockupTerms)
 }
 
 func validateSupportedDenoms(i interface{}) error {
@@ -57,3 +67,66 @@ func validateSupportedDenoms(i interface{}) error {
 	}
 	return nil
 }
+
+func validateLockupTerms(i interface{}) error {
+	lockupTerms, ok := i.(LockupTerms)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return lockupTerms.Validate()
+}
+
+// NewLockupTerm returns a new LockupTerm
+func NewLockupTerm(duration time.Duration, rewardMultiplier, earlyWithdrawalPenalty sdk.Dec) LockupTerm {
+	return LockupTerm{
+		Duration:               duration,
+		RewardMultiplier:       rewardMultiplier,
+		EarlyWithdrawalPenalty: earlyWithdrawalPenalty,
+	}
+}
+
+// Validate validates a lockup term
+func (t LockupTerm) Validate() error {
+	if t.Duration <= 0 {
+		return fmt.Errorf("lockup term duration must be positive: %s", t.Duration)
+	}
+	if t.RewardMultiplier.IsNil() || t.RewardMultiplier.LT(sdk.OneDec()) {
+		return fmt.Errorf("lockup term reward multiplier must be at least 1: %s", t.RewardMultiplier)
+	}
+	if t.EarlyWithdrawalPenalty.IsNil() || t.EarlyWithdrawalPenalty.IsNegative() || t.EarlyWithdrawalPenalty.GTE(sdk.OneDec()) {
+		return fmt.Errorf("lockup term early withdrawal penalty must be between 0 and 1: %s", t.EarlyWithdrawalPenalty)
+	}
+
+	return nil
+}
+
+// LockupTerms is a slice of LockupTerm
+type LockupTerms []LockupTerm
+
+// Validate validates LockupTerms
+func (ts LockupTerms) Validate() error {
+	seenDurations := make(map[time.Duration]bool)
+	for _, t := range ts {
+		if err := t.Validate(); err != nil {
+			return err
+		}
+		if seenDurations[t.Duration] {
+			return fmt.Errorf("duplicated lockup term %s", t.Duration)
+		}
+		seenDurations[t.Duration] = true
+	}
+
+	return nil
+}
+
+// Get returns the lockup term with the given duration
+func (ts LockupTerms) Get(duration time.Duration) (LockupTerm, bool) {
+	for _, t := range ts {
+		if t.Duration == duration {
+			return t, true
+		}
+	}
+
+	return LockupTerm{}, false
+}
@@ -42,7 +42,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f78c91efc5db144f, []int{0}
+	return fileDescriptor_656b5a49cac49fcc, []int{0}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -81,7 +81,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f78c91efc5db144f, []int{1}
+	return fileDescriptor_656b5a49cac49fcc, []int{1}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -122,7 +122,7 @@ func (m *QueryDepositsRequest) Reset()         { *m = QueryDepositsRequest{} }
 func (m *QueryDepositsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositsRequest) ProtoMessage()    {}
 func (*QueryDepositsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f78c91efc5db144f, []int{2}
+	return fileDescriptor_656b5a49cac49fcc, []int{2}
 }
 func (m *QueryDepositsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -183,7 +183,7 @@ func (m *QueryDepositsResponse) Reset()         { *m = QueryDepositsResponse{} }
 func (m *QueryDepositsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDepositsResponse) ProtoMessage()    {}
 func (*QueryDepositsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f78c91efc5db144f, []int{3}
+	return fileDescriptor_656b5a49cac49fcc, []int{3}
 }
 func (m *QueryDepositsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -234,7 +234,7 @@ func (m *QueryTotalSupplyRequest) Reset()         { *m = QueryTotalSupplyRequest
 func (m *QueryTotalSupplyRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalSupplyRequest) ProtoMessage()    {}
 func (*QueryTotalSupplyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f78c91efc5db144f, []int{4}
+	return fileDescriptor_656b5a49cac49fcc, []int{4}
 }
 func (m *QueryTotalSupplyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,7 +275,7 @@ func (m *QueryTotalSupplyResponse) Reset()         { *m = QueryTotalSupplyRespon
 func (m *QueryTotalSupplyResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalSupplyResponse) ProtoMessage()    {}
 func (*QueryTotalSupplyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f78c91efc5db144f, []int{5}
+	return fileDescriptor_656b5a49cac49fcc, []int{5}
 }
 func (m *QueryTotalSupplyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -327,49 +327,49 @@ func init() {
 	proto.RegisterType((*QueryTotalSupplyResponse)(nil), "fury.savings.v1beta1.QueryTotalSupplyResponse")
 }
 
-func init() { proto.RegisterFile("fury/savings/v1beta1/query.proto", fileDescriptor_f78c91efc5db144f) }
-
-var fileDescriptor_f78c91efc5db144f = []byte{
-	// 619 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xb1, 0x8f, 0x12, 0x41,
-	0x14, 0xc6, 0x19, 0x38, 0xc8, 0x39, 0x34, 0x66, 0x5c, 0x75, 0x21, 0xb8, 0x90, 0xcd, 0xe5, 0xe4,
-	0x30, 0xec, 0x7a, 0xd8, 0x5d, 0x27, 0x67, 0xb4, 0xb0, 0xd1, 0x3d, 0x13, 0x13, 0x9b, 0xcb, 0x00,
-	0x93, 0x65, 0x73, 0xb0, 0xb3, 0xb7, 0x33, 0x8b, 0xd2, 0x6a, 0x63, 0x62, 0x63, 0x62, 0xa1, 0xa5,
-	0x85, 0x95, 0x89, 0x9d, 0xf1, 0x6f, 0xb8, 0xf2, 0xa2, 0x8d, 0x95, 0x1a, 0xf0, 0x0f, 0x31, 0x3b,
-	0x33, 0xbb, 0x07, 0xc7, 0xe6, 0xa4, 0x82, 0x79, 0xf3, 0x7d, 0xdf, 0xfc, 0xe6, 0xcd, 0x03, 0xd8,
-	0x38, 0xc2, 0x13, 0x6c, 0x33, 0x3c, 0xf1, 0x7c, 0x97, 0xd9, 0x93, 0xdd, 0x1e, 0xe1, 0x78, 0xd7,
-	0x3e, 0x8e, 0x48, 0x38, 0xb5, 0x82, 0x90, 0x72, 0x8a, 0xb4, 0x58, 0x61, 0x29, 0x85, 0xa5, 0x14,
-	0xd5, 0x56, 0x9f, 0xb2, 0x31, 0x65, 0x76, 0x0f, 0x33, 0x22, 0xe5, 0xa9, 0x39, 0xc0, 0xae, 0xe7,
-	0x63, 0xee, 0x51, 0x5f, 0x26, 0x54, 0x8d, 0x45, 0x6d, 0xa2, 0xea, 0x53, 0x2f, 0xd9, 0xaf, 0xc8,
-	0xfd, 0x43, 0xb1, 0xb2, 0xe5, 0x42, 0x6d, 0x69, 0x2e, 0x75, 0xa9, 0xac, 0xc7, 0xdf, 0x54, 0xb5,
-	0xe6, 0x52, 0xea, 0x8e, 0x88, 0x8d, 0x03, 0xcf, 0xc6, 0xbe, 0x4f, 0xb9, 0x38, 0x2d, 0xf1, 0x64,
-	0x5f, 0x89, 0x71, 0x1a, 0x12, 0xa9, 0x30, 0x35, 0x88, 0x1e, 0xc7, 0xc8, 0x8f, 0x70, 0x88, 0xc7,
-	0xcc, 0x21, 0xc7, 0x11, 0x61, 0xdc, 0x7c, 0x0a, 0xaf, 0x2c, 0x55, 0x59, 0x40, 0x7d, 0x46, 0xd0,
-	0x1e, 0x2c, 0x05, 0xa2, 0xa2, 0x83, 0x06, 0x68, 0x96, 0x3b, 0x35, 0x2b, 0xab, 0x21, 0x96, 0x74,
-	0x75, 0x37, 0x4e, 0x7e, 0xd5, 0x73, 0x8e, 0x72, 0xec, 0x6d, 0xbc, 0xfe, 0x58, 0xcf, 0x99, 0x9f,
-	0x00, 0xd4, 0x44, 0xf2, 0x3d, 0x12, 0x50, 0xe6, 0xf1, 0xe4, 0x44, 0xa4, 0xc1, 0xe2, 0x80, 0xf8,
-	0x74, 0x2c, 0x92, 0x2f, 0x39, 0x72, 0x81, 0x2c, 0x58, 0xa4, 0xcf, 0x7d, 0x12, 0xea, 0xf9, 0xb8,
-	0xda, 0xd5, 0xbf, 0x7f, 0x6d, 0x6b, 0xaa, 0x29, 0x77, 0x07, 0x83, 0x90, 0x30, 0x76, 0xc0, 0x43,
-	0xcf, 0x77, 0x1d, 0x29, 0x43, 0xf7, 0x21, 0x3c, 0x6b, 0xb9, 0x5e, 0x10, 0x90, 0xdb, 0x96, 0x72,
-	0xc4, 0x3d, 0xb7, 0xe4, 0x73, 0x9e, 0x91, 0xba, 0x44, 0x11, 0x38, 0x0b, 0x4e, 0xf3, 0x0b, 0x80,
-	0x57, 0xcf, 0x61, 0xaa, 0x16, 0x3c, 0x84, 0x9b, 0x03, 0x55, 0xd3, 0x41, 0xa3, 0xd0, 0x2c, 0x77,
-	0x6e, 0x64, 0x37, 0x41, 0x39, 0xbb, 0x97, 0xe3, 0x2e, 0x7c, 0xfe, 0x5d, 0xdf, 0x4c, 0xa3, 0xd2,
-	0x00, 0xf4, 0x60, 0x09, 0x37, 0x2f, 0x70, 0x6f, 0xfe, 0x17, 0x57, 0x92, 0x2c, 0xf1, 0x56, 0xe0,
-	0x75, 0x81, 0xfb, 0x84, 0x72, 0x3c, 0x3a, 0x88, 0x82, 0x60, 0x34, 0x4d, 0x9e, 0xf2, 0x3d, 0x80,
-	0xfa, 0xea, 0x9e, 0xba, 0xcd, 0x35, 0x58, 0x1a, 0x12, 0xcf, 0x1d, 0x72, 0xd1, 0xf6, 0x82, 0xa3,
-	0x56, 0xa8, 0x0f, 0x4b, 0x21, 0x61, 0xd1, 0x88, 0xeb, 0x79, 0x71, 0xc7, 0xca, 0x12, 0x54, 0x82,
-	0xb3, 0x4f, 0x3d, 0xbf, 0x7b, 0x5b, 0xdd, 0xaf, 0xe9, 0x7a, 0x7c, 0x18, 0xf5, 0xac, 0x3e, 0x1d,
-	0xab, 0xb9, 0x55, 0x1f, 0x6d, 0x36, 0x38, 0xb2, 0xf9, 0x34, 0x20, 0x4c, 0x18, 0x98, 0xa3, 0xa2,
-	0x3b, 0xdf, 0x0a, 0xb0, 0x28, 0xc8, 0xd0, 0x2b, 0x00, 0x4b, 0x72, 0x68, 0x50, 0x33, 0xbb, 0x9b,
-	0xab, 0x33, 0x5a, 0xdd, 0x59, 0x43, 0x29, 0xaf, 0x69, 0x6e, 0xbd, 0xfc, 0xf1, 0xf7, 0x5d, 0xde,
-	0x40, 0x35, 0x3b, 0xf3, 0xf7, 0x20, 0x27, 0x14, 0xbd, 0x01, 0x30, 0x7d, 0x24, 0xd4, 0xba, 0x20,
-	0xfd, 0xdc, 0xec, 0x56, 0x6f, 0xad, 0xa5, 0x55, 0x2c, 0xdb, 0x82, 0xa5, 0x81, 0x8c, 0x6c, 0x96,
-	0x74, 0x36, 0x3e, 0x00, 0x58, 0x5e, 0x78, 0x32, 0xd4, 0xbe, 0xe0, 0x90, 0xd5, 0x67, 0xaf, 0x5a,
-	0xeb, 0xca, 0x15, 0x56, 0x4b, 0x60, 0x6d, 0x21, 0x33, 0x1b, 0x8b, 0xc7, 0x96, 0x43, 0x26, 0x3c,
-	0xdd, 0xfd, 0x93, 0x99, 0x01, 0x4e, 0x67, 0x06, 0xf8, 0x33, 0x33, 0xc0, 0xdb, 0xb9, 0x91, 0x3b,
-	0x9d, 0x1b, 0xb9, 0x9f, 0x73, 0x23, 0xf7, 0x6c, 0x67, 0x61, 0x08, 0xe2, 0x9c, 0xf6, 0x08, 0xf7,
-	0x98, 0x4c, 0x7c, 0x91, 0x66, 0x8a, 0x59, 0xe8, 0x95, 0xc4, 0xff, 0xcf, 0x9d, 0x7f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xa9, 0x76, 0x1d, 0x1f, 0x76, 0x05, 0x00, 0x00,
+func init() { proto.RegisterFile("fury/savings/v1beta1/query.proto", fileDescriptor_656b5a49cac49fcc) }
+
+var fileDescriptor_656b5a49cac49fcc = []byte{
+	// 623 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x31, 0x6f, 0x13, 0x3f,
+	0x18, 0xc6, 0xe3, 0xa4, 0x8d, 0xfa, 0x77, 0x96, 0xbf, 0xcc, 0x01, 0x97, 0x28, 0x5c, 0xa2, 0x53,
+	0x55, 0x42, 0x50, 0xee, 0x68, 0xd8, 0xba, 0x11, 0x10, 0x08, 0xb1, 0xc0, 0x15, 0x09, 0x89, 0xa5,
+	0xba, 0x24, 0xe6, 0x62, 0x35, 0xb1, 0xaf, 0x67, 0x5f, 0x4b, 0x56, 0x58, 0x90, 0x58, 0x90, 0x18,
+	0x60, 0x64, 0x60, 0x42, 0x62, 0x43, 0x7c, 0x86, 0x8e, 0x15, 0x2c, 0x4c, 0x80, 0x12, 0x3e, 0x08,
+	0x3a, 0xdb, 0xb9, 0x26, 0xcd, 0xa9, 0x64, 0x4a, 0x6c, 0x3f, 0xcf, 0xe3, 0x9f, 0xdf, 0xf7, 0x4d,
+	0x60, 0xfd, 0x59, 0x1c, 0x8d, 0x5d, 0xee, 0x1f, 0x12, 0x1a, 0x70, 0xf7, 0x70, 0xbb, 0x8b, 0x85,
+	0xbf, 0xed, 0x1e, 0xc4, 0x38, 0x1a, 0x3b, 0x61, 0xc4, 0x04, 0x43, 0x46, 0xa2, 0x70, 0xb4, 0xc2,
+	0xd1, 0x8a, 0x4a, 0xb3, 0xc7, 0xf8, 0x88, 0x71, 0xb7, 0xeb, 0x73, 0xac, 0xe4, 0xa9, 0x39, 0xf4,
+	0x03, 0x42, 0x7d, 0x41, 0x18, 0x55, 0x09, 0x15, 0x6b, 0x5e, 0x3b, 0x53, 0xf5, 0x18, 0x99, 0x9d,
+	0x97, 0xd5, 0xf9, 0x9e, 0x5c, 0xb9, 0x6a, 0xa1, 0x8f, 0xb2, 0xf1, 0xb8, 0x60, 0x11, 0xd6, 0x0a,
+	0x23, 0x60, 0x01, 0x53, 0xce, 0xe4, 0x9b, 0xde, 0xad, 0x06, 0x8c, 0x05, 0x43, 0xec, 0xfa, 0x21,
+	0x71, 0x7d, 0x4a, 0x99, 0x90, 0x3c, 0x3a, 0xd5, 0x36, 0x20, 0x7a, 0x94, 0x20, 0x3f, 0xf4, 0x23,
+	0x7f, 0xc4, 0x3d, 0x7c, 0x10, 0x63, 0x2e, 0xec, 0x27, 0xf0, 0xc2, 0xc2, 0x2e, 0x0f, 0x19, 0xe5,
+	0x18, 0xed, 0xc0, 0x62, 0x28, 0x77, 0x4c, 0x50, 0x07, 0x8d, 0x52, 0xbb, 0xea, 0x64, 0x15, 0xc4,
+	0x51, 0xae, 0xce, 0xda, 0xf1, 0xcf, 0x5a, 0xce, 0xd3, 0x8e, 0x9d, 0xb5, 0x57, 0x1f, 0x6a, 0x39,
+	0xfb, 0x23, 0x80, 0x86, 0x4c, 0xbe, 0x83, 0x43, 0xc6, 0x89, 0x98, 0xdd, 0x88, 0x0c, 0xb8, 0xde,
+	0xc7, 0x94, 0x8d, 0x64, 0xf2, 0x7f, 0x9e, 0x5a, 0x20, 0x07, 0xae, 0xb3, 0x23, 0x8a, 0x23, 0x33,
+	0x9f, 0xec, 0x76, 0xcc, 0x6f, 0x5f, 0x5a, 0x86, 0x2e, 0xca, 0xad, 0x7e, 0x3f, 0xc2, 0x9c, 0xef,
+	0x8a, 0x88, 0xd0, 0xc0, 0x53, 0x32, 0x74, 0x17, 0xc2, 0xd3, 0x92, 0x9b, 0x05, 0x09, 0xb9, 0xe5,
+	0x68, 0x47, 0x52, 0x73, 0x47, 0xb5, 0xf3, 0x94, 0x34, 0xc0, 0x9a, 0xc0, 0x9b, 0x73, 0xda, 0x9f,
+	0x01, 0xbc, 0x78, 0x06, 0x53, 0x97, 0xe0, 0x01, 0xdc, 0xe8, 0xeb, 0x3d, 0x13, 0xd4, 0x0b, 0x8d,
+	0x52, 0xfb, 0x4a, 0x76, 0x11, 0xb4, 0xb3, 0xf3, 0x7f, 0x52, 0x85, 0x4f, 0xbf, 0x6a, 0x1b, 0x69,
+	0x54, 0x1a, 0x80, 0xee, 0x2d, 0xe0, 0xe6, 0x25, 0xee, 0xd5, 0x7f, 0xe2, 0x2a, 0x92, 0x05, 0xde,
+	0x32, 0xbc, 0x2c, 0x71, 0x1f, 0x33, 0xe1, 0x0f, 0x77, 0xe3, 0x30, 0x1c, 0x8e, 0x67, 0xad, 0x7c,
+	0x07, 0xa0, 0xb9, 0x7c, 0xa6, 0x5f, 0x73, 0x09, 0x16, 0x07, 0x98, 0x04, 0x03, 0x21, 0xcb, 0x5e,
+	0xf0, 0xf4, 0x0a, 0xf5, 0x60, 0x31, 0xc2, 0x3c, 0x1e, 0x0a, 0x33, 0x2f, 0xdf, 0x58, 0x5e, 0x80,
+	0x9a, 0xe1, 0xdc, 0x66, 0x84, 0x76, 0x6e, 0xe8, 0xf7, 0x35, 0x02, 0x22, 0x06, 0x71, 0xd7, 0xe9,
+	0xb1, 0x91, 0x9e, 0x5b, 0xfd, 0xd1, 0xe2, 0xfd, 0x7d, 0x57, 0x8c, 0x43, 0xcc, 0xa5, 0x81, 0x7b,
+	0x3a, 0xba, 0xfd, 0xb5, 0x00, 0xd7, 0x25, 0x19, 0x7a, 0x09, 0x60, 0x51, 0x0d, 0x0d, 0x6a, 0x64,
+	0x57, 0x73, 0x79, 0x46, 0x2b, 0xd7, 0x56, 0x50, 0xaa, 0x67, 0xda, 0x9b, 0x2f, 0xbe, 0xff, 0x79,
+	0x9b, 0xb7, 0x50, 0xd5, 0xcd, 0xfc, 0x0d, 0xa9, 0x09, 0x45, 0xaf, 0x01, 0x4c, 0x9b, 0x84, 0x9a,
+	0xe7, 0xa4, 0x9f, 0x99, 0xdd, 0xca, 0xf5, 0x95, 0xb4, 0x9a, 0x65, 0x4b, 0xb2, 0xd4, 0x91, 0x95,
+	0xcd, 0x92, 0xce, 0xc6, 0x7b, 0x00, 0x4b, 0x73, 0x2d, 0x43, 0xad, 0x73, 0x2e, 0x59, 0x6e, 0x7b,
+	0xc5, 0x59, 0x55, 0xae, 0xb1, 0x9a, 0x12, 0x6b, 0x13, 0xd9, 0xd9, 0x58, 0x22, 0xb1, 0xec, 0x71,
+	0xe9, 0xe9, 0xdc, 0x3f, 0x9e, 0x58, 0xe0, 0x64, 0x62, 0x81, 0xdf, 0x13, 0x0b, 0xbc, 0x99, 0x5a,
+	0xb9, 0x93, 0xa9, 0x95, 0xfb, 0x31, 0xb5, 0x72, 0x4f, 0xdd, 0xb9, 0x21, 0x20, 0xb4, 0x17, 0x77,
+	0x63, 0xde, 0xa2, 0x58, 0x1c, 0xb1, 0x68, 0x5f, 0xe5, 0x3e, 0x4f, 0x93, 0xe5, 0x44, 0x74, 0x8b,
+	0xf2, 0x5f, 0xe8, 0xe6, 0xdf, 0x01, 0x00, 0xf2, 0xfa, 0x47, 0xb6, 0x7c, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
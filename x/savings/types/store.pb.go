@@ -10,15 +10,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -29,13 +34,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params defines the parameters for the savings module.
 type Params struct {
 	SupportedDenoms []string `protobuf:"bytes,1,rep,name=supported_denoms,json=supportedDenoms,proto3" json:"supported_denoms,omitempty"`
+	// LockupTerms are the terms deposits can be locked for in exchange for a
+	// reward multiplier.
+	LockupTerms LockupTerms `protobuf:"bytes,2,rep,name=lockup_terms,json=lockupTerms,proto3,castrepeated=LockupTerms" json:"lockup_terms"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7110366fa182786, []int{0}
+	return fileDescriptor_044e344806415c5a, []int{0}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -64,17 +72,66 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// LockupTerm defines a time-locked deposit term.
+type LockupTerm struct {
+	// Duration is the time deposits are locked for.
+	Duration time.Duration `protobuf:"bytes,1,opt,name=duration,proto3,stdduration" json:"duration"`
+	// RewardMultiplier scales the savings incentive rewards earned by locked
+	// deposits.
+	RewardMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=reward_multiplier,json=rewardMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_multiplier"`
+	// EarlyWithdrawalPenalty is the fraction of a locked deposit withdrawn
+	// before maturity that is shared among the remaining savers.
+	EarlyWithdrawalPenalty github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=early_withdrawal_penalty,json=earlyWithdrawalPenalty,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"early_withdrawal_penalty"`
+}
+
+func (m *LockupTerm) Reset()         { *m = LockupTerm{} }
+func (m *LockupTerm) String() string { return proto.CompactTextString(m) }
+func (*LockupTerm) ProtoMessage()    {}
+func (*LockupTerm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_044e344806415c5a, []int{1}
+}
+func (m *LockupTerm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockupTerm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockupTerm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockupTerm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockupTerm.Merge(m, src)
+}
+func (m *LockupTerm) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockupTerm) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockupTerm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockupTerm proto.InternalMessageInfo
+
 // Deposit defines an amount of coins deposited into a savings module account.
 type Deposit struct {
 	Depositor github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=depositor,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"depositor,omitempty"`
 	Amount    github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// Locks are the parts of the amount that are locked until their maturity.
+	Locks Locks `protobuf:"bytes,3,rep,name=locks,proto3,castrepeated=Locks" json:"locks"`
+	// PenaltyIndexes are the penalty pool factors the amount was last synced to.
+	PenaltyIndexes PenaltyIndexes `protobuf:"bytes,4,rep,name=penalty_indexes,json=penaltyIndexes,proto3,castrepeated=PenaltyIndexes" json:"penalty_indexes"`
 }
 
 func (m *Deposit) Reset()         { *m = Deposit{} }
 func (m *Deposit) String() string { return proto.CompactTextString(m) }
 func (*Deposit) ProtoMessage()    {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7110366fa182786, []int{1}
+	return fileDescriptor_044e344806415c5a, []int{2}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -103,36 +160,196 @@ func (m *Deposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Deposit proto.InternalMessageInfo
 
+// Lock defines a part of a deposit locked for a lockup term.
+type Lock struct {
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+	// Term is the duration of the lockup term the amount is locked for.
+	Term time.Duration `protobuf:"bytes,2,opt,name=term,proto3,stdduration" json:"term"`
+	// Maturity is the time the amount is unlocked.
+	Maturity time.Time `protobuf:"bytes,3,opt,name=maturity,proto3,stdtime" json:"maturity"`
+	// RewardMultiplier is the reward multiplier of the term when locked.
+	RewardMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=reward_multiplier,json=rewardMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_multiplier"`
+	// EarlyWithdrawalPenalty is the early withdrawal penalty of the term when
+	// locked.
+	EarlyWithdrawalPenalty github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=early_withdrawal_penalty,json=earlyWithdrawalPenalty,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"early_withdrawal_penalty"`
+}
+
+func (m *Lock) Reset()         { *m = Lock{} }
+func (m *Lock) String() string { return proto.CompactTextString(m) }
+func (*Lock) ProtoMessage()    {}
+func (*Lock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_044e344806415c5a, []int{3}
+}
+func (m *Lock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Lock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Lock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Lock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Lock.Merge(m, src)
+}
+func (m *Lock) XXX_Size() int {
+	return m.Size()
+}
+func (m *Lock) XXX_DiscardUnknown() {
+	xxx_messageInfo_Lock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Lock proto.InternalMessageInfo
+
+// PenaltyIndex defines the penalty pool factor of a denom a deposit was last
+// synced to.
+type PenaltyIndex struct {
+	Denom string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Value github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"value"`
+}
+
+func (m *PenaltyIndex) Reset()         { *m = PenaltyIndex{} }
+func (m *PenaltyIndex) String() string { return proto.CompactTextString(m) }
+func (*PenaltyIndex) ProtoMessage()    {}
+func (*PenaltyIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_044e344806415c5a, []int{4}
+}
+func (m *PenaltyIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PenaltyIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PenaltyIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PenaltyIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PenaltyIndex.Merge(m, src)
+}
+func (m *PenaltyIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *PenaltyIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_PenaltyIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PenaltyIndex proto.InternalMessageInfo
+
+// PenaltyPool holds the early withdrawal penalties of a denom that have not
+// yet been added to the deposits of the remaining savers.
+type PenaltyPool struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Factor is the cumulative growth of deposits of the denom from penalties.
+	Factor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=factor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"factor"`
+	// Balance is the amount of penalties not yet added to deposits.
+	Balance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
+}
+
+func (m *PenaltyPool) Reset()         { *m = PenaltyPool{} }
+func (m *PenaltyPool) String() string { return proto.CompactTextString(m) }
+func (*PenaltyPool) ProtoMessage()    {}
+func (*PenaltyPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_044e344806415c5a, []int{5}
+}
+func (m *PenaltyPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PenaltyPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PenaltyPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PenaltyPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PenaltyPool.Merge(m, src)
+}
+func (m *PenaltyPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *PenaltyPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_PenaltyPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PenaltyPool proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "fury.savings.v1beta1.Params")
+	proto.RegisterType((*LockupTerm)(nil), "fury.savings.v1beta1.LockupTerm")
 	proto.RegisterType((*Deposit)(nil), "fury.savings.v1beta1.Deposit")
+	proto.RegisterType((*Lock)(nil), "fury.savings.v1beta1.Lock")
+	proto.RegisterType((*PenaltyIndex)(nil), "fury.savings.v1beta1.PenaltyIndex")
+	proto.RegisterType((*PenaltyPool)(nil), "fury.savings.v1beta1.PenaltyPool")
 }
 
-func init() { proto.RegisterFile("fury/savings/v1beta1/store.proto", fileDescriptor_f7110366fa182786) }
-
-var fileDescriptor_f7110366fa182786 = []byte{
-	// 335 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xc1, 0x4e, 0xc2, 0x40,
-	0x14, 0xec, 0x4a, 0x82, 0xa1, 0x1e, 0x34, 0x95, 0x03, 0x70, 0x58, 0x1a, 0x4e, 0xe5, 0xd0, 0x5d,
-	0x91, 0x2f, 0xa0, 0x92, 0xe8, 0xd1, 0x70, 0xf4, 0x42, 0xb6, 0xed, 0x5a, 0x1b, 0x6c, 0x5f, 0xd3,
-	0xb7, 0x10, 0xf9, 0x0b, 0xbf, 0xc3, 0xb3, 0x1f, 0xc1, 0x91, 0x78, 0x30, 0x9e, 0x50, 0xe1, 0x2f,
-	0x3c, 0x99, 0xb6, 0x2b, 0x7a, 0xf4, 0xb4, 0x6f, 0xe7, 0xcd, 0x4c, 0x26, 0xf3, 0x4c, 0x7b, 0x26,
-	0x16, 0x82, 0xa3, 0x58, 0xc4, 0x69, 0x84, 0x7c, 0x31, 0xf0, 0xa5, 0x12, 0x03, 0x8e, 0x0a, 0x72,
-	0xc9, 0xb2, 0x1c, 0x14, 0x58, 0xcd, 0x82, 0xc1, 0x34, 0x83, 0x69, 0x46, 0x87, 0x06, 0x80, 0x09,
-	0x20, 0xf7, 0x05, 0xca, 0xbd, 0x2c, 0x80, 0x38, 0xad, 0x54, 0x9d, 0x76, 0xb5, 0x9f, 0x96, 0x3f,
-	0x5e, 0x7d, 0xf4, 0xaa, 0x19, 0x41, 0x04, 0x15, 0x5e, 0x4c, 0x15, 0xda, 0x1b, 0x9a, 0xf5, 0x6b,
-	0x91, 0x8b, 0x04, 0xad, 0xbe, 0x79, 0x82, 0xf3, 0x2c, 0x83, 0x5c, 0xc9, 0x70, 0x1a, 0xca, 0x14,
-	0x12, 0x6c, 0x11, 0xbb, 0xe6, 0x34, 0x26, 0xc7, 0x7b, 0x7c, 0x5c, 0xc2, 0xbd, 0x57, 0x62, 0x1e,
-	0x8e, 0x65, 0x06, 0x18, 0x2b, 0xeb, 0xd6, 0x6c, 0x84, 0xd5, 0x08, 0x79, 0x8b, 0xd8, 0xc4, 0x69,
-	0x78, 0x57, 0x5f, 0x9b, 0xae, 0x1b, 0xc5, 0xea, 0x6e, 0xee, 0xb3, 0x00, 0x12, 0x1d, 0x43, 0x3f,
-	0x2e, 0x86, 0x33, 0xae, 0x96, 0x99, 0x44, 0x36, 0x0a, 0x82, 0x51, 0x18, 0xe6, 0x12, 0xf1, 0xe5,
-	0xd9, 0x3d, 0xd5, 0x61, 0x35, 0xe2, 0x2d, 0x95, 0xc4, 0xc9, 0xaf, 0xb5, 0x15, 0x98, 0x75, 0x91,
-	0xc0, 0x3c, 0x55, 0xad, 0x03, 0xbb, 0xe6, 0x1c, 0x9d, 0xb7, 0x99, 0x16, 0x14, 0x55, 0xfc, 0xf4,
-	0xc3, 0x2e, 0x20, 0x4e, 0xbd, 0xb3, 0xd5, 0xa6, 0x6b, 0x3c, 0xbd, 0x77, 0x9d, 0x7f, 0x64, 0x28,
-	0x04, 0x38, 0xd1, 0xd6, 0xde, 0xe5, 0xea, 0x93, 0x1a, 0xab, 0x2d, 0x25, 0xeb, 0x2d, 0x25, 0x1f,
-	0x5b, 0x4a, 0x1e, 0x77, 0xd4, 0x58, 0xef, 0xa8, 0xf1, 0xb6, 0xa3, 0xc6, 0x4d, 0xff, 0x8f, 0x5f,
-	0x71, 0x1d, 0xf7, 0x5e, 0xf8, 0x58, 0x4e, 0xfc, 0x61, 0x7f, 0xcb, 0xd2, 0xd6, 0xaf, 0x97, 0xed,
-	0x0e, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xef, 0x42, 0x46, 0x9e, 0xe8, 0x01, 0x00, 0x00,
+func init() { proto.RegisterFile("fury/savings/v1beta1/store.proto", fileDescriptor_044e344806415c5a) }
+
+var fileDescriptor_044e344806415c5a = []byte{
+	// 742 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8e, 0xf3, 0x07, 0x99, 0x70, 0x81, 0x3b, 0x20, 0x64, 0x58, 0x38, 0x51, 0x16, 0x57, 0xb9,
+	0x8b, 0xd8, 0x85, 0x2e, 0xd8, 0x54, 0xa2, 0xb8, 0x59, 0x14, 0xa9, 0x48, 0xc8, 0x42, 0x6d, 0xd5,
+	0x4d, 0x34, 0xb1, 0x27, 0xc1, 0xc2, 0xf6, 0x58, 0x33, 0xe3, 0x84, 0x3c, 0x44, 0x25, 0x96, 0xdd,
+	0xf4, 0x05, 0xba, 0xea, 0x82, 0x87, 0x60, 0x49, 0x59, 0x55, 0x5d, 0x40, 0x0b, 0x6f, 0x51, 0x75,
+	0x51, 0x79, 0x66, 0xe2, 0xa4, 0x08, 0x24, 0x54, 0xd1, 0xae, 0xe2, 0x73, 0xe6, 0x7c, 0xdf, 0x97,
+	0xf3, 0x37, 0x03, 0xea, 0xbd, 0x84, 0x8e, 0x2c, 0x86, 0x06, 0x7e, 0xd4, 0x67, 0xd6, 0x60, 0xbd,
+	0x8b, 0x39, 0x5a, 0xb7, 0x18, 0x27, 0x14, 0x9b, 0x31, 0x25, 0x9c, 0xc0, 0xe5, 0x34, 0xc2, 0x54,
+	0x11, 0xa6, 0x8a, 0x58, 0x33, 0x5c, 0xc2, 0x42, 0xc2, 0xac, 0x2e, 0x62, 0x38, 0x83, 0xb9, 0xc4,
+	0x8f, 0x24, 0x6a, 0x6d, 0x55, 0x9e, 0x77, 0x84, 0x65, 0x49, 0x43, 0x1d, 0x2d, 0xf7, 0x49, 0x9f,
+	0x48, 0x7f, 0xfa, 0xa5, 0xbc, 0x46, 0x9f, 0x90, 0x7e, 0x80, 0x2d, 0x61, 0x75, 0x93, 0x9e, 0xe5,
+	0x25, 0x14, 0x71, 0x9f, 0x8c, 0x09, 0x6b, 0x37, 0xcf, 0xb9, 0x1f, 0x62, 0xc6, 0x51, 0x18, 0xcb,
+	0x80, 0xc6, 0x5b, 0x0d, 0x94, 0xf7, 0x10, 0x45, 0x21, 0x83, 0xff, 0x83, 0x45, 0x96, 0xc4, 0x31,
+	0xa1, 0x1c, 0x7b, 0x1d, 0x0f, 0x47, 0x24, 0x64, 0xba, 0x56, 0x2f, 0x34, 0x2b, 0xce, 0x42, 0xe6,
+	0x6f, 0x0b, 0x37, 0x7c, 0x0d, 0xe6, 0x02, 0xe2, 0x1e, 0x26, 0x71, 0x87, 0x63, 0x1a, 0x32, 0x3d,
+	0x5f, 0x2f, 0x34, 0xab, 0x1b, 0x75, 0xf3, 0xb6, 0xa4, 0xcd, 0x17, 0x22, 0x72, 0x1f, 0xd3, 0xd0,
+	0x5e, 0x3a, 0xbd, 0xa8, 0xe5, 0x3e, 0x5c, 0xd6, 0xaa, 0x13, 0x1f, 0x73, 0xaa, 0xc1, 0xc4, 0x68,
+	0x7c, 0xcc, 0x03, 0x30, 0x39, 0x84, 0x5b, 0x60, 0x76, 0x9c, 0x91, 0xae, 0xd5, 0xb5, 0x66, 0x75,
+	0x63, 0xd5, 0x94, 0x29, 0x99, 0xe3, 0x94, 0xcc, 0xb6, 0x0a, 0xb0, 0x67, 0x53, 0xf6, 0x77, 0x97,
+	0x35, 0xcd, 0xc9, 0x40, 0xd0, 0x07, 0xff, 0x52, 0x3c, 0x44, 0xd4, 0xeb, 0x84, 0x49, 0xc0, 0xfd,
+	0x38, 0xf0, 0x31, 0xd5, 0xf3, 0x75, 0xad, 0x59, 0xb1, 0x9f, 0xa4, 0xe1, 0x5f, 0x2e, 0x6a, 0xff,
+	0xf5, 0x7d, 0x7e, 0x90, 0x74, 0x4d, 0x97, 0x84, 0xaa, 0xe4, 0xea, 0xa7, 0xc5, 0xbc, 0x43, 0x8b,
+	0x8f, 0x62, 0xcc, 0xcc, 0x36, 0x76, 0xcf, 0x4f, 0x5a, 0x40, 0x75, 0xa4, 0x8d, 0x5d, 0x67, 0x51,
+	0xd2, 0xee, 0x66, 0xac, 0x70, 0x00, 0x74, 0x8c, 0x68, 0x30, 0xea, 0x0c, 0x7d, 0x7e, 0xe0, 0x51,
+	0x34, 0x44, 0x41, 0x27, 0xc6, 0x11, 0x0a, 0xf8, 0x48, 0x2f, 0x3c, 0x80, 0xe2, 0x8a, 0x60, 0x7f,
+	0x95, 0x91, 0xef, 0x49, 0xee, 0xc6, 0x8f, 0x3c, 0x98, 0x69, 0xe3, 0x98, 0x30, 0x9f, 0xc3, 0x1e,
+	0xa8, 0x78, 0xf2, 0x93, 0x50, 0x51, 0xb0, 0x8a, 0xfd, 0xfc, 0xfb, 0x45, 0xad, 0x75, 0x0f, 0xc1,
+	0x6d, 0xd7, 0xdd, 0xf6, 0x3c, 0x8a, 0x19, 0x3b, 0x3f, 0x69, 0x2d, 0x29, 0x5d, 0xe5, 0xb1, 0x47,
+	0x1c, 0x33, 0x67, 0x42, 0x0d, 0x5d, 0x50, 0x46, 0x21, 0x49, 0x22, 0xae, 0x5a, 0xbf, 0x6a, 0x2a,
+	0x40, 0x3a, 0xd9, 0x59, 0xe7, 0x9f, 0x11, 0x3f, 0xb2, 0x1f, 0xa9, 0x9e, 0x37, 0xef, 0xf1, 0x1f,
+	0x52, 0x00, 0x73, 0x14, 0x35, 0xdc, 0x02, 0xa5, 0x74, 0x34, 0x98, 0x5e, 0x10, 0x1a, 0x6b, 0x77,
+	0x8f, 0x97, 0xfd, 0x8f, 0x12, 0x29, 0xa5, 0x16, 0x73, 0x24, 0x0e, 0xba, 0x60, 0x41, 0x35, 0xa0,
+	0xe3, 0x47, 0x1e, 0x3e, 0xc2, 0x4c, 0x2f, 0x0a, 0xaa, 0xc6, 0xed, 0x54, 0xaa, 0xa2, 0x3b, 0x69,
+	0xac, 0xbd, 0xa2, 0x28, 0xe7, 0xa7, 0xbd, 0x98, 0x39, 0xf3, 0xf1, 0x2f, 0x76, 0xe3, 0x7d, 0x01,
+	0x14, 0x53, 0x55, 0xb8, 0x99, 0xd5, 0x64, 0x3c, 0xa9, 0x77, 0xd6, 0xa4, 0x98, 0x72, 0x67, 0x79,
+	0x6e, 0x82, 0x62, 0xba, 0x46, 0x7a, 0x5e, 0xc1, 0xee, 0x31, 0xe0, 0x02, 0x00, 0x9f, 0x82, 0xd9,
+	0x10, 0xf1, 0x84, 0xfa, 0x6a, 0xc2, 0xd2, 0x1a, 0xdd, 0x04, 0xef, 0x8f, 0x17, 0x5e, 0xa2, 0x8f,
+	0xc5, 0x7a, 0x8c, 0x51, 0xb7, 0xaf, 0x47, 0xf1, 0xaf, 0xaf, 0x47, 0xe9, 0x0f, 0xae, 0xc7, 0x11,
+	0x98, 0x9b, 0xee, 0x20, 0x5c, 0x06, 0x25, 0x71, 0xb9, 0xc9, 0xf5, 0x70, 0xa4, 0x01, 0x1d, 0x50,
+	0x1a, 0xa0, 0x20, 0xc1, 0x0f, 0x72, 0x37, 0x48, 0xaa, 0xc6, 0x27, 0x0d, 0x54, 0x95, 0xf4, 0x1e,
+	0x21, 0xc1, 0x1d, 0xca, 0xfb, 0xa0, 0xdc, 0x43, 0x6e, 0xba, 0xaf, 0x0f, 0x21, 0xad, 0xb8, 0xe0,
+	0x4b, 0x30, 0xd3, 0x45, 0x01, 0x8a, 0x5c, 0xfc, 0x1b, 0x77, 0xcf, 0x4e, 0xc4, 0xa7, 0x68, 0x77,
+	0x22, 0xee, 0x8c, 0xc9, 0xec, 0xdd, 0xd3, 0x6f, 0x46, 0xee, 0xf4, 0xca, 0xd0, 0xce, 0xae, 0x0c,
+	0xed, 0xeb, 0x95, 0xa1, 0x1d, 0x5f, 0x1b, 0xb9, 0xb3, 0x6b, 0x23, 0xf7, 0xf9, 0xda, 0xc8, 0xbd,
+	0xb1, 0xa6, 0xc8, 0xfd, 0xc8, 0x4d, 0xba, 0x09, 0x6b, 0x45, 0x98, 0x0f, 0x09, 0x3d, 0xb4, 0xc4,
+	0x93, 0x79, 0x94, 0x3d, 0x9a, 0x42, 0xa9, 0x5b, 0x16, 0x73, 0xfa, 0xf8, 0xe7, 0x00, 0x6d, 0xa9,
+	0xd2, 0x8d, 0x51, 0x07, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -155,6 +372,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LockupTerms) > 0 {
+		for iNdEx := len(m.LockupTerms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupTerms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.SupportedDenoms) > 0 {
 		for iNdEx := len(m.SupportedDenoms) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.SupportedDenoms[iNdEx])
@@ -167,6 +398,57 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LockupTerm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockupTerm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockupTerm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EarlyWithdrawalPenalty.Size()
+		i -= size
+		if _, err := m.EarlyWithdrawalPenalty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.RewardMultiplier.Size()
+		i -= size
+		if _, err := m.RewardMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintStore(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Deposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -187,6 +469,34 @@ func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PenaltyIndexes) > 0 {
+		for iNdEx := len(m.PenaltyIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PenaltyIndexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Amount) > 0 {
 		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -211,6 +521,165 @@ func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Lock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Lock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Lock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EarlyWithdrawalPenalty.Size()
+		i -= size
+		if _, err := m.EarlyWithdrawalPenalty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RewardMultiplier.Size()
+		i -= size
+		if _, err := m.RewardMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Maturity, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Maturity):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintStore(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Term, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Term):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintStore(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PenaltyIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PenaltyIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PenaltyIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PenaltyPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PenaltyPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PenaltyPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Factor.Size()
+		i -= size
+		if _, err := m.Factor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStore(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStore(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStore(v)
 	base := offset
@@ -234,6 +703,27 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovStore(uint64(l))
 		}
 	}
+	if len(m.LockupTerms) > 0 {
+		for _, e := range m.LockupTerms {
+			l = e.Size()
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LockupTerm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovStore(uint64(l))
+	l = m.RewardMultiplier.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = m.EarlyWithdrawalPenalty.Size()
+	n += 1 + l + sovStore(uint64(l))
 	return n
 }
 
@@ -253,18 +743,81 @@ func (m *Deposit) Size() (n int) {
 			n += 1 + l + sovStore(uint64(l))
 		}
 	}
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
+	if len(m.PenaltyIndexes) > 0 {
+		for _, e := range m.PenaltyIndexes {
+			l = e.Size()
+			n += 1 + l + sovStore(uint64(l))
+		}
+	}
 	return n
 }
 
-func sovStore(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStore(x uint64) (n int) {
-	return sovStore(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *Lock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Term)
+	n += 1 + l + sovStore(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Maturity)
+	n += 1 + l + sovStore(uint64(l))
+	l = m.RewardMultiplier.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = m.EarlyWithdrawalPenalty.Size()
+	n += 1 + l + sovStore(uint64(l))
+	return n
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+
+func (m *PenaltyIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *PenaltyPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = m.Factor.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func sovStore(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStore(x uint64) (n int) {
+	return sovStore(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -323,6 +876,191 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.SupportedDenoms = append(m.SupportedDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupTerms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupTerms = append(m.LockupTerms, LockupTerm{})
+			if err := m.LockupTerms[len(m.LockupTerms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockupTerm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockupTerm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockupTerm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyWithdrawalPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EarlyWithdrawalPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStore(dAtA[iNdEx:])
@@ -439,6 +1177,557 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, Lock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyIndexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PenaltyIndexes = append(m.PenaltyIndexes, PenaltyIndex{})
+			if err := m.PenaltyIndexes[len(m.PenaltyIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Lock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Lock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Lock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Term, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maturity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Maturity, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyWithdrawalPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EarlyWithdrawalPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PenaltyIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PenaltyIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PenaltyIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PenaltyPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PenaltyPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PenaltyPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Factor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStore(dAtA[iNdEx:])
@@ -12,18 +12,22 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -35,13 +39,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type MsgDeposit struct {
 	Depositor string                                   `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
 	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// LockupTerm optionally locks the deposit for one of the lockup terms in params.
+	LockupTerm *time.Duration `protobuf:"bytes,3,opt,name=lockup_term,json=lockupTerm,proto3,stdduration" json:"lockup_term,omitempty"`
 }
 
 func (m *MsgDeposit) Reset()         { *m = MsgDeposit{} }
 func (m *MsgDeposit) String() string { return proto.CompactTextString(m) }
 func (*MsgDeposit) ProtoMessage()    {}
 func (*MsgDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c0bf8679b144267a, []int{0}
+	return fileDescriptor_d460262261c3faa1, []int{0}
 }
 func (m *MsgDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -84,6 +90,13 @@ func (m *MsgDeposit) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *MsgDeposit) GetLockupTerm() *time.Duration {
+	if m != nil {
+		return m.LockupTerm
+	}
+	return nil
+}
+
 // MsgDepositResponse defines the Msg/Deposit response type.
 type MsgDepositResponse struct {
 }
@@ -92,7 +105,7 @@ func (m *MsgDepositResponse) Reset()         { *m = MsgDepositResponse{} }
 func (m *MsgDepositResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositResponse) ProtoMessage()    {}
 func (*MsgDepositResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c0bf8679b144267a, []int{1}
+	return fileDescriptor_d460262261c3faa1, []int{1}
 }
 func (m *MsgDepositResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -125,13 +138,16 @@ var xxx_messageInfo_MsgDepositResponse proto.InternalMessageInfo
 type MsgWithdraw struct {
 	Depositor string                                   `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
 	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// EarlyWithdrawal allows withdrawing locked deposits before maturity, paying
+	// the early withdrawal penalty of their lockup terms.
+	EarlyWithdrawal bool `protobuf:"varint,3,opt,name=early_withdrawal,json=earlyWithdrawal,proto3" json:"early_withdrawal,omitempty"`
 }
 
 func (m *MsgWithdraw) Reset()         { *m = MsgWithdraw{} }
 func (m *MsgWithdraw) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdraw) ProtoMessage()    {}
 func (*MsgWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c0bf8679b144267a, []int{2}
+	return fileDescriptor_d460262261c3faa1, []int{2}
 }
 func (m *MsgWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -174,6 +190,13 @@ func (m *MsgWithdraw) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *MsgWithdraw) GetEarlyWithdrawal() bool {
+	if m != nil {
+		return m.EarlyWithdrawal
+	}
+	return false
+}
+
 // MsgWithdrawResponse defines the Msg/Withdraw response type.
 type MsgWithdrawResponse struct {
 }
@@ -182,7 +205,7 @@ func (m *MsgWithdrawResponse) Reset()         { *m = MsgWithdrawResponse{} }
 func (m *MsgWithdrawResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawResponse) ProtoMessage()    {}
 func (*MsgWithdrawResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c0bf8679b144267a, []int{3}
+	return fileDescriptor_d460262261c3faa1, []int{3}
 }
 func (m *MsgWithdrawResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,33 +241,39 @@ func init() {
 	proto.RegisterType((*MsgWithdrawResponse)(nil), "fury.savings.v1beta1.MsgWithdrawResponse")
 }
 
-func init() { proto.RegisterFile("fury/savings/v1beta1/tx.proto", fileDescriptor_c0bf8679b144267a) }
-
-var fileDescriptor_c0bf8679b144267a = []byte{
-	// 368 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x52, 0xcd, 0x4e, 0xea, 0x40,
-	0x14, 0xee, 0x5c, 0x12, 0xee, 0x65, 0xd8, 0xf5, 0xd6, 0x04, 0x9a, 0x58, 0x90, 0x55, 0x59, 0x30,
-	0x15, 0x4c, 0xdc, 0x0b, 0x6e, 0xd9, 0x60, 0x8c, 0xc6, 0x8d, 0x99, 0xfe, 0x64, 0x98, 0x20, 0x9d,
-	0xa6, 0x67, 0x40, 0x7c, 0x0b, 0x5f, 0x43, 0xd6, 0xc6, 0x67, 0x60, 0x49, 0x5c, 0xb9, 0x52, 0x03,
-	0x2f, 0x62, 0xda, 0x4e, 0x81, 0x85, 0x86, 0xad, 0xab, 0x9e, 0x99, 0xef, 0x27, 0xdf, 0x7c, 0x3d,
-	0xf8, 0x70, 0x44, 0xa7, 0xd4, 0x01, 0x3a, 0xe5, 0x21, 0x03, 0x67, 0xda, 0x76, 0x03, 0x49, 0xdb,
-	0x8e, 0x9c, 0x91, 0x28, 0x16, 0x52, 0xe8, 0x46, 0x02, 0x13, 0x05, 0x13, 0x05, 0x9b, 0x96, 0x27,
-	0x60, 0x2c, 0xc0, 0x71, 0x29, 0x04, 0x1b, 0x8d, 0x27, 0x78, 0x98, 0xa9, 0xcc, 0x6a, 0x86, 0xdf,
-	0xa6, 0x27, 0x27, 0x3b, 0x28, 0xc8, 0x60, 0x82, 0x89, 0xec, 0x3e, 0x99, 0xb2, 0xdb, 0xc6, 0x13,
-	0xc2, 0xb8, 0x0f, 0xec, 0x3c, 0x88, 0x04, 0x70, 0xa9, 0x9f, 0xe2, 0x92, 0x9f, 0x8d, 0x22, 0xae,
-	0xa0, 0x3a, 0xb2, 0x4b, 0xdd, 0xca, 0xeb, 0x73, 0xcb, 0x50, 0x4e, 0x67, 0xbe, 0x1f, 0x07, 0x00,
-	0x17, 0x32, 0xe6, 0x21, 0x1b, 0x6c, 0xa9, 0xba, 0x87, 0x8b, 0x74, 0x2c, 0x26, 0xa1, 0xac, 0xfc,
-	0xa9, 0x17, 0xec, 0x72, 0xa7, 0x4a, 0x94, 0x22, 0x09, 0x9a, 0xa7, 0x27, 0x3d, 0xc1, 0xc3, 0xee,
-	0xf1, 0xe2, 0xbd, 0xa6, 0xcd, 0x3f, 0x6a, 0x36, 0xe3, 0x72, 0x38, 0x71, 0x89, 0x27, 0xc6, 0x2a,
-	0xa8, 0xfa, 0xb4, 0xc0, 0x1f, 0x39, 0xf2, 0x21, 0x0a, 0x20, 0x15, 0xc0, 0x40, 0x59, 0x37, 0x0c,
-	0xac, 0x6f, 0xa3, 0x0e, 0x02, 0x88, 0x44, 0x08, 0x41, 0x63, 0x8e, 0x70, 0xb9, 0x0f, 0xec, 0x8a,
-	0xcb, 0xa1, 0x1f, 0xd3, 0xfb, 0xdf, 0xfd, 0x84, 0x03, 0xfc, 0x7f, 0x27, 0x6b, 0xfe, 0x86, 0xce,
-	0x0b, 0xc2, 0x85, 0x3e, 0x30, 0xfd, 0x12, 0xff, 0xcd, 0xff, 0x44, 0x9d, 0x7c, 0xb7, 0x00, 0x64,
-	0x5b, 0x80, 0x69, 0xef, 0x63, 0xe4, 0xf6, 0xfa, 0x35, 0xfe, 0xb7, 0xa9, 0xe7, 0xe8, 0x47, 0x55,
-	0x4e, 0x31, 0x9b, 0x7b, 0x29, 0xb9, 0x73, 0xb7, 0xb7, 0x58, 0x59, 0x68, 0xb9, 0xb2, 0xd0, 0xe7,
-	0xca, 0x42, 0x8f, 0x6b, 0x4b, 0x5b, 0xae, 0x2d, 0xed, 0x6d, 0x6d, 0x69, 0x37, 0xcd, 0x9d, 0x6e,
-	0x12, 0xbb, 0xd6, 0x1d, 0x75, 0x21, 0x9d, 0x9c, 0xd9, 0x66, 0xeb, 0xd3, 0x8a, 0xdc, 0x62, 0xba,
-	0x8a, 0x27, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x4e, 0x17, 0x24, 0x24, 0x12, 0x03, 0x00, 0x00,
+func init() { proto.RegisterFile("fury/savings/v1beta1/tx.proto", fileDescriptor_d460262261c3faa1) }
+
+var fileDescriptor_d460262261c3faa1 = []byte{
+	// 457 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x93, 0x3d, 0x6f, 0x13, 0x31,
+	0x18, 0xc7, 0x63, 0x82, 0x4a, 0xeb, 0x0c, 0xa0, 0x23, 0x48, 0x69, 0x24, 0x2e, 0x21, 0x53, 0x3a,
+	0xc4, 0xa6, 0x45, 0x62, 0x86, 0xd0, 0x85, 0x21, 0xcb, 0x01, 0x2a, 0x62, 0x89, 0xee, 0xc5, 0x75,
+	0xad, 0xe4, 0xee, 0x39, 0xf9, 0xf1, 0x35, 0xcd, 0xb7, 0x60, 0xe4, 0x33, 0x30, 0x23, 0x3e, 0x43,
+	0xc7, 0x0a, 0x09, 0x89, 0x89, 0xa2, 0x64, 0xe4, 0x4b, 0xa0, 0xf8, 0x7c, 0x97, 0x0e, 0xa0, 0xae,
+	0x4c, 0xe7, 0xe7, 0xe5, 0xff, 0xf8, 0x7e, 0x7f, 0xdb, 0xf4, 0xf1, 0x69, 0xa1, 0x97, 0x1c, 0xc3,
+	0x73, 0x95, 0x49, 0xe4, 0xe7, 0x87, 0x91, 0x30, 0xe1, 0x21, 0x37, 0x17, 0x2c, 0xd7, 0x60, 0xc0,
+	0x6b, 0x6f, 0xca, 0xcc, 0x95, 0x99, 0x2b, 0x77, 0xfd, 0x18, 0x30, 0x05, 0xe4, 0x51, 0x88, 0xa2,
+	0xd6, 0xc4, 0xa0, 0xb2, 0x52, 0xd5, 0xdd, 0x2f, 0xeb, 0x53, 0x1b, 0xf1, 0x32, 0x70, 0xa5, 0xb6,
+	0x04, 0x09, 0x65, 0x7e, 0xb3, 0x72, 0x59, 0x5f, 0x02, 0xc8, 0xb9, 0xe0, 0x36, 0x8a, 0x8a, 0x53,
+	0x9e, 0x14, 0x3a, 0x34, 0x0a, 0xdc, 0xc0, 0xc1, 0x6f, 0x42, 0xe9, 0x04, 0xe5, 0xb1, 0xc8, 0x01,
+	0x95, 0xf1, 0x9e, 0xd3, 0xbd, 0xa4, 0x5c, 0x82, 0xee, 0x90, 0x3e, 0x19, 0xee, 0x8d, 0x3b, 0xdf,
+	0xbe, 0x8c, 0xda, 0x6e, 0xa7, 0x97, 0x49, 0xa2, 0x05, 0xe2, 0x1b, 0xa3, 0x55, 0x26, 0x83, 0x6d,
+	0xab, 0x17, 0xd3, 0x9d, 0x30, 0x85, 0x22, 0x33, 0x9d, 0x3b, 0xfd, 0xe6, 0xb0, 0x75, 0xb4, 0xcf,
+	0x9c, 0x62, 0x03, 0x52, 0xd1, 0xb1, 0x57, 0xa0, 0xb2, 0xf1, 0xd3, 0xcb, 0x9f, 0xbd, 0xc6, 0xe7,
+	0xeb, 0xde, 0x50, 0x2a, 0x73, 0x56, 0x44, 0x2c, 0x86, 0xd4, 0x81, 0xb8, 0xcf, 0x08, 0x93, 0x19,
+	0x37, 0xcb, 0x5c, 0xa0, 0x15, 0x60, 0xe0, 0x46, 0x7b, 0x2f, 0x68, 0x6b, 0x0e, 0xf1, 0xac, 0xc8,
+	0xa7, 0x46, 0xe8, 0xb4, 0xd3, 0xec, 0x13, 0xbb, 0x53, 0x49, 0xc8, 0x2a, 0x42, 0x76, 0xec, 0x08,
+	0xc7, 0x77, 0x3f, 0x5d, 0xf7, 0x48, 0x40, 0x4b, 0xcd, 0x5b, 0xa1, 0xd3, 0x41, 0x9b, 0x7a, 0x5b,
+	0xd8, 0x40, 0x60, 0x0e, 0x19, 0x8a, 0xc1, 0x77, 0x42, 0x5b, 0x13, 0x94, 0x27, 0xca, 0x9c, 0x25,
+	0x3a, 0x5c, 0xfc, 0xdf, 0x26, 0x1c, 0xd0, 0x07, 0x22, 0xd4, 0xf3, 0xe5, 0x74, 0xe1, 0x7e, 0x37,
+	0x9c, 0x5b, 0x27, 0x76, 0x83, 0xfb, 0x36, 0x7f, 0x52, 0xa7, 0x07, 0x8f, 0xe8, 0xc3, 0x1b, 0x58,
+	0x15, 0xee, 0xd1, 0x57, 0x42, 0x9b, 0x13, 0x94, 0xde, 0x3b, 0x7a, 0xaf, 0x3a, 0xf6, 0x3e, 0xfb,
+	0xdb, 0x6d, 0x64, 0x5b, 0xaf, 0xba, 0xc3, 0xdb, 0x3a, 0xaa, 0xf1, 0xde, 0x7b, 0xba, 0x5b, 0x3b,
+	0xf9, 0xe4, 0x9f, 0xaa, 0xaa, 0xa5, 0x7b, 0x70, 0x6b, 0x4b, 0x35, 0x79, 0xfc, 0xfa, 0x72, 0xe5,
+	0x93, 0xab, 0x95, 0x4f, 0x7e, 0xad, 0x7c, 0xf2, 0x71, 0xed, 0x37, 0xae, 0xd6, 0x7e, 0xe3, 0xc7,
+	0xda, 0x6f, 0x7c, 0xe0, 0x37, 0x6c, 0x54, 0x59, 0x5c, 0x44, 0x05, 0x8e, 0x32, 0x61, 0x16, 0xa0,
+	0x67, 0xdc, 0x3e, 0xc3, 0x8b, 0xfa, 0x21, 0x5a, 0x4f, 0xa3, 0x1d, 0x7b, 0x5b, 0x9e, 0xfd, 0x19,
+	0x00, 0x95, 0xf2, 0xfc, 0x10, 0xa5, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -387,6 +416,16 @@ func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LockupTerm != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.LockupTerm, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.LockupTerm):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Amount) > 0 {
 		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -454,6 +493,16 @@ func (m *MsgWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EarlyWithdrawal {
+		i--
+		if m.EarlyWithdrawal {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Amount) > 0 {
 		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -528,6 +577,10 @@ func (m *MsgDeposit) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if m.LockupTerm != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.LockupTerm)
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -556,6 +609,9 @@ func (m *MsgWithdraw) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if m.EarlyWithdrawal {
+		n += 2
+	}
 	return n
 }
 
@@ -669,6 +725,42 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupTerm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LockupTerm == nil {
+				m.LockupTerm = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.LockupTerm, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -835,6 +927,26 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyWithdrawal", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EarlyWithdrawal = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])